@@ -1,6 +1,7 @@
 package app
 
 import (
+	"context"
 	"io"
 
 	clienthelpers "cosmossdk.io/client/v2/helpers"
@@ -47,6 +48,9 @@ import (
 
 	"resist/docs"
 	identitymodulekeeper "resist/x/identity/keeper"
+	"resist/x/identity/login"
+	"resist/x/posts/hubsync"
+	postsipfs "resist/x/posts/ipfs"
 	postsmodulekeeper "resist/x/posts/keeper"
 	rewardsmodulekeeper "resist/x/rewards/keeper"
 	usergroupskeeper "resist/x/usergroups/keeper"
@@ -109,6 +113,14 @@ type App struct {
 	PostsKeeper    postsmodulekeeper.Keeper
 	RewardsKeeper  rewardsmodulekeeper.Keeper
 	IdentityKeeper identitymodulekeeper.Keeper
+
+	// hub sync daemon
+	hubSyncConfig hubsync.Config
+	homePath      string
+	stopHubSync   context.CancelFunc
+
+	// off-chain login
+	loginConfig login.Config
 }
 
 func init() {
@@ -201,6 +213,17 @@ func New(
 		panic(err)
 	}
 
+	app.configureHubSync(appOpts)
+	app.configureLogin(appOpts)
+
+	// pin the content distributed by committed blocks in the node's IPFS
+	// backend, off the consensus path.
+	if client := app.PostsKeeper.IPFSClient(); client != nil {
+		streaming := app.StreamingManager()
+		streaming.ABCIListeners = append(streaming.ABCIListeners, postsipfs.NewPinner(app.PostsKeeper, client, app.Logger().With("module", "ipfs-pinner")))
+		app.SetStreamingManager(streaming)
+	}
+
 	/****  Module Options ****/
 
 	// create the simulation manager and define the order of the modules for deterministic simulations
@@ -280,6 +303,16 @@ func (app *App) RegisterAPIRoutes(apiSvr *api.Server, apiConfig config.APIConfig
 
 	// register app's OpenAPI routes.
 	docs.RegisterOpenAPIService(Name, apiSvr.Router)
+
+	// serve distributed content held by the node's IPFS backend.
+	if client := app.PostsKeeper.IPFSClient(); client != nil {
+		postsipfs.RegisterGatewayRoutes(apiSvr.Router, client)
+	}
+
+	// serve the off-chain login and session token verification.
+	if err := app.registerLoginRoutes(apiSvr.Router, apiSvr.ClientCtx); err != nil {
+		panic(err)
+	}
 }
 
 // GetMaccPerms returns a copy of the module account permissions
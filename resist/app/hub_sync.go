@@ -0,0 +1,102 @@
+package app
+
+import (
+	"context"
+	"net"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/server/config"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cast"
+
+	"resist/x/posts/hubsync"
+)
+
+// configureHubSync reads the hub sync daemon configuration. The daemon is
+// started along with the node services.
+func (app *App) configureHubSync(appOpts servertypes.AppOptions) {
+	app.hubSyncConfig = hubsync.ReadConfig(appOpts)
+	app.homePath = cast.ToString(appOpts.Get(flags.FlagHome))
+}
+
+// RegisterNodeService registers the node gRPC service and starts the hub sync
+// daemon when it is enabled.
+func (app *App) RegisterNodeService(clientCtx client.Context, cfg config.Config) {
+	app.App.RegisterNodeService(clientCtx, cfg)
+
+	if !app.hubSyncConfig.Enabled || app.stopHubSync != nil {
+		return
+	}
+	if err := app.startHubSync(clientCtx); err != nil {
+		panic(err)
+	}
+}
+
+func (app *App) startHubSync(clientCtx client.Context) error {
+	cfg := app.hubSyncConfig
+	logger := app.Logger().With("module", "hub-sync")
+
+	store := app.PostsKeeper.IPFSClient()
+	if store == nil {
+		logger.Error("hub sync requires an IPFS backend, not starting")
+		return nil
+	}
+
+	kr, err := keyring.New(sdk.KeyringServiceName(), cfg.KeyringBackend, app.homePath, clientCtx.Input, app.appCodec)
+	if err != nil {
+		return err
+	}
+	clientCtx = clientCtx.
+		WithCodec(app.appCodec).
+		WithInterfaceRegistry(app.interfaceRegistry).
+		WithTxConfig(app.txConfig)
+	broadcaster, err := hubsync.NewBroadcaster(clientCtx, kr, cfg.KeyName, app.ChainID(), cfg.GasLimit, cfg.Fees)
+	if err != nil {
+		return err
+	}
+	owner, err := app.AuthKeeper.AddressCodec().BytesToString(broadcaster.Address())
+	if err != nil {
+		return err
+	}
+
+	var events hubsync.EventsClient
+	if c, ok := clientCtx.Client.(hubsync.EventsClient); ok {
+		events = c
+	}
+	chain := hubsync.NewAppChain(
+		func() (sdk.Context, error) { return app.CreateQueryContext(0, false) },
+		app.PostsKeeper,
+		app.RewardsKeeper,
+		broadcaster,
+		events,
+	)
+	daemon, err := hubsync.NewDaemon(cfg, owner, chain, store, hubsync.NewKeyringSigner(kr, cfg.KeyName), app.AuthKeeper.AddressCodec(), logger)
+	if err != nil {
+		return err
+	}
+
+	ln, err := net.Listen("tcp", cfg.ListenAddress)
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	app.stopHubSync = cancel
+	go func() {
+		if err := daemon.Run(ctx, ln); err != nil {
+			logger.Error("hub sync daemon stopped", "err", err)
+		}
+	}()
+	logger.Info("hub sync daemon started", "node_id", cfg.NodeID, "address", ln.Addr().String())
+	return nil
+}
+
+// Close stops the hub sync daemon and closes the application.
+func (app *App) Close() error {
+	if app.stopHubSync != nil {
+		app.stopHubSync()
+	}
+	return app.App.Close()
+}
@@ -0,0 +1,57 @@
+package app
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gorilla/mux"
+
+	"resist/x/identity/login"
+)
+
+// configureLogin reads the off-chain login configuration. The login routes
+// are registered along with the API routes.
+func (app *App) configureLogin(appOpts servertypes.AppOptions) {
+	app.loginConfig = login.ReadConfig(appOpts)
+}
+
+// registerLoginRoutes serves the verification of session tokens and, when
+// login is enabled, issues challenges and session tokens signed by the hub.
+func (app *App) registerLoginRoutes(router *mux.Router, clientCtx client.Context) error {
+	cfg := app.loginConfig
+	if err := cfg.Validate(); err != nil {
+		return err
+	}
+
+	owners := login.NodeOwnersFunc(func(_ context.Context, nodeId string) (string, error) {
+		ctx, err := app.CreateQueryContext(0, false)
+		if err != nil {
+			return "", err
+		}
+		node, err := app.RewardsKeeper.GetNode(ctx, nodeId)
+		if err != nil {
+			return "", err
+		}
+		return node.Owner, nil
+	})
+	verifier := login.NewVerifier(owners, app.AuthKeeper.AddressCodec())
+
+	var service *login.Service
+	if cfg.Enabled {
+		kr, err := keyring.New(sdk.KeyringServiceName(), cfg.KeyringBackend, app.homePath, clientCtx.Input, app.appCodec)
+		if err != nil {
+			return err
+		}
+		signer := login.NewKeyringSigner(kr, cfg.KeyName)
+		if _, err := signer.PubKey(); err != nil {
+			return err
+		}
+		service = login.NewService(cfg.NodeID, signer, app.AuthKeeper.AddressCodec(), cfg.ChallengeTTL, cfg.TokenTTL)
+	}
+
+	login.RegisterRoutes(router, service, verifier)
+	return nil
+}
@@ -12,6 +12,7 @@ import (
 	"time"
 
 	"cosmossdk.io/log"
+	sdkmath "cosmossdk.io/math"
 	"cosmossdk.io/store"
 	storetypes "cosmossdk.io/store/types"
 	"cosmossdk.io/x/feegrant"
@@ -21,15 +22,22 @@ import (
 	dbm "github.com/cosmos/cosmos-db"
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	simulationtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	authzkeeper "github.com/cosmos/cosmos-sdk/x/authz/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/cosmos/cosmos-sdk/x/simulation"
 	simcli "github.com/cosmos/cosmos-sdk/x/simulation/client/cli"
 	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/spf13/viper"
 	"github.com/stretchr/testify/require"
+
+	poststypes "resist/x/posts/types"
+	rewardstypes "resist/x/rewards/types"
 )
 
 const (
@@ -456,4 +464,116 @@ func TestAppStateDeterminism(t *testing.T) {
 			}
 		}
 	}
-}
\ No newline at end of file
+}
+
+// TestAppHashDeterminism replays the same blocks of transactions on two app
+// instances, each with the local clock of a different time zone, and checks
+// that they compute the same app hashes. State transitions must only depend on
+// the block, never on the local clock of the validator.
+func TestAppHashDeterminism(t *testing.T) {
+	privKey := secp256k1.GenPrivKeyFromSecret([]byte("determinism"))
+	valSet, err := simtestutil.CreateRandomValidatorSet()
+	require.NoError(t, err)
+
+	// Both instances start from the same genesis
+	app := New(log.NewNopLogger(), dbm.NewMemDB(), nil, true, simtestutil.EmptyAppOptions{}, baseapp.SetChainID(SimAppChainID))
+	acc := authtypes.NewBaseAccount(sdk.AccAddress(privKey.PubKey().Address()), privKey.PubKey(), 0, 0)
+	balance := banktypes.Balance{
+		Address: acc.GetAddress().String(),
+		Coins:   sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(100000000000000))),
+	}
+	genesisState, err := simtestutil.GenesisStateWithValSet(app.AppCodec(), app.DefaultGenesis(), valSet, []authtypes.GenesisAccount{acc}, balance)
+	require.NoError(t, err)
+	stateBytes, err := json.Marshal(genesisState)
+	require.NoError(t, err)
+
+	local := time.Local
+	t.Cleanup(func() { time.Local = local })
+	time.Local = time.FixedZone("UTC-11", -11*60*60)
+	appHashes := replayDeterminismBlocks(t, stateBytes, privKey)
+	time.Local = time.FixedZone("UTC+14", 14*60*60)
+	require.Equal(t, appHashes, replayDeterminismBlocks(t, stateBytes, privKey))
+}
+
+// replayDeterminismBlocks runs blocks creating posts and allocating
+// resources on a new app instance, and returns the app hash of each block.
+// The messages of a block can depend on the state committed by the previous
+// blocks.
+func replayDeterminismBlocks(t *testing.T, stateBytes []byte, privKey *secp256k1.PrivKey) [][]byte {
+	t.Helper()
+
+	app := New(log.NewNopLogger(), dbm.NewMemDB(), nil, true, simtestutil.EmptyAppOptions{}, baseapp.SetChainID(SimAppChainID))
+	genesisTime := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	_, err := app.InitChain(&abci.RequestInitChain{
+		ChainId:         SimAppChainID,
+		Time:            genesisTime,
+		InitialHeight:   1,
+		ConsensusParams: simtestutil.DefaultConsensusParams,
+		AppStateBytes:   stateBytes,
+	})
+	require.NoError(t, err)
+
+	creator := sdk.AccAddress(privKey.PubKey().Address()).String()
+	resources := &rewardstypes.ResourceSpec{CpuCores: 4, MemoryGb: 8, StorageGb: 100, BandwidthMbps: 100}
+	blocks := []func(ctx sdk.Context) []sdk.Msg{
+		// The first block only commits the genesis
+		func(sdk.Context) []sdk.Msg { return nil },
+		func(sdk.Context) []sdk.Msg {
+			return []sdk.Msg{
+				&poststypes.MsgCreatePost{Creator: creator, Title: "first", Content: "first post"},
+				&poststypes.MsgCreatePost{Creator: creator, Title: "second", Content: "second post"},
+				&rewardstypes.MsgRegisterNode{Creator: creator, NodeId: "node-0", NodeType: "full", AvailableResources: resources},
+			}
+		},
+		func(sdk.Context) []sdk.Msg {
+			return []sdk.Msg{&rewardstypes.MsgCreateResourceOffer{Creator: creator, NodeId: "node-0", OfferedResources: resources, PricePerHour: 10}}
+		},
+		func(ctx sdk.Context) []sdk.Msg {
+			var offerID string
+			require.NoError(t, app.RewardsKeeper.ResourceOffers.Walk(ctx, nil, func(id string, _ rewardstypes.ResourceOffer) (bool, error) {
+				offerID = id
+				return true, nil
+			}))
+			return []sdk.Msg{&rewardstypes.MsgAllocateResources{Creator: creator, OfferId: offerID, ContentId: "content-0", RequestedResources: &rewardstypes.ResourceSpec{CpuCores: 1}, DurationHours: 2}}
+		},
+		func(ctx sdk.Context) []sdk.Msg {
+			var allocationID string
+			require.NoError(t, app.RewardsKeeper.ResourceAllocations.Walk(ctx, nil, func(id string, _ rewardstypes.ResourceAllocation) (bool, error) {
+				allocationID = id
+				return true, nil
+			}))
+			return []sdk.Msg{&rewardstypes.MsgDeallocateResources{Creator: creator, AllocationId: allocationID}}
+		},
+	}
+
+	r := rand.New(rand.NewSource(1))
+	var appHashes [][]byte
+	for i, block := range blocks {
+		height := int64(i + 1)
+		ctx := app.NewContext(true)
+
+		var txs [][]byte
+		if msgs := block(ctx); len(msgs) > 0 {
+			acc := app.AuthKeeper.GetAccount(ctx, sdk.AccAddress(privKey.PubKey().Address()))
+			tx, err := simtestutil.GenSignedMockTx(r, app.TxConfig(), msgs, sdk.NewCoins(), simtestutil.DefaultGenTxGas*10, SimAppChainID, []uint64{acc.GetAccountNumber()}, []uint64{acc.GetSequence()}, privKey)
+			require.NoError(t, err)
+			bz, err := app.TxConfig().TxEncoder()(tx)
+			require.NoError(t, err)
+			txs = append(txs, bz)
+		}
+
+		res, err := app.FinalizeBlock(&abci.RequestFinalizeBlock{
+			Height: height,
+			Time:   genesisTime.Add(time.Duration(height) * 5 * time.Second),
+			Txs:    txs,
+		})
+		require.NoError(t, err)
+		for _, txRes := range res.TxResults {
+			require.Zero(t, txRes.Code, "block %d: %s", height, txRes.Log)
+		}
+		_, err = app.Commit()
+		require.NoError(t, err)
+		appHashes = append(appHashes, res.AppHash)
+	}
+	return appHashes
+}
@@ -0,0 +1,167 @@
+// Package blindsig implements RSA blind signatures with a full domain hash
+// (RSA-FDH), used to issue persona credentials that issuers sign without
+// seeing, and that cannot be linked to the identity that requested them.
+//
+// A requester blinds a message with Blind and sends the blinded message to
+// the issuer, which signs it with BlindSign. The requester removes the
+// blinding factor from the blind signature with Unblind, and the result is a
+// plain RSA-FDH signature of the message, checked with Verify.
+//
+// Issuers must dedicate their persona keys to blind signatures: a blind
+// signature is a raw RSA operation on a value chosen by the requester.
+package blindsig
+
+import (
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+)
+
+// MinKeyBits is the minimum size of the modulus of a persona key.
+const MinKeyBits = 2048
+
+// ErrInvalidSignature is returned when a signature does not verify.
+var ErrInvalidSignature = errors.New("invalid blind signature")
+
+// ParsePublicKey parses a PKIX, ASN.1 DER encoded RSA public key of at least
+// MinKeyBits bits.
+func ParsePublicKey(der []byte) (*rsa.PublicKey, error) {
+	key, err := x509.ParsePKIXPublicKey(der)
+	if err != nil {
+		return nil, err
+	}
+	pub, ok := key.(*rsa.PublicKey)
+	if !ok {
+		return nil, fmt.Errorf("%T is not an RSA public key", key)
+	}
+	if pub.N.BitLen() < MinKeyBits {
+		return nil, fmt.Errorf("RSA key must be at least %d bits, got %d", MinKeyBits, pub.N.BitLen())
+	}
+	return pub, nil
+}
+
+// Blind blinds msg for pub with a random factor read from random. It returns
+// the blinded message to send to the signer and the unblinder to keep
+// secret until the blind signature is received.
+func Blind(random io.Reader, pub *rsa.PublicKey, msg []byte) (blinded, unblinder []byte, err error) {
+	e := big.NewInt(int64(pub.E))
+	for {
+		r, err := randomBelow(random, pub.N)
+		if err != nil {
+			return nil, nil, err
+		}
+		rInv := new(big.Int).ModInverse(r, pub.N)
+		if rInv == nil {
+			continue
+		}
+		// blinded = H(msg) * r^e mod n
+		m := new(big.Int).Exp(r, e, pub.N)
+		m.Mul(m, hashToInt(pub, msg)).Mod(m, pub.N)
+		return m.FillBytes(make([]byte, pub.Size())), rInv.FillBytes(make([]byte, pub.Size())), nil
+	}
+}
+
+// BlindSign signs a blinded message with priv.
+func BlindSign(priv *rsa.PrivateKey, blinded []byte) ([]byte, error) {
+	m, err := toInt(&priv.PublicKey, blinded)
+	if err != nil {
+		return nil, err
+	}
+	s := new(big.Int).Exp(m, priv.D, priv.N)
+	sig := s.FillBytes(make([]byte, priv.PublicKey.Size()))
+	// Do not leak a faulty signature.
+	if err := VerifyBlind(&priv.PublicKey, blinded, sig); err != nil {
+		return nil, err
+	}
+	return sig, nil
+}
+
+// VerifyBlind checks that sig is the blind signature of blinded by pub.
+func VerifyBlind(pub *rsa.PublicKey, blinded, sig []byte) error {
+	m, err := toInt(pub, blinded)
+	if err != nil {
+		return err
+	}
+	return verifyInt(pub, m, sig)
+}
+
+// Unblind removes the blinding factor from a blind signature, returning the
+// signature of the message passed to Blind.
+func Unblind(pub *rsa.PublicKey, blindSig, unblinder []byte) ([]byte, error) {
+	s, err := toInt(pub, blindSig)
+	if err != nil {
+		return nil, err
+	}
+	rInv, err := toInt(pub, unblinder)
+	if err != nil {
+		return nil, err
+	}
+	s.Mul(s, rInv).Mod(s, pub.N)
+	return s.FillBytes(make([]byte, pub.Size())), nil
+}
+
+// Verify checks that sig is the RSA-FDH signature of msg by pub.
+func Verify(pub *rsa.PublicKey, msg, sig []byte) error {
+	return verifyInt(pub, hashToInt(pub, msg), sig)
+}
+
+// verifyInt checks that sig^e = m mod n.
+func verifyInt(pub *rsa.PublicKey, m *big.Int, sig []byte) error {
+	s, err := toInt(pub, sig)
+	if err != nil {
+		return err
+	}
+	if new(big.Int).Exp(s, big.NewInt(int64(pub.E)), pub.N).Cmp(m) != 0 {
+		return ErrInvalidSignature
+	}
+	return nil
+}
+
+// hashToInt hashes msg to an integer modulo n, expanding SHA-256 with a
+// counter to the size of the modulus.
+func hashToInt(pub *rsa.PublicKey, msg []byte) *big.Int {
+	size := pub.Size()
+	digest := make([]byte, 0, size+sha256.Size)
+	var counter [4]byte
+	for i := uint32(0); len(digest) < size; i++ {
+		binary.BigEndian.PutUint32(counter[:], i)
+		h := sha256.New()
+		h.Write(counter[:])
+		h.Write(msg)
+		digest = h.Sum(digest)
+	}
+	m := new(big.Int).SetBytes(digest[:size])
+	return m.Mod(m, pub.N)
+}
+
+// toInt decodes a big-endian integer of the size of the modulus, lower than
+// the modulus.
+func toInt(pub *rsa.PublicKey, b []byte) (*big.Int, error) {
+	if len(b) != pub.Size() {
+		return nil, fmt.Errorf("expected %d bytes, got %d", pub.Size(), len(b))
+	}
+	m := new(big.Int).SetBytes(b)
+	if m.Cmp(pub.N) >= 0 {
+		return nil, errors.New("value out of range")
+	}
+	return m, nil
+}
+
+// randomBelow returns a random integer in [1, n).
+func randomBelow(random io.Reader, n *big.Int) (*big.Int, error) {
+	buf := make([]byte, (n.BitLen()+7)/8+8)
+	for {
+		if _, err := io.ReadFull(random, buf); err != nil {
+			return nil, err
+		}
+		r := new(big.Int).SetBytes(buf)
+		if r.Mod(r, n).Sign() > 0 {
+			return r, nil
+		}
+	}
+}
@@ -0,0 +1,54 @@
+package blindsig
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBlindSignature(t *testing.T) {
+	priv, err := rsa.GenerateKey(rand.Reader, MinKeyBits)
+	require.NoError(t, err)
+	der, err := x509.MarshalPKIXPublicKey(&priv.PublicKey)
+	require.NoError(t, err)
+	pub, err := ParsePublicKey(der)
+	require.NoError(t, err)
+
+	msg := []byte("persona")
+	blinded, unblinder, err := Blind(rand.Reader, pub, msg)
+	require.NoError(t, err)
+	require.NotEqual(t, hashToInt(pub, msg).Bytes(), blinded)
+
+	blindSig, err := BlindSign(priv, blinded)
+	require.NoError(t, err)
+	require.NoError(t, VerifyBlind(pub, blinded, blindSig))
+
+	sig, err := Unblind(pub, blindSig, unblinder)
+	require.NoError(t, err)
+	require.NoError(t, Verify(pub, msg, sig))
+	require.NotEqual(t, blindSig, sig)
+
+	require.ErrorIs(t, Verify(pub, []byte("other"), sig), ErrInvalidSignature)
+	require.ErrorIs(t, Verify(pub, msg, blindSig), ErrInvalidSignature)
+	require.Error(t, Verify(pub, msg, sig[1:]))
+
+	// Blinding the same message twice gives unrelated blinded messages
+	other, _, err := Blind(rand.Reader, pub, msg)
+	require.NoError(t, err)
+	require.NotEqual(t, blinded, other)
+}
+
+func TestParsePublicKey(t *testing.T) {
+	small, err := rsa.GenerateKey(rand.Reader, 1024)
+	require.NoError(t, err)
+	der, err := x509.MarshalPKIXPublicKey(&small.PublicKey)
+	require.NoError(t, err)
+	_, err = ParsePublicKey(der)
+	require.Error(t, err)
+
+	_, err = ParsePublicKey([]byte("invalid"))
+	require.Error(t, err)
+}
@@ -0,0 +1,8 @@
+package keeper
+
+import "context"
+
+// EndBlocker prunes the authentication challenges that expired unanswered.
+func (k Keeper) EndBlocker(ctx context.Context) error {
+	return k.PruneExpiredChallenges(ctx)
+}
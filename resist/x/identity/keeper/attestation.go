@@ -0,0 +1,124 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"slices"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"resist/x/identity/types"
+)
+
+// SetAttestation stores an attestation and indexes it under its subject.
+func (k Keeper) SetAttestation(ctx context.Context, attestation types.Attestation) error {
+	if err := k.AttestationBySubject.Set(ctx, collections.Join(attestation.Subject, attestation.Id)); err != nil {
+		return err
+	}
+	return k.Attestation.Set(ctx, attestation.Id, attestation)
+}
+
+// IsIssuer reports whether address is a registered attestation issuer.
+func (k Keeper) IsIssuer(ctx context.Context, address string) (bool, error) {
+	return k.Issuer.Has(ctx, address)
+}
+
+// IsAttestationValid reports whether an attestation is neither revoked nor
+// expired, and whether its issuer is still registered for its claim type.
+func (k Keeper) IsAttestationValid(ctx context.Context, attestation types.Attestation) (bool, error) {
+	if attestation.Revoked || attestation.ExpiresAt <= sdk.UnwrapSDKContext(ctx).BlockTime().Unix() {
+		return false, nil
+	}
+	issuer, err := k.Issuer.Get(ctx, attestation.Issuer)
+	if errors.Is(err, collections.ErrNotFound) {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+	return slices.Contains(issuer.ClaimTypes, attestation.ClaimType), nil
+}
+
+// GetValidAttestations returns the valid attestations of a subject.
+func (k Keeper) GetValidAttestations(ctx context.Context, subject string) ([]types.Attestation, error) {
+	var attestations []types.Attestation
+	err := k.AttestationBySubject.Walk(ctx, collections.NewPrefixedPairRange[string, uint64](subject), func(key collections.Pair[string, uint64]) (bool, error) {
+		attestation, err := k.Attestation.Get(ctx, key.K2())
+		if err != nil {
+			return true, err
+		}
+		valid, err := k.IsAttestationValid(ctx, attestation)
+		if err != nil {
+			return true, err
+		}
+		if valid {
+			attestations = append(attestations, attestation)
+		}
+		return false, nil
+	})
+	return attestations, err
+}
+
+// IsVerified reports whether an account satisfies the verification rules
+// param: it holds valid attestations of one of the verifying claim types by
+// enough distinct issuers, or it is a persona holding a valid credential.
+func (k Keeper) IsVerified(ctx context.Context, subject string) (bool, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return false, err
+	}
+	rules := params.VerificationRules
+
+	attestations, err := k.GetValidAttestations(ctx, subject)
+	if err != nil {
+		return false, err
+	}
+	issuers := make(map[string]struct{})
+	for _, attestation := range attestations {
+		if slices.Contains(rules.ClaimTypes, attestation.ClaimType) {
+			issuers[attestation.Issuer] = struct{}{}
+		}
+	}
+	if len(issuers) >= int(rules.MinIssuers) {
+		return true, nil
+	}
+	if !rules.PersonasVerified {
+		return false, nil
+	}
+
+	persona, err := k.Persona.Get(ctx, subject)
+	if errors.Is(err, collections.ErrNotFound) {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+	return k.IsPersonaValid(ctx, persona)
+}
+
+// GetVerifiedDomains returns the sorted domains subject holds valid domain
+// attestations for.
+func (k Keeper) GetVerifiedDomains(ctx context.Context, subject string) ([]string, error) {
+	attestations, err := k.GetValidAttestations(ctx, subject)
+	if err != nil {
+		return nil, err
+	}
+	var domains []string
+	for _, attestation := range attestations {
+		if attestation.ClaimType == types.ClaimTypeDomain {
+			domains = append(domains, attestation.Evidence)
+		}
+	}
+	slices.Sort(domains)
+	return slices.Compact(domains), nil
+}
+
+// withVerified sets the Verified flag of a profile from the attestations of
+// its owner.
+func (k Keeper) withVerified(ctx context.Context, profile types.UserProfile) (types.UserProfile, error) {
+	verified, err := k.IsVerified(ctx, profile.Creator)
+	if err != nil {
+		return types.UserProfile{}, err
+	}
+	profile.Verified = verified
+	return profile, nil
+}
@@ -0,0 +1,119 @@
+package keeper
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"resist/x/identity/types"
+)
+
+// maxExpiredChallengesPerBlock bounds the work done by the EndBlocker.
+// Expired challenges left over are pruned in the following blocks.
+const maxExpiredChallengesPerBlock = 500
+
+// GetChallenge returns the pending challenge of address, or an empty string
+// if there is none.
+func (k Keeper) GetChallenge(ctx context.Context, address string) (string, error) {
+	challenge, err := k.Challenge.Get(ctx, address)
+	if errors.Is(err, collections.ErrNotFound) {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	return challenge.Challenge, nil
+}
+
+// SetChallenge stores the challenge of an address, replacing and unindexing
+// its previous challenge.
+func (k Keeper) SetChallenge(ctx context.Context, challenge types.Challenge) error {
+	if err := k.RemoveChallenge(ctx, challenge.Address); err != nil {
+		return err
+	}
+	if err := k.Challenge.Set(ctx, challenge.Address, challenge); err != nil {
+		return err
+	}
+	if err := k.ChallengeByExpiry.Set(ctx, collections.Join(challenge.ExpiresAt, challenge.Address)); err != nil {
+		return err
+	}
+	return k.ChallengeByCreator.Set(ctx, collections.Join(challenge.Creator, challenge.Address))
+}
+
+// RemoveChallenge removes the challenge of an address and its indexes.
+// Removing a missing challenge is a no-op.
+func (k Keeper) RemoveChallenge(ctx context.Context, address string) error {
+	challenge, err := k.Challenge.Get(ctx, address)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if err := k.Challenge.Remove(ctx, address); err != nil {
+		return err
+	}
+	if err := k.ChallengeByExpiry.Remove(ctx, collections.Join(challenge.ExpiresAt, address)); err != nil {
+		return err
+	}
+	return k.ChallengeByCreator.Remove(ctx, collections.Join(challenge.Creator, address))
+}
+
+// CountPendingChallenges returns the number of challenges requested by
+// creator that were neither answered nor pruned.
+func (k Keeper) CountPendingChallenges(ctx context.Context, creator string) (uint32, error) {
+	var count uint32
+	err := k.ChallengeByCreator.Walk(ctx, collections.NewPrefixedPairRange[string, string](creator), func(collections.Pair[string, string]) (bool, error) {
+		count++
+		return false, nil
+	})
+	return count, err
+}
+
+// PruneExpiredChallenges removes up to maxExpiredChallengesPerBlock
+// challenges that expired before the block time.
+func (k Keeper) PruneExpiredChallenges(ctx context.Context) error {
+	blockTime := sdk.UnwrapSDKContext(ctx).BlockTime().Unix()
+
+	var expired []string
+	err := k.ChallengeByExpiry.Walk(ctx, nil, func(key collections.Pair[int64, string]) (bool, error) {
+		if key.K1() >= blockTime || len(expired) == maxExpiredChallengesPerBlock {
+			return true, nil
+		}
+		expired = append(expired, key.K2())
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, address := range expired {
+		if err := k.RemoveChallenge(ctx, address); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// newChallenge derives a unique challenge for address. Challenges must be
+// the same on every validator, so they hash the header of the block and a
+// sequence instead of using local randomness.
+func (k Keeper) newChallenge(ctx context.Context, address string) (string, error) {
+	seq, err := k.ChallengeSeq.Next(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	hash := sha256.New()
+	hash.Write([]byte(sdkCtx.ChainID()))
+	hash.Write(sdkCtx.HeaderHash())
+	hash.Write(binary.BigEndian.AppendUint64(nil, seq))
+	hash.Write([]byte(address))
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 
 	"resist/x/identity/types"
+
+	"cosmossdk.io/collections"
 )
 
 // InitGenesis initializes the module's state from a provided genesis state.
@@ -13,6 +15,70 @@ func (k Keeper) InitGenesis(ctx context.Context, genState types.GenesisState) er
 			return err
 		}
 	}
+	for _, elem := range genState.IssuerMap {
+		if err := k.Issuer.Set(ctx, elem.Address, elem); err != nil {
+			return err
+		}
+	}
+	for _, elem := range genState.AttestationList {
+		if err := k.SetAttestation(ctx, elem); err != nil {
+			return err
+		}
+	}
+	if err := k.AttestationSeq.Set(ctx, genState.AttestationCount); err != nil {
+		return err
+	}
+	for _, elem := range genState.HandleMap {
+		if err := k.SetHandle(ctx, elem); err != nil {
+			return err
+		}
+	}
+	for _, elem := range genState.GuardiansMap {
+		if err := k.setGuardians(ctx, elem); err != nil {
+			return err
+		}
+	}
+	for _, elem := range genState.RecoveryMap {
+		if err := k.Recovery.Set(ctx, elem.Address, elem); err != nil {
+			return err
+		}
+	}
+	for _, elem := range genState.PersonaKeyList {
+		if err := k.PersonaKey.Set(ctx, elem.Id, elem); err != nil {
+			return err
+		}
+	}
+	if err := k.PersonaKeySeq.Set(ctx, genState.PersonaKeyCount); err != nil {
+		return err
+	}
+	for _, elem := range genState.PersonaRequestList {
+		if err := k.SetPersonaRequest(ctx, elem); err != nil {
+			return err
+		}
+	}
+	if err := k.PersonaRequestSeq.Set(ctx, genState.PersonaRequestCount); err != nil {
+		return err
+	}
+	for _, elem := range genState.PersonaMap {
+		if err := k.Persona.Set(ctx, elem.Address, elem); err != nil {
+			return err
+		}
+	}
+	for _, elem := range genState.FollowList {
+		if err := k.SetFollow(ctx, elem.Follower, elem.Followee); err != nil {
+			return err
+		}
+	}
+	for _, elem := range genState.BlockList {
+		if err := k.SetBlock(ctx, elem.Blocker, elem.Blocked); err != nil {
+			return err
+		}
+	}
+	for _, elem := range genState.ChallengeList {
+		if err := k.SetChallenge(ctx, elem); err != nil {
+			return err
+		}
+	}
 
 	return k.Params.Set(ctx, genState.Params)
 }
@@ -32,6 +98,84 @@ func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error)
 	}); err != nil {
 		return nil, err
 	}
+	if err := k.Issuer.Walk(ctx, nil, func(_ string, val types.Issuer) (stop bool, err error) {
+		genesis.IssuerMap = append(genesis.IssuerMap, val)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+	if err := k.Attestation.Walk(ctx, nil, func(_ uint64, val types.Attestation) (stop bool, err error) {
+		genesis.AttestationList = append(genesis.AttestationList, val)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+	genesis.AttestationCount, err = k.AttestationSeq.Peek(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.Handle.Walk(ctx, nil, func(_ string, val types.Handle) (stop bool, err error) {
+		genesis.HandleMap = append(genesis.HandleMap, val)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+	if err := k.Guardians.Walk(ctx, nil, func(_ string, val types.Guardians) (stop bool, err error) {
+		genesis.GuardiansMap = append(genesis.GuardiansMap, val)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+	if err := k.Recovery.Walk(ctx, nil, func(_ string, val types.Recovery) (stop bool, err error) {
+		genesis.RecoveryMap = append(genesis.RecoveryMap, val)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+	if err := k.PersonaKey.Walk(ctx, nil, func(_ uint64, val types.PersonaKey) (stop bool, err error) {
+		genesis.PersonaKeyList = append(genesis.PersonaKeyList, val)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+	genesis.PersonaKeyCount, err = k.PersonaKeySeq.Peek(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.PersonaRequest.Walk(ctx, nil, func(_ uint64, val types.PersonaCredentialRequest) (stop bool, err error) {
+		genesis.PersonaRequestList = append(genesis.PersonaRequestList, val)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+	genesis.PersonaRequestCount, err = k.PersonaRequestSeq.Peek(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.Persona.Walk(ctx, nil, func(_ string, val types.Persona) (stop bool, err error) {
+		genesis.PersonaMap = append(genesis.PersonaMap, val)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+	if err := k.Follow.Walk(ctx, nil, func(key collections.Pair[string, string]) (stop bool, err error) {
+		genesis.FollowList = append(genesis.FollowList, types.Follow{Follower: key.K1(), Followee: key.K2()})
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+	if err := k.Block.Walk(ctx, nil, func(key collections.Pair[string, string]) (stop bool, err error) {
+		genesis.BlockList = append(genesis.BlockList, types.Block{Blocker: key.K1(), Blocked: key.K2()})
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+	if err := k.Challenge.Walk(ctx, nil, func(_ string, val types.Challenge) (stop bool, err error) {
+		genesis.ChallengeList = append(genesis.ChallengeList, val)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
 
 	return genesis, nil
 }
@@ -10,8 +10,23 @@ import (
 
 func TestGenesis(t *testing.T) {
 	genesisState := types.GenesisState{
-		Params:         types.DefaultParams(),
-		UserProfileMap: []types.UserProfile{{Index: "0"}, {Index: "1"}}}
+		Params:              types.DefaultParams(),
+		UserProfileMap:      []types.UserProfile{{Index: "0"}, {Index: "1"}},
+		IssuerMap:           []types.Issuer{{Address: "0", ClaimTypes: []string{types.ClaimTypeHuman}}},
+		AttestationList:     []types.Attestation{{Id: 0, Issuer: "0", Subject: "1", ClaimType: types.ClaimTypeHuman}},
+		AttestationCount:    1,
+		HandleMap:           []types.Handle{{Name: "alice", Owner: "0"}, {Name: "bob", Owner: "1"}},
+		GuardiansMap:        []types.Guardians{{Address: "0", Guardians: []string{"1"}, Threshold: 1}},
+		RecoveryMap:         []types.Recovery{{Address: "0", NewAddress: "2", Approvals: []string{"1"}}},
+		PersonaKeyList:      []types.PersonaKey{{Id: 0, Issuer: "0"}},
+		PersonaKeyCount:     1,
+		PersonaRequestList:  []types.PersonaCredentialRequest{{Id: 0, Requester: "1", KeyId: 0}},
+		PersonaRequestCount: 1,
+		PersonaMap:          []types.Persona{{Address: "2", KeyId: 0}},
+		FollowList:          []types.Follow{{Follower: "0", Followee: "1"}, {Follower: "1", Followee: "0"}},
+		BlockList:           []types.Block{{Blocker: "0", Blocked: "2"}},
+		ChallengeList:       []types.Challenge{{Address: "0", Challenge: "00", Creator: "1", ExpiresAt: 300}},
+	}
 
 	f := initFixture(t)
 	err := f.keeper.InitGenesis(f.ctx, genesisState)
@@ -22,5 +37,19 @@ func TestGenesis(t *testing.T) {
 
 	require.EqualExportedValues(t, genesisState.Params, got.Params)
 	require.EqualExportedValues(t, genesisState.UserProfileMap, got.UserProfileMap)
+	require.EqualExportedValues(t, genesisState.IssuerMap, got.IssuerMap)
+	require.EqualExportedValues(t, genesisState.AttestationList, got.AttestationList)
+	require.Equal(t, genesisState.AttestationCount, got.AttestationCount)
+	require.EqualExportedValues(t, genesisState.HandleMap, got.HandleMap)
+	require.EqualExportedValues(t, genesisState.GuardiansMap, got.GuardiansMap)
+	require.EqualExportedValues(t, genesisState.RecoveryMap, got.RecoveryMap)
+	require.EqualExportedValues(t, genesisState.PersonaKeyList, got.PersonaKeyList)
+	require.Equal(t, genesisState.PersonaKeyCount, got.PersonaKeyCount)
+	require.EqualExportedValues(t, genesisState.PersonaRequestList, got.PersonaRequestList)
+	require.Equal(t, genesisState.PersonaRequestCount, got.PersonaRequestCount)
+	require.EqualExportedValues(t, genesisState.PersonaMap, got.PersonaMap)
+	require.EqualExportedValues(t, genesisState.FollowList, got.FollowList)
+	require.EqualExportedValues(t, genesisState.BlockList, got.BlockList)
+	require.EqualExportedValues(t, genesisState.ChallengeList, got.ChallengeList)
 
 }
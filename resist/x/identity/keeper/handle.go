@@ -0,0 +1,53 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+
+	"resist/x/identity/types"
+)
+
+// SetHandle stores a handle and indexes it under its owner.
+func (k Keeper) SetHandle(ctx context.Context, handle types.Handle) error {
+	skeleton := types.HandleSkeleton(handle.Name)
+	if err := k.HandleByOwner.Set(ctx, handle.Owner, skeleton); err != nil {
+		return err
+	}
+	return k.Handle.Set(ctx, skeleton, handle)
+}
+
+// RemoveHandle removes a handle and its owner index.
+func (k Keeper) RemoveHandle(ctx context.Context, handle types.Handle) error {
+	if err := k.HandleByOwner.Remove(ctx, handle.Owner); err != nil {
+		return err
+	}
+	return k.Handle.Remove(ctx, types.HandleSkeleton(handle.Name))
+}
+
+// ResolveHandle returns the handle matching handle, ignoring its case, its
+// leading @ and confusable characters.
+func (k Keeper) ResolveHandle(ctx context.Context, handle string) (types.Handle, error) {
+	name, err := types.NormalizeHandle(handle)
+	if err != nil {
+		return types.Handle{}, errorsmod.Wrap(types.ErrInvalidHandle, err.Error())
+	}
+	val, err := k.Handle.Get(ctx, types.HandleSkeleton(name))
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.Handle{}, types.ErrHandleNotFound
+	}
+	return val, err
+}
+
+// GetOwnerHandle returns the handle held by owner.
+func (k Keeper) GetOwnerHandle(ctx context.Context, owner string) (types.Handle, error) {
+	skeleton, err := k.HandleByOwner.Get(ctx, owner)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.Handle{}, types.ErrHandleNotFound
+	} else if err != nil {
+		return types.Handle{}, err
+	}
+	return k.Handle.Get(ctx, skeleton)
+}
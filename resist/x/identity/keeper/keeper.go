@@ -2,13 +2,11 @@ package keeper
 
 import (
 	"fmt"
-	"strings"
 
 	"cosmossdk.io/collections"
 	"cosmossdk.io/core/address"
 	corestore "cosmossdk.io/core/store"
 	"github.com/cosmos/cosmos-sdk/codec"
-	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"resist/x/identity/types"
 )
@@ -21,9 +19,61 @@ type Keeper struct {
 	// Typically, this should be the x/gov module account.
 	authority []byte
 
+	authKeeper types.AuthKeeper
+	bankKeeper types.BankKeeper
+	// hooks is shared by the copies of the keeper, as it is set once every
+	// module, including those depending on this keeper, is provided.
+	hooks *types.IdentityHooks
+
 	Schema      collections.Schema
 	Params      collections.Item[types.Params]
 	UserProfile collections.Map[string, types.UserProfile]
+	// Issuer is keyed by issuer address.
+	Issuer collections.Map[string, types.Issuer]
+	// Attestation is keyed by a sequential id.
+	Attestation    collections.Map[uint64, types.Attestation]
+	AttestationSeq collections.Sequence
+	// AttestationBySubject indexes attestations by (subject, id).
+	AttestationBySubject collections.KeySet[collections.Pair[string, uint64]]
+	// Handle is keyed by handle skeleton.
+	Handle collections.Map[string, types.Handle]
+	// HandleByOwner maps owner addresses to the skeleton of their handle.
+	HandleByOwner collections.Map[string, string]
+	// Guardians is keyed by identity address.
+	Guardians collections.Map[string, types.Guardians]
+	// GuardianWard indexes Guardians by (guardian, identity address).
+	GuardianWard collections.KeySet[collections.Pair[string, string]]
+	// Recovery is keyed by the address of the identity being recovered.
+	Recovery collections.Map[string, types.Recovery]
+	// PersonaKey is keyed by a sequential id.
+	PersonaKey    collections.Map[uint64, types.PersonaKey]
+	PersonaKeySeq collections.Sequence
+	// PersonaRequest is keyed by a sequential id.
+	PersonaRequest    collections.Map[uint64, types.PersonaCredentialRequest]
+	PersonaRequestSeq collections.Sequence
+	// PersonaRequestByRequester indexes persona credential requests by
+	// (requester, id).
+	PersonaRequestByRequester collections.KeySet[collections.Pair[string, uint64]]
+	// Persona is keyed by persona address.
+	Persona collections.Map[string, types.Persona]
+	// Follow is keyed by (follower, followee).
+	Follow collections.KeySet[collections.Pair[string, string]]
+	// Follower indexes follows by (followee, follower).
+	Follower collections.KeySet[collections.Pair[string, string]]
+	// FollowerCount and FollowingCount count the follows of each address.
+	FollowerCount  collections.Map[string, uint64]
+	FollowingCount collections.Map[string, uint64]
+	// Block is keyed by (blocker, blocked).
+	Block collections.KeySet[collections.Pair[string, string]]
+	// BlockedBy indexes blocks by (blocked, blocker).
+	BlockedBy collections.KeySet[collections.Pair[string, string]]
+	// Challenge is keyed by the address to authenticate.
+	Challenge    collections.Map[string, types.Challenge]
+	ChallengeSeq collections.Sequence
+	// ChallengeByExpiry indexes challenges by (expires_at, address).
+	ChallengeByExpiry collections.KeySet[collections.Pair[int64, string]]
+	// ChallengeByCreator indexes challenges by (creator, address).
+	ChallengeByCreator collections.KeySet[collections.Pair[string, string]]
 }
 
 func NewKeeper(
@@ -32,6 +82,8 @@ func NewKeeper(
 	addressCodec address.Codec,
 	authority []byte,
 
+	authKeeper types.AuthKeeper,
+	bankKeeper types.BankKeeper,
 ) Keeper {
 	if _, err := addressCodec.BytesToString(authority); err != nil {
 		panic(fmt.Sprintf("invalid authority address %s: %s", authority, err))
@@ -45,8 +97,44 @@ func NewKeeper(
 		addressCodec: addressCodec,
 		authority:    authority,
 
+		authKeeper: authKeeper,
+		bankKeeper: bankKeeper,
+		hooks:      new(types.IdentityHooks),
+
 		Params:      collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
-		UserProfile: collections.NewMap(sb, types.UserProfileKey, "userProfile", collections.StringKey, codec.CollValue[types.UserProfile](cdc))}
+		UserProfile: collections.NewMap(sb, types.UserProfileKey, "userProfile", collections.StringKey, codec.CollValue[types.UserProfile](cdc)),
+
+		Issuer:               collections.NewMap(sb, types.IssuerKey, "issuer", collections.StringKey, codec.CollValue[types.Issuer](cdc)),
+		Attestation:          collections.NewMap(sb, types.AttestationKey, "attestation", collections.Uint64Key, codec.CollValue[types.Attestation](cdc)),
+		AttestationSeq:       collections.NewSequence(sb, types.AttestationCountKey, "attestationSequence"),
+		AttestationBySubject: collections.NewKeySet(sb, types.AttestationBySubjectKey, "attestationBySubject", collections.PairKeyCodec(collections.StringKey, collections.Uint64Key)),
+
+		Handle:        collections.NewMap(sb, types.HandleKey, "handle", collections.StringKey, codec.CollValue[types.Handle](cdc)),
+		HandleByOwner: collections.NewMap(sb, types.HandleByOwnerKey, "handleByOwner", collections.StringKey, collections.StringValue),
+
+		Guardians:    collections.NewMap(sb, types.GuardiansKey, "guardians", collections.StringKey, codec.CollValue[types.Guardians](cdc)),
+		GuardianWard: collections.NewKeySet(sb, types.GuardianWardKey, "guardianWard", collections.PairKeyCodec(collections.StringKey, collections.StringKey)),
+		Recovery:     collections.NewMap(sb, types.RecoveryKey, "recovery", collections.StringKey, codec.CollValue[types.Recovery](cdc)),
+
+		PersonaKey:                collections.NewMap(sb, types.PersonaKeyKey, "personaKey", collections.Uint64Key, codec.CollValue[types.PersonaKey](cdc)),
+		PersonaKeySeq:             collections.NewSequence(sb, types.PersonaKeyCountKey, "personaKeySequence"),
+		PersonaRequest:            collections.NewMap(sb, types.PersonaRequestKey, "personaRequest", collections.Uint64Key, codec.CollValue[types.PersonaCredentialRequest](cdc)),
+		PersonaRequestSeq:         collections.NewSequence(sb, types.PersonaRequestCountKey, "personaRequestSequence"),
+		PersonaRequestByRequester: collections.NewKeySet(sb, types.PersonaRequestByRequesterKey, "personaRequestByRequester", collections.PairKeyCodec(collections.StringKey, collections.Uint64Key)),
+		Persona:                   collections.NewMap(sb, types.PersonaValueKey, "persona", collections.StringKey, codec.CollValue[types.Persona](cdc)),
+
+		Follow:         collections.NewKeySet(sb, types.FollowKey, "follow", collections.PairKeyCodec(collections.StringKey, collections.StringKey)),
+		Follower:       collections.NewKeySet(sb, types.FollowerKey, "follower", collections.PairKeyCodec(collections.StringKey, collections.StringKey)),
+		FollowerCount:  collections.NewMap(sb, types.FollowerCountKey, "followerCount", collections.StringKey, collections.Uint64Value),
+		FollowingCount: collections.NewMap(sb, types.FollowingCountKey, "followingCount", collections.StringKey, collections.Uint64Value),
+		Block:          collections.NewKeySet(sb, types.BlockKey, "block", collections.PairKeyCodec(collections.StringKey, collections.StringKey)),
+		BlockedBy:      collections.NewKeySet(sb, types.BlockedByKey, "blockedBy", collections.PairKeyCodec(collections.StringKey, collections.StringKey)),
+
+		Challenge:          collections.NewMap(sb, types.ChallengeKey, "challenge", collections.StringKey, codec.CollValue[types.Challenge](cdc)),
+		ChallengeSeq:       collections.NewSequence(sb, types.ChallengeCountKey, "challengeSequence"),
+		ChallengeByExpiry:  collections.NewKeySet(sb, types.ChallengeByExpiryKey, "challengeByExpiry", collections.PairKeyCodec(collections.Int64Key, collections.StringKey)),
+		ChallengeByCreator: collections.NewKeySet(sb, types.ChallengeByCreatorKey, "challengeByCreator", collections.PairKeyCodec(collections.StringKey, collections.StringKey)),
+	}
 
 	schema, err := sb.Build()
 	if err != nil {
@@ -62,21 +150,10 @@ func (k Keeper) GetAuthority() []byte {
 	return k.authority
 }
 
-// GetChallenge returns the challenge for a given address.
-func (k Keeper) GetChallenge(ctx sdk.Context, address string) (string, error) {
-	store := k.storeService.OpenKVStore(ctx)
-	challengeKey := fmt.Sprintf("challenge:%s", address)
-	challengeData, err := store.Get([]byte(challengeKey))
-	if err != nil {
-		return "", err
-	}
-	if challengeData == nil {
-		return "", nil
-	}
-
-	parts := strings.Split(string(challengeData), ":")
-	if len(parts) != 2 {
-		return "", fmt.Errorf("invalid challenge data")
+// SetHooks sets the hooks notified of identity migrations.
+func (k Keeper) SetHooks(hooks types.IdentityHooks) {
+	if *k.hooks != nil {
+		panic("cannot set identity hooks twice")
 	}
-	return parts[0], nil
+	*k.hooks = hooks
 }
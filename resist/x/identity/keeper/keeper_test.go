@@ -5,11 +5,13 @@ import (
 	"testing"
 
 	"cosmossdk.io/core/address"
+	errorsmod "cosmossdk.io/errors"
 	storetypes "cosmossdk.io/store/types"
 	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
 	"github.com/cosmos/cosmos-sdk/runtime"
 	"github.com/cosmos/cosmos-sdk/testutil"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
@@ -20,8 +22,55 @@ import (
 
 type fixture struct {
 	ctx          context.Context
+	storeKey     *storetypes.KVStoreKey
 	keeper       keeper.Keeper
 	addressCodec address.Codec
+	authKeeper   *mockAuthKeeper
+	bankKeeper   *mockBankKeeper
+}
+
+// mockAuthKeeper is an in-memory account registry.
+type mockAuthKeeper struct {
+	addressCodec address.Codec
+	accounts     map[string]sdk.AccountI
+}
+
+func (m *mockAuthKeeper) AddressCodec() address.Codec {
+	return m.addressCodec
+}
+
+func (m *mockAuthKeeper) GetAccount(_ context.Context, addr sdk.AccAddress) sdk.AccountI {
+	return m.accounts[string(addr)]
+}
+
+// mockBankKeeper is an in-memory ledger of account and module balances.
+type mockBankKeeper struct {
+	balances map[string]sdk.Coins
+	burned   sdk.Coins
+}
+
+func (m *mockBankKeeper) SpendableCoins(_ context.Context, addr sdk.AccAddress) sdk.Coins {
+	return m.balances[string(addr)]
+}
+
+func (m *mockBankKeeper) SendCoinsFromAccountToModule(_ context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error {
+	balance, hasNeg := m.balances[string(senderAddr)].SafeSub(amt...)
+	if hasNeg {
+		return errorsmod.Wrapf(sdkerrors.ErrInsufficientFunds, "%s is smaller than %s", m.balances[string(senderAddr)], amt)
+	}
+	m.balances[string(senderAddr)] = balance
+	m.balances[recipientModule] = m.balances[recipientModule].Add(amt...)
+	return nil
+}
+
+func (m *mockBankKeeper) BurnCoins(_ context.Context, moduleName string, amt sdk.Coins) error {
+	balance, hasNeg := m.balances[moduleName].SafeSub(amt...)
+	if hasNeg {
+		return errorsmod.Wrapf(sdkerrors.ErrInsufficientFunds, "%s is smaller than %s", m.balances[moduleName], amt)
+	}
+	m.balances[moduleName] = balance
+	m.burned = m.burned.Add(amt...)
+	return nil
 }
 
 func initFixture(t *testing.T) *fixture {
@@ -35,12 +84,16 @@ func initFixture(t *testing.T) *fixture {
 	ctx := testutil.DefaultContextWithDB(t, storeKey, storetypes.NewTransientStoreKey("transient_test")).Ctx
 
 	authority := authtypes.NewModuleAddress(types.GovModuleName)
+	authKeeper := &mockAuthKeeper{addressCodec: addressCodec, accounts: make(map[string]sdk.AccountI)}
+	bankKeeper := &mockBankKeeper{balances: make(map[string]sdk.Coins)}
 
 	k := keeper.NewKeeper(
 		storeService,
 		encCfg.Codec,
 		addressCodec,
 		authority,
+		authKeeper,
+		bankKeeper,
 	)
 
 	// Initialize params
@@ -50,7 +103,10 @@ func initFixture(t *testing.T) *fixture {
 
 	return &fixture{
 		ctx:          ctx,
+		storeKey:     storeKey,
 		keeper:       k,
 		addressCodec: addressCodec,
+		authKeeper:   authKeeper,
+		bankKeeper:   bankKeeper,
 	}
 }
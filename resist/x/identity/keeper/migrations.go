@@ -0,0 +1,56 @@
+package keeper
+
+import (
+	"cosmossdk.io/collections"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"resist/x/identity/types"
+)
+
+// legacyChallengePrefix is the prefix of the raw challenge:<address> keys
+// stored before challenges moved to the Challenge map.
+var legacyChallengePrefix = []byte("challenge:")
+
+// Migrator handles the in-place store migrations of the module.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates the store from version 1, which had no params and
+// stored the challenges under raw challenge:<address> keys. The legacy
+// challenges, which expired within minutes and can be requested again, are
+// deleted, and the guardians are indexed by guardian.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	store := runtime.KVStoreAdapter(m.keeper.storeService.OpenKVStore(ctx))
+	iterator := storetypes.KVStorePrefixIterator(store, legacyChallengePrefix)
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	if err := iterator.Close(); err != nil {
+		return err
+	}
+	for _, key := range keys {
+		store.Delete(key)
+	}
+
+	if err := m.keeper.Params.Set(ctx, types.DefaultParams()); err != nil {
+		return err
+	}
+
+	return m.keeper.Guardians.Walk(ctx, nil, func(address string, guardians types.Guardians) (bool, error) {
+		for _, guardian := range guardians.Guardians {
+			if err := m.keeper.GuardianWard.Set(ctx, collections.Join(guardian, address)); err != nil {
+				return true, err
+			}
+		}
+		return false, nil
+	})
+}
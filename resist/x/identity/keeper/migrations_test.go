@@ -0,0 +1,37 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"resist/x/identity/keeper"
+	"resist/x/identity/types"
+)
+
+func TestMigrate1to2(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx)
+
+	store := ctx.KVStore(f.storeKey)
+	store.Set([]byte("challenge:address"), []byte("00:300"))
+	// The params had no fields at version 1
+	require.NoError(t, f.keeper.Params.Set(ctx, types.Params{}))
+	require.NoError(t, f.keeper.Guardians.Set(ctx, "alice", types.Guardians{Address: "alice", Guardians: []string{"bob", "carol"}, Threshold: 1}))
+
+	require.NoError(t, keeper.NewMigrator(f.keeper).Migrate1to2(ctx))
+
+	require.False(t, store.Has([]byte("challenge:address")))
+	params, err := f.keeper.Params.Get(ctx)
+	require.NoError(t, err)
+	require.NoError(t, params.Validate())
+	require.Equal(t, types.DefaultParams(), params)
+	require.True(t, params.IsReservedHandle("admin"))
+	for _, guardian := range []string{"bob", "carol"} {
+		has, err := f.keeper.GuardianWard.Has(ctx, collections.Join(guardian, "alice"))
+		require.NoError(t, err)
+		require.True(t, has)
+	}
+}
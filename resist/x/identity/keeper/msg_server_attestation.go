@@ -0,0 +1,200 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"resist/x/identity/types"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func (k msgServer) RegisterIssuer(ctx context.Context, msg *types.MsgRegisterIssuer) (*types.MsgRegisterIssuerResponse, error) {
+	if err := k.checkAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+	if _, err := k.addressCodec.StringToBytes(msg.Issuer); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid issuer address: %s", err))
+	}
+	if len(msg.ClaimTypes) == 0 {
+		return nil, errorsmod.Wrap(types.ErrInvalidClaimType, "claim types cannot be empty")
+	}
+	for _, claimType := range msg.ClaimTypes {
+		if !types.IsValidClaimType(claimType) {
+			return nil, errorsmod.Wrapf(types.ErrInvalidClaimType, "unknown claim type %q", claimType)
+		}
+	}
+
+	claimTypes := slices.Clone(msg.ClaimTypes)
+	slices.Sort(claimTypes)
+	issuer := types.Issuer{
+		Address:      msg.Issuer,
+		Name:         msg.Name,
+		ClaimTypes:   slices.Compact(claimTypes),
+		RegisteredAt: sdk.UnwrapSDKContext(ctx).BlockTime().Unix(),
+	}
+	if err := k.Issuer.Set(ctx, issuer.Address, issuer); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"issuer_registered",
+			sdk.NewAttribute("issuer", issuer.Address),
+			sdk.NewAttribute("name", issuer.Name),
+		),
+	)
+
+	return &types.MsgRegisterIssuerResponse{}, nil
+}
+
+func (k msgServer) RemoveIssuer(ctx context.Context, msg *types.MsgRemoveIssuer) (*types.MsgRemoveIssuerResponse, error) {
+	if err := k.checkAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+	if has, err := k.Issuer.Has(ctx, msg.Issuer); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	} else if !has {
+		return nil, errorsmod.Wrapf(types.ErrIssuerNotFound, "issuer %s", msg.Issuer)
+	}
+
+	// The attestations of the issuer are kept but are no longer valid
+	if err := k.Issuer.Remove(ctx, msg.Issuer); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"issuer_removed",
+			sdk.NewAttribute("issuer", msg.Issuer),
+		),
+	)
+
+	return &types.MsgRemoveIssuerResponse{}, nil
+}
+
+func (k msgServer) Attest(ctx context.Context, msg *types.MsgAttest) (*types.MsgAttestResponse, error) {
+	if _, err := k.addressCodec.StringToBytes(msg.Issuer); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid issuer address: %s", err))
+	}
+	if _, err := k.addressCodec.StringToBytes(msg.Subject); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid subject address: %s", err))
+	}
+	if msg.Subject == msg.Issuer {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "issuers cannot attest claims about themselves")
+	}
+
+	issuer, err := k.Issuer.Get(ctx, msg.Issuer)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, errorsmod.Wrapf(types.ErrIssuerNotFound, "issuer %s", msg.Issuer)
+		}
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+	if !slices.Contains(issuer.ClaimTypes, msg.ClaimType) {
+		return nil, errorsmod.Wrapf(types.ErrInvalidClaimType, "issuer %s cannot attest %q", msg.Issuer, msg.ClaimType)
+	}
+	if msg.ClaimType == types.ClaimTypeDomain {
+		if domain, err := types.NormalizeDomain(msg.Evidence); err != nil {
+			return nil, errorsmod.Wrap(types.ErrInvalidDomain, err.Error())
+		} else if domain != msg.Evidence {
+			return nil, errorsmod.Wrapf(types.ErrInvalidDomain, "domain %q is not normalized", msg.Evidence)
+		}
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	blockTime := sdkCtx.BlockTime().Unix()
+	if msg.ExpiresAt <= blockTime {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "attestation must expire in the future")
+	}
+
+	id, err := k.AttestationSeq.Next(ctx)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+	attestation := types.Attestation{
+		Id:        id,
+		Issuer:    msg.Issuer,
+		Subject:   msg.Subject,
+		ClaimType: msg.ClaimType,
+		Evidence:  msg.Evidence,
+		IssuedAt:  blockTime,
+		ExpiresAt: msg.ExpiresAt,
+	}
+	if err := k.SetAttestation(ctx, attestation); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"attestation_issued",
+			sdk.NewAttribute("id", fmt.Sprintf("%d", id)),
+			sdk.NewAttribute("issuer", msg.Issuer),
+			sdk.NewAttribute("subject", msg.Subject),
+			sdk.NewAttribute("claim_type", msg.ClaimType),
+			sdk.NewAttribute("expires_at", fmt.Sprintf("%d", msg.ExpiresAt)),
+		),
+	)
+
+	return &types.MsgAttestResponse{Id: id}, nil
+}
+
+func (k msgServer) RevokeAttestation(ctx context.Context, msg *types.MsgRevokeAttestation) (*types.MsgRevokeAttestationResponse, error) {
+	if _, err := k.addressCodec.StringToBytes(msg.Issuer); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid issuer address: %s", err))
+	}
+
+	attestation, err := k.Attestation.Get(ctx, msg.Id)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, errorsmod.Wrapf(types.ErrAttestationNotFound, "attestation %d", msg.Id)
+		}
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+	if msg.Issuer != attestation.Issuer {
+		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "incorrect issuer")
+	}
+	if attestation.Revoked {
+		return nil, errorsmod.Wrapf(types.ErrAttestationRevoked, "attestation %d", msg.Id)
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	attestation.Revoked = true
+	attestation.RevokedAt = sdkCtx.BlockTime().Unix()
+	attestation.RevocationReason = msg.Reason
+	if err := k.SetAttestation(ctx, attestation); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"attestation_revoked",
+			sdk.NewAttribute("id", fmt.Sprintf("%d", msg.Id)),
+			sdk.NewAttribute("issuer", msg.Issuer),
+			sdk.NewAttribute("subject", attestation.Subject),
+		),
+	)
+
+	return &types.MsgRevokeAttestationResponse{}, nil
+}
+
+// checkAuthority checks that signer is the module authority.
+func (k msgServer) checkAuthority(signer string) error {
+	authority, err := k.addressCodec.StringToBytes(signer)
+	if err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+	if !bytes.Equal(k.GetAuthority(), authority) {
+		expectedAuthorityStr, _ := k.addressCodec.BytesToString(k.GetAuthority())
+		return errorsmod.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", expectedAuthorityStr, signer)
+	}
+	return nil
+}
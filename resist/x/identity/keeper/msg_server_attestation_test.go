@@ -0,0 +1,188 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"resist/x/identity/keeper"
+	"resist/x/identity/types"
+)
+
+func TestAttestationMsgServer(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(time.Unix(1000, 0))
+
+	authority, err := f.addressCodec.BytesToString(f.keeper.GetAuthority())
+	require.NoError(t, err)
+	issuer, err := f.addressCodec.BytesToString([]byte("issuer______________________"))
+	require.NoError(t, err)
+	subject, err := f.addressCodec.BytesToString([]byte("subject_____________________"))
+	require.NoError(t, err)
+
+	_, err = srv.CreateUserProfile(ctx, &types.MsgCreateUserProfile{Creator: subject, DisplayName: "reporter"})
+	require.NoError(t, err)
+
+	// Issuers are registered by governance only
+	_, err = srv.RegisterIssuer(ctx, &types.MsgRegisterIssuer{Authority: issuer, Issuer: issuer, ClaimTypes: []string{types.ClaimTypeJournalist}})
+	require.ErrorIs(t, err, types.ErrInvalidSigner)
+	_, err = srv.RegisterIssuer(ctx, &types.MsgRegisterIssuer{Authority: authority, Issuer: issuer, ClaimTypes: []string{"celebrity"}})
+	require.ErrorIs(t, err, types.ErrInvalidClaimType)
+
+	_, err = srv.Attest(ctx, &types.MsgAttest{Issuer: issuer, Subject: subject, ClaimType: types.ClaimTypeJournalist, ExpiresAt: 2000})
+	require.ErrorIs(t, err, types.ErrIssuerNotFound)
+
+	_, err = srv.RegisterIssuer(ctx, &types.MsgRegisterIssuer{Authority: authority, Issuer: issuer, Name: "Press Union", ClaimTypes: []string{types.ClaimTypeJournalist, types.ClaimTypeHuman}})
+	require.NoError(t, err)
+
+	_, err = srv.Attest(ctx, &types.MsgAttest{Issuer: issuer, Subject: subject, ClaimType: types.ClaimTypeOrganization, ExpiresAt: 2000})
+	require.ErrorIs(t, err, types.ErrInvalidClaimType)
+	_, err = srv.Attest(ctx, &types.MsgAttest{Issuer: issuer, Subject: subject, ClaimType: types.ClaimTypeJournalist, ExpiresAt: 1000})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	profile, err := qs.GetUserProfile(ctx, &types.QueryGetUserProfileRequest{Index: subject})
+	require.NoError(t, err)
+	require.False(t, profile.UserProfile.Verified)
+
+	journalist, err := srv.Attest(ctx, &types.MsgAttest{Issuer: issuer, Subject: subject, ClaimType: types.ClaimTypeJournalist, Evidence: "https://press.example/members/42", ExpiresAt: 2000})
+	require.NoError(t, err)
+	human, err := srv.Attest(ctx, &types.MsgAttest{Issuer: issuer, Subject: subject, ClaimType: types.ClaimTypeHuman, ExpiresAt: 3000})
+	require.NoError(t, err)
+
+	profile, err = qs.GetUserProfile(ctx, &types.QueryGetUserProfileRequest{Index: subject})
+	require.NoError(t, err)
+	require.True(t, profile.UserProfile.Verified)
+
+	// Owners cannot verify their own profile anymore
+	_, err = srv.UpdateUserProfile(ctx, &types.MsgUpdateUserProfile{Creator: subject, Index: subject, DisplayName: "reporter"})
+	require.NoError(t, err)
+	stored, err := f.keeper.UserProfile.Get(ctx, subject)
+	require.NoError(t, err)
+	require.False(t, stored.Verified)
+
+	_, err = srv.RevokeAttestation(ctx, &types.MsgRevokeAttestation{Issuer: subject, Id: human.Id})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	_, err = srv.RevokeAttestation(ctx, &types.MsgRevokeAttestation{Issuer: issuer, Id: human.Id, Reason: "duplicate account"})
+	require.NoError(t, err)
+	_, err = srv.RevokeAttestation(ctx, &types.MsgRevokeAttestation{Issuer: issuer, Id: human.Id})
+	require.ErrorIs(t, err, types.ErrAttestationRevoked)
+
+	attestation, err := qs.GetAttestation(ctx, &types.QueryGetAttestationRequest{Id: human.Id})
+	require.NoError(t, err)
+	require.False(t, attestation.Valid)
+	require.Equal(t, "duplicate account", attestation.Attestation.RevocationReason)
+
+	all, err := qs.ListAttestation(ctx, &types.QueryAllAttestationRequest{Subject: subject})
+	require.NoError(t, err)
+	require.Len(t, all.Attestation, 2)
+	valid, err := qs.ListAttestation(ctx, &types.QueryAllAttestationRequest{Subject: subject, ValidOnly: true})
+	require.NoError(t, err)
+	require.Len(t, valid.Attestation, 1)
+	require.Equal(t, journalist.Id, valid.Attestation[0].Id)
+
+	// Attestations expire
+	verified, err := f.keeper.IsVerified(ctx.WithBlockTime(time.Unix(2000, 0)), subject)
+	require.NoError(t, err)
+	require.False(t, verified)
+
+	// Removing an issuer invalidates its attestations
+	_, err = srv.RemoveIssuer(ctx, &types.MsgRemoveIssuer{Authority: authority, Issuer: issuer})
+	require.NoError(t, err)
+	profile, err = qs.GetUserProfile(ctx, &types.QueryGetUserProfileRequest{Index: subject})
+	require.NoError(t, err)
+	require.False(t, profile.UserProfile.Verified)
+}
+
+func TestDomainAttestation(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(time.Unix(1000, 0))
+
+	authority, err := f.addressCodec.BytesToString(f.keeper.GetAuthority())
+	require.NoError(t, err)
+	verifier, err := f.addressCodec.BytesToString([]byte("verifier____________________"))
+	require.NoError(t, err)
+	subject, err := f.addressCodec.BytesToString([]byte("subject_____________________"))
+	require.NoError(t, err)
+
+	_, err = srv.CreateUserProfile(ctx, &types.MsgCreateUserProfile{
+		Creator:     subject,
+		DisplayName: "Example News",
+		Metadata:    []types.ProfileMetadata{{Key: types.MetadataKeyWebsite, Value: "https://example.org"}},
+	})
+	require.NoError(t, err)
+	_, err = srv.RegisterIssuer(ctx, &types.MsgRegisterIssuer{Authority: authority, Issuer: verifier, ClaimTypes: []string{types.ClaimTypeDomain}})
+	require.NoError(t, err)
+
+	// The evidence of domain attestations is the normalized domain
+	_, err = srv.Attest(ctx, &types.MsgAttest{Issuer: verifier, Subject: subject, ClaimType: types.ClaimTypeDomain, Evidence: "https://example.org", ExpiresAt: 2000})
+	require.ErrorIs(t, err, types.ErrInvalidDomain)
+	_, err = srv.Attest(ctx, &types.MsgAttest{Issuer: verifier, Subject: subject, ClaimType: types.ClaimTypeDomain, Evidence: "Example.org", ExpiresAt: 2000})
+	require.ErrorIs(t, err, types.ErrInvalidDomain)
+	_, err = srv.Attest(ctx, &types.MsgAttest{Issuer: verifier, Subject: subject, ClaimType: types.ClaimTypeDomain, Evidence: "example.org", ExpiresAt: 2000})
+	require.NoError(t, err)
+
+	profile, err := qs.GetUserProfile(ctx, &types.QueryGetUserProfileRequest{Index: subject})
+	require.NoError(t, err)
+	require.Equal(t, []string{"example.org"}, profile.VerifiedDomains)
+	require.Equal(t, []types.ProfileMetadata{{Key: types.MetadataKeyWebsite, Value: "https://example.org"}}, profile.UserProfile.Metadata)
+
+	// The domain must be verified again once the attestation expires
+	profile, err = qs.GetUserProfile(ctx.WithBlockTime(time.Unix(2000, 0)), &types.QueryGetUserProfileRequest{Index: subject})
+	require.NoError(t, err)
+	require.Empty(t, profile.VerifiedDomains)
+}
+
+func TestVerificationRules(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(time.Unix(1000, 0))
+
+	authority, err := f.addressCodec.BytesToString(f.keeper.GetAuthority())
+	require.NoError(t, err)
+	issuers := make([]string, 2)
+	for i := range issuers {
+		issuers[i], err = f.addressCodec.BytesToString([]byte{'i', 's', 's', 'u', 'e', 'r', byte('0' + i), 27: '_'})
+		require.NoError(t, err)
+		_, err = srv.RegisterIssuer(ctx, &types.MsgRegisterIssuer{Authority: authority, Issuer: issuers[i], ClaimTypes: []string{types.ClaimTypeJournalist, types.ClaimTypeDomain}})
+		require.NoError(t, err)
+	}
+	subject, err := f.addressCodec.BytesToString([]byte("subject_____________________"))
+	require.NoError(t, err)
+
+	params, err := f.keeper.Params.Get(ctx)
+	require.NoError(t, err)
+	params.VerificationRules = types.VerificationRules{ClaimTypes: []string{types.ClaimTypeJournalist}, MinIssuers: 2}
+	_, err = srv.UpdateParams(ctx, &types.MsgUpdateParams{Authority: authority, Params: params})
+	require.NoError(t, err)
+
+	isVerified := func() bool {
+		verified, err := f.keeper.IsVerified(ctx, subject)
+		require.NoError(t, err)
+		return verified
+	}
+
+	// Attestations of other claim types do not count
+	_, err = srv.Attest(ctx, &types.MsgAttest{Issuer: issuers[0], Subject: subject, ClaimType: types.ClaimTypeDomain, Evidence: "example.org", ExpiresAt: 2000})
+	require.NoError(t, err)
+	_, err = srv.Attest(ctx, &types.MsgAttest{Issuer: issuers[1], Subject: subject, ClaimType: types.ClaimTypeDomain, Evidence: "example.org", ExpiresAt: 2000})
+	require.NoError(t, err)
+	require.False(t, isVerified())
+
+	// Nor do several attestations of the same issuer
+	_, err = srv.Attest(ctx, &types.MsgAttest{Issuer: issuers[0], Subject: subject, ClaimType: types.ClaimTypeJournalist, ExpiresAt: 2000})
+	require.NoError(t, err)
+	_, err = srv.Attest(ctx, &types.MsgAttest{Issuer: issuers[0], Subject: subject, ClaimType: types.ClaimTypeJournalist, ExpiresAt: 2000})
+	require.NoError(t, err)
+	require.False(t, isVerified())
+
+	_, err = srv.Attest(ctx, &types.MsgAttest{Issuer: issuers[1], Subject: subject, ClaimType: types.ClaimTypeJournalist, ExpiresAt: 2000})
+	require.NoError(t, err)
+	require.True(t, isVerified())
+}
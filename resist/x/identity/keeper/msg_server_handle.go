@@ -0,0 +1,161 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"resist/x/identity/types"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func (k msgServer) ClaimHandle(ctx context.Context, msg *types.MsgClaimHandle) (*types.MsgClaimHandleResponse, error) {
+	creator, err := k.addressCodec.StringToBytes(msg.Creator)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid address: %s", err))
+	}
+	name, err := types.NormalizeHandle(msg.Handle)
+	if err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidHandle, err.Error())
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+	if params.IsReservedHandle(name) {
+		return nil, errorsmod.Wrapf(types.ErrHandleReserved, "@%s", name)
+	}
+	if err := k.checkHandleAvailable(ctx, name); err != nil {
+		return nil, err
+	}
+	if held, err := k.GetOwnerHandle(ctx, msg.Creator); err == nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "account already holds @%s", held.Name)
+	} else if !errors.Is(err, types.ErrHandleNotFound) {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
+	// The registration fee is burned rather than collected, so that squatting
+	// handles has a cost without funding anyone.
+	if !params.HandleFee.IsZero() {
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, creator, types.ModuleName, params.HandleFee); err != nil {
+			return nil, errorsmod.Wrap(err, "failed to pay handle fee")
+		}
+		if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, params.HandleFee); err != nil {
+			return nil, errorsmod.Wrap(err, "failed to burn handle fee")
+		}
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	handle := types.Handle{
+		Name:      name,
+		Owner:     msg.Creator,
+		ClaimedAt: sdkCtx.BlockTime().Unix(),
+	}
+	if err := k.SetHandle(ctx, handle); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"handle_claimed",
+			sdk.NewAttribute("handle", handle.Name),
+			sdk.NewAttribute("owner", handle.Owner),
+			sdk.NewAttribute("fee", params.HandleFee.String()),
+		),
+	)
+
+	return &types.MsgClaimHandleResponse{}, nil
+}
+
+func (k msgServer) ReleaseHandle(ctx context.Context, msg *types.MsgReleaseHandle) (*types.MsgReleaseHandleResponse, error) {
+	if _, err := k.addressCodec.StringToBytes(msg.Creator); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid address: %s", err))
+	}
+	handle, err := k.getOwnedHandle(ctx, msg.Creator, msg.Handle)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.RemoveHandle(ctx, handle); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"handle_released",
+			sdk.NewAttribute("handle", handle.Name),
+			sdk.NewAttribute("owner", handle.Owner),
+		),
+	)
+
+	return &types.MsgReleaseHandleResponse{}, nil
+}
+
+func (k msgServer) TransferHandle(ctx context.Context, msg *types.MsgTransferHandle) (*types.MsgTransferHandleResponse, error) {
+	if _, err := k.addressCodec.StringToBytes(msg.Creator); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid address: %s", err))
+	}
+	if _, err := k.addressCodec.StringToBytes(msg.Recipient); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid recipient address: %s", err))
+	}
+	handle, err := k.getOwnedHandle(ctx, msg.Creator, msg.Handle)
+	if err != nil {
+		return nil, err
+	}
+	if held, err := k.GetOwnerHandle(ctx, msg.Recipient); err == nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "recipient already holds @%s", held.Name)
+	} else if !errors.Is(err, types.ErrHandleNotFound) {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
+	if err := k.RemoveHandle(ctx, handle); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+	handle.Owner = msg.Recipient
+	if err := k.SetHandle(ctx, handle); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"handle_transferred",
+			sdk.NewAttribute("handle", handle.Name),
+			sdk.NewAttribute("from", msg.Creator),
+			sdk.NewAttribute("to", msg.Recipient),
+		),
+	)
+
+	return &types.MsgTransferHandleResponse{}, nil
+}
+
+// checkHandleAvailable returns an error if a handle confusable with name is
+// already claimed.
+func (k msgServer) checkHandleAvailable(ctx context.Context, name string) error {
+	taken, err := k.Handle.Get(ctx, types.HandleSkeleton(name))
+	if err == nil {
+		return errorsmod.Wrapf(types.ErrHandleTaken, "@%s is confusable with @%s", name, taken.Name)
+	}
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil
+	}
+	return errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+}
+
+// getOwnedHandle resolves handle and checks that it is held by owner.
+func (k msgServer) getOwnedHandle(ctx context.Context, owner, handle string) (types.Handle, error) {
+	val, err := k.ResolveHandle(ctx, handle)
+	if err != nil {
+		return types.Handle{}, err
+	}
+	if val.Owner != owner {
+		return types.Handle{}, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "incorrect handle owner")
+	}
+	return val, nil
+}
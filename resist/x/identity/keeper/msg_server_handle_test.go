@@ -0,0 +1,96 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"resist/x/identity/keeper"
+	"resist/x/identity/types"
+)
+
+func TestHandleMsgServer(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(time.Unix(1000, 0))
+
+	alice, err := f.addressCodec.BytesToString([]byte("alice_______________________"))
+	require.NoError(t, err)
+	bob, err := f.addressCodec.BytesToString([]byte("bob_________________________"))
+	require.NoError(t, err)
+
+	fee := sdk.NewCoins(sdk.NewCoin("stake", sdkmath.NewInt(10)))
+	params := types.DefaultParams()
+	params.HandleFee = fee
+	require.NoError(t, f.keeper.Params.Set(ctx, params))
+
+	_, err = srv.ClaimHandle(ctx, &types.MsgClaimHandle{Creator: alice, Handle: "1alice"})
+	require.ErrorIs(t, err, types.ErrInvalidHandle)
+	_, err = srv.ClaimHandle(ctx, &types.MsgClaimHandle{Creator: alice, Handle: "al"})
+	require.ErrorIs(t, err, types.ErrInvalidHandle)
+	_, err = srv.ClaimHandle(ctx, &types.MsgClaimHandle{Creator: alice, Handle: "Re_sist"})
+	require.ErrorIs(t, err, types.ErrHandleReserved)
+
+	// The fee is required and burned
+	_, err = srv.ClaimHandle(ctx, &types.MsgClaimHandle{Creator: alice, Handle: "@Alice_01"})
+	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFunds)
+	f.bankKeeper.balances[string(sdk.MustAccAddressFromBech32(alice))] = fee
+	_, err = srv.ClaimHandle(ctx, &types.MsgClaimHandle{Creator: alice, Handle: "@Alice_01"})
+	require.NoError(t, err)
+	require.Equal(t, fee, f.bankKeeper.burned)
+	require.True(t, f.bankKeeper.balances[types.ModuleName].IsZero())
+
+	resolved, err := qs.ResolveHandle(ctx, &types.QueryResolveHandleRequest{Handle: "ALICE_01"})
+	require.NoError(t, err)
+	require.Equal(t, types.Handle{Name: "alice_01", Owner: alice, ClaimedAt: 1000}, resolved.Handle)
+	reverse, err := qs.ReverseResolve(ctx, &types.QueryReverseResolveRequest{Address: alice})
+	require.NoError(t, err)
+	require.Equal(t, resolved.Handle, reverse.Handle)
+
+	// Handles confusable with a claimed handle resolve to it and cannot be claimed
+	confusable, err := qs.ResolveHandle(ctx, &types.QueryResolveHandleRequest{Handle: "aiiceol"})
+	require.NoError(t, err)
+	require.Equal(t, alice, confusable.Handle.Owner)
+	f.bankKeeper.balances[string(sdk.MustAccAddressFromBech32(bob))] = fee.Add(fee...)
+	_, err = srv.ClaimHandle(ctx, &types.MsgClaimHandle{Creator: bob, Handle: "aIice0l"})
+	require.ErrorIs(t, err, types.ErrHandleTaken)
+
+	// Accounts hold one handle
+	_, err = srv.ClaimHandle(ctx, &types.MsgClaimHandle{Creator: alice, Handle: "alice_news"})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	_, err = srv.ClaimHandle(ctx, &types.MsgClaimHandle{Creator: bob, Handle: "bob"})
+	require.NoError(t, err)
+
+	_, err = srv.TransferHandle(ctx, &types.MsgTransferHandle{Creator: bob, Handle: "alice_01", Recipient: bob})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	_, err = srv.TransferHandle(ctx, &types.MsgTransferHandle{Creator: alice, Handle: "alice_01", Recipient: bob})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	_, err = srv.ReleaseHandle(ctx, &types.MsgReleaseHandle{Creator: alice, Handle: "bob"})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	_, err = srv.ReleaseHandle(ctx, &types.MsgReleaseHandle{Creator: bob, Handle: "bob"})
+	require.NoError(t, err)
+	_, err = qs.ResolveHandle(ctx, &types.QueryResolveHandleRequest{Handle: "bob"})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = srv.TransferHandle(ctx, &types.MsgTransferHandle{Creator: alice, Handle: "alice_01", Recipient: bob})
+	require.NoError(t, err)
+	resolved, err = qs.ResolveHandle(ctx, &types.QueryResolveHandleRequest{Handle: "alice_01"})
+	require.NoError(t, err)
+	require.Equal(t, bob, resolved.Handle.Owner)
+	_, err = qs.ReverseResolve(ctx, &types.QueryReverseResolveRequest{Address: alice})
+	require.Equal(t, codes.NotFound, status.Code(err))
+	reverse, err = qs.ReverseResolve(ctx, &types.QueryReverseResolveRequest{Address: bob})
+	require.NoError(t, err)
+	require.Equal(t, "alice_01", reverse.Handle.Name)
+
+	_, err = qs.ResolveHandle(ctx, &types.QueryResolveHandleRequest{Handle: "!"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
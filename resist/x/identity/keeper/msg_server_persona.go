@@ -0,0 +1,271 @@
+package keeper
+
+import (
+	"context"
+	"crypto/rsa"
+	"errors"
+	"fmt"
+	"slices"
+
+	"resist/x/identity/blindsig"
+	"resist/x/identity/types"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func (k msgServer) RegisterPersonaKey(ctx context.Context, msg *types.MsgRegisterPersonaKey) (*types.MsgRegisterPersonaKeyResponse, error) {
+	if _, err := k.addressCodec.StringToBytes(msg.Issuer); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid issuer address: %s", err))
+	}
+	issuer, err := k.Issuer.Get(ctx, msg.Issuer)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, errorsmod.Wrapf(types.ErrIssuerNotFound, "issuer %s", msg.Issuer)
+		}
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+	if !slices.Contains(issuer.ClaimTypes, types.ClaimTypeHuman) {
+		return nil, errorsmod.Wrapf(types.ErrInvalidClaimType, "issuer %s cannot attest %q", msg.Issuer, types.ClaimTypeHuman)
+	}
+	if _, err := blindsig.ParsePublicKey(msg.PublicKey); err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidPersonaKey, err.Error())
+	}
+
+	id, err := k.PersonaKeySeq.Next(ctx)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	key := types.PersonaKey{
+		Id:           id,
+		Issuer:       msg.Issuer,
+		PublicKey:    msg.PublicKey,
+		RegisteredAt: sdkCtx.BlockTime().Unix(),
+	}
+	if err := k.PersonaKey.Set(ctx, id, key); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"persona_key_registered",
+			sdk.NewAttribute("id", fmt.Sprintf("%d", id)),
+			sdk.NewAttribute("issuer", msg.Issuer),
+		),
+	)
+
+	return &types.MsgRegisterPersonaKeyResponse{Id: id}, nil
+}
+
+func (k msgServer) RevokePersonaKey(ctx context.Context, msg *types.MsgRevokePersonaKey) (*types.MsgRevokePersonaKeyResponse, error) {
+	if _, err := k.addressCodec.StringToBytes(msg.Issuer); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid issuer address: %s", err))
+	}
+	key, err := k.getPersonaKey(ctx, msg.KeyId)
+	if err != nil {
+		return nil, err
+	}
+	if msg.Issuer != key.Issuer {
+		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "incorrect issuer")
+	}
+	if key.Revoked {
+		return nil, errorsmod.Wrapf(types.ErrInvalidPersonaKey, "persona key %d is already revoked", msg.KeyId)
+	}
+
+	// The personas holding credentials of the key are no longer verified
+	key.Revoked = true
+	if err := k.PersonaKey.Set(ctx, key.Id, key); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"persona_key_revoked",
+			sdk.NewAttribute("id", fmt.Sprintf("%d", key.Id)),
+			sdk.NewAttribute("issuer", msg.Issuer),
+		),
+	)
+
+	return &types.MsgRevokePersonaKeyResponse{}, nil
+}
+
+func (k msgServer) RequestPersonaCredential(ctx context.Context, msg *types.MsgRequestPersonaCredential) (*types.MsgRequestPersonaCredentialResponse, error) {
+	if _, err := k.addressCodec.StringToBytes(msg.Creator); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid address: %s", err))
+	}
+	key, pub, err := k.getValidPersonaKey(ctx, msg.KeyId)
+	if err != nil {
+		return nil, err
+	}
+	if len(msg.BlindedMessage) != pub.Size() {
+		return nil, errorsmod.Wrapf(types.ErrInvalidPersonaCredential, "blinded message must be %d bytes", pub.Size())
+	}
+
+	human, err := k.IsHuman(ctx, msg.Creator)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+	if !human {
+		return nil, errorsmod.Wrapf(types.ErrNotHuman, "%s", msg.Creator)
+	}
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+	count, err := k.countPersonaRequests(ctx, msg.Creator)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+	if count >= params.MaxPersonas {
+		return nil, errorsmod.Wrapf(types.ErrPersonaLimitReached, "%d persona credentials requested", count)
+	}
+
+	id, err := k.PersonaRequestSeq.Next(ctx)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	request := types.PersonaCredentialRequest{
+		Id:             id,
+		Requester:      msg.Creator,
+		KeyId:          key.Id,
+		BlindedMessage: msg.BlindedMessage,
+		RequestedAt:    sdkCtx.BlockTime().Unix(),
+	}
+	if err := k.SetPersonaRequest(ctx, request); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"persona_credential_requested",
+			sdk.NewAttribute("id", fmt.Sprintf("%d", id)),
+			sdk.NewAttribute("key_id", fmt.Sprintf("%d", key.Id)),
+			sdk.NewAttribute("issuer", key.Issuer),
+		),
+	)
+
+	return &types.MsgRequestPersonaCredentialResponse{Id: id}, nil
+}
+
+func (k msgServer) IssuePersonaCredential(ctx context.Context, msg *types.MsgIssuePersonaCredential) (*types.MsgIssuePersonaCredentialResponse, error) {
+	if _, err := k.addressCodec.StringToBytes(msg.Issuer); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid issuer address: %s", err))
+	}
+	request, err := k.PersonaRequest.Get(ctx, msg.RequestId)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, errorsmod.Wrapf(types.ErrPersonaRequestNotFound, "request %d", msg.RequestId)
+		}
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+	key, pub, err := k.getValidPersonaKey(ctx, request.KeyId)
+	if err != nil {
+		return nil, err
+	}
+	if msg.Issuer != key.Issuer {
+		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "incorrect issuer")
+	}
+	if len(request.BlindSignature) > 0 {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "request %d is already issued", request.Id)
+	}
+	if err := blindsig.VerifyBlind(pub, request.BlindedMessage, msg.BlindSignature); err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidPersonaCredential, err.Error())
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	request.BlindSignature = msg.BlindSignature
+	request.IssuedAt = sdkCtx.BlockTime().Unix()
+	if err := k.SetPersonaRequest(ctx, request); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"persona_credential_issued",
+			sdk.NewAttribute("id", fmt.Sprintf("%d", request.Id)),
+			sdk.NewAttribute("key_id", fmt.Sprintf("%d", key.Id)),
+			sdk.NewAttribute("issuer", key.Issuer),
+		),
+	)
+
+	return &types.MsgIssuePersonaCredentialResponse{}, nil
+}
+
+func (k msgServer) RegisterPersona(ctx context.Context, msg *types.MsgRegisterPersona) (*types.MsgRegisterPersonaResponse, error) {
+	if _, err := k.addressCodec.StringToBytes(msg.Creator); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid address: %s", err))
+	}
+	if exists, err := k.Persona.Has(ctx, msg.Creator); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	} else if exists {
+		return nil, errorsmod.Wrapf(types.ErrPersonaAlreadyRegistered, "%s", msg.Creator)
+	}
+	key, pub, err := k.getValidPersonaKey(ctx, msg.KeyId)
+	if err != nil {
+		return nil, err
+	}
+
+	// The credential signs the persona address, so that it cannot be
+	// presented by another account nor on another chain.
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if err := blindsig.Verify(pub, types.PersonaMessage(sdkCtx.ChainID(), msg.Creator), msg.Signature); err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidPersonaCredential, err.Error())
+	}
+
+	persona := types.Persona{
+		Address:      msg.Creator,
+		KeyId:        key.Id,
+		RegisteredAt: sdkCtx.BlockTime().Unix(),
+	}
+	if err := k.Persona.Set(ctx, persona.Address, persona); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"persona_registered",
+			sdk.NewAttribute("address", persona.Address),
+			sdk.NewAttribute("key_id", fmt.Sprintf("%d", key.Id)),
+		),
+	)
+
+	return &types.MsgRegisterPersonaResponse{}, nil
+}
+
+// getPersonaKey returns the persona key of id.
+func (k msgServer) getPersonaKey(ctx context.Context, id uint64) (types.PersonaKey, error) {
+	key, err := k.PersonaKey.Get(ctx, id)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return types.PersonaKey{}, errorsmod.Wrapf(types.ErrPersonaKeyNotFound, "persona key %d", id)
+		}
+		return types.PersonaKey{}, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+	return key, nil
+}
+
+// getValidPersonaKey returns the persona key of id and its RSA public key,
+// checking that the key is valid.
+func (k msgServer) getValidPersonaKey(ctx context.Context, id uint64) (types.PersonaKey, *rsa.PublicKey, error) {
+	key, err := k.getPersonaKey(ctx, id)
+	if err != nil {
+		return types.PersonaKey{}, nil, err
+	}
+	valid, err := k.IsPersonaKeyValid(ctx, key)
+	if err != nil {
+		return types.PersonaKey{}, nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+	if !valid {
+		return types.PersonaKey{}, nil, errorsmod.Wrapf(types.ErrInvalidPersonaKey, "persona key %d is revoked", id)
+	}
+	pub, err := blindsig.ParsePublicKey(key.PublicKey)
+	if err != nil {
+		return types.PersonaKey{}, nil, errorsmod.Wrap(types.ErrInvalidPersonaKey, err.Error())
+	}
+	return key, pub, nil
+}
@@ -0,0 +1,128 @@
+package keeper_test
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+
+	"resist/x/identity/blindsig"
+	"resist/x/identity/keeper"
+	"resist/x/identity/types"
+)
+
+func TestPersonaMsgServer(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(time.Unix(1000, 0)).WithChainID("resist-1")
+
+	authority, err := f.addressCodec.BytesToString(authtypes.NewModuleAddress(types.GovModuleName))
+	require.NoError(t, err)
+	issuer, err := f.addressCodec.BytesToString([]byte("issuer______________________"))
+	require.NoError(t, err)
+	alice, err := f.addressCodec.BytesToString([]byte("alice_______________________"))
+	require.NoError(t, err)
+	persona, err := f.addressCodec.BytesToString([]byte("persona_____________________"))
+	require.NoError(t, err)
+
+	priv, err := rsa.GenerateKey(rand.Reader, blindsig.MinKeyBits)
+	require.NoError(t, err)
+	der, err := x509.MarshalPKIXPublicKey(&priv.PublicKey)
+	require.NoError(t, err)
+
+	// Persona keys are registered by issuers of human attestations
+	_, err = srv.RegisterPersonaKey(ctx, &types.MsgRegisterPersonaKey{Issuer: issuer, PublicKey: der})
+	require.ErrorIs(t, err, types.ErrIssuerNotFound)
+	_, err = srv.RegisterIssuer(ctx, &types.MsgRegisterIssuer{Authority: authority, Issuer: issuer, ClaimTypes: []string{types.ClaimTypeJournalist}})
+	require.NoError(t, err)
+	_, err = srv.RegisterPersonaKey(ctx, &types.MsgRegisterPersonaKey{Issuer: issuer, PublicKey: der})
+	require.ErrorIs(t, err, types.ErrInvalidClaimType)
+	_, err = srv.RegisterIssuer(ctx, &types.MsgRegisterIssuer{Authority: authority, Issuer: issuer, ClaimTypes: []string{types.ClaimTypeHuman}})
+	require.NoError(t, err)
+	_, err = srv.RegisterPersonaKey(ctx, &types.MsgRegisterPersonaKey{Issuer: issuer, PublicKey: []byte("invalid")})
+	require.ErrorIs(t, err, types.ErrInvalidPersonaKey)
+	registered, err := srv.RegisterPersonaKey(ctx, &types.MsgRegisterPersonaKey{Issuer: issuer, PublicKey: der})
+	require.NoError(t, err)
+	keyID := registered.Id
+
+	// Only verified humans request credentials
+	blinded, unblinder, err := blindsig.Blind(rand.Reader, &priv.PublicKey, types.PersonaMessage("resist-1", persona))
+	require.NoError(t, err)
+	_, err = srv.RequestPersonaCredential(ctx, &types.MsgRequestPersonaCredential{Creator: alice, KeyId: keyID, BlindedMessage: blinded})
+	require.ErrorIs(t, err, types.ErrNotHuman)
+	_, err = srv.Attest(ctx, &types.MsgAttest{Issuer: issuer, Subject: alice, ClaimType: types.ClaimTypeHuman, ExpiresAt: 2000})
+	require.NoError(t, err)
+	_, err = srv.RequestPersonaCredential(ctx, &types.MsgRequestPersonaCredential{Creator: alice, KeyId: keyID, BlindedMessage: blinded[1:]})
+	require.ErrorIs(t, err, types.ErrInvalidPersonaCredential)
+	requested, err := srv.RequestPersonaCredential(ctx, &types.MsgRequestPersonaCredential{Creator: alice, KeyId: keyID, BlindedMessage: blinded})
+	require.NoError(t, err)
+
+	pending, err := qs.ListPersonaCredentialRequest(ctx, &types.QueryAllPersonaCredentialRequestRequest{KeyId: keyID, PendingOnly: true})
+	require.NoError(t, err)
+	require.Len(t, pending.PersonaRequest, 1)
+	require.Equal(t, blinded, pending.PersonaRequest[0].BlindedMessage)
+
+	// The issuer signs without seeing the persona address
+	blindSig, err := blindsig.BlindSign(priv, blinded)
+	require.NoError(t, err)
+	_, err = srv.IssuePersonaCredential(ctx, &types.MsgIssuePersonaCredential{Issuer: alice, RequestId: requested.Id, BlindSignature: blindSig})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	_, err = srv.IssuePersonaCredential(ctx, &types.MsgIssuePersonaCredential{Issuer: issuer, RequestId: requested.Id, BlindSignature: unblinder})
+	require.ErrorIs(t, err, types.ErrInvalidPersonaCredential)
+	_, err = srv.IssuePersonaCredential(ctx, &types.MsgIssuePersonaCredential{Issuer: issuer, RequestId: requested.Id, BlindSignature: blindSig})
+	require.NoError(t, err)
+	_, err = srv.IssuePersonaCredential(ctx, &types.MsgIssuePersonaCredential{Issuer: issuer, RequestId: requested.Id, BlindSignature: blindSig})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	request, err := qs.GetPersonaCredentialRequest(ctx, &types.QueryGetPersonaCredentialRequestRequest{Id: requested.Id})
+	require.NoError(t, err)
+	signature, err := blindsig.Unblind(&priv.PublicKey, request.PersonaRequest.BlindSignature, unblinder)
+	require.NoError(t, err)
+
+	// The credential is bound to the persona address and the chain
+	_, err = srv.RegisterPersona(ctx, &types.MsgRegisterPersona{Creator: alice, KeyId: keyID, Signature: signature})
+	require.ErrorIs(t, err, types.ErrInvalidPersonaCredential)
+	_, err = srv.RegisterPersona(ctx.WithChainID("other-1"), &types.MsgRegisterPersona{Creator: persona, KeyId: keyID, Signature: signature})
+	require.ErrorIs(t, err, types.ErrInvalidPersonaCredential)
+	_, err = srv.RegisterPersona(ctx, &types.MsgRegisterPersona{Creator: persona, KeyId: keyID, Signature: signature})
+	require.NoError(t, err)
+	_, err = srv.RegisterPersona(ctx, &types.MsgRegisterPersona{Creator: persona, KeyId: keyID, Signature: signature})
+	require.ErrorIs(t, err, types.ErrPersonaAlreadyRegistered)
+
+	// The persona is verified without any attestation linking it to alice
+	verified, err := f.keeper.IsVerified(ctx, persona)
+	require.NoError(t, err)
+	require.True(t, verified)
+	attestations, err := f.keeper.GetValidAttestations(ctx, persona)
+	require.NoError(t, err)
+	require.Empty(t, attestations)
+	got, err := qs.GetPersona(ctx, &types.QueryGetPersonaRequest{Address: persona})
+	require.NoError(t, err)
+	require.Equal(t, types.Persona{Address: persona, KeyId: keyID, RegisteredAt: 1000}, got.Persona)
+	require.True(t, got.Valid)
+
+	// Identities request a limited number of credentials
+	params := types.DefaultParams()
+	params.MaxPersonas = 1
+	require.NoError(t, f.keeper.Params.Set(ctx, params))
+	_, err = srv.RequestPersonaCredential(ctx, &types.MsgRequestPersonaCredential{Creator: alice, KeyId: keyID, BlindedMessage: blinded})
+	require.ErrorIs(t, err, types.ErrPersonaLimitReached)
+
+	// Revoking the key revokes its personas
+	_, err = srv.RevokePersonaKey(ctx, &types.MsgRevokePersonaKey{Issuer: alice, KeyId: keyID})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	_, err = srv.RevokePersonaKey(ctx, &types.MsgRevokePersonaKey{Issuer: issuer, KeyId: keyID})
+	require.NoError(t, err)
+	verified, err = f.keeper.IsVerified(ctx, persona)
+	require.NoError(t, err)
+	require.False(t, verified)
+	_, err = srv.RegisterPersona(ctx, &types.MsgRegisterPersona{Creator: alice, KeyId: keyID, Signature: signature})
+	require.ErrorIs(t, err, types.ErrInvalidPersonaKey)
+}
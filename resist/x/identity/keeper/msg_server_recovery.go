@@ -0,0 +1,294 @@
+package keeper
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+
+	"resist/x/identity/types"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func (k msgServer) RotateIdentityKey(ctx context.Context, msg *types.MsgRotateIdentityKey) (*types.MsgRotateIdentityKeyResponse, error) {
+	if _, err := k.addressCodec.StringToBytes(msg.Creator); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid address: %s", err))
+	}
+	if _, err := k.addressCodec.StringToBytes(msg.OldAddress); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid old address: %s", err))
+	}
+	if msg.OldAddress == msg.Creator {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "identity is already at this address")
+	}
+
+	// The old key proves the rotation by signing the new address, which signs
+	// the message, so that neither key can move the identity alone.
+	if msg.PubKey == nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidPubKey, "public key of the old address is required")
+	}
+	pubKey, ok := msg.PubKey.GetCachedValue().(cryptotypes.PubKey)
+	if !ok {
+		return nil, errorsmod.Wrapf(types.ErrInvalidPubKey, "cannot unpack %s", msg.PubKey.TypeUrl)
+	}
+	sigBytes, err := hex.DecodeString(msg.Signature)
+	if err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidSignature, "signature is not hex encoded")
+	}
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	signBytes := types.RotationSignBytes(sdkCtx.ChainID(), msg.OldAddress, msg.Creator)
+	if err := k.verifyAddressSignature(ctx, msg.OldAddress, pubKey, sigBytes, signBytes); err != nil {
+		return nil, err
+	}
+
+	if err := k.MigrateIdentity(ctx, msg.OldAddress, msg.Creator); err != nil {
+		return nil, err
+	}
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"identity_migrated",
+			sdk.NewAttribute("old_address", msg.OldAddress),
+			sdk.NewAttribute("new_address", msg.Creator),
+			sdk.NewAttribute("method", "rotation"),
+		),
+	)
+
+	return &types.MsgRotateIdentityKeyResponse{}, nil
+}
+
+func (k msgServer) SetGuardians(ctx context.Context, msg *types.MsgSetGuardians) (*types.MsgSetGuardiansResponse, error) {
+	if _, err := k.addressCodec.StringToBytes(msg.Creator); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid address: %s", err))
+	}
+	if found, err := k.UserProfile.Has(ctx, msg.Creator); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	} else if !found {
+		return nil, errorsmod.Wrap(sdkerrors.ErrKeyNotFound, "profile not found")
+	}
+
+	// Changing the guardians voids the approvals of a pending recovery.
+	if err := k.Recovery.Remove(ctx, msg.Creator); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
+	// An empty list removes the guardians.
+	if len(msg.Guardians) == 0 {
+		if err := k.removeGuardians(ctx, msg.Creator); err != nil {
+			return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+		}
+	} else {
+		for _, guardian := range msg.Guardians {
+			if _, err := k.addressCodec.StringToBytes(guardian); err != nil {
+				return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid guardian address: %s", err))
+			}
+		}
+		guardians := types.Guardians{
+			Address:   msg.Creator,
+			Guardians: msg.Guardians,
+			Threshold: msg.Threshold,
+		}
+		if err := guardians.Validate(); err != nil {
+			return nil, errorsmod.Wrap(types.ErrInvalidGuardians, err.Error())
+		}
+		if err := k.setGuardians(ctx, guardians); err != nil {
+			return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+		}
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"guardians_set",
+			sdk.NewAttribute("address", msg.Creator),
+			sdk.NewAttribute("guardians", strconv.Itoa(len(msg.Guardians))),
+			sdk.NewAttribute("threshold", strconv.FormatUint(uint64(msg.Threshold), 10)),
+		),
+	)
+
+	return &types.MsgSetGuardiansResponse{}, nil
+}
+
+func (k msgServer) InitiateRecovery(ctx context.Context, msg *types.MsgInitiateRecovery) (*types.MsgInitiateRecoveryResponse, error) {
+	guardians, err := k.getGuardians(ctx, msg.Guardian, msg.Address)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := k.addressCodec.StringToBytes(msg.NewAddress); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid new address: %s", err))
+	}
+	if msg.NewAddress == msg.Address {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "identity is already at this address")
+	}
+	if pending, err := k.Recovery.Get(ctx, msg.Address); err == nil {
+		return nil, errorsmod.Wrapf(types.ErrRecoveryConflict, "recovery to %s is pending", pending.NewAddress)
+	} else if !errors.Is(err, collections.ErrNotFound) {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	recovery := types.Recovery{
+		Address:     msg.Address,
+		NewAddress:  msg.NewAddress,
+		InitiatedAt: sdkCtx.BlockTime().Unix(),
+	}
+	if err := k.approveRecovery(ctx, guardians, &recovery, msg.Guardian); err != nil {
+		return nil, err
+	}
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"recovery_initiated",
+			sdk.NewAttribute("address", msg.Address),
+			sdk.NewAttribute("new_address", msg.NewAddress),
+			sdk.NewAttribute("guardian", msg.Guardian),
+		),
+	)
+
+	return &types.MsgInitiateRecoveryResponse{}, nil
+}
+
+func (k msgServer) ApproveRecovery(ctx context.Context, msg *types.MsgApproveRecovery) (*types.MsgApproveRecoveryResponse, error) {
+	guardians, err := k.getGuardians(ctx, msg.Guardian, msg.Address)
+	if err != nil {
+		return nil, err
+	}
+	recovery, err := k.getRecovery(ctx, msg.Address)
+	if err != nil {
+		return nil, err
+	}
+	// Approvals name the new address, so that guardians cannot be made to
+	// approve a recovery to another address than the one they checked.
+	if recovery.NewAddress != msg.NewAddress {
+		return nil, errorsmod.Wrapf(types.ErrRecoveryConflict, "pending recovery is to %s", recovery.NewAddress)
+	}
+	if slices.Contains(recovery.Approvals, msg.Guardian) {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "recovery already approved")
+	}
+	if err := k.approveRecovery(ctx, guardians, &recovery, msg.Guardian); err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"recovery_approved",
+			sdk.NewAttribute("address", msg.Address),
+			sdk.NewAttribute("new_address", msg.NewAddress),
+			sdk.NewAttribute("guardian", msg.Guardian),
+			sdk.NewAttribute("approvals", strconv.Itoa(len(recovery.Approvals))),
+		),
+	)
+
+	return &types.MsgApproveRecoveryResponse{}, nil
+}
+
+func (k msgServer) CancelRecovery(ctx context.Context, msg *types.MsgCancelRecovery) (*types.MsgCancelRecoveryResponse, error) {
+	if _, err := k.addressCodec.StringToBytes(msg.Creator); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid address: %s", err))
+	}
+	recovery, err := k.getRecovery(ctx, msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.Recovery.Remove(ctx, msg.Creator); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"recovery_cancelled",
+			sdk.NewAttribute("address", recovery.Address),
+			sdk.NewAttribute("new_address", recovery.NewAddress),
+		),
+	)
+
+	return &types.MsgCancelRecoveryResponse{}, nil
+}
+
+func (k msgServer) ExecuteRecovery(ctx context.Context, msg *types.MsgExecuteRecovery) (*types.MsgExecuteRecoveryResponse, error) {
+	if _, err := k.addressCodec.StringToBytes(msg.Creator); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid address: %s", err))
+	}
+	recovery, err := k.getRecovery(ctx, msg.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if recovery.ExecutableAt == 0 {
+		return nil, errorsmod.Wrap(types.ErrRecoveryNotReady, "recovery is not approved by enough guardians")
+	}
+	if sdkCtx.BlockTime().Unix() < recovery.ExecutableAt {
+		return nil, errorsmod.Wrapf(types.ErrRecoveryNotReady, "recovery is executable at %d", recovery.ExecutableAt)
+	}
+
+	if err := k.MigrateIdentity(ctx, recovery.Address, recovery.NewAddress); err != nil {
+		return nil, err
+	}
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"identity_migrated",
+			sdk.NewAttribute("old_address", recovery.Address),
+			sdk.NewAttribute("new_address", recovery.NewAddress),
+			sdk.NewAttribute("method", "recovery"),
+		),
+	)
+
+	return &types.MsgExecuteRecoveryResponse{}, nil
+}
+
+// getGuardians returns the guardians of address and checks that guardian is
+// one of them.
+func (k msgServer) getGuardians(ctx context.Context, guardian, address string) (types.Guardians, error) {
+	if _, err := k.addressCodec.StringToBytes(guardian); err != nil {
+		return types.Guardians{}, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid guardian address: %s", err))
+	}
+	guardians, err := k.Guardians.Get(ctx, address)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.Guardians{}, errorsmod.Wrapf(sdkerrors.ErrKeyNotFound, "%s has no guardians", address)
+	} else if err != nil {
+		return types.Guardians{}, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+	if !slices.Contains(guardians.Guardians, guardian) {
+		return types.Guardians{}, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not a guardian of %s", guardian, address)
+	}
+	return guardians, nil
+}
+
+// getRecovery returns the pending recovery of address.
+func (k msgServer) getRecovery(ctx context.Context, address string) (types.Recovery, error) {
+	recovery, err := k.Recovery.Get(ctx, address)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.Recovery{}, errorsmod.Wrapf(types.ErrRecoveryNotFound, "%s", address)
+	} else if err != nil {
+		return types.Recovery{}, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+	return recovery, nil
+}
+
+// approveRecovery adds the approval of guardian to recovery and stores it.
+// Reaching the threshold starts the time lock, during which the owner can
+// still cancel the recovery.
+func (k msgServer) approveRecovery(ctx context.Context, guardians types.Guardians, recovery *types.Recovery, guardian string) error {
+	recovery.Approvals = append(recovery.Approvals, guardian)
+	if recovery.ExecutableAt == 0 && len(recovery.Approvals) >= int(guardians.Threshold) {
+		params, err := k.Params.Get(ctx)
+		if err != nil {
+			return errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+		}
+		recovery.ExecutableAt = sdk.UnwrapSDKContext(ctx).BlockTime().Unix() + params.RecoveryDelay
+	}
+	if err := k.Recovery.Set(ctx, recovery.Address, *recovery); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+	return nil
+}
@@ -0,0 +1,209 @@
+package keeper_test
+
+import (
+	"context"
+	"encoding/hex"
+	"testing"
+	"time"
+
+	"cosmossdk.io/collections"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"resist/x/identity/keeper"
+	"resist/x/identity/types"
+)
+
+// migrations records the identity migrations notified to the hooks.
+type migrations [][2]string
+
+func (m *migrations) AfterIdentityMigrated(_ context.Context, oldAddress, newAddress string) error {
+	*m = append(*m, [2]string{oldAddress, newAddress})
+	return nil
+}
+
+func TestRotateIdentityKey(t *testing.T) {
+	f := initFixture(t)
+	var migrated migrations
+	f.keeper.SetHooks(&migrated)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(time.Unix(1000, 0)).WithChainID("resist-1")
+
+	oldKey := secp256k1.GenPrivKey()
+	oldAddress, err := f.addressCodec.BytesToString(oldKey.PubKey().Address())
+	require.NoError(t, err)
+	newAddress, err := f.addressCodec.BytesToString([]byte("new_________________________"))
+	require.NoError(t, err)
+	pubKey, err := codectypes.NewAnyWithValue(oldKey.PubKey())
+	require.NoError(t, err)
+	sign := func(chainID string) string {
+		signature, err := oldKey.Sign(types.RotationSignBytes(chainID, oldAddress, newAddress))
+		require.NoError(t, err)
+		return hex.EncodeToString(signature)
+	}
+
+	require.NoError(t, f.keeper.UserProfile.Set(ctx, oldAddress, types.UserProfile{Index: oldAddress, Creator: oldAddress, DisplayName: "Alice"}))
+	require.NoError(t, f.keeper.SetHandle(ctx, types.Handle{Name: "alice", Owner: oldAddress}))
+	require.NoError(t, f.keeper.SetAttestation(ctx, types.Attestation{Id: 0, Subject: oldAddress, ClaimType: types.ClaimTypeKeyControl}))
+	require.NoError(t, f.keeper.SetAttestation(ctx, types.Attestation{Id: 1, Subject: oldAddress, ClaimType: types.ClaimTypeJournalist}))
+
+	// The rotation is bound to the chain
+	_, err = srv.RotateIdentityKey(ctx, &types.MsgRotateIdentityKey{Creator: newAddress, OldAddress: oldAddress, PubKey: pubKey, Signature: sign("other-1")})
+	require.ErrorIs(t, err, types.ErrInvalidSignature)
+	_, err = srv.RotateIdentityKey(ctx, &types.MsgRotateIdentityKey{Creator: newAddress, OldAddress: oldAddress, Signature: sign("resist-1")})
+	require.ErrorIs(t, err, types.ErrInvalidPubKey)
+
+	_, err = srv.RotateIdentityKey(ctx, &types.MsgRotateIdentityKey{Creator: newAddress, OldAddress: oldAddress, PubKey: pubKey, Signature: sign("resist-1")})
+	require.NoError(t, err)
+	require.Equal(t, migrations{{oldAddress, newAddress}}, migrated)
+
+	found, err := f.keeper.UserProfile.Has(ctx, oldAddress)
+	require.NoError(t, err)
+	require.False(t, found)
+	profile, err := f.keeper.UserProfile.Get(ctx, newAddress)
+	require.NoError(t, err)
+	require.Equal(t, types.UserProfile{Index: newAddress, Creator: newAddress, DisplayName: "Alice"}, profile)
+	handle, err := f.keeper.GetOwnerHandle(ctx, newAddress)
+	require.NoError(t, err)
+	require.Equal(t, "alice", handle.Name)
+	_, err = f.keeper.GetOwnerHandle(ctx, oldAddress)
+	require.ErrorIs(t, err, types.ErrHandleNotFound)
+
+	// Key control attestations stay with the old key
+	keyControl, err := f.keeper.Attestation.Get(ctx, 0)
+	require.NoError(t, err)
+	require.Equal(t, oldAddress, keyControl.Subject)
+	journalist, err := f.keeper.Attestation.Get(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, newAddress, journalist.Subject)
+
+	// The identity already moved
+	_, err = srv.RotateIdentityKey(ctx, &types.MsgRotateIdentityKey{Creator: newAddress, OldAddress: oldAddress, PubKey: pubKey, Signature: sign("resist-1")})
+	require.ErrorIs(t, err, sdkerrors.ErrKeyNotFound)
+}
+
+func TestRecoveryMsgServer(t *testing.T) {
+	f := initFixture(t)
+	var migrated migrations
+	f.keeper.SetHooks(&migrated)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(time.Unix(1000, 0))
+
+	address := func(name string) string {
+		addr, err := f.addressCodec.BytesToString([]byte(name + "____________________________")[:28])
+		require.NoError(t, err)
+		return addr
+	}
+	alice, recovered, bob, carol, dave, eve := address("alice"), address("recovered"), address("bob"), address("carol"), address("dave"), address("eve")
+	require.NoError(t, f.keeper.UserProfile.Set(ctx, alice, types.UserProfile{Index: alice, Creator: alice}))
+
+	_, err := srv.SetGuardians(ctx, &types.MsgSetGuardians{Creator: alice, Guardians: []string{bob, carol}, Threshold: 3})
+	require.ErrorIs(t, err, types.ErrInvalidGuardians)
+	_, err = srv.SetGuardians(ctx, &types.MsgSetGuardians{Creator: alice, Guardians: []string{bob, alice}, Threshold: 1})
+	require.ErrorIs(t, err, types.ErrInvalidGuardians)
+	_, err = srv.SetGuardians(ctx, &types.MsgSetGuardians{Creator: bob, Guardians: []string{carol}, Threshold: 1})
+	require.ErrorIs(t, err, sdkerrors.ErrKeyNotFound)
+	_, err = srv.SetGuardians(ctx, &types.MsgSetGuardians{Creator: alice, Guardians: []string{bob, carol, dave}, Threshold: 2})
+	require.NoError(t, err)
+
+	_, err = srv.InitiateRecovery(ctx, &types.MsgInitiateRecovery{Guardian: eve, Address: alice, NewAddress: eve})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	_, err = srv.InitiateRecovery(ctx, &types.MsgInitiateRecovery{Guardian: bob, Address: alice, NewAddress: recovered})
+	require.NoError(t, err)
+	_, err = srv.InitiateRecovery(ctx, &types.MsgInitiateRecovery{Guardian: carol, Address: alice, NewAddress: carol})
+	require.ErrorIs(t, err, types.ErrRecoveryConflict)
+
+	// The threshold is not reached
+	_, err = srv.ExecuteRecovery(ctx, &types.MsgExecuteRecovery{Creator: eve, Address: alice})
+	require.ErrorIs(t, err, types.ErrRecoveryNotReady)
+
+	_, err = srv.ApproveRecovery(ctx, &types.MsgApproveRecovery{Guardian: bob, Address: alice, NewAddress: recovered})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	_, err = srv.ApproveRecovery(ctx, &types.MsgApproveRecovery{Guardian: carol, Address: alice, NewAddress: carol})
+	require.ErrorIs(t, err, types.ErrRecoveryConflict)
+	_, err = srv.ApproveRecovery(ctx, &types.MsgApproveRecovery{Guardian: carol, Address: alice, NewAddress: recovered})
+	require.NoError(t, err)
+	recovery, err := f.keeper.Recovery.Get(ctx, alice)
+	require.NoError(t, err)
+	require.Equal(t, types.Recovery{
+		Address:      alice,
+		NewAddress:   recovered,
+		Approvals:    []string{bob, carol},
+		InitiatedAt:  1000,
+		ExecutableAt: 1000 + types.DefaultRecoveryDelay,
+	}, recovery)
+
+	// The owner can cancel during the time lock
+	_, err = srv.ExecuteRecovery(ctx, &types.MsgExecuteRecovery{Creator: eve, Address: alice})
+	require.ErrorIs(t, err, types.ErrRecoveryNotReady)
+	_, err = srv.CancelRecovery(ctx, &types.MsgCancelRecovery{Creator: alice})
+	require.NoError(t, err)
+	_, err = srv.ApproveRecovery(ctx, &types.MsgApproveRecovery{Guardian: dave, Address: alice, NewAddress: recovered})
+	require.ErrorIs(t, err, types.ErrRecoveryNotFound)
+
+	_, err = srv.InitiateRecovery(ctx, &types.MsgInitiateRecovery{Guardian: dave, Address: alice, NewAddress: recovered})
+	require.NoError(t, err)
+	_, err = srv.ApproveRecovery(ctx, &types.MsgApproveRecovery{Guardian: bob, Address: alice, NewAddress: recovered})
+	require.NoError(t, err)
+
+	ctx = ctx.WithBlockTime(time.Unix(1000+types.DefaultRecoveryDelay, 0))
+	_, err = srv.ExecuteRecovery(ctx, &types.MsgExecuteRecovery{Creator: eve, Address: alice})
+	require.NoError(t, err)
+	require.Equal(t, migrations{{alice, recovered}}, migrated)
+
+	_, err = f.keeper.Recovery.Get(ctx, alice)
+	require.Error(t, err)
+	found, err := f.keeper.UserProfile.Has(ctx, recovered)
+	require.NoError(t, err)
+	require.True(t, found)
+	guardians, err := f.keeper.Guardians.Get(ctx, recovered)
+	require.NoError(t, err)
+	require.Equal(t, types.Guardians{Address: recovered, Guardians: []string{bob, carol, dave}, Threshold: 2}, guardians)
+}
+
+func TestMigrateIdentityWards(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(time.Unix(1000, 0))
+
+	address := func(name string) string {
+		addr, err := f.addressCodec.BytesToString([]byte(name + "____________________________")[:28])
+		require.NoError(t, err)
+		return addr
+	}
+	alice, recovered, bob, carol, dave := address("alice"), address("recovered"), address("bob"), address("carol"), address("dave")
+	for _, addr := range []string{alice, bob, carol} {
+		require.NoError(t, f.keeper.UserProfile.Set(ctx, addr, types.UserProfile{Index: addr, Creator: addr}))
+	}
+	_, err := srv.SetGuardians(ctx, &types.MsgSetGuardians{Creator: bob, Guardians: []string{alice, dave}, Threshold: 2})
+	require.NoError(t, err)
+	_, err = srv.SetGuardians(ctx, &types.MsgSetGuardians{Creator: carol, Guardians: []string{alice, dave}, Threshold: 2})
+	require.NoError(t, err)
+	_, err = srv.InitiateRecovery(ctx, &types.MsgInitiateRecovery{Guardian: alice, Address: bob, NewAddress: dave})
+	require.NoError(t, err)
+
+	require.NoError(t, f.keeper.MigrateIdentity(ctx, alice, recovered))
+
+	// The migrated guardian keeps its role and its approval
+	guardians, err := f.keeper.Guardians.Get(ctx, bob)
+	require.NoError(t, err)
+	require.Equal(t, []string{recovered, dave}, guardians.Guardians)
+	recovery, err := f.keeper.Recovery.Get(ctx, bob)
+	require.NoError(t, err)
+	require.Equal(t, []string{recovered}, recovery.Approvals)
+	_, err = srv.ApproveRecovery(ctx, &types.MsgApproveRecovery{Guardian: recovered, Address: bob, NewAddress: dave})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	_, err = srv.ApproveRecovery(ctx, &types.MsgApproveRecovery{Guardian: alice, Address: bob, NewAddress: dave})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	// The index follows the migration
+	has, err := f.keeper.GuardianWard.Has(ctx, collections.Join(alice, carol))
+	require.NoError(t, err)
+	require.False(t, has)
+	has, err = f.keeper.GuardianWard.Has(ctx, collections.Join(recovered, carol))
+	require.NoError(t, err)
+	require.True(t, has)
+}
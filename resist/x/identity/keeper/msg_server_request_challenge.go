@@ -2,13 +2,12 @@ package keeper
 
 import (
 	"context"
-	"crypto/rand"
-	"encoding/hex"
-	"fmt"
-	"time"
+	"errors"
+	"strconv"
 
 	"resist/x/identity/types"
 
+	"cosmossdk.io/collections"
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -23,21 +22,46 @@ func (k msgServer) RequestChallenge(ctx context.Context, msg *types.MsgRequestCh
 		return nil, errorsmod.Wrap(err, "invalid address to authenticate")
 	}
 
-	// Generate a random challenge
-	challengeBytes := make([]byte, 32)
-	if _, err := rand.Read(challengeBytes); err != nil {
-		return nil, errorsmod.Wrap(err, "failed to generate challenge")
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to get params")
 	}
-	challengeString := hex.EncodeToString(challengeBytes)
 
-	// Store challenge with expiration (5 minutes)
+	// Only the creator of a pending challenge replaces it before it expires,
+	// so that requesting challenges for an address cannot block its login.
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
-	store := k.storeService.OpenKVStore(ctx)
-	challengeKey := fmt.Sprintf("challenge:%s", msg.Address)
-	expiration := sdkCtx.BlockTime().Add(5 * time.Minute).Unix()
-	challengeData := fmt.Sprintf("%s:%d", challengeString, expiration)
+	existing, err := k.Challenge.Get(ctx, msg.Address)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return nil, errorsmod.Wrap(err, "failed to get challenge")
+	}
+	if err == nil && existing.Creator != msg.Creator && existing.ExpiresAt > sdkCtx.BlockTime().Unix() {
+		return nil, errorsmod.Wrapf(types.ErrChallengePending, "challenge of %s expires at %d", msg.Address, existing.ExpiresAt)
+	}
+
+	// Rate limit the creator. Replacing the pending challenge it requested
+	// for the same address does not count.
+	replaces := err == nil && existing.Creator == msg.Creator
+	pending, err := k.CountPendingChallenges(ctx, msg.Creator)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to count pending challenges")
+	}
+	if !replaces && pending >= params.MaxPendingChallenges {
+		return nil, errorsmod.Wrapf(types.ErrTooManyChallenges, "%s has %d pending challenges", msg.Creator, pending)
+	}
 
-	if err := store.Set([]byte(challengeKey), []byte(challengeData)); err != nil {
+	challengeString, err := k.newChallenge(ctx, msg.Address)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to generate challenge")
+	}
+
+	// Store challenge with expiration
+	challenge := types.Challenge{
+		Address:   msg.Address,
+		Challenge: challengeString,
+		Creator:   msg.Creator,
+		ExpiresAt: sdkCtx.BlockTime().Unix() + params.ChallengeTtl,
+	}
+	if err := k.SetChallenge(ctx, challenge); err != nil {
 		return nil, errorsmod.Wrap(err, "failed to store challenge")
 	}
 
@@ -47,8 +71,9 @@ func (k msgServer) RequestChallenge(ctx context.Context, msg *types.MsgRequestCh
 			"challenge_requested",
 			sdk.NewAttribute("address", msg.Address),
 			sdk.NewAttribute("challenge", challengeString),
+			sdk.NewAttribute("expires_at", strconv.FormatInt(challenge.ExpiresAt, 10)),
 		),
 	)
 
-	return &types.MsgRequestChallengeResponse{}, nil
+	return &types.MsgRequestChallengeResponse{Challenge: challengeString, ExpiresAt: challenge.ExpiresAt}, nil
 }
@@ -0,0 +1,93 @@
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"resist/x/identity/keeper"
+	"resist/x/identity/types"
+)
+
+func TestRequestChallengeMsgServer(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(time.Unix(1000, 0))
+	params, err := f.keeper.Params.Get(ctx)
+	require.NoError(t, err)
+
+	creator, err := f.addressCodec.BytesToString([]byte("creator_____________________"))
+	require.NoError(t, err)
+	other, err := f.addressCodec.BytesToString([]byte("other_______________________"))
+	require.NoError(t, err)
+	addresses := make([]string, params.MaxPendingChallenges+1)
+	for i := range addresses {
+		addresses[i], err = f.addressCodec.BytesToString([]byte(fmt.Sprintf("address_%-20d", i)))
+		require.NoError(t, err)
+	}
+
+	resp, err := srv.RequestChallenge(ctx, &types.MsgRequestChallenge{Creator: creator, Address: addresses[0]})
+	require.NoError(t, err)
+	require.Equal(t, int64(1000)+params.ChallengeTtl, resp.ExpiresAt)
+	challenge, err := f.keeper.GetChallenge(ctx, addresses[0])
+	require.NoError(t, err)
+	require.Equal(t, resp.Challenge, challenge)
+
+	// Requesting again replaces the challenge without counting twice.
+	again, err := srv.RequestChallenge(ctx, &types.MsgRequestChallenge{Creator: creator, Address: addresses[0]})
+	require.NoError(t, err)
+	require.NotEqual(t, resp.Challenge, again.Challenge)
+	pending, err := f.keeper.CountPendingChallenges(ctx, creator)
+	require.NoError(t, err)
+	require.Equal(t, uint32(1), pending)
+
+	for _, address := range addresses[1:params.MaxPendingChallenges] {
+		_, err := srv.RequestChallenge(ctx, &types.MsgRequestChallenge{Creator: creator, Address: address})
+		require.NoError(t, err)
+	}
+	_, err = srv.RequestChallenge(ctx, &types.MsgRequestChallenge{Creator: creator, Address: addresses[params.MaxPendingChallenges]})
+	require.ErrorIs(t, err, types.ErrTooManyChallenges)
+
+	// Other creators are not limited, but cannot replace a pending challenge
+	// until it expires.
+	_, err = srv.RequestChallenge(ctx, &types.MsgRequestChallenge{Creator: other, Address: addresses[0]})
+	require.ErrorIs(t, err, types.ErrChallengePending)
+	challenge, err = f.keeper.GetChallenge(ctx, addresses[0])
+	require.NoError(t, err)
+	require.Equal(t, again.Challenge, challenge)
+	_, err = srv.RequestChallenge(ctx, &types.MsgRequestChallenge{Creator: other, Address: addresses[params.MaxPendingChallenges]})
+	require.NoError(t, err)
+	pending, err = f.keeper.CountPendingChallenges(ctx, creator)
+	require.NoError(t, err)
+	require.Equal(t, params.MaxPendingChallenges, pending)
+	expired := ctx.WithBlockTime(time.Unix(1001+params.ChallengeTtl, 0))
+	_, err = srv.RequestChallenge(expired, &types.MsgRequestChallenge{Creator: other, Address: addresses[0]})
+	require.NoError(t, err)
+	pending, err = f.keeper.CountPendingChallenges(ctx, creator)
+	require.NoError(t, err)
+	require.Equal(t, params.MaxPendingChallenges-1, pending)
+	require.NoError(t, f.keeper.RemoveChallenge(ctx, addresses[0]))
+
+	// Expired challenges are pruned at the end of the block.
+	require.NoError(t, f.keeper.EndBlocker(ctx.WithBlockTime(time.Unix(1000+params.ChallengeTtl, 0))))
+	pending, err = f.keeper.CountPendingChallenges(ctx, creator)
+	require.NoError(t, err)
+	require.Equal(t, params.MaxPendingChallenges-1, pending)
+
+	require.NoError(t, f.keeper.EndBlocker(ctx.WithBlockTime(time.Unix(1001+params.ChallengeTtl, 0))))
+	for _, address := range addresses {
+		challenge, err := f.keeper.GetChallenge(ctx, address)
+		require.NoError(t, err)
+		require.Empty(t, challenge)
+	}
+	pending, err = f.keeper.CountPendingChallenges(ctx, creator)
+	require.NoError(t, err)
+	require.Zero(t, pending)
+	has, err := f.keeper.ChallengeByExpiry.Has(ctx, collections.Join(1000+params.ChallengeTtl, addresses[0]))
+	require.NoError(t, err)
+	require.False(t, has)
+}
@@ -0,0 +1,137 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"resist/x/identity/types"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func (k msgServer) Follow(ctx context.Context, msg *types.MsgFollow) (*types.MsgFollowResponse, error) {
+	if err := k.validateEdge(msg.Creator, msg.Address); err != nil {
+		return nil, err
+	}
+	if blocked, err := k.IsBlocked(ctx, msg.Address, msg.Creator); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	} else if blocked {
+		return nil, errorsmod.Wrapf(types.ErrBlocked, "%s", msg.Address)
+	}
+	if blocked, err := k.IsBlocked(ctx, msg.Creator, msg.Address); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	} else if blocked {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "unblock %s to follow it", msg.Address)
+	}
+	if following, err := k.Keeper.Follow.Has(ctx, collections.Join(msg.Creator, msg.Address)); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	} else if following {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "already following %s", msg.Address)
+	}
+
+	if err := k.SetFollow(ctx, msg.Creator, msg.Address); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			"followed",
+			sdk.NewAttribute("follower", msg.Creator),
+			sdk.NewAttribute("followee", msg.Address),
+		),
+	)
+
+	return &types.MsgFollowResponse{}, nil
+}
+
+func (k msgServer) Unfollow(ctx context.Context, msg *types.MsgUnfollow) (*types.MsgUnfollowResponse, error) {
+	if err := k.validateEdge(msg.Creator, msg.Address); err != nil {
+		return nil, err
+	}
+	if following, err := k.Keeper.Follow.Has(ctx, collections.Join(msg.Creator, msg.Address)); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	} else if !following {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "not following %s", msg.Address)
+	}
+
+	if err := k.RemoveFollow(ctx, msg.Creator, msg.Address); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			"unfollowed",
+			sdk.NewAttribute("follower", msg.Creator),
+			sdk.NewAttribute("followee", msg.Address),
+		),
+	)
+
+	return &types.MsgUnfollowResponse{}, nil
+}
+
+func (k msgServer) Block(ctx context.Context, msg *types.MsgBlock) (*types.MsgBlockResponse, error) {
+	if err := k.validateEdge(msg.Creator, msg.Address); err != nil {
+		return nil, err
+	}
+	if blocked, err := k.IsBlocked(ctx, msg.Creator, msg.Address); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	} else if blocked {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "already blocking %s", msg.Address)
+	}
+
+	if err := k.SetBlock(ctx, msg.Creator, msg.Address); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			"blocked",
+			sdk.NewAttribute("blocker", msg.Creator),
+			sdk.NewAttribute("blocked", msg.Address),
+		),
+	)
+
+	return &types.MsgBlockResponse{}, nil
+}
+
+func (k msgServer) Unblock(ctx context.Context, msg *types.MsgUnblock) (*types.MsgUnblockResponse, error) {
+	if err := k.validateEdge(msg.Creator, msg.Address); err != nil {
+		return nil, err
+	}
+	if blocked, err := k.IsBlocked(ctx, msg.Creator, msg.Address); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	} else if !blocked {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "not blocking %s", msg.Address)
+	}
+
+	if err := k.RemoveBlock(ctx, msg.Creator, msg.Address); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			"unblocked",
+			sdk.NewAttribute("blocker", msg.Creator),
+			sdk.NewAttribute("blocked", msg.Address),
+		),
+	)
+
+	return &types.MsgUnblockResponse{}, nil
+}
+
+// validateEdge checks the addresses of a follow or block.
+func (k msgServer) validateEdge(creator, address string) error {
+	if _, err := k.addressCodec.StringToBytes(creator); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid address: %s", err))
+	}
+	if _, err := k.addressCodec.StringToBytes(address); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid target address: %s", err))
+	}
+	if creator == address {
+		return types.ErrSelfFollow
+	}
+	return nil
+}
@@ -0,0 +1,113 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+
+	"resist/x/identity/keeper"
+	"resist/x/identity/types"
+)
+
+func TestSocialGraphMsgServer(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	address := func(name string) string {
+		addr, err := f.addressCodec.BytesToString([]byte(name + "____________________________")[:28])
+		require.NoError(t, err)
+		return addr
+	}
+	alice, bob, carol := address("alice"), address("bob"), address("carol")
+	counts := func(address string) *types.QueryGetFollowCountsResponse {
+		res, err := qs.GetFollowCounts(f.ctx, &types.QueryGetFollowCountsRequest{Address: address})
+		require.NoError(t, err)
+		return res
+	}
+
+	_, err := srv.Follow(f.ctx, &types.MsgFollow{Creator: alice, Address: alice})
+	require.ErrorIs(t, err, types.ErrSelfFollow)
+	_, err = srv.Follow(f.ctx, &types.MsgFollow{Creator: alice, Address: "invalid"})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidAddress)
+	for _, follower := range []string{alice, carol} {
+		_, err = srv.Follow(f.ctx, &types.MsgFollow{Creator: follower, Address: bob})
+		require.NoError(t, err)
+	}
+	_, err = srv.Follow(f.ctx, &types.MsgFollow{Creator: bob, Address: alice})
+	require.NoError(t, err)
+	_, err = srv.Follow(f.ctx, &types.MsgFollow{Creator: alice, Address: bob})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	require.Equal(t, &types.QueryGetFollowCountsResponse{Followers: 2, Following: 1}, counts(bob))
+
+	followers, err := qs.ListFollowers(f.ctx, &types.QueryListFollowersRequest{Address: bob, Pagination: &query.PageRequest{Limit: 1, CountTotal: true}})
+	require.NoError(t, err)
+	require.Len(t, followers.Followers, 1)
+	require.EqualValues(t, 2, followers.Pagination.Total)
+	following, err := qs.ListFollowing(f.ctx, &types.QueryListFollowingRequest{Address: alice})
+	require.NoError(t, err)
+	require.Equal(t, []string{bob}, following.Following)
+
+	_, err = srv.Unfollow(f.ctx, &types.MsgUnfollow{Creator: carol, Address: bob})
+	require.NoError(t, err)
+	_, err = srv.Unfollow(f.ctx, &types.MsgUnfollow{Creator: carol, Address: bob})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	require.Equal(t, &types.QueryGetFollowCountsResponse{Followers: 1, Following: 1}, counts(bob))
+
+	// Blocking removes the follows both ways and prevents following
+	_, err = srv.Block(f.ctx, &types.MsgBlock{Creator: bob, Address: alice})
+	require.NoError(t, err)
+	_, err = srv.Block(f.ctx, &types.MsgBlock{Creator: bob, Address: alice})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	require.Equal(t, &types.QueryGetFollowCountsResponse{}, counts(bob))
+	require.Equal(t, &types.QueryGetFollowCountsResponse{}, counts(alice))
+	_, err = srv.Follow(f.ctx, &types.MsgFollow{Creator: alice, Address: bob})
+	require.ErrorIs(t, err, types.ErrBlocked)
+	_, err = srv.Follow(f.ctx, &types.MsgFollow{Creator: bob, Address: alice})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	blocked, err := qs.ListBlocked(f.ctx, &types.QueryListBlockedRequest{Address: bob})
+	require.NoError(t, err)
+	require.Equal(t, []string{alice}, blocked.Blocked)
+
+	_, err = srv.Unblock(f.ctx, &types.MsgUnblock{Creator: bob, Address: alice})
+	require.NoError(t, err)
+	_, err = srv.Unblock(f.ctx, &types.MsgUnblock{Creator: bob, Address: alice})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	_, err = srv.Follow(f.ctx, &types.MsgFollow{Creator: alice, Address: bob})
+	require.NoError(t, err)
+}
+
+func TestMigrateSocialGraph(t *testing.T) {
+	f := initFixture(t)
+
+	address := func(name string) string {
+		addr, err := f.addressCodec.BytesToString([]byte(name + "____________________________")[:28])
+		require.NoError(t, err)
+		return addr
+	}
+	alice, recovered, bob, carol := address("alice"), address("recovered"), address("bob"), address("carol")
+	require.NoError(t, f.keeper.UserProfile.Set(f.ctx, alice, types.UserProfile{Index: alice, Creator: alice}))
+	require.NoError(t, f.keeper.SetFollow(f.ctx, alice, bob))
+	require.NoError(t, f.keeper.SetFollow(f.ctx, carol, alice))
+	require.NoError(t, f.keeper.SetFollow(f.ctx, recovered, alice))
+	require.NoError(t, f.keeper.SetBlock(f.ctx, bob, carol))
+	require.NoError(t, f.keeper.SetBlock(f.ctx, carol, alice))
+	require.NoError(t, f.keeper.RemoveFollow(f.ctx, carol, alice))
+
+	require.NoError(t, f.keeper.MigrateIdentity(f.ctx, alice, recovered))
+
+	exported, err := f.keeper.ExportGenesis(f.ctx)
+	require.NoError(t, err)
+	require.ElementsMatch(t, []types.Follow{{Follower: recovered, Followee: bob}}, exported.FollowList)
+	require.ElementsMatch(t, []types.Block{{Blocker: bob, Blocked: carol}, {Blocker: carol, Blocked: recovered}}, exported.BlockList)
+	followers, following, err := f.keeper.GetFollowCounts(f.ctx, recovered)
+	require.NoError(t, err)
+	require.Zero(t, followers)
+	require.EqualValues(t, 1, following)
+	followers, following, err = f.keeper.GetFollowCounts(f.ctx, alice)
+	require.NoError(t, err)
+	require.Zero(t, followers)
+	require.Zero(t, following)
+}
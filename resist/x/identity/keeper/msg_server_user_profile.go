@@ -18,6 +18,10 @@ func (k msgServer) CreateUserProfile(ctx context.Context, msg *types.MsgCreateUs
 		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid address: %s", err))
 	}
 
+	if err := k.validateProfile(ctx, msg.DisplayName, msg.Bio, msg.AvatarUrl, msg.Metadata); err != nil {
+		return nil, err
+	}
+
 	// Use the creator's address as the profile index for authentication integration
 	profileIndex := msg.Creator
 
@@ -39,8 +43,8 @@ func (k msgServer) CreateUserProfile(ctx context.Context, msg *types.MsgCreateUs
 		DisplayName: msg.DisplayName,
 		Bio:         msg.Bio,
 		AvatarUrl:   msg.AvatarUrl,
-		Verified:    false, // Profiles start as unverified
 		CreatedAt:   currentTime,
+		Metadata:    msg.Metadata,
 	}
 
 	if err := k.UserProfile.Set(ctx, userProfile.Index, userProfile); err != nil {
@@ -78,6 +82,9 @@ func (k msgServer) UpdateUserProfile(ctx context.Context, msg *types.MsgUpdateUs
 	if msg.Creator != val.Creator {
 		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "incorrect owner")
 	}
+	if err := k.validateProfile(ctx, msg.DisplayName, msg.Bio, msg.AvatarUrl, msg.Metadata); err != nil {
+		return nil, err
+	}
 
 	var userProfile = types.UserProfile{
 		Creator:     msg.Creator,
@@ -85,8 +92,8 @@ func (k msgServer) UpdateUserProfile(ctx context.Context, msg *types.MsgUpdateUs
 		DisplayName: msg.DisplayName,
 		Bio:         msg.Bio,
 		AvatarUrl:   msg.AvatarUrl,
-		Verified:    msg.Verified,
 		CreatedAt:   msg.CreatedAt,
+		Metadata:    msg.Metadata,
 	}
 
 	if err := k.UserProfile.Set(ctx, userProfile.Index, userProfile); err != nil {
@@ -122,3 +129,15 @@ func (k msgServer) DeleteUserProfile(ctx context.Context, msg *types.MsgDeleteUs
 
 	return &types.MsgDeleteUserProfileResponse{}, nil
 }
+
+// validateProfile checks the profile fields against the profile limits.
+func (k msgServer) validateProfile(ctx context.Context, displayName, bio, avatarURL string, metadata []types.ProfileMetadata) error {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+	if err := params.ProfileLimits.ValidateProfile(displayName, bio, avatarURL, metadata); err != nil {
+		return errorsmod.Wrap(types.ErrInvalidProfile, err.Error())
+	}
+	return nil
+}
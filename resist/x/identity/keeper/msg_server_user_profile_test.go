@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	"strconv"
+	"strings"
 	"testing"
 
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -155,3 +156,44 @@ func TestUserProfileMsgServerDelete(t *testing.T) {
 		})
 	}
 }
+
+func TestUserProfileMsgServerLimits(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	creator, err := f.addressCodec.BytesToString([]byte("signerAddr__________________"))
+	require.NoError(t, err)
+
+	params := types.DefaultParams()
+	params.ProfileLimits.MaxMetadataEntries = 2
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+	long := strings.Repeat("a", int(params.ProfileLimits.MaxAvatarUrlLength)+1)
+
+	for _, tc := range []struct {
+		desc string
+		msg  *types.MsgCreateUserProfile
+	}{
+		{desc: "display name", msg: &types.MsgCreateUserProfile{Creator: creator, DisplayName: long}},
+		{desc: "bio", msg: &types.MsgCreateUserProfile{Creator: creator, Bio: long}},
+		{desc: "avatar url", msg: &types.MsgCreateUserProfile{Creator: creator, AvatarUrl: long}},
+		{desc: "metadata value", msg: &types.MsgCreateUserProfile{Creator: creator, Metadata: []types.ProfileMetadata{{Key: types.MetadataKeyPGP, Value: long}}}},
+		{desc: "empty metadata key", msg: &types.MsgCreateUserProfile{Creator: creator, Metadata: []types.ProfileMetadata{{Value: "x"}}}},
+		{desc: "duplicated metadata key", msg: &types.MsgCreateUserProfile{Creator: creator, Metadata: []types.ProfileMetadata{{Key: types.MetadataKeyMatrix, Value: "@a:example.org"}, {Key: types.MetadataKeyMatrix, Value: "@b:example.org"}}}},
+		{desc: "metadata entries", msg: &types.MsgCreateUserProfile{Creator: creator, Metadata: []types.ProfileMetadata{{Key: "a"}, {Key: "b"}, {Key: "c"}}}},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			_, err := srv.CreateUserProfile(f.ctx, tc.msg)
+			require.ErrorIs(t, err, types.ErrInvalidProfile)
+		})
+	}
+
+	metadata := []types.ProfileMetadata{{Key: types.MetadataKeyWebsite, Value: "https://example.org"}, {Key: types.MetadataKeyPGP, Value: "0123456789ABCDEF"}}
+	_, err = srv.CreateUserProfile(f.ctx, &types.MsgCreateUserProfile{Creator: creator, DisplayName: "Example", Metadata: metadata})
+	require.NoError(t, err)
+	_, err = srv.UpdateUserProfile(f.ctx, &types.MsgUpdateUserProfile{Creator: creator, Index: creator, Bio: long})
+	require.ErrorIs(t, err, types.ErrInvalidProfile)
+	_, err = srv.UpdateUserProfile(f.ctx, &types.MsgUpdateUserProfile{Creator: creator, Index: creator, DisplayName: "Example News", Metadata: metadata[:1]})
+	require.NoError(t, err)
+	profile, err := f.keeper.UserProfile.Get(f.ctx, creator)
+	require.NoError(t, err)
+	require.Equal(t, metadata[:1], profile.Metadata)
+}
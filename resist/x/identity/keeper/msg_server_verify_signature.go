@@ -2,18 +2,20 @@ package keeper
 
 import (
 	"context"
-	"crypto/sha256"
 	"encoding/hex"
-	"fmt"
-	"strconv"
-	"strings"
+	"errors"
 
 	"resist/x/identity/types"
 
+	"cosmossdk.io/collections"
 	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	"github.com/cosmos/cosmos-sdk/types/bech32"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 )
 
 func (k msgServer) VerifySignature(ctx context.Context, msg *types.MsgVerifySignature) (*types.MsgVerifySignatureResponse, error) {
@@ -27,106 +29,54 @@ func (k msgServer) VerifySignature(ctx context.Context, msg *types.MsgVerifySign
 	}
 
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
-	store := k.storeService.OpenKVStore(ctx)
 
 	// Retrieve and validate challenge
-	challengeKey := fmt.Sprintf("challenge:%s", msg.Address)
-	challengeData, err := store.Get([]byte(challengeKey))
-	if err != nil {
-		return nil, errorsmod.Wrap(err, "failed to retrieve challenge")
-	}
-	if challengeData == nil {
+	challenge, err := k.Challenge.Get(ctx, msg.Address)
+	if errors.Is(err, collections.ErrNotFound) {
 		return nil, errorsmod.Wrap(types.ErrChallengeNotFound, "challenge not found for address")
 	}
-
-	// Parse challenge data (format: "challenge:expiration")
-	parts := strings.Split(string(challengeData), ":")
-	if len(parts) != 2 {
-		return nil, errorsmod.Wrap(types.ErrInvalidChallenge, "invalid challenge format")
-	}
-
-	storedChallenge := parts[0]
-	expiration, err := strconv.ParseInt(parts[1], 10, 64)
 	if err != nil {
-		return nil, errorsmod.Wrap(types.ErrInvalidChallenge, "invalid expiration format")
+		return nil, errorsmod.Wrap(err, "failed to retrieve challenge")
 	}
 
 	// Check if challenge has expired
-	if sdkCtx.BlockTime().Unix() > expiration {
+	if sdkCtx.BlockTime().Unix() > challenge.ExpiresAt {
 		return nil, errorsmod.Wrap(types.ErrChallengeExpired, "challenge has expired")
 	}
 
 	// Verify the challenge matches
-	if storedChallenge != msg.Challenge {
+	if challenge.Challenge != msg.Challenge {
 		return nil, errorsmod.Wrap(types.ErrInvalidChallenge, "challenge mismatch")
 	}
 
-	// Decode signature
-	sigBytes, err := hex.DecodeString(msg.Signature)
-	if err != nil {
-		return nil, errorsmod.Wrap(err, "invalid signature format")
-	}
-
-	// Create message hash to verify
-	messageToSign := fmt.Sprintf("Authenticate with challenge: %s", msg.Challenge)
-	hash := sha256.Sum256([]byte(messageToSign))
-
-	// Extract address bytes for verification
-	_, addressBytes, err := bech32.DecodeAndConvert(msg.Address)
-	if err != nil {
-		return nil, errorsmod.Wrap(err, "failed to decode address")
-	}
-
-	// Verify signature
-	pubKey := &secp256k1.PubKey{Key: sigBytes[:33]} // First 33 bytes are public key
-	signature := sigBytes[33:]                      // Remaining bytes are signature
-
-	// Verify the signature
-	if !pubKey.VerifySignature(hash[:], signature) {
-		return nil, errorsmod.Wrap(types.ErrInvalidSignature, "signature verification failed")
-	}
-
-	// Verify the public key corresponds to the claimed address
-	deriviedAddr := sdk.AccAddress(pubKey.Address())
-	claimedAddr := sdk.AccAddress(addressBytes)
-	if !deriviedAddr.Equals(claimedAddr) {
-		return nil, errorsmod.Wrap(types.ErrAddressMismatch, "address does not match public key")
+	// Verify the signature and that the key controls the claimed address
+	if err := k.verifyChallengeSignature(ctx, msg); err != nil {
+		return nil, err
 	}
 
 	// Clean up the challenge
-	if err := store.Delete([]byte(challengeKey)); err != nil {
+	if err := k.RemoveChallenge(ctx, msg.Address); err != nil {
 		return nil, errorsmod.Wrap(err, "failed to delete challenge")
 	}
 
-	// Check if user profile exists, create/verify it
+	// Create the profile on first authentication. Proving control of a key
+	// does not verify the profile, only attestations of issuers do.
 	profileExists, err := k.UserProfile.Has(ctx, msg.Address)
 	if err != nil {
 		return nil, errorsmod.Wrap(err, "failed to check profile existence")
 	}
 
-	if profileExists {
-		// Update existing profile to mark as verified
-		profile, err := k.UserProfile.Get(ctx, msg.Address)
-		if err != nil {
-			return nil, errorsmod.Wrap(err, "failed to get existing profile")
-		}
-		profile.Verified = true
-		if err := k.UserProfile.Set(ctx, msg.Address, profile); err != nil {
-			return nil, errorsmod.Wrap(err, "failed to verify profile")
-		}
-	} else {
-		// Create new verified profile
+	if !profileExists {
 		newProfile := types.UserProfile{
 			Creator:     msg.Address,
 			Index:       msg.Address,
 			DisplayName: "", // User can set this later
 			Bio:         "",
 			AvatarUrl:   "",
-			Verified:    true,
 			CreatedAt:   sdkCtx.BlockTime().Unix(),
 		}
 		if err := k.UserProfile.Set(ctx, msg.Address, newProfile); err != nil {
-			return nil, errorsmod.Wrap(err, "failed to create verified profile")
+			return nil, errorsmod.Wrap(err, "failed to create profile")
 		}
 	}
 
@@ -136,9 +86,93 @@ func (k msgServer) VerifySignature(ctx context.Context, msg *types.MsgVerifySign
 			"authentication_successful",
 			sdk.NewAttribute("address", msg.Address),
 			sdk.NewAttribute("creator", msg.Creator),
-			sdk.NewAttribute("verified", "true"),
 		),
 	)
 
 	return &types.MsgVerifySignatureResponse{}, nil
 }
+
+// verifyChallengeSignature checks the signature of the challenge sign bytes by
+// the key of msg.Address.
+func (k msgServer) verifyChallengeSignature(ctx context.Context, msg *types.MsgVerifySignature) error {
+	sigBytes, err := hex.DecodeString(msg.Signature)
+	if err != nil {
+		return errorsmod.Wrap(types.ErrInvalidSignature, "signature is not hex encoded")
+	}
+
+	if msg.PubKey == nil {
+		// Legacy format: the secp256k1 public key followed by the signature.
+		if len(sigBytes) <= secp256k1.PubKeySize {
+			return errorsmod.Wrapf(types.ErrInvalidSignature, "signature must hold a %d bytes public key and a signature", secp256k1.PubKeySize)
+		}
+		pubKey := &secp256k1.PubKey{Key: sigBytes[:secp256k1.PubKeySize]}
+		return k.verifyAddressSignature(ctx, msg.Address, pubKey, sigBytes[secp256k1.PubKeySize:], types.ChallengeSignBytes(msg.Challenge))
+	}
+
+	pubKey, ok := msg.PubKey.GetCachedValue().(cryptotypes.PubKey)
+	if !ok {
+		return errorsmod.Wrapf(types.ErrInvalidPubKey, "cannot unpack %s", msg.PubKey.TypeUrl)
+	}
+	return k.verifyAddressSignature(ctx, msg.Address, pubKey, sigBytes, types.ChallengeSignBytes(msg.Challenge))
+}
+
+// verifyAddressSignature checks the signature of signBytes by pubKey, and
+// that pubKey is the key of address. When the account is known to x/auth
+// with a public key, the key must be the same.
+func (k Keeper) verifyAddressSignature(ctx context.Context, address string, pubKey cryptotypes.PubKey, sigBytes, signBytes []byte) error {
+	switch pubKey := pubKey.(type) {
+	case *secp256k1.PubKey, *secp256r1.PubKey, *ed25519.PubKey:
+		if !pubKey.VerifySignature(signBytes, sigBytes) {
+			return errorsmod.Wrap(types.ErrInvalidSignature, "signature verification failed")
+		}
+	case *multisig.LegacyAminoPubKey:
+		sigData, err := multiSignatureData(pubKey, sigBytes)
+		if err != nil {
+			return err
+		}
+		getSignBytes := func(signing.SignMode) ([]byte, error) { return signBytes, nil }
+		if err := pubKey.VerifyMultisignature(getSignBytes, sigData); err != nil {
+			return errorsmod.Wrap(types.ErrInvalidSignature, err.Error())
+		}
+	default:
+		return errorsmod.Wrapf(types.ErrInvalidPubKey, "unsupported key type %s", pubKey.Type())
+	}
+
+	// Verify the public key corresponds to the claimed address
+	addr, err := k.addressCodec.StringToBytes(address)
+	if err != nil {
+		return errorsmod.Wrap(err, "invalid address")
+	}
+	if !sdk.AccAddress(pubKey.Address()).Equals(sdk.AccAddress(addr)) {
+		return errorsmod.Wrap(types.ErrAddressMismatch, "address does not match public key")
+	}
+	if account := k.authKeeper.GetAccount(ctx, addr); account != nil {
+		if accountPubKey := account.GetPubKey(); accountPubKey != nil && !accountPubKey.Equals(pubKey) {
+			return errorsmod.Wrapf(types.ErrPubKeyMismatch, "account %s", address)
+		}
+	}
+	return nil
+}
+
+// multiSignatureData decodes the MultiSignature sigBytes, holding one
+// signature per sub key of pubKey, empty for the keys that did not sign.
+func multiSignatureData(pubKey *multisig.LegacyAminoPubKey, sigBytes []byte) (*signing.MultiSignatureData, error) {
+	var multiSig cryptotypes.MultiSignature
+	if err := multiSig.Unmarshal(sigBytes); err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidSignature, "signature is not a multisignature")
+	}
+	subKeys := pubKey.GetPubKeys()
+	if len(multiSig.Signatures) != len(subKeys) {
+		return nil, errorsmod.Wrapf(types.ErrInvalidSignature, "expected %d signatures, got %d", len(subKeys), len(multiSig.Signatures))
+	}
+
+	sigData := &signing.MultiSignatureData{BitArray: cryptotypes.NewCompactBitArray(len(subKeys))}
+	for i, sig := range multiSig.Signatures {
+		if len(sig) == 0 {
+			continue
+		}
+		sigData.BitArray.SetIndex(i, true)
+		sigData.Signatures = append(sigData.Signatures, &signing.SingleSignatureData{Signature: sig})
+	}
+	return sigData, nil
+}
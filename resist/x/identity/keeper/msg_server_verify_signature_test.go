@@ -0,0 +1,184 @@
+package keeper_test
+
+import (
+	"encoding/hex"
+	"testing"
+	"time"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+
+	"resist/x/identity/keeper"
+	"resist/x/identity/types"
+)
+
+func TestVerifySignatureMsgServer(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(time.Unix(1000, 0))
+
+	creator, err := f.addressCodec.BytesToString([]byte("creator_____________________"))
+	require.NoError(t, err)
+
+	secp256r1Key, err := secp256r1.GenPrivKey()
+	require.NoError(t, err)
+	multisigKeys := []cryptotypes.PrivKey{secp256k1.GenPrivKey(), ed25519.GenPrivKey(), secp256k1.GenPrivKey()}
+	multisigPubKey := multisig.NewLegacyAminoPubKey(2, []cryptotypes.PubKey{
+		multisigKeys[0].PubKey(), multisigKeys[1].PubKey(), multisigKeys[2].PubKey(),
+	})
+
+	// request returns a challenge for the address of pubKey.
+	request := func(pubKey cryptotypes.PubKey) (string, string) {
+		address, err := f.addressCodec.BytesToString(pubKey.Address())
+		require.NoError(t, err)
+		_, err = srv.RequestChallenge(ctx, &types.MsgRequestChallenge{Creator: creator, Address: address})
+		require.NoError(t, err)
+		challenge, err := f.keeper.GetChallenge(ctx, address)
+		require.NoError(t, err)
+		return address, challenge
+	}
+	anyPubKey := func(pubKey cryptotypes.PubKey) *codectypes.Any {
+		value, err := codectypes.NewAnyWithValue(pubKey)
+		require.NoError(t, err)
+		return value
+	}
+	sign := func(key cryptotypes.PrivKey, challenge string) []byte {
+		signature, err := key.Sign(types.ChallengeSignBytes(challenge))
+		require.NoError(t, err)
+		return signature
+	}
+
+	t.Run("legacy secp256k1", func(t *testing.T) {
+		key := secp256k1.GenPrivKey()
+		address, challenge := request(key.PubKey())
+
+		_, err := srv.VerifySignature(ctx, &types.MsgVerifySignature{Creator: creator, Address: address, Challenge: challenge, Signature: "00"})
+		require.ErrorIs(t, err, types.ErrInvalidSignature)
+		_, err = srv.VerifySignature(ctx, &types.MsgVerifySignature{Creator: creator, Address: address, Challenge: challenge, Signature: "zz"})
+		require.ErrorIs(t, err, types.ErrInvalidSignature)
+
+		signature := append(key.PubKey().Bytes(), sign(key, challenge)...)
+		_, err = srv.VerifySignature(ctx, &types.MsgVerifySignature{Creator: creator, Address: address, Challenge: challenge, Signature: hex.EncodeToString(signature)})
+		require.NoError(t, err)
+		found, err := f.keeper.UserProfile.Has(ctx, address)
+		require.NoError(t, err)
+		require.True(t, found)
+	})
+
+	for _, tc := range []struct {
+		desc string
+		key  cryptotypes.PrivKey
+	}{
+		{desc: "secp256k1", key: secp256k1.GenPrivKey()},
+		{desc: "secp256r1", key: secp256r1Key},
+		{desc: "ed25519", key: ed25519.GenPrivKey()},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			address, challenge := request(tc.key.PubKey())
+
+			_, err := srv.VerifySignature(ctx, &types.MsgVerifySignature{
+				Creator:   creator,
+				Address:   address,
+				Challenge: challenge,
+				Signature: hex.EncodeToString(sign(tc.key, "other")),
+				PubKey:    anyPubKey(tc.key.PubKey()),
+			})
+			require.ErrorIs(t, err, types.ErrInvalidSignature)
+
+			_, err = srv.VerifySignature(ctx, &types.MsgVerifySignature{
+				Creator:   creator,
+				Address:   address,
+				Challenge: challenge,
+				Signature: hex.EncodeToString(sign(tc.key, challenge)),
+				PubKey:    anyPubKey(tc.key.PubKey()),
+			})
+			require.NoError(t, err)
+		})
+	}
+
+	t.Run("multisig", func(t *testing.T) {
+		address, challenge := request(multisigPubKey)
+		multiSignature := func(signatures ...[]byte) string {
+			bz, err := (&cryptotypes.MultiSignature{Signatures: signatures}).Marshal()
+			require.NoError(t, err)
+			return hex.EncodeToString(bz)
+		}
+
+		// Below threshold
+		_, err := srv.VerifySignature(ctx, &types.MsgVerifySignature{
+			Creator:   creator,
+			Address:   address,
+			Challenge: challenge,
+			Signature: multiSignature(sign(multisigKeys[0], challenge), nil, nil),
+			PubKey:    anyPubKey(multisigPubKey),
+		})
+		require.ErrorIs(t, err, types.ErrInvalidSignature)
+		_, err = srv.VerifySignature(ctx, &types.MsgVerifySignature{
+			Creator:   creator,
+			Address:   address,
+			Challenge: challenge,
+			Signature: multiSignature(sign(multisigKeys[0], challenge)),
+			PubKey:    anyPubKey(multisigPubKey),
+		})
+		require.ErrorIs(t, err, types.ErrInvalidSignature)
+
+		_, err = srv.VerifySignature(ctx, &types.MsgVerifySignature{
+			Creator:   creator,
+			Address:   address,
+			Challenge: challenge,
+			Signature: multiSignature(sign(multisigKeys[0], challenge), nil, sign(multisigKeys[2], challenge)),
+			PubKey:    anyPubKey(multisigPubKey),
+		})
+		require.NoError(t, err)
+	})
+
+	t.Run("address mismatch", func(t *testing.T) {
+		key := secp256k1.GenPrivKey()
+		other := ed25519.GenPrivKey()
+		address, challenge := request(key.PubKey())
+
+		_, err := srv.VerifySignature(ctx, &types.MsgVerifySignature{
+			Creator:   creator,
+			Address:   address,
+			Challenge: challenge,
+			Signature: hex.EncodeToString(sign(other, challenge)),
+			PubKey:    anyPubKey(other.PubKey()),
+		})
+		require.ErrorIs(t, err, types.ErrAddressMismatch)
+	})
+
+	t.Run("account public key mismatch", func(t *testing.T) {
+		key := secp256k1.GenPrivKey()
+		address, challenge := request(key.PubKey())
+		// An account whose on-chain key differs from the key deriving its address
+		accountPubKey := secp256k1.GenPrivKey().PubKey()
+		addr := sdk.AccAddress(key.PubKey().Address())
+		f.authKeeper.accounts[string(addr)] = authtypes.NewBaseAccount(addr, accountPubKey, 0, 0)
+
+		_, err := srv.VerifySignature(ctx, &types.MsgVerifySignature{
+			Creator:   creator,
+			Address:   address,
+			Challenge: challenge,
+			Signature: hex.EncodeToString(sign(key, challenge)),
+			PubKey:    anyPubKey(key.PubKey()),
+		})
+		require.ErrorIs(t, err, types.ErrPubKeyMismatch)
+
+		f.authKeeper.accounts[string(addr)] = authtypes.NewBaseAccount(addr, key.PubKey(), 0, 0)
+		_, err = srv.VerifySignature(ctx, &types.MsgVerifySignature{
+			Creator:   creator,
+			Address:   address,
+			Challenge: challenge,
+			Signature: hex.EncodeToString(sign(key, challenge)),
+			PubKey:    anyPubKey(key.PubKey()),
+		})
+		require.NoError(t, err)
+	})
+}
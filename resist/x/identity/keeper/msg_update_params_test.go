@@ -36,12 +36,40 @@ func TestMsgUpdateParams(t *testing.T) {
 			expErrMsg: "invalid authority",
 		},
 		{
-			name: "send enabled param",
+			name: "invalid recovery delay",
 			input: &types.MsgUpdateParams{
 				Authority: authorityStr,
-				Params:    types.Params{},
+				Params:    types.NewParams(nil, types.DefaultReservedHandles, 0, types.DefaultMaxPersonas, types.DefaultProfileLimits, types.DefaultChallengeTTL, types.DefaultMaxPendingChallenges, types.DefaultVerificationRules),
 			},
-			expErr: false,
+			expErr:    true,
+			expErrMsg: "recovery delay must be positive",
+		},
+		{
+			name: "invalid challenge ttl",
+			input: &types.MsgUpdateParams{
+				Authority: authorityStr,
+				Params:    types.NewParams(nil, types.DefaultReservedHandles, types.DefaultRecoveryDelay, types.DefaultMaxPersonas, types.DefaultProfileLimits, 0, types.DefaultMaxPendingChallenges, types.DefaultVerificationRules),
+			},
+			expErr:    true,
+			expErrMsg: "challenge ttl must be positive",
+		},
+		{
+			name: "invalid verification claim type",
+			input: &types.MsgUpdateParams{
+				Authority: authorityStr,
+				Params:    types.NewParams(nil, types.DefaultReservedHandles, types.DefaultRecoveryDelay, types.DefaultMaxPersonas, types.DefaultProfileLimits, types.DefaultChallengeTTL, types.DefaultMaxPendingChallenges, types.VerificationRules{ClaimTypes: []string{"celebrity"}, MinIssuers: 1}),
+			},
+			expErr:    true,
+			expErrMsg: "invalid claim type",
+		},
+		{
+			name: "no verification issuers",
+			input: &types.MsgUpdateParams{
+				Authority: authorityStr,
+				Params:    types.NewParams(nil, types.DefaultReservedHandles, types.DefaultRecoveryDelay, types.DefaultMaxPersonas, types.DefaultProfileLimits, types.DefaultChallengeTTL, types.DefaultMaxPendingChallenges, types.VerificationRules{ClaimTypes: []string{types.ClaimTypeHuman}}),
+			},
+			expErr:    true,
+			expErrMsg: "min issuers must be positive",
 		},
 		{
 			name: "all good",
@@ -0,0 +1,106 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"slices"
+
+	"cosmossdk.io/collections"
+
+	"resist/x/identity/types"
+)
+
+// SetPersonaRequest stores a persona credential request and indexes it under
+// its requester.
+func (k Keeper) SetPersonaRequest(ctx context.Context, request types.PersonaCredentialRequest) error {
+	if err := k.PersonaRequestByRequester.Set(ctx, collections.Join(request.Requester, request.Id)); err != nil {
+		return err
+	}
+	return k.PersonaRequest.Set(ctx, request.Id, request)
+}
+
+// IsPersonaKeyValid reports whether a persona key is not revoked and whether
+// its issuer is still registered to attest humans.
+func (k Keeper) IsPersonaKeyValid(ctx context.Context, key types.PersonaKey) (bool, error) {
+	if key.Revoked {
+		return false, nil
+	}
+	issuer, err := k.Issuer.Get(ctx, key.Issuer)
+	if errors.Is(err, collections.ErrNotFound) {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+	return slices.Contains(issuer.ClaimTypes, types.ClaimTypeHuman), nil
+}
+
+// IsPersonaValid reports whether a persona holds a credential of a valid
+// persona key.
+func (k Keeper) IsPersonaValid(ctx context.Context, persona types.Persona) (bool, error) {
+	key, err := k.PersonaKey.Get(ctx, persona.KeyId)
+	if errors.Is(err, collections.ErrNotFound) {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+	return k.IsPersonaKeyValid(ctx, key)
+}
+
+// IsHuman reports whether an account holds a valid human attestation.
+func (k Keeper) IsHuman(ctx context.Context, subject string) (bool, error) {
+	attestations, err := k.GetValidAttestations(ctx, subject)
+	if err != nil {
+		return false, err
+	}
+	return slices.ContainsFunc(attestations, func(attestation types.Attestation) bool {
+		return attestation.ClaimType == types.ClaimTypeHuman
+	}), nil
+}
+
+// countPersonaRequests returns the number of persona credentials requested by
+// requester.
+func (k Keeper) countPersonaRequests(ctx context.Context, requester string) (uint32, error) {
+	var count uint32
+	err := k.PersonaRequestByRequester.Walk(ctx, collections.NewPrefixedPairRange[string, uint64](requester), func(collections.Pair[string, uint64]) (bool, error) {
+		count++
+		return false, nil
+	})
+	return count, err
+}
+
+// migratePersonas moves the persona credential requests of oldAddress, and
+// the persona registered at oldAddress, to newAddress.
+func (k Keeper) migratePersonas(ctx context.Context, oldAddress, newAddress string) error {
+	var ids []uint64
+	if err := k.PersonaRequestByRequester.Walk(ctx, collections.NewPrefixedPairRange[string, uint64](oldAddress), func(key collections.Pair[string, uint64]) (bool, error) {
+		ids = append(ids, key.K2())
+		return false, nil
+	}); err != nil {
+		return err
+	}
+	for _, id := range ids {
+		request, err := k.PersonaRequest.Get(ctx, id)
+		if err != nil {
+			return err
+		}
+		if err := k.PersonaRequestByRequester.Remove(ctx, collections.Join(oldAddress, id)); err != nil {
+			return err
+		}
+		request.Requester = newAddress
+		if err := k.SetPersonaRequest(ctx, request); err != nil {
+			return err
+		}
+	}
+
+	persona, err := k.Persona.Get(ctx, oldAddress)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	if err := k.Persona.Remove(ctx, oldAddress); err != nil {
+		return err
+	}
+	persona.Address = newAddress
+	return k.Persona.Set(ctx, newAddress, persona)
+}
@@ -0,0 +1,105 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"resist/x/identity/types"
+
+	"cosmossdk.io/collections"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (q queryServer) ListIssuer(ctx context.Context, req *types.QueryAllIssuerRequest) (*types.QueryAllIssuerResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	issuers, pageRes, err := query.CollectionPaginate(
+		ctx,
+		q.k.Issuer,
+		req.Pagination,
+		func(_ string, value types.Issuer) (types.Issuer, error) {
+			return value, nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllIssuerResponse{Issuer: issuers, Pagination: pageRes}, nil
+}
+
+func (q queryServer) GetIssuer(ctx context.Context, req *types.QueryGetIssuerRequest) (*types.QueryGetIssuerResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	val, err := q.k.Issuer.Get(ctx, req.Address)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "not found")
+		}
+
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return &types.QueryGetIssuerResponse{Issuer: val}, nil
+}
+
+func (q queryServer) ListAttestation(ctx context.Context, req *types.QueryAllAttestationRequest) (*types.QueryAllAttestationResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.Subject == "" {
+		return nil, status.Error(codes.InvalidArgument, "subject cannot be empty")
+	}
+
+	attestations, pageRes, err := query.CollectionFilteredPaginate(
+		ctx,
+		q.k.AttestationBySubject,
+		req.Pagination,
+		func(key collections.Pair[string, uint64], _ collections.NoValue) (bool, error) {
+			if !req.ValidOnly {
+				return true, nil
+			}
+			attestation, err := q.k.Attestation.Get(ctx, key.K2())
+			if err != nil {
+				return false, err
+			}
+			return q.k.IsAttestationValid(ctx, attestation)
+		},
+		func(key collections.Pair[string, uint64], _ collections.NoValue) (types.Attestation, error) {
+			return q.k.Attestation.Get(ctx, key.K2())
+		},
+		query.WithCollectionPaginationPairPrefix[string, uint64](req.Subject),
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllAttestationResponse{Attestation: attestations, Pagination: pageRes}, nil
+}
+
+func (q queryServer) GetAttestation(ctx context.Context, req *types.QueryGetAttestationRequest) (*types.QueryGetAttestationResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	val, err := q.k.Attestation.Get(ctx, req.Id)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "not found")
+		}
+
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+	valid, err := q.k.IsAttestationValid(ctx, val)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return &types.QueryGetAttestationResponse{Attestation: val, Valid: valid}, nil
+}
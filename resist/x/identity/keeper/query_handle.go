@@ -0,0 +1,48 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"resist/x/identity/types"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (q queryServer) ResolveHandle(ctx context.Context, req *types.QueryResolveHandleRequest) (*types.QueryResolveHandleResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	val, err := q.k.ResolveHandle(ctx, req.Handle)
+	if err != nil {
+		if errors.Is(err, types.ErrInvalidHandle) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		if errors.Is(err, types.ErrHandleNotFound) {
+			return nil, status.Error(codes.NotFound, "not found")
+		}
+
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return &types.QueryResolveHandleResponse{Handle: val}, nil
+}
+
+func (q queryServer) ReverseResolve(ctx context.Context, req *types.QueryReverseResolveRequest) (*types.QueryReverseResolveResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	val, err := q.k.GetOwnerHandle(ctx, req.Address)
+	if err != nil {
+		if errors.Is(err, types.ErrHandleNotFound) {
+			return nil, status.Error(codes.NotFound, "not found")
+		}
+
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return &types.QueryReverseResolveResponse{Handle: val}, nil
+}
@@ -0,0 +1,111 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"resist/x/identity/types"
+
+	"cosmossdk.io/collections"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (q queryServer) ListPersonaKey(ctx context.Context, req *types.QueryAllPersonaKeyRequest) (*types.QueryAllPersonaKeyResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	keys, pageRes, err := query.CollectionPaginate(
+		ctx,
+		q.k.PersonaKey,
+		req.Pagination,
+		func(_ uint64, value types.PersonaKey) (types.PersonaKey, error) {
+			return value, nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllPersonaKeyResponse{PersonaKey: keys, Pagination: pageRes}, nil
+}
+
+func (q queryServer) GetPersonaKey(ctx context.Context, req *types.QueryGetPersonaKeyRequest) (*types.QueryGetPersonaKeyResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	val, err := q.k.PersonaKey.Get(ctx, req.Id)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "not found")
+		}
+
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return &types.QueryGetPersonaKeyResponse{PersonaKey: val}, nil
+}
+
+func (q queryServer) ListPersonaCredentialRequest(ctx context.Context, req *types.QueryAllPersonaCredentialRequestRequest) (*types.QueryAllPersonaCredentialRequestResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	requests, pageRes, err := query.CollectionFilteredPaginate(
+		ctx,
+		q.k.PersonaRequest,
+		req.Pagination,
+		func(_ uint64, value types.PersonaCredentialRequest) (bool, error) {
+			return value.KeyId == req.KeyId && (!req.PendingOnly || len(value.BlindSignature) == 0), nil
+		},
+		func(_ uint64, value types.PersonaCredentialRequest) (types.PersonaCredentialRequest, error) {
+			return value, nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllPersonaCredentialRequestResponse{PersonaRequest: requests, Pagination: pageRes}, nil
+}
+
+func (q queryServer) GetPersonaCredentialRequest(ctx context.Context, req *types.QueryGetPersonaCredentialRequestRequest) (*types.QueryGetPersonaCredentialRequestResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	val, err := q.k.PersonaRequest.Get(ctx, req.Id)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "not found")
+		}
+
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return &types.QueryGetPersonaCredentialRequestResponse{PersonaRequest: val}, nil
+}
+
+func (q queryServer) GetPersona(ctx context.Context, req *types.QueryGetPersonaRequest) (*types.QueryGetPersonaResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	val, err := q.k.Persona.Get(ctx, req.Address)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "not found")
+		}
+
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+	valid, err := q.k.IsPersonaValid(ctx, val)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return &types.QueryGetPersonaResponse{Persona: val, Valid: valid}, nil
+}
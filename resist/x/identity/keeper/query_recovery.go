@@ -0,0 +1,46 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"resist/x/identity/types"
+
+	"cosmossdk.io/collections"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (q queryServer) GetGuardians(ctx context.Context, req *types.QueryGetGuardiansRequest) (*types.QueryGetGuardiansResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	val, err := q.k.Guardians.Get(ctx, req.Address)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "not found")
+		}
+
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return &types.QueryGetGuardiansResponse{Guardians: val}, nil
+}
+
+func (q queryServer) GetRecovery(ctx context.Context, req *types.QueryGetRecoveryRequest) (*types.QueryGetRecoveryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	val, err := q.k.Recovery.Get(ctx, req.Address)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "not found")
+		}
+
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return &types.QueryGetRecoveryResponse{Recovery: val}, nil
+}
@@ -0,0 +1,78 @@
+package keeper
+
+import (
+	"context"
+
+	"resist/x/identity/types"
+
+	"cosmossdk.io/collections"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (q queryServer) ListFollowers(ctx context.Context, req *types.QueryListFollowersRequest) (*types.QueryListFollowersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	followers, pageRes, err := paginatePairs(ctx, q.k.Follower, req.Address, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryListFollowersResponse{Followers: followers, Pagination: pageRes}, nil
+}
+
+func (q queryServer) ListFollowing(ctx context.Context, req *types.QueryListFollowingRequest) (*types.QueryListFollowingResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	following, pageRes, err := paginatePairs(ctx, q.k.Follow, req.Address, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryListFollowingResponse{Following: following, Pagination: pageRes}, nil
+}
+
+func (q queryServer) GetFollowCounts(ctx context.Context, req *types.QueryGetFollowCountsRequest) (*types.QueryGetFollowCountsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	followers, following, err := q.k.GetFollowCounts(ctx, req.Address)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return &types.QueryGetFollowCountsResponse{Followers: followers, Following: following}, nil
+}
+
+func (q queryServer) ListBlocked(ctx context.Context, req *types.QueryListBlockedRequest) (*types.QueryListBlockedResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	blocked, pageRes, err := paginatePairs(ctx, q.k.Block, req.Address, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryListBlockedResponse{Blocked: blocked, Pagination: pageRes}, nil
+}
+
+// paginatePairs paginates the second keys of the pairs of set prefixed by
+// first.
+func paginatePairs(ctx context.Context, set collections.KeySet[collections.Pair[string, string]], first string, pageReq *query.PageRequest) ([]string, *query.PageResponse, error) {
+	return query.CollectionPaginate(
+		ctx,
+		set,
+		pageReq,
+		func(key collections.Pair[string, string], _ collections.NoValue) (string, error) {
+			return key.K2(), nil
+		},
+		query.WithCollectionPaginationPairPrefix[string, string](first),
+	)
+}
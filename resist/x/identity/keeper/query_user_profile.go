@@ -22,7 +22,7 @@ func (q queryServer) ListUserProfile(ctx context.Context, req *types.QueryAllUse
 		q.k.UserProfile,
 		req.Pagination,
 		func(_ string, value types.UserProfile) (types.UserProfile, error) {
-			return value, nil
+			return q.k.withVerified(ctx, value)
 		},
 	)
 	if err != nil {
@@ -45,6 +45,14 @@ func (q queryServer) GetUserProfile(ctx context.Context, req *types.QueryGetUser
 
 		return nil, status.Error(codes.Internal, "internal error")
 	}
+	val, err = q.k.withVerified(ctx, val)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+	domains, err := q.k.GetVerifiedDomains(ctx, val.Creator)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "internal error")
+	}
 
-	return &types.QueryGetUserProfileResponse{UserProfile: val}, nil
+	return &types.QueryGetUserProfileResponse{UserProfile: val, VerifiedDomains: domains}, nil
 }
@@ -21,7 +21,6 @@ func createNUserProfile(keeper keeper.Keeper, ctx context.Context, n int) []type
 		items[i].DisplayName = strconv.Itoa(i)
 		items[i].Bio = strconv.Itoa(i)
 		items[i].AvatarUrl = strconv.Itoa(i)
-		items[i].Verified = true
 		items[i].CreatedAt = int64(i)
 		_ = keeper.UserProfile.Set(ctx, items[i].Index, items[i])
 	}
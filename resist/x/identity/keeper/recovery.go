@@ -0,0 +1,222 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"slices"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"resist/x/identity/types"
+)
+
+// MigrateIdentity moves the identity of oldAddress to newAddress: its profile,
+// handle, guardians, the guardian roles it holds for other identities,
+// attestations, personas, follows and blocks, then notifies the identity hooks
+// so that other modules re-key their state. Key
+// control attestations stay with the old address, as they attest the old key.
+// A pending recovery of the identity is dropped.
+func (k Keeper) MigrateIdentity(ctx context.Context, oldAddress, newAddress string) error {
+	profile, err := k.UserProfile.Get(ctx, oldAddress)
+	if errors.Is(err, collections.ErrNotFound) {
+		return errorsmod.Wrapf(sdkerrors.ErrKeyNotFound, "no identity at %s", oldAddress)
+	} else if err != nil {
+		return err
+	}
+	if exists, err := k.UserProfile.Has(ctx, newAddress); err != nil {
+		return err
+	} else if exists {
+		return errorsmod.Wrapf(types.ErrIdentityExists, "%s", newAddress)
+	}
+	if exists, err := k.Persona.Has(ctx, newAddress); err != nil {
+		return err
+	} else if exists {
+		return errorsmod.Wrapf(types.ErrIdentityExists, "%s is a persona", newAddress)
+	}
+	if held, err := k.GetOwnerHandle(ctx, newAddress); err == nil {
+		return errorsmod.Wrapf(types.ErrIdentityExists, "%s holds @%s", newAddress, held.Name)
+	} else if !errors.Is(err, types.ErrHandleNotFound) {
+		return err
+	}
+
+	if err := k.UserProfile.Remove(ctx, oldAddress); err != nil {
+		return err
+	}
+	profile.Index = newAddress
+	profile.Creator = newAddress
+	if err := k.UserProfile.Set(ctx, newAddress, profile); err != nil {
+		return err
+	}
+
+	if handle, err := k.GetOwnerHandle(ctx, oldAddress); err == nil {
+		if err := k.RemoveHandle(ctx, handle); err != nil {
+			return err
+		}
+		handle.Owner = newAddress
+		if err := k.SetHandle(ctx, handle); err != nil {
+			return err
+		}
+	} else if !errors.Is(err, types.ErrHandleNotFound) {
+		return err
+	}
+
+	if err := k.migrateGuardians(ctx, oldAddress, newAddress); err != nil {
+		return err
+	}
+	if err := k.Recovery.Remove(ctx, oldAddress); err != nil {
+		return err
+	}
+	if err := k.migrateWards(ctx, oldAddress, newAddress); err != nil {
+		return err
+	}
+
+	if err := k.migrateAttestations(ctx, oldAddress, newAddress); err != nil {
+		return err
+	}
+	if err := k.migratePersonas(ctx, oldAddress, newAddress); err != nil {
+		return err
+	}
+	if err := k.migrateSocialGraph(ctx, oldAddress, newAddress); err != nil {
+		return err
+	}
+
+	if hooks := *k.hooks; hooks != nil {
+		return hooks.AfterIdentityMigrated(ctx, oldAddress, newAddress)
+	}
+	return nil
+}
+
+// setGuardians stores the guardians of an identity and indexes them by
+// guardian.
+func (k Keeper) setGuardians(ctx context.Context, guardians types.Guardians) error {
+	if err := k.removeGuardians(ctx, guardians.Address); err != nil {
+		return err
+	}
+	for _, guardian := range guardians.Guardians {
+		if err := k.GuardianWard.Set(ctx, collections.Join(guardian, guardians.Address)); err != nil {
+			return err
+		}
+	}
+	return k.Guardians.Set(ctx, guardians.Address, guardians)
+}
+
+// removeGuardians removes the guardians of address and their index entries.
+func (k Keeper) removeGuardians(ctx context.Context, address string) error {
+	guardians, err := k.Guardians.Get(ctx, address)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	for _, guardian := range guardians.Guardians {
+		if err := k.GuardianWard.Remove(ctx, collections.Join(guardian, address)); err != nil {
+			return err
+		}
+	}
+	return k.Guardians.Remove(ctx, address)
+}
+
+// migrateGuardians moves the guardians of oldAddress to newAddress. The new
+// address is removed from the guardians, as an identity cannot guard itself,
+// and the threshold lowered if needed.
+func (k Keeper) migrateGuardians(ctx context.Context, oldAddress, newAddress string) error {
+	guardians, err := k.Guardians.Get(ctx, oldAddress)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	if err := k.removeGuardians(ctx, oldAddress); err != nil {
+		return err
+	}
+
+	guardians.Address = newAddress
+	guardians.Guardians = slices.DeleteFunc(guardians.Guardians, func(guardian string) bool { return guardian == newAddress })
+	if len(guardians.Guardians) == 0 {
+		return nil
+	}
+	guardians.Threshold = min(guardians.Threshold, uint32(len(guardians.Guardians)))
+	return k.setGuardians(ctx, guardians)
+}
+
+// migrateWards replaces oldAddress with newAddress in the guardians of the
+// identities it guards, and in the approvals of their pending recovery, so
+// that the guardian keeps a single vote. An identity guarded by newAddress
+// already keeps it once, with the threshold lowered if needed.
+func (k Keeper) migrateWards(ctx context.Context, oldAddress, newAddress string) error {
+	var wards []string
+	if err := k.GuardianWard.Walk(ctx, collections.NewPrefixedPairRange[string, string](oldAddress), func(key collections.Pair[string, string]) (bool, error) {
+		wards = append(wards, key.K2())
+		return false, nil
+	}); err != nil {
+		return err
+	}
+
+	replace := func(addresses []string) []string {
+		replaced := make([]string, 0, len(addresses))
+		for _, address := range addresses {
+			if address == oldAddress {
+				address = newAddress
+			}
+			if !slices.Contains(replaced, address) {
+				replaced = append(replaced, address)
+			}
+		}
+		return replaced
+	}
+	for _, ward := range wards {
+		guardians, err := k.Guardians.Get(ctx, ward)
+		if err != nil {
+			return err
+		}
+		guardians.Guardians = replace(guardians.Guardians)
+		guardians.Threshold = min(guardians.Threshold, uint32(len(guardians.Guardians)))
+		if err := k.setGuardians(ctx, guardians); err != nil {
+			return err
+		}
+
+		recovery, err := k.Recovery.Get(ctx, ward)
+		if errors.Is(err, collections.ErrNotFound) {
+			continue
+		} else if err != nil {
+			return err
+		}
+		recovery.Approvals = replace(recovery.Approvals)
+		if err := k.Recovery.Set(ctx, ward, recovery); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// migrateAttestations re-subjects the attestations of oldAddress, except key
+// control attestations, to newAddress.
+func (k Keeper) migrateAttestations(ctx context.Context, oldAddress, newAddress string) error {
+	var attestations []types.Attestation
+	err := k.AttestationBySubject.Walk(ctx, collections.NewPrefixedPairRange[string, uint64](oldAddress), func(key collections.Pair[string, uint64]) (bool, error) {
+		attestation, err := k.Attestation.Get(ctx, key.K2())
+		if err != nil {
+			return true, err
+		}
+		if attestation.ClaimType != types.ClaimTypeKeyControl {
+			attestations = append(attestations, attestation)
+		}
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, attestation := range attestations {
+		if err := k.AttestationBySubject.Remove(ctx, collections.Join(oldAddress, attestation.Id)); err != nil {
+			return err
+		}
+		attestation.Subject = newAddress
+		if err := k.SetAttestation(ctx, attestation); err != nil {
+			return err
+		}
+	}
+	return nil
+}
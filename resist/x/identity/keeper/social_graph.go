@@ -0,0 +1,180 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+)
+
+// SetFollow makes follower follow followee, indexing the follow and counting
+// it. Following twice is a no-op.
+func (k Keeper) SetFollow(ctx context.Context, follower, followee string) error {
+	if exists, err := k.Follow.Has(ctx, collections.Join(follower, followee)); err != nil || exists {
+		return err
+	}
+	if err := k.Follow.Set(ctx, collections.Join(follower, followee)); err != nil {
+		return err
+	}
+	if err := k.Follower.Set(ctx, collections.Join(followee, follower)); err != nil {
+		return err
+	}
+	if err := addCount(ctx, k.FollowingCount, follower, 1); err != nil {
+		return err
+	}
+	return addCount(ctx, k.FollowerCount, followee, 1)
+}
+
+// RemoveFollow makes follower stop following followee. Removing a missing
+// follow is a no-op.
+func (k Keeper) RemoveFollow(ctx context.Context, follower, followee string) error {
+	if exists, err := k.Follow.Has(ctx, collections.Join(follower, followee)); err != nil || !exists {
+		return err
+	}
+	if err := k.Follow.Remove(ctx, collections.Join(follower, followee)); err != nil {
+		return err
+	}
+	if err := k.Follower.Remove(ctx, collections.Join(followee, follower)); err != nil {
+		return err
+	}
+	if err := addCount(ctx, k.FollowingCount, follower, -1); err != nil {
+		return err
+	}
+	return addCount(ctx, k.FollowerCount, followee, -1)
+}
+
+// SetBlock makes blocker block blocked, removing the follows between them.
+func (k Keeper) SetBlock(ctx context.Context, blocker, blocked string) error {
+	if err := k.Block.Set(ctx, collections.Join(blocker, blocked)); err != nil {
+		return err
+	}
+	if err := k.BlockedBy.Set(ctx, collections.Join(blocked, blocker)); err != nil {
+		return err
+	}
+	if err := k.RemoveFollow(ctx, blocker, blocked); err != nil {
+		return err
+	}
+	return k.RemoveFollow(ctx, blocked, blocker)
+}
+
+// RemoveBlock makes blocker unblock blocked.
+func (k Keeper) RemoveBlock(ctx context.Context, blocker, blocked string) error {
+	if err := k.Block.Remove(ctx, collections.Join(blocker, blocked)); err != nil {
+		return err
+	}
+	return k.BlockedBy.Remove(ctx, collections.Join(blocked, blocker))
+}
+
+// IsBlocked reports whether blocker blocks blocked.
+func (k Keeper) IsBlocked(ctx context.Context, blocker, blocked string) (bool, error) {
+	return k.Block.Has(ctx, collections.Join(blocker, blocked))
+}
+
+// GetFollowCounts returns the number of followers of address and the number
+// of addresses it follows.
+func (k Keeper) GetFollowCounts(ctx context.Context, address string) (followers, following uint64, err error) {
+	followers, err = getCount(ctx, k.FollowerCount, address)
+	if err != nil {
+		return 0, 0, err
+	}
+	following, err = getCount(ctx, k.FollowingCount, address)
+	return followers, following, err
+}
+
+// migrateSocialGraph moves the follows and blocks of oldAddress, in both
+// directions, to newAddress. Edges between oldAddress and newAddress are
+// dropped, as an identity cannot follow or block itself.
+func (k Keeper) migrateSocialGraph(ctx context.Context, oldAddress, newAddress string) error {
+	following, err := walkPairs(ctx, k.Follow, oldAddress)
+	if err != nil {
+		return err
+	}
+	for _, followee := range following {
+		if err := k.RemoveFollow(ctx, oldAddress, followee); err != nil {
+			return err
+		}
+		if followee != newAddress {
+			if err := k.SetFollow(ctx, newAddress, followee); err != nil {
+				return err
+			}
+		}
+	}
+	followers, err := walkPairs(ctx, k.Follower, oldAddress)
+	if err != nil {
+		return err
+	}
+	for _, follower := range followers {
+		if err := k.RemoveFollow(ctx, follower, oldAddress); err != nil {
+			return err
+		}
+		if follower != newAddress {
+			if err := k.SetFollow(ctx, follower, newAddress); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Blocks are moved after the follows, so that the follows between the
+	// new address and the accounts it now blocks, or is blocked by, are
+	// removed.
+	blocked, err := walkPairs(ctx, k.Block, oldAddress)
+	if err != nil {
+		return err
+	}
+	for _, address := range blocked {
+		if err := k.RemoveBlock(ctx, oldAddress, address); err != nil {
+			return err
+		}
+		if address != newAddress {
+			if err := k.SetBlock(ctx, newAddress, address); err != nil {
+				return err
+			}
+		}
+	}
+	blockers, err := walkPairs(ctx, k.BlockedBy, oldAddress)
+	if err != nil {
+		return err
+	}
+	for _, blocker := range blockers {
+		if err := k.RemoveBlock(ctx, blocker, oldAddress); err != nil {
+			return err
+		}
+		if blocker != newAddress {
+			if err := k.SetBlock(ctx, blocker, newAddress); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// walkPairs returns the second keys of the pairs of set prefixed by first.
+func walkPairs(ctx context.Context, set collections.KeySet[collections.Pair[string, string]], first string) ([]string, error) {
+	var seconds []string
+	err := set.Walk(ctx, collections.NewPrefixedPairRange[string, string](first), func(key collections.Pair[string, string]) (bool, error) {
+		seconds = append(seconds, key.K2())
+		return false, nil
+	})
+	return seconds, err
+}
+
+func getCount(ctx context.Context, counts collections.Map[string, uint64], address string) (uint64, error) {
+	count, err := counts.Get(ctx, address)
+	if errors.Is(err, collections.ErrNotFound) {
+		return 0, nil
+	}
+	return count, err
+}
+
+// addCount adds delta to the count of address, removing counts reaching zero.
+func addCount(ctx context.Context, counts collections.Map[string, uint64], address string, delta int64) error {
+	count, err := getCount(ctx, counts, address)
+	if err != nil {
+		return err
+	}
+	count = uint64(int64(count) + delta)
+	if count == 0 {
+		return counts.Remove(ctx, address)
+	}
+	return counts.Set(ctx, address, count)
+}
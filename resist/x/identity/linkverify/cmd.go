@@ -0,0 +1,77 @@
+package linkverify
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+
+	"resist/x/identity/types"
+)
+
+const (
+	// FlagExpiresIn is the validity of the domain attestations, after which
+	// the domain must be verified again.
+	FlagExpiresIn = "expires-in"
+	// FlagTimeout bounds the time to fetch the well-known document.
+	FlagTimeout = "timeout"
+)
+
+// VerifyLinkCmd returns the command an issuer of domain attestations runs to
+// check that an account published its link token on a domain, and to attest
+// the domain if so.
+func VerifyLinkCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "verify-link [address] [domain]",
+		Short: "Check the link token of an account on a domain and attest the domain",
+		Long: fmt.Sprintf(`Check that an account published its link token on a domain, in the %s
+document or in a %s<domain> DNS TXT record prefixed with %q, and broadcast a
+domain attestation of the account signed by the issuer.`, WellKnownPath, TXTRecordLabel, TXTRecordPrefix),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			domain, err := types.NormalizeDomain(args[1])
+			if err != nil {
+				return err
+			}
+			expiresIn, err := cmd.Flags().GetDuration(FlagExpiresIn)
+			if err != nil {
+				return err
+			}
+			timeout, err := cmd.Flags().GetDuration(FlagTimeout)
+			if err != nil {
+				return err
+			}
+
+			verifier := NewVerifier(&http.Client{Timeout: timeout}, net.DefaultResolver)
+			method, err := verifier.Verify(cmd.Context(), clientCtx.ChainID, args[0], domain)
+			if err != nil {
+				return err
+			}
+			cmd.PrintErrf("found link token of %s on %s (%s)\n", args[0], domain, method)
+
+			msg := &types.MsgAttest{
+				Issuer:    clientCtx.GetFromAddress().String(),
+				Subject:   args[0],
+				ClaimType: types.ClaimTypeDomain,
+				Evidence:  domain,
+				ExpiresAt: time.Now().Add(expiresIn).Unix(),
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Duration(FlagExpiresIn, 90*24*time.Hour, "Validity of the domain attestation")
+	cmd.Flags().Duration(FlagTimeout, 10*time.Second, "Timeout of the well-known document request")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
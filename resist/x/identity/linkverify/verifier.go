@@ -0,0 +1,123 @@
+// Package linkverify checks that an account controls a domain, for issuers of
+// domain attestations. The account publishes its link token, see
+// types.LinkToken, in the well-known document of the domain or in a DNS TXT
+// record, and the issuer attests the domain once the token is found.
+package linkverify
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strings"
+
+	"resist/x/identity/types"
+)
+
+const (
+	// WellKnownPath is the path of the document listing the link tokens
+	// published on a domain.
+	WellKnownPath = "/.well-known/resist.json"
+	// TXTRecordLabel is prepended to a domain to name the DNS TXT records
+	// holding its link tokens.
+	TXTRecordLabel = "_resist."
+	// TXTRecordPrefix prefixes the link tokens in DNS TXT records.
+	TXTRecordPrefix = "resist-verification="
+)
+
+// Verification methods
+const (
+	MethodWellKnown = "well-known"
+	MethodDNS       = "dns"
+)
+
+// maxDocumentBytes bounds the size of well-known documents.
+const maxDocumentBytes = 64 << 10
+
+// ErrTokenNotFound is returned when the link token is published neither in the
+// well-known document nor in the DNS TXT records of the domain.
+var ErrTokenNotFound = errors.New("link token not found")
+
+// WellKnown is the well-known document of a domain.
+type WellKnown struct {
+	Tokens []string `json:"tokens"`
+}
+
+// Resolver looks up DNS TXT records, such as net.Resolver.
+type Resolver interface {
+	LookupTXT(ctx context.Context, name string) ([]string, error)
+}
+
+// Verifier looks up link tokens on domains.
+type Verifier struct {
+	client   *http.Client
+	resolver Resolver
+	// baseURL returns the URL of a domain, to which WellKnownPath is appended.
+	baseURL func(domain string) string
+}
+
+// NewVerifier returns a Verifier fetching well-known documents with client
+// and DNS TXT records with resolver.
+func NewVerifier(client *http.Client, resolver Resolver) *Verifier {
+	return &Verifier{
+		client:   client,
+		resolver: resolver,
+		baseURL:  func(domain string) string { return "https://" + domain },
+	}
+}
+
+// Verify checks that address published its link token for chainID on domain,
+// which must be normalized, and returns the method it was found with.
+func (v *Verifier) Verify(ctx context.Context, chainID, address, domain string) (string, error) {
+	token := types.LinkToken(chainID, address, domain)
+
+	wellKnownErr := v.checkWellKnown(ctx, domain, token)
+	if wellKnownErr == nil {
+		return MethodWellKnown, nil
+	}
+	dnsErr := v.checkDNS(ctx, domain, token)
+	if dnsErr == nil {
+		return MethodDNS, nil
+	}
+	return "", fmt.Errorf("%w on %s: %s: %v; %s: %v", ErrTokenNotFound, domain, MethodWellKnown, wellKnownErr, MethodDNS, dnsErr)
+}
+
+func (v *Verifier) checkWellKnown(ctx context.Context, domain, token string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, v.baseURL(domain)+WellKnownPath, nil)
+	if err != nil {
+		return err
+	}
+	res, err := v.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status %s", res.Status)
+	}
+
+	var doc WellKnown
+	if err := json.NewDecoder(io.LimitReader(res.Body, maxDocumentBytes)).Decode(&doc); err != nil {
+		return fmt.Errorf("invalid document: %w", err)
+	}
+	if !slices.Contains(doc.Tokens, token) {
+		return ErrTokenNotFound
+	}
+	return nil
+}
+
+func (v *Verifier) checkDNS(ctx context.Context, domain, token string) error {
+	records, err := v.resolver.LookupTXT(ctx, TXTRecordLabel+domain)
+	if err != nil {
+		return err
+	}
+	for _, record := range records {
+		if value, ok := strings.CutPrefix(strings.TrimSpace(record), TXTRecordPrefix); ok && value == token {
+			return nil
+		}
+	}
+	return ErrTokenNotFound
+}
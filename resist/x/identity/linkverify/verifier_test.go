@@ -0,0 +1,61 @@
+package linkverify
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"resist/x/identity/types"
+)
+
+type txtRecords map[string][]string
+
+func (r txtRecords) LookupTXT(_ context.Context, name string) ([]string, error) {
+	records, ok := r[name]
+	if !ok {
+		return nil, errors.New("no such host")
+	}
+	return records, nil
+}
+
+func TestVerify(t *testing.T) {
+	const chainID, address, domain = "resist-1", "resist1alice", "example.org"
+	token := types.LinkToken(chainID, address, domain)
+
+	var doc *WellKnown
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if doc == nil || r.URL.Path != WellKnownPath {
+			http.NotFound(w, r)
+			return
+		}
+		require.NoError(t, json.NewEncoder(w).Encode(doc))
+	}))
+	defer server.Close()
+
+	records := txtRecords{}
+	verifier := NewVerifier(server.Client(), records)
+	verifier.baseURL = func(string) string { return server.URL }
+
+	_, err := verifier.Verify(context.Background(), chainID, address, domain)
+	require.ErrorIs(t, err, ErrTokenNotFound)
+
+	// The token is bound to the chain, the address and the domain
+	records[TXTRecordLabel+domain] = []string{"v=spf1 -all", token, TXTRecordPrefix + types.LinkToken("other-1", address, domain)}
+	_, err = verifier.Verify(context.Background(), chainID, address, domain)
+	require.ErrorIs(t, err, ErrTokenNotFound)
+
+	records[TXTRecordLabel+domain] = append(records[TXTRecordLabel+domain], TXTRecordPrefix+token)
+	method, err := verifier.Verify(context.Background(), chainID, address, domain)
+	require.NoError(t, err)
+	require.Equal(t, MethodDNS, method)
+
+	doc = &WellKnown{Tokens: []string{token}}
+	method, err = verifier.Verify(context.Background(), chainID, address, domain)
+	require.NoError(t, err)
+	require.Equal(t, MethodWellKnown, method)
+}
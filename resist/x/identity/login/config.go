@@ -0,0 +1,106 @@
+package login
+
+import (
+	"fmt"
+	"time"
+
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/spf13/cast"
+)
+
+// Config defines the [login] section of app.toml.
+type Config struct {
+	// Enabled issues session tokens to the accounts signing in to this hub.
+	// Tokens issued by other hubs are verified even when it is disabled.
+	Enabled bool `mapstructure:"enabled"`
+	// NodeID is the x/rewards node id of this hub.
+	NodeID string `mapstructure:"node-id"`
+	// KeyName is the keyring key of the node owner, used to sign session tokens.
+	KeyName string `mapstructure:"key-name"`
+	// KeyringBackend is the backend of the keyring holding KeyName.
+	KeyringBackend string `mapstructure:"keyring-backend"`
+	// ChallengeTTL is the time an account has to sign a login challenge.
+	ChallengeTTL time.Duration `mapstructure:"challenge-ttl"`
+	// TokenTTL is the lifetime of a session token.
+	TokenTTL time.Duration `mapstructure:"token-ttl"`
+}
+
+// DefaultConfig returns the default login configuration, with sign-in disabled.
+func DefaultConfig() Config {
+	return Config{
+		Enabled:        false,
+		KeyringBackend: "os",
+		ChallengeTTL:   5 * time.Minute,
+		TokenTTL:       time.Hour,
+	}
+}
+
+// DefaultConfigTemplate is the app.toml template of the [login] section. It is
+// meant to be appended to the server configuration template.
+const DefaultConfigTemplate = `
+###############################################################################
+###                           Login Configuration                           ###
+###############################################################################
+
+[login]
+
+# Enabled lets accounts sign in to this hub with an off-chain signed challenge
+# and issues them session tokens. It requires the API server to be enabled.
+enabled = {{ .Login.Enabled }}
+
+# x/rewards node id of this hub.
+node-id = "{{ .Login.NodeID }}"
+
+# Keyring key of the node owner, used to sign session tokens.
+key-name = "{{ .Login.KeyName }}"
+
+# Backend of the keyring holding key-name, in the node home directory.
+keyring-backend = "{{ .Login.KeyringBackend }}"
+
+# Time an account has to sign a login challenge.
+challenge-ttl = "{{ .Login.ChallengeTTL }}"
+
+# Lifetime of a session token.
+token-ttl = "{{ .Login.TokenTTL }}"
+`
+
+// ReadConfig reads the [login] section from the application options.
+func ReadConfig(appOpts servertypes.AppOptions) Config {
+	cfg := DefaultConfig()
+	if v := appOpts.Get("login.enabled"); v != nil {
+		cfg.Enabled = cast.ToBool(v)
+	}
+	if v := appOpts.Get("login.node-id"); v != nil {
+		cfg.NodeID = cast.ToString(v)
+	}
+	if v := appOpts.Get("login.key-name"); v != nil {
+		cfg.KeyName = cast.ToString(v)
+	}
+	if v := appOpts.Get("login.keyring-backend"); v != nil {
+		cfg.KeyringBackend = cast.ToString(v)
+	}
+	if v := appOpts.Get("login.challenge-ttl"); v != nil {
+		cfg.ChallengeTTL = cast.ToDuration(v)
+	}
+	if v := appOpts.Get("login.token-ttl"); v != nil {
+		cfg.TokenTTL = cast.ToDuration(v)
+	}
+	return cfg
+}
+
+// Validate checks that an enabled configuration is complete.
+func (cfg Config) Validate() error {
+	if !cfg.Enabled {
+		return nil
+	}
+	if cfg.NodeID == "" {
+		return fmt.Errorf("login.node-id is required")
+	}
+	if cfg.KeyName == "" {
+		return fmt.Errorf("login.key-name is required")
+	}
+	if cfg.ChallengeTTL <= 0 || cfg.TokenTTL <= 0 {
+		return fmt.Errorf("login ttls must be positive")
+	}
+	return nil
+}
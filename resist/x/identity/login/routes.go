@@ -0,0 +1,121 @@
+package login
+
+import (
+	"encoding/json"
+	"errors"
+	"net"
+	"net/http"
+
+	"github.com/gorilla/mux"
+)
+
+// maxRequestBytes bounds the size of login request bodies.
+const maxRequestBytes = 16 << 10
+
+type challengeRequest struct {
+	Address string `json:"address"`
+}
+
+type loginRequest struct {
+	Address   string `json:"address"`
+	PubKey    []byte `json:"pub_key"`
+	Nonce     string `json:"nonce"`
+	Signature []byte `json:"signature"`
+}
+
+type loginResponse struct {
+	Token   string  `json:"token"`
+	Session Session `json:"session"`
+}
+
+type verifyRequest struct {
+	Token string `json:"token"`
+}
+
+// RegisterRoutes serves the login endpoints under /resist/identity/v1/login.
+// Tokens are verified with verifier; challenges are issued and signed in only
+// when service is not nil.
+func RegisterRoutes(router *mux.Router, service *Service, verifier *Verifier) {
+	router.HandleFunc("/resist/identity/v1/login/verify", func(w http.ResponseWriter, r *http.Request) {
+		var req verifyRequest
+		if !decodeRequest(w, r, &req) {
+			return
+		}
+		session, err := verifier.Verify(r.Context(), req.Token)
+		if errors.Is(err, ErrInvalidToken) || errors.Is(err, ErrTokenExpired) {
+			http.Error(w, err.Error(), http.StatusUnauthorized)
+			return
+		}
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		writeResponse(w, session)
+	}).Methods(http.MethodPost)
+
+	if service == nil {
+		return
+	}
+
+	router.HandleFunc("/resist/identity/v1/login/challenge", func(w http.ResponseWriter, r *http.Request) {
+		var req challengeRequest
+		if !decodeRequest(w, r, &req) {
+			return
+		}
+		challenge, err := service.Challenge(clientHost(r), req.Address)
+		if errors.Is(err, ErrTooManyChallenges) {
+			http.Error(w, err.Error(), http.StatusServiceUnavailable)
+			return
+		}
+		if errors.Is(err, ErrTooManyClientChallenges) {
+			http.Error(w, err.Error(), http.StatusTooManyRequests)
+			return
+		}
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		writeResponse(w, challenge)
+	}).Methods(http.MethodPost)
+
+	router.HandleFunc("/resist/identity/v1/login", func(w http.ResponseWriter, r *http.Request) {
+		var req loginRequest
+		if !decodeRequest(w, r, &req) {
+			return
+		}
+		token, session, err := service.Login(req.Address, req.PubKey, req.Nonce, req.Signature)
+		if errors.Is(err, ErrChallengeNotFound) || errors.Is(err, ErrInvalidSignature) {
+			http.Error(w, err.Error(), http.StatusUnauthorized)
+			return
+		}
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		writeResponse(w, loginResponse{Token: token, Session: session})
+	}).Methods(http.MethodPost)
+}
+
+// clientHost returns the host a request comes from.
+func clientHost(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
+
+func decodeRequest(w http.ResponseWriter, r *http.Request, req any) bool {
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxRequestBytes)).Decode(req); err != nil {
+		http.Error(w, "invalid request body: "+err.Error(), http.StatusBadRequest)
+		return false
+	}
+	return true
+}
+
+func writeResponse(w http.ResponseWriter, resp any) {
+	// Challenges and sessions are specific to the caller.
+	w.Header().Set("Cache-Control", "no-store")
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(resp)
+}
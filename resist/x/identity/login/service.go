@@ -0,0 +1,209 @@
+package login
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"cosmossdk.io/core/address"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+)
+
+// Limits on the challenges that were issued but not signed yet. The global
+// limit bounds the memory they hold, and the limits per client and per
+// account keep a single caller from using it all.
+const (
+	maxPendingChallenges           = 10000
+	maxPendingChallengesPerClient  = 16
+	maxPendingChallengesPerAddress = 4
+)
+
+// nonceSize is the size in bytes of a challenge nonce.
+const nonceSize = 32
+
+var (
+	// ErrChallengeNotFound is returned when signing in with an unknown,
+	// already used or expired challenge.
+	ErrChallengeNotFound = errors.New("login challenge not found")
+	// ErrTooManyChallenges is returned when too many challenges are pending.
+	ErrTooManyChallenges = errors.New("too many pending login challenges")
+	// ErrTooManyClientChallenges is returned when too many challenges are
+	// pending for the client or the account.
+	ErrTooManyClientChallenges = errors.New("too many pending login challenges for this client or account")
+	// ErrInvalidSignature is returned when the challenge signature does not
+	// match the account.
+	ErrInvalidSignature = errors.New("invalid challenge signature")
+)
+
+// Challenge is the data an account signs to sign in to a hub. Challenges are
+// kept in memory by the hub that issued them and are never written on-chain.
+type Challenge struct {
+	Address   string `json:"address"`
+	Nonce     string `json:"nonce"`
+	Data      string `json:"data"`
+	ExpiresAt int64  `json:"expires_at"`
+}
+
+// Service issues login challenges and exchanges their signature for a session
+// token signed by the hub.
+type Service struct {
+	nodeID       string
+	signer       Signer
+	addressCodec address.Codec
+	challengeTTL time.Duration
+	tokenTTL     time.Duration
+	now          func() time.Time
+
+	mu         sync.Mutex
+	challenges map[string]pendingChallenge
+	// issued holds the nonces of the challenges in the order they were
+	// issued, which is also the order they expire in. Nonces of signed
+	// challenges are dropped once they reach the front.
+	issued    []string
+	byClient  map[string]int
+	byAddress map[string]int
+}
+
+// pendingChallenge is a challenge issued to client and not signed yet.
+type pendingChallenge struct {
+	Challenge
+	client string
+}
+
+// NewService returns a Service for the hub nodeID, signing session tokens
+// with signer.
+func NewService(nodeID string, signer Signer, addressCodec address.Codec, challengeTTL, tokenTTL time.Duration) *Service {
+	return &Service{
+		nodeID:       nodeID,
+		signer:       signer,
+		addressCodec: addressCodec,
+		challengeTTL: challengeTTL,
+		tokenTTL:     tokenTTL,
+		now:          time.Now,
+		challenges:   make(map[string]pendingChallenge),
+		byClient:     make(map[string]int),
+		byAddress:    make(map[string]int),
+	}
+}
+
+// Challenge issues a single use challenge for addr to client, the host the
+// request comes from.
+func (s *Service) Challenge(client, addr string) (Challenge, error) {
+	if _, err := s.addressCodec.StringToBytes(addr); err != nil {
+		return Challenge{}, fmt.Errorf("invalid address: %w", err)
+	}
+	nonce := make([]byte, nonceSize)
+	if _, err := rand.Read(nonce); err != nil {
+		return Challenge{}, err
+	}
+
+	now := s.now()
+	expiresAt := now.Add(s.challengeTTL)
+	challenge := Challenge{
+		Address: addr,
+		Nonce:   hex.EncodeToString(nonce),
+		Data: fmt.Sprintf(
+			"Sign in to Resist hub %s\nAccount: %s\nNonce: %s\nExpires: %s",
+			s.nodeID, addr, hex.EncodeToString(nonce), expiresAt.UTC().Format(time.RFC3339),
+		),
+		ExpiresAt: expiresAt.Unix(),
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.evictExpired(now)
+	if len(s.challenges) >= maxPendingChallenges {
+		return Challenge{}, ErrTooManyChallenges
+	}
+	if s.byClient[client] >= maxPendingChallengesPerClient || s.byAddress[addr] >= maxPendingChallengesPerAddress {
+		return Challenge{}, ErrTooManyClientChallenges
+	}
+	s.challenges[challenge.Nonce] = pendingChallenge{Challenge: challenge, client: client}
+	s.issued = append(s.issued, challenge.Nonce)
+	s.byClient[client]++
+	s.byAddress[addr]++
+	return challenge, nil
+}
+
+// evictExpired removes the challenges expired at now. The caller must hold
+// s.mu.
+func (s *Service) evictExpired(now time.Time) {
+	n := 0
+	for _, nonce := range s.issued {
+		pending, ok := s.challenges[nonce]
+		if ok && now.Unix() < pending.ExpiresAt {
+			break
+		}
+		s.remove(nonce)
+		n++
+	}
+	s.issued = s.issued[n:]
+}
+
+// remove removes the challenge with nonce, if pending, and returns it. The
+// caller must hold s.mu.
+func (s *Service) remove(nonce string) (pendingChallenge, bool) {
+	pending, ok := s.challenges[nonce]
+	if !ok {
+		return pendingChallenge{}, false
+	}
+	delete(s.challenges, nonce)
+	decrement(s.byClient, pending.client)
+	decrement(s.byAddress, pending.Address)
+	return pending, true
+}
+
+// decrement decrements counts[key], deleting it when it reaches zero.
+func decrement(counts map[string]int, key string) {
+	if counts[key] <= 1 {
+		delete(counts, key)
+	} else {
+		counts[key]--
+	}
+}
+
+// Login checks the ADR-036 signature of the challenge nonce by the account
+// addr, made with pubKey, and returns a session token for the account. The
+// challenge is consumed whether the signature is valid or not.
+func (s *Service) Login(addr string, pubKey []byte, nonce string, signature []byte) (string, Session, error) {
+	s.mu.Lock()
+	challenge, ok := s.remove(nonce)
+	s.mu.Unlock()
+
+	now := s.now()
+	if !ok || challenge.Address != addr || now.Unix() >= challenge.ExpiresAt {
+		return "", Session{}, ErrChallengeNotFound
+	}
+
+	if len(pubKey) != secp256k1.PubKeySize {
+		return "", Session{}, ErrInvalidSignature
+	}
+	key := &secp256k1.PubKey{Key: pubKey}
+	signer, err := s.addressCodec.BytesToString(key.Address())
+	if err != nil {
+		return "", Session{}, err
+	}
+	if signer != addr || !key.VerifySignature(SignBytes(addr, []byte(challenge.Data)), signature) {
+		return "", Session{}, ErrInvalidSignature
+	}
+
+	hubKey, err := s.signer.PubKey()
+	if err != nil {
+		return "", Session{}, err
+	}
+	session := Session{
+		Address:   addr,
+		NodeID:    s.nodeID,
+		HubKey:    hubKey,
+		IssuedAt:  now.Unix(),
+		ExpiresAt: now.Add(s.tokenTTL).Unix(),
+	}
+	token, err := encodeToken(s.signer, session)
+	if err != nil {
+		return "", Session{}, err
+	}
+	return token, session, nil
+}
@@ -0,0 +1,213 @@
+package login
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+type keySigner struct {
+	key *secp256k1.PrivKey
+}
+
+func (s keySigner) PubKey() ([]byte, error) {
+	return s.key.PubKey().Bytes(), nil
+}
+
+func (s keySigner) Sign(msg []byte) ([]byte, error) {
+	return s.key.Sign(msg)
+}
+
+func TestSignBytes(t *testing.T) {
+	require.Equal(t,
+		`{"account_number":"0","chain_id":"","fee":{"amount":[],"gas":"0"},"memo":"","msgs":[{"type":"sign/MsgSignData","value":{"data":"aGVsbG8=","signer":"cosmos1signer"}}],"sequence":"0"}`,
+		string(SignBytes("cosmos1signer", []byte("hello"))),
+	)
+}
+
+func TestLogin(t *testing.T) {
+	addressCodec := addresscodec.NewBech32Codec(sdk.GetConfig().GetBech32AccountAddrPrefix())
+	now := time.Unix(1_700_000_000, 0)
+
+	hubKey := secp256k1.GenPrivKey()
+	hubOwner, err := addressCodec.BytesToString(hubKey.PubKey().Address())
+	require.NoError(t, err)
+	owners := map[string]string{"hub-1": hubOwner}
+
+	service := NewService("hub-1", keySigner{key: hubKey}, addressCodec, time.Minute, time.Hour)
+	service.now = func() time.Time { return now }
+	verifier := NewVerifier(NodeOwnersFunc(func(_ context.Context, nodeId string) (string, error) {
+		owner, ok := owners[nodeId]
+		if !ok {
+			return "", errors.New("node not found")
+		}
+		return owner, nil
+	}), addressCodec)
+	verifier.now = func() time.Time { return now }
+
+	accountKey := secp256k1.GenPrivKey()
+	account, err := addressCodec.BytesToString(accountKey.PubKey().Address())
+	require.NoError(t, err)
+	sign := func(challenge Challenge) []byte {
+		signature, err := accountKey.Sign(SignBytes(account, []byte(challenge.Data)))
+		require.NoError(t, err)
+		return signature
+	}
+
+	t.Run("invalid address", func(t *testing.T) {
+		_, err := service.Challenge("client", "invalid")
+		require.Error(t, err)
+	})
+
+	t.Run("sign in", func(t *testing.T) {
+		challenge, err := service.Challenge("client", account)
+		require.NoError(t, err)
+		require.Contains(t, challenge.Data, challenge.Nonce)
+
+		token, session, err := service.Login(account, accountKey.PubKey().Bytes(), challenge.Nonce, sign(challenge))
+		require.NoError(t, err)
+		require.Equal(t, Session{
+			Address:   account,
+			NodeID:    "hub-1",
+			HubKey:    hubKey.PubKey().Bytes(),
+			IssuedAt:  now.Unix(),
+			ExpiresAt: now.Add(time.Hour).Unix(),
+		}, session)
+
+		verified, err := verifier.Verify(context.Background(), token)
+		require.NoError(t, err)
+		require.Equal(t, session, verified)
+
+		// Challenges are single use.
+		_, _, err = service.Login(account, accountKey.PubKey().Bytes(), challenge.Nonce, sign(challenge))
+		require.ErrorIs(t, err, ErrChallengeNotFound)
+	})
+
+	t.Run("invalid signature", func(t *testing.T) {
+		challenge, err := service.Challenge("client", account)
+		require.NoError(t, err)
+		otherKey := secp256k1.GenPrivKey()
+		signature, err := otherKey.Sign(SignBytes(account, []byte(challenge.Data)))
+		require.NoError(t, err)
+
+		_, _, err = service.Login(account, otherKey.PubKey().Bytes(), challenge.Nonce, signature)
+		require.ErrorIs(t, err, ErrInvalidSignature)
+		// A failed attempt consumes the challenge.
+		_, _, err = service.Login(account, accountKey.PubKey().Bytes(), challenge.Nonce, sign(challenge))
+		require.ErrorIs(t, err, ErrChallengeNotFound)
+	})
+
+	t.Run("raw signature", func(t *testing.T) {
+		challenge, err := service.Challenge("client", account)
+		require.NoError(t, err)
+		signature, err := accountKey.Sign([]byte(challenge.Data))
+		require.NoError(t, err)
+
+		_, _, err = service.Login(account, accountKey.PubKey().Bytes(), challenge.Nonce, signature)
+		require.ErrorIs(t, err, ErrInvalidSignature)
+	})
+
+	t.Run("expired challenge", func(t *testing.T) {
+		challenge, err := service.Challenge("client", account)
+		require.NoError(t, err)
+		service.now = func() time.Time { return now.Add(time.Minute) }
+		defer func() { service.now = func() time.Time { return now } }()
+
+		_, _, err = service.Login(account, accountKey.PubKey().Bytes(), challenge.Nonce, sign(challenge))
+		require.ErrorIs(t, err, ErrChallengeNotFound)
+	})
+
+	t.Run("invalid token", func(t *testing.T) {
+		challenge, err := service.Challenge("client", account)
+		require.NoError(t, err)
+		token, _, err := service.Login(account, accountKey.PubKey().Bytes(), challenge.Nonce, sign(challenge))
+		require.NoError(t, err)
+
+		_, err = verifier.Verify(context.Background(), "invalid")
+		require.ErrorIs(t, err, ErrInvalidToken)
+		_, err = verifier.Verify(context.Background(), "A"+token)
+		require.ErrorIs(t, err, ErrInvalidToken)
+
+		verifier.now = func() time.Time { return now.Add(time.Hour) }
+		_, err = verifier.Verify(context.Background(), token)
+		require.ErrorIs(t, err, ErrTokenExpired)
+		verifier.now = func() time.Time { return now }
+
+		// Tokens are revoked along with the ownership of the hub.
+		owners["hub-1"] = account
+		_, err = verifier.Verify(context.Background(), token)
+		require.ErrorIs(t, err, ErrInvalidToken)
+		delete(owners, "hub-1")
+		_, err = verifier.Verify(context.Background(), token)
+		require.ErrorIs(t, err, ErrInvalidToken)
+		owners["hub-1"] = hubOwner
+	})
+
+	t.Run("forged token", func(t *testing.T) {
+		forger := secp256k1.GenPrivKey()
+		token, err := encodeToken(keySigner{key: forger}, Session{
+			Address:   account,
+			NodeID:    "hub-1",
+			HubKey:    forger.PubKey().Bytes(),
+			IssuedAt:  now.Unix(),
+			ExpiresAt: now.Add(time.Hour).Unix(),
+		})
+		require.NoError(t, err)
+
+		_, err = verifier.Verify(context.Background(), token)
+		require.ErrorIs(t, err, ErrInvalidToken)
+	})
+}
+
+func TestChallengeLimits(t *testing.T) {
+	addressCodec := addresscodec.NewBech32Codec(sdk.GetConfig().GetBech32AccountAddrPrefix())
+	now := time.Unix(1_700_000_000, 0)
+	service := NewService("hub-1", keySigner{key: secp256k1.GenPrivKey()}, addressCodec, time.Minute, time.Hour)
+	service.now = func() time.Time { return now }
+
+	newAccount := func() string {
+		account, err := addressCodec.BytesToString(secp256k1.GenPrivKey().PubKey().Address())
+		require.NoError(t, err)
+		return account
+	}
+
+	// An account has a few pending challenges at most, whatever the client
+	account := newAccount()
+	var nonces []string
+	for range maxPendingChallengesPerAddress {
+		challenge, err := service.Challenge("client-1", account)
+		require.NoError(t, err)
+		nonces = append(nonces, challenge.Nonce)
+	}
+	_, err := service.Challenge("client-2", account)
+	require.ErrorIs(t, err, ErrTooManyClientChallenges)
+
+	// Consumed challenges no longer count
+	_, _, err = service.Login(account, nil, nonces[0], nil)
+	require.ErrorIs(t, err, ErrInvalidSignature)
+	_, err = service.Challenge("client-2", account)
+	require.NoError(t, err)
+
+	// A client cannot fill the pending challenges with new accounts
+	for service.byClient["client-1"] < maxPendingChallengesPerClient {
+		_, err := service.Challenge("client-1", newAccount())
+		require.NoError(t, err)
+	}
+	_, err = service.Challenge("client-1", newAccount())
+	require.ErrorIs(t, err, ErrTooManyClientChallenges)
+	_, err = service.Challenge("client-3", newAccount())
+	require.NoError(t, err)
+
+	// Expired challenges are evicted before the limits are checked
+	service.now = func() time.Time { return now.Add(time.Minute) }
+	_, err = service.Challenge("client-1", account)
+	require.NoError(t, err)
+	require.Len(t, service.challenges, 1)
+	require.Equal(t, map[string]int{"client-1": 1}, service.byClient)
+}
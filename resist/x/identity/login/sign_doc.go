@@ -0,0 +1,54 @@
+package login
+
+import (
+	"encoding/json"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// signDoc is the amino JSON sign doc of ADR-036 arbitrary data. It is the
+// document signed by wallets implementing signArbitrary, so the chain id,
+// account number, sequence, fee and memo are left empty.
+type signDoc struct {
+	AccountNumber string    `json:"account_number"`
+	ChainID       string    `json:"chain_id"`
+	Fee           signFee   `json:"fee"`
+	Memo          string    `json:"memo"`
+	Msgs          []signMsg `json:"msgs"`
+	Sequence      string    `json:"sequence"`
+}
+
+type signFee struct {
+	Amount sdk.Coins `json:"amount"`
+	Gas    string    `json:"gas"`
+}
+
+type signMsg struct {
+	Type  string      `json:"type"`
+	Value signMsgData `json:"value"`
+}
+
+type signMsgData struct {
+	Data   []byte `json:"data"`
+	Signer string `json:"signer"`
+}
+
+// SignBytes returns the ADR-036 sign bytes of data signed by signer. Since
+// the sign doc is not a valid transaction, the signature can never be
+// replayed on-chain.
+func SignBytes(signer string, data []byte) []byte {
+	doc := signDoc{
+		AccountNumber: "0",
+		Fee:           signFee{Amount: sdk.Coins{}, Gas: "0"},
+		Msgs: []signMsg{{
+			Type:  "sign/MsgSignData",
+			Value: signMsgData{Data: data, Signer: signer},
+		}},
+		Sequence: "0",
+	}
+	bz, err := json.Marshal(doc)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(bz)
+}
@@ -0,0 +1,157 @@
+package login
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"cosmossdk.io/core/address"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+var (
+	// ErrInvalidToken is returned for malformed or forged session tokens.
+	ErrInvalidToken = errors.New("invalid session token")
+	// ErrTokenExpired is returned for session tokens past their expiry.
+	ErrTokenExpired = errors.New("session token expired")
+)
+
+// Session is the content of a session token: the account that signed in, the
+// hub it signed in to and the key of the hub node owner signing the token.
+type Session struct {
+	Address   string `json:"address"`
+	NodeID    string `json:"node_id"`
+	HubKey    []byte `json:"hub_key"`
+	IssuedAt  int64  `json:"issued_at"`
+	ExpiresAt int64  `json:"expires_at"`
+}
+
+// Signer signs session tokens with the key of the hub node owner.
+type Signer interface {
+	// PubKey returns the secp256k1 public key of the node owner.
+	PubKey() ([]byte, error)
+	// Sign returns the signature of msg by the node owner.
+	Sign(msg []byte) ([]byte, error)
+}
+
+type keyringSigner struct {
+	kr      keyring.Keyring
+	keyName string
+}
+
+// NewKeyringSigner returns a Signer signing with the keyName key of kr.
+func NewKeyringSigner(kr keyring.Keyring, keyName string) Signer {
+	return keyringSigner{kr: kr, keyName: keyName}
+}
+
+func (s keyringSigner) PubKey() ([]byte, error) {
+	record, err := s.kr.Key(s.keyName)
+	if err != nil {
+		return nil, err
+	}
+	pubKey, err := record.GetPubKey()
+	if err != nil {
+		return nil, err
+	}
+	return pubKey.Bytes(), nil
+}
+
+func (s keyringSigner) Sign(msg []byte) ([]byte, error) {
+	signature, _, err := s.kr.Sign(s.keyName, msg, signing.SignMode_SIGN_MODE_DIRECT)
+	return signature, err
+}
+
+// NodeOwners looks up the owner of the hubs registered in x/rewards.
+type NodeOwners interface {
+	NodeOwner(ctx context.Context, nodeId string) (string, error)
+}
+
+// NodeOwnersFunc adapts a function to NodeOwners.
+type NodeOwnersFunc func(ctx context.Context, nodeId string) (string, error)
+
+// NodeOwner calls f.
+func (f NodeOwnersFunc) NodeOwner(ctx context.Context, nodeId string) (string, error) {
+	return f(ctx, nodeId)
+}
+
+// encodeToken signs session and encodes it as its base64url JSON payload and
+// signature, separated by a dot.
+func encodeToken(signer Signer, session Session) (string, error) {
+	payload, err := json.Marshal(session)
+	if err != nil {
+		return "", err
+	}
+	signature, err := signer.Sign(payload)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(payload) + "." + base64.RawURLEncoding.EncodeToString(signature), nil
+}
+
+// Verifier checks session tokens issued by any hub: the token must be signed
+// by the current owner of the hub node it names, so tokens of a hub are
+// revoked along with its ownership.
+type Verifier struct {
+	owners       NodeOwners
+	addressCodec address.Codec
+	now          func() time.Time
+}
+
+// NewVerifier returns a Verifier looking up the hub owners in owners.
+func NewVerifier(owners NodeOwners, addressCodec address.Codec) *Verifier {
+	return &Verifier{
+		owners:       owners,
+		addressCodec: addressCodec,
+		now:          time.Now,
+	}
+}
+
+// Verify returns the session of token if it is valid.
+func (v *Verifier) Verify(ctx context.Context, token string) (Session, error) {
+	encodedPayload, encodedSignature, ok := strings.Cut(token, ".")
+	if !ok {
+		return Session{}, ErrInvalidToken
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(encodedPayload)
+	if err != nil {
+		return Session{}, ErrInvalidToken
+	}
+	signature, err := base64.RawURLEncoding.DecodeString(encodedSignature)
+	if err != nil {
+		return Session{}, ErrInvalidToken
+	}
+	var session Session
+	if err := json.Unmarshal(payload, &session); err != nil {
+		return Session{}, ErrInvalidToken
+	}
+
+	if len(session.HubKey) != secp256k1.PubKeySize {
+		return Session{}, ErrInvalidToken
+	}
+	hubKey := &secp256k1.PubKey{Key: session.HubKey}
+	if !hubKey.VerifySignature(payload, signature) {
+		return Session{}, ErrInvalidToken
+	}
+	if v.now().Unix() >= session.ExpiresAt {
+		return Session{}, ErrTokenExpired
+	}
+
+	owner, err := v.owners.NodeOwner(ctx, session.NodeID)
+	if err != nil {
+		return Session{}, fmt.Errorf("%w: %w", ErrInvalidToken, err)
+	}
+	hubOwner, err := v.addressCodec.BytesToString(hubKey.Address())
+	if err != nil {
+		return Session{}, err
+	}
+	if hubOwner != owner {
+		return Session{}, fmt.Errorf("%w: %s does not own node %s", ErrInvalidToken, hubOwner, session.NodeID)
+	}
+	return session, nil
+}
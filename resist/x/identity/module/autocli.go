@@ -29,6 +29,108 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Alias:          []string{"show-user-profile"},
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "index"}},
 				},
+				{
+					RpcMethod: "ListIssuer",
+					Use:       "list-issuer",
+					Short:     "List all attestation issuers",
+				},
+				{
+					RpcMethod:      "GetIssuer",
+					Use:            "get-issuer [address]",
+					Short:          "Gets an attestation issuer",
+					Alias:          []string{"show-issuer"},
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}},
+				},
+				{
+					RpcMethod:      "ListAttestation",
+					Use:            "list-attestation [subject]",
+					Short:          "List the attestations of an account, optionally only the valid ones (--valid-only)",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "subject"}},
+				},
+				{
+					RpcMethod:      "GetAttestation",
+					Use:            "get-attestation [id]",
+					Short:          "Gets an attestation and whether it is valid",
+					Alias:          []string{"show-attestation"},
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}},
+				},
+				{
+					RpcMethod:      "ResolveHandle",
+					Use:            "resolve-handle [handle]",
+					Short:          "Resolve a handle to the account holding it",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "handle"}},
+				},
+				{
+					RpcMethod:      "ReverseResolve",
+					Use:            "reverse-resolve [address]",
+					Short:          "Gets the handle held by an account",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}},
+				},
+				{
+					RpcMethod:      "GetGuardians",
+					Use:            "get-guardians [address]",
+					Short:          "Gets the recovery guardians of an identity",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}},
+				},
+				{
+					RpcMethod:      "GetRecovery",
+					Use:            "get-recovery [address]",
+					Short:          "Gets the pending recovery of an identity",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}},
+				},
+				{
+					RpcMethod: "ListPersonaKey",
+					Use:       "list-persona-key",
+					Short:     "List the persona keys of the issuers",
+				},
+				{
+					RpcMethod:      "GetPersonaKey",
+					Use:            "get-persona-key [id]",
+					Short:          "Gets a persona key",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}},
+				},
+				{
+					RpcMethod:      "ListPersonaCredentialRequest",
+					Use:            "list-persona-credential-request [key-id]",
+					Short:          "List the persona credential requests of a persona key",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "key_id"}},
+				},
+				{
+					RpcMethod:      "GetPersonaCredentialRequest",
+					Use:            "get-persona-credential-request [id]",
+					Short:          "Gets a persona credential request",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}},
+				},
+				{
+					RpcMethod:      "GetPersona",
+					Use:            "get-persona [address]",
+					Short:          "Gets a persona and whether its credential is valid",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}},
+				},
+				{
+					RpcMethod:      "ListFollowers",
+					Use:            "list-followers [address]",
+					Short:          "List the followers of an address",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}},
+				},
+				{
+					RpcMethod:      "ListFollowing",
+					Use:            "list-following [address]",
+					Short:          "List the addresses an address follows",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}},
+				},
+				{
+					RpcMethod:      "GetFollowCounts",
+					Use:            "get-follow-counts [address]",
+					Short:          "Gets the follower and following counts of an address",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}},
+				},
+				{
+					RpcMethod:      "ListBlocked",
+					Use:            "list-blocked [address]",
+					Short:          "List the addresses an address blocks",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}},
+				},
 				// this line is used by ignite scaffolding # autocli/query
 			},
 		},
@@ -54,15 +156,15 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 				},
 				{
 					RpcMethod:      "CreateUserProfile",
-					Use:            "create-user-profile [index] [display-name] [bio] [avatar-url] [verified] [created-at]",
+					Use:            "create-user-profile [index] [display-name] [bio] [avatar-url] [created-at]",
 					Short:          "Create a new user-profile",
-					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "index"}, {ProtoField: "display_name"}, {ProtoField: "bio"}, {ProtoField: "avatar_url"}, {ProtoField: "verified"}, {ProtoField: "created_at"}},
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "index"}, {ProtoField: "display_name"}, {ProtoField: "bio"}, {ProtoField: "avatar_url"}, {ProtoField: "created_at"}},
 				},
 				{
 					RpcMethod:      "UpdateUserProfile",
-					Use:            "update-user-profile [index] [display-name] [bio] [avatar-url] [verified] [created-at]",
+					Use:            "update-user-profile [index] [display-name] [bio] [avatar-url] [created-at]",
 					Short:          "Update user-profile",
-					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "index"}, {ProtoField: "display_name"}, {ProtoField: "bio"}, {ProtoField: "avatar_url"}, {ProtoField: "verified"}, {ProtoField: "created_at"}},
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "index"}, {ProtoField: "display_name"}, {ProtoField: "bio"}, {ProtoField: "avatar_url"}, {ProtoField: "created_at"}},
 				},
 				{
 					RpcMethod:      "DeleteUserProfile",
@@ -70,6 +172,133 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Short:          "Delete user-profile",
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "index"}},
 				},
+				{
+					RpcMethod: "RegisterIssuer",
+					Skip:      true, // skipped because authority gated
+				},
+				{
+					RpcMethod: "RemoveIssuer",
+					Skip:      true, // skipped because authority gated
+				},
+				{
+					RpcMethod:      "Attest",
+					Use:            "attest [subject] [claim-type] [evidence] [expires-at]",
+					Short:          "Attest a claim about an account as a registered issuer",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "subject"}, {ProtoField: "claim_type"}, {ProtoField: "evidence"}, {ProtoField: "expires_at"}},
+				},
+				{
+					RpcMethod:      "RevokeAttestation",
+					Use:            "revoke-attestation [id] [reason]",
+					Short:          "Revoke an attestation",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}, {ProtoField: "reason"}},
+				},
+				{
+					RpcMethod:      "ClaimHandle",
+					Use:            "claim-handle [handle]",
+					Short:          "Claim a unique handle, paying the handle fee",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "handle"}},
+				},
+				{
+					RpcMethod:      "ReleaseHandle",
+					Use:            "release-handle [handle]",
+					Short:          "Release a handle you hold",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "handle"}},
+				},
+				{
+					RpcMethod:      "TransferHandle",
+					Use:            "transfer-handle [handle] [recipient]",
+					Short:          "Transfer a handle you hold to an account without handle",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "handle"}, {ProtoField: "recipient"}},
+				},
+				{
+					RpcMethod:      "RotateIdentityKey",
+					Use:            "rotate-identity-key [old-address] [signature]",
+					Short:          "Move the identity of old-address to the sender, with a rotation signature of the old key passed in --pub-key",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "old_address"}, {ProtoField: "signature"}},
+				},
+				{
+					RpcMethod:      "SetGuardians",
+					Use:            "set-guardians [threshold] [guardians]...",
+					Short:          "Set the guardians able to recover your identity, or remove them with no guardians",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "threshold"}, {ProtoField: "guardians", Varargs: true}},
+				},
+				{
+					RpcMethod:      "InitiateRecovery",
+					Use:            "initiate-recovery [address] [new-address]",
+					Short:          "Start the recovery of an identity you guard to a new address",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}, {ProtoField: "new_address"}},
+				},
+				{
+					RpcMethod:      "ApproveRecovery",
+					Use:            "approve-recovery [address] [new-address]",
+					Short:          "Approve the pending recovery of an identity you guard",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}, {ProtoField: "new_address"}},
+				},
+				{
+					RpcMethod: "CancelRecovery",
+					Use:       "cancel-recovery",
+					Short:     "Cancel the pending recovery of your identity",
+				},
+				{
+					RpcMethod:      "ExecuteRecovery",
+					Use:            "execute-recovery [address]",
+					Short:          "Move an identity to its new address once its recovery time lock ended",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}},
+				},
+				{
+					RpcMethod:      "RegisterPersonaKey",
+					Use:            "register-persona-key [public-key]",
+					Short:          "Register a DER encoded RSA key to blindly sign persona credentials, as an issuer of human attestations",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "public_key"}},
+				},
+				{
+					RpcMethod:      "RevokePersonaKey",
+					Use:            "revoke-persona-key [key-id]",
+					Short:          "Revoke a persona key and the personas holding its credentials",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "key_id"}},
+				},
+				{
+					RpcMethod:      "RequestPersonaCredential",
+					Use:            "request-persona-credential [key-id] [blinded-message]",
+					Short:          "Request a blinded persona credential, as a verified human",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "key_id"}, {ProtoField: "blinded_message"}},
+				},
+				{
+					RpcMethod:      "IssuePersonaCredential",
+					Use:            "issue-persona-credential [request-id] [blind-signature]",
+					Short:          "Sign a requested persona credential, as the issuer of its key",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "request_id"}, {ProtoField: "blind_signature"}},
+				},
+				{
+					RpcMethod:      "RegisterPersona",
+					Use:            "register-persona [key-id] [signature]",
+					Short:          "Register the sender as a persona with an unblinded persona credential",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "key_id"}, {ProtoField: "signature"}},
+				},
+				{
+					RpcMethod:      "Follow",
+					Use:            "follow [address]",
+					Short:          "Follow an address",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}},
+				},
+				{
+					RpcMethod:      "Unfollow",
+					Use:            "unfollow [address]",
+					Short:          "Stop following an address",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}},
+				},
+				{
+					RpcMethod:      "Block",
+					Use:            "block [address]",
+					Short:          "Block an address from following you and interacting with your posts",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}},
+				},
+				{
+					RpcMethod:      "Unblock",
+					Use:            "unblock [address]",
+					Short:          "Unblock an address",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}},
+				},
 				// this line is used by ignite scaffolding # autocli/tx
 			},
 		},
@@ -1,6 +1,9 @@
 package identity
 
 import (
+	"maps"
+	"slices"
+
 	"cosmossdk.io/core/address"
 	"cosmossdk.io/core/appmodule"
 	"cosmossdk.io/core/store"
@@ -22,6 +25,7 @@ func init() {
 	appconfig.Register(
 		&types.Module{},
 		appconfig.Provide(ProvideModule),
+		appconfig.Invoke(InvokeSetIdentityHooks),
 	)
 }
 
@@ -55,8 +59,23 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 		in.Cdc,
 		in.AddressCodec,
 		authority,
+		in.AuthKeeper,
+		in.BankKeeper,
 	)
 	m := NewAppModule(in.Cdc, k, in.AuthKeeper, in.BankKeeper)
 
 	return ModuleOutputs{IdentityKeeper: k, Module: m}
 }
+
+// InvokeSetIdentityHooks sets the identity hooks provided by other modules. It
+// is invoked once every module is provided, so that modules depending on the
+// identity keeper can implement its hooks.
+func InvokeSetIdentityHooks(k keeper.Keeper, identityHooks map[string]types.IdentityHooksWrapper) error {
+	// Call the hooks in module name order, for determinism.
+	var hooks types.MultiIdentityHooks
+	for _, name := range slices.Sorted(maps.Keys(identityHooks)) {
+		hooks = append(hooks, identityHooks[name])
+	}
+	k.SetHooks(hooks)
+	return nil
+}
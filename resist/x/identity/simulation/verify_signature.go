@@ -6,6 +6,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/client"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
 	"github.com/cosmos/cosmos-sdk/x/simulation"
@@ -31,17 +32,22 @@ func SimulateMsgVerifySignature(
 		}
 
 		// Sign the challenge
-		sigBytes, err := simAccount.PrivKey.Sign([]byte(challengeString))
+		sigBytes, err := simAccount.PrivKey.Sign(types.ChallengeSignBytes(challengeString))
 		if err != nil {
 			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(&types.MsgVerifySignature{}), "failed to sign challenge"), nil, err
 		}
 		signatureString := hex.EncodeToString(sigBytes)
+		pubKey, err := codectypes.NewAnyWithValue(simAccount.PubKey)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(&types.MsgVerifySignature{}), "failed to pack public key"), nil, err
+		}
 
 		msg := &types.MsgVerifySignature{
 			Creator:   simAccount.Address.String(),
 			Challenge: challengeString,
 			Signature: signatureString,
 			Address:   simAccount.Address.String(),
+			PubKey:    pubKey,
 		}
 
 		txCtx := simulation.OperationInput{
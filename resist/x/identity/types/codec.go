@@ -21,8 +21,45 @@ func RegisterInterfaces(registrar codectypes.InterfaceRegistry) {
 		&MsgRequestChallenge{},
 	)
 
+	registrar.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgAttest{},
+		&MsgRevokeAttestation{},
+	)
+
+	registrar.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgClaimHandle{},
+		&MsgReleaseHandle{},
+		&MsgTransferHandle{},
+	)
+
+	registrar.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgRotateIdentityKey{},
+		&MsgSetGuardians{},
+		&MsgInitiateRecovery{},
+		&MsgApproveRecovery{},
+		&MsgCancelRecovery{},
+		&MsgExecuteRecovery{},
+	)
+
+	registrar.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgRegisterPersonaKey{},
+		&MsgRevokePersonaKey{},
+		&MsgRequestPersonaCredential{},
+		&MsgIssuePersonaCredential{},
+		&MsgRegisterPersona{},
+	)
+
+	registrar.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgFollow{},
+		&MsgUnfollow{},
+		&MsgBlock{},
+		&MsgUnblock{},
+	)
+
 	registrar.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgUpdateParams{},
+		&MsgRegisterIssuer{},
+		&MsgRemoveIssuer{},
 	)
 	msgservice.RegisterMsgServiceDesc(registrar, &_Msg_serviceDesc)
 }
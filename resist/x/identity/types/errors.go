@@ -14,4 +14,40 @@ var (
 	ErrChallengeExpired  = errors.Register(ModuleName, 1103, "challenge has expired")
 	ErrInvalidSignature  = errors.Register(ModuleName, 1104, "invalid signature")
 	ErrAddressMismatch   = errors.Register(ModuleName, 1105, "address does not match public key")
+
+	ErrIssuerNotFound      = errors.Register(ModuleName, 1106, "attestation issuer not found")
+	ErrInvalidClaimType    = errors.Register(ModuleName, 1107, "invalid attestation claim type")
+	ErrAttestationNotFound = errors.Register(ModuleName, 1108, "attestation not found")
+	ErrAttestationRevoked  = errors.Register(ModuleName, 1109, "attestation is revoked")
+
+	ErrInvalidPubKey  = errors.Register(ModuleName, 1110, "invalid public key")
+	ErrPubKeyMismatch = errors.Register(ModuleName, 1111, "public key does not match account public key")
+
+	ErrInvalidHandle  = errors.Register(ModuleName, 1112, "invalid handle")
+	ErrHandleTaken    = errors.Register(ModuleName, 1113, "handle is already claimed")
+	ErrHandleReserved = errors.Register(ModuleName, 1114, "handle is reserved")
+	ErrHandleNotFound = errors.Register(ModuleName, 1115, "handle not found")
+
+	ErrIdentityExists   = errors.Register(ModuleName, 1116, "identity already exists at address")
+	ErrInvalidGuardians = errors.Register(ModuleName, 1117, "invalid guardians")
+	ErrRecoveryNotFound = errors.Register(ModuleName, 1118, "recovery not found")
+	ErrRecoveryNotReady = errors.Register(ModuleName, 1119, "recovery is not executable yet")
+	ErrRecoveryConflict = errors.Register(ModuleName, 1120, "recovery to another address is pending")
+
+	ErrPersonaKeyNotFound       = errors.Register(ModuleName, 1121, "persona key not found")
+	ErrInvalidPersonaKey        = errors.Register(ModuleName, 1122, "invalid persona key")
+	ErrPersonaRequestNotFound   = errors.Register(ModuleName, 1123, "persona credential request not found")
+	ErrInvalidPersonaCredential = errors.Register(ModuleName, 1124, "invalid persona credential")
+	ErrPersonaLimitReached      = errors.Register(ModuleName, 1125, "persona credential limit reached")
+	ErrPersonaAlreadyRegistered = errors.Register(ModuleName, 1126, "persona already registered")
+	ErrNotHuman                 = errors.Register(ModuleName, 1127, "account holds no valid human attestation")
+
+	ErrSelfFollow = errors.Register(ModuleName, 1128, "cannot follow or block oneself")
+	ErrBlocked    = errors.Register(ModuleName, 1129, "blocked by address")
+
+	ErrInvalidProfile = errors.Register(ModuleName, 1130, "invalid user profile")
+	ErrInvalidDomain  = errors.Register(ModuleName, 1131, "invalid domain")
+
+	ErrTooManyChallenges = errors.Register(ModuleName, 1132, "too many pending challenges")
+	ErrChallengePending  = errors.Register(ModuleName, 1133, "address has a pending challenge requested by another account")
 )
@@ -6,7 +6,7 @@ import "fmt"
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
 		Params:         DefaultParams(),
-		UserProfileMap: []UserProfile{}}
+		UserProfileMap: []UserProfile{}, IssuerMap: []Issuer{}, AttestationList: []Attestation{}, HandleMap: []Handle{}, GuardiansMap: []Guardians{}, RecoveryMap: []Recovery{}, PersonaKeyList: []PersonaKey{}, PersonaRequestList: []PersonaCredentialRequest{}, PersonaMap: []Persona{}, FollowList: []Follow{}, BlockList: []Block{}, ChallengeList: []Challenge{}}
 }
 
 // Validate performs basic genesis state validation returning an error upon any
@@ -21,6 +21,142 @@ func (gs GenesisState) Validate() error {
 		}
 		userProfileIndexMap[index] = struct{}{}
 	}
+	issuerIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.IssuerMap {
+		if _, ok := issuerIndexMap[elem.Address]; ok {
+			return fmt.Errorf("duplicated index for issuer")
+		}
+		for _, claimType := range elem.ClaimTypes {
+			if !IsValidClaimType(claimType) {
+				return fmt.Errorf("invalid claim type %q for issuer %s", claimType, elem.Address)
+			}
+		}
+		issuerIndexMap[elem.Address] = struct{}{}
+	}
+	attestationIdMap := make(map[uint64]bool)
+	attestationCount := gs.GetAttestationCount()
+	for _, elem := range gs.AttestationList {
+		if _, ok := attestationIdMap[elem.Id]; ok {
+			return fmt.Errorf("duplicated id for attestation")
+		}
+		if elem.Id >= attestationCount {
+			return fmt.Errorf("attestation id should be lower or equal than the last id")
+		}
+		if !IsValidClaimType(elem.ClaimType) {
+			return fmt.Errorf("invalid claim type %q for attestation %d", elem.ClaimType, elem.Id)
+		}
+		attestationIdMap[elem.Id] = true
+	}
+	handleIndexMap := make(map[string]struct{})
+	handleOwnerMap := make(map[string]struct{})
+	for _, elem := range gs.HandleMap {
+		name, err := NormalizeHandle(elem.Name)
+		if err != nil || name != elem.Name {
+			return fmt.Errorf("invalid handle %q", elem.Name)
+		}
+		skeleton := HandleSkeleton(name)
+		if _, ok := handleIndexMap[skeleton]; ok {
+			return fmt.Errorf("duplicated index for handle")
+		}
+		if _, ok := handleOwnerMap[elem.Owner]; ok {
+			return fmt.Errorf("duplicated owner %s for handle", elem.Owner)
+		}
+		handleIndexMap[skeleton] = struct{}{}
+		handleOwnerMap[elem.Owner] = struct{}{}
+	}
+	guardiansIndexMap := make(map[string]struct{})
+	for _, elem := range gs.GuardiansMap {
+		if _, ok := guardiansIndexMap[elem.Address]; ok {
+			return fmt.Errorf("duplicated index for guardians")
+		}
+		if err := elem.Validate(); err != nil {
+			return err
+		}
+		guardiansIndexMap[elem.Address] = struct{}{}
+	}
+	recoveryIndexMap := make(map[string]struct{})
+	for _, elem := range gs.RecoveryMap {
+		if _, ok := recoveryIndexMap[elem.Address]; ok {
+			return fmt.Errorf("duplicated index for recovery")
+		}
+		if _, ok := guardiansIndexMap[elem.Address]; !ok {
+			return fmt.Errorf("recovery of %s without guardians", elem.Address)
+		}
+		recoveryIndexMap[elem.Address] = struct{}{}
+	}
+	personaKeyIdMap := make(map[uint64]bool)
+	personaKeyCount := gs.GetPersonaKeyCount()
+	for _, elem := range gs.PersonaKeyList {
+		if _, ok := personaKeyIdMap[elem.Id]; ok {
+			return fmt.Errorf("duplicated id for personaKey")
+		}
+		if elem.Id >= personaKeyCount {
+			return fmt.Errorf("personaKey id should be lower or equal than the last id")
+		}
+		personaKeyIdMap[elem.Id] = true
+	}
+	personaRequestIdMap := make(map[uint64]bool)
+	personaRequestCount := gs.GetPersonaRequestCount()
+	for _, elem := range gs.PersonaRequestList {
+		if _, ok := personaRequestIdMap[elem.Id]; ok {
+			return fmt.Errorf("duplicated id for personaRequest")
+		}
+		if elem.Id >= personaRequestCount {
+			return fmt.Errorf("personaRequest id should be lower or equal than the last id")
+		}
+		if !personaKeyIdMap[elem.KeyId] {
+			return fmt.Errorf("personaRequest %d of unknown personaKey %d", elem.Id, elem.KeyId)
+		}
+		personaRequestIdMap[elem.Id] = true
+	}
+	personaIndexMap := make(map[string]struct{})
+	for _, elem := range gs.PersonaMap {
+		if _, ok := personaIndexMap[elem.Address]; ok {
+			return fmt.Errorf("duplicated index for persona")
+		}
+		if !personaKeyIdMap[elem.KeyId] {
+			return fmt.Errorf("persona %s of unknown personaKey %d", elem.Address, elem.KeyId)
+		}
+		personaIndexMap[elem.Address] = struct{}{}
+	}
+	blockIndexMap := make(map[[2]string]struct{})
+	for _, elem := range gs.BlockList {
+		key := [2]string{elem.Blocker, elem.Blocked}
+		if _, ok := blockIndexMap[key]; ok {
+			return fmt.Errorf("duplicated index for block")
+		}
+		if elem.Blocker == elem.Blocked {
+			return fmt.Errorf("%s blocks itself", elem.Blocker)
+		}
+		blockIndexMap[key] = struct{}{}
+	}
+	followIndexMap := make(map[[2]string]struct{})
+	for _, elem := range gs.FollowList {
+		key := [2]string{elem.Follower, elem.Followee}
+		if _, ok := followIndexMap[key]; ok {
+			return fmt.Errorf("duplicated index for follow")
+		}
+		if elem.Follower == elem.Followee {
+			return fmt.Errorf("%s follows itself", elem.Follower)
+		}
+		_, blocks := blockIndexMap[key]
+		_, blocked := blockIndexMap[[2]string{elem.Followee, elem.Follower}]
+		if blocks || blocked {
+			return fmt.Errorf("follow of %s by %s between blocked accounts", elem.Followee, elem.Follower)
+		}
+		followIndexMap[key] = struct{}{}
+	}
+	challengeIndexMap := make(map[string]struct{})
+	for _, elem := range gs.ChallengeList {
+		if _, ok := challengeIndexMap[elem.Address]; ok {
+			return fmt.Errorf("duplicated index for challenge")
+		}
+		if elem.Challenge == "" {
+			return fmt.Errorf("empty challenge for %s", elem.Address)
+		}
+		challengeIndexMap[elem.Address] = struct{}{}
+	}
 
 	return gs.Params.Validate()
 }
@@ -27,8 +27,22 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // GenesisState defines the identity module's genesis state.
 type GenesisState struct {
 	// params defines all the parameters of the module.
-	Params         Params        `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
-	UserProfileMap []UserProfile `protobuf:"bytes,2,rep,name=user_profile_map,json=userProfileMap,proto3" json:"user_profile_map"`
+	Params              Params                     `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	UserProfileMap      []UserProfile              `protobuf:"bytes,2,rep,name=user_profile_map,json=userProfileMap,proto3" json:"user_profile_map"`
+	IssuerMap           []Issuer                   `protobuf:"bytes,3,rep,name=issuer_map,json=issuerMap,proto3" json:"issuer_map"`
+	AttestationList     []Attestation              `protobuf:"bytes,4,rep,name=attestation_list,json=attestationList,proto3" json:"attestation_list"`
+	AttestationCount    uint64                     `protobuf:"varint,5,opt,name=attestation_count,json=attestationCount,proto3" json:"attestation_count,omitempty"`
+	HandleMap           []Handle                   `protobuf:"bytes,6,rep,name=handle_map,json=handleMap,proto3" json:"handle_map"`
+	GuardiansMap        []Guardians                `protobuf:"bytes,7,rep,name=guardians_map,json=guardiansMap,proto3" json:"guardians_map"`
+	RecoveryMap         []Recovery                 `protobuf:"bytes,8,rep,name=recovery_map,json=recoveryMap,proto3" json:"recovery_map"`
+	PersonaKeyList      []PersonaKey               `protobuf:"bytes,9,rep,name=persona_key_list,json=personaKeyList,proto3" json:"persona_key_list"`
+	PersonaKeyCount     uint64                     `protobuf:"varint,10,opt,name=persona_key_count,json=personaKeyCount,proto3" json:"persona_key_count,omitempty"`
+	PersonaRequestList  []PersonaCredentialRequest `protobuf:"bytes,11,rep,name=persona_request_list,json=personaRequestList,proto3" json:"persona_request_list"`
+	PersonaRequestCount uint64                     `protobuf:"varint,12,opt,name=persona_request_count,json=personaRequestCount,proto3" json:"persona_request_count,omitempty"`
+	PersonaMap          []Persona                  `protobuf:"bytes,13,rep,name=persona_map,json=personaMap,proto3" json:"persona_map"`
+	FollowList          []Follow                   `protobuf:"bytes,14,rep,name=follow_list,json=followList,proto3" json:"follow_list"`
+	BlockList           []Block                    `protobuf:"bytes,15,rep,name=block_list,json=blockList,proto3" json:"block_list"`
+	ChallengeList       []Challenge                `protobuf:"bytes,16,rep,name=challenge_list,json=challengeList,proto3" json:"challenge_list"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -78,6 +92,104 @@ func (m *GenesisState) GetUserProfileMap() []UserProfile {
 	return nil
 }
 
+func (m *GenesisState) GetIssuerMap() []Issuer {
+	if m != nil {
+		return m.IssuerMap
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAttestationList() []Attestation {
+	if m != nil {
+		return m.AttestationList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAttestationCount() uint64 {
+	if m != nil {
+		return m.AttestationCount
+	}
+	return 0
+}
+
+func (m *GenesisState) GetHandleMap() []Handle {
+	if m != nil {
+		return m.HandleMap
+	}
+	return nil
+}
+
+func (m *GenesisState) GetGuardiansMap() []Guardians {
+	if m != nil {
+		return m.GuardiansMap
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRecoveryMap() []Recovery {
+	if m != nil {
+		return m.RecoveryMap
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPersonaKeyList() []PersonaKey {
+	if m != nil {
+		return m.PersonaKeyList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPersonaKeyCount() uint64 {
+	if m != nil {
+		return m.PersonaKeyCount
+	}
+	return 0
+}
+
+func (m *GenesisState) GetPersonaRequestList() []PersonaCredentialRequest {
+	if m != nil {
+		return m.PersonaRequestList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPersonaRequestCount() uint64 {
+	if m != nil {
+		return m.PersonaRequestCount
+	}
+	return 0
+}
+
+func (m *GenesisState) GetPersonaMap() []Persona {
+	if m != nil {
+		return m.PersonaMap
+	}
+	return nil
+}
+
+func (m *GenesisState) GetFollowList() []Follow {
+	if m != nil {
+		return m.FollowList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetBlockList() []Block {
+	if m != nil {
+		return m.BlockList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetChallengeList() []Challenge {
+	if m != nil {
+		return m.ChallengeList
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "resist.identity.v1.GenesisState")
 }
@@ -85,23 +197,47 @@ func init() {
 func init() { proto.RegisterFile("resist/identity/v1/genesis.proto", fileDescriptor_c8333092dc84e5af) }
 
 var fileDescriptor_c8333092dc84e5af = []byte{
-	// 253 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x28, 0x4a, 0x2d, 0xce,
-	0x2c, 0x2e, 0xd1, 0xcf, 0x4c, 0x49, 0xcd, 0x2b, 0xc9, 0x2c, 0xa9, 0xd4, 0x2f, 0x33, 0xd4, 0x4f,
-	0x4f, 0xcd, 0x03, 0x09, 0xea, 0x15, 0x14, 0xe5, 0x97, 0xe4, 0x0b, 0x09, 0x41, 0x54, 0xe8, 0xc1,
-	0x54, 0xe8, 0x95, 0x19, 0x4a, 0x09, 0x26, 0xe6, 0x66, 0xe6, 0xe5, 0xeb, 0x83, 0x49, 0x88, 0x32,
-	0x29, 0x91, 0xf4, 0xfc, 0xf4, 0x7c, 0x30, 0x53, 0x1f, 0xc4, 0x82, 0x8a, 0xca, 0x63, 0x31, 0xbe,
-	0x20, 0xb1, 0x28, 0x31, 0x17, 0x6a, 0xba, 0x94, 0x2a, 0x16, 0x05, 0xa5, 0xc5, 0xa9, 0x45, 0xf1,
-	0x05, 0x45, 0xf9, 0x69, 0x99, 0x39, 0xa9, 0x10, 0x65, 0x4a, 0xf3, 0x18, 0xb9, 0x78, 0xdc, 0x21,
-	0xce, 0x0a, 0x2e, 0x49, 0x2c, 0x49, 0x15, 0xb2, 0xe5, 0x62, 0x83, 0x98, 0x23, 0xc1, 0xa8, 0xc0,
-	0xa8, 0xc1, 0x6d, 0x24, 0xa5, 0x87, 0xe9, 0x4c, 0xbd, 0x00, 0xb0, 0x0a, 0x27, 0xce, 0x13, 0xf7,
-	0xe4, 0x19, 0x56, 0x3c, 0xdf, 0xa0, 0xc5, 0x18, 0x04, 0xd5, 0x24, 0xe4, 0xcf, 0x25, 0x80, 0x6c,
-	0x4b, 0x7c, 0x6e, 0x62, 0x81, 0x04, 0x93, 0x02, 0xb3, 0x06, 0xb7, 0x91, 0x3c, 0x36, 0x83, 0x42,
-	0x8b, 0x53, 0x8b, 0x02, 0x20, 0x4a, 0x9d, 0x58, 0x40, 0xa6, 0x05, 0xf1, 0x95, 0x22, 0x84, 0x7c,
-	0x13, 0x0b, 0x9c, 0x0c, 0x4f, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39,
-	0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x21, 0x4a, 0x1c,
-	0xea, 0xc3, 0x0a, 0x84, 0x1f, 0x4b, 0x2a, 0x0b, 0x52, 0x8b, 0x93, 0xd8, 0xc0, 0x5e, 0x33, 0x06,
-	0x04, 0x00, 0x00, 0xff, 0xff, 0x9d, 0x81, 0xb1, 0x1b, 0x83, 0x01, 0x00, 0x00,
+	// 629 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x94, 0x4f, 0x6f, 0x12, 0x41,
+	0x18, 0xc6, 0x59, 0x5b, 0x6b, 0x3b, 0x4b, 0x29, 0x5d, 0x6b, 0x44, 0xd4, 0x2d, 0x36, 0x9a, 0x34,
+	0xd5, 0x40, 0xc0, 0xb3, 0x9a, 0x42, 0xb4, 0xf5, 0x3f, 0xc1, 0x78, 0xf1, 0x42, 0xa6, 0x30, 0x5d,
+	0x36, 0x5d, 0x76, 0xd6, 0x99, 0x59, 0x94, 0x6f, 0xe1, 0xc7, 0xf0, 0xe8, 0xc7, 0xe8, 0xb1, 0x47,
+	0xbd, 0x18, 0x03, 0x07, 0xbf, 0x86, 0x99, 0x77, 0x66, 0x60, 0xd5, 0x81, 0x4b, 0x43, 0x26, 0xbf,
+	0xe7, 0x37, 0xcf, 0xbe, 0x7d, 0x33, 0xa8, 0xc2, 0x08, 0x0f, 0xb9, 0xa8, 0x85, 0x7d, 0x12, 0x8b,
+	0x50, 0x8c, 0x6b, 0xa3, 0x7a, 0x2d, 0x20, 0xb1, 0x3c, 0xac, 0x26, 0x8c, 0x0a, 0xea, 0x79, 0x8a,
+	0xa8, 0x1a, 0xa2, 0x3a, 0xaa, 0x97, 0xb7, 0xf1, 0x30, 0x8c, 0x69, 0x0d, 0xfe, 0x2a, 0xac, 0xbc,
+	0x13, 0xd0, 0x80, 0xc2, 0xcf, 0x9a, 0xfc, 0xa5, 0x4f, 0xef, 0x5a, 0xf4, 0x58, 0x08, 0xc2, 0x05,
+	0x16, 0x21, 0x8d, 0x35, 0xb5, 0x67, 0xa1, 0x7a, 0x03, 0x1c, 0x45, 0x24, 0x0e, 0x88, 0x66, 0x76,
+	0x2d, 0xcc, 0x00, 0xc7, 0xfd, 0x68, 0x19, 0x90, 0x60, 0x86, 0x87, 0xfa, 0x43, 0xca, 0xb6, 0x4f,
+	0x4d, 0x08, 0xe3, 0x34, 0xc6, 0x9a, 0xb8, 0x63, 0x21, 0x18, 0xe9, 0xd1, 0x11, 0x61, 0x63, 0x8d,
+	0xdc, 0xb3, 0x20, 0x9c, 0xf6, 0x42, 0x1c, 0x75, 0x03, 0x86, 0x93, 0xc1, 0x12, 0x2c, 0xe5, 0x84,
+	0x75, 0x13, 0x46, 0x4f, 0x43, 0xd3, 0x79, 0xef, 0xc7, 0x3a, 0xca, 0x1f, 0xa9, 0x69, 0xbf, 0x13,
+	0x58, 0x10, 0xef, 0x11, 0x5a, 0x53, 0x9d, 0x4b, 0x4e, 0xc5, 0xd9, 0x77, 0x1b, 0xe5, 0xea, 0xff,
+	0xd3, 0xaf, 0xb6, 0x81, 0x68, 0x6e, 0x9c, 0xff, 0xdc, 0xcd, 0x7d, 0xfd, 0xfd, 0xed, 0xc0, 0xe9,
+	0xe8, 0x90, 0xf7, 0x16, 0x15, 0xb3, 0xb7, 0x74, 0x87, 0x38, 0x29, 0x5d, 0xaa, 0xac, 0xec, 0xbb,
+	0x8d, 0x5d, 0x9b, 0xe8, 0x3d, 0x27, 0xac, 0xad, 0xd0, 0xe6, 0xaa, 0xb4, 0x75, 0x0a, 0xe9, 0xfc,
+	0xe8, 0x35, 0x4e, 0xbc, 0x27, 0x08, 0x85, 0x9c, 0xa7, 0x84, 0x81, 0x6a, 0x05, 0x54, 0xd6, 0x4e,
+	0xcf, 0x81, 0xd2, 0x96, 0x0d, 0x95, 0x91, 0x82, 0x36, 0x2a, 0x66, 0xfe, 0xdf, 0xdd, 0x28, 0xe4,
+	0xa2, 0xb4, 0xba, 0xb8, 0xd1, 0xe1, 0x9c, 0xd5, 0xae, 0xad, 0x4c, 0xfc, 0x55, 0xc8, 0x85, 0x77,
+	0x1f, 0x6d, 0x67, 0x8d, 0x3d, 0x9a, 0xc6, 0xa2, 0x74, 0xb9, 0xe2, 0xec, 0xaf, 0x76, 0xb2, 0x57,
+	0xb5, 0xe4, 0xb9, 0xec, 0xaf, 0x96, 0x04, 0xfa, 0xaf, 0x2d, 0xee, 0x7f, 0x0c, 0x94, 0xe9, 0xaf,
+	0x32, 0xb2, 0xff, 0x31, 0xda, 0x0c, 0x52, 0xcc, 0xfa, 0x21, 0x8e, 0x39, 0x38, 0xae, 0x80, 0xe3,
+	0xb6, 0xcd, 0x71, 0x64, 0x40, 0xad, 0xc9, 0xcf, 0x92, 0xd2, 0xf4, 0x14, 0xe5, 0xcd, 0x2e, 0x81,
+	0x68, 0x1d, 0x44, 0xb7, 0x6c, 0xa2, 0x8e, 0xe6, 0xb4, 0xc7, 0x35, 0x39, 0xa9, 0x79, 0x83, 0x8a,
+	0x7a, 0x69, 0xbb, 0x67, 0x64, 0xac, 0x06, 0xba, 0x01, 0x2a, 0xdf, 0xba, 0x2b, 0x8a, 0x7d, 0x49,
+	0x8c, 0xac, 0x90, 0xcc, 0x4e, 0x60, 0x9c, 0x07, 0x68, 0x3b, 0xeb, 0x53, 0xe3, 0x44, 0x30, 0xce,
+	0xad, 0x39, 0xaa, 0xa6, 0xd9, 0x47, 0x3b, 0x86, 0x65, 0xe4, 0x63, 0x4a, 0xb8, 0x50, 0xf7, 0xbb,
+	0x70, 0xff, 0x83, 0x25, 0xf7, 0xb7, 0x18, 0x81, 0x43, 0x1c, 0x75, 0x54, 0x50, 0xb7, 0xf1, 0xb4,
+	0x4f, 0x9f, 0x42, 0xa3, 0x06, 0xba, 0xf6, 0xef, 0x2d, 0xaa, 0x55, 0x1e, 0x5a, 0x5d, 0xfd, 0x3b,
+	0xa2, 0x9a, 0x35, 0x91, 0x6b, 0x32, 0x72, 0xb6, 0x9b, 0x50, 0xe8, 0xe6, 0x92, 0x42, 0xfa, 0x7e,
+	0xa4, 0x53, 0x72, 0xb2, 0x87, 0xc8, 0x3d, 0xa5, 0x51, 0x44, 0x3f, 0xa9, 0x8f, 0x2a, 0x2c, 0x5e,
+	0x96, 0x67, 0x80, 0x19, 0x85, 0x0a, 0x41, 0xf5, 0xc7, 0x08, 0x9d, 0x44, 0xb4, 0x77, 0xa6, 0x0c,
+	0x5b, 0x60, 0xb8, 0x61, 0x33, 0x34, 0x25, 0x65, 0xb6, 0x0d, 0x22, 0x90, 0x7f, 0x81, 0x0a, 0xb3,
+	0x77, 0x4f, 0x39, 0x8a, 0x8b, 0xd7, 0xad, 0x65, 0x48, 0xed, 0xd9, 0x9c, 0x45, 0xa5, 0xab, 0x59,
+	0x3f, 0x9f, 0xf8, 0xce, 0xc5, 0xc4, 0x77, 0x7e, 0x4d, 0x7c, 0xe7, 0xcb, 0xd4, 0xcf, 0x5d, 0x4c,
+	0xfd, 0xdc, 0xf7, 0xa9, 0x9f, 0xfb, 0x70, 0x5d, 0x3f, 0x4e, 0x9f, 0xe7, 0xcf, 0x93, 0x18, 0x27,
+	0x84, 0x9f, 0xac, 0xc1, 0xab, 0xf4, 0xf0, 0x4f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x62, 0x6b, 0x5d,
+	0x38, 0x15, 0x06, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -124,6 +260,177 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ChallengeList) > 0 {
+		for iNdEx := len(m.ChallengeList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ChallengeList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.BlockList) > 0 {
+		for iNdEx := len(m.BlockList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BlockList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if len(m.FollowList) > 0 {
+		for iNdEx := len(m.FollowList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FollowList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.PersonaMap) > 0 {
+		for iNdEx := len(m.PersonaMap) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PersonaMap[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if m.PersonaRequestCount != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.PersonaRequestCount))
+		i--
+		dAtA[i] = 0x60
+	}
+	if len(m.PersonaRequestList) > 0 {
+		for iNdEx := len(m.PersonaRequestList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PersonaRequestList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if m.PersonaKeyCount != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.PersonaKeyCount))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.PersonaKeyList) > 0 {
+		for iNdEx := len(m.PersonaKeyList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PersonaKeyList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.RecoveryMap) > 0 {
+		for iNdEx := len(m.RecoveryMap) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RecoveryMap[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.GuardiansMap) > 0 {
+		for iNdEx := len(m.GuardiansMap) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GuardiansMap[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.HandleMap) > 0 {
+		for iNdEx := len(m.HandleMap) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HandleMap[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.AttestationCount != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.AttestationCount))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.AttestationList) > 0 {
+		for iNdEx := len(m.AttestationList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AttestationList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.IssuerMap) > 0 {
+		for iNdEx := len(m.IssuerMap) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.IssuerMap[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.UserProfileMap) > 0 {
 		for iNdEx := len(m.UserProfileMap) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -176,6 +483,81 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.IssuerMap) > 0 {
+		for _, e := range m.IssuerMap {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AttestationList) > 0 {
+		for _, e := range m.AttestationList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.AttestationCount != 0 {
+		n += 1 + sovGenesis(uint64(m.AttestationCount))
+	}
+	if len(m.HandleMap) > 0 {
+		for _, e := range m.HandleMap {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.GuardiansMap) > 0 {
+		for _, e := range m.GuardiansMap {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RecoveryMap) > 0 {
+		for _, e := range m.RecoveryMap {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PersonaKeyList) > 0 {
+		for _, e := range m.PersonaKeyList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.PersonaKeyCount != 0 {
+		n += 1 + sovGenesis(uint64(m.PersonaKeyCount))
+	}
+	if len(m.PersonaRequestList) > 0 {
+		for _, e := range m.PersonaRequestList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.PersonaRequestCount != 0 {
+		n += 1 + sovGenesis(uint64(m.PersonaRequestCount))
+	}
+	if len(m.PersonaMap) > 0 {
+		for _, e := range m.PersonaMap {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.FollowList) > 0 {
+		for _, e := range m.FollowList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.BlockList) > 0 {
+		for _, e := range m.BlockList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ChallengeList) > 0 {
+		for _, e := range m.ChallengeList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -281,6 +663,437 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IssuerMap", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IssuerMap = append(m.IssuerMap, Issuer{})
+			if err := m.IssuerMap[len(m.IssuerMap)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttestationList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AttestationList = append(m.AttestationList, Attestation{})
+			if err := m.AttestationList[len(m.AttestationList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttestationCount", wireType)
+			}
+			m.AttestationCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AttestationCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HandleMap", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HandleMap = append(m.HandleMap, Handle{})
+			if err := m.HandleMap[len(m.HandleMap)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GuardiansMap", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GuardiansMap = append(m.GuardiansMap, Guardians{})
+			if err := m.GuardiansMap[len(m.GuardiansMap)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecoveryMap", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecoveryMap = append(m.RecoveryMap, Recovery{})
+			if err := m.RecoveryMap[len(m.RecoveryMap)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PersonaKeyList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PersonaKeyList = append(m.PersonaKeyList, PersonaKey{})
+			if err := m.PersonaKeyList[len(m.PersonaKeyList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PersonaKeyCount", wireType)
+			}
+			m.PersonaKeyCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PersonaKeyCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PersonaRequestList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PersonaRequestList = append(m.PersonaRequestList, PersonaCredentialRequest{})
+			if err := m.PersonaRequestList[len(m.PersonaRequestList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PersonaRequestCount", wireType)
+			}
+			m.PersonaRequestCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PersonaRequestCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PersonaMap", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PersonaMap = append(m.PersonaMap, Persona{})
+			if err := m.PersonaMap[len(m.PersonaMap)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FollowList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FollowList = append(m.FollowList, Follow{})
+			if err := m.FollowList[len(m.FollowList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockList = append(m.BlockList, Block{})
+			if err := m.BlockList[len(m.BlockList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChallengeList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChallengeList = append(m.ChallengeList, Challenge{})
+			if err := m.ChallengeList[len(m.ChallengeList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
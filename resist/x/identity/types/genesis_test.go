@@ -21,8 +21,101 @@ func TestGenesisState_Validate(t *testing.T) {
 		},
 		{
 			desc:     "valid genesis state",
-			genState: &types.GenesisState{UserProfileMap: []types.UserProfile{{Index: "0"}, {Index: "1"}}},
+			genState: &types.GenesisState{Params: types.DefaultParams(), UserProfileMap: []types.UserProfile{{Index: "0"}, {Index: "1"}}, IssuerMap: []types.Issuer{{Address: "0", ClaimTypes: []string{types.ClaimTypeHuman}}}, AttestationList: []types.Attestation{{Id: 0, Issuer: "0", Subject: "1", ClaimType: types.ClaimTypeHuman}}, AttestationCount: 1, HandleMap: []types.Handle{{Name: "alice", Owner: "0"}, {Name: "bob", Owner: "1"}}, GuardiansMap: []types.Guardians{{Address: "0", Guardians: []string{"1"}, Threshold: 1}}, RecoveryMap: []types.Recovery{{Address: "0", NewAddress: "2", Approvals: []string{"1"}}}, PersonaKeyList: []types.PersonaKey{{Id: 0, Issuer: "0"}}, PersonaKeyCount: 1, PersonaRequestList: []types.PersonaCredentialRequest{{Id: 0, Requester: "1", KeyId: 0}}, PersonaRequestCount: 1, PersonaMap: []types.Persona{{Address: "2", KeyId: 0}}, FollowList: []types.Follow{{Follower: "0", Followee: "1"}, {Follower: "1", Followee: "0"}}, BlockList: []types.Block{{Blocker: "0", Blocked: "2"}}, ChallengeList: []types.Challenge{{Address: "0", Challenge: "00", Creator: "1", ExpiresAt: 300}}},
 			valid:    true,
+		}, {
+			desc: "duplicated challenge",
+			genState: &types.GenesisState{
+				Params:        types.DefaultParams(),
+				ChallengeList: []types.Challenge{{Address: "0", Challenge: "00"}, {Address: "0", Challenge: "01"}},
+			},
+			valid: false,
+		}, {
+			desc: "follow of a blocker",
+			genState: &types.GenesisState{
+				Params:     types.DefaultParams(),
+				FollowList: []types.Follow{{Follower: "0", Followee: "1"}},
+				BlockList:  []types.Block{{Blocker: "1", Blocked: "0"}},
+			},
+			valid: false,
+		}, {
+			desc: "self follow",
+			genState: &types.GenesisState{
+				Params:     types.DefaultParams(),
+				FollowList: []types.Follow{{Follower: "0", Followee: "0"}},
+			},
+			valid: false,
+		}, {
+			desc: "duplicated block",
+			genState: &types.GenesisState{
+				Params:    types.DefaultParams(),
+				BlockList: []types.Block{{Blocker: "0", Blocked: "1"}, {Blocker: "0", Blocked: "1"}},
+			},
+			valid: false,
+		}, {
+			desc: "confusable handles",
+			genState: &types.GenesisState{
+				HandleMap: []types.Handle{{Name: "alice", Owner: "0"}, {Name: "aiice", Owner: "1"}},
+			},
+			valid: false,
+		}, {
+			desc: "two handles for an owner",
+			genState: &types.GenesisState{
+				HandleMap: []types.Handle{{Name: "alice", Owner: "0"}, {Name: "bob", Owner: "0"}},
+			},
+			valid: false,
+		}, {
+			desc: "handle not normalized",
+			genState: &types.GenesisState{
+				HandleMap: []types.Handle{{Name: "Alice", Owner: "0"}},
+			},
+			valid: false,
+		}, {
+			desc: "guardian threshold above guardians",
+			genState: &types.GenesisState{
+				GuardiansMap: []types.Guardians{{Address: "0", Guardians: []string{"1"}, Threshold: 2}},
+			},
+			valid: false,
+		}, {
+			desc: "own guardian",
+			genState: &types.GenesisState{
+				GuardiansMap: []types.Guardians{{Address: "0", Guardians: []string{"0", "1"}, Threshold: 1}},
+			},
+			valid: false,
+		}, {
+			desc: "recovery without guardians",
+			genState: &types.GenesisState{
+				RecoveryMap: []types.Recovery{{Address: "0", NewAddress: "2"}},
+			},
+			valid: false,
+		}, {
+			desc: "invalid persona key id",
+			genState: &types.GenesisState{
+				PersonaKeyList:  []types.PersonaKey{{Id: 1}},
+				PersonaKeyCount: 1,
+			},
+			valid: false,
+		}, {
+			desc: "persona of unknown key",
+			genState: &types.GenesisState{
+				PersonaKeyList:  []types.PersonaKey{{Id: 0}},
+				PersonaKeyCount: 1,
+				PersonaMap:      []types.Persona{{Address: "0", KeyId: 1}},
+			},
+			valid: false,
+		}, {
+			desc: "invalid issuer claim type",
+			genState: &types.GenesisState{
+				IssuerMap: []types.Issuer{{Address: "0", ClaimTypes: []string{"celebrity"}}},
+			},
+			valid: false,
+		}, {
+			desc: "invalid attestation id",
+			genState: &types.GenesisState{
+				AttestationList:  []types.Attestation{{Id: 1, ClaimType: types.ClaimTypeHuman}},
+				AttestationCount: 1,
+			},
+			valid: false,
 		}, {
 			desc: "duplicated userProfile",
 			genState: &types.GenesisState{
@@ -0,0 +1,39 @@
+package types
+
+import "context"
+
+// IdentityHooks are implemented by the modules keeping state keyed by
+// identity address, to follow the identities moved to a new address.
+type IdentityHooks interface {
+	// AfterIdentityMigrated is called after the identity of oldAddress moved
+	// to newAddress, by key rotation or social recovery.
+	AfterIdentityMigrated(ctx context.Context, oldAddress, newAddress string) error
+}
+
+var _ IdentityHooks = MultiIdentityHooks{}
+
+// MultiIdentityHooks combines multiple identity hooks, called in order.
+type MultiIdentityHooks []IdentityHooks
+
+// NewMultiIdentityHooks returns hooks calling each of hooks in order.
+func NewMultiIdentityHooks(hooks ...IdentityHooks) MultiIdentityHooks {
+	return hooks
+}
+
+// AfterIdentityMigrated calls AfterIdentityMigrated on every hook, stopping at
+// the first error.
+func (h MultiIdentityHooks) AfterIdentityMigrated(ctx context.Context, oldAddress, newAddress string) error {
+	for _, hook := range h {
+		if err := hook.AfterIdentityMigrated(ctx, oldAddress, newAddress); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// IdentityHooksWrapper is a wrapper for modules to inject IdentityHooks using
+// depinject.
+type IdentityHooksWrapper struct{ IdentityHooks }
+
+// IsOnePerModuleType implements the depinject.OnePerModuleType interface.
+func (IdentityHooksWrapper) IsOnePerModuleType() {}
@@ -0,0 +1,35 @@
+package types
+
+import "cosmossdk.io/collections"
+
+// IssuerKey is the prefix to retrieve all Issuer
+var IssuerKey = collections.NewPrefix("issuer/value/")
+
+// AttestationKey is the prefix to retrieve all Attestation
+var AttestationKey = collections.NewPrefix("attestation/value/")
+
+// AttestationCountKey is the prefix of the Attestation id sequence
+var AttestationCountKey = collections.NewPrefix("attestation/count/")
+
+// AttestationBySubjectKey is the prefix of the index of Attestation by subject
+var AttestationBySubjectKey = collections.NewPrefix("attestation/subject/")
+
+// Attestation claim types
+const (
+	ClaimTypeKeyControl   = "key-control"
+	ClaimTypeJournalist   = "journalist"
+	ClaimTypeOrganization = "organization"
+	ClaimTypeHuman        = "human"
+	// ClaimTypeDomain attests that the subject controls the domain in the
+	// evidence of the attestation.
+	ClaimTypeDomain = "domain"
+)
+
+// IsValidClaimType reports whether claimType is a known attestation claim type.
+func IsValidClaimType(claimType string) bool {
+	switch claimType {
+	case ClaimTypeKeyControl, ClaimTypeJournalist, ClaimTypeOrganization, ClaimTypeHuman, ClaimTypeDomain:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,17 @@
+package types
+
+import "cosmossdk.io/collections"
+
+// ChallengeKey is the prefix to retrieve all Challenge by address
+var ChallengeKey = collections.NewPrefix("challenge/value/")
+
+// ChallengeByExpiryKey is the prefix of the index of Challenge by
+// (expires_at, address)
+var ChallengeByExpiryKey = collections.NewPrefix("challenge/expiry/")
+
+// ChallengeByCreatorKey is the prefix of the index of Challenge by
+// (creator, address)
+var ChallengeByCreatorKey = collections.NewPrefix("challenge/creator/")
+
+// ChallengeCountKey is the prefix of the sequence of requested challenges
+var ChallengeCountKey = collections.NewPrefix("challenge/count/")
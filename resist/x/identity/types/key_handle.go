@@ -0,0 +1,63 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	"cosmossdk.io/collections"
+)
+
+// HandleKey is the prefix to retrieve all Handle, keyed by skeleton
+var HandleKey = collections.NewPrefix("handle/value/")
+
+// HandleByOwnerKey is the prefix of the index of Handle skeletons by owner
+var HandleByOwnerKey = collections.NewPrefix("handle/owner/")
+
+const (
+	// MinHandleLength is the minimum number of characters of a handle.
+	MinHandleLength = 3
+	// MaxHandleLength is the maximum number of characters of a handle.
+	MaxHandleLength = 30
+)
+
+// NormalizeHandle validates handle and returns its lower-cased form, without
+// the leading @. Handles are made of ASCII letters, digits and underscores
+// and start with a letter.
+func NormalizeHandle(handle string) (string, error) {
+	name := strings.ToLower(strings.TrimPrefix(handle, "@"))
+	if len(name) < MinHandleLength || len(name) > MaxHandleLength {
+		return "", fmt.Errorf("handle must be %d to %d characters long", MinHandleLength, MaxHandleLength)
+	}
+	for i, c := range name {
+		switch {
+		case c >= 'a' && c <= 'z':
+		case (c >= '0' && c <= '9' || c == '_') && i > 0:
+		default:
+			return "", fmt.Errorf("handle must start with a letter and contain only letters, digits and underscores")
+		}
+	}
+	return name, nil
+}
+
+// handleConfusables maps the characters of a normalized handle to the
+// character they are confused with.
+var handleConfusables = strings.NewReplacer(
+	"0", "o",
+	"1", "l",
+	"i", "l",
+	"5", "s",
+	"_", "",
+)
+
+// handleConfusableSequences maps the sequences of characters of a handle to
+// the character they are confused with.
+var handleConfusableSequences = strings.NewReplacer(
+	"rn", "m",
+	"vv", "w",
+)
+
+// HandleSkeleton returns the key of a normalized handle: handles sharing a
+// skeleton cannot be told apart and resolve to the same account.
+func HandleSkeleton(name string) string {
+	return handleConfusableSequences.Replace(handleConfusables.Replace(name))
+}
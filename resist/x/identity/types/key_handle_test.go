@@ -0,0 +1,40 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"resist/x/identity/types"
+)
+
+func TestNormalizeHandle(t *testing.T) {
+	for _, tc := range []struct {
+		handle   string
+		name     string
+		skeleton string
+		valid    bool
+	}{
+		{handle: "@Alice", name: "alice", skeleton: "allce", valid: true},
+		{handle: "al1ce_0", name: "al1ce_0", skeleton: "allceo", valid: true},
+		{handle: "Modern", name: "modern", skeleton: "modem", valid: true},
+		{handle: "vvhistle", name: "vvhistle", skeleton: "whlstle", valid: true},
+		{handle: "ab"},
+		{handle: "_alice"},
+		{handle: "9alice"},
+		{handle: "alice.eth"},
+		{handle: "аlice"}, // Cyrillic a
+		{handle: "a123456789012345678901234567890"},
+	} {
+		t.Run(tc.handle, func(t *testing.T) {
+			name, err := types.NormalizeHandle(tc.handle)
+			if !tc.valid {
+				require.ErrorContains(t, err, "handle")
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.name, name)
+			require.Equal(t, tc.skeleton, types.HandleSkeleton(name))
+		})
+	}
+}
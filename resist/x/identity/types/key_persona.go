@@ -0,0 +1,22 @@
+package types
+
+import "cosmossdk.io/collections"
+
+// PersonaKeyKey is the prefix to retrieve all PersonaKey
+var PersonaKeyKey = collections.NewPrefix("personaKey/value/")
+
+// PersonaKeyCountKey is the prefix of the PersonaKey id sequence
+var PersonaKeyCountKey = collections.NewPrefix("personaKey/count/")
+
+// PersonaRequestKey is the prefix to retrieve all PersonaCredentialRequest
+var PersonaRequestKey = collections.NewPrefix("personaRequest/value/")
+
+// PersonaRequestCountKey is the prefix of the PersonaCredentialRequest id sequence
+var PersonaRequestCountKey = collections.NewPrefix("personaRequest/count/")
+
+// PersonaRequestByRequesterKey is the prefix of the index of
+// PersonaCredentialRequest by requester
+var PersonaRequestByRequesterKey = collections.NewPrefix("personaRequest/requester/")
+
+// PersonaValueKey is the prefix to retrieve all Persona
+var PersonaValueKey = collections.NewPrefix("persona/value/")
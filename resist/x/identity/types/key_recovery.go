@@ -0,0 +1,41 @@
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/collections"
+)
+
+// GuardiansKey is the prefix to retrieve all Guardians
+var GuardiansKey = collections.NewPrefix("guardians/value/")
+
+// GuardianWardKey is the prefix of the index of Guardians by guardian
+var GuardianWardKey = collections.NewPrefix("guardians/ward/")
+
+// RecoveryKey is the prefix to retrieve all Recovery
+var RecoveryKey = collections.NewPrefix("recovery/value/")
+
+// MaxGuardians is the maximum number of guardians of an identity.
+const MaxGuardians = 10
+
+// Validate checks that the guardians are distinct from each other and from
+// the identity owner, and that the threshold can be reached.
+func (g Guardians) Validate() error {
+	if len(g.Guardians) == 0 || len(g.Guardians) > MaxGuardians {
+		return fmt.Errorf("identity must have 1 to %d guardians", MaxGuardians)
+	}
+	if g.Threshold == 0 || int(g.Threshold) > len(g.Guardians) {
+		return fmt.Errorf("threshold must be between 1 and the number of guardians: %d", g.Threshold)
+	}
+	seen := make(map[string]struct{}, len(g.Guardians))
+	for _, guardian := range g.Guardians {
+		if guardian == g.Address {
+			return fmt.Errorf("identity cannot be its own guardian")
+		}
+		if _, ok := seen[guardian]; ok {
+			return fmt.Errorf("duplicated guardian %s", guardian)
+		}
+		seen[guardian] = struct{}{}
+	}
+	return nil
+}
@@ -0,0 +1,21 @@
+package types
+
+import "cosmossdk.io/collections"
+
+// FollowKey is the prefix to retrieve all Follow by (follower, followee)
+var FollowKey = collections.NewPrefix("follow/value/")
+
+// FollowerKey is the prefix of the index of Follow by (followee, follower)
+var FollowerKey = collections.NewPrefix("follow/follower/")
+
+// FollowerCountKey is the prefix of the follower count of each address
+var FollowerCountKey = collections.NewPrefix("follow/followerCount/")
+
+// FollowingCountKey is the prefix of the following count of each address
+var FollowingCountKey = collections.NewPrefix("follow/followingCount/")
+
+// BlockKey is the prefix to retrieve all Block by (blocker, blocked)
+var BlockKey = collections.NewPrefix("block/value/")
+
+// BlockedByKey is the prefix of the index of Block by (blocked, blocker)
+var BlockedByKey = collections.NewPrefix("block/blockedBy/")
@@ -0,0 +1,44 @@
+package types
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+)
+
+// maxDomainLength is the maximum length of a domain name.
+const maxDomainLength = 253
+
+// NormalizeDomain lowercases domain and strips its trailing dot. It must be
+// an ASCII domain name of at least two labels; internationalized domains are
+// given in their punycode form.
+func NormalizeDomain(domain string) (string, error) {
+	name := strings.TrimSuffix(strings.ToLower(domain), ".")
+	if name == "" || len(name) > maxDomainLength {
+		return "", fmt.Errorf("domain must be 1 to %d characters", maxDomainLength)
+	}
+	labels := strings.Split(name, ".")
+	if len(labels) < 2 {
+		return "", fmt.Errorf("domain %q must have at least two labels", domain)
+	}
+	for _, label := range labels {
+		if label == "" || len(label) > 63 || label[0] == '-' || label[len(label)-1] == '-' {
+			return "", fmt.Errorf("invalid domain label %q", label)
+		}
+		for _, r := range label {
+			if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' {
+				return "", fmt.Errorf("invalid character %q in domain %q", r, domain)
+			}
+		}
+	}
+	return name, nil
+}
+
+// LinkToken returns the token an account publishes on a domain to prove it
+// controls it, the hex SHA-256 of "Link <domain> to <address> on <chain_id>".
+// domain must be normalized.
+func LinkToken(chainID, address, domain string) string {
+	hash := sha256.Sum256([]byte("Link " + domain + " to " + address + " on " + chainID))
+	return hex.EncodeToString(hash[:])
+}
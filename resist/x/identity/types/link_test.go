@@ -0,0 +1,25 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"resist/x/identity/types"
+)
+
+func TestNormalizeDomain(t *testing.T) {
+	for domain, want := range map[string]string{
+		"Example.ORG.":          "example.org",
+		"news.example.co.uk":    "news.example.co.uk",
+		"xn--bcher-kva.example": "xn--bcher-kva.example",
+	} {
+		got, err := types.NormalizeDomain(domain)
+		require.NoError(t, err, domain)
+		require.Equal(t, want, got)
+	}
+	for _, domain := range []string{"", "localhost", "example..org", "-example.org", "exa_mple.org", "bücher.example", "example.org/path"} {
+		_, err := types.NormalizeDomain(domain)
+		require.Error(t, err, domain)
+	}
+}
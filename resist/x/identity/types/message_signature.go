@@ -0,0 +1,47 @@
+package types
+
+import (
+	"crypto/sha256"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+)
+
+var (
+	_ codectypes.UnpackInterfacesMessage = (*MsgVerifySignature)(nil)
+	_ codectypes.UnpackInterfacesMessage = (*MsgRotateIdentityKey)(nil)
+)
+
+// UnpackInterfaces implements UnpackInterfacesMessage.
+func (msg *MsgVerifySignature) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var pubKey cryptotypes.PubKey
+	return unpacker.UnpackAny(msg.PubKey, &pubKey)
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.
+func (msg *MsgRotateIdentityKey) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var pubKey cryptotypes.PubKey
+	return unpacker.UnpackAny(msg.PubKey, &pubKey)
+}
+
+// ChallengeSignBytes returns the bytes signed to answer challenge: the
+// SHA-256 hash of "Authenticate with challenge: <challenge>".
+func ChallengeSignBytes(challenge string) []byte {
+	hash := sha256.Sum256([]byte("Authenticate with challenge: " + challenge))
+	return hash[:]
+}
+
+// RotationSignBytes returns the bytes signed by the key of oldAddress to move
+// its identity to newAddress on the chain chainID: the SHA-256 hash of
+// "Rotate identity <oldAddress> to <newAddress> on <chainID>".
+func RotationSignBytes(chainID, oldAddress, newAddress string) []byte {
+	hash := sha256.Sum256([]byte("Rotate identity " + oldAddress + " to " + newAddress + " on " + chainID))
+	return hash[:]
+}
+
+// PersonaMessage returns the message blindly signed by a persona key issuer
+// for the persona at address on the chain chainID: "Persona <address> on
+// <chainID>".
+func PersonaMessage(chainID, address string) []byte {
+	return []byte("Persona " + address + " on " + chainID)
+}
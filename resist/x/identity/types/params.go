@@ -1,17 +1,164 @@
 package types
 
+import (
+	"fmt"
+	"slices"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DefaultRecoveryDelay is the default number of seconds an identity owner has
+// to cancel a recovery approved by their guardians.
+const DefaultRecoveryDelay int64 = 7 * 24 * 60 * 60
+
+// DefaultMaxPersonas is the default number of persona credentials an identity
+// can request.
+const DefaultMaxPersonas uint32 = 3
+
+// DefaultChallengeTTL is the default number of seconds an authentication
+// challenge can be answered.
+const DefaultChallengeTTL int64 = 5 * 60
+
+// DefaultMaxPendingChallenges is the default number of unanswered challenges
+// an account can have requested at once.
+const DefaultMaxPendingChallenges uint32 = 5
+
+// DefaultProfileLimits are the default maximum sizes of user profiles.
+var DefaultProfileLimits = ProfileLimits{
+	MaxDisplayNameLength:   64,
+	MaxBioLength:           500,
+	MaxAvatarUrlLength:     512,
+	MaxMetadataEntries:     10,
+	MaxMetadataKeyLength:   32,
+	MaxMetadataValueLength: 256,
+}
+
+// DefaultVerificationRules verify the accounts holding any valid attestation,
+// and the personas.
+var DefaultVerificationRules = VerificationRules{
+	ClaimTypes: []string{
+		ClaimTypeKeyControl,
+		ClaimTypeJournalist,
+		ClaimTypeOrganization,
+		ClaimTypeHuman,
+		ClaimTypeDomain,
+	},
+	MinIssuers:       1,
+	PersonasVerified: true,
+}
+
+// DefaultReservedHandles are the handles that cannot be claimed by default,
+// to prevent impersonating the network and its moderators.
+var DefaultReservedHandles = []string{
+	"admin",
+	"administrator",
+	"governance",
+	"help",
+	"moderator",
+	"official",
+	"resist",
+	"root",
+	"security",
+	"staff",
+	"support",
+	"system",
+	"validator",
+}
+
 // NewParams creates a new Params instance.
-func NewParams() Params {
-	return Params{}
+func NewParams(
+	handleFee sdk.Coins,
+	reservedHandles []string,
+	recoveryDelay int64,
+	maxPersonas uint32,
+	profileLimits ProfileLimits,
+	challengeTTL int64,
+	maxPendingChallenges uint32,
+	verificationRules VerificationRules,
+) Params {
+	return Params{
+		HandleFee:            handleFee,
+		ReservedHandles:      reservedHandles,
+		RecoveryDelay:        recoveryDelay,
+		MaxPersonas:          maxPersonas,
+		ProfileLimits:        profileLimits,
+		ChallengeTtl:         challengeTTL,
+		MaxPendingChallenges: maxPendingChallenges,
+		VerificationRules:    verificationRules,
+	}
 }
 
-// DefaultParams returns a default set of parameters.
+// DefaultParams returns a default set of parameters. Handles are free by
+// default.
 func DefaultParams() Params {
-	return NewParams()
+	return NewParams(
+		nil,
+		DefaultReservedHandles,
+		DefaultRecoveryDelay,
+		DefaultMaxPersonas,
+		DefaultProfileLimits,
+		DefaultChallengeTTL,
+		DefaultMaxPendingChallenges,
+		DefaultVerificationRules,
+	)
 }
 
 // Validate validates the set of params.
 func (p Params) Validate() error {
+	if err := p.HandleFee.Validate(); err != nil {
+		return fmt.Errorf("invalid handle fee: %w", err)
+	}
+	for _, handle := range p.ReservedHandles {
+		if _, err := NormalizeHandle(handle); err != nil {
+			return fmt.Errorf("invalid reserved handle %q: %w", handle, err)
+		}
+	}
+	if p.RecoveryDelay <= 0 {
+		return fmt.Errorf("recovery delay must be positive: %d", p.RecoveryDelay)
+	}
+	if err := p.ProfileLimits.Validate(); err != nil {
+		return fmt.Errorf("invalid profile limits: %w", err)
+	}
+	if p.ChallengeTtl <= 0 {
+		return fmt.Errorf("challenge ttl must be positive: %d", p.ChallengeTtl)
+	}
+	if p.MaxPendingChallenges == 0 {
+		return fmt.Errorf("max pending challenges must be positive")
+	}
+	if err := p.VerificationRules.Validate(); err != nil {
+		return fmt.Errorf("invalid verification rules: %w", err)
+	}
+
+	return nil
+}
+
+// IsReservedHandle reports whether the normalized handle name is confusable
+// with a reserved handle.
+func (p Params) IsReservedHandle(name string) bool {
+	skeleton := HandleSkeleton(name)
+	for _, reserved := range p.ReservedHandles {
+		if normalized, err := NormalizeHandle(reserved); err == nil && HandleSkeleton(normalized) == skeleton {
+			return true
+		}
+	}
+	return false
+}
 
+// Validate checks that the rules can verify accounts with known claim types.
+func (r VerificationRules) Validate() error {
+	if len(r.ClaimTypes) == 0 {
+		return fmt.Errorf("no claim types")
+	}
+	for i, claimType := range r.ClaimTypes {
+		if !IsValidClaimType(claimType) {
+			return fmt.Errorf("invalid claim type %q", claimType)
+		}
+		if slices.Contains(r.ClaimTypes[:i], claimType) {
+			return fmt.Errorf("duplicated claim type %q", claimType)
+		}
+	}
+	if r.MinIssuers == 0 {
+		return fmt.Errorf("min issuers must be positive")
+	}
 	return nil
 }
@@ -5,6 +5,8 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -26,6 +28,27 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // Params defines the parameters for the module.
 type Params struct {
+	// handle_fee is burned from the account claiming a handle.
+	HandleFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=handle_fee,json=handleFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"handle_fee"`
+	// reserved_handles cannot be claimed, nor any handle confusable with them.
+	ReservedHandles []string `protobuf:"bytes,2,rep,name=reserved_handles,json=reservedHandles,proto3" json:"reserved_handles,omitempty"`
+	// recovery_delay is the number of seconds between the approval of a
+	// recovery by the guardians and its execution, during which the owner can
+	// cancel it.
+	RecoveryDelay int64 `protobuf:"varint,3,opt,name=recovery_delay,json=recoveryDelay,proto3" json:"recovery_delay,omitempty"`
+	// max_personas is the number of persona credentials an identity can
+	// request.
+	MaxPersonas uint32 `protobuf:"varint,4,opt,name=max_personas,json=maxPersonas,proto3" json:"max_personas,omitempty"`
+	// profile_limits bound the size of user profiles.
+	ProfileLimits ProfileLimits `protobuf:"bytes,5,opt,name=profile_limits,json=profileLimits,proto3" json:"profile_limits"`
+	// challenge_ttl is the number of seconds an authentication challenge can
+	// be answered.
+	ChallengeTtl int64 `protobuf:"varint,6,opt,name=challenge_ttl,json=challengeTtl,proto3" json:"challenge_ttl,omitempty"`
+	// max_pending_challenges is the number of unanswered challenges an account
+	// can have requested at once.
+	MaxPendingChallenges uint32 `protobuf:"varint,7,opt,name=max_pending_challenges,json=maxPendingChallenges,proto3" json:"max_pending_challenges,omitempty"`
+	// verification_rules decide which accounts are verified.
+	VerificationRules VerificationRules `protobuf:"bytes,8,opt,name=verification_rules,json=verificationRules,proto3" json:"verification_rules"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -61,24 +84,268 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
+func (m *Params) GetHandleFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.HandleFee
+	}
+	return nil
+}
+
+func (m *Params) GetReservedHandles() []string {
+	if m != nil {
+		return m.ReservedHandles
+	}
+	return nil
+}
+
+func (m *Params) GetRecoveryDelay() int64 {
+	if m != nil {
+		return m.RecoveryDelay
+	}
+	return 0
+}
+
+func (m *Params) GetMaxPersonas() uint32 {
+	if m != nil {
+		return m.MaxPersonas
+	}
+	return 0
+}
+
+func (m *Params) GetProfileLimits() ProfileLimits {
+	if m != nil {
+		return m.ProfileLimits
+	}
+	return ProfileLimits{}
+}
+
+func (m *Params) GetChallengeTtl() int64 {
+	if m != nil {
+		return m.ChallengeTtl
+	}
+	return 0
+}
+
+func (m *Params) GetMaxPendingChallenges() uint32 {
+	if m != nil {
+		return m.MaxPendingChallenges
+	}
+	return 0
+}
+
+func (m *Params) GetVerificationRules() VerificationRules {
+	if m != nil {
+		return m.VerificationRules
+	}
+	return VerificationRules{}
+}
+
+// ProfileLimits are the maximum lengths in bytes of the user profile fields,
+// and the maximum number of profile metadata entries.
+type ProfileLimits struct {
+	MaxDisplayNameLength   uint32 `protobuf:"varint,1,opt,name=max_display_name_length,json=maxDisplayNameLength,proto3" json:"max_display_name_length,omitempty"`
+	MaxBioLength           uint32 `protobuf:"varint,2,opt,name=max_bio_length,json=maxBioLength,proto3" json:"max_bio_length,omitempty"`
+	MaxAvatarUrlLength     uint32 `protobuf:"varint,3,opt,name=max_avatar_url_length,json=maxAvatarUrlLength,proto3" json:"max_avatar_url_length,omitempty"`
+	MaxMetadataEntries     uint32 `protobuf:"varint,4,opt,name=max_metadata_entries,json=maxMetadataEntries,proto3" json:"max_metadata_entries,omitempty"`
+	MaxMetadataKeyLength   uint32 `protobuf:"varint,5,opt,name=max_metadata_key_length,json=maxMetadataKeyLength,proto3" json:"max_metadata_key_length,omitempty"`
+	MaxMetadataValueLength uint32 `protobuf:"varint,6,opt,name=max_metadata_value_length,json=maxMetadataValueLength,proto3" json:"max_metadata_value_length,omitempty"`
+}
+
+func (m *ProfileLimits) Reset()         { *m = ProfileLimits{} }
+func (m *ProfileLimits) String() string { return proto.CompactTextString(m) }
+func (*ProfileLimits) ProtoMessage()    {}
+func (*ProfileLimits) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8da6dd2dc6309bf2, []int{1}
+}
+func (m *ProfileLimits) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProfileLimits) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProfileLimits.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProfileLimits) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProfileLimits.Merge(m, src)
+}
+func (m *ProfileLimits) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProfileLimits) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProfileLimits.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProfileLimits proto.InternalMessageInfo
+
+func (m *ProfileLimits) GetMaxDisplayNameLength() uint32 {
+	if m != nil {
+		return m.MaxDisplayNameLength
+	}
+	return 0
+}
+
+func (m *ProfileLimits) GetMaxBioLength() uint32 {
+	if m != nil {
+		return m.MaxBioLength
+	}
+	return 0
+}
+
+func (m *ProfileLimits) GetMaxAvatarUrlLength() uint32 {
+	if m != nil {
+		return m.MaxAvatarUrlLength
+	}
+	return 0
+}
+
+func (m *ProfileLimits) GetMaxMetadataEntries() uint32 {
+	if m != nil {
+		return m.MaxMetadataEntries
+	}
+	return 0
+}
+
+func (m *ProfileLimits) GetMaxMetadataKeyLength() uint32 {
+	if m != nil {
+		return m.MaxMetadataKeyLength
+	}
+	return 0
+}
+
+func (m *ProfileLimits) GetMaxMetadataValueLength() uint32 {
+	if m != nil {
+		return m.MaxMetadataValueLength
+	}
+	return 0
+}
+
+// VerificationRules decide which accounts are verified.
+type VerificationRules struct {
+	// claim_types are the claim types of the valid attestations verifying an
+	// account.
+	ClaimTypes []string `protobuf:"bytes,1,rep,name=claim_types,json=claimTypes,proto3" json:"claim_types,omitempty"`
+	// min_issuers is the number of distinct issuers that must attest one of
+	// claim_types.
+	MinIssuers uint32 `protobuf:"varint,2,opt,name=min_issuers,json=minIssuers,proto3" json:"min_issuers,omitempty"`
+	// personas_verified verifies the personas holding a valid credential.
+	PersonasVerified bool `protobuf:"varint,3,opt,name=personas_verified,json=personasVerified,proto3" json:"personas_verified,omitempty"`
+}
+
+func (m *VerificationRules) Reset()         { *m = VerificationRules{} }
+func (m *VerificationRules) String() string { return proto.CompactTextString(m) }
+func (*VerificationRules) ProtoMessage()    {}
+func (*VerificationRules) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8da6dd2dc6309bf2, []int{2}
+}
+func (m *VerificationRules) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VerificationRules) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VerificationRules.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VerificationRules) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VerificationRules.Merge(m, src)
+}
+func (m *VerificationRules) XXX_Size() int {
+	return m.Size()
+}
+func (m *VerificationRules) XXX_DiscardUnknown() {
+	xxx_messageInfo_VerificationRules.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VerificationRules proto.InternalMessageInfo
+
+func (m *VerificationRules) GetClaimTypes() []string {
+	if m != nil {
+		return m.ClaimTypes
+	}
+	return nil
+}
+
+func (m *VerificationRules) GetMinIssuers() uint32 {
+	if m != nil {
+		return m.MinIssuers
+	}
+	return 0
+}
+
+func (m *VerificationRules) GetPersonasVerified() bool {
+	if m != nil {
+		return m.PersonasVerified
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "resist.identity.v1.Params")
+	proto.RegisterType((*ProfileLimits)(nil), "resist.identity.v1.ProfileLimits")
+	proto.RegisterType((*VerificationRules)(nil), "resist.identity.v1.VerificationRules")
 }
 
 func init() { proto.RegisterFile("resist/identity/v1/params.proto", fileDescriptor_8da6dd2dc6309bf2) }
 
 var fileDescriptor_8da6dd2dc6309bf2 = []byte{
-	// 159 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x2f, 0x4a, 0x2d, 0xce,
-	0x2c, 0x2e, 0xd1, 0xcf, 0x4c, 0x49, 0xcd, 0x2b, 0xc9, 0x2c, 0xa9, 0xd4, 0x2f, 0x33, 0xd4, 0x2f,
-	0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x82, 0x28, 0xd0,
-	0x83, 0x29, 0xd0, 0x2b, 0x33, 0x94, 0x12, 0x4c, 0xcc, 0xcd, 0xcc, 0xcb, 0xd7, 0x07, 0x93, 0x10,
-	0x65, 0x52, 0x22, 0xe9, 0xf9, 0xe9, 0xf9, 0x60, 0xa6, 0x3e, 0x88, 0x05, 0x11, 0x55, 0xd2, 0xe6,
-	0x62, 0x0b, 0x00, 0x1b, 0x66, 0xa5, 0xf8, 0x62, 0x81, 0x3c, 0x63, 0xd7, 0xf3, 0x0d, 0x5a, 0x12,
-	0x50, 0x0b, 0x2b, 0x10, 0x56, 0x42, 0x94, 0x38, 0x19, 0x9e, 0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91,
-	0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c, 0xc7, 0x70, 0xe3,
-	0xb1, 0x1c, 0x43, 0x94, 0x38, 0xa6, 0x9e, 0x92, 0xca, 0x82, 0xd4, 0xe2, 0x24, 0x36, 0xb0, 0x35,
-	0xc6, 0x80, 0x00, 0x00, 0x00, 0xff, 0xff, 0x9a, 0x02, 0x94, 0x62, 0xc6, 0x00, 0x00, 0x00,
+	// 695 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x54, 0x4f, 0x4f, 0xdb, 0x48,
+	0x14, 0x8f, 0x09, 0x64, 0xc9, 0x84, 0xb0, 0x64, 0xc4, 0x82, 0xe1, 0x90, 0x04, 0x76, 0x91, 0xb2,
+	0xac, 0xd6, 0xde, 0xb0, 0xcb, 0x61, 0xb9, 0x35, 0xd0, 0xaa, 0x55, 0x69, 0x85, 0x52, 0xca, 0xa1,
+	0x97, 0xd1, 0x24, 0x7e, 0x24, 0x23, 0x6c, 0x4f, 0x34, 0x33, 0xb1, 0x92, 0x6f, 0x50, 0xf5, 0xd4,
+	0x8f, 0x50, 0xf5, 0x54, 0xf5, 0xc4, 0xc7, 0xa0, 0x37, 0x8e, 0x3d, 0xb5, 0x15, 0x1c, 0xe8, 0xc7,
+	0xa8, 0x66, 0xc6, 0x4e, 0x43, 0xe9, 0x25, 0xb1, 0x7e, 0x7f, 0xe6, 0xfd, 0xde, 0x9b, 0x67, 0xa3,
+	0x9a, 0x00, 0xc9, 0xa4, 0xf2, 0x59, 0x00, 0xb1, 0x62, 0x6a, 0xec, 0x27, 0x4d, 0x7f, 0x40, 0x05,
+	0x8d, 0xa4, 0x37, 0x10, 0x5c, 0x71, 0x8c, 0xad, 0xc0, 0xcb, 0x04, 0x5e, 0xd2, 0x5c, 0xaf, 0xd0,
+	0x88, 0xc5, 0xdc, 0x37, 0xbf, 0x56, 0xb6, 0x5e, 0xed, 0x72, 0x19, 0x71, 0xe9, 0x77, 0xa8, 0x04,
+	0x3f, 0x69, 0x76, 0x40, 0xd1, 0xa6, 0xdf, 0xe5, 0x2c, 0x4e, 0xf9, 0xe5, 0x1e, 0xef, 0x71, 0xf3,
+	0xe8, 0xeb, 0x27, 0x8b, 0x6e, 0xbe, 0x9d, 0x45, 0x85, 0x23, 0x53, 0x0d, 0x73, 0x84, 0xfa, 0x34,
+	0x0e, 0x42, 0x20, 0xa7, 0x00, 0xae, 0x53, 0xcf, 0x37, 0x4a, 0x3b, 0x6b, 0x9e, 0x3d, 0xd5, 0xd3,
+	0xa7, 0x7a, 0xe9, 0xa9, 0xde, 0x3e, 0x67, 0x71, 0x6b, 0xf7, 0xe2, 0x53, 0x2d, 0xf7, 0xfe, 0x73,
+	0xad, 0xd1, 0x63, 0xaa, 0x3f, 0xec, 0x78, 0x5d, 0x1e, 0xf9, 0x69, 0x04, 0xfb, 0xf7, 0xb7, 0x0c,
+	0xce, 0x7c, 0x35, 0x1e, 0x80, 0x34, 0x06, 0xf9, 0xee, 0xe6, 0x7c, 0xdb, 0x69, 0x17, 0x6d, 0x8d,
+	0x07, 0x00, 0xf8, 0x4f, 0xb4, 0x24, 0x40, 0x82, 0x48, 0x20, 0x20, 0x16, 0x95, 0xee, 0x4c, 0x3d,
+	0xdf, 0x28, 0xb6, 0x7f, 0xcd, 0xf0, 0x87, 0x16, 0xc6, 0x5b, 0x68, 0x51, 0x40, 0x97, 0x27, 0x20,
+	0xc6, 0x24, 0x80, 0x90, 0x8e, 0xdd, 0x7c, 0xdd, 0x69, 0xe4, 0xdb, 0xe5, 0x0c, 0x3d, 0xd0, 0x20,
+	0xde, 0x40, 0x0b, 0x11, 0x1d, 0x91, 0x01, 0x08, 0xc9, 0x63, 0x2a, 0xdd, 0xd9, 0xba, 0xd3, 0x28,
+	0xb7, 0x4b, 0x11, 0x1d, 0x1d, 0xa5, 0x10, 0x7e, 0x86, 0x16, 0x07, 0x82, 0x9f, 0xb2, 0x10, 0x48,
+	0xc8, 0x22, 0xa6, 0xa4, 0x3b, 0x57, 0x77, 0x1a, 0xa5, 0x9d, 0x0d, 0xef, 0xee, 0x98, 0xbd, 0x23,
+	0xab, 0x3c, 0x34, 0xc2, 0x56, 0x51, 0x77, 0x6c, 0xbb, 0x28, 0x0f, 0xa6, 0x19, 0xfc, 0x3b, 0x2a,
+	0x77, 0xfb, 0x34, 0x0c, 0x21, 0xee, 0x01, 0x51, 0x2a, 0x74, 0x0b, 0x26, 0xdd, 0xc2, 0x04, 0x3c,
+	0x56, 0x21, 0xfe, 0x0f, 0xad, 0xd8, 0x70, 0x71, 0xc0, 0xe2, 0x1e, 0x99, 0x70, 0xd2, 0xfd, 0xc5,
+	0xc4, 0x5c, 0x36, 0x31, 0x0d, 0xb9, 0x3f, 0xe1, 0x30, 0x41, 0x38, 0x01, 0xc1, 0x4e, 0x59, 0x97,
+	0x2a, 0xc6, 0x63, 0x22, 0x86, 0x7a, 0x4c, 0xf3, 0x26, 0xf3, 0xd6, 0xcf, 0x32, 0x9f, 0x4c, 0xa9,
+	0xdb, 0x5a, 0x3c, 0x9d, 0xbb, 0x92, 0xfc, 0xc8, 0xee, 0x6d, 0x7c, 0x7d, 0x53, 0x73, 0x5e, 0xdd,
+	0x9c, 0x6f, 0xbb, 0xe9, 0x22, 0x8e, 0xbe, 0xaf, 0xa2, 0xdd, 0x8c, 0xcd, 0x0f, 0x33, 0xa8, 0x7c,
+	0x6b, 0x14, 0x78, 0x17, 0xad, 0xea, 0x5e, 0x02, 0x26, 0x07, 0x21, 0x1d, 0x93, 0x98, 0x46, 0x40,
+	0x74, 0x60, 0xd5, 0x77, 0x9d, 0x49, 0x33, 0x07, 0x96, 0x7d, 0x4a, 0x23, 0x38, 0x34, 0x1c, 0xfe,
+	0x03, 0x2d, 0x6a, 0x5b, 0x87, 0xf1, 0x4c, 0x3d, 0x63, 0xd4, 0xfa, 0xd6, 0x5a, 0x8c, 0xa7, 0xaa,
+	0x26, 0xfa, 0x4d, 0xab, 0x68, 0x42, 0x15, 0x15, 0x64, 0x28, 0xc2, 0x4c, 0x9c, 0x37, 0x62, 0x1c,
+	0xd1, 0xd1, 0x3d, 0xc3, 0x3d, 0x17, 0x61, 0x6a, 0xf9, 0x07, 0xe9, 0x82, 0x24, 0x02, 0x45, 0x03,
+	0xaa, 0x28, 0x81, 0x58, 0x09, 0x06, 0xd9, 0x02, 0x68, 0xc7, 0x93, 0x94, 0xba, 0x6f, 0x99, 0xac,
+	0x83, 0x89, 0xe3, 0x0c, 0xc6, 0x59, 0x99, 0xb9, 0x49, 0x07, 0x99, 0xe9, 0x31, 0x8c, 0xd3, 0x42,
+	0xff, 0xa3, 0xb5, 0x5b, 0xb6, 0x84, 0x86, 0xc3, 0x49, 0xeb, 0x05, 0x63, 0x5c, 0x99, 0x32, 0x9e,
+	0x68, 0xda, 0x5a, 0xf7, 0x66, 0xf5, 0xa0, 0x37, 0x5f, 0x3a, 0xa8, 0x72, 0xe7, 0x8a, 0x70, 0x0d,
+	0x95, 0xba, 0x21, 0x65, 0x11, 0x31, 0xef, 0x8b, 0x79, 0xf9, 0x8a, 0x6d, 0x64, 0xa0, 0x63, 0x8d,
+	0x68, 0x41, 0xc4, 0x62, 0xc2, 0xa4, 0x1c, 0x82, 0x90, 0xe9, 0xd8, 0x50, 0xc4, 0xe2, 0x47, 0x16,
+	0xc1, 0x7f, 0xa1, 0x4a, 0xb6, 0xf6, 0xc4, 0x5e, 0x32, 0x04, 0x66, 0x60, 0xf3, 0xed, 0xa5, 0x8c,
+	0x38, 0x49, 0x71, 0x1b, 0xa5, 0xd5, 0xbc, 0xb8, 0xaa, 0x3a, 0x97, 0x57, 0x55, 0xe7, 0xcb, 0x55,
+	0xd5, 0x79, 0x7d, 0x5d, 0xcd, 0x5d, 0x5e, 0x57, 0x73, 0x1f, 0xaf, 0xab, 0xb9, 0x17, 0xab, 0x77,
+	0x57, 0xc1, 0x04, 0xeb, 0x14, 0xcc, 0x57, 0xe3, 0xdf, 0x6f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x0f,
+	0x8f, 0xc4, 0xa3, 0xb5, 0x04, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -100,6 +367,114 @@ func (this *Params) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
+	if len(this.HandleFee) != len(that1.HandleFee) {
+		return false
+	}
+	for i := range this.HandleFee {
+		if !this.HandleFee[i].Equal(&that1.HandleFee[i]) {
+			return false
+		}
+	}
+	if len(this.ReservedHandles) != len(that1.ReservedHandles) {
+		return false
+	}
+	for i := range this.ReservedHandles {
+		if this.ReservedHandles[i] != that1.ReservedHandles[i] {
+			return false
+		}
+	}
+	if this.RecoveryDelay != that1.RecoveryDelay {
+		return false
+	}
+	if this.MaxPersonas != that1.MaxPersonas {
+		return false
+	}
+	if !this.ProfileLimits.Equal(&that1.ProfileLimits) {
+		return false
+	}
+	if this.ChallengeTtl != that1.ChallengeTtl {
+		return false
+	}
+	if this.MaxPendingChallenges != that1.MaxPendingChallenges {
+		return false
+	}
+	if !this.VerificationRules.Equal(&that1.VerificationRules) {
+		return false
+	}
+	return true
+}
+func (this *ProfileLimits) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ProfileLimits)
+	if !ok {
+		that2, ok := that.(ProfileLimits)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.MaxDisplayNameLength != that1.MaxDisplayNameLength {
+		return false
+	}
+	if this.MaxBioLength != that1.MaxBioLength {
+		return false
+	}
+	if this.MaxAvatarUrlLength != that1.MaxAvatarUrlLength {
+		return false
+	}
+	if this.MaxMetadataEntries != that1.MaxMetadataEntries {
+		return false
+	}
+	if this.MaxMetadataKeyLength != that1.MaxMetadataKeyLength {
+		return false
+	}
+	if this.MaxMetadataValueLength != that1.MaxMetadataValueLength {
+		return false
+	}
+	return true
+}
+func (this *VerificationRules) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*VerificationRules)
+	if !ok {
+		that2, ok := that.(VerificationRules)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.ClaimTypes) != len(that1.ClaimTypes) {
+		return false
+	}
+	for i := range this.ClaimTypes {
+		if this.ClaimTypes[i] != that1.ClaimTypes[i] {
+			return false
+		}
+	}
+	if this.MinIssuers != that1.MinIssuers {
+		return false
+	}
+	if this.PersonasVerified != that1.PersonasVerified {
+		return false
+	}
 	return true
 }
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -122,6 +497,169 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.VerificationRules.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	if m.MaxPendingChallenges != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxPendingChallenges))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.ChallengeTtl != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ChallengeTtl))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size, err := m.ProfileLimits.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.MaxPersonas != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxPersonas))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.RecoveryDelay != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.RecoveryDelay))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ReservedHandles) > 0 {
+		for iNdEx := len(m.ReservedHandles) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ReservedHandles[iNdEx])
+			copy(dAtA[i:], m.ReservedHandles[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.ReservedHandles[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.HandleFee) > 0 {
+		for iNdEx := len(m.HandleFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HandleFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProfileLimits) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProfileLimits) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProfileLimits) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxMetadataValueLength != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxMetadataValueLength))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.MaxMetadataKeyLength != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxMetadataKeyLength))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.MaxMetadataEntries != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxMetadataEntries))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MaxAvatarUrlLength != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxAvatarUrlLength))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MaxBioLength != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxBioLength))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MaxDisplayNameLength != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxDisplayNameLength))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VerificationRules) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VerificationRules) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VerificationRules) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PersonasVerified {
+		i--
+		if m.PersonasVerified {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MinIssuers != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MinIssuers))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ClaimTypes) > 0 {
+		for iNdEx := len(m.ClaimTypes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ClaimTypes[iNdEx])
+			copy(dAtA[i:], m.ClaimTypes[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.ClaimTypes[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -142,14 +680,90 @@ func (m *Params) Size() (n int) {
 	}
 	var l int
 	_ = l
+	if len(m.HandleFee) > 0 {
+		for _, e := range m.HandleFee {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if len(m.ReservedHandles) > 0 {
+		for _, s := range m.ReservedHandles {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if m.RecoveryDelay != 0 {
+		n += 1 + sovParams(uint64(m.RecoveryDelay))
+	}
+	if m.MaxPersonas != 0 {
+		n += 1 + sovParams(uint64(m.MaxPersonas))
+	}
+	l = m.ProfileLimits.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.ChallengeTtl != 0 {
+		n += 1 + sovParams(uint64(m.ChallengeTtl))
+	}
+	if m.MaxPendingChallenges != 0 {
+		n += 1 + sovParams(uint64(m.MaxPendingChallenges))
+	}
+	l = m.VerificationRules.Size()
+	n += 1 + l + sovParams(uint64(l))
 	return n
 }
 
-func sovParams(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozParams(x uint64) (n int) {
-	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *ProfileLimits) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxDisplayNameLength != 0 {
+		n += 1 + sovParams(uint64(m.MaxDisplayNameLength))
+	}
+	if m.MaxBioLength != 0 {
+		n += 1 + sovParams(uint64(m.MaxBioLength))
+	}
+	if m.MaxAvatarUrlLength != 0 {
+		n += 1 + sovParams(uint64(m.MaxAvatarUrlLength))
+	}
+	if m.MaxMetadataEntries != 0 {
+		n += 1 + sovParams(uint64(m.MaxMetadataEntries))
+	}
+	if m.MaxMetadataKeyLength != 0 {
+		n += 1 + sovParams(uint64(m.MaxMetadataKeyLength))
+	}
+	if m.MaxMetadataValueLength != 0 {
+		n += 1 + sovParams(uint64(m.MaxMetadataValueLength))
+	}
+	return n
+}
+
+func (m *VerificationRules) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ClaimTypes) > 0 {
+		for _, s := range m.ClaimTypes {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if m.MinIssuers != 0 {
+		n += 1 + sovParams(uint64(m.MinIssuers))
+	}
+	if m.PersonasVerified {
+		n += 2
+	}
+	return n
+}
+
+func sovParams(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozParams(x uint64) (n int) {
+	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
 func (m *Params) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
@@ -180,6 +794,499 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HandleFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HandleFee = append(m.HandleFee, types.Coin{})
+			if err := m.HandleFee[len(m.HandleFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReservedHandles", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReservedHandles = append(m.ReservedHandles, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecoveryDelay", wireType)
+			}
+			m.RecoveryDelay = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RecoveryDelay |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPersonas", wireType)
+			}
+			m.MaxPersonas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPersonas |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProfileLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProfileLimits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChallengeTtl", wireType)
+			}
+			m.ChallengeTtl = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChallengeTtl |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPendingChallenges", wireType)
+			}
+			m.MaxPendingChallenges = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPendingChallenges |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerificationRules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.VerificationRules.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProfileLimits) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProfileLimits: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProfileLimits: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDisplayNameLength", wireType)
+			}
+			m.MaxDisplayNameLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxDisplayNameLength |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBioLength", wireType)
+			}
+			m.MaxBioLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxBioLength |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAvatarUrlLength", wireType)
+			}
+			m.MaxAvatarUrlLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxAvatarUrlLength |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMetadataEntries", wireType)
+			}
+			m.MaxMetadataEntries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxMetadataEntries |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMetadataKeyLength", wireType)
+			}
+			m.MaxMetadataKeyLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxMetadataKeyLength |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMetadataValueLength", wireType)
+			}
+			m.MaxMetadataValueLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxMetadataValueLength |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VerificationRules) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VerificationRules: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VerificationRules: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimTypes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClaimTypes = append(m.ClaimTypes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinIssuers", wireType)
+			}
+			m.MinIssuers = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinIssuers |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PersonasVerified", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.PersonasVerified = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
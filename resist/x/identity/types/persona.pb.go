@@ -0,0 +1,1155 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: resist/identity/v1/persona.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// PersonaKey is an RSA key an issuer blindly signs persona credentials with.
+type PersonaKey struct {
+	Id     uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Issuer string `protobuf:"bytes,2,opt,name=issuer,proto3" json:"issuer,omitempty"`
+	// public_key is the PKIX, ASN.1 DER encoded RSA public key.
+	PublicKey    []byte `protobuf:"bytes,3,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	RegisteredAt int64  `protobuf:"varint,4,opt,name=registered_at,json=registeredAt,proto3" json:"registered_at,omitempty"`
+	Revoked      bool   `protobuf:"varint,5,opt,name=revoked,proto3" json:"revoked,omitempty"`
+}
+
+func (m *PersonaKey) Reset()         { *m = PersonaKey{} }
+func (m *PersonaKey) String() string { return proto.CompactTextString(m) }
+func (*PersonaKey) ProtoMessage()    {}
+func (*PersonaKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_615ac12593d06b45, []int{0}
+}
+func (m *PersonaKey) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PersonaKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PersonaKey.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PersonaKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PersonaKey.Merge(m, src)
+}
+func (m *PersonaKey) XXX_Size() int {
+	return m.Size()
+}
+func (m *PersonaKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_PersonaKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PersonaKey proto.InternalMessageInfo
+
+func (m *PersonaKey) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *PersonaKey) GetIssuer() string {
+	if m != nil {
+		return m.Issuer
+	}
+	return ""
+}
+
+func (m *PersonaKey) GetPublicKey() []byte {
+	if m != nil {
+		return m.PublicKey
+	}
+	return nil
+}
+
+func (m *PersonaKey) GetRegisteredAt() int64 {
+	if m != nil {
+		return m.RegisteredAt
+	}
+	return 0
+}
+
+func (m *PersonaKey) GetRevoked() bool {
+	if m != nil {
+		return m.Revoked
+	}
+	return false
+}
+
+// PersonaCredentialRequest is a blinded persona credential requested by a
+// verified identity to a persona key issuer.
+type PersonaCredentialRequest struct {
+	Id             uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Requester      string `protobuf:"bytes,2,opt,name=requester,proto3" json:"requester,omitempty"`
+	KeyId          uint64 `protobuf:"varint,3,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
+	BlindedMessage []byte `protobuf:"bytes,4,opt,name=blinded_message,json=blindedMessage,proto3" json:"blinded_message,omitempty"`
+	// blind_signature is set once the issuer signed the blinded message.
+	BlindSignature []byte `protobuf:"bytes,5,opt,name=blind_signature,json=blindSignature,proto3" json:"blind_signature,omitempty"`
+	RequestedAt    int64  `protobuf:"varint,6,opt,name=requested_at,json=requestedAt,proto3" json:"requested_at,omitempty"`
+	IssuedAt       int64  `protobuf:"varint,7,opt,name=issued_at,json=issuedAt,proto3" json:"issued_at,omitempty"`
+}
+
+func (m *PersonaCredentialRequest) Reset()         { *m = PersonaCredentialRequest{} }
+func (m *PersonaCredentialRequest) String() string { return proto.CompactTextString(m) }
+func (*PersonaCredentialRequest) ProtoMessage()    {}
+func (*PersonaCredentialRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_615ac12593d06b45, []int{1}
+}
+func (m *PersonaCredentialRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PersonaCredentialRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PersonaCredentialRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PersonaCredentialRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PersonaCredentialRequest.Merge(m, src)
+}
+func (m *PersonaCredentialRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *PersonaCredentialRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PersonaCredentialRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PersonaCredentialRequest proto.InternalMessageInfo
+
+func (m *PersonaCredentialRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *PersonaCredentialRequest) GetRequester() string {
+	if m != nil {
+		return m.Requester
+	}
+	return ""
+}
+
+func (m *PersonaCredentialRequest) GetKeyId() uint64 {
+	if m != nil {
+		return m.KeyId
+	}
+	return 0
+}
+
+func (m *PersonaCredentialRequest) GetBlindedMessage() []byte {
+	if m != nil {
+		return m.BlindedMessage
+	}
+	return nil
+}
+
+func (m *PersonaCredentialRequest) GetBlindSignature() []byte {
+	if m != nil {
+		return m.BlindSignature
+	}
+	return nil
+}
+
+func (m *PersonaCredentialRequest) GetRequestedAt() int64 {
+	if m != nil {
+		return m.RequestedAt
+	}
+	return 0
+}
+
+func (m *PersonaCredentialRequest) GetIssuedAt() int64 {
+	if m != nil {
+		return m.IssuedAt
+	}
+	return 0
+}
+
+// Persona is an account holding a persona credential. It proves that a
+// verified human controls it without revealing which identity.
+type Persona struct {
+	Address      string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	KeyId        uint64 `protobuf:"varint,2,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
+	RegisteredAt int64  `protobuf:"varint,3,opt,name=registered_at,json=registeredAt,proto3" json:"registered_at,omitempty"`
+}
+
+func (m *Persona) Reset()         { *m = Persona{} }
+func (m *Persona) String() string { return proto.CompactTextString(m) }
+func (*Persona) ProtoMessage()    {}
+func (*Persona) Descriptor() ([]byte, []int) {
+	return fileDescriptor_615ac12593d06b45, []int{2}
+}
+func (m *Persona) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Persona) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Persona.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Persona) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Persona.Merge(m, src)
+}
+func (m *Persona) XXX_Size() int {
+	return m.Size()
+}
+func (m *Persona) XXX_DiscardUnknown() {
+	xxx_messageInfo_Persona.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Persona proto.InternalMessageInfo
+
+func (m *Persona) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *Persona) GetKeyId() uint64 {
+	if m != nil {
+		return m.KeyId
+	}
+	return 0
+}
+
+func (m *Persona) GetRegisteredAt() int64 {
+	if m != nil {
+		return m.RegisteredAt
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*PersonaKey)(nil), "resist.identity.v1.PersonaKey")
+	proto.RegisterType((*PersonaCredentialRequest)(nil), "resist.identity.v1.PersonaCredentialRequest")
+	proto.RegisterType((*Persona)(nil), "resist.identity.v1.Persona")
+}
+
+func init() { proto.RegisterFile("resist/identity/v1/persona.proto", fileDescriptor_615ac12593d06b45) }
+
+var fileDescriptor_615ac12593d06b45 = []byte{
+	// 377 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x92, 0xc1, 0x4a, 0xeb, 0x40,
+	0x14, 0x86, 0x3b, 0x69, 0x9b, 0x36, 0xe7, 0xe6, 0xf6, 0xc2, 0xc0, 0xbd, 0x37, 0x70, 0xaf, 0x21,
+	0xd6, 0x85, 0x59, 0xb5, 0x14, 0x9f, 0xa0, 0xba, 0x12, 0x11, 0x64, 0xdc, 0xb9, 0x09, 0xa9, 0x73,
+	0x28, 0x43, 0x6a, 0x12, 0x67, 0x26, 0xc5, 0xbc, 0x85, 0xf8, 0x54, 0x2e, 0xbb, 0x74, 0x29, 0xed,
+	0x13, 0xf8, 0x06, 0xd2, 0x49, 0xd2, 0x16, 0xba, 0x3c, 0xdf, 0xf9, 0x99, 0xf9, 0xff, 0x9f, 0x03,
+	0x81, 0x44, 0x25, 0x94, 0x1e, 0x0b, 0x8e, 0xa9, 0x16, 0xba, 0x1c, 0x2f, 0x27, 0xe3, 0x1c, 0xa5,
+	0xca, 0xd2, 0x78, 0x94, 0xcb, 0x4c, 0x67, 0x94, 0x56, 0x8a, 0x51, 0xa3, 0x18, 0x2d, 0x27, 0xc3,
+	0x37, 0x02, 0x70, 0x57, 0xa9, 0x6e, 0xb0, 0xa4, 0x03, 0xb0, 0x04, 0xf7, 0x48, 0x40, 0xc2, 0x0e,
+	0xb3, 0x04, 0xa7, 0x7f, 0xc0, 0x16, 0x4a, 0x15, 0x28, 0x3d, 0x2b, 0x20, 0xa1, 0xc3, 0xea, 0x89,
+	0x9e, 0x00, 0xe4, 0xc5, 0x6c, 0x21, 0x1e, 0xa3, 0x04, 0x4b, 0xaf, 0x1d, 0x90, 0xd0, 0x65, 0x4e,
+	0x45, 0xb6, 0xcf, 0x9c, 0xc1, 0x4f, 0x89, 0x73, 0xa1, 0x34, 0x4a, 0xe4, 0x51, 0xac, 0xbd, 0x4e,
+	0x40, 0xc2, 0x36, 0x73, 0xf7, 0x70, 0xaa, 0xa9, 0x07, 0x3d, 0x89, 0xcb, 0x2c, 0x41, 0xee, 0x75,
+	0x03, 0x12, 0xf6, 0x59, 0x33, 0x0e, 0xbf, 0x08, 0x78, 0xb5, 0xa9, 0x2b, 0x89, 0xc6, 0x6d, 0xbc,
+	0x60, 0xf8, 0x5c, 0xa0, 0xd2, 0x47, 0x16, 0xff, 0x83, 0x23, 0xab, 0xd5, 0xce, 0xe5, 0x1e, 0xd0,
+	0xdf, 0x60, 0x27, 0x58, 0x46, 0x82, 0x1b, 0x93, 0x1d, 0xd6, 0x4d, 0xb0, 0xbc, 0xe6, 0xf4, 0x1c,
+	0x7e, 0xcd, 0x16, 0x22, 0xe5, 0xc8, 0xa3, 0x27, 0x54, 0x2a, 0x9e, 0xa3, 0xb1, 0xe8, 0xb2, 0x41,
+	0x8d, 0x6f, 0x2b, 0xba, 0x13, 0x46, 0x4a, 0xcc, 0xd3, 0x58, 0x17, 0x12, 0x8d, 0xd9, 0x46, 0x78,
+	0xdf, 0x50, 0x7a, 0x0a, 0x6e, 0xf3, 0xab, 0x49, 0x6c, 0x9b, 0xc4, 0x3f, 0x76, 0x6c, 0xaa, 0xe9,
+	0x3f, 0x70, 0x4c, 0x7d, 0x66, 0xdf, 0x33, 0xfb, 0x7e, 0x05, 0xa6, 0x7a, 0x18, 0x41, 0xaf, 0x8e,
+	0xbc, 0x2d, 0x26, 0xe6, 0x5c, 0xa2, 0x52, 0x26, 0xa6, 0xc3, 0x9a, 0xf1, 0x20, 0x8d, 0x75, 0x98,
+	0xe6, 0xa8, 0xee, 0xf6, 0x71, 0xdd, 0x97, 0x93, 0xf7, 0xb5, 0x4f, 0x56, 0x6b, 0x9f, 0x7c, 0xae,
+	0x7d, 0xf2, 0xba, 0xf1, 0x5b, 0xab, 0x8d, 0xdf, 0xfa, 0xd8, 0xf8, 0xad, 0x87, 0xbf, 0xf5, 0xe5,
+	0xbc, 0xec, 0x6f, 0x47, 0x97, 0x39, 0xaa, 0x99, 0x6d, 0xee, 0xe6, 0xe2, 0x3b, 0x00, 0x00, 0xff,
+	0xff, 0x09, 0xc3, 0x12, 0x0b, 0x5b, 0x02, 0x00, 0x00,
+}
+
+func (m *PersonaKey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PersonaKey) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PersonaKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Revoked {
+		i--
+		if m.Revoked {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.RegisteredAt != 0 {
+		i = encodeVarintPersona(dAtA, i, uint64(m.RegisteredAt))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.PublicKey) > 0 {
+		i -= len(m.PublicKey)
+		copy(dAtA[i:], m.PublicKey)
+		i = encodeVarintPersona(dAtA, i, uint64(len(m.PublicKey)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Issuer) > 0 {
+		i -= len(m.Issuer)
+		copy(dAtA[i:], m.Issuer)
+		i = encodeVarintPersona(dAtA, i, uint64(len(m.Issuer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintPersona(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PersonaCredentialRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PersonaCredentialRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PersonaCredentialRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IssuedAt != 0 {
+		i = encodeVarintPersona(dAtA, i, uint64(m.IssuedAt))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.RequestedAt != 0 {
+		i = encodeVarintPersona(dAtA, i, uint64(m.RequestedAt))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.BlindSignature) > 0 {
+		i -= len(m.BlindSignature)
+		copy(dAtA[i:], m.BlindSignature)
+		i = encodeVarintPersona(dAtA, i, uint64(len(m.BlindSignature)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.BlindedMessage) > 0 {
+		i -= len(m.BlindedMessage)
+		copy(dAtA[i:], m.BlindedMessage)
+		i = encodeVarintPersona(dAtA, i, uint64(len(m.BlindedMessage)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.KeyId != 0 {
+		i = encodeVarintPersona(dAtA, i, uint64(m.KeyId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Requester) > 0 {
+		i -= len(m.Requester)
+		copy(dAtA[i:], m.Requester)
+		i = encodeVarintPersona(dAtA, i, uint64(len(m.Requester)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintPersona(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Persona) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Persona) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Persona) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RegisteredAt != 0 {
+		i = encodeVarintPersona(dAtA, i, uint64(m.RegisteredAt))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.KeyId != 0 {
+		i = encodeVarintPersona(dAtA, i, uint64(m.KeyId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintPersona(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintPersona(dAtA []byte, offset int, v uint64) int {
+	offset -= sovPersona(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *PersonaKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovPersona(uint64(m.Id))
+	}
+	l = len(m.Issuer)
+	if l > 0 {
+		n += 1 + l + sovPersona(uint64(l))
+	}
+	l = len(m.PublicKey)
+	if l > 0 {
+		n += 1 + l + sovPersona(uint64(l))
+	}
+	if m.RegisteredAt != 0 {
+		n += 1 + sovPersona(uint64(m.RegisteredAt))
+	}
+	if m.Revoked {
+		n += 2
+	}
+	return n
+}
+
+func (m *PersonaCredentialRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovPersona(uint64(m.Id))
+	}
+	l = len(m.Requester)
+	if l > 0 {
+		n += 1 + l + sovPersona(uint64(l))
+	}
+	if m.KeyId != 0 {
+		n += 1 + sovPersona(uint64(m.KeyId))
+	}
+	l = len(m.BlindedMessage)
+	if l > 0 {
+		n += 1 + l + sovPersona(uint64(l))
+	}
+	l = len(m.BlindSignature)
+	if l > 0 {
+		n += 1 + l + sovPersona(uint64(l))
+	}
+	if m.RequestedAt != 0 {
+		n += 1 + sovPersona(uint64(m.RequestedAt))
+	}
+	if m.IssuedAt != 0 {
+		n += 1 + sovPersona(uint64(m.IssuedAt))
+	}
+	return n
+}
+
+func (m *Persona) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovPersona(uint64(l))
+	}
+	if m.KeyId != 0 {
+		n += 1 + sovPersona(uint64(m.KeyId))
+	}
+	if m.RegisteredAt != 0 {
+		n += 1 + sovPersona(uint64(m.RegisteredAt))
+	}
+	return n
+}
+
+func sovPersona(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozPersona(x uint64) (n int) {
+	return sovPersona(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *PersonaKey) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPersona
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PersonaKey: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PersonaKey: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPersona
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Issuer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPersona
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPersona
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPersona
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Issuer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPersona
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPersona
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPersona
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublicKey = append(m.PublicKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PublicKey == nil {
+				m.PublicKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegisteredAt", wireType)
+			}
+			m.RegisteredAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPersona
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RegisteredAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revoked", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPersona
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Revoked = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPersona(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPersona
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PersonaCredentialRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPersona
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PersonaCredentialRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PersonaCredentialRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPersona
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Requester", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPersona
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPersona
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPersona
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Requester = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyId", wireType)
+			}
+			m.KeyId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPersona
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.KeyId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlindedMessage", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPersona
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPersona
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPersona
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlindedMessage = append(m.BlindedMessage[:0], dAtA[iNdEx:postIndex]...)
+			if m.BlindedMessage == nil {
+				m.BlindedMessage = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlindSignature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPersona
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPersona
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPersona
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlindSignature = append(m.BlindSignature[:0], dAtA[iNdEx:postIndex]...)
+			if m.BlindSignature == nil {
+				m.BlindSignature = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestedAt", wireType)
+			}
+			m.RequestedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPersona
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RequestedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IssuedAt", wireType)
+			}
+			m.IssuedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPersona
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IssuedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPersona(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPersona
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Persona) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPersona
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Persona: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Persona: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPersona
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPersona
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPersona
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyId", wireType)
+			}
+			m.KeyId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPersona
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.KeyId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegisteredAt", wireType)
+			}
+			m.RegisteredAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPersona
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RegisteredAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPersona(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPersona
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipPersona(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowPersona
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPersona
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPersona
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthPersona
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupPersona
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthPersona
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthPersona        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowPersona          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupPersona = fmt.Errorf("proto: unexpected end of group")
+)
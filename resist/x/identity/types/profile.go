@@ -0,0 +1,55 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Well-known profile metadata keys. Other keys are allowed.
+const (
+	MetadataKeyWebsite = "website"
+	MetadataKeyPGP     = "pgp"
+	MetadataKeyMatrix  = "matrix"
+)
+
+// Validate checks that the limits allow non-empty profiles.
+func (l ProfileLimits) Validate() error {
+	if l.MaxDisplayNameLength == 0 || l.MaxBioLength == 0 || l.MaxAvatarUrlLength == 0 {
+		return errors.New("profile field lengths must be positive")
+	}
+	if l.MaxMetadataEntries > 0 && (l.MaxMetadataKeyLength == 0 || l.MaxMetadataValueLength == 0) {
+		return errors.New("metadata key and value lengths must be positive")
+	}
+	return nil
+}
+
+// ValidateProfile checks the profile fields against the limits, and that the
+// metadata keys are distinct.
+func (l ProfileLimits) ValidateProfile(displayName, bio, avatarURL string, metadata []ProfileMetadata) error {
+	if len(displayName) > int(l.MaxDisplayNameLength) {
+		return fmt.Errorf("display name longer than %d bytes", l.MaxDisplayNameLength)
+	}
+	if len(bio) > int(l.MaxBioLength) {
+		return fmt.Errorf("bio longer than %d bytes", l.MaxBioLength)
+	}
+	if len(avatarURL) > int(l.MaxAvatarUrlLength) {
+		return fmt.Errorf("avatar url longer than %d bytes", l.MaxAvatarUrlLength)
+	}
+	if len(metadata) > int(l.MaxMetadataEntries) {
+		return fmt.Errorf("more than %d metadata entries", l.MaxMetadataEntries)
+	}
+	keys := make(map[string]struct{}, len(metadata))
+	for _, entry := range metadata {
+		if entry.Key == "" || len(entry.Key) > int(l.MaxMetadataKeyLength) {
+			return fmt.Errorf("metadata key %q must be 1 to %d bytes", entry.Key, l.MaxMetadataKeyLength)
+		}
+		if len(entry.Value) > int(l.MaxMetadataValueLength) {
+			return fmt.Errorf("metadata %q longer than %d bytes", entry.Key, l.MaxMetadataValueLength)
+		}
+		if _, ok := keys[entry.Key]; ok {
+			return fmt.Errorf("duplicated metadata key %q", entry.Key)
+		}
+		keys[entry.Key] = struct{}{}
+	}
+	return nil
+}
@@ -162,6 +162,9 @@ func (m *QueryGetUserProfileRequest) GetIndex() string {
 // QueryGetUserProfileResponse defines the QueryGetUserProfileResponse message.
 type QueryGetUserProfileResponse struct {
 	UserProfile UserProfile `protobuf:"bytes,1,opt,name=user_profile,json=userProfile,proto3" json:"user_profile"`
+	// verified_domains are the domains the profile owner holds valid domain
+	// attestations for.
+	VerifiedDomains []string `protobuf:"bytes,2,rep,name=verified_domains,json=verifiedDomains,proto3" json:"verified_domains,omitempty"`
 }
 
 func (m *QueryGetUserProfileResponse) Reset()         { *m = QueryGetUserProfileResponse{} }
@@ -204,6 +207,13 @@ func (m *QueryGetUserProfileResponse) GetUserProfile() UserProfile {
 	return UserProfile{}
 }
 
+func (m *QueryGetUserProfileResponse) GetVerifiedDomains() []string {
+	if m != nil {
+		return m.VerifiedDomains
+	}
+	return nil
+}
+
 // QueryAllUserProfileRequest defines the QueryAllUserProfileRequest message.
 type QueryAllUserProfileRequest struct {
 	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
@@ -302,509 +312,7902 @@ func (m *QueryAllUserProfileResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "resist.identity.v1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "resist.identity.v1.QueryParamsResponse")
-	proto.RegisterType((*QueryGetUserProfileRequest)(nil), "resist.identity.v1.QueryGetUserProfileRequest")
-	proto.RegisterType((*QueryGetUserProfileResponse)(nil), "resist.identity.v1.QueryGetUserProfileResponse")
-	proto.RegisterType((*QueryAllUserProfileRequest)(nil), "resist.identity.v1.QueryAllUserProfileRequest")
-	proto.RegisterType((*QueryAllUserProfileResponse)(nil), "resist.identity.v1.QueryAllUserProfileResponse")
+// QueryGetIssuerRequest defines the QueryGetIssuerRequest message.
+type QueryGetIssuerRequest struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func init() { proto.RegisterFile("resist/identity/v1/query.proto", fileDescriptor_31c5b3e3bec1457b) }
-
-var fileDescriptor_31c5b3e3bec1457b = []byte{
-	// 509 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0x41, 0x8b, 0x13, 0x31,
-	0x18, 0x86, 0x9b, 0x5d, 0xb7, 0xb0, 0x59, 0x51, 0x8c, 0x05, 0x65, 0x5c, 0xa6, 0x4b, 0xc0, 0xdd,
-	0xd2, 0x43, 0xe2, 0xd4, 0xb3, 0x07, 0x7b, 0x70, 0x3d, 0x78, 0xa8, 0x83, 0x5e, 0xbc, 0x48, 0x6a,
-	0xe3, 0x10, 0x68, 0x93, 0xd9, 0x49, 0x5a, 0xb6, 0xc8, 0x5e, 0xfc, 0x05, 0x82, 0x57, 0x6f, 0x5e,
-	0x3c, 0xee, 0xcf, 0x58, 0xf0, 0xb2, 0xe0, 0xc5, 0x93, 0x48, 0x2b, 0xf8, 0x37, 0xa4, 0x49, 0x4a,
-	0x5b, 0x37, 0x75, 0x8a, 0x97, 0x92, 0x26, 0xef, 0x9b, 0xef, 0xf9, 0xe6, 0x7b, 0x67, 0x60, 0x5c,
-	0x70, 0x2d, 0xb4, 0xa1, 0xa2, 0xc7, 0xa5, 0x11, 0x66, 0x4c, 0x47, 0x09, 0x3d, 0x19, 0xf2, 0x62,
-	0x4c, 0xf2, 0x42, 0x19, 0x85, 0x90, 0x3b, 0x27, 0xf3, 0x73, 0x32, 0x4a, 0xa2, 0x5b, 0x6c, 0x20,
-	0xa4, 0xa2, 0xf6, 0xd7, 0xc9, 0xa2, 0xe6, 0x1b, 0xa5, 0x07, 0x4a, 0xd3, 0x2e, 0xd3, 0xdc, 0xf9,
-	0xe9, 0x28, 0xe9, 0x72, 0xc3, 0x12, 0x9a, 0xb3, 0x4c, 0x48, 0x66, 0x84, 0x92, 0x5e, 0x5b, 0xcb,
-	0x54, 0xa6, 0xec, 0x92, 0xce, 0x56, 0x7e, 0x77, 0x3f, 0x53, 0x2a, 0xeb, 0x73, 0xca, 0x72, 0x41,
-	0x99, 0x94, 0xca, 0x58, 0x8b, 0xf6, 0xa7, 0xf5, 0x00, 0x66, 0xce, 0x0a, 0x36, 0x98, 0x0b, 0xee,
-	0x07, 0x04, 0x43, 0xcd, 0x8b, 0xd7, 0x79, 0xa1, 0xde, 0x8a, 0x3e, 0x77, 0x32, 0x5c, 0x83, 0xe8,
-	0xf9, 0x8c, 0xae, 0x63, 0xbd, 0x29, 0x3f, 0x19, 0x72, 0x6d, 0xf0, 0x0b, 0x78, 0x7b, 0x65, 0x57,
-	0xe7, 0x4a, 0x6a, 0x8e, 0x1e, 0xc1, 0xaa, 0xab, 0x71, 0x17, 0x1c, 0x80, 0xc6, 0x5e, 0x2b, 0x22,
-	0x57, 0x1f, 0x06, 0x71, 0x9e, 0xf6, 0xee, 0xc5, 0x8f, 0x7a, 0xe5, 0xcb, 0xef, 0xf3, 0x26, 0x48,
-	0xbd, 0x09, 0xb7, 0x60, 0x64, 0x6f, 0x3d, 0xe6, 0xe6, 0xa5, 0xe6, 0x45, 0xc7, 0x81, 0xf8, 0x9a,
-	0xa8, 0x06, 0x77, 0x84, 0xec, 0xf1, 0x53, 0x7b, 0xf7, 0x6e, 0xea, 0xfe, 0xe0, 0x0c, 0xde, 0x0b,
-	0x7a, 0x3c, 0xd1, 0x53, 0x78, 0x7d, 0xb9, 0x29, 0xcf, 0x55, 0x0f, 0x71, 0x2d, 0xd9, 0xdb, 0xd7,
-	0x66, 0x70, 0xe9, 0xde, 0x70, 0xb1, 0x85, 0x7b, 0x1e, 0xee, 0x71, 0xbf, 0x1f, 0x80, 0x7b, 0x02,
-	0xe1, 0x62, 0x6c, 0xbe, 0xca, 0x21, 0x71, 0x33, 0x26, 0xb3, 0x19, 0x13, 0x97, 0x11, 0x3f, 0x63,
-	0xd2, 0x61, 0xd9, 0xdc, 0x9b, 0x2e, 0x39, 0xf1, 0x39, 0xf0, 0xfd, 0xfc, 0x5d, 0x66, 0x6d, 0x3f,
-	0xdb, 0xff, 0xd7, 0x0f, 0x3a, 0x5e, 0x21, 0xde, 0xb2, 0xc4, 0x47, 0xa5, 0xc4, 0x0e, 0x63, 0x19,
-	0xb9, 0xf5, 0x75, 0x1b, 0xee, 0x58, 0x64, 0x74, 0x06, 0xab, 0x6e, 0xb8, 0xe8, 0x30, 0x04, 0x74,
-	0x35, 0x47, 0xd1, 0x51, 0xa9, 0xce, 0x15, 0xc4, 0xf8, 0xfd, 0xb7, 0x5f, 0x1f, 0xb7, 0xf6, 0x51,
-	0x44, 0xd7, 0xe6, 0x1a, 0x7d, 0x06, 0xf0, 0xc6, 0x6a, 0x0c, 0x10, 0x59, 0x7b, 0x7f, 0x30, 0x63,
-	0x11, 0xdd, 0x58, 0xef, 0xb9, 0x1e, 0x58, 0xae, 0x26, 0x6a, 0xd0, 0x92, 0xd7, 0x89, 0xbe, 0xb3,
-	0x79, 0x3d, 0x43, 0x9f, 0x00, 0xbc, 0xf9, 0x4c, 0xe8, 0x0d, 0x31, 0x83, 0x69, 0xfb, 0x07, 0x66,
-	0x38, 0x36, 0xb8, 0x61, 0x31, 0x31, 0x3a, 0x28, 0xc3, 0x6c, 0x27, 0x17, 0x93, 0x18, 0x5c, 0x4e,
-	0x62, 0xf0, 0x73, 0x12, 0x83, 0x0f, 0xd3, 0xb8, 0x72, 0x39, 0x8d, 0x2b, 0xdf, 0xa7, 0x71, 0xe5,
-	0xd5, 0x1d, 0x6f, 0x3d, 0x5d, 0x98, 0xcd, 0x38, 0xe7, 0xba, 0x5b, 0xb5, 0x5f, 0x8a, 0x87, 0x7f,
-	0x02, 0x00, 0x00, 0xff, 0xff, 0xee, 0x4f, 0x24, 0xe7, 0x1a, 0x05, 0x00, 0x00,
+func (m *QueryGetIssuerRequest) Reset()         { *m = QueryGetIssuerRequest{} }
+func (m *QueryGetIssuerRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetIssuerRequest) ProtoMessage()    {}
+func (*QueryGetIssuerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_31c5b3e3bec1457b, []int{6}
+}
+func (m *QueryGetIssuerRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetIssuerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetIssuerRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetIssuerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetIssuerRequest.Merge(m, src)
+}
+func (m *QueryGetIssuerRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetIssuerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetIssuerRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryGetIssuerRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Parameters queries the parameters of the module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// ListUserProfile Queries a list of UserProfile items.
-	GetUserProfile(ctx context.Context, in *QueryGetUserProfileRequest, opts ...grpc.CallOption) (*QueryGetUserProfileResponse, error)
-	// ListUserProfile defines the ListUserProfile RPC.
-	ListUserProfile(ctx context.Context, in *QueryAllUserProfileRequest, opts ...grpc.CallOption) (*QueryAllUserProfileResponse, error)
+func (m *QueryGetIssuerRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+// QueryGetIssuerResponse defines the QueryGetIssuerResponse message.
+type QueryGetIssuerResponse struct {
+	Issuer Issuer `protobuf:"bytes,1,opt,name=issuer,proto3" json:"issuer"`
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryGetIssuerResponse) Reset()         { *m = QueryGetIssuerResponse{} }
+func (m *QueryGetIssuerResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetIssuerResponse) ProtoMessage()    {}
+func (*QueryGetIssuerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_31c5b3e3bec1457b, []int{7}
 }
-
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/resist.identity.v1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryGetIssuerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *queryClient) GetUserProfile(ctx context.Context, in *QueryGetUserProfileRequest, opts ...grpc.CallOption) (*QueryGetUserProfileResponse, error) {
-	out := new(QueryGetUserProfileResponse)
-	err := c.cc.Invoke(ctx, "/resist.identity.v1.Query/GetUserProfile", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryGetIssuerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetIssuerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryGetIssuerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetIssuerResponse.Merge(m, src)
+}
+func (m *QueryGetIssuerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetIssuerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetIssuerResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) ListUserProfile(ctx context.Context, in *QueryAllUserProfileRequest, opts ...grpc.CallOption) (*QueryAllUserProfileResponse, error) {
-	out := new(QueryAllUserProfileResponse)
-	err := c.cc.Invoke(ctx, "/resist.identity.v1.Query/ListUserProfile", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetIssuerResponse proto.InternalMessageInfo
+
+func (m *QueryGetIssuerResponse) GetIssuer() Issuer {
+	if m != nil {
+		return m.Issuer
 	}
-	return out, nil
+	return Issuer{}
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Parameters queries the parameters of the module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// ListUserProfile Queries a list of UserProfile items.
-	GetUserProfile(context.Context, *QueryGetUserProfileRequest) (*QueryGetUserProfileResponse, error)
-	// ListUserProfile defines the ListUserProfile RPC.
-	ListUserProfile(context.Context, *QueryAllUserProfileRequest) (*QueryAllUserProfileResponse, error)
+// QueryAllIssuerRequest defines the QueryAllIssuerRequest message.
+type QueryAllIssuerRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QueryAllIssuerRequest) Reset()         { *m = QueryAllIssuerRequest{} }
+func (m *QueryAllIssuerRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllIssuerRequest) ProtoMessage()    {}
+func (*QueryAllIssuerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_31c5b3e3bec1457b, []int{8}
 }
-
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryAllIssuerRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) GetUserProfile(ctx context.Context, req *QueryGetUserProfileRequest) (*QueryGetUserProfileResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetUserProfile not implemented")
+func (m *QueryAllIssuerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllIssuerRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) ListUserProfile(ctx context.Context, req *QueryAllUserProfileRequest) (*QueryAllUserProfileResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListUserProfile not implemented")
+func (m *QueryAllIssuerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllIssuerRequest.Merge(m, src)
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *QueryAllIssuerRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllIssuerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllIssuerRequest.DiscardUnknown(m)
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/resist.identity.v1.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+var xxx_messageInfo_QueryAllIssuerRequest proto.InternalMessageInfo
+
+func (m *QueryAllIssuerRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_GetUserProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetUserProfileRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetUserProfile(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/resist.identity.v1.Query/GetUserProfile",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetUserProfile(ctx, req.(*QueryGetUserProfileRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryAllIssuerResponse defines the QueryAllIssuerResponse message.
+type QueryAllIssuerResponse struct {
+	Issuer     []Issuer            `protobuf:"bytes,1,rep,name=issuer,proto3" json:"issuer"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func _Query_ListUserProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllUserProfileRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ListUserProfile(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/resist.identity.v1.Query/ListUserProfile",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ListUserProfile(ctx, req.(*QueryAllUserProfileRequest))
+func (m *QueryAllIssuerResponse) Reset()         { *m = QueryAllIssuerResponse{} }
+func (m *QueryAllIssuerResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllIssuerResponse) ProtoMessage()    {}
+func (*QueryAllIssuerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_31c5b3e3bec1457b, []int{9}
+}
+func (m *QueryAllIssuerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllIssuerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllIssuerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
 }
-
-var Query_serviceDesc = _Query_serviceDesc
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "resist.identity.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "GetUserProfile",
-			Handler:    _Query_GetUserProfile_Handler,
-		},
-		{
-			MethodName: "ListUserProfile",
-			Handler:    _Query_ListUserProfile_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "resist/identity/v1/query.proto",
+func (m *QueryAllIssuerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllIssuerResponse.Merge(m, src)
+}
+func (m *QueryAllIssuerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllIssuerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllIssuerResponse.DiscardUnknown(m)
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAllIssuerResponse proto.InternalMessageInfo
+
+func (m *QueryAllIssuerResponse) GetIssuer() []Issuer {
+	if m != nil {
+		return m.Issuer
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryAllIssuerResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// QueryGetAttestationRequest defines the QueryGetAttestationRequest message.
+type QueryGetAttestationRequest struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryGetAttestationRequest) Reset()         { *m = QueryGetAttestationRequest{} }
+func (m *QueryGetAttestationRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetAttestationRequest) ProtoMessage()    {}
+func (*QueryGetAttestationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_31c5b3e3bec1457b, []int{10}
+}
+func (m *QueryGetAttestationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetAttestationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetAttestationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryGetAttestationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetAttestationRequest.Merge(m, src)
 }
-
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+func (m *QueryGetAttestationRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetAttestationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetAttestationRequest.DiscardUnknown(m)
 }
 
-func (m *QueryGetUserProfileRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetAttestationRequest proto.InternalMessageInfo
+
+func (m *QueryGetAttestationRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryGetUserProfileRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryGetAttestationResponse defines the QueryGetAttestationResponse message.
+type QueryGetAttestationResponse struct {
+	Attestation Attestation `protobuf:"bytes,1,opt,name=attestation,proto3" json:"attestation"`
+	// valid is set if the attestation is neither revoked nor expired and its
+	// issuer is still registered for its claim type.
+	Valid bool `protobuf:"varint,2,opt,name=valid,proto3" json:"valid,omitempty"`
 }
 
-func (m *QueryGetUserProfileRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Index) > 0 {
-		i -= len(m.Index)
-		copy(dAtA[i:], m.Index)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Index)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryGetAttestationResponse) Reset()         { *m = QueryGetAttestationResponse{} }
+func (m *QueryGetAttestationResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetAttestationResponse) ProtoMessage()    {}
+func (*QueryGetAttestationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_31c5b3e3bec1457b, []int{11}
+}
+func (m *QueryGetAttestationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetAttestationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetAttestationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryGetAttestationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetAttestationResponse.Merge(m, src)
+}
+func (m *QueryGetAttestationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetAttestationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetAttestationResponse.DiscardUnknown(m)
 }
 
-func (m *QueryGetUserProfileResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetAttestationResponse proto.InternalMessageInfo
+
+func (m *QueryGetAttestationResponse) GetAttestation() Attestation {
+	if m != nil {
+		return m.Attestation
 	}
-	return dAtA[:n], nil
+	return Attestation{}
 }
 
-func (m *QueryGetUserProfileResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryGetAttestationResponse) GetValid() bool {
+	if m != nil {
+		return m.Valid
+	}
+	return false
 }
 
-func (m *QueryGetUserProfileResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.UserProfile.MarshalToSizedBuffer(dAtA[:i])
+// QueryAllAttestationRequest defines the QueryAllAttestationRequest message.
+type QueryAllAttestationRequest struct {
+	Subject string `protobuf:"bytes,1,opt,name=subject,proto3" json:"subject,omitempty"`
+	// valid_only restricts the result to the valid attestations.
+	ValidOnly  bool               `protobuf:"varint,2,opt,name=valid_only,json=validOnly,proto3" json:"valid_only,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllAttestationRequest) Reset()         { *m = QueryAllAttestationRequest{} }
+func (m *QueryAllAttestationRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllAttestationRequest) ProtoMessage()    {}
+func (*QueryAllAttestationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_31c5b3e3bec1457b, []int{12}
+}
+func (m *QueryAllAttestationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllAttestationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllAttestationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryAllUserProfileRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryAllAttestationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllAttestationRequest.Merge(m, src)
+}
+func (m *QueryAllAttestationRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllAttestationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllAttestationRequest.DiscardUnknown(m)
 }
 
-func (m *QueryAllUserProfileRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryAllAttestationRequest proto.InternalMessageInfo
+
+func (m *QueryAllAttestationRequest) GetSubject() string {
+	if m != nil {
+		return m.Subject
+	}
+	return ""
 }
 
-func (m *QueryAllUserProfileRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (m *QueryAllAttestationRequest) GetValidOnly() bool {
+	if m != nil {
+		return m.ValidOnly
 	}
-	return len(dAtA) - i, nil
+	return false
 }
 
-func (m *QueryAllUserProfileResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryAllAttestationRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryAllUserProfileResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryAllAttestationResponse defines the QueryAllAttestationResponse message.
+type QueryAllAttestationResponse struct {
+	Attestation []Attestation       `protobuf:"bytes,1,rep,name=attestation,proto3" json:"attestation"`
+	Pagination  *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryAllUserProfileResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.UserProfile) > 0 {
-		for iNdEx := len(m.UserProfile) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.UserProfile[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryAllAttestationResponse) Reset()         { *m = QueryAllAttestationResponse{} }
+func (m *QueryAllAttestationResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllAttestationResponse) ProtoMessage()    {}
+func (*QueryAllAttestationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_31c5b3e3bec1457b, []int{13}
+}
+func (m *QueryAllAttestationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllAttestationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllAttestationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryAllAttestationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllAttestationResponse.Merge(m, src)
+}
+func (m *QueryAllAttestationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllAttestationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllAttestationResponse.DiscardUnknown(m)
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_QueryAllAttestationResponse proto.InternalMessageInfo
+
+func (m *QueryAllAttestationResponse) GetAttestation() []Attestation {
+	if m != nil {
+		return m.Attestation
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *QueryAllAttestationResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+// QueryResolveHandleRequest defines the QueryResolveHandleRequest message.
+type QueryResolveHandleRequest struct {
+	Handle string `protobuf:"bytes,1,opt,name=handle,proto3" json:"handle,omitempty"`
 }
 
-func (m *QueryGetUserProfileRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Index)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryResolveHandleRequest) Reset()         { *m = QueryResolveHandleRequest{} }
+func (m *QueryResolveHandleRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryResolveHandleRequest) ProtoMessage()    {}
+func (*QueryResolveHandleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_31c5b3e3bec1457b, []int{14}
+}
+func (m *QueryResolveHandleRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryResolveHandleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryResolveHandleRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QueryResolveHandleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryResolveHandleRequest.Merge(m, src)
+}
+func (m *QueryResolveHandleRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryResolveHandleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryResolveHandleRequest.DiscardUnknown(m)
 }
 
-func (m *QueryGetUserProfileResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryResolveHandleRequest proto.InternalMessageInfo
+
+func (m *QueryResolveHandleRequest) GetHandle() string {
+	if m != nil {
+		return m.Handle
 	}
-	var l int
-	_ = l
-	l = m.UserProfile.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return ""
+}
+
+// QueryResolveHandleResponse defines the QueryResolveHandleResponse message.
+type QueryResolveHandleResponse struct {
+	Handle Handle `protobuf:"bytes,1,opt,name=handle,proto3" json:"handle"`
+}
+
+func (m *QueryResolveHandleResponse) Reset()         { *m = QueryResolveHandleResponse{} }
+func (m *QueryResolveHandleResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryResolveHandleResponse) ProtoMessage()    {}
+func (*QueryResolveHandleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_31c5b3e3bec1457b, []int{15}
+}
+func (m *QueryResolveHandleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryResolveHandleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryResolveHandleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryResolveHandleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryResolveHandleResponse.Merge(m, src)
+}
+func (m *QueryResolveHandleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryResolveHandleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryResolveHandleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryResolveHandleResponse proto.InternalMessageInfo
+
+func (m *QueryResolveHandleResponse) GetHandle() Handle {
+	if m != nil {
+		return m.Handle
+	}
+	return Handle{}
+}
+
+// QueryReverseResolveRequest defines the QueryReverseResolveRequest message.
+type QueryReverseResolveRequest struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QueryReverseResolveRequest) Reset()         { *m = QueryReverseResolveRequest{} }
+func (m *QueryReverseResolveRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryReverseResolveRequest) ProtoMessage()    {}
+func (*QueryReverseResolveRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_31c5b3e3bec1457b, []int{16}
+}
+func (m *QueryReverseResolveRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryReverseResolveRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryReverseResolveRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryReverseResolveRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryReverseResolveRequest.Merge(m, src)
+}
+func (m *QueryReverseResolveRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryReverseResolveRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryReverseResolveRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryReverseResolveRequest proto.InternalMessageInfo
+
+func (m *QueryReverseResolveRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// QueryReverseResolveResponse defines the QueryReverseResolveResponse message.
+type QueryReverseResolveResponse struct {
+	Handle Handle `protobuf:"bytes,1,opt,name=handle,proto3" json:"handle"`
+}
+
+func (m *QueryReverseResolveResponse) Reset()         { *m = QueryReverseResolveResponse{} }
+func (m *QueryReverseResolveResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryReverseResolveResponse) ProtoMessage()    {}
+func (*QueryReverseResolveResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_31c5b3e3bec1457b, []int{17}
+}
+func (m *QueryReverseResolveResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryReverseResolveResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryReverseResolveResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryReverseResolveResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryReverseResolveResponse.Merge(m, src)
+}
+func (m *QueryReverseResolveResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryReverseResolveResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryReverseResolveResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryReverseResolveResponse proto.InternalMessageInfo
+
+func (m *QueryReverseResolveResponse) GetHandle() Handle {
+	if m != nil {
+		return m.Handle
+	}
+	return Handle{}
+}
+
+// QueryGetGuardiansRequest defines the QueryGetGuardiansRequest message.
+type QueryGetGuardiansRequest struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QueryGetGuardiansRequest) Reset()         { *m = QueryGetGuardiansRequest{} }
+func (m *QueryGetGuardiansRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetGuardiansRequest) ProtoMessage()    {}
+func (*QueryGetGuardiansRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_31c5b3e3bec1457b, []int{18}
+}
+func (m *QueryGetGuardiansRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetGuardiansRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetGuardiansRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetGuardiansRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetGuardiansRequest.Merge(m, src)
+}
+func (m *QueryGetGuardiansRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetGuardiansRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetGuardiansRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetGuardiansRequest proto.InternalMessageInfo
+
+func (m *QueryGetGuardiansRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// QueryGetGuardiansResponse defines the QueryGetGuardiansResponse message.
+type QueryGetGuardiansResponse struct {
+	Guardians Guardians `protobuf:"bytes,1,opt,name=guardians,proto3" json:"guardians"`
+}
+
+func (m *QueryGetGuardiansResponse) Reset()         { *m = QueryGetGuardiansResponse{} }
+func (m *QueryGetGuardiansResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetGuardiansResponse) ProtoMessage()    {}
+func (*QueryGetGuardiansResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_31c5b3e3bec1457b, []int{19}
+}
+func (m *QueryGetGuardiansResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetGuardiansResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetGuardiansResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetGuardiansResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetGuardiansResponse.Merge(m, src)
+}
+func (m *QueryGetGuardiansResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetGuardiansResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetGuardiansResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetGuardiansResponse proto.InternalMessageInfo
+
+func (m *QueryGetGuardiansResponse) GetGuardians() Guardians {
+	if m != nil {
+		return m.Guardians
+	}
+	return Guardians{}
+}
+
+// QueryGetRecoveryRequest defines the QueryGetRecoveryRequest message.
+type QueryGetRecoveryRequest struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QueryGetRecoveryRequest) Reset()         { *m = QueryGetRecoveryRequest{} }
+func (m *QueryGetRecoveryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetRecoveryRequest) ProtoMessage()    {}
+func (*QueryGetRecoveryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_31c5b3e3bec1457b, []int{20}
+}
+func (m *QueryGetRecoveryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetRecoveryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetRecoveryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetRecoveryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetRecoveryRequest.Merge(m, src)
+}
+func (m *QueryGetRecoveryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetRecoveryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetRecoveryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetRecoveryRequest proto.InternalMessageInfo
+
+func (m *QueryGetRecoveryRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// QueryGetRecoveryResponse defines the QueryGetRecoveryResponse message.
+type QueryGetRecoveryResponse struct {
+	Recovery Recovery `protobuf:"bytes,1,opt,name=recovery,proto3" json:"recovery"`
+}
+
+func (m *QueryGetRecoveryResponse) Reset()         { *m = QueryGetRecoveryResponse{} }
+func (m *QueryGetRecoveryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetRecoveryResponse) ProtoMessage()    {}
+func (*QueryGetRecoveryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_31c5b3e3bec1457b, []int{21}
+}
+func (m *QueryGetRecoveryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetRecoveryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetRecoveryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetRecoveryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetRecoveryResponse.Merge(m, src)
+}
+func (m *QueryGetRecoveryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetRecoveryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetRecoveryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetRecoveryResponse proto.InternalMessageInfo
+
+func (m *QueryGetRecoveryResponse) GetRecovery() Recovery {
+	if m != nil {
+		return m.Recovery
+	}
+	return Recovery{}
+}
+
+// QueryGetPersonaKeyRequest defines the QueryGetPersonaKeyRequest message.
+type QueryGetPersonaKeyRequest struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *QueryGetPersonaKeyRequest) Reset()         { *m = QueryGetPersonaKeyRequest{} }
+func (m *QueryGetPersonaKeyRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetPersonaKeyRequest) ProtoMessage()    {}
+func (*QueryGetPersonaKeyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_31c5b3e3bec1457b, []int{22}
+}
+func (m *QueryGetPersonaKeyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetPersonaKeyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetPersonaKeyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetPersonaKeyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetPersonaKeyRequest.Merge(m, src)
+}
+func (m *QueryGetPersonaKeyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetPersonaKeyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetPersonaKeyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetPersonaKeyRequest proto.InternalMessageInfo
+
+func (m *QueryGetPersonaKeyRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+// QueryGetPersonaKeyResponse defines the QueryGetPersonaKeyResponse message.
+type QueryGetPersonaKeyResponse struct {
+	PersonaKey PersonaKey `protobuf:"bytes,1,opt,name=persona_key,json=personaKey,proto3" json:"persona_key"`
+}
+
+func (m *QueryGetPersonaKeyResponse) Reset()         { *m = QueryGetPersonaKeyResponse{} }
+func (m *QueryGetPersonaKeyResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetPersonaKeyResponse) ProtoMessage()    {}
+func (*QueryGetPersonaKeyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_31c5b3e3bec1457b, []int{23}
+}
+func (m *QueryGetPersonaKeyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetPersonaKeyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetPersonaKeyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetPersonaKeyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetPersonaKeyResponse.Merge(m, src)
+}
+func (m *QueryGetPersonaKeyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetPersonaKeyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetPersonaKeyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetPersonaKeyResponse proto.InternalMessageInfo
+
+func (m *QueryGetPersonaKeyResponse) GetPersonaKey() PersonaKey {
+	if m != nil {
+		return m.PersonaKey
+	}
+	return PersonaKey{}
+}
+
+// QueryAllPersonaKeyRequest defines the QueryAllPersonaKeyRequest message.
+type QueryAllPersonaKeyRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllPersonaKeyRequest) Reset()         { *m = QueryAllPersonaKeyRequest{} }
+func (m *QueryAllPersonaKeyRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllPersonaKeyRequest) ProtoMessage()    {}
+func (*QueryAllPersonaKeyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_31c5b3e3bec1457b, []int{24}
+}
+func (m *QueryAllPersonaKeyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllPersonaKeyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllPersonaKeyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllPersonaKeyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllPersonaKeyRequest.Merge(m, src)
+}
+func (m *QueryAllPersonaKeyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllPersonaKeyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllPersonaKeyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllPersonaKeyRequest proto.InternalMessageInfo
+
+func (m *QueryAllPersonaKeyRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryAllPersonaKeyResponse defines the QueryAllPersonaKeyResponse message.
+type QueryAllPersonaKeyResponse struct {
+	PersonaKey []PersonaKey        `protobuf:"bytes,1,rep,name=persona_key,json=personaKey,proto3" json:"persona_key"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllPersonaKeyResponse) Reset()         { *m = QueryAllPersonaKeyResponse{} }
+func (m *QueryAllPersonaKeyResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllPersonaKeyResponse) ProtoMessage()    {}
+func (*QueryAllPersonaKeyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_31c5b3e3bec1457b, []int{25}
+}
+func (m *QueryAllPersonaKeyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllPersonaKeyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllPersonaKeyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllPersonaKeyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllPersonaKeyResponse.Merge(m, src)
+}
+func (m *QueryAllPersonaKeyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllPersonaKeyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllPersonaKeyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllPersonaKeyResponse proto.InternalMessageInfo
+
+func (m *QueryAllPersonaKeyResponse) GetPersonaKey() []PersonaKey {
+	if m != nil {
+		return m.PersonaKey
+	}
+	return nil
+}
+
+func (m *QueryAllPersonaKeyResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryGetPersonaCredentialRequestRequest defines the QueryGetPersonaCredentialRequestRequest message.
+type QueryGetPersonaCredentialRequestRequest struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *QueryGetPersonaCredentialRequestRequest) Reset() {
+	*m = QueryGetPersonaCredentialRequestRequest{}
+}
+func (m *QueryGetPersonaCredentialRequestRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetPersonaCredentialRequestRequest) ProtoMessage()    {}
+func (*QueryGetPersonaCredentialRequestRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_31c5b3e3bec1457b, []int{26}
+}
+func (m *QueryGetPersonaCredentialRequestRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetPersonaCredentialRequestRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetPersonaCredentialRequestRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetPersonaCredentialRequestRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetPersonaCredentialRequestRequest.Merge(m, src)
+}
+func (m *QueryGetPersonaCredentialRequestRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetPersonaCredentialRequestRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetPersonaCredentialRequestRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetPersonaCredentialRequestRequest proto.InternalMessageInfo
+
+func (m *QueryGetPersonaCredentialRequestRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+// QueryGetPersonaCredentialRequestResponse defines the QueryGetPersonaCredentialRequestResponse message.
+type QueryGetPersonaCredentialRequestResponse struct {
+	PersonaRequest PersonaCredentialRequest `protobuf:"bytes,1,opt,name=persona_request,json=personaRequest,proto3" json:"persona_request"`
+}
+
+func (m *QueryGetPersonaCredentialRequestResponse) Reset() {
+	*m = QueryGetPersonaCredentialRequestResponse{}
+}
+func (m *QueryGetPersonaCredentialRequestResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetPersonaCredentialRequestResponse) ProtoMessage()    {}
+func (*QueryGetPersonaCredentialRequestResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_31c5b3e3bec1457b, []int{27}
+}
+func (m *QueryGetPersonaCredentialRequestResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetPersonaCredentialRequestResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetPersonaCredentialRequestResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetPersonaCredentialRequestResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetPersonaCredentialRequestResponse.Merge(m, src)
+}
+func (m *QueryGetPersonaCredentialRequestResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetPersonaCredentialRequestResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetPersonaCredentialRequestResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetPersonaCredentialRequestResponse proto.InternalMessageInfo
+
+func (m *QueryGetPersonaCredentialRequestResponse) GetPersonaRequest() PersonaCredentialRequest {
+	if m != nil {
+		return m.PersonaRequest
+	}
+	return PersonaCredentialRequest{}
+}
+
+// QueryAllPersonaCredentialRequestRequest defines the QueryAllPersonaCredentialRequestRequest message.
+type QueryAllPersonaCredentialRequestRequest struct {
+	KeyId uint64 `protobuf:"varint,1,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
+	// pending_only restricts the result to the requests not signed yet.
+	PendingOnly bool               `protobuf:"varint,2,opt,name=pending_only,json=pendingOnly,proto3" json:"pending_only,omitempty"`
+	Pagination  *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllPersonaCredentialRequestRequest) Reset() {
+	*m = QueryAllPersonaCredentialRequestRequest{}
+}
+func (m *QueryAllPersonaCredentialRequestRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllPersonaCredentialRequestRequest) ProtoMessage()    {}
+func (*QueryAllPersonaCredentialRequestRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_31c5b3e3bec1457b, []int{28}
+}
+func (m *QueryAllPersonaCredentialRequestRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllPersonaCredentialRequestRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllPersonaCredentialRequestRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllPersonaCredentialRequestRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllPersonaCredentialRequestRequest.Merge(m, src)
+}
+func (m *QueryAllPersonaCredentialRequestRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllPersonaCredentialRequestRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllPersonaCredentialRequestRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllPersonaCredentialRequestRequest proto.InternalMessageInfo
+
+func (m *QueryAllPersonaCredentialRequestRequest) GetKeyId() uint64 {
+	if m != nil {
+		return m.KeyId
+	}
+	return 0
+}
+
+func (m *QueryAllPersonaCredentialRequestRequest) GetPendingOnly() bool {
+	if m != nil {
+		return m.PendingOnly
+	}
+	return false
+}
+
+func (m *QueryAllPersonaCredentialRequestRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryAllPersonaCredentialRequestResponse defines the QueryAllPersonaCredentialRequestResponse message.
+type QueryAllPersonaCredentialRequestResponse struct {
+	PersonaRequest []PersonaCredentialRequest `protobuf:"bytes,1,rep,name=persona_request,json=personaRequest,proto3" json:"persona_request"`
+	Pagination     *query.PageResponse        `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllPersonaCredentialRequestResponse) Reset() {
+	*m = QueryAllPersonaCredentialRequestResponse{}
+}
+func (m *QueryAllPersonaCredentialRequestResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllPersonaCredentialRequestResponse) ProtoMessage()    {}
+func (*QueryAllPersonaCredentialRequestResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_31c5b3e3bec1457b, []int{29}
+}
+func (m *QueryAllPersonaCredentialRequestResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllPersonaCredentialRequestResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllPersonaCredentialRequestResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllPersonaCredentialRequestResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllPersonaCredentialRequestResponse.Merge(m, src)
+}
+func (m *QueryAllPersonaCredentialRequestResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllPersonaCredentialRequestResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllPersonaCredentialRequestResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllPersonaCredentialRequestResponse proto.InternalMessageInfo
+
+func (m *QueryAllPersonaCredentialRequestResponse) GetPersonaRequest() []PersonaCredentialRequest {
+	if m != nil {
+		return m.PersonaRequest
+	}
+	return nil
+}
+
+func (m *QueryAllPersonaCredentialRequestResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryGetPersonaRequest defines the QueryGetPersonaRequest message.
+type QueryGetPersonaRequest struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QueryGetPersonaRequest) Reset()         { *m = QueryGetPersonaRequest{} }
+func (m *QueryGetPersonaRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetPersonaRequest) ProtoMessage()    {}
+func (*QueryGetPersonaRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_31c5b3e3bec1457b, []int{30}
+}
+func (m *QueryGetPersonaRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetPersonaRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetPersonaRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetPersonaRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetPersonaRequest.Merge(m, src)
+}
+func (m *QueryGetPersonaRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetPersonaRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetPersonaRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetPersonaRequest proto.InternalMessageInfo
+
+func (m *QueryGetPersonaRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// QueryGetPersonaResponse defines the QueryGetPersonaResponse message.
+type QueryGetPersonaResponse struct {
+	Persona Persona `protobuf:"bytes,1,opt,name=persona,proto3" json:"persona"`
+	// valid is set if the persona key is not revoked and its issuer is still
+	// registered to attest humans.
+	Valid bool `protobuf:"varint,2,opt,name=valid,proto3" json:"valid,omitempty"`
+}
+
+func (m *QueryGetPersonaResponse) Reset()         { *m = QueryGetPersonaResponse{} }
+func (m *QueryGetPersonaResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetPersonaResponse) ProtoMessage()    {}
+func (*QueryGetPersonaResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_31c5b3e3bec1457b, []int{31}
+}
+func (m *QueryGetPersonaResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetPersonaResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetPersonaResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetPersonaResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetPersonaResponse.Merge(m, src)
+}
+func (m *QueryGetPersonaResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetPersonaResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetPersonaResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetPersonaResponse proto.InternalMessageInfo
+
+func (m *QueryGetPersonaResponse) GetPersona() Persona {
+	if m != nil {
+		return m.Persona
+	}
+	return Persona{}
+}
+
+func (m *QueryGetPersonaResponse) GetValid() bool {
+	if m != nil {
+		return m.Valid
+	}
+	return false
+}
+
+// QueryListFollowersRequest defines the QueryListFollowersRequest message.
+type QueryListFollowersRequest struct {
+	Address    string             `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryListFollowersRequest) Reset()         { *m = QueryListFollowersRequest{} }
+func (m *QueryListFollowersRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryListFollowersRequest) ProtoMessage()    {}
+func (*QueryListFollowersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_31c5b3e3bec1457b, []int{32}
+}
+func (m *QueryListFollowersRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryListFollowersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryListFollowersRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryListFollowersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryListFollowersRequest.Merge(m, src)
+}
+func (m *QueryListFollowersRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryListFollowersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryListFollowersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryListFollowersRequest proto.InternalMessageInfo
+
+func (m *QueryListFollowersRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *QueryListFollowersRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryListFollowersResponse defines the QueryListFollowersResponse message.
+type QueryListFollowersResponse struct {
+	Followers  []string            `protobuf:"bytes,1,rep,name=followers,proto3" json:"followers,omitempty"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryListFollowersResponse) Reset()         { *m = QueryListFollowersResponse{} }
+func (m *QueryListFollowersResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryListFollowersResponse) ProtoMessage()    {}
+func (*QueryListFollowersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_31c5b3e3bec1457b, []int{33}
+}
+func (m *QueryListFollowersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryListFollowersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryListFollowersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryListFollowersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryListFollowersResponse.Merge(m, src)
+}
+func (m *QueryListFollowersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryListFollowersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryListFollowersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryListFollowersResponse proto.InternalMessageInfo
+
+func (m *QueryListFollowersResponse) GetFollowers() []string {
+	if m != nil {
+		return m.Followers
+	}
+	return nil
+}
+
+func (m *QueryListFollowersResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryListFollowingRequest defines the QueryListFollowingRequest message.
+type QueryListFollowingRequest struct {
+	Address    string             `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryListFollowingRequest) Reset()         { *m = QueryListFollowingRequest{} }
+func (m *QueryListFollowingRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryListFollowingRequest) ProtoMessage()    {}
+func (*QueryListFollowingRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_31c5b3e3bec1457b, []int{34}
+}
+func (m *QueryListFollowingRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryListFollowingRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryListFollowingRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryListFollowingRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryListFollowingRequest.Merge(m, src)
+}
+func (m *QueryListFollowingRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryListFollowingRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryListFollowingRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryListFollowingRequest proto.InternalMessageInfo
+
+func (m *QueryListFollowingRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *QueryListFollowingRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryListFollowingResponse defines the QueryListFollowingResponse message.
+type QueryListFollowingResponse struct {
+	Following  []string            `protobuf:"bytes,1,rep,name=following,proto3" json:"following,omitempty"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryListFollowingResponse) Reset()         { *m = QueryListFollowingResponse{} }
+func (m *QueryListFollowingResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryListFollowingResponse) ProtoMessage()    {}
+func (*QueryListFollowingResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_31c5b3e3bec1457b, []int{35}
+}
+func (m *QueryListFollowingResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryListFollowingResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryListFollowingResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryListFollowingResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryListFollowingResponse.Merge(m, src)
+}
+func (m *QueryListFollowingResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryListFollowingResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryListFollowingResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryListFollowingResponse proto.InternalMessageInfo
+
+func (m *QueryListFollowingResponse) GetFollowing() []string {
+	if m != nil {
+		return m.Following
+	}
+	return nil
+}
+
+func (m *QueryListFollowingResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryGetFollowCountsRequest defines the QueryGetFollowCountsRequest message.
+type QueryGetFollowCountsRequest struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QueryGetFollowCountsRequest) Reset()         { *m = QueryGetFollowCountsRequest{} }
+func (m *QueryGetFollowCountsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetFollowCountsRequest) ProtoMessage()    {}
+func (*QueryGetFollowCountsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_31c5b3e3bec1457b, []int{36}
+}
+func (m *QueryGetFollowCountsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetFollowCountsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetFollowCountsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetFollowCountsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetFollowCountsRequest.Merge(m, src)
+}
+func (m *QueryGetFollowCountsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetFollowCountsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetFollowCountsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetFollowCountsRequest proto.InternalMessageInfo
+
+func (m *QueryGetFollowCountsRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// QueryGetFollowCountsResponse defines the QueryGetFollowCountsResponse message.
+type QueryGetFollowCountsResponse struct {
+	Followers uint64 `protobuf:"varint,1,opt,name=followers,proto3" json:"followers,omitempty"`
+	Following uint64 `protobuf:"varint,2,opt,name=following,proto3" json:"following,omitempty"`
+}
+
+func (m *QueryGetFollowCountsResponse) Reset()         { *m = QueryGetFollowCountsResponse{} }
+func (m *QueryGetFollowCountsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetFollowCountsResponse) ProtoMessage()    {}
+func (*QueryGetFollowCountsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_31c5b3e3bec1457b, []int{37}
+}
+func (m *QueryGetFollowCountsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetFollowCountsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetFollowCountsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetFollowCountsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetFollowCountsResponse.Merge(m, src)
+}
+func (m *QueryGetFollowCountsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetFollowCountsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetFollowCountsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetFollowCountsResponse proto.InternalMessageInfo
+
+func (m *QueryGetFollowCountsResponse) GetFollowers() uint64 {
+	if m != nil {
+		return m.Followers
+	}
+	return 0
+}
+
+func (m *QueryGetFollowCountsResponse) GetFollowing() uint64 {
+	if m != nil {
+		return m.Following
+	}
+	return 0
+}
+
+// QueryListBlockedRequest defines the QueryListBlockedRequest message.
+type QueryListBlockedRequest struct {
+	Address    string             `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryListBlockedRequest) Reset()         { *m = QueryListBlockedRequest{} }
+func (m *QueryListBlockedRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryListBlockedRequest) ProtoMessage()    {}
+func (*QueryListBlockedRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_31c5b3e3bec1457b, []int{38}
+}
+func (m *QueryListBlockedRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryListBlockedRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryListBlockedRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryListBlockedRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryListBlockedRequest.Merge(m, src)
+}
+func (m *QueryListBlockedRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryListBlockedRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryListBlockedRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryListBlockedRequest proto.InternalMessageInfo
+
+func (m *QueryListBlockedRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *QueryListBlockedRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryListBlockedResponse defines the QueryListBlockedResponse message.
+type QueryListBlockedResponse struct {
+	Blocked    []string            `protobuf:"bytes,1,rep,name=blocked,proto3" json:"blocked,omitempty"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryListBlockedResponse) Reset()         { *m = QueryListBlockedResponse{} }
+func (m *QueryListBlockedResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryListBlockedResponse) ProtoMessage()    {}
+func (*QueryListBlockedResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_31c5b3e3bec1457b, []int{39}
+}
+func (m *QueryListBlockedResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryListBlockedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryListBlockedResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryListBlockedResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryListBlockedResponse.Merge(m, src)
+}
+func (m *QueryListBlockedResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryListBlockedResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryListBlockedResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryListBlockedResponse proto.InternalMessageInfo
+
+func (m *QueryListBlockedResponse) GetBlocked() []string {
+	if m != nil {
+		return m.Blocked
+	}
+	return nil
+}
+
+func (m *QueryListBlockedResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "resist.identity.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "resist.identity.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryGetUserProfileRequest)(nil), "resist.identity.v1.QueryGetUserProfileRequest")
+	proto.RegisterType((*QueryGetUserProfileResponse)(nil), "resist.identity.v1.QueryGetUserProfileResponse")
+	proto.RegisterType((*QueryAllUserProfileRequest)(nil), "resist.identity.v1.QueryAllUserProfileRequest")
+	proto.RegisterType((*QueryAllUserProfileResponse)(nil), "resist.identity.v1.QueryAllUserProfileResponse")
+	proto.RegisterType((*QueryGetIssuerRequest)(nil), "resist.identity.v1.QueryGetIssuerRequest")
+	proto.RegisterType((*QueryGetIssuerResponse)(nil), "resist.identity.v1.QueryGetIssuerResponse")
+	proto.RegisterType((*QueryAllIssuerRequest)(nil), "resist.identity.v1.QueryAllIssuerRequest")
+	proto.RegisterType((*QueryAllIssuerResponse)(nil), "resist.identity.v1.QueryAllIssuerResponse")
+	proto.RegisterType((*QueryGetAttestationRequest)(nil), "resist.identity.v1.QueryGetAttestationRequest")
+	proto.RegisterType((*QueryGetAttestationResponse)(nil), "resist.identity.v1.QueryGetAttestationResponse")
+	proto.RegisterType((*QueryAllAttestationRequest)(nil), "resist.identity.v1.QueryAllAttestationRequest")
+	proto.RegisterType((*QueryAllAttestationResponse)(nil), "resist.identity.v1.QueryAllAttestationResponse")
+	proto.RegisterType((*QueryResolveHandleRequest)(nil), "resist.identity.v1.QueryResolveHandleRequest")
+	proto.RegisterType((*QueryResolveHandleResponse)(nil), "resist.identity.v1.QueryResolveHandleResponse")
+	proto.RegisterType((*QueryReverseResolveRequest)(nil), "resist.identity.v1.QueryReverseResolveRequest")
+	proto.RegisterType((*QueryReverseResolveResponse)(nil), "resist.identity.v1.QueryReverseResolveResponse")
+	proto.RegisterType((*QueryGetGuardiansRequest)(nil), "resist.identity.v1.QueryGetGuardiansRequest")
+	proto.RegisterType((*QueryGetGuardiansResponse)(nil), "resist.identity.v1.QueryGetGuardiansResponse")
+	proto.RegisterType((*QueryGetRecoveryRequest)(nil), "resist.identity.v1.QueryGetRecoveryRequest")
+	proto.RegisterType((*QueryGetRecoveryResponse)(nil), "resist.identity.v1.QueryGetRecoveryResponse")
+	proto.RegisterType((*QueryGetPersonaKeyRequest)(nil), "resist.identity.v1.QueryGetPersonaKeyRequest")
+	proto.RegisterType((*QueryGetPersonaKeyResponse)(nil), "resist.identity.v1.QueryGetPersonaKeyResponse")
+	proto.RegisterType((*QueryAllPersonaKeyRequest)(nil), "resist.identity.v1.QueryAllPersonaKeyRequest")
+	proto.RegisterType((*QueryAllPersonaKeyResponse)(nil), "resist.identity.v1.QueryAllPersonaKeyResponse")
+	proto.RegisterType((*QueryGetPersonaCredentialRequestRequest)(nil), "resist.identity.v1.QueryGetPersonaCredentialRequestRequest")
+	proto.RegisterType((*QueryGetPersonaCredentialRequestResponse)(nil), "resist.identity.v1.QueryGetPersonaCredentialRequestResponse")
+	proto.RegisterType((*QueryAllPersonaCredentialRequestRequest)(nil), "resist.identity.v1.QueryAllPersonaCredentialRequestRequest")
+	proto.RegisterType((*QueryAllPersonaCredentialRequestResponse)(nil), "resist.identity.v1.QueryAllPersonaCredentialRequestResponse")
+	proto.RegisterType((*QueryGetPersonaRequest)(nil), "resist.identity.v1.QueryGetPersonaRequest")
+	proto.RegisterType((*QueryGetPersonaResponse)(nil), "resist.identity.v1.QueryGetPersonaResponse")
+	proto.RegisterType((*QueryListFollowersRequest)(nil), "resist.identity.v1.QueryListFollowersRequest")
+	proto.RegisterType((*QueryListFollowersResponse)(nil), "resist.identity.v1.QueryListFollowersResponse")
+	proto.RegisterType((*QueryListFollowingRequest)(nil), "resist.identity.v1.QueryListFollowingRequest")
+	proto.RegisterType((*QueryListFollowingResponse)(nil), "resist.identity.v1.QueryListFollowingResponse")
+	proto.RegisterType((*QueryGetFollowCountsRequest)(nil), "resist.identity.v1.QueryGetFollowCountsRequest")
+	proto.RegisterType((*QueryGetFollowCountsResponse)(nil), "resist.identity.v1.QueryGetFollowCountsResponse")
+	proto.RegisterType((*QueryListBlockedRequest)(nil), "resist.identity.v1.QueryListBlockedRequest")
+	proto.RegisterType((*QueryListBlockedResponse)(nil), "resist.identity.v1.QueryListBlockedResponse")
+}
+
+func init() { proto.RegisterFile("resist/identity/v1/query.proto", fileDescriptor_31c5b3e3bec1457b) }
+
+var fileDescriptor_31c5b3e3bec1457b = []byte{
+	// 1703 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x99, 0xcf, 0x6f, 0xdc, 0x44,
+	0x14, 0xc7, 0xe3, 0xa4, 0x4d, 0xba, 0x93, 0x36, 0x81, 0xa1, 0x3f, 0x52, 0x37, 0xdd, 0xa4, 0xa6,
+	0x4d, 0xd2, 0xfc, 0xb0, 0xbb, 0x49, 0x05, 0xad, 0x0a, 0x48, 0x49, 0xa1, 0x69, 0x05, 0x12, 0x61,
+	0xc5, 0x0f, 0xa9, 0x48, 0xac, 0x9c, 0xf5, 0x74, 0x6b, 0xe2, 0xd8, 0x5b, 0xdb, 0xbb, 0x74, 0x15,
+	0xf6, 0x02, 0x87, 0x5e, 0x2b, 0x40, 0xa2, 0x52, 0x8b, 0x7a, 0xe0, 0x00, 0x08, 0x10, 0x3d, 0xf3,
+	0x17, 0x54, 0x9c, 0x2a, 0xf5, 0xc2, 0x09, 0xa1, 0x16, 0x89, 0x7f, 0x03, 0xed, 0xf8, 0xcd, 0x7a,
+	0xbc, 0x3b, 0x9e, 0x75, 0xc3, 0x0a, 0x2e, 0xed, 0x7a, 0xfc, 0xde, 0xcc, 0xe7, 0xbd, 0x79, 0x33,
+	0x9e, 0xef, 0x04, 0xe5, 0x7d, 0x12, 0xd8, 0x41, 0x68, 0xd8, 0x16, 0x71, 0x43, 0x3b, 0x6c, 0x18,
+	0xf5, 0x82, 0x71, 0xa3, 0x46, 0xfc, 0x86, 0x5e, 0xf5, 0xbd, 0xd0, 0xc3, 0x38, 0x7a, 0xaf, 0xb3,
+	0xf7, 0x7a, 0xbd, 0xa0, 0x3e, 0x6f, 0x6e, 0xdb, 0xae, 0x67, 0xd0, 0x7f, 0x23, 0x33, 0x75, 0xbe,
+	0xec, 0x05, 0xdb, 0x5e, 0x60, 0x6c, 0x9a, 0x01, 0x89, 0xfc, 0x8d, 0x7a, 0x61, 0x93, 0x84, 0x66,
+	0xc1, 0xa8, 0x9a, 0x15, 0xdb, 0x35, 0x43, 0xdb, 0x73, 0xc1, 0xf6, 0x60, 0xc5, 0xab, 0x78, 0xf4,
+	0xa7, 0xd1, 0xfa, 0x05, 0xad, 0x93, 0x15, 0xcf, 0xab, 0x38, 0xc4, 0x30, 0xab, 0xb6, 0x61, 0xba,
+	0xae, 0x17, 0x52, 0x97, 0x00, 0xde, 0x9e, 0x14, 0x60, 0x9a, 0x61, 0x48, 0x82, 0x90, 0xef, 0x79,
+	0x4a, 0x60, 0x75, 0xdd, 0x74, 0x2d, 0x87, 0x48, 0x0c, 0xaa, 0xa6, 0x6f, 0x6e, 0xb3, 0x71, 0xa6,
+	0x45, 0x06, 0xc4, 0x0f, 0x3c, 0xd7, 0x04, 0x8b, 0x13, 0x02, 0x0b, 0x9f, 0x94, 0xbd, 0x7a, 0x3b,
+	0x67, 0xea, 0x29, 0x81, 0x49, 0xe0, 0x95, 0x6d, 0xd3, 0x29, 0x55, 0x7c, 0xb3, 0x7a, 0x5d, 0x62,
+	0x56, 0x0b, 0x88, 0x5f, 0xaa, 0xfa, 0xde, 0x35, 0x9b, 0x31, 0x6b, 0x07, 0x11, 0x7e, 0xa7, 0x95,
+	0xd0, 0x0d, 0xca, 0x59, 0x24, 0x37, 0x6a, 0x24, 0x08, 0xb5, 0x77, 0xd1, 0x0b, 0x89, 0xd6, 0xa0,
+	0xea, 0xb9, 0x01, 0xc1, 0xaf, 0xa2, 0xe1, 0x28, 0x9e, 0x09, 0x65, 0x5a, 0x99, 0x1b, 0x5d, 0x56,
+	0xf5, 0xee, 0xf9, 0xd3, 0x23, 0x9f, 0xb5, 0xdc, 0xc3, 0x3f, 0xa6, 0x06, 0xbe, 0xff, 0xfb, 0xc1,
+	0xbc, 0x52, 0x04, 0x27, 0x6d, 0x19, 0xa9, 0xb4, 0xd7, 0x75, 0x12, 0xbe, 0x17, 0x10, 0x7f, 0x23,
+	0x02, 0x81, 0x31, 0xf1, 0x41, 0xb4, 0xd7, 0x76, 0x2d, 0x72, 0x93, 0xf6, 0x9d, 0x2b, 0x46, 0x0f,
+	0xda, 0x17, 0x0a, 0x3a, 0x26, 0x74, 0x02, 0xa4, 0xcb, 0x68, 0x3f, 0x1f, 0x15, 0x80, 0x4d, 0x89,
+	0xc0, 0x38, 0xf7, 0xb5, 0x3d, 0x2d, 0xba, 0xe2, 0x68, 0x2d, 0x6e, 0xc2, 0xa7, 0xd1, 0x73, 0x75,
+	0xe2, 0xdb, 0xd7, 0x6c, 0x62, 0x95, 0x2c, 0x6f, 0xdb, 0xb4, 0xdd, 0x60, 0x62, 0x70, 0x7a, 0x68,
+	0x2e, 0x57, 0x1c, 0x67, 0xed, 0xaf, 0x47, 0xcd, 0x9a, 0x05, 0x81, 0xac, 0x3a, 0x8e, 0x20, 0x90,
+	0x4b, 0x08, 0xc5, 0x55, 0x09, 0x40, 0x33, 0x7a, 0x54, 0xc2, 0x7a, 0xab, 0x84, 0xf5, 0x68, 0x09,
+	0x40, 0x09, 0xeb, 0x1b, 0x66, 0x85, 0xf9, 0x16, 0x39, 0x4f, 0xed, 0x01, 0x0b, 0xbd, 0x73, 0x98,
+	0xd4, 0xd0, 0x87, 0x76, 0x19, 0xfa, 0x7a, 0x82, 0x78, 0x90, 0x12, 0xcf, 0xf6, 0x24, 0x8e, 0x30,
+	0x12, 0xc8, 0x05, 0x74, 0x88, 0x4d, 0xd6, 0x95, 0x20, 0xa8, 0x11, 0x9f, 0xe5, 0x64, 0x02, 0x8d,
+	0x98, 0x96, 0xe5, 0x93, 0x20, 0x80, 0xe9, 0x65, 0x8f, 0x5a, 0x11, 0x1d, 0xee, 0x74, 0x81, 0xf8,
+	0xce, 0xa1, 0x61, 0x9b, 0xb6, 0xc8, 0xaa, 0x2d, 0xf2, 0x81, 0xa0, 0xc0, 0x5e, 0x2b, 0x01, 0xc6,
+	0xaa, 0xe3, 0x24, 0x31, 0xfa, 0x35, 0x35, 0x77, 0x15, 0xa0, 0xe6, 0x46, 0x10, 0x50, 0x0f, 0x3d,
+	0x0b, 0x75, 0xff, 0x66, 0x61, 0x31, 0x5e, 0x67, 0xab, 0xf1, 0x2e, 0xc6, 0x72, 0x30, 0x86, 0x06,
+	0x6d, 0x8b, 0xc6, 0xbe, 0xa7, 0x38, 0x68, 0x5b, 0xda, 0xa7, 0xf1, 0x02, 0x4b, 0x58, 0x43, 0x3c,
+	0xeb, 0x68, 0x94, 0xdb, 0x0a, 0x65, 0xeb, 0x8b, 0xf3, 0x66, 0x45, 0xc6, 0x79, 0xb6, 0xd6, 0x77,
+	0xdd, 0x74, 0x6c, 0x8b, 0x46, 0xb6, 0xaf, 0x18, 0x3d, 0x68, 0xdf, 0x28, 0xf1, 0x5a, 0x12, 0xc0,
+	0x4e, 0xa0, 0x91, 0xa0, 0xb6, 0xf9, 0x31, 0x29, 0x87, 0xac, 0x6e, 0xe0, 0x11, 0x1f, 0x47, 0x88,
+	0xf6, 0x50, 0xf2, 0x5c, 0xa7, 0x01, 0x7d, 0xe6, 0x68, 0xcb, 0xdb, 0xae, 0xd3, 0xe8, 0x98, 0xe9,
+	0xa1, 0x5d, 0xcf, 0xf4, 0x2f, 0xdc, 0x22, 0xcc, 0x94, 0x9e, 0xa1, 0x5d, 0xa6, 0xa7, 0x6f, 0xb3,
+	0xbf, 0x82, 0x8e, 0x52, 0xe0, 0x22, 0x09, 0x3c, 0xa7, 0x4e, 0x2e, 0xd3, 0x2f, 0x14, 0xcb, 0xe7,
+	0x61, 0x34, 0x1c, 0x7d, 0xb2, 0x20, 0x9d, 0xf0, 0xa4, 0xbd, 0x0f, 0xb3, 0xd0, 0xe1, 0x14, 0xd7,
+	0x34, 0xe7, 0x95, 0x52, 0xd3, 0x91, 0x0f, 0xab, 0x69, 0xe8, 0xf7, 0xa5, 0x76, 0xbf, 0x75, 0xe2,
+	0x07, 0x04, 0xba, 0xef, 0xbd, 0x2b, 0x7c, 0x00, 0x59, 0xef, 0xf4, 0xfb, 0xd7, 0x40, 0x67, 0xd1,
+	0x04, 0xab, 0xf6, 0xf5, 0x9a, 0xe9, 0x5b, 0xb6, 0xe9, 0x06, 0xbd, 0x71, 0x3e, 0x82, 0x9c, 0x26,
+	0xbd, 0x00, 0x66, 0x15, 0xe5, 0x2a, 0xac, 0x11, 0x78, 0x8e, 0x8b, 0x78, 0xda, 0x9e, 0x80, 0x14,
+	0x7b, 0x69, 0x2b, 0xe8, 0x08, 0xeb, 0xbf, 0x08, 0x5f, 0xfb, 0xde, 0x50, 0x57, 0xe3, 0x50, 0x62,
+	0x27, 0x60, 0x7a, 0x0d, 0xed, 0x63, 0xc7, 0x06, 0x40, 0x9a, 0x14, 0x21, 0x31, 0x3f, 0x20, 0x6a,
+	0xfb, 0x68, 0x0b, 0x71, 0xc0, 0x1b, 0xd1, 0x01, 0xe5, 0x4d, 0xd2, 0x48, 0xdb, 0x41, 0xca, 0xf1,
+	0x7e, 0xc3, 0x1b, 0x03, 0xca, 0x1b, 0x68, 0x14, 0xce, 0x38, 0xa5, 0x2d, 0xc2, 0x68, 0xf2, 0xc2,
+	0x93, 0x43, 0xdb, 0x19, 0x78, 0x50, 0xb5, 0xdd, 0xa2, 0x95, 0x81, 0x68, 0xd5, 0x71, 0xba, 0x89,
+	0xfa, 0xb5, 0xaf, 0xff, 0xc4, 0xed, 0x46, 0x59, 0x42, 0x19, 0xda, 0x4d, 0x28, 0xfd, 0x5b, 0xea,
+	0xe7, 0xd1, 0x6c, 0x47, 0xe2, 0x2f, 0xfa, 0x84, 0x52, 0x98, 0x0e, 0x0b, 0x2f, 0x65, 0xce, 0x6e,
+	0x29, 0x68, 0xae, 0xb7, 0x2f, 0xc4, 0xfd, 0x21, 0x1a, 0x67, 0x71, 0xfb, 0xd1, 0x2b, 0xc8, 0xf1,
+	0xa2, 0x24, 0xf6, 0xae, 0xee, 0x20, 0x13, 0x63, 0xd0, 0x15, 0x3b, 0x6b, 0xfe, 0xa8, 0x40, 0x14,
+	0x71, 0xce, 0x53, 0xa3, 0x38, 0x84, 0x86, 0xb7, 0x48, 0xa3, 0xd4, 0x8e, 0x64, 0xef, 0x16, 0x69,
+	0x5c, 0xb1, 0xf0, 0x09, 0xb4, 0xbf, 0x4a, 0x5c, 0xcb, 0x76, 0x2b, 0xfc, 0xd7, 0x60, 0x14, 0xda,
+	0xfa, 0xfa, 0x3d, 0x78, 0xc4, 0xf2, 0x26, 0xa5, 0x95, 0xe5, 0x6d, 0xa8, 0x3f, 0x79, 0xeb, 0x5f,
+	0x15, 0x2d, 0xc7, 0x27, 0xb0, 0x8d, 0xe4, 0x10, 0xe9, 0x7b, 0x8f, 0x13, 0x6f, 0x58, 0x6d, 0x1f,
+	0x08, 0xfa, 0x02, 0x1a, 0x01, 0x52, 0x28, 0x92, 0x63, 0x92, 0x60, 0x21, 0x36, 0xe6, 0x91, 0x72,
+	0x48, 0x68, 0xc2, 0xda, 0x7f, 0xcb, 0x0e, 0xc2, 0x4b, 0x9e, 0xe3, 0x78, 0x9f, 0x10, 0xbf, 0xf7,
+	0xae, 0xdd, 0x31, 0xe7, 0x83, 0xbb, 0x9e, 0xf3, 0xcf, 0xd9, 0xae, 0xd0, 0x31, 0x3e, 0x04, 0x3c,
+	0x89, 0x72, 0xd7, 0x58, 0x23, 0x9d, 0xdf, 0x5c, 0x31, 0x6e, 0xe8, 0xdf, 0x34, 0x75, 0x27, 0xc1,
+	0x76, 0x2b, 0xff, 0x6b, 0x12, 0xe8, 0xf8, 0x9d, 0x49, 0xb0, 0xdd, 0x4a, 0x32, 0x09, 0xb6, 0x5b,
+	0xe9, 0x5f, 0x12, 0x5e, 0x8e, 0x0f, 0xab, 0x11, 0xc3, 0x45, 0xaf, 0xe6, 0x86, 0x41, 0x96, 0x8f,
+	0xe5, 0xa4, 0xd8, 0x51, 0x3c, 0x89, 0xad, 0xcd, 0x85, 0x9b, 0xc4, 0x44, 0x74, 0x83, 0xfc, 0x5b,
+	0xdb, 0xad, 0x68, 0x3b, 0xb0, 0x18, 0x5a, 0x99, 0x59, 0x73, 0xbc, 0xf2, 0x16, 0xb1, 0xfe, 0xbb,
+	0x79, 0x69, 0xc2, 0x29, 0x20, 0x31, 0x38, 0x04, 0x35, 0x81, 0x46, 0x36, 0xa3, 0x26, 0x98, 0x12,
+	0xf6, 0xd8, 0xb7, 0x09, 0x59, 0xfe, 0xf5, 0x28, 0xda, 0x4b, 0xc7, 0xc7, 0x4d, 0x34, 0x1c, 0x49,
+	0x7f, 0x3c, 0x23, 0x5a, 0xf0, 0xdd, 0xb7, 0x0c, 0xea, 0x6c, 0x4f, 0xbb, 0x68, 0x40, 0x4d, 0xfb,
+	0xec, 0xf1, 0x5f, 0x5f, 0x0e, 0x4e, 0x62, 0xd5, 0x48, 0xbd, 0x61, 0xc1, 0xdf, 0x2a, 0x68, 0x2c,
+	0x79, 0x47, 0x80, 0xf5, 0xd4, 0xfe, 0x85, 0x37, 0x10, 0xaa, 0x91, 0xd9, 0x1e, 0xb8, 0xce, 0x50,
+	0xae, 0x79, 0x3c, 0x67, 0xf4, 0xb8, 0x6c, 0x31, 0x76, 0xe8, 0x6d, 0x46, 0x13, 0xdf, 0x53, 0xd0,
+	0x78, 0x6b, 0xa6, 0xb2, 0x61, 0x0a, 0xef, 0x17, 0x24, 0x98, 0xe2, 0x8b, 0x02, 0x6d, 0x8e, 0x62,
+	0x6a, 0x78, 0xba, 0x17, 0x26, 0xbe, 0xad, 0xa0, 0x5c, 0x5b, 0x88, 0xe3, 0xd3, 0xb2, 0x7c, 0x24,
+	0x84, 0xb5, 0x3a, 0x9f, 0xc5, 0x14, 0x70, 0x16, 0x29, 0xce, 0x0c, 0x3e, 0x29, 0xc2, 0x89, 0xb4,
+	0xb0, 0xb1, 0x03, 0xcb, 0xa4, 0x89, 0x6f, 0x29, 0x08, 0xb5, 0x32, 0xd6, 0x93, 0xa9, 0x53, 0xec,
+	0x4b, 0x98, 0xba, 0x54, 0xbb, 0xbc, 0xc2, 0x40, 0x9f, 0xdf, 0x8f, 0x2a, 0x8c, 0xd3, 0x71, 0xf2,
+	0x0a, 0xeb, 0x96, 0xb3, 0xf2, 0x0a, 0x13, 0xc8, 0x4b, 0x79, 0xae, 0x38, 0xf9, 0x68, 0xec, 0xd8,
+	0x56, 0x13, 0xff, 0x0c, 0xd5, 0x95, 0x0d, 0x51, 0xa8, 0xb8, 0xe5, 0xd5, 0x25, 0x42, 0x3c, 0x4f,
+	0x11, 0x57, 0x70, 0xa1, 0x17, 0x22, 0x28, 0x77, 0x63, 0x07, 0x7e, 0x34, 0xf1, 0x5d, 0x05, 0x1d,
+	0x48, 0x28, 0x4e, 0xbc, 0x94, 0x3a, 0xba, 0x48, 0xce, 0xaa, 0x7a, 0x56, 0x73, 0x60, 0x5d, 0xa0,
+	0xac, 0xa7, 0xf0, 0x8b, 0x46, 0xea, 0x5d, 0xae, 0xb1, 0x13, 0xfd, 0xdf, 0xc4, 0xdf, 0x29, 0x68,
+	0x2c, 0xa9, 0x3f, 0xb1, 0x6c, 0x3c, 0x81, 0xc0, 0x95, 0x24, 0x53, 0x2c, 0x6c, 0xb5, 0xb3, 0x14,
+	0x50, 0xc7, 0x8b, 0x12, 0x40, 0x58, 0x1a, 0xdc, 0x1a, 0xb9, 0xa7, 0xa0, 0xfd, 0xbc, 0x34, 0xc5,
+	0x8b, 0xb2, 0x3a, 0xeb, 0xd4, 0xbd, 0xea, 0x52, 0x46, 0x6b, 0x60, 0x34, 0x28, 0xe3, 0x69, 0x3c,
+	0x2b, 0x62, 0x6c, 0x6b, 0x5a, 0x0e, 0xef, 0x8e, 0x82, 0x46, 0x39, 0x91, 0x8a, 0x17, 0x64, 0xe3,
+	0x75, 0xe8, 0x5f, 0x75, 0x31, 0x9b, 0x31, 0xb0, 0xe9, 0x94, 0x6d, 0x0e, 0xcf, 0x18, 0x92, 0x8b,
+	0xf4, 0x64, 0xe6, 0x0e, 0x24, 0x64, 0x2b, 0x96, 0x26, 0xa3, 0x4b, 0x79, 0xaa, 0x7a, 0x56, 0xf3,
+	0x2c, 0x0b, 0x9a, 0x13, 0x97, 0xd1, 0x82, 0xbe, 0xa3, 0xa0, 0xb1, 0xd6, 0x82, 0xce, 0xc4, 0x27,
+	0x52, 0xc6, 0xaa, 0x9e, 0xd5, 0x1c, 0xf8, 0x66, 0x29, 0xdf, 0x09, 0x3c, 0xd5, 0x83, 0x0f, 0xff,
+	0xa6, 0xa0, 0x63, 0x12, 0xed, 0x88, 0x2f, 0x64, 0x48, 0x4c, 0x9a, 0xce, 0x53, 0x5f, 0xd9, 0x9d,
+	0x73, 0x96, 0xcf, 0x72, 0x87, 0x20, 0x8b, 0xf2, 0xfc, 0x58, 0x41, 0x93, 0x5c, 0x9e, 0x9f, 0x25,
+	0x9a, 0xde, 0xaa, 0x55, 0x12, 0x4d, 0x06, 0x11, 0xa9, 0x9d, 0xa3, 0xd1, 0x2c, 0xe3, 0x33, 0x59,
+	0xa2, 0xa1, 0x95, 0x13, 0x49, 0xe4, 0x26, 0xfe, 0x4a, 0x41, 0x28, 0xce, 0x17, 0x9e, 0xcf, 0x90,
+	0x54, 0x86, 0xbc, 0x90, 0xc9, 0x16, 0x08, 0x97, 0x28, 0xe1, 0x2c, 0x3e, 0x25, 0x21, 0xe4, 0xd6,
+	0xdc, 0x7d, 0x05, 0x1d, 0x48, 0x28, 0x29, 0x49, 0x4d, 0x8b, 0x14, 0x9f, 0xa4, 0xa6, 0x85, 0x02,
+	0x4d, 0xbe, 0x61, 0xb5, 0x0f, 0xf9, 0xa9, 0x84, 0x2d, 0x01, 0x93, 0x85, 0x30, 0x96, 0x63, 0x99,
+	0x08, 0x39, 0xf5, 0x94, 0x85, 0xd0, 0x76, 0x2b, 0x1c, 0xe1, 0x0f, 0x0a, 0x1a, 0xef, 0x90, 0x32,
+	0x58, 0x7a, 0xb8, 0x10, 0xa8, 0x25, 0xf5, 0x4c, 0x76, 0x07, 0xe0, 0x5c, 0xa1, 0x9c, 0x4b, 0x78,
+	0x21, 0x9d, 0xb3, 0x54, 0xa6, 0x2e, 0x1c, 0xeb, 0xd7, 0x0a, 0x1a, 0xe5, 0xd4, 0x89, 0x64, 0xfb,
+	0xef, 0x16, 0x50, 0x92, 0xed, 0x5f, 0x20, 0x78, 0xe4, 0x95, 0x08, 0xda, 0x27, 0x26, 0x5b, 0x2b,
+	0x3c, 0x7c, 0x92, 0x57, 0x1e, 0x3d, 0xc9, 0x2b, 0x7f, 0x3e, 0xc9, 0x2b, 0xb7, 0x9f, 0xe6, 0x07,
+	0x1e, 0x3d, 0xcd, 0x0f, 0xfc, 0xfe, 0x34, 0x3f, 0x70, 0xf5, 0x08, 0xf8, 0xdf, 0x8c, 0x7b, 0x08,
+	0x1b, 0x55, 0x12, 0x6c, 0x0e, 0xd3, 0x3f, 0x9b, 0xae, 0xfc, 0x13, 0x00, 0x00, 0xff, 0xff, 0xbf,
+	0xd0, 0xc8, 0x9c, 0xda, 0x1e, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Parameters queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// ListUserProfile Queries a list of UserProfile items.
+	GetUserProfile(ctx context.Context, in *QueryGetUserProfileRequest, opts ...grpc.CallOption) (*QueryGetUserProfileResponse, error)
+	// ListUserProfile defines the ListUserProfile RPC.
+	ListUserProfile(ctx context.Context, in *QueryAllUserProfileRequest, opts ...grpc.CallOption) (*QueryAllUserProfileResponse, error)
+	// GetIssuer Queries an attestation Issuer by address.
+	GetIssuer(ctx context.Context, in *QueryGetIssuerRequest, opts ...grpc.CallOption) (*QueryGetIssuerResponse, error)
+	// ListIssuer Queries a list of attestation Issuer items.
+	ListIssuer(ctx context.Context, in *QueryAllIssuerRequest, opts ...grpc.CallOption) (*QueryAllIssuerResponse, error)
+	// GetAttestation Queries an Attestation by id.
+	GetAttestation(ctx context.Context, in *QueryGetAttestationRequest, opts ...grpc.CallOption) (*QueryGetAttestationResponse, error)
+	// ListAttestation Queries the attestations of a subject.
+	ListAttestation(ctx context.Context, in *QueryAllAttestationRequest, opts ...grpc.CallOption) (*QueryAllAttestationResponse, error)
+	// ResolveHandle Queries the Handle matching a handle, ignoring case and
+	// confusable characters.
+	ResolveHandle(ctx context.Context, in *QueryResolveHandleRequest, opts ...grpc.CallOption) (*QueryResolveHandleResponse, error)
+	// ReverseResolve Queries the Handle held by an account.
+	ReverseResolve(ctx context.Context, in *QueryReverseResolveRequest, opts ...grpc.CallOption) (*QueryReverseResolveResponse, error)
+	// GetGuardians Queries the Guardians of an identity.
+	GetGuardians(ctx context.Context, in *QueryGetGuardiansRequest, opts ...grpc.CallOption) (*QueryGetGuardiansResponse, error)
+	// GetRecovery Queries the pending Recovery of an identity.
+	GetRecovery(ctx context.Context, in *QueryGetRecoveryRequest, opts ...grpc.CallOption) (*QueryGetRecoveryResponse, error)
+	// GetPersonaKey Queries a PersonaKey by id.
+	GetPersonaKey(ctx context.Context, in *QueryGetPersonaKeyRequest, opts ...grpc.CallOption) (*QueryGetPersonaKeyResponse, error)
+	// ListPersonaKey Queries a list of PersonaKey items.
+	ListPersonaKey(ctx context.Context, in *QueryAllPersonaKeyRequest, opts ...grpc.CallOption) (*QueryAllPersonaKeyResponse, error)
+	// GetPersonaCredentialRequest Queries a PersonaCredentialRequest by id.
+	GetPersonaCredentialRequest(ctx context.Context, in *QueryGetPersonaCredentialRequestRequest, opts ...grpc.CallOption) (*QueryGetPersonaCredentialRequestResponse, error)
+	// ListPersonaCredentialRequest Queries the PersonaCredentialRequest items
+	// of a persona key, for its issuer to sign the pending ones.
+	ListPersonaCredentialRequest(ctx context.Context, in *QueryAllPersonaCredentialRequestRequest, opts ...grpc.CallOption) (*QueryAllPersonaCredentialRequestResponse, error)
+	// GetPersona Queries a Persona by address.
+	GetPersona(ctx context.Context, in *QueryGetPersonaRequest, opts ...grpc.CallOption) (*QueryGetPersonaResponse, error)
+	// ListFollowers Queries the followers of an address.
+	ListFollowers(ctx context.Context, in *QueryListFollowersRequest, opts ...grpc.CallOption) (*QueryListFollowersResponse, error)
+	// ListFollowing Queries the addresses an address follows.
+	ListFollowing(ctx context.Context, in *QueryListFollowingRequest, opts ...grpc.CallOption) (*QueryListFollowingResponse, error)
+	// GetFollowCounts Queries the follower and following counts of an address.
+	GetFollowCounts(ctx context.Context, in *QueryGetFollowCountsRequest, opts ...grpc.CallOption) (*QueryGetFollowCountsResponse, error)
+	// ListBlocked Queries the addresses an address blocks.
+	ListBlocked(ctx context.Context, in *QueryListBlockedRequest, opts ...grpc.CallOption) (*QueryListBlockedResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/resist.identity.v1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetUserProfile(ctx context.Context, in *QueryGetUserProfileRequest, opts ...grpc.CallOption) (*QueryGetUserProfileResponse, error) {
+	out := new(QueryGetUserProfileResponse)
+	err := c.cc.Invoke(ctx, "/resist.identity.v1.Query/GetUserProfile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ListUserProfile(ctx context.Context, in *QueryAllUserProfileRequest, opts ...grpc.CallOption) (*QueryAllUserProfileResponse, error) {
+	out := new(QueryAllUserProfileResponse)
+	err := c.cc.Invoke(ctx, "/resist.identity.v1.Query/ListUserProfile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetIssuer(ctx context.Context, in *QueryGetIssuerRequest, opts ...grpc.CallOption) (*QueryGetIssuerResponse, error) {
+	out := new(QueryGetIssuerResponse)
+	err := c.cc.Invoke(ctx, "/resist.identity.v1.Query/GetIssuer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ListIssuer(ctx context.Context, in *QueryAllIssuerRequest, opts ...grpc.CallOption) (*QueryAllIssuerResponse, error) {
+	out := new(QueryAllIssuerResponse)
+	err := c.cc.Invoke(ctx, "/resist.identity.v1.Query/ListIssuer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetAttestation(ctx context.Context, in *QueryGetAttestationRequest, opts ...grpc.CallOption) (*QueryGetAttestationResponse, error) {
+	out := new(QueryGetAttestationResponse)
+	err := c.cc.Invoke(ctx, "/resist.identity.v1.Query/GetAttestation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ListAttestation(ctx context.Context, in *QueryAllAttestationRequest, opts ...grpc.CallOption) (*QueryAllAttestationResponse, error) {
+	out := new(QueryAllAttestationResponse)
+	err := c.cc.Invoke(ctx, "/resist.identity.v1.Query/ListAttestation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ResolveHandle(ctx context.Context, in *QueryResolveHandleRequest, opts ...grpc.CallOption) (*QueryResolveHandleResponse, error) {
+	out := new(QueryResolveHandleResponse)
+	err := c.cc.Invoke(ctx, "/resist.identity.v1.Query/ResolveHandle", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ReverseResolve(ctx context.Context, in *QueryReverseResolveRequest, opts ...grpc.CallOption) (*QueryReverseResolveResponse, error) {
+	out := new(QueryReverseResolveResponse)
+	err := c.cc.Invoke(ctx, "/resist.identity.v1.Query/ReverseResolve", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetGuardians(ctx context.Context, in *QueryGetGuardiansRequest, opts ...grpc.CallOption) (*QueryGetGuardiansResponse, error) {
+	out := new(QueryGetGuardiansResponse)
+	err := c.cc.Invoke(ctx, "/resist.identity.v1.Query/GetGuardians", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetRecovery(ctx context.Context, in *QueryGetRecoveryRequest, opts ...grpc.CallOption) (*QueryGetRecoveryResponse, error) {
+	out := new(QueryGetRecoveryResponse)
+	err := c.cc.Invoke(ctx, "/resist.identity.v1.Query/GetRecovery", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetPersonaKey(ctx context.Context, in *QueryGetPersonaKeyRequest, opts ...grpc.CallOption) (*QueryGetPersonaKeyResponse, error) {
+	out := new(QueryGetPersonaKeyResponse)
+	err := c.cc.Invoke(ctx, "/resist.identity.v1.Query/GetPersonaKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ListPersonaKey(ctx context.Context, in *QueryAllPersonaKeyRequest, opts ...grpc.CallOption) (*QueryAllPersonaKeyResponse, error) {
+	out := new(QueryAllPersonaKeyResponse)
+	err := c.cc.Invoke(ctx, "/resist.identity.v1.Query/ListPersonaKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetPersonaCredentialRequest(ctx context.Context, in *QueryGetPersonaCredentialRequestRequest, opts ...grpc.CallOption) (*QueryGetPersonaCredentialRequestResponse, error) {
+	out := new(QueryGetPersonaCredentialRequestResponse)
+	err := c.cc.Invoke(ctx, "/resist.identity.v1.Query/GetPersonaCredentialRequest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ListPersonaCredentialRequest(ctx context.Context, in *QueryAllPersonaCredentialRequestRequest, opts ...grpc.CallOption) (*QueryAllPersonaCredentialRequestResponse, error) {
+	out := new(QueryAllPersonaCredentialRequestResponse)
+	err := c.cc.Invoke(ctx, "/resist.identity.v1.Query/ListPersonaCredentialRequest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetPersona(ctx context.Context, in *QueryGetPersonaRequest, opts ...grpc.CallOption) (*QueryGetPersonaResponse, error) {
+	out := new(QueryGetPersonaResponse)
+	err := c.cc.Invoke(ctx, "/resist.identity.v1.Query/GetPersona", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ListFollowers(ctx context.Context, in *QueryListFollowersRequest, opts ...grpc.CallOption) (*QueryListFollowersResponse, error) {
+	out := new(QueryListFollowersResponse)
+	err := c.cc.Invoke(ctx, "/resist.identity.v1.Query/ListFollowers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ListFollowing(ctx context.Context, in *QueryListFollowingRequest, opts ...grpc.CallOption) (*QueryListFollowingResponse, error) {
+	out := new(QueryListFollowingResponse)
+	err := c.cc.Invoke(ctx, "/resist.identity.v1.Query/ListFollowing", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetFollowCounts(ctx context.Context, in *QueryGetFollowCountsRequest, opts ...grpc.CallOption) (*QueryGetFollowCountsResponse, error) {
+	out := new(QueryGetFollowCountsResponse)
+	err := c.cc.Invoke(ctx, "/resist.identity.v1.Query/GetFollowCounts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ListBlocked(ctx context.Context, in *QueryListBlockedRequest, opts ...grpc.CallOption) (*QueryListBlockedResponse, error) {
+	out := new(QueryListBlockedResponse)
+	err := c.cc.Invoke(ctx, "/resist.identity.v1.Query/ListBlocked", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Parameters queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// ListUserProfile Queries a list of UserProfile items.
+	GetUserProfile(context.Context, *QueryGetUserProfileRequest) (*QueryGetUserProfileResponse, error)
+	// ListUserProfile defines the ListUserProfile RPC.
+	ListUserProfile(context.Context, *QueryAllUserProfileRequest) (*QueryAllUserProfileResponse, error)
+	// GetIssuer Queries an attestation Issuer by address.
+	GetIssuer(context.Context, *QueryGetIssuerRequest) (*QueryGetIssuerResponse, error)
+	// ListIssuer Queries a list of attestation Issuer items.
+	ListIssuer(context.Context, *QueryAllIssuerRequest) (*QueryAllIssuerResponse, error)
+	// GetAttestation Queries an Attestation by id.
+	GetAttestation(context.Context, *QueryGetAttestationRequest) (*QueryGetAttestationResponse, error)
+	// ListAttestation Queries the attestations of a subject.
+	ListAttestation(context.Context, *QueryAllAttestationRequest) (*QueryAllAttestationResponse, error)
+	// ResolveHandle Queries the Handle matching a handle, ignoring case and
+	// confusable characters.
+	ResolveHandle(context.Context, *QueryResolveHandleRequest) (*QueryResolveHandleResponse, error)
+	// ReverseResolve Queries the Handle held by an account.
+	ReverseResolve(context.Context, *QueryReverseResolveRequest) (*QueryReverseResolveResponse, error)
+	// GetGuardians Queries the Guardians of an identity.
+	GetGuardians(context.Context, *QueryGetGuardiansRequest) (*QueryGetGuardiansResponse, error)
+	// GetRecovery Queries the pending Recovery of an identity.
+	GetRecovery(context.Context, *QueryGetRecoveryRequest) (*QueryGetRecoveryResponse, error)
+	// GetPersonaKey Queries a PersonaKey by id.
+	GetPersonaKey(context.Context, *QueryGetPersonaKeyRequest) (*QueryGetPersonaKeyResponse, error)
+	// ListPersonaKey Queries a list of PersonaKey items.
+	ListPersonaKey(context.Context, *QueryAllPersonaKeyRequest) (*QueryAllPersonaKeyResponse, error)
+	// GetPersonaCredentialRequest Queries a PersonaCredentialRequest by id.
+	GetPersonaCredentialRequest(context.Context, *QueryGetPersonaCredentialRequestRequest) (*QueryGetPersonaCredentialRequestResponse, error)
+	// ListPersonaCredentialRequest Queries the PersonaCredentialRequest items
+	// of a persona key, for its issuer to sign the pending ones.
+	ListPersonaCredentialRequest(context.Context, *QueryAllPersonaCredentialRequestRequest) (*QueryAllPersonaCredentialRequestResponse, error)
+	// GetPersona Queries a Persona by address.
+	GetPersona(context.Context, *QueryGetPersonaRequest) (*QueryGetPersonaResponse, error)
+	// ListFollowers Queries the followers of an address.
+	ListFollowers(context.Context, *QueryListFollowersRequest) (*QueryListFollowersResponse, error)
+	// ListFollowing Queries the addresses an address follows.
+	ListFollowing(context.Context, *QueryListFollowingRequest) (*QueryListFollowingResponse, error)
+	// GetFollowCounts Queries the follower and following counts of an address.
+	GetFollowCounts(context.Context, *QueryGetFollowCountsRequest) (*QueryGetFollowCountsResponse, error)
+	// ListBlocked Queries the addresses an address blocks.
+	ListBlocked(context.Context, *QueryListBlockedRequest) (*QueryListBlockedResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) GetUserProfile(ctx context.Context, req *QueryGetUserProfileRequest) (*QueryGetUserProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserProfile not implemented")
+}
+func (*UnimplementedQueryServer) ListUserProfile(ctx context.Context, req *QueryAllUserProfileRequest) (*QueryAllUserProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUserProfile not implemented")
+}
+func (*UnimplementedQueryServer) GetIssuer(ctx context.Context, req *QueryGetIssuerRequest) (*QueryGetIssuerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetIssuer not implemented")
+}
+func (*UnimplementedQueryServer) ListIssuer(ctx context.Context, req *QueryAllIssuerRequest) (*QueryAllIssuerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListIssuer not implemented")
+}
+func (*UnimplementedQueryServer) GetAttestation(ctx context.Context, req *QueryGetAttestationRequest) (*QueryGetAttestationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAttestation not implemented")
+}
+func (*UnimplementedQueryServer) ListAttestation(ctx context.Context, req *QueryAllAttestationRequest) (*QueryAllAttestationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAttestation not implemented")
+}
+func (*UnimplementedQueryServer) ResolveHandle(ctx context.Context, req *QueryResolveHandleRequest) (*QueryResolveHandleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveHandle not implemented")
+}
+func (*UnimplementedQueryServer) ReverseResolve(ctx context.Context, req *QueryReverseResolveRequest) (*QueryReverseResolveResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReverseResolve not implemented")
+}
+func (*UnimplementedQueryServer) GetGuardians(ctx context.Context, req *QueryGetGuardiansRequest) (*QueryGetGuardiansResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGuardians not implemented")
+}
+func (*UnimplementedQueryServer) GetRecovery(ctx context.Context, req *QueryGetRecoveryRequest) (*QueryGetRecoveryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRecovery not implemented")
+}
+func (*UnimplementedQueryServer) GetPersonaKey(ctx context.Context, req *QueryGetPersonaKeyRequest) (*QueryGetPersonaKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPersonaKey not implemented")
+}
+func (*UnimplementedQueryServer) ListPersonaKey(ctx context.Context, req *QueryAllPersonaKeyRequest) (*QueryAllPersonaKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPersonaKey not implemented")
+}
+func (*UnimplementedQueryServer) GetPersonaCredentialRequest(ctx context.Context, req *QueryGetPersonaCredentialRequestRequest) (*QueryGetPersonaCredentialRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPersonaCredentialRequest not implemented")
+}
+func (*UnimplementedQueryServer) ListPersonaCredentialRequest(ctx context.Context, req *QueryAllPersonaCredentialRequestRequest) (*QueryAllPersonaCredentialRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPersonaCredentialRequest not implemented")
+}
+func (*UnimplementedQueryServer) GetPersona(ctx context.Context, req *QueryGetPersonaRequest) (*QueryGetPersonaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPersona not implemented")
+}
+func (*UnimplementedQueryServer) ListFollowers(ctx context.Context, req *QueryListFollowersRequest) (*QueryListFollowersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFollowers not implemented")
+}
+func (*UnimplementedQueryServer) ListFollowing(ctx context.Context, req *QueryListFollowingRequest) (*QueryListFollowingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFollowing not implemented")
+}
+func (*UnimplementedQueryServer) GetFollowCounts(ctx context.Context, req *QueryGetFollowCountsRequest) (*QueryGetFollowCountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFollowCounts not implemented")
+}
+func (*UnimplementedQueryServer) ListBlocked(ctx context.Context, req *QueryListBlockedRequest) (*QueryListBlockedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBlocked not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/resist.identity.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetUserProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetUserProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetUserProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/resist.identity.v1.Query/GetUserProfile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetUserProfile(ctx, req.(*QueryGetUserProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListUserProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllUserProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListUserProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/resist.identity.v1.Query/ListUserProfile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListUserProfile(ctx, req.(*QueryAllUserProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetIssuer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetIssuerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetIssuer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/resist.identity.v1.Query/GetIssuer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetIssuer(ctx, req.(*QueryGetIssuerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListIssuer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllIssuerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListIssuer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/resist.identity.v1.Query/ListIssuer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListIssuer(ctx, req.(*QueryAllIssuerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetAttestation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetAttestationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetAttestation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/resist.identity.v1.Query/GetAttestation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetAttestation(ctx, req.(*QueryGetAttestationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListAttestation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllAttestationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListAttestation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/resist.identity.v1.Query/ListAttestation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListAttestation(ctx, req.(*QueryAllAttestationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ResolveHandle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryResolveHandleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ResolveHandle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/resist.identity.v1.Query/ResolveHandle",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ResolveHandle(ctx, req.(*QueryResolveHandleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ReverseResolve_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryReverseResolveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ReverseResolve(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/resist.identity.v1.Query/ReverseResolve",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ReverseResolve(ctx, req.(*QueryReverseResolveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetGuardians_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetGuardiansRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetGuardians(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/resist.identity.v1.Query/GetGuardians",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetGuardians(ctx, req.(*QueryGetGuardiansRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetRecovery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetRecoveryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetRecovery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/resist.identity.v1.Query/GetRecovery",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetRecovery(ctx, req.(*QueryGetRecoveryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetPersonaKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetPersonaKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetPersonaKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/resist.identity.v1.Query/GetPersonaKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetPersonaKey(ctx, req.(*QueryGetPersonaKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListPersonaKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllPersonaKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListPersonaKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/resist.identity.v1.Query/ListPersonaKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListPersonaKey(ctx, req.(*QueryAllPersonaKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetPersonaCredentialRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetPersonaCredentialRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetPersonaCredentialRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/resist.identity.v1.Query/GetPersonaCredentialRequest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetPersonaCredentialRequest(ctx, req.(*QueryGetPersonaCredentialRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListPersonaCredentialRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllPersonaCredentialRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListPersonaCredentialRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/resist.identity.v1.Query/ListPersonaCredentialRequest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListPersonaCredentialRequest(ctx, req.(*QueryAllPersonaCredentialRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetPersona_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetPersonaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetPersona(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/resist.identity.v1.Query/GetPersona",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetPersona(ctx, req.(*QueryGetPersonaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListFollowers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryListFollowersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListFollowers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/resist.identity.v1.Query/ListFollowers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListFollowers(ctx, req.(*QueryListFollowersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListFollowing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryListFollowingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListFollowing(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/resist.identity.v1.Query/ListFollowing",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListFollowing(ctx, req.(*QueryListFollowingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetFollowCounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetFollowCountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetFollowCounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/resist.identity.v1.Query/GetFollowCounts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetFollowCounts(ctx, req.(*QueryGetFollowCountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListBlocked_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryListBlockedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListBlocked(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/resist.identity.v1.Query/ListBlocked",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListBlocked(ctx, req.(*QueryListBlockedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "resist.identity.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "GetUserProfile",
+			Handler:    _Query_GetUserProfile_Handler,
+		},
+		{
+			MethodName: "ListUserProfile",
+			Handler:    _Query_ListUserProfile_Handler,
+		},
+		{
+			MethodName: "GetIssuer",
+			Handler:    _Query_GetIssuer_Handler,
+		},
+		{
+			MethodName: "ListIssuer",
+			Handler:    _Query_ListIssuer_Handler,
+		},
+		{
+			MethodName: "GetAttestation",
+			Handler:    _Query_GetAttestation_Handler,
+		},
+		{
+			MethodName: "ListAttestation",
+			Handler:    _Query_ListAttestation_Handler,
+		},
+		{
+			MethodName: "ResolveHandle",
+			Handler:    _Query_ResolveHandle_Handler,
+		},
+		{
+			MethodName: "ReverseResolve",
+			Handler:    _Query_ReverseResolve_Handler,
+		},
+		{
+			MethodName: "GetGuardians",
+			Handler:    _Query_GetGuardians_Handler,
+		},
+		{
+			MethodName: "GetRecovery",
+			Handler:    _Query_GetRecovery_Handler,
+		},
+		{
+			MethodName: "GetPersonaKey",
+			Handler:    _Query_GetPersonaKey_Handler,
+		},
+		{
+			MethodName: "ListPersonaKey",
+			Handler:    _Query_ListPersonaKey_Handler,
+		},
+		{
+			MethodName: "GetPersonaCredentialRequest",
+			Handler:    _Query_GetPersonaCredentialRequest_Handler,
+		},
+		{
+			MethodName: "ListPersonaCredentialRequest",
+			Handler:    _Query_ListPersonaCredentialRequest_Handler,
+		},
+		{
+			MethodName: "GetPersona",
+			Handler:    _Query_GetPersona_Handler,
+		},
+		{
+			MethodName: "ListFollowers",
+			Handler:    _Query_ListFollowers_Handler,
+		},
+		{
+			MethodName: "ListFollowing",
+			Handler:    _Query_ListFollowing_Handler,
+		},
+		{
+			MethodName: "GetFollowCounts",
+			Handler:    _Query_GetFollowCounts_Handler,
+		},
+		{
+			MethodName: "ListBlocked",
+			Handler:    _Query_ListBlocked_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "resist/identity/v1/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetUserProfileRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetUserProfileRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetUserProfileRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Index) > 0 {
+		i -= len(m.Index)
+		copy(dAtA[i:], m.Index)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Index)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetUserProfileResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetUserProfileResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetUserProfileResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.VerifiedDomains) > 0 {
+		for iNdEx := len(m.VerifiedDomains) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.VerifiedDomains[iNdEx])
+			copy(dAtA[i:], m.VerifiedDomains[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.VerifiedDomains[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.UserProfile.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllUserProfileRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllUserProfileRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllUserProfileRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllUserProfileResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllUserProfileResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllUserProfileResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.UserProfile) > 0 {
+		for iNdEx := len(m.UserProfile) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UserProfile[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetIssuerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetIssuerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetIssuerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetIssuerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetIssuerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetIssuerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Issuer.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllIssuerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllIssuerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllIssuerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllIssuerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllIssuerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllIssuerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Issuer) > 0 {
+		for iNdEx := len(m.Issuer) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Issuer[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetAttestationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetAttestationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetAttestationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetAttestationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetAttestationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetAttestationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Valid {
+		i--
+		if m.Valid {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Attestation.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllAttestationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllAttestationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllAttestationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ValidOnly {
+		i--
+		if m.ValidOnly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Subject) > 0 {
+		i -= len(m.Subject)
+		copy(dAtA[i:], m.Subject)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Subject)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllAttestationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllAttestationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllAttestationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Attestation) > 0 {
+		for iNdEx := len(m.Attestation) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Attestation[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryResolveHandleRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryResolveHandleRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryResolveHandleRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Handle) > 0 {
+		i -= len(m.Handle)
+		copy(dAtA[i:], m.Handle)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Handle)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryResolveHandleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryResolveHandleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryResolveHandleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Handle.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryReverseResolveRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryReverseResolveRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryReverseResolveRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryReverseResolveResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryReverseResolveResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryReverseResolveResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Handle.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetGuardiansRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetGuardiansRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetGuardiansRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetGuardiansResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetGuardiansResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetGuardiansResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Guardians.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetRecoveryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetRecoveryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetRecoveryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetRecoveryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetRecoveryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetRecoveryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Recovery.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetPersonaKeyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetPersonaKeyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetPersonaKeyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetPersonaKeyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetPersonaKeyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetPersonaKeyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.PersonaKey.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllPersonaKeyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllPersonaKeyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllPersonaKeyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllPersonaKeyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllPersonaKeyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllPersonaKeyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PersonaKey) > 0 {
+		for iNdEx := len(m.PersonaKey) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PersonaKey[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetPersonaCredentialRequestRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetPersonaCredentialRequestRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetPersonaCredentialRequestRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetPersonaCredentialRequestResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetPersonaCredentialRequestResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetPersonaCredentialRequestResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.PersonaRequest.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllPersonaCredentialRequestRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllPersonaCredentialRequestRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllPersonaCredentialRequestRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.PendingOnly {
+		i--
+		if m.PendingOnly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.KeyId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.KeyId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllPersonaCredentialRequestResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllPersonaCredentialRequestResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllPersonaCredentialRequestResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PersonaRequest) > 0 {
+		for iNdEx := len(m.PersonaRequest) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PersonaRequest[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetPersonaRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetPersonaRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetPersonaRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetPersonaResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetPersonaResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetPersonaResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Valid {
+		i--
+		if m.Valid {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Persona.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListFollowersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListFollowersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListFollowersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListFollowersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListFollowersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListFollowersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Followers) > 0 {
+		for iNdEx := len(m.Followers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Followers[iNdEx])
+			copy(dAtA[i:], m.Followers[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Followers[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListFollowingRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListFollowingRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListFollowingRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListFollowingResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListFollowingResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListFollowingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Following) > 0 {
+		for iNdEx := len(m.Following) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Following[iNdEx])
+			copy(dAtA[i:], m.Following[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Following[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetFollowCountsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetFollowCountsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetFollowCountsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetFollowCountsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetFollowCountsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetFollowCountsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Following != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Following))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Followers != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Followers))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListBlockedRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListBlockedRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListBlockedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListBlockedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListBlockedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListBlockedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Blocked) > 0 {
+		for iNdEx := len(m.Blocked) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Blocked[iNdEx])
+			copy(dAtA[i:], m.Blocked[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Blocked[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetUserProfileRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Index)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetUserProfileResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.UserProfile.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.VerifiedDomains) > 0 {
+		for _, s := range m.VerifiedDomains {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
 }
 
 func (m *QueryAllUserProfileRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllUserProfileResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.UserProfile) > 0 {
+		for _, e := range m.UserProfile {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetIssuerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetIssuerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Issuer.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllIssuerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllIssuerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Issuer) > 0 {
+		for _, e := range m.Issuer {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetAttestationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryGetAttestationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Attestation.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Valid {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryAllAttestationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Subject)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ValidOnly {
+		n += 2
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllAttestationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Attestation) > 0 {
+		for _, e := range m.Attestation {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryResolveHandleRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Handle)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryResolveHandleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Handle.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryReverseResolveRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryReverseResolveResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Handle.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetGuardiansRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetGuardiansResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Guardians.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetRecoveryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetRecoveryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Recovery.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetPersonaKeyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryGetPersonaKeyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.PersonaKey.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllPersonaKeyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllPersonaKeyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PersonaKey) > 0 {
+		for _, e := range m.PersonaKey {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetPersonaCredentialRequestRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryGetPersonaCredentialRequestResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.PersonaRequest.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllPersonaCredentialRequestRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.KeyId != 0 {
+		n += 1 + sovQuery(uint64(m.KeyId))
+	}
+	if m.PendingOnly {
+		n += 2
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllPersonaCredentialRequestResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PersonaRequest) > 0 {
+		for _, e := range m.PersonaRequest {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetPersonaRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetPersonaResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Persona.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Valid {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryListFollowersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryListFollowersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Followers) > 0 {
+		for _, s := range m.Followers {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryListFollowingRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryListFollowingResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Following) > 0 {
+		for _, s := range m.Following {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetFollowCountsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetFollowCountsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Followers != 0 {
+		n += 1 + sovQuery(uint64(m.Followers))
+	}
+	if m.Following != 0 {
+		n += 1 + sovQuery(uint64(m.Following))
+	}
+	return n
+}
+
+func (m *QueryListBlockedRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryListBlockedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Blocked) > 0 {
+		for _, s := range m.Blocked {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetUserProfileRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetUserProfileRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetUserProfileRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Index = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetUserProfileResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetUserProfileResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetUserProfileResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserProfile", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UserProfile.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerifiedDomains", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VerifiedDomains = append(m.VerifiedDomains, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllUserProfileRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllUserProfileRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllUserProfileRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllUserProfileResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllUserProfileResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllUserProfileResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserProfile", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UserProfile = append(m.UserProfile, UserProfile{})
+			if err := m.UserProfile[len(m.UserProfile)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetIssuerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetIssuerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetIssuerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetIssuerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetIssuerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetIssuerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Issuer", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Issuer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllIssuerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllIssuerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllIssuerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllIssuerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllIssuerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllIssuerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Issuer", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Issuer = append(m.Issuer, Issuer{})
+			if err := m.Issuer[len(m.Issuer)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetAttestationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetAttestationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetAttestationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetAttestationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetAttestationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetAttestationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attestation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Attestation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Valid", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Valid = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllAttestationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllAttestationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllAttestationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subject", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Subject = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidOnly", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ValidOnly = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllAttestationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllAttestationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllAttestationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attestation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Attestation = append(m.Attestation, Attestation{})
+			if err := m.Attestation[len(m.Attestation)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryResolveHandleRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryResolveHandleRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryResolveHandleRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Handle", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Handle = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryResolveHandleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryResolveHandleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryResolveHandleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Handle", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Handle.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryReverseResolveRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryReverseResolveRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryReverseResolveRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryReverseResolveResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryReverseResolveResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryReverseResolveResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Handle", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Handle.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetGuardiansRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetGuardiansRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetGuardiansRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetGuardiansResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetGuardiansResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetGuardiansResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Guardians", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Guardians.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetRecoveryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetRecoveryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetRecoveryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetRecoveryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetRecoveryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetRecoveryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recovery", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Recovery.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetPersonaKeyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetPersonaKeyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetPersonaKeyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetPersonaKeyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetPersonaKeyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetPersonaKeyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PersonaKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PersonaKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllPersonaKeyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllPersonaKeyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllPersonaKeyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllPersonaKeyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllPersonaKeyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllPersonaKeyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PersonaKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PersonaKey = append(m.PersonaKey, PersonaKey{})
+			if err := m.PersonaKey[len(m.PersonaKey)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetPersonaCredentialRequestRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetPersonaCredentialRequestRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetPersonaCredentialRequestRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetPersonaCredentialRequestResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetPersonaCredentialRequestResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetPersonaCredentialRequestResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PersonaRequest", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PersonaRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllPersonaCredentialRequestRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllPersonaCredentialRequestRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllPersonaCredentialRequestRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyId", wireType)
+			}
+			m.KeyId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.KeyId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingOnly", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.PendingOnly = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllPersonaCredentialRequestResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllPersonaCredentialRequestResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllPersonaCredentialRequestResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PersonaRequest", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PersonaRequest = append(m.PersonaRequest, PersonaCredentialRequest{})
+			if err := m.PersonaRequest[len(m.PersonaRequest)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetPersonaRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetPersonaRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetPersonaRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetPersonaResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetPersonaResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetPersonaResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Persona", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Persona.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Valid", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Valid = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
+func (m *QueryListFollowersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryListFollowersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryListFollowersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *QueryAllUserProfileResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	if len(m.UserProfile) > 0 {
-		for _, e := range m.UserProfile {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	return nil
+}
+func (m *QueryListFollowersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryListFollowersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryListFollowersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Followers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Followers = append(m.Followers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryListFollowingRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -827,12 +8230,80 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryListFollowingRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryListFollowingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -854,7 +8325,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryListFollowingResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -877,15 +8348,47 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryListFollowingResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryListFollowingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Following", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Following = append(m.Following, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -912,7 +8415,10 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -937,7 +8443,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetUserProfileRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetFollowCountsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -960,15 +8466,15 @@ func (m *QueryGetUserProfileRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetUserProfileRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetFollowCountsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetUserProfileRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetFollowCountsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -996,7 +8502,7 @@ func (m *QueryGetUserProfileRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Index = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1019,7 +8525,7 @@ func (m *QueryGetUserProfileRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetUserProfileResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetFollowCountsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1042,17 +8548,17 @@ func (m *QueryGetUserProfileResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetUserProfileResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetFollowCountsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetUserProfileResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetFollowCountsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UserProfile", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Followers", wireType)
 			}
-			var msglen int
+			m.Followers = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1062,25 +8568,30 @@ func (m *QueryGetUserProfileResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Followers |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Following", wireType)
 			}
-			if err := m.UserProfile.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Following = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Following |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1102,7 +8613,7 @@ func (m *QueryGetUserProfileResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllUserProfileRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryListBlockedRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1125,13 +8636,45 @@ func (m *QueryAllUserProfileRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllUserProfileRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryListBlockedRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllUserProfileRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryListBlockedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -1188,7 +8731,7 @@ func (m *QueryAllUserProfileRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllUserProfileResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryListBlockedResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1211,17 +8754,17 @@ func (m *QueryAllUserProfileResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllUserProfileResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryListBlockedResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllUserProfileResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryListBlockedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UserProfile", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Blocked", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1231,25 +8774,23 @@ func (m *QueryAllUserProfileResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UserProfile = append(m.UserProfile, UserProfile{})
-			if err := m.UserProfile[len(m.UserProfile)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Blocked = append(m.Blocked, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
 	math "math"
@@ -28,9 +29,14 @@ type UserProfile struct {
 	DisplayName string `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
 	Bio         string `protobuf:"bytes,3,opt,name=bio,proto3" json:"bio,omitempty"`
 	AvatarUrl   string `protobuf:"bytes,4,opt,name=avatar_url,json=avatarUrl,proto3" json:"avatar_url,omitempty"`
-	Verified    bool   `protobuf:"varint,5,opt,name=verified,proto3" json:"verified,omitempty"`
-	CreatedAt   int64  `protobuf:"varint,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	Creator     string `protobuf:"bytes,7,opt,name=creator,proto3" json:"creator,omitempty"`
+	// verified is derived from the valid attestations of the profile owner and
+	// is never set by the owner.
+	Verified  bool   `protobuf:"varint,5,opt,name=verified,proto3" json:"verified,omitempty"`
+	CreatedAt int64  `protobuf:"varint,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Creator   string `protobuf:"bytes,7,opt,name=creator,proto3" json:"creator,omitempty"`
+	// metadata are the other public details of the profile owner, such as
+	// their website, PGP fingerprint or Matrix ID, with distinct keys.
+	Metadata []ProfileMetadata `protobuf:"bytes,8,rep,name=metadata,proto3" json:"metadata"`
 }
 
 func (m *UserProfile) Reset()         { *m = UserProfile{} }
@@ -115,8 +121,69 @@ func (m *UserProfile) GetCreator() string {
 	return ""
 }
 
+func (m *UserProfile) GetMetadata() []ProfileMetadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+// ProfileMetadata is a key/value entry of a user profile.
+type ProfileMetadata struct {
+	Key   string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (m *ProfileMetadata) Reset()         { *m = ProfileMetadata{} }
+func (m *ProfileMetadata) String() string { return proto.CompactTextString(m) }
+func (*ProfileMetadata) ProtoMessage()    {}
+func (*ProfileMetadata) Descriptor() ([]byte, []int) {
+	return fileDescriptor_15bb6fee1f6caf8d, []int{1}
+}
+func (m *ProfileMetadata) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProfileMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProfileMetadata.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProfileMetadata) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProfileMetadata.Merge(m, src)
+}
+func (m *ProfileMetadata) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProfileMetadata) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProfileMetadata.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProfileMetadata proto.InternalMessageInfo
+
+func (m *ProfileMetadata) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *ProfileMetadata) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*UserProfile)(nil), "resist.identity.v1.UserProfile")
+	proto.RegisterType((*ProfileMetadata)(nil), "resist.identity.v1.ProfileMetadata")
 }
 
 func init() {
@@ -124,24 +191,28 @@ func init() {
 }
 
 var fileDescriptor_15bb6fee1f6caf8d = []byte{
-	// 264 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x44, 0x90, 0xcd, 0x4a, 0xc4, 0x30,
-	0x14, 0x85, 0x27, 0xd6, 0xf9, 0x69, 0xc6, 0x85, 0x04, 0xc1, 0x20, 0x18, 0xaa, 0x20, 0x74, 0xd5,
-	0x52, 0x7c, 0x02, 0x7d, 0x00, 0x91, 0xc2, 0x6c, 0xdc, 0x94, 0x8c, 0xb9, 0x03, 0x81, 0x4e, 0x53,
-	0x6e, 0x32, 0x65, 0xfa, 0x16, 0x3e, 0x96, 0x0b, 0x17, 0xb3, 0x74, 0x29, 0xed, 0x8b, 0xc8, 0xa4,
-	0x1d, 0xdd, 0xe5, 0x3b, 0xe7, 0xe4, 0x2e, 0x3e, 0xfa, 0x80, 0x60, 0xb5, 0x75, 0xa9, 0x56, 0x50,
-	0x39, 0xed, 0xda, 0xb4, 0xc9, 0xd2, 0x9d, 0x05, 0x2c, 0x6a, 0x34, 0x1b, 0x5d, 0x42, 0x52, 0xa3,
-	0x71, 0x86, 0xb1, 0x61, 0x96, 0x9c, 0x66, 0x49, 0x93, 0xdd, 0x7f, 0x11, 0xba, 0x5c, 0x59, 0xc0,
-	0xd7, 0x61, 0xc9, 0xae, 0xe8, 0x54, 0x57, 0x0a, 0xf6, 0x9c, 0x44, 0x24, 0x0e, 0xf3, 0x01, 0xd8,
-	0x1d, 0xbd, 0x50, 0xda, 0xd6, 0xa5, 0x6c, 0x8b, 0x4a, 0x6e, 0x81, 0x9f, 0xf9, 0x72, 0x39, 0x66,
-	0x2f, 0x72, 0x0b, 0xec, 0x92, 0x06, 0x6b, 0x6d, 0x78, 0xe0, 0x9b, 0xe3, 0x93, 0xdd, 0x52, 0x2a,
-	0x1b, 0xe9, 0x24, 0x16, 0x3b, 0x2c, 0xf9, 0xb9, 0x2f, 0xc2, 0x21, 0x59, 0x61, 0xc9, 0x6e, 0xe8,
-	0xa2, 0x01, 0xd4, 0x1b, 0x0d, 0x8a, 0x4f, 0x23, 0x12, 0x2f, 0xf2, 0x3f, 0x3e, 0x7e, 0x7d, 0x47,
-	0x90, 0x0e, 0x54, 0x21, 0x1d, 0x9f, 0x45, 0x24, 0x0e, 0xf2, 0x70, 0x4c, 0x9e, 0x1c, 0xe3, 0x74,
-	0xee, 0xc1, 0x20, 0x9f, 0xfb, 0xb3, 0x27, 0x7c, 0xce, 0x3e, 0x3b, 0x41, 0x0e, 0x9d, 0x20, 0x3f,
-	0x9d, 0x20, 0x1f, 0xbd, 0x98, 0x1c, 0x7a, 0x31, 0xf9, 0xee, 0xc5, 0xe4, 0xed, 0x7a, 0x74, 0xb4,
-	0xff, 0xb7, 0xe4, 0xda, 0x1a, 0xec, 0x7a, 0xe6, 0xe5, 0x3c, 0xfe, 0x06, 0x00, 0x00, 0xff, 0xff,
-	0x41, 0x9e, 0x06, 0x63, 0x45, 0x01, 0x00, 0x00,
+	// 333 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x51, 0x4d, 0x4b, 0xfb, 0x30,
+	0x1c, 0x6e, 0xd6, 0xbd, 0x74, 0xd9, 0x1f, 0xfe, 0x12, 0x06, 0x86, 0x81, 0xb5, 0x4e, 0x84, 0x9e,
+	0x5a, 0xa6, 0x27, 0x8f, 0x0e, 0x3c, 0x2a, 0x52, 0xd8, 0xc5, 0x4b, 0xc9, 0xec, 0x6f, 0x23, 0xd8,
+	0x2d, 0x25, 0xcd, 0xca, 0xfa, 0x2d, 0x04, 0xbf, 0xd4, 0x8e, 0x3b, 0x7a, 0x12, 0xd9, 0xbe, 0x88,
+	0x34, 0xcd, 0x26, 0xe8, 0xed, 0xf7, 0xbc, 0x05, 0xf2, 0x3c, 0xf8, 0x4a, 0x42, 0xce, 0x73, 0x15,
+	0xf2, 0x04, 0x96, 0x8a, 0xab, 0x32, 0x2c, 0x46, 0xe1, 0x2a, 0x07, 0x19, 0x67, 0x52, 0xcc, 0x78,
+	0x0a, 0x41, 0x26, 0x85, 0x12, 0x84, 0xd4, 0xb6, 0xe0, 0x60, 0x0b, 0x8a, 0xd1, 0xa0, 0x3f, 0x17,
+	0x73, 0xa1, 0xe5, 0xb0, 0xba, 0x6a, 0xe7, 0xf0, 0xbd, 0x81, 0x7b, 0x93, 0x1c, 0xe4, 0x53, 0x9d,
+	0x27, 0x7d, 0xdc, 0xe2, 0xcb, 0x04, 0xd6, 0x14, 0x79, 0xc8, 0xef, 0x46, 0x35, 0x20, 0x17, 0xf8,
+	0x5f, 0xc2, 0xf3, 0x2c, 0x65, 0x65, 0xbc, 0x64, 0x0b, 0xa0, 0x0d, 0x2d, 0xf6, 0x0c, 0xf7, 0xc8,
+	0x16, 0x40, 0x4e, 0xb0, 0x3d, 0xe5, 0x82, 0xda, 0x5a, 0xa9, 0x4e, 0x72, 0x86, 0x31, 0x2b, 0x98,
+	0x62, 0x32, 0x5e, 0xc9, 0x94, 0x36, 0xb5, 0xd0, 0xad, 0x99, 0x89, 0x4c, 0xc9, 0x00, 0x3b, 0x05,
+	0x48, 0x3e, 0xe3, 0x90, 0xd0, 0x96, 0x87, 0x7c, 0x27, 0x3a, 0xe2, 0x2a, 0xfa, 0x22, 0x81, 0x29,
+	0x48, 0x62, 0xa6, 0x68, 0xdb, 0x43, 0xbe, 0x1d, 0x75, 0x0d, 0x73, 0xa7, 0x08, 0xc5, 0x1d, 0x0d,
+	0x84, 0xa4, 0x1d, 0xfd, 0xec, 0x01, 0x92, 0x7b, 0xec, 0x2c, 0x40, 0xb1, 0x84, 0x29, 0x46, 0x1d,
+	0xcf, 0xf6, 0x7b, 0xd7, 0x97, 0xc1, 0xdf, 0x2e, 0x02, 0xf3, 0xdb, 0x07, 0x63, 0x1d, 0x37, 0x37,
+	0x9f, 0xe7, 0x56, 0x74, 0x8c, 0x0e, 0x6f, 0xf1, 0xff, 0x5f, 0x96, 0xea, 0x7f, 0xaf, 0x50, 0x9a,
+	0x5a, 0xaa, 0xb3, 0xaa, 0xaa, 0x60, 0xe9, 0xea, 0xd0, 0x46, 0x0d, 0xc6, 0xa3, 0xcd, 0xce, 0x45,
+	0xdb, 0x9d, 0x8b, 0xbe, 0x76, 0x2e, 0x7a, 0xdb, 0xbb, 0xd6, 0x76, 0xef, 0x5a, 0x1f, 0x7b, 0xd7,
+	0x7a, 0x3e, 0x35, 0xdb, 0xad, 0x7f, 0xd6, 0x53, 0x65, 0x06, 0xf9, 0xb4, 0xad, 0xa7, 0xb8, 0xf9,
+	0x0e, 0x00, 0x00, 0xff, 0xff, 0x55, 0x48, 0x29, 0xcd, 0xdd, 0x01, 0x00, 0x00,
 }
 
 func (m *UserProfile) Marshal() (dAtA []byte, err error) {
@@ -164,6 +235,20 @@ func (m *UserProfile) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Metadata) > 0 {
+		for iNdEx := len(m.Metadata) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Metadata[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintUserProfile(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
 	if len(m.Creator) > 0 {
 		i -= len(m.Creator)
 		copy(dAtA[i:], m.Creator)
@@ -217,6 +302,43 @@ func (m *UserProfile) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ProfileMetadata) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProfileMetadata) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProfileMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintUserProfile(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintUserProfile(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintUserProfile(dAtA []byte, offset int, v uint64) int {
 	offset -= sovUserProfile(v)
 	base := offset
@@ -260,6 +382,29 @@ func (m *UserProfile) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovUserProfile(uint64(l))
 	}
+	if len(m.Metadata) > 0 {
+		for _, e := range m.Metadata {
+			l = e.Size()
+			n += 1 + l + sovUserProfile(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ProfileMetadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovUserProfile(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovUserProfile(uint64(l))
+	}
 	return n
 }
 
@@ -497,6 +642,154 @@ func (m *UserProfile) Unmarshal(dAtA []byte) error {
 			}
 			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUserProfile
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthUserProfile
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthUserProfile
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metadata = append(m.Metadata, ProfileMetadata{})
+			if err := m.Metadata[len(m.Metadata)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipUserProfile(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthUserProfile
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProfileMetadata) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowUserProfile
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProfileMetadata: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProfileMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUserProfile
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUserProfile
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUserProfile
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUserProfile
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUserProfile
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUserProfile
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipUserProfile(dAtA[iNdEx:])
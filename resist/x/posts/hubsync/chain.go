@@ -0,0 +1,133 @@
+package hubsync
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"cosmossdk.io/collections"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"resist/x/posts/keeper"
+	"resist/x/posts/types"
+	rewardstypes "resist/x/rewards/types"
+)
+
+// Chain is the view of the chain used by the daemon.
+type Chain interface {
+	HubSync(ctx context.Context, syncId string) (types.HubSync, error)
+	// NodeHubSyncs returns the syncs the node is the source or target of.
+	NodeHubSyncs(ctx context.Context, nodeId string) ([]types.HubSync, error)
+	ContentDistribution(ctx context.Context, contentId string) (types.ContentDistribution, error)
+	Node(ctx context.Context, nodeId string) (rewardstypes.Node, error)
+	// Broadcast signs msgs with the node owner key and broadcasts them.
+	Broadcast(ctx context.Context, msgs ...sdk.Msg) error
+	// Subscribe returns the ids of the syncs initiated towards the node as
+	// they are committed. It returns a nil channel if events are unavailable.
+	Subscribe(ctx context.Context, nodeId string) (<-chan string, error)
+}
+
+// EventsClient subscribes to CometBFT events.
+type EventsClient interface {
+	Subscribe(ctx context.Context, subscriber, query string, outCapacity ...int) (<-chan coretypes.ResultEvent, error)
+}
+
+// AppChain reads the committed state of the node's own application and
+// broadcasts through its CometBFT client.
+type AppChain struct {
+	queryContext  func() (sdk.Context, error)
+	postsKeeper   keeper.Keeper
+	rewardsKeeper types.RewardsKeeper
+	broadcaster   *Broadcaster
+	events        EventsClient
+}
+
+// NewAppChain returns a Chain backed by the application keepers. queryContext
+// returns a context on the latest committed state. events may be nil.
+func NewAppChain(
+	queryContext func() (sdk.Context, error),
+	postsKeeper keeper.Keeper,
+	rewardsKeeper types.RewardsKeeper,
+	broadcaster *Broadcaster,
+	events EventsClient,
+) *AppChain {
+	return &AppChain{
+		queryContext:  queryContext,
+		postsKeeper:   postsKeeper,
+		rewardsKeeper: rewardsKeeper,
+		broadcaster:   broadcaster,
+		events:        events,
+	}
+}
+
+func (c *AppChain) HubSync(_ context.Context, syncId string) (types.HubSync, error) {
+	ctx, err := c.queryContext()
+	if err != nil {
+		return types.HubSync{}, err
+	}
+	return c.postsKeeper.HubSync.Get(ctx, syncId)
+}
+
+func (c *AppChain) NodeHubSyncs(_ context.Context, nodeId string) ([]types.HubSync, error) {
+	ctx, err := c.queryContext()
+	if err != nil {
+		return nil, err
+	}
+	var syncs []types.HubSync
+	err = c.postsKeeper.HubSyncByNode.Walk(ctx, collections.NewPrefixedPairRange[string, string](nodeId), func(key collections.Pair[string, string]) (bool, error) {
+		hubSync, err := c.postsKeeper.HubSync.Get(ctx, key.K2())
+		if err != nil {
+			return true, err
+		}
+		syncs = append(syncs, hubSync)
+		return false, nil
+	})
+	return syncs, err
+}
+
+func (c *AppChain) ContentDistribution(_ context.Context, contentId string) (types.ContentDistribution, error) {
+	ctx, err := c.queryContext()
+	if err != nil {
+		return types.ContentDistribution{}, err
+	}
+	return c.postsKeeper.ContentDistribution.Get(ctx, contentId)
+}
+
+func (c *AppChain) Node(_ context.Context, nodeId string) (rewardstypes.Node, error) {
+	ctx, err := c.queryContext()
+	if err != nil {
+		return rewardstypes.Node{}, err
+	}
+	return c.rewardsKeeper.GetNode(ctx, nodeId)
+}
+
+func (c *AppChain) Broadcast(ctx context.Context, msgs ...sdk.Msg) error {
+	return c.broadcaster.Broadcast(ctx, msgs...)
+}
+
+func (c *AppChain) Subscribe(ctx context.Context, nodeId string) (<-chan string, error) {
+	if c.events == nil {
+		return nil, nil
+	}
+	query := fmt.Sprintf("tm.event='Tx' AND hub_sync_initiated.target_node='%s'", strings.ReplaceAll(nodeId, "'", ""))
+	events, err := c.events.Subscribe(ctx, "hub-sync", query)
+	if err != nil {
+		return nil, err
+	}
+
+	syncIds := make(chan string)
+	go func() {
+		defer close(syncIds)
+		for event := range events {
+			for _, syncId := range event.Events["hub_sync_initiated.sync_id"] {
+				select {
+				case syncIds <- syncId:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+	return syncIds, nil
+}
@@ -0,0 +1,134 @@
+package hubsync
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"slices"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+
+	"resist/x/posts/keeper"
+	"resist/x/posts/types"
+)
+
+// Signer authenticates the hub to the source hubs of its syncs.
+type Signer interface {
+	// Sign returns the secp256k1 public key of the node owner and its
+	// signature of msg.
+	Sign(msg []byte) (pubKey, signature []byte, err error)
+}
+
+type keyringSigner struct {
+	kr      keyring.Keyring
+	keyName string
+}
+
+// NewKeyringSigner returns a Signer signing with the keyName key of kr.
+func NewKeyringSigner(kr keyring.Keyring, keyName string) Signer {
+	return keyringSigner{kr: kr, keyName: keyName}
+}
+
+func (s keyringSigner) Sign(msg []byte) ([]byte, []byte, error) {
+	signature, pubKey, err := s.kr.Sign(s.keyName, msg, signing.SignMode_SIGN_MODE_DIRECT)
+	if err != nil {
+		return nil, nil, err
+	}
+	return pubKey.Bytes(), signature, nil
+}
+
+// fetch requests the content of a sync from the source hub at addr. Every
+// content received is checked against its distribution on chain, then added
+// and pinned into store. progress is called with the bytes received so far
+// after every content.
+func fetch(
+	ctx context.Context,
+	addr string,
+	timeout time.Duration,
+	signer Signer,
+	chain Chain,
+	store keeper.IPFSClient,
+	hubSync types.HubSync,
+	payload keeper.ContentSyncPayload,
+	progress func(bytes uint64),
+) (uint64, error) {
+	dialer := net.Dialer{Timeout: timeout}
+	conn, err := dialer.DialContext(ctx, "tcp", addr)
+	if err != nil {
+		return 0, err
+	}
+	defer conn.Close()
+	go func() {
+		<-ctx.Done()
+		_ = conn.SetDeadline(time.Now())
+	}()
+
+	_ = conn.SetDeadline(time.Now().Add(timeout))
+	hello, err := expectFrame(conn, frameHello)
+	if err != nil {
+		return 0, err
+	}
+	pubKey, signature, err := signer.Sign(authBytes(hello.Nonce, hubSync.SyncId))
+	if err != nil {
+		return 0, err
+	}
+	if err := writeFrame(conn, frame{
+		Type:      frameRequest,
+		SyncId:    hubSync.SyncId,
+		PubKey:    pubKey,
+		Signature: signature,
+		Payload:   &payload,
+	}); err != nil {
+		return 0, err
+	}
+
+	var transferred uint64
+	for {
+		_ = conn.SetDeadline(time.Now().Add(timeout))
+		f, err := readFrame(conn)
+		if err != nil {
+			return transferred, err
+		}
+		switch f.Type {
+		case frameDone:
+			return transferred, nil
+		case frameContent:
+		default:
+			return transferred, fmt.Errorf("unexpected %q frame", f.Type)
+		}
+
+		if !slices.Contains(hubSync.ContentIds, f.ContentId) {
+			return transferred, fmt.Errorf("content %s is not part of sync %s", f.ContentId, hubSync.SyncId)
+		}
+		distribution, err := chain.ContentDistribution(ctx, f.ContentId)
+		if err != nil {
+			return transferred, fmt.Errorf("content %s: %w", f.ContentId, err)
+		}
+		if f.Cid != distribution.IpfsHash || f.Size != distribution.GetReplication().GetTotalSizeBytes() {
+			return transferred, fmt.Errorf("content %s does not match its distribution", f.ContentId)
+		}
+		data := make([]byte, f.Size)
+		if _, err := io.ReadFull(conn, data); err != nil {
+			return transferred, err
+		}
+		cid, err := types.ComputeContentCID(data)
+		if err != nil {
+			return transferred, err
+		}
+		if cid != distribution.IpfsHash {
+			return transferred, fmt.Errorf("content %s has CID %s, expected %s", f.ContentId, cid, distribution.IpfsHash)
+		}
+		if _, err := store.Add(data); err != nil {
+			return transferred, err
+		}
+		if err := store.Pin(cid); err != nil {
+			return transferred, err
+		}
+
+		transferred += f.Size
+		progress(transferred)
+	}
+}
@@ -0,0 +1,168 @@
+package hubsync
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/spf13/cast"
+)
+
+// Config defines the [hub-sync] section of app.toml.
+type Config struct {
+	// Enabled starts the hub sync daemon along with the node.
+	Enabled bool `mapstructure:"enabled"`
+	// NodeID is the x/rewards node id of this hub.
+	NodeID string `mapstructure:"node-id"`
+	// KeyName is the keyring key of the node owner, used to authenticate to
+	// other hubs and to sign sync progress transactions.
+	KeyName string `mapstructure:"key-name"`
+	// KeyringBackend is the backend of the keyring holding KeyName.
+	KeyringBackend string `mapstructure:"keyring-backend"`
+	// ListenAddress is the TCP address other hubs fetch content from.
+	ListenAddress string `mapstructure:"listen-address"`
+	// Peers maps the node ids of other hubs to their sync address, formatted
+	// as a comma separated list of node-id@host:port.
+	Peers string `mapstructure:"peers"`
+	// PollInterval is the interval at which pending syncs are looked up.
+	PollInterval time.Duration `mapstructure:"poll-interval"`
+	// ProgressInterval is the minimum interval between two progress reports.
+	ProgressInterval time.Duration `mapstructure:"progress-interval"`
+	// Timeout bounds every read and write on a sync connection.
+	Timeout time.Duration `mapstructure:"timeout"`
+	// GasLimit is the gas limit of sync progress transactions.
+	GasLimit uint64 `mapstructure:"gas-limit"`
+	// Fees are the fees paid by sync progress transactions.
+	Fees string `mapstructure:"fees"`
+}
+
+// DefaultConfig returns the default hub sync configuration, with the daemon disabled.
+func DefaultConfig() Config {
+	return Config{
+		Enabled:          false,
+		KeyringBackend:   "os",
+		ListenAddress:    "0.0.0.0:26680",
+		PollInterval:     30 * time.Second,
+		ProgressInterval: 10 * time.Second,
+		Timeout:          time.Minute,
+		GasLimit:         200000,
+	}
+}
+
+// DefaultConfigTemplate is the app.toml template of the [hub-sync] section. It
+// is meant to be appended to the server configuration template.
+const DefaultConfigTemplate = `
+###############################################################################
+###                         Hub Sync Configuration                          ###
+###############################################################################
+
+[hub-sync]
+
+# Enabled starts the daemon transferring content between hubs. It requires an
+# [ipfs] backend to serve and store content.
+enabled = {{ .HubSync.Enabled }}
+
+# x/rewards node id of this hub.
+node-id = "{{ .HubSync.NodeID }}"
+
+# Keyring key of the node owner, used to authenticate to other hubs and to sign
+# sync progress transactions.
+key-name = "{{ .HubSync.KeyName }}"
+
+# Backend of the keyring holding key-name, in the node home directory.
+keyring-backend = "{{ .HubSync.KeyringBackend }}"
+
+# TCP address other hubs fetch content from.
+listen-address = "{{ .HubSync.ListenAddress }}"
+
+# Sync addresses of other hubs, as a comma separated list of node-id@host:port.
+peers = "{{ .HubSync.Peers }}"
+
+# Interval at which pending syncs are looked up.
+poll-interval = "{{ .HubSync.PollInterval }}"
+
+# Minimum interval between two on-chain progress reports of a sync.
+progress-interval = "{{ .HubSync.ProgressInterval }}"
+
+# Timeout of every read and write on a sync connection.
+timeout = "{{ .HubSync.Timeout }}"
+
+# Gas limit and fees of sync progress transactions.
+gas-limit = {{ .HubSync.GasLimit }}
+fees = "{{ .HubSync.Fees }}"
+`
+
+// ReadConfig reads the [hub-sync] section from the application options.
+func ReadConfig(appOpts servertypes.AppOptions) Config {
+	cfg := DefaultConfig()
+	if v := appOpts.Get("hub-sync.enabled"); v != nil {
+		cfg.Enabled = cast.ToBool(v)
+	}
+	if v := appOpts.Get("hub-sync.node-id"); v != nil {
+		cfg.NodeID = cast.ToString(v)
+	}
+	if v := appOpts.Get("hub-sync.key-name"); v != nil {
+		cfg.KeyName = cast.ToString(v)
+	}
+	if v := appOpts.Get("hub-sync.keyring-backend"); v != nil {
+		cfg.KeyringBackend = cast.ToString(v)
+	}
+	if v := appOpts.Get("hub-sync.listen-address"); v != nil {
+		cfg.ListenAddress = cast.ToString(v)
+	}
+	if v := appOpts.Get("hub-sync.peers"); v != nil {
+		cfg.Peers = cast.ToString(v)
+	}
+	if v := appOpts.Get("hub-sync.poll-interval"); v != nil {
+		cfg.PollInterval = cast.ToDuration(v)
+	}
+	if v := appOpts.Get("hub-sync.progress-interval"); v != nil {
+		cfg.ProgressInterval = cast.ToDuration(v)
+	}
+	if v := appOpts.Get("hub-sync.timeout"); v != nil {
+		cfg.Timeout = cast.ToDuration(v)
+	}
+	if v := appOpts.Get("hub-sync.gas-limit"); v != nil {
+		cfg.GasLimit = cast.ToUint64(v)
+	}
+	if v := appOpts.Get("hub-sync.fees"); v != nil {
+		cfg.Fees = cast.ToString(v)
+	}
+	return cfg
+}
+
+// Validate checks that an enabled configuration is complete.
+func (cfg Config) Validate() error {
+	if !cfg.Enabled {
+		return nil
+	}
+	if cfg.NodeID == "" {
+		return fmt.Errorf("hub-sync.node-id is required")
+	}
+	if cfg.KeyName == "" {
+		return fmt.Errorf("hub-sync.key-name is required")
+	}
+	if cfg.PollInterval <= 0 || cfg.Timeout <= 0 {
+		return fmt.Errorf("hub-sync intervals must be positive")
+	}
+	_, err := cfg.PeerAddresses()
+	return err
+}
+
+// PeerAddresses parses Peers into a map of node id to address.
+func (cfg Config) PeerAddresses() (map[string]string, error) {
+	peers := make(map[string]string)
+	for _, peer := range strings.Split(cfg.Peers, ",") {
+		peer = strings.TrimSpace(peer)
+		if peer == "" {
+			continue
+		}
+		nodeId, addr, ok := strings.Cut(peer, "@")
+		if !ok || nodeId == "" || addr == "" {
+			return nil, fmt.Errorf("invalid hub-sync peer %q, expected node-id@host:port", peer)
+		}
+		peers[nodeId] = addr
+	}
+	return peers, nil
+}
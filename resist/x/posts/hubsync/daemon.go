@@ -0,0 +1,255 @@
+package hubsync
+
+import (
+	"context"
+	"errors"
+	"net"
+	"sync"
+	"time"
+
+	"cosmossdk.io/core/address"
+	"cosmossdk.io/log"
+
+	"resist/x/posts/keeper"
+	"resist/x/posts/types"
+)
+
+// Daemon serves the content of this hub to other hubs and fetches the content
+// of the syncs targeting it, reporting their progress on chain.
+type Daemon struct {
+	cfg    Config
+	owner  string
+	peers  map[string]string
+	chain  Chain
+	store  keeper.IPFSClient
+	signer Signer
+	server *Server
+	logger log.Logger
+
+	mu sync.Mutex
+	// inFlight holds the syncs being fetched, or whose completion is not
+	// committed yet.
+	inFlight map[string]bool
+}
+
+// NewDaemon returns a Daemon for the hub cfg.NodeID, owned by owner.
+func NewDaemon(cfg Config, owner string, chain Chain, store keeper.IPFSClient, signer Signer, addressCodec address.Codec, logger log.Logger) (*Daemon, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	peers, err := cfg.PeerAddresses()
+	if err != nil {
+		return nil, err
+	}
+	logger = logger.With("module", "hub-sync")
+
+	return &Daemon{
+		cfg:      cfg,
+		owner:    owner,
+		peers:    peers,
+		chain:    chain,
+		store:    store,
+		signer:   signer,
+		server:   NewServer(cfg.NodeID, chain, store, addressCodec, cfg.Timeout, logger),
+		logger:   logger,
+		inFlight: make(map[string]bool),
+	}, nil
+}
+
+// Run serves content on ln and fetches the syncs targeting the hub until ctx
+// is done.
+func (d *Daemon) Run(ctx context.Context, ln net.Listener) error {
+	serveErr := make(chan error, 1)
+	go func() {
+		serveErr <- d.server.Serve(ctx, ln)
+	}()
+
+	initiated, err := d.chain.Subscribe(ctx, d.cfg.NodeID)
+	if err != nil {
+		d.logger.Error("failed to subscribe to hub sync events", "err", err)
+	}
+
+	ticker := time.NewTicker(d.cfg.PollInterval)
+	defer ticker.Stop()
+
+	d.poll(ctx)
+	for {
+		select {
+		case <-ctx.Done():
+			return <-serveErr
+		case err := <-serveErr:
+			return err
+		case <-ticker.C:
+			d.poll(ctx)
+		case syncId, ok := <-initiated:
+			if !ok {
+				initiated = nil
+				continue
+			}
+			hubSync, err := d.chain.HubSync(ctx, syncId)
+			if err != nil {
+				d.logger.Error("failed to get hub sync", "sync_id", syncId, "err", err)
+				continue
+			}
+			d.start(ctx, hubSync)
+		}
+	}
+}
+
+// poll starts the open syncs targeting the hub.
+func (d *Daemon) poll(ctx context.Context) {
+	syncs, err := d.chain.NodeHubSyncs(ctx, d.cfg.NodeID)
+	if err != nil {
+		d.logger.Error("failed to list hub syncs", "err", err)
+		return
+	}
+	for _, hubSync := range syncs {
+		if hubSync.TargetNode != d.cfg.NodeID {
+			continue
+		}
+		if !isOpen(hubSync) {
+			d.mu.Lock()
+			delete(d.inFlight, hubSync.SyncId)
+			d.mu.Unlock()
+			continue
+		}
+		d.start(ctx, hubSync)
+	}
+}
+
+func (d *Daemon) start(ctx context.Context, hubSync types.HubSync) {
+	if hubSync.TargetNode != d.cfg.NodeID || !isOpen(hubSync) {
+		return
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.inFlight[hubSync.SyncId] {
+		return
+	}
+	d.inFlight[hubSync.SyncId] = true
+
+	go func() {
+		if !d.sync(ctx, hubSync) {
+			d.mu.Lock()
+			delete(d.inFlight, hubSync.SyncId)
+			d.mu.Unlock()
+		}
+	}()
+}
+
+// sync fetches the content of hubSync and reports its completion. It returns
+// false if the sync should be retried.
+func (d *Daemon) sync(ctx context.Context, hubSync types.HubSync) bool {
+	logger := d.logger.With("sync_id", hubSync.SyncId, "source_node", hubSync.SourceNode)
+
+	addr, ok := d.peers[hubSync.SourceNode]
+	if !ok {
+		logger.Info("no address configured for the source hub")
+		return d.complete(ctx, hubSync, 0, errors.New("source hub unreachable"))
+	}
+
+	payload, err := d.payload(ctx, hubSync)
+	if err != nil {
+		logger.Error("failed to build sync payload", "err", err)
+		return false
+	}
+
+	logger.Info("fetching hub sync content", "method", hubSync.SyncMethod, "contents", len(hubSync.ContentIds))
+	var lastReport time.Time
+	transferred, err := fetch(ctx, addr, d.cfg.Timeout, d.signer, d.chain, d.store, hubSync, payload, func(bytes uint64) {
+		if time.Since(lastReport) < d.cfg.ProgressInterval {
+			return
+		}
+		lastReport = time.Now()
+		if err := d.chain.Broadcast(ctx, &types.MsgReportSyncProgress{
+			Creator:          d.owner,
+			SyncId:           hubSync.SyncId,
+			BytesTransferred: max(bytes, hubSync.BytesTransferred),
+		}); err != nil {
+			logger.Error("failed to report sync progress", "err", err)
+		}
+	})
+	if ctx.Err() != nil {
+		return false
+	}
+	var opErr *net.OpError
+	if errors.As(err, &opErr) && opErr.Op == "dial" {
+		// The source hub may come back, retry at the next poll
+		logger.Error("failed to connect to the source hub", "addr", addr, "err", err)
+		return false
+	}
+	return d.complete(ctx, hubSync, transferred, err)
+}
+
+// complete reports the outcome of a sync on chain.
+func (d *Daemon) complete(ctx context.Context, hubSync types.HubSync, transferred uint64, syncErr error) bool {
+	msg := &types.MsgCompleteSync{
+		Creator:          d.owner,
+		SyncId:           hubSync.SyncId,
+		Success:          syncErr == nil,
+		BytesTransferred: max(transferred, hubSync.BytesTransferred),
+	}
+	if syncErr != nil {
+		msg.FailureReason = syncErr.Error()
+	}
+	if err := d.chain.Broadcast(ctx, msg); err != nil {
+		d.logger.Error("failed to complete hub sync", "sync_id", hubSync.SyncId, "err", err)
+		return false
+	}
+	d.logger.Info("hub sync completed", "sync_id", hubSync.SyncId, "success", msg.Success, "bytes", msg.BytesTransferred, "reason", msg.FailureReason)
+	return true
+}
+
+// payload returns the request payload of hubSync, describing the content the
+// hub already holds for the incremental and selective methods.
+func (d *Daemon) payload(ctx context.Context, hubSync types.HubSync) (keeper.ContentSyncPayload, error) {
+	payload := keeper.ContentSyncPayload{
+		ContentIds: hubSync.ContentIds,
+		SyncMethod: hubSync.SyncMethod,
+	}
+
+	switch hubSync.SyncMethod {
+	case "incremental":
+		payload.ChecksumMap = make(map[string]string)
+		for _, contentId := range hubSync.ContentIds {
+			if cid, ok := d.holds(ctx, contentId); ok {
+				payload.ChecksumMap[contentId] = cid
+			}
+		}
+
+		syncs, err := d.chain.NodeHubSyncs(ctx, d.cfg.NodeID)
+		if err != nil {
+			return payload, err
+		}
+		for _, previous := range syncs {
+			if previous.SourceNode == hubSync.SourceNode && previous.TargetNode == d.cfg.NodeID &&
+				previous.Status == types.HubSyncStatusCompleted && previous.CompletedAt > payload.LastSync {
+				payload.LastSync = previous.CompletedAt
+			}
+		}
+	case "selective":
+		payload.ContentIds = nil
+		for _, contentId := range hubSync.ContentIds {
+			if _, ok := d.holds(ctx, contentId); !ok {
+				payload.ContentIds = append(payload.ContentIds, contentId)
+			}
+		}
+	}
+	return payload, nil
+}
+
+// holds returns the CID of the content if the hub stores it.
+func (d *Daemon) holds(ctx context.Context, contentId string) (string, bool) {
+	distribution, err := d.chain.ContentDistribution(ctx, contentId)
+	if err != nil {
+		return "", false
+	}
+	if _, err := d.store.Get(distribution.IpfsHash); err != nil {
+		return "", false
+	}
+	return distribution.IpfsHash, true
+}
+
+func isOpen(hubSync types.HubSync) bool {
+	return hubSync.Status == types.HubSyncStatusPending || hubSync.Status == types.HubSyncStatusSyncing
+}
@@ -0,0 +1,281 @@
+package hubsync
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"cosmossdk.io/log"
+	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"resist/x/posts/ipfs"
+	"resist/x/posts/types"
+	rewardstypes "resist/x/rewards/types"
+)
+
+type fakeChain struct {
+	mu            sync.Mutex
+	syncs         map[string]types.HubSync
+	distributions map[string]types.ContentDistribution
+	nodes         map[string]rewardstypes.Node
+	msgs          []sdk.Msg
+}
+
+func (c *fakeChain) HubSync(_ context.Context, syncId string) (types.HubSync, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	hubSync, ok := c.syncs[syncId]
+	if !ok {
+		return types.HubSync{}, errors.New("not found")
+	}
+	return hubSync, nil
+}
+
+func (c *fakeChain) NodeHubSyncs(_ context.Context, nodeId string) ([]types.HubSync, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	var syncs []types.HubSync
+	for _, hubSync := range c.syncs {
+		if hubSync.SourceNode == nodeId || hubSync.TargetNode == nodeId {
+			syncs = append(syncs, hubSync)
+		}
+	}
+	return syncs, nil
+}
+
+func (c *fakeChain) ContentDistribution(_ context.Context, contentId string) (types.ContentDistribution, error) {
+	distribution, ok := c.distributions[contentId]
+	if !ok {
+		return types.ContentDistribution{}, errors.New("not found")
+	}
+	return distribution, nil
+}
+
+func (c *fakeChain) Node(_ context.Context, nodeId string) (rewardstypes.Node, error) {
+	node, ok := c.nodes[nodeId]
+	if !ok {
+		return rewardstypes.Node{}, errors.New("not found")
+	}
+	return node, nil
+}
+
+func (c *fakeChain) Broadcast(_ context.Context, msgs ...sdk.Msg) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.msgs = append(c.msgs, msgs...)
+	return nil
+}
+
+func (c *fakeChain) Subscribe(context.Context, string) (<-chan string, error) {
+	return nil, nil
+}
+
+func (c *fakeChain) completion(t *testing.T) *types.MsgCompleteSync {
+	t.Helper()
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	require.NotEmpty(t, c.msgs)
+	msg, ok := c.msgs[len(c.msgs)-1].(*types.MsgCompleteSync)
+	require.True(t, ok)
+	return msg
+}
+
+type keySigner struct {
+	key *secp256k1.PrivKey
+}
+
+func (s keySigner) Sign(msg []byte) ([]byte, []byte, error) {
+	signature, err := s.key.Sign(msg)
+	return s.key.PubKey().Bytes(), signature, err
+}
+
+type fixture struct {
+	chain  *fakeChain
+	source *ipfs.Blockstore
+	target *ipfs.Blockstore
+	daemon *Daemon
+	cids   map[string]string
+}
+
+func setup(t *testing.T, signerKey *secp256k1.PrivKey) fixture {
+	t.Helper()
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	addressCodec := addresscodec.NewBech32Codec("cosmos")
+	ownerKey := secp256k1.GenPrivKey()
+	owner, err := addressCodec.BytesToString(ownerKey.PubKey().Address())
+	require.NoError(t, err)
+	if signerKey == nil {
+		signerKey = ownerKey
+	}
+
+	source, err := ipfs.NewBlockstore(t.TempDir())
+	require.NoError(t, err)
+	target, err := ipfs.NewBlockstore(t.TempDir())
+	require.NoError(t, err)
+
+	chain := &fakeChain{
+		syncs:         make(map[string]types.HubSync),
+		distributions: make(map[string]types.ContentDistribution),
+		nodes: map[string]rewardstypes.Node{
+			"hub-a": {NodeId: "hub-a", Owner: "cosmos1source"},
+			"hub-b": {NodeId: "hub-b", Owner: owner},
+		},
+	}
+	cids := make(map[string]string)
+	for i, data := range [][]byte{
+		[]byte("first post"),
+		[]byte("second post"),
+		bytes.Repeat([]byte{0xcd}, 2*types.DefaultChunkSize+5),
+	} {
+		contentId := []string{"post-1", "post-2", "post-3"}[i]
+		cid, err := source.Add(data)
+		require.NoError(t, err)
+		cids[contentId] = cid
+		chain.distributions[contentId] = types.ContentDistribution{
+			ContentId:   contentId,
+			IpfsHash:    cid,
+			Replication: &types.ContentReplication{TotalSizeBytes: uint64(len(data))},
+			LastSync:    int64(100 * (i + 1)),
+		}
+	}
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	server := NewServer("hub-a", chain, source, addressCodec, time.Second, log.NewNopLogger())
+	go server.Serve(ctx, ln)
+
+	cfg := DefaultConfig()
+	cfg.Enabled = true
+	cfg.NodeID = "hub-b"
+	cfg.KeyName = "owner"
+	cfg.Peers = "hub-a@" + ln.Addr().String()
+	cfg.Timeout = time.Second
+	daemon, err := NewDaemon(cfg, owner, chain, target, keySigner{key: signerKey}, addressCodec, log.NewNopLogger())
+	require.NoError(t, err)
+
+	return fixture{chain: chain, source: source, target: target, daemon: daemon, cids: cids}
+}
+
+func (f fixture) initiate(method string, bytesTotal uint64) types.HubSync {
+	hubSync := types.HubSync{
+		SyncId:     "sync_0",
+		SourceNode: "hub-a",
+		TargetNode: "hub-b",
+		ContentIds: []string{"post-1", "post-2", "post-3"},
+		SyncMethod: method,
+		Status:     types.HubSyncStatusPending,
+		TotalBytes: bytesTotal,
+	}
+	f.chain.syncs[hubSync.SyncId] = hubSync
+	return hubSync
+}
+
+func TestDaemonSync(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		// held are the contents the target already holds
+		held []string
+		// previous is the completion time of the last sync from the source
+		previous int64
+		method   string
+		fetched  []string
+	}{
+		{
+			name:    "full",
+			held:    []string{"post-1"},
+			method:  "full",
+			fetched: []string{"post-1", "post-2", "post-3"},
+		},
+		{
+			name:    "selective",
+			held:    []string{"post-1"},
+			method:  "selective",
+			fetched: []string{"post-2", "post-3"},
+		},
+		{
+			name:     "incremental",
+			held:     []string{"post-3"},
+			previous: 100,
+			method:   "incremental",
+			fetched:  []string{"post-2"},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			f := setup(t, nil)
+			for _, contentId := range tc.held {
+				data, err := f.source.Get(f.cids[contentId])
+				require.NoError(t, err)
+				_, err = f.target.Add(data)
+				require.NoError(t, err)
+			}
+			if tc.previous > 0 {
+				f.chain.syncs["sync_prev"] = types.HubSync{
+					SyncId:      "sync_prev",
+					SourceNode:  "hub-a",
+					TargetNode:  "hub-b",
+					Status:      types.HubSyncStatusCompleted,
+					CompletedAt: tc.previous,
+				}
+			}
+			hubSync := f.initiate(tc.method, 1<<30)
+
+			require.True(t, f.daemon.sync(context.Background(), hubSync))
+
+			var expectedBytes uint64
+			for _, contentId := range tc.fetched {
+				expectedBytes += f.chain.distributions[contentId].Replication.TotalSizeBytes
+				require.Equal(t, uint64(1), f.target.PinCount(f.cids[contentId]), contentId)
+			}
+			msg := f.chain.completion(t)
+			require.True(t, msg.Success, msg.FailureReason)
+			require.Equal(t, hubSync.SyncId, msg.SyncId)
+			require.Equal(t, expectedBytes, msg.BytesTransferred)
+
+			// Contents neither held nor fetched are still missing
+			for contentId, cid := range f.cids {
+				if !slices.Contains(tc.held, contentId) && !slices.Contains(tc.fetched, contentId) {
+					require.False(t, f.target.Has(cid), contentId)
+				}
+			}
+		})
+	}
+}
+
+func TestDaemonSyncUnauthorized(t *testing.T) {
+	f := setup(t, secp256k1.GenPrivKey())
+	hubSync := f.initiate("full", 1<<30)
+
+	require.True(t, f.daemon.sync(context.Background(), hubSync))
+
+	msg := f.chain.completion(t)
+	require.False(t, msg.Success)
+	require.Contains(t, msg.FailureReason, "does not own node hub-b")
+	require.Zero(t, msg.BytesTransferred)
+	for _, cid := range f.cids {
+		require.Zero(t, f.target.PinCount(cid))
+	}
+}
+
+func TestDaemonSyncClosed(t *testing.T) {
+	f := setup(t, nil)
+	hubSync := f.initiate("full", 1<<30)
+	hubSync.Status = types.HubSyncStatusCompleted
+	f.chain.syncs[hubSync.SyncId] = hubSync
+	hubSync.Status = types.HubSyncStatusPending
+
+	require.True(t, f.daemon.sync(context.Background(), hubSync))
+
+	msg := f.chain.completion(t)
+	require.False(t, msg.Success)
+	require.Contains(t, msg.FailureReason, "sync sync_0 is completed")
+}
@@ -0,0 +1,116 @@
+package hubsync
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"resist/x/posts/keeper"
+)
+
+// The sync protocol runs over a plain TCP connection opened by the target hub:
+//
+//	source -> target  hello   {nonce}
+//	target -> source  request {sync_id, pub_key, signature, payload}
+//	source -> target  content {content_id, cid, size} followed by size raw bytes, repeated
+//	source -> target  done
+//
+// The request signature over the nonce and sync id proves that the target hub
+// is operated by the owner of the sync's target node. Content integrity does
+// not rely on the source: the target checks every CID against the chain.
+// Either side may send an error frame and close the connection.
+
+const (
+	frameHello   = "hello"
+	frameRequest = "request"
+	frameContent = "content"
+	frameDone    = "done"
+	frameError   = "error"
+
+	// maxFrameSize bounds the size of control frames; content bytes are not framed.
+	maxFrameSize = 1 << 20
+
+	authDomain = "resist-hub-sync/v1"
+	nonceSize  = 32
+)
+
+// frame is a control message of the sync protocol.
+type frame struct {
+	Type string `json:"type"`
+
+	// hello
+	Nonce []byte `json:"nonce,omitempty"`
+
+	// request
+	SyncId    string                     `json:"sync_id,omitempty"`
+	PubKey    []byte                     `json:"pub_key,omitempty"`
+	Signature []byte                     `json:"signature,omitempty"`
+	Payload   *keeper.ContentSyncPayload `json:"payload,omitempty"`
+
+	// content
+	ContentId string `json:"content_id,omitempty"`
+	Cid       string `json:"cid,omitempty"`
+	Size      uint64 `json:"size,omitempty"`
+
+	// error
+	Error string `json:"error,omitempty"`
+}
+
+// authBytes returns the bytes signed by the target hub to authenticate a request.
+func authBytes(nonce []byte, syncId string) []byte {
+	h := sha256.New()
+	h.Write([]byte(authDomain))
+	h.Write(nonce)
+	h.Write([]byte(syncId))
+	return h.Sum(nil)
+}
+
+func writeFrame(w io.Writer, f frame) error {
+	bz, err := json.Marshal(f)
+	if err != nil {
+		return err
+	}
+	if len(bz) > maxFrameSize {
+		return fmt.Errorf("frame of %d bytes exceeds the maximum frame size", len(bz))
+	}
+	buf := binary.BigEndian.AppendUint32(make([]byte, 0, 4+len(bz)), uint32(len(bz)))
+	_, err = w.Write(append(buf, bz...))
+	return err
+}
+
+func readFrame(r io.Reader) (frame, error) {
+	var header [4]byte
+	if _, err := io.ReadFull(r, header[:]); err != nil {
+		return frame{}, err
+	}
+	size := binary.BigEndian.Uint32(header[:])
+	if size > maxFrameSize {
+		return frame{}, fmt.Errorf("frame of %d bytes exceeds the maximum frame size", size)
+	}
+	bz := make([]byte, size)
+	if _, err := io.ReadFull(r, bz); err != nil {
+		return frame{}, err
+	}
+	var f frame
+	if err := json.Unmarshal(bz, &f); err != nil {
+		return frame{}, fmt.Errorf("invalid frame: %w", err)
+	}
+	if f.Type == frameError {
+		return frame{}, fmt.Errorf("peer error: %s", f.Error)
+	}
+	return f, nil
+}
+
+// expectFrame reads a frame of the given type.
+func expectFrame(r io.Reader, frameType string) (frame, error) {
+	f, err := readFrame(r)
+	if err != nil {
+		return frame{}, err
+	}
+	if f.Type != frameType {
+		return frame{}, fmt.Errorf("unexpected %q frame, expected %q", f.Type, frameType)
+	}
+	return f, nil
+}
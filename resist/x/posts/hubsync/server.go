@@ -0,0 +1,180 @@
+package hubsync
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"net"
+	"slices"
+	"time"
+
+	"cosmossdk.io/core/address"
+	"cosmossdk.io/log"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+
+	"resist/x/posts/keeper"
+	"resist/x/posts/types"
+)
+
+// Server serves content to the target hubs of the syncs this hub is the source of.
+type Server struct {
+	nodeID       string
+	chain        Chain
+	store        keeper.IPFSClient
+	addressCodec address.Codec
+	timeout      time.Duration
+	logger       log.Logger
+}
+
+// NewServer returns a Server for the hub nodeID, serving content from store.
+func NewServer(nodeID string, chain Chain, store keeper.IPFSClient, addressCodec address.Codec, timeout time.Duration, logger log.Logger) *Server {
+	return &Server{
+		nodeID:       nodeID,
+		chain:        chain,
+		store:        store,
+		addressCodec: addressCodec,
+		timeout:      timeout,
+		logger:       logger,
+	}
+}
+
+// Serve accepts connections on ln until ctx is done.
+func (s *Server) Serve(ctx context.Context, ln net.Listener) error {
+	go func() {
+		<-ctx.Done()
+		ln.Close()
+	}()
+
+	for {
+		conn, err := ln.Accept()
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return err
+		}
+		go func() {
+			defer conn.Close()
+			if err := s.handle(ctx, conn); err != nil {
+				s.logger.Error("hub sync request failed", "remote", conn.RemoteAddr().String(), "err", err)
+				_ = conn.SetWriteDeadline(time.Now().Add(s.timeout))
+				_ = writeFrame(conn, frame{Type: frameError, Error: err.Error()})
+			}
+		}()
+	}
+}
+
+func (s *Server) handle(ctx context.Context, conn net.Conn) error {
+	nonce := make([]byte, nonceSize)
+	if _, err := rand.Read(nonce); err != nil {
+		return err
+	}
+	_ = conn.SetDeadline(time.Now().Add(s.timeout))
+	if err := writeFrame(conn, frame{Type: frameHello, Nonce: nonce}); err != nil {
+		return err
+	}
+	req, err := expectFrame(conn, frameRequest)
+	if err != nil {
+		return err
+	}
+
+	hubSync, err := s.authorize(ctx, nonce, req)
+	if err != nil {
+		return err
+	}
+	distributions, err := selectContent(ctx, s.chain, hubSync, req.Payload)
+	if err != nil {
+		return err
+	}
+
+	for _, distribution := range distributions {
+		data, err := s.store.Get(distribution.IpfsHash)
+		if err != nil {
+			return fmt.Errorf("content %s unavailable: %w", distribution.ContentId, err)
+		}
+		_ = conn.SetDeadline(time.Now().Add(s.timeout))
+		if err := writeFrame(conn, frame{Type: frameContent, ContentId: distribution.ContentId, Cid: distribution.IpfsHash, Size: uint64(len(data))}); err != nil {
+			return err
+		}
+		if _, err := conn.Write(data); err != nil {
+			return err
+		}
+	}
+	return writeFrame(conn, frame{Type: frameDone})
+}
+
+// authorize checks that the request is signed by the owner of the target node
+// of an open sync this hub is the source of.
+func (s *Server) authorize(ctx context.Context, nonce []byte, req frame) (types.HubSync, error) {
+	hubSync, err := s.chain.HubSync(ctx, req.SyncId)
+	if err != nil {
+		return types.HubSync{}, fmt.Errorf("unknown sync %s", req.SyncId)
+	}
+	if hubSync.SourceNode != s.nodeID {
+		return types.HubSync{}, fmt.Errorf("sync %s is not served by %s", hubSync.SyncId, s.nodeID)
+	}
+	if hubSync.Status != types.HubSyncStatusPending && hubSync.Status != types.HubSyncStatusSyncing {
+		return types.HubSync{}, fmt.Errorf("sync %s is %s", hubSync.SyncId, hubSync.Status)
+	}
+
+	if len(req.PubKey) != secp256k1.PubKeySize {
+		return types.HubSync{}, errors.New("invalid public key")
+	}
+	pubKey := &secp256k1.PubKey{Key: req.PubKey}
+	if !pubKey.VerifySignature(authBytes(nonce, req.SyncId), req.Signature) {
+		return types.HubSync{}, errors.New("invalid signature")
+	}
+	target, err := s.chain.Node(ctx, hubSync.TargetNode)
+	if err != nil {
+		return types.HubSync{}, err
+	}
+	signer, err := s.addressCodec.BytesToString(pubKey.Address())
+	if err != nil {
+		return types.HubSync{}, err
+	}
+	if signer != target.Owner {
+		return types.HubSync{}, fmt.Errorf("%s does not own node %s", signer, hubSync.TargetNode)
+	}
+	return hubSync, nil
+}
+
+// selectContent returns the distributions of the content to transfer for the
+// sync method of the sync:
+//   - "full" transfers every content of the sync;
+//   - "selective" transfers the content the target asks for;
+//   - "incremental" transfers the content updated since the target's last
+//     sync, skipping the content whose CID the target already holds.
+func selectContent(ctx context.Context, chain Chain, hubSync types.HubSync, payload *keeper.ContentSyncPayload) ([]types.ContentDistribution, error) {
+	if payload == nil {
+		payload = &keeper.ContentSyncPayload{}
+	}
+	if payload.SyncMethod != hubSync.SyncMethod {
+		return nil, fmt.Errorf("sync %s uses the %q method", hubSync.SyncId, hubSync.SyncMethod)
+	}
+
+	contentIds := hubSync.ContentIds
+	if hubSync.SyncMethod == "selective" {
+		for _, contentId := range payload.ContentIds {
+			if !slices.Contains(hubSync.ContentIds, contentId) {
+				return nil, fmt.Errorf("content %s is not part of sync %s", contentId, hubSync.SyncId)
+			}
+		}
+		contentIds = payload.ContentIds
+	}
+
+	distributions := make([]types.ContentDistribution, 0, len(contentIds))
+	for _, contentId := range contentIds {
+		distribution, err := chain.ContentDistribution(ctx, contentId)
+		if err != nil {
+			return nil, fmt.Errorf("content %s: %w", contentId, err)
+		}
+		if hubSync.SyncMethod == "incremental" {
+			if payload.ChecksumMap[contentId] == distribution.IpfsHash || distribution.LastSync <= payload.LastSync {
+				continue
+			}
+		}
+		distributions = append(distributions, distribution)
+	}
+	return distributions, nil
+}
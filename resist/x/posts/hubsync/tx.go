@@ -0,0 +1,108 @@
+package hubsync
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// Broadcaster signs transactions with a keyring key and broadcasts them in
+// sync mode. It tracks the account sequence locally so that several
+// transactions can be sent within a block.
+type Broadcaster struct {
+	clientCtx client.Context
+	factory   tx.Factory
+	keyName   string
+
+	mu       sync.Mutex
+	prepared bool
+}
+
+// NewBroadcaster returns a Broadcaster signing with the keyName key of kr.
+// clientCtx must hold a CometBFT client and the application codecs.
+func NewBroadcaster(clientCtx client.Context, kr keyring.Keyring, keyName, chainID string, gasLimit uint64, fees string) (*Broadcaster, error) {
+	record, err := kr.Key(keyName)
+	if err != nil {
+		return nil, err
+	}
+	addr, err := record.GetAddress()
+	if err != nil {
+		return nil, err
+	}
+
+	clientCtx = clientCtx.
+		WithKeyring(kr).
+		WithFromName(keyName).
+		WithFromAddress(addr).
+		WithChainID(chainID).
+		WithAccountRetriever(authtypes.AccountRetriever{}).
+		WithBroadcastMode("sync").
+		WithSkipConfirmation(true)
+
+	factory := tx.Factory{}.
+		WithKeybase(kr).
+		WithTxConfig(clientCtx.TxConfig).
+		WithAccountRetriever(clientCtx.AccountRetriever).
+		WithChainID(chainID).
+		WithGas(gasLimit).
+		WithFees(fees).
+		WithSignMode(signing.SignMode_SIGN_MODE_DIRECT)
+
+	return &Broadcaster{clientCtx: clientCtx, factory: factory, keyName: keyName}, nil
+}
+
+// Address returns the address signing the transactions.
+func (b *Broadcaster) Address() sdk.AccAddress {
+	return b.clientCtx.FromAddress
+}
+
+// Broadcast signs msgs into a single transaction and broadcasts it.
+func (b *Broadcaster) Broadcast(ctx context.Context, msgs ...sdk.Msg) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if !b.prepared {
+		// Refresh the account number and sequence from the chain
+		factory, err := b.factory.WithAccountNumber(0).WithSequence(0).Prepare(b.clientCtx)
+		if err != nil {
+			return err
+		}
+		b.factory = factory
+		b.prepared = true
+	}
+
+	builder, err := b.factory.BuildUnsignedTx(msgs...)
+	if err != nil {
+		return err
+	}
+	if err := tx.Sign(ctx, b.factory, b.keyName, builder, true); err != nil {
+		return err
+	}
+	bz, err := b.clientCtx.TxConfig.TxEncoder()(builder.GetTx())
+	if err != nil {
+		return err
+	}
+
+	res, err := b.clientCtx.BroadcastTxSync(bz)
+	if err != nil {
+		b.prepared = false
+		return err
+	}
+	if res.Code != 0 {
+		if res.Codespace == sdkerrors.RootCodespace && res.Code == sdkerrors.ErrWrongSequence.ABCICode() {
+			b.prepared = false
+		}
+		return fmt.Errorf("transaction %s failed with code %d: %s", res.TxHash, res.Code, res.RawLog)
+	}
+
+	b.factory = b.factory.WithSequence(b.factory.Sequence() + 1)
+	return nil
+}
@@ -0,0 +1,277 @@
+package ipfs
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"resist/x/posts/keeper"
+	"resist/x/posts/types"
+)
+
+var _ keeper.IPFSClient = (*Blockstore)(nil)
+
+// ErrNotFound is returned when a block is not held by the blockstore.
+var ErrNotFound = errors.New("block not found")
+
+const pinsFile = "pins.json"
+
+// Blockstore is an IPFSClient backed by the local filesystem. Content is split
+// into the same DAG the chain uses to compute ContentDistribution.IpfsHash, so
+// hashes returned by Add match the on-chain CIDs. Pins are reference counted
+// and blocks that are no longer reachable from a pinned root are removed by GC.
+type Blockstore struct {
+	mu        sync.Mutex
+	dir       string
+	chunkSize int
+	pins      map[string]uint64
+}
+
+// NewBlockstore opens (or creates) a blockstore in dir.
+func NewBlockstore(dir string) (*Blockstore, error) {
+	if err := os.MkdirAll(filepath.Join(dir, "blocks"), 0o700); err != nil {
+		return nil, fmt.Errorf("failed to create blockstore: %w", err)
+	}
+
+	bs := &Blockstore{
+		dir:       dir,
+		chunkSize: types.DefaultChunkSize,
+		pins:      make(map[string]uint64),
+	}
+
+	bz, err := os.ReadFile(filepath.Join(dir, pinsFile))
+	switch {
+	case errors.Is(err, fs.ErrNotExist):
+	case err != nil:
+		return nil, fmt.Errorf("failed to read pin set: %w", err)
+	default:
+		if err := json.Unmarshal(bz, &bs.pins); err != nil {
+			return nil, fmt.Errorf("failed to decode pin set: %w", err)
+		}
+	}
+
+	return bs, nil
+}
+
+// Add chunks data, stores its blocks and returns the root CID.
+func (bs *Blockstore) Add(data []byte) (string, error) {
+	dag, err := types.BuildContentDAG(data, bs.chunkSize)
+	if err != nil {
+		return "", err
+	}
+
+	bs.mu.Lock()
+	defer bs.mu.Unlock()
+
+	for _, block := range dag.Blocks {
+		if err := bs.putBlock(block); err != nil {
+			return "", err
+		}
+	}
+	return dag.Root, nil
+}
+
+// Get reassembles the content addressed by hash.
+func (bs *Blockstore) Get(hash string) ([]byte, error) {
+	bs.mu.Lock()
+	defer bs.mu.Unlock()
+
+	var out []byte
+	err := bs.walk(hash, func(cid string, codec uint64, data []byte) error {
+		if codec == types.RawCodec {
+			out = append(out, data...)
+		}
+		return nil
+	})
+	return out, err
+}
+
+// GetBlock returns a single verified block.
+func (bs *Blockstore) GetBlock(cid string) ([]byte, error) {
+	bs.mu.Lock()
+	defer bs.mu.Unlock()
+
+	return bs.getBlock(cid)
+}
+
+// Has reports whether every block of the DAG rooted at hash is present.
+func (bs *Blockstore) Has(hash string) bool {
+	bs.mu.Lock()
+	defer bs.mu.Unlock()
+
+	return bs.walk(hash, nil) == nil
+}
+
+// Pin increments the pin count of hash. All blocks of the DAG must be present.
+func (bs *Blockstore) Pin(hash string) error {
+	bs.mu.Lock()
+	defer bs.mu.Unlock()
+
+	if err := bs.walk(hash, nil); err != nil {
+		return fmt.Errorf("cannot pin %s: %w", hash, err)
+	}
+	bs.pins[hash]++
+	return bs.savePins()
+}
+
+// Unpin decrements the pin count of hash. Once the last pin is released the
+// blocks that are no longer referenced are garbage collected.
+func (bs *Blockstore) Unpin(hash string) error {
+	bs.mu.Lock()
+	defer bs.mu.Unlock()
+
+	count, ok := bs.pins[hash]
+	if !ok {
+		return fmt.Errorf("%s is not pinned", hash)
+	}
+	if count > 1 {
+		bs.pins[hash] = count - 1
+		return bs.savePins()
+	}
+
+	delete(bs.pins, hash)
+	if err := bs.savePins(); err != nil {
+		return err
+	}
+	_, err := bs.gc()
+	return err
+}
+
+// PinCount returns the number of pins held on hash.
+func (bs *Blockstore) PinCount(hash string) uint64 {
+	bs.mu.Lock()
+	defer bs.mu.Unlock()
+
+	return bs.pins[hash]
+}
+
+// GC removes every block that is not reachable from a pinned root and returns
+// the number of removed blocks.
+func (bs *Blockstore) GC() (int, error) {
+	bs.mu.Lock()
+	defer bs.mu.Unlock()
+
+	return bs.gc()
+}
+
+func (bs *Blockstore) gc() (int, error) {
+	live := make(map[string]struct{})
+	for root := range bs.pins {
+		if err := bs.walk(root, func(cid string, _ uint64, _ []byte) error {
+			live[cid] = struct{}{}
+			return nil
+		}); err != nil {
+			return 0, fmt.Errorf("pinned content %s is incomplete: %w", root, err)
+		}
+	}
+
+	removed := 0
+	err := filepath.WalkDir(filepath.Join(bs.dir, "blocks"), func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+		if _, ok := live[d.Name()]; ok {
+			return nil
+		}
+		if err := os.Remove(path); err != nil {
+			return err
+		}
+		removed++
+		return nil
+	})
+	return removed, err
+}
+
+// walk visits every block of the DAG rooted at cid in content order.
+func (bs *Blockstore) walk(cid string, visit func(cid string, codec uint64, data []byte) error) error {
+	codec, _, err := types.DecodeCID(cid)
+	if err != nil {
+		return err
+	}
+	data, err := bs.getBlock(cid)
+	if err != nil {
+		return err
+	}
+	if visit != nil {
+		if err := visit(cid, codec, data); err != nil {
+			return err
+		}
+	}
+	if codec == types.RawCodec {
+		return nil
+	}
+
+	links, err := types.DecodeFileNode(data)
+	if err != nil {
+		return err
+	}
+	for _, link := range links {
+		if err := bs.walk(link.Cid, visit); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (bs *Blockstore) blockPath(cid string) string {
+	// Shard blocks by the next-to-last two characters of the CID, as flatfs does.
+	shard := cid[len(cid)-3 : len(cid)-1]
+	return filepath.Join(bs.dir, "blocks", shard, cid)
+}
+
+func (bs *Blockstore) getBlock(cid string) ([]byte, error) {
+	if _, _, err := types.DecodeCID(cid); err != nil {
+		return nil, err
+	}
+	data, err := os.ReadFile(bs.blockPath(cid))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, fmt.Errorf("%s: %w", cid, ErrNotFound)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := types.VerifyBlock(cid, data); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (bs *Blockstore) putBlock(block types.Block) error {
+	path := bs.blockPath(block.Cid)
+	if _, err := os.Stat(path); err == nil {
+		return nil
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return err
+	}
+	return writeFileAtomic(path, block.Data)
+}
+
+func (bs *Blockstore) savePins() error {
+	bz, err := json.Marshal(bs.pins)
+	if err != nil {
+		return err
+	}
+	return writeFileAtomic(filepath.Join(bs.dir, pinsFile), bz)
+}
+
+func writeFileAtomic(path string, data []byte) error {
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".tmp-*")
+	if err != nil {
+		return err
+	}
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
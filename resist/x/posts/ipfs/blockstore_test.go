@@ -0,0 +1,77 @@
+package ipfs_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"resist/x/posts/ipfs"
+	"resist/x/posts/types"
+)
+
+func TestBlockstoreAddGet(t *testing.T) {
+	bs, err := ipfs.NewBlockstore(t.TempDir())
+	require.NoError(t, err)
+
+	for _, data := range [][]byte{
+		[]byte("hello world"),
+		bytes.Repeat([]byte{0xab}, 3*types.DefaultChunkSize+17),
+	} {
+		cid, err := bs.Add(data)
+		require.NoError(t, err)
+
+		expected, err := types.ComputeContentCID(data)
+		require.NoError(t, err)
+		require.Equal(t, expected, cid)
+		require.True(t, bs.Has(cid))
+
+		got, err := bs.Get(cid)
+		require.NoError(t, err)
+		require.Equal(t, data, got)
+	}
+
+	_, err = bs.Get(types.NewCID(types.RawCodec, []byte("missing")))
+	require.ErrorIs(t, err, ipfs.ErrNotFound)
+}
+
+func TestBlockstorePinsAndGC(t *testing.T) {
+	dir := t.TempDir()
+	bs, err := ipfs.NewBlockstore(dir)
+	require.NoError(t, err)
+
+	shared := bytes.Repeat([]byte{1}, types.DefaultChunkSize)
+	a, err := bs.Add(append(append([]byte{}, shared...), 'a'))
+	require.NoError(t, err)
+	b, err := bs.Add(append(append([]byte{}, shared...), 'b'))
+	require.NoError(t, err)
+
+	require.NoError(t, bs.Pin(a))
+	require.NoError(t, bs.Pin(a))
+	require.NoError(t, bs.Pin(b))
+	require.Equal(t, uint64(2), bs.PinCount(a))
+
+	// The pin set survives a restart.
+	bs, err = ipfs.NewBlockstore(dir)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), bs.PinCount(a))
+
+	require.NoError(t, bs.Unpin(a))
+	require.True(t, bs.Has(a))
+
+	// Releasing the last pin collects the blocks only a referenced.
+	require.NoError(t, bs.Unpin(a))
+	require.False(t, bs.Has(a))
+	require.True(t, bs.Has(b))
+	require.Error(t, bs.Unpin(a))
+
+	// Unpinned content is collected by GC.
+	c, err := bs.Add([]byte("unpinned"))
+	require.NoError(t, err)
+	removed, err := bs.GC()
+	require.NoError(t, err)
+	require.Equal(t, 1, removed)
+	require.False(t, bs.Has(c))
+
+	require.Error(t, bs.Pin(c))
+}
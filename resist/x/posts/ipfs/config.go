@@ -0,0 +1,110 @@
+package ipfs
+
+import (
+	"fmt"
+	"path/filepath"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/spf13/cast"
+
+	"resist/x/posts/keeper"
+)
+
+const (
+	// BackendNone disables off-chain content storage.
+	BackendNone = ""
+	// BackendLocal stores content in a blockstore on the local filesystem.
+	BackendLocal = "local"
+	// BackendKubo stores content in a Kubo-compatible daemon through its HTTP RPC API.
+	BackendKubo = "kubo"
+)
+
+// Config defines the [ipfs] section of app.toml.
+type Config struct {
+	// Backend selects the IPFSClient implementation: "", "local" or "kubo".
+	Backend string `mapstructure:"backend"`
+	// BlockstoreDir is the directory of the local blockstore, relative to the node home.
+	BlockstoreDir string `mapstructure:"blockstore-dir"`
+	// APIAddress is the address of the Kubo RPC API.
+	APIAddress string `mapstructure:"api-address"`
+	// Timeout bounds every request made to the Kubo RPC API.
+	Timeout time.Duration `mapstructure:"timeout"`
+}
+
+// DefaultConfig returns the default IPFS configuration, with off-chain storage disabled.
+func DefaultConfig() Config {
+	return Config{
+		Backend:       BackendNone,
+		BlockstoreDir: filepath.Join("data", "ipfs"),
+		APIAddress:    "http://127.0.0.1:5001",
+		Timeout:       30 * time.Second,
+	}
+}
+
+// DefaultConfigTemplate is the app.toml template of the [ipfs] section. It is
+// meant to be appended to the server configuration template.
+const DefaultConfigTemplate = `
+###############################################################################
+###                           IPFS Configuration                            ###
+###############################################################################
+
+[ipfs]
+
+# Backend holding the bytes of distributed content off-chain.
+# "" disables off-chain storage, "local" uses a blockstore on this machine and
+# "kubo" talks to a Kubo-compatible daemon.
+backend = "{{ .IPFS.Backend }}"
+
+# Directory of the local blockstore, relative to the node home directory.
+blockstore-dir = "{{ .IPFS.BlockstoreDir }}"
+
+# Address of the Kubo RPC API.
+api-address = "{{ .IPFS.APIAddress }}"
+
+# Timeout of requests made to the Kubo RPC API.
+timeout = "{{ .IPFS.Timeout }}"
+`
+
+// ReadConfig reads the [ipfs] section from the application options.
+func ReadConfig(appOpts servertypes.AppOptions) Config {
+	cfg := DefaultConfig()
+	if v := appOpts.Get("ipfs.backend"); v != nil {
+		cfg.Backend = cast.ToString(v)
+	}
+	if v := appOpts.Get("ipfs.blockstore-dir"); v != nil {
+		cfg.BlockstoreDir = cast.ToString(v)
+	}
+	if v := appOpts.Get("ipfs.api-address"); v != nil {
+		cfg.APIAddress = cast.ToString(v)
+	}
+	if v := appOpts.Get("ipfs.timeout"); v != nil {
+		cfg.Timeout = cast.ToDuration(v)
+	}
+	return cfg
+}
+
+// NewClient returns the IPFSClient selected by the configuration, or nil if
+// off-chain storage is disabled.
+func NewClient(cfg Config, homeDir string) (keeper.IPFSClient, error) {
+	switch cfg.Backend {
+	case BackendNone:
+		return nil, nil
+	case BackendLocal:
+		dir := cfg.BlockstoreDir
+		if !filepath.IsAbs(dir) {
+			dir = filepath.Join(homeDir, dir)
+		}
+		return NewBlockstore(dir)
+	case BackendKubo:
+		return NewKuboClient(cfg.APIAddress, cfg.Timeout), nil
+	default:
+		return nil, fmt.Errorf("unknown ipfs backend %q", cfg.Backend)
+	}
+}
+
+// NewClientFromAppOptions builds the configured IPFSClient from the application options.
+func NewClientFromAppOptions(appOpts servertypes.AppOptions) (keeper.IPFSClient, error) {
+	return NewClient(ReadConfig(appOpts), cast.ToString(appOpts.Get(flags.FlagHome)))
+}
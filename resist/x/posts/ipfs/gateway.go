@@ -0,0 +1,38 @@
+package ipfs
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	"resist/x/posts/keeper"
+	"resist/x/posts/types"
+)
+
+// RegisterGatewayRoutes serves the content held by client under /ipfs/{cid}.
+func RegisterGatewayRoutes(router *mux.Router, client keeper.IPFSClient) {
+	router.HandleFunc("/ipfs/{cid}", func(w http.ResponseWriter, r *http.Request) {
+		cid := mux.Vars(r)["cid"]
+		if _, _, err := types.DecodeCID(cid); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		data, err := client.Get(cid)
+		if errors.Is(err, ErrNotFound) {
+			http.Error(w, "content not found", http.StatusNotFound)
+			return
+		}
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadGateway)
+			return
+		}
+
+		// Content is immutable, so it can be cached forever.
+		w.Header().Set("Cache-Control", "public, max-age=29030400, immutable")
+		w.Header().Set("Etag", `"`+cid+`"`)
+		w.Header().Set("Content-Type", http.DetectContentType(data))
+		_, _ = w.Write(data)
+	}).Methods(http.MethodGet, http.MethodHead)
+}
@@ -0,0 +1,143 @@
+package ipfs
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"resist/x/posts/keeper"
+	"resist/x/posts/types"
+)
+
+var _ keeper.IPFSClient = (*KuboClient)(nil)
+
+// KuboClient is an IPFSClient talking to the RPC API of a Kubo-compatible daemon.
+// Content is added with the chunking parameters the chain uses, and both the
+// CID returned by the daemon and the bytes it serves are checked against the
+// locally computed CID. Kubo pins are not reference counted: Unpin releases the
+// content regardless of how many times it was pinned.
+type KuboClient struct {
+	apiAddress string
+	client     *http.Client
+}
+
+// NewKuboClient returns a client for the Kubo RPC API listening at apiAddress.
+func NewKuboClient(apiAddress string, timeout time.Duration) *KuboClient {
+	return &KuboClient{
+		apiAddress: strings.TrimSuffix(apiAddress, "/"),
+		client:     &http.Client{Timeout: timeout},
+	}
+}
+
+// Add uploads data to the daemon and returns its CID.
+func (c *KuboClient) Add(data []byte) (string, error) {
+	expected, err := types.ComputeContentCID(data)
+	if err != nil {
+		return "", err
+	}
+
+	var body bytes.Buffer
+	w := multipart.NewWriter(&body)
+	part, err := w.CreateFormFile("file", "content")
+	if err != nil {
+		return "", err
+	}
+	if _, err := part.Write(data); err != nil {
+		return "", err
+	}
+	if err := w.Close(); err != nil {
+		return "", err
+	}
+
+	params := url.Values{
+		"cid-version": {"1"},
+		"raw-leaves":  {"true"},
+		"chunker":     {fmt.Sprintf("size-%d", types.DefaultChunkSize)},
+		"pin":         {"false"},
+	}
+	resp, err := c.call("add", params, w.FormDataContentType(), &body)
+	if err != nil {
+		return "", err
+	}
+
+	var added struct {
+		Hash string `json:"Hash"`
+	}
+	if err := json.Unmarshal(resp, &added); err != nil {
+		return "", fmt.Errorf("failed to decode add response: %w", err)
+	}
+	if added.Hash != expected {
+		return "", fmt.Errorf("daemon returned cid %s, expected %s", added.Hash, expected)
+	}
+	return added.Hash, nil
+}
+
+// Get fetches the content addressed by hash and verifies it.
+func (c *KuboClient) Get(hash string) ([]byte, error) {
+	if _, _, err := types.DecodeCID(hash); err != nil {
+		return nil, err
+	}
+	data, err := c.call("cat", url.Values{"arg": {hash}}, "", nil)
+	if err != nil {
+		return nil, err
+	}
+	cid, err := types.ComputeContentCID(data)
+	if err != nil {
+		return nil, err
+	}
+	if cid != hash {
+		return nil, fmt.Errorf("daemon returned content for %s, expected %s", cid, hash)
+	}
+	return data, nil
+}
+
+// Pin pins hash recursively on the daemon.
+func (c *KuboClient) Pin(hash string) error {
+	_, err := c.call("pin/add", url.Values{"arg": {hash}}, "", nil)
+	return err
+}
+
+// Unpin removes the recursive pin of hash on the daemon.
+func (c *KuboClient) Unpin(hash string) error {
+	_, err := c.call("pin/rm", url.Values{"arg": {hash}}, "", nil)
+	return err
+}
+
+// call issues a request to /api/v0/<method>. The Kubo RPC API only accepts POST.
+func (c *KuboClient) call(method string, params url.Values, contentType string, body io.Reader) ([]byte, error) {
+	endpoint := fmt.Sprintf("%s/api/v0/%s?%s", c.apiAddress, method, params.Encode())
+	req, err := http.NewRequest(http.MethodPost, endpoint, body)
+	if err != nil {
+		return nil, err
+	}
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("ipfs %s: %w", method, err)
+	}
+	defer resp.Body.Close()
+
+	bz, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("ipfs %s: %w", method, err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		var apiErr struct {
+			Message string `json:"Message"`
+		}
+		if json.Unmarshal(bz, &apiErr) == nil && apiErr.Message != "" {
+			return nil, fmt.Errorf("ipfs %s: %s", method, apiErr.Message)
+		}
+		return nil, fmt.Errorf("ipfs %s: unexpected status %s", method, resp.Status)
+	}
+	return bz, nil
+}
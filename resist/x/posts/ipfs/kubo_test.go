@@ -0,0 +1,92 @@
+package ipfs_test
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"resist/x/posts/ipfs"
+	"resist/x/posts/types"
+)
+
+// fakeKubo emulates the subset of the Kubo RPC API used by KuboClient on top of a blockstore.
+func fakeKubo(t *testing.T, bs *ipfs.Blockstore) *httptest.Server {
+	t.Helper()
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+		fail := func(err error) {
+			w.WriteHeader(http.StatusInternalServerError)
+			_ = json.NewEncoder(w).Encode(map[string]string{"Message": err.Error()})
+		}
+
+		arg := r.URL.Query().Get("arg")
+		switch r.URL.Path {
+		case "/api/v0/add":
+			require.Equal(t, "1", r.URL.Query().Get("cid-version"))
+			file, _, err := r.FormFile("file")
+			require.NoError(t, err)
+			data, err := io.ReadAll(file)
+			require.NoError(t, err)
+			cid, err := bs.Add(data)
+			if err != nil {
+				fail(err)
+				return
+			}
+			_ = json.NewEncoder(w).Encode(map[string]string{"Name": "content", "Hash": cid})
+		case "/api/v0/cat":
+			data, err := bs.Get(arg)
+			if err != nil {
+				fail(err)
+				return
+			}
+			_, _ = w.Write(data)
+		case "/api/v0/pin/add":
+			if err := bs.Pin(arg); err != nil {
+				fail(err)
+			}
+		case "/api/v0/pin/rm":
+			if err := bs.Unpin(arg); err != nil {
+				fail(err)
+			}
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+}
+
+func TestKuboClient(t *testing.T) {
+	bs, err := ipfs.NewBlockstore(t.TempDir())
+	require.NoError(t, err)
+	srv := fakeKubo(t, bs)
+	defer srv.Close()
+
+	client := ipfs.NewKuboClient(srv.URL+"/", time.Second)
+	data := []byte("hello world")
+
+	cid, err := client.Add(data)
+	require.NoError(t, err)
+	expected, err := types.ComputeContentCID(data)
+	require.NoError(t, err)
+	require.Equal(t, expected, cid)
+
+	got, err := client.Get(cid)
+	require.NoError(t, err)
+	require.Equal(t, data, got)
+
+	require.NoError(t, client.Pin(cid))
+	require.Equal(t, uint64(1), bs.PinCount(cid))
+	require.NoError(t, client.Unpin(cid))
+	require.Zero(t, bs.PinCount(cid))
+
+	_, err = client.Get(cid)
+	require.ErrorContains(t, err, "not found")
+}
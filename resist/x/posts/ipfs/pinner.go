@@ -0,0 +1,106 @@
+package ipfs
+
+import (
+	"context"
+
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	"resist/x/posts/keeper"
+	"resist/x/posts/types"
+)
+
+// pinQueueSize is the number of committed contents waiting to be pinned
+// beyond which new ones are dropped.
+const pinQueueSize = 256
+
+var _ storetypes.ABCIListener = (*Pinner)(nil)
+
+// DistributedContent is implemented by the posts keeper, which holds the
+// content distributed in the finalized blocks.
+type DistributedContent interface {
+	TakeDistributedContent() map[string][]byte
+}
+
+// Pinner is an ABCI listener adding and pinning in the IPFS backend the
+// content distributed by the successful transactions of every committed
+// block. The backend is called in the background, so a slow or unavailable
+// daemon never delays blocks, and content of failed transactions is never
+// pinned.
+type Pinner struct {
+	content DistributedContent
+	client  keeper.IPFSClient
+	logger  log.Logger
+
+	// committed are the CIDs distributed by the block being committed.
+	committed []string
+	queue     chan []byte
+}
+
+// NewPinner returns a Pinner storing content in client, and starts pinning in
+// the background.
+func NewPinner(content DistributedContent, client keeper.IPFSClient, logger log.Logger) *Pinner {
+	p := &Pinner{
+		content: content,
+		client:  client,
+		logger:  logger,
+		queue:   make(chan []byte, pinQueueSize),
+	}
+	go p.run()
+	return p
+}
+
+// ListenFinalizeBlock records the content distributed by the successful
+// transactions of the block.
+func (p *Pinner) ListenFinalizeBlock(_ context.Context, _ abci.RequestFinalizeBlock, res abci.ResponseFinalizeBlock) error {
+	p.committed = p.committed[:0]
+	for _, tx := range res.TxResults {
+		if !tx.IsOK() {
+			continue
+		}
+		for _, event := range tx.Events {
+			if event.Type != types.EventTypeContentDistributed {
+				continue
+			}
+			for _, attr := range event.Attributes {
+				if attr.Key == types.AttributeKeyIpfsHash {
+					p.committed = append(p.committed, attr.Value)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// ListenCommit queues the content recorded for the committed block to be
+// pinned, and drops the content of the transactions that failed.
+func (p *Pinner) ListenCommit(context.Context, abci.ResponseCommit, []*storetypes.StoreKVPair) error {
+	content := p.content.TakeDistributedContent()
+	for _, cid := range p.committed {
+		data, ok := content[cid]
+		if !ok {
+			continue
+		}
+		select {
+		case p.queue <- data:
+		default:
+			p.logger.Error("too much content waiting to be pinned, dropping", "ipfs_hash", cid)
+		}
+	}
+	p.committed = p.committed[:0]
+	return nil
+}
+
+func (p *Pinner) run() {
+	for data := range p.queue {
+		hash, err := p.client.Add(data)
+		if err != nil {
+			p.logger.Error("failed to add content to ipfs", "err", err)
+			continue
+		}
+		if err := p.client.Pin(hash); err != nil {
+			p.logger.Error("failed to pin content", "ipfs_hash", hash, "err", err)
+		}
+	}
+}
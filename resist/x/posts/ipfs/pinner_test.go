@@ -0,0 +1,50 @@
+package ipfs_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"cosmossdk.io/log"
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/stretchr/testify/require"
+
+	"resist/x/posts/ipfs"
+	"resist/x/posts/types"
+)
+
+type distributedContent map[string][]byte
+
+func (c distributedContent) TakeDistributedContent() map[string][]byte {
+	return c
+}
+
+func distributedEvent(cid string) abci.Event {
+	return abci.Event{
+		Type:       types.EventTypeContentDistributed,
+		Attributes: []abci.EventAttribute{{Key: types.AttributeKeyIpfsHash, Value: cid}},
+	}
+}
+
+func TestPinnerPinsCommittedContent(t *testing.T) {
+	bs, err := ipfs.NewBlockstore(t.TempDir())
+	require.NoError(t, err)
+
+	committed, failed := []byte("committed"), []byte("failed")
+	committedCID, err := types.ComputeContentCID(committed)
+	require.NoError(t, err)
+	failedCID, err := types.ComputeContentCID(failed)
+	require.NoError(t, err)
+
+	pinner := ipfs.NewPinner(distributedContent{committedCID: committed, failedCID: failed}, bs, log.NewNopLogger())
+	require.NoError(t, pinner.ListenFinalizeBlock(context.Background(), abci.RequestFinalizeBlock{}, abci.ResponseFinalizeBlock{
+		TxResults: []*abci.ExecTxResult{
+			{Events: []abci.Event{distributedEvent(committedCID)}},
+			{Code: 1, Events: []abci.Event{distributedEvent(failedCID)}},
+		},
+	}))
+	require.NoError(t, pinner.ListenCommit(context.Background(), abci.ResponseCommit{}, nil))
+
+	require.Eventually(t, func() bool { return bs.Has(committedCID) }, time.Second, 10*time.Millisecond)
+	require.False(t, bs.Has(failedCID))
+}
@@ -0,0 +1,130 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"resist/x/posts/types"
+)
+
+// maxExpiredReplicasPerBlock bounds the work done by the EndBlocker. Expired
+// assignments left over are handled in the following blocks.
+const maxExpiredReplicasPerBlock = 100
+
+// EndBlocker reassigns the replicas that were not acknowledged in time,
+// prunes the expired node messages and runs the storage audits: missed
+// challenges are failed and a new round of challenges is issued at the start
+// of each audit epoch.
+func (k Keeper) EndBlocker(ctx context.Context) error {
+	if err := k.expireReplicaAssignments(ctx); err != nil {
+		return err
+	}
+	if err := k.expireSignalMessages(ctx); err != nil {
+		return err
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+	if err := k.expireStorageChallenges(ctx, params); err != nil {
+		return err
+	}
+	if params.AuditEpochBlocks <= 0 {
+		// Params left unset by an upgrade disable the audits rather than halt the chain
+		return nil
+	}
+	if sdk.UnwrapSDKContext(ctx).BlockHeight()%params.AuditEpochBlocks == 0 && params.AuditChallengesPerEpoch > 0 {
+		return k.issueStorageChallenges(ctx, params)
+	}
+	return nil
+}
+
+// expireReplicaAssignments fails the pending replica assignments whose
+// acknowledgement deadline has passed and assigns their replica to another node.
+func (k Keeper) expireReplicaAssignments(ctx context.Context) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	blockTime := sdkCtx.BlockTime().Unix()
+
+	var expired []collections.Triple[int64, string, string]
+	err := k.ReplicaDeadline.Walk(ctx, nil, func(key collections.Triple[int64, string, string]) (bool, error) {
+		if key.K1() >= blockTime || len(expired) == maxExpiredReplicasPerBlock {
+			return true, nil
+		}
+		expired = append(expired, key)
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	distributionService := NewContentDistributionService(&k)
+	for _, key := range expired {
+		assignment, err := k.ReplicaAssignment.Get(ctx, collections.Join(key.K2(), key.K3()))
+		if err != nil {
+			return err
+		}
+		assignment.Status = types.ReplicaStatusFailed
+		if err := k.SetReplicaAssignment(ctx, assignment); err != nil {
+			return err
+		}
+		sdkCtx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				"replica_failed",
+				sdk.NewAttribute("content_id", assignment.ContentId),
+				sdk.NewAttribute("node_id", assignment.NodeId),
+			),
+		)
+
+		if err := k.reassignReplica(ctx, distributionService, assignment); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// reassignReplica assigns the replica held by a failed assignment to a node
+// that was never assigned the content. The content stays under-replicated if
+// no such node is available.
+func (k Keeper) reassignReplica(ctx context.Context, distributionService *ContentDistributionService, failed types.ReplicaAssignment) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	distribution, err := k.ContentDistribution.Get(ctx, failed.ContentId)
+	if err != nil {
+		return err
+	}
+	assignments, err := k.GetReplicaAssignments(ctx, failed.ContentId)
+	if err != nil {
+		return err
+	}
+	assigned := make([]string, 0, len(assignments))
+	for _, assignment := range assignments {
+		assigned = append(assigned, assignment.NodeId)
+	}
+
+	nodeId, err := distributionService.SelectReplacementNode(ctx, distribution, assigned)
+	if err != nil {
+		// A broken selection must not halt the chain; the replica stays unassigned.
+		sdkCtx.Logger().Error("failed to select replacement replica node", "module", "x/"+types.ModuleName, "content_id", failed.ContentId, "err", err)
+		return nil
+	}
+	if nodeId == "" {
+		return nil
+	}
+
+	if err := k.AssignReplicas(ctx, failed.ContentId, []string{nodeId}); err != nil {
+		return err
+	}
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"replica_reassigned",
+			sdk.NewAttribute("content_id", failed.ContentId),
+			sdk.NewAttribute("previous_node_id", failed.NodeId),
+			sdk.NewAttribute("node_id", nodeId),
+		),
+	)
+	return nil
+}
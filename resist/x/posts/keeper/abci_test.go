@@ -0,0 +1,87 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"resist/x/posts/keeper"
+	"resist/x/posts/types"
+	rewardstypes "resist/x/rewards/types"
+)
+
+func TestEndBlockerReassignsExpiredReplicas(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(time.Unix(1000, 0))
+
+	creator, err := f.addressCodec.BytesToString([]byte("signerAddr__________________"))
+	require.NoError(t, err)
+	for _, node := range []rewardstypes.Node{
+		{NodeId: "eu-1", Owner: creator, Location: "eu", IsActive: true},
+		{NodeId: "us-1", Owner: creator, Location: "us", IsActive: true},
+		{NodeId: "us-2", Owner: creator, Location: "us", IsActive: true},
+	} {
+		f.rewardsKeeper.nodes[node.NodeId] = node
+	}
+
+	resp, err := srv.DistributeContent(ctx, &types.MsgDistributeContent{
+		Creator:        creator,
+		ContentId:      "post-1",
+		ContentData:    []byte("hello world"),
+		Metadata:       &types.ContentMetadata{},
+		TargetReplicas: 2,
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"eu-1", "us-1"}, resp.AssignedNodes)
+
+	_, err = srv.AckReplica(ctx, &types.MsgAckReplica{Creator: creator, ContentId: "post-1", NodeId: "eu-1", Cid: resp.IpfsHash})
+	require.NoError(t, err)
+
+	// Nothing expires at the deadline itself
+	deadline := 1000 + types.DefaultReplicaAckTimeout
+	require.NoError(t, f.keeper.EndBlocker(ctx.WithBlockTime(time.Unix(deadline, 0))))
+	assignment, err := f.keeper.ReplicaAssignment.Get(ctx, collections.Join("post-1", "us-1"))
+	require.NoError(t, err)
+	require.Equal(t, types.ReplicaStatusPending, assignment.Status)
+
+	// us-1 missed its deadline and is replaced by the only node never assigned
+	ctx = ctx.WithBlockTime(time.Unix(deadline+1, 0))
+	require.NoError(t, f.keeper.EndBlocker(ctx))
+
+	assignment, err = f.keeper.ReplicaAssignment.Get(ctx, collections.Join("post-1", "us-1"))
+	require.NoError(t, err)
+	require.Equal(t, types.ReplicaStatusFailed, assignment.Status)
+
+	assignment, err = f.keeper.ReplicaAssignment.Get(ctx, collections.Join("post-1", "us-2"))
+	require.NoError(t, err)
+	require.Equal(t, types.ReplicaStatusPending, assignment.Status)
+	require.Equal(t, deadline+1+types.DefaultReplicaAckTimeout, assignment.AckDeadline)
+
+	assignment, err = f.keeper.ReplicaAssignment.Get(ctx, collections.Join("post-1", "eu-1"))
+	require.NoError(t, err)
+	require.Equal(t, types.ReplicaStatusStored, assignment.Status)
+
+	// us-2 fails too; no node is left to take over the replica
+	ctx = ctx.WithBlockTime(time.Unix(deadline+2+types.DefaultReplicaAckTimeout, 0))
+	require.NoError(t, f.keeper.EndBlocker(ctx))
+
+	assignments, err := f.keeper.GetReplicaAssignments(ctx, "post-1")
+	require.NoError(t, err)
+	require.Len(t, assignments, 3)
+	require.Equal(t, types.ReplicaStatusFailed, assignments[2].Status)
+
+	empty := true
+	require.NoError(t, f.keeper.ReplicaDeadline.Walk(ctx, nil, func(collections.Triple[int64, string, string]) (bool, error) {
+		empty = false
+		return true, nil
+	}))
+	require.True(t, empty)
+
+	dist, err := f.keeper.ContentDistribution.Get(ctx, "post-1")
+	require.NoError(t, err)
+	require.Equal(t, uint32(1), dist.Replication.CurrentReplicas)
+}
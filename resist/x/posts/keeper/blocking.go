@@ -0,0 +1,45 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+
+	"resist/x/posts/types"
+)
+
+// checkNotBlocked returns ErrBlocked if the author or the creator of post
+// blocks address, which then cannot interact with the post.
+func (k Keeper) checkNotBlocked(ctx context.Context, post types.SocialPost, address string) error {
+	owners := []string{post.Author}
+	if post.Creator != post.Author {
+		owners = append(owners, post.Creator)
+	}
+	for _, owner := range owners {
+		if owner == "" {
+			continue
+		}
+		blocked, err := k.identityKeeper.IsBlocked(ctx, owner, address)
+		if err != nil {
+			return err
+		}
+		if blocked {
+			return errorsmod.Wrapf(types.ErrBlocked, "%s blocks %s", owner, address)
+		}
+	}
+	return nil
+}
+
+// checkNotBlockedByIndex is checkNotBlocked for the post at postIndex, if
+// any.
+func (k Keeper) checkNotBlockedByIndex(ctx context.Context, postIndex, address string) error {
+	post, err := k.lookupPost(ctx, postIndex)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	return k.checkNotBlocked(ctx, post, address)
+}
@@ -2,11 +2,19 @@ package keeper
 
 import (
 	"context"
-	"crypto/rand"
+	"crypto/sha256"
+	"errors"
 	"fmt"
-	"time"
+	mrand "math/rand/v2"
+	"sort"
+	"strings"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"resist/x/posts/types"
+	rewardstypes "resist/x/rewards/types"
 )
 
 // ContentDistributionService handles IPFS integration and content replication
@@ -21,142 +29,311 @@ func NewContentDistributionService(k *Keeper) *ContentDistributionService {
 	}
 }
 
-// DistributeToIPFS simulates uploading content to IPFS
-// In a real implementation, this would integrate with go-ipfs
-func (s *ContentDistributionService) DistributeToIPFS(ctx context.Context, contentData []byte, metadata *types.ContentMetadata) (string, error) {
-	// Generate a mock IPFS hash for now
-	// In production, this would be: ipfs.Add(contentData)
-	hash := s.generateMockIPFSHash(contentData)
+// DistributeToIPFS computes the content CID and stores the ContentDistribution
+// record for the given content. The CID is derived deterministically from the
+// content bytes so that every validator arrives at the same state; the bytes
+// themselves are held off-chain by the nodes replicating the content.
+func (s *ContentDistributionService) DistributeToIPFS(ctx context.Context, creator string, contentData []byte, metadata *types.ContentMetadata, strategy string, targetReplicas uint32) (types.ContentDistribution, error) {
+	ipfsHash, err := types.ComputeContentCID(contentData)
+	if err != nil {
+		return types.ContentDistribution{}, errorsmod.Wrap(types.ErrInvalidInput, err.Error())
+	}
+
+	found, err := s.keeper.ContentDistribution.Has(ctx, metadata.ContentId)
+	if err != nil {
+		return types.ContentDistribution{}, err
+	}
+	if found {
+		return types.ContentDistribution{}, errorsmod.Wrapf(types.ErrInvalidInput, "content %s already distributed", metadata.ContentId)
+	}
 
-	// Store content metadata
+	blockTime := sdk.UnwrapSDKContext(ctx).BlockTime().Unix()
 	distribution := types.ContentDistribution{
 		ContentId:   metadata.ContentId,
-		IpfsHash:    hash,
+		IpfsHash:    ipfsHash,
 		MirrorNodes: []string{}, // Will be populated by replication
-		CreatedAt:   time.Now().Unix(),
-		LastSync:    time.Now().Unix(),
+		CreatedAt:   blockTime,
+		LastSync:    blockTime,
+		Creator:     creator,
+		Metadata:    metadata,
 		Replication: &types.ContentReplication{
-			TargetReplicas:       3, // Default replicas
-			CurrentReplicas:      0,
-			ReplicaNodes:         []string{},
-			ReplicationStrategy:  "geographic", // Default strategy
-			TotalSizeBytes:       uint64(len(contentData)),
+			TargetReplicas:      targetReplicas,
+			CurrentReplicas:     0,
+			ReplicaNodes:        []string{},
+			ReplicationStrategy: strategy,
+			TotalSizeBytes:      uint64(len(contentData)),
 		},
 	}
 
-	// In a real implementation, store this in the blockchain state
-	// For now, we'll just return the hash
-	_ = distribution // Use the variable to avoid unused error
-	return hash, nil
+	if err := s.keeper.ContentDistribution.Set(ctx, distribution.ContentId, distribution); err != nil {
+		return types.ContentDistribution{}, err
+	}
+
+	s.storeOffChain(ctx, ipfsHash, contentData)
+
+	return distribution, nil
 }
 
-// SelectReplicationNodes selects optimal nodes for content replication
-func (s *ContentDistributionService) SelectReplicationNodes(ctx context.Context, strategy string, targetReplicas uint32, preferredNodes []string) ([]string, error) {
-	// This would integrate with the rewards module to find available nodes
-	// For now, return mock node selections
+// storeOffChain keeps the content bytes until the block is committed, for the
+// IPFS backend, if any, to add and pin them off the consensus path. The bytes
+// are only kept while finalizing a block, and never influence consensus.
+func (s *ContentDistributionService) storeOffChain(ctx context.Context, ipfsHash string, contentData []byte) {
+	if s.keeper.ipfsClient == nil || sdk.UnwrapSDKContext(ctx).ExecMode() != sdk.ExecModeFinalize {
+		return
+	}
+	s.keeper.distributedContent.put(ipfsHash, contentData)
+}
+
+// SelectReplicationNodes selects the nodes that should replicate a piece of
+// content among the active nodes registered in x/rewards that support its
+// content type. Eligible preferred nodes are assigned first; the remaining
+// replicas are picked according to the strategy.
+func (s *ContentDistributionService) SelectReplicationNodes(ctx context.Context, contentId, contentType, strategy string, targetReplicas uint32, preferredNodes []string) ([]string, error) {
+	return s.selectNodes(ctx, contentId, contentType, strategy, targetReplicas, preferredNodes, nil)
+}
+
+// SelectReplacementNode picks a node to take over a replica of the given
+// content, skipping the nodes that were already assigned to it. It returns an
+// empty string when no eligible node is left.
+func (s *ContentDistributionService) SelectReplacementNode(ctx context.Context, distribution types.ContentDistribution, assigned []string) (string, error) {
+	exclude := make(map[string]bool, len(assigned))
+	for _, nodeId := range assigned {
+		exclude[nodeId] = true
+	}
+
+	var contentType, strategy string
+	if distribution.Metadata != nil {
+		contentType = distribution.Metadata.ContentType
+	}
+	if distribution.Replication != nil {
+		strategy = distribution.Replication.ReplicationStrategy
+	}
+
+	selected, err := s.selectNodes(ctx, distribution.ContentId, contentType, strategy, 1, nil, exclude)
+	if err != nil || len(selected) == 0 {
+		return "", err
+	}
+	return selected[0], nil
+}
+
+func (s *ContentDistributionService) selectNodes(ctx context.Context, contentId, contentType, strategy string, targetReplicas uint32, preferredNodes []string, exclude map[string]bool) ([]string, error) {
+	if s.keeper.rewardsKeeper == nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidInput, "no node registry available")
+	}
+
+	nodes, err := s.keeper.rewardsKeeper.GetActiveNodes(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	candidates := make(map[string]rewardstypes.Node)
+	for _, node := range nodes {
+		if !exclude[node.NodeId] && supportsContentType(node, contentType) {
+			candidates[node.NodeId] = node
+		}
+	}
+
+	selected := make([]string, 0, targetReplicas)
+	for _, nodeId := range preferredNodes {
+		if uint32(len(selected)) == targetReplicas {
+			break
+		}
+		if _, ok := candidates[nodeId]; !ok {
+			return nil, errorsmod.Wrapf(types.ErrInvalidInput, "preferred node %s is not an active node supporting %q content", nodeId, contentType)
+		}
+		selected = append(selected, nodeId)
+		delete(candidates, nodeId)
+	}
+
+	remaining := make([]rewardstypes.Node, 0, len(candidates))
+	for _, node := range candidates {
+		remaining = append(remaining, node)
+	}
+	sort.Slice(remaining, func(i, j int) bool { return remaining[i].NodeId < remaining[j].NodeId })
 
+	var ranked []rewardstypes.Node
 	switch strategy {
 	case "geographic":
-		return s.selectGeographicallyDistributed(ctx, targetReplicas)
+		ranked = s.rankGeographicallyDistributed(ctx, remaining, selected)
 	case "performance":
-		return s.selectHighPerformanceNodes(ctx, targetReplicas)
+		ranked, err = s.rankHighPerformanceNodes(ctx, remaining)
 	case "random":
-		return s.selectRandomNodes(ctx, targetReplicas)
+		ranked = s.rankRandomNodes(ctx, contentId, remaining)
 	default:
-		return s.selectRandomNodes(ctx, targetReplicas)
+		return nil, errorsmod.Wrapf(types.ErrInvalidInput, "unknown replication strategy %q", strategy)
 	}
+	if err != nil {
+		return nil, err
+	}
+
+	for _, node := range ranked {
+		if uint32(len(selected)) == targetReplicas {
+			break
+		}
+		selected = append(selected, node.NodeId)
+	}
+
+	return selected, nil
 }
 
-// InitiateHubSync starts synchronization between two hubs
-func (s *ContentDistributionService) InitiateHubSync(ctx context.Context, sourceNode, targetNode string, contentIds []string, method string) (string, error) {
-	syncId, err := s.generateSyncID()
+// InitiateHubSync records a pending synchronization of content from the source
+// hub to the target hub. The target node reports the progress of the transfer.
+func (s *ContentDistributionService) InitiateHubSync(ctx context.Context, creator, sourceNode, targetNode string, contentIds []string, method string) (types.HubSync, error) {
+	var totalBytes uint64
+	for _, contentId := range contentIds {
+		distribution, err := s.keeper.ContentDistribution.Get(ctx, contentId)
+		if err != nil {
+			if errors.Is(err, collections.ErrNotFound) {
+				return types.HubSync{}, errorsmod.Wrapf(types.ErrContentNotFound, "content %s", contentId)
+			}
+			return types.HubSync{}, err
+		}
+		if distribution.Replication != nil {
+			totalBytes += distribution.Replication.TotalSizeBytes
+		}
+	}
+
+	seq, err := s.keeper.HubSyncSeq.Next(ctx)
 	if err != nil {
-		return "", err
+		return types.HubSync{}, err
 	}
 
-	// Create hub sync record
+	blockTime := sdk.UnwrapSDKContext(ctx).BlockTime().Unix()
 	hubSync := types.HubSync{
-		SyncId:          syncId,
-		SourceNode:      sourceNode,
-		TargetNode:      targetNode,
-		ContentIds:      contentIds,
-		Status:          "pending",
-		StartedAt:       time.Now().Unix(),
-		SyncMethod:      method,
+		SyncId:           fmt.Sprintf("sync_%d", seq),
+		SourceNode:       sourceNode,
+		TargetNode:       targetNode,
+		ContentIds:       contentIds,
+		Status:           types.HubSyncStatusPending,
+		StartedAt:        blockTime,
+		UpdatedAt:        blockTime,
+		SyncMethod:       method,
 		BytesTransferred: 0,
+		Creator:          creator,
+		TotalBytes:       totalBytes,
+	}
+	if err := s.keeper.SetHubSync(ctx, hubSync); err != nil {
+		return types.HubSync{}, err
 	}
 
-	// In production, this would:
-	// 1. Validate nodes exist and are online
-	// 2. Check resource availability
-	// 3. Initiate secure connection via Signal protocol
-	// 4. Begin content transfer
-	_ = hubSync // Use the variable to avoid unused error
-
-	return syncId, nil
-}
-
-// Helper functions
-
-func (s *ContentDistributionService) generateMockIPFSHash(data []byte) string {
-	// Generate a realistic-looking IPFS hash
-	// In production, this would be the actual IPFS CID
-	bytes := make([]byte, 32)
-	rand.Read(bytes)
-	return fmt.Sprintf("Qm%x", bytes)[:46] // Typical IPFS hash length
+	return hubSync, nil
 }
 
-func (s *ContentDistributionService) generateSyncID() (string, error) {
-	bytes := make([]byte, 16)
-	if _, err := rand.Read(bytes); err != nil {
-		return "", err
+// EstimateSyncDuration returns the number of seconds needed to transfer bytes
+// at the bandwidth of the slower of the two nodes, or zero if either node does
+// not advertise its bandwidth.
+func EstimateSyncDuration(bytes uint64, source, target rewardstypes.Node) int64 {
+	mbps := min(source.BandwidthMbps, target.BandwidthMbps)
+	if mbps == 0 {
+		return 0
 	}
-	return fmt.Sprintf("sync_%x", bytes), nil
+	bytesPerSecond := mbps * 1_000_000 / 8
+	return int64((bytes + bytesPerSecond - 1) / bytesPerSecond)
 }
 
-func (s *ContentDistributionService) selectGeographicallyDistributed(ctx context.Context, targetReplicas uint32) ([]string, error) {
-	// Mock implementation - would query nodes by geographic location
-	nodes := []string{
-		"node_us_east_1", "node_eu_west_1", "node_asia_pacific_1",
-		"node_us_west_1", "node_eu_central_1",
+// rankGeographicallyDistributed orders nodes so that consecutive picks cover as
+// many distinct locations as possible, starting with locations not already
+// covered by the selected nodes.
+func (s *ContentDistributionService) rankGeographicallyDistributed(ctx context.Context, nodes []rewardstypes.Node, selected []string) []rewardstypes.Node {
+	covered := make(map[string]bool)
+	for _, nodeId := range selected {
+		if node, err := s.keeper.rewardsKeeper.GetNode(ctx, nodeId); err == nil {
+			covered[node.Location] = true
+		}
 	}
 
-	if targetReplicas > uint32(len(nodes)) {
-		targetReplicas = uint32(len(nodes))
+	byLocation := make(map[string][]rewardstypes.Node)
+	var locations []string
+	for _, node := range nodes {
+		if _, ok := byLocation[node.Location]; !ok {
+			locations = append(locations, node.Location)
+		}
+		byLocation[node.Location] = append(byLocation[node.Location], node)
 	}
+	// Uncovered locations first, then by name for determinism.
+	sort.Slice(locations, func(i, j int) bool {
+		if covered[locations[i]] != covered[locations[j]] {
+			return !covered[locations[i]]
+		}
+		return locations[i] < locations[j]
+	})
 
-	return nodes[:targetReplicas], nil
+	ranked := make([]rewardstypes.Node, 0, len(nodes))
+	for round := 0; len(ranked) < len(nodes); round++ {
+		for _, location := range locations {
+			if round < len(byLocation[location]) {
+				ranked = append(ranked, byLocation[location][round])
+			}
+		}
+	}
+	return ranked
 }
 
-func (s *ContentDistributionService) selectHighPerformanceNodes(ctx context.Context, targetReplicas uint32) ([]string, error) {
-	// Mock implementation - would query nodes by performance metrics
-	nodes := []string{
-		"node_high_perf_1", "node_high_perf_2", "node_high_perf_3",
-		"node_high_perf_4", "node_high_perf_5",
+// rankHighPerformanceNodes orders nodes by uptime, then bandwidth, then the
+// service history recorded in their hub metrics.
+func (s *ContentDistributionService) rankHighPerformanceNodes(ctx context.Context, nodes []rewardstypes.Node) ([]rewardstypes.Node, error) {
+	metrics := make(map[string]rewardstypes.HubMetrics, len(nodes))
+	for _, node := range nodes {
+		m, err := s.keeper.rewardsKeeper.GetHubMetrics(ctx, node.NodeId)
+		if err != nil {
+			return nil, err
+		}
+		metrics[node.NodeId] = m
 	}
 
-	if targetReplicas > uint32(len(nodes)) {
-		targetReplicas = uint32(len(nodes))
-	}
+	ranked := append([]rewardstypes.Node(nil), nodes...)
+	sort.SliceStable(ranked, func(i, j int) bool {
+		a, b := ranked[i], ranked[j]
+		if a.UptimePercentage != b.UptimePercentage {
+			return a.UptimePercentage > b.UptimePercentage
+		}
+		if a.BandwidthMbps != b.BandwidthMbps {
+			return a.BandwidthMbps > b.BandwidthMbps
+		}
+		ma, mb := metrics[a.NodeId], metrics[b.NodeId]
+		if ma.UptimeSeconds != mb.UptimeSeconds {
+			return ma.UptimeSeconds > mb.UptimeSeconds
+		}
+		if ma.DataServedGb != mb.DataServedGb {
+			return ma.DataServedGb > mb.DataServedGb
+		}
+		// Prefer the less loaded node
+		return ma.ActiveAllocations < mb.ActiveAllocations
+	})
+	return ranked, nil
+}
 
-	return nodes[:targetReplicas], nil
+// rankRandomNodes shuffles nodes with a seed derived from the block hash and
+// the content id, so every validator computes the same permutation.
+func (s *ContentDistributionService) rankRandomNodes(ctx context.Context, contentId string, nodes []rewardstypes.Node) []rewardstypes.Node {
+	h := sha256.New()
+	h.Write(sdk.UnwrapSDKContext(ctx).HeaderHash())
+	h.Write([]byte(contentId))
+	var seed [32]byte
+	copy(seed[:], h.Sum(nil))
+
+	ranked := append([]rewardstypes.Node(nil), nodes...)
+	rng := mrand.New(mrand.NewChaCha8(seed))
+	rng.Shuffle(len(ranked), func(i, j int) { ranked[i], ranked[j] = ranked[j], ranked[i] })
+	return ranked
 }
 
-func (s *ContentDistributionService) selectRandomNodes(ctx context.Context, targetReplicas uint32) ([]string, error) {
-	// Mock implementation - would randomly select from available nodes
-	nodes := []string{
-		"node_random_1", "node_random_2", "node_random_3",
-		"node_random_4", "node_random_5", "node_random_6",
+// supportsContentType reports whether a node accepts content of the given type.
+// Nodes that do not declare any content type accept every type.
+func supportsContentType(node rewardstypes.Node, contentType string) bool {
+	if len(node.SupportedContentTypes) == 0 || contentType == "" {
+		return true
 	}
-
-	if targetReplicas > uint32(len(nodes)) {
-		targetReplicas = uint32(len(nodes))
+	for _, supported := range node.SupportedContentTypes {
+		if strings.EqualFold(supported, contentType) {
+			return true
+		}
 	}
-
-	return nodes[:targetReplicas], nil
+	return false
 }
 
-// IPFS Integration Interface (for future implementation)
+// IPFSClient stores content off-chain. Implementations live in resist/x/posts/ipfs
+// and are selected through the [ipfs] section of app.toml.
 type IPFSClient interface {
 	Add(data []byte) (string, error)
 	Get(hash string) ([]byte, error)
@@ -0,0 +1,74 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"resist/x/posts/keeper"
+	"resist/x/posts/types"
+	rewardstypes "resist/x/rewards/types"
+)
+
+func TestSelectReplicationNodes(t *testing.T) {
+	f := initFixture(t)
+	svc := keeper.NewContentDistributionService(&f.keeper)
+
+	for _, node := range []rewardstypes.Node{
+		{NodeId: "eu-1", Location: "eu", IsActive: true, UptimePercentage: 99, BandwidthMbps: 100},
+		{NodeId: "eu-2", Location: "eu", IsActive: true, UptimePercentage: 100, BandwidthMbps: 50},
+		{NodeId: "us-1", Location: "us", IsActive: true, UptimePercentage: 100, BandwidthMbps: 50},
+		{NodeId: "asia-1", Location: "asia", IsActive: true, UptimePercentage: 90, BandwidthMbps: 1000, SupportedContentTypes: []string{"video"}},
+		{NodeId: "down-1", Location: "africa", IsActive: false, UptimePercentage: 100},
+	} {
+		f.rewardsKeeper.nodes[node.NodeId] = node
+	}
+	f.rewardsKeeper.metrics["us-1"] = rewardstypes.HubMetrics{NodeId: "us-1", UptimeSeconds: 1000}
+
+	t.Run("geographic", func(t *testing.T) {
+		nodes, err := svc.SelectReplicationNodes(f.ctx, "c", "video", "geographic", 3, nil)
+		require.NoError(t, err)
+		require.Equal(t, []string{"asia-1", "eu-1", "us-1"}, nodes)
+	})
+
+	t.Run("geographic skips covered locations", func(t *testing.T) {
+		nodes, err := svc.SelectReplicationNodes(f.ctx, "c", "text", "geographic", 2, []string{"eu-2"})
+		require.NoError(t, err)
+		require.Equal(t, []string{"eu-2", "us-1"}, nodes)
+	})
+
+	t.Run("performance", func(t *testing.T) {
+		nodes, err := svc.SelectReplicationNodes(f.ctx, "c", "text", "performance", 3, nil)
+		require.NoError(t, err)
+		require.Equal(t, []string{"us-1", "eu-2", "eu-1"}, nodes)
+	})
+
+	t.Run("random is deterministic", func(t *testing.T) {
+		first, err := svc.SelectReplicationNodes(f.ctx, "c", "text", "random", 2, nil)
+		require.NoError(t, err)
+		require.Len(t, first, 2)
+		second, err := svc.SelectReplicationNodes(f.ctx, "c", "text", "random", 2, nil)
+		require.NoError(t, err)
+		require.Equal(t, first, second)
+		require.NotContains(t, first, "asia-1")
+		require.NotContains(t, first, "down-1")
+	})
+
+	t.Run("fewer nodes than replicas", func(t *testing.T) {
+		nodes, err := svc.SelectReplicationNodes(f.ctx, "c", "video", "performance", 10, nil)
+		require.NoError(t, err)
+		require.Len(t, nodes, 4)
+	})
+
+	t.Run("ineligible preferred node", func(t *testing.T) {
+		_, err := svc.SelectReplicationNodes(f.ctx, "c", "text", "geographic", 3, []string{"down-1"})
+		require.ErrorIs(t, err, types.ErrInvalidInput)
+		_, err = svc.SelectReplicationNodes(f.ctx, "c", "text", "geographic", 3, []string{"asia-1"})
+		require.ErrorIs(t, err, types.ErrInvalidInput)
+	})
+
+	t.Run("unknown strategy", func(t *testing.T) {
+		_, err := svc.SelectReplicationNodes(f.ctx, "c", "text", "closest", 3, nil)
+		require.ErrorIs(t, err, types.ErrInvalidInput)
+	})
+}
@@ -0,0 +1,29 @@
+package keeper
+
+import "sync"
+
+// distributedContent holds content bytes by CID outside of the state. It is
+// written while finalizing blocks, possibly optimistically, and read once
+// they are committed.
+type distributedContent struct {
+	mu      sync.Mutex
+	content map[string][]byte
+}
+
+func newDistributedContent() *distributedContent {
+	return &distributedContent{content: make(map[string][]byte)}
+}
+
+func (c *distributedContent) put(cid string, data []byte) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.content[cid] = data
+}
+
+func (c *distributedContent) take() map[string][]byte {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	content := c.content
+	c.content = make(map[string][]byte)
+	return content
+}
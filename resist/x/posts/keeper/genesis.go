@@ -3,6 +3,8 @@ package keeper
 import (
 	"context"
 
+	"cosmossdk.io/collections"
+
 	"resist/x/posts/types"
 )
 
@@ -12,6 +14,41 @@ func (k Keeper) InitGenesis(ctx context.Context, genState types.GenesisState) er
 		if err := k.SocialPost.Set(ctx, elem.Index, elem); err != nil {
 			return err
 		}
+		if elem.ParentIndex != "" {
+			key, err := replyKey(elem)
+			if err != nil {
+				return err
+			}
+			if err := k.Reply.Set(ctx, key); err != nil {
+				return err
+			}
+		}
+		if err := k.indexPostSources(ctx, elem); err != nil {
+			return err
+		}
+	}
+	for _, elem := range genState.DeletedPostMap {
+		if err := k.DeletedPost.Set(ctx, elem.Index, elem); err != nil {
+			return err
+		}
+		// Tombstones only stay in their thread while they have replies
+		if elem.ParentIndex != "" && elem.ReplyCount > 0 {
+			key, err := replyKey(elem)
+			if err != nil {
+				return err
+			}
+			if err := k.Reply.Set(ctx, key); err != nil {
+				return err
+			}
+		}
+	}
+	if err := k.PostSeq.Set(ctx, genState.SocialPostCount); err != nil {
+		return err
+	}
+	for _, elem := range genState.PostAliasMap {
+		if err := k.PostAlias.Set(ctx, elem.OldIndex, elem.Index); err != nil {
+			return err
+		}
 	}
 	for _, elem := range genState.VoteMap {
 		if err := k.Vote.Set(ctx, elem.Index, elem); err != nil {
@@ -23,11 +60,57 @@ func (k Keeper) InitGenesis(ctx context.Context, genState types.GenesisState) er
 			return err
 		}
 	}
+	for _, elem := range genState.SourceVoteList {
+		if err := k.SourceVote.Set(ctx, collections.Join(elem.SourceIndex, elem.Voter), elem); err != nil {
+			return err
+		}
+	}
 	for _, elem := range genState.PostTagMap {
 		if err := k.PostTag.Set(ctx, elem.Index, elem); err != nil {
 			return err
 		}
 	}
+	for _, elem := range genState.ContentDistributionMap {
+		if err := k.ContentDistribution.Set(ctx, elem.ContentId, elem); err != nil {
+			return err
+		}
+	}
+	for _, elem := range genState.ReplicaAssignmentList {
+		if err := k.SetReplicaAssignment(ctx, elem); err != nil {
+			return err
+		}
+	}
+	for _, elem := range genState.StorageChallengeList {
+		if err := k.SetStorageChallenge(ctx, elem); err != nil {
+			return err
+		}
+	}
+	if err := k.StorageChallengeSeq.Set(ctx, genState.StorageChallengeCount); err != nil {
+		return err
+	}
+	for _, elem := range genState.HubSyncList {
+		if err := k.SetHubSync(ctx, elem); err != nil {
+			return err
+		}
+	}
+	if err := k.HubSyncSeq.Set(ctx, genState.HubSyncCount); err != nil {
+		return err
+	}
+	for _, elem := range genState.PrekeyBundleMap {
+		if err := k.PrekeyBundle.Set(ctx, elem.NodeId, elem); err != nil {
+			return err
+		}
+	}
+	for _, elem := range genState.OneTimePrekeyList {
+		if err := k.OneTimePrekey.Set(ctx, collections.Join(elem.NodeId, elem.Id), elem); err != nil {
+			return err
+		}
+	}
+	for _, elem := range genState.SignalMessageList {
+		if err := k.QueueSignalMessage(ctx, elem); err != nil {
+			return err
+		}
+	}
 
 	return k.Params.Set(ctx, genState.Params)
 }
@@ -47,6 +130,22 @@ func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error)
 	}); err != nil {
 		return nil, err
 	}
+	if err := k.DeletedPost.Walk(ctx, nil, func(_ string, val types.SocialPost) (stop bool, err error) {
+		genesis.DeletedPostMap = append(genesis.DeletedPostMap, val)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+	genesis.SocialPostCount, err = k.PostSeq.Peek(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.PostAlias.Walk(ctx, nil, func(oldIndex string, index string) (stop bool, err error) {
+		genesis.PostAliasMap = append(genesis.PostAliasMap, types.PostAlias{OldIndex: oldIndex, Index: index})
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
 	if err := k.Vote.Walk(ctx, nil, func(_ string, val types.Vote) (stop bool, err error) {
 		genesis.VoteMap = append(genesis.VoteMap, val)
 		return false, nil
@@ -59,12 +158,68 @@ func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error)
 	}); err != nil {
 		return nil, err
 	}
+	if err := k.SourceVote.Walk(ctx, nil, func(_ collections.Pair[string, string], val types.SourceVote) (stop bool, err error) {
+		genesis.SourceVoteList = append(genesis.SourceVoteList, val)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
 	if err := k.PostTag.Walk(ctx, nil, func(_ string, val types.PostTag) (stop bool, err error) {
 		genesis.PostTagMap = append(genesis.PostTagMap, val)
 		return false, nil
 	}); err != nil {
 		return nil, err
 	}
+	if err := k.ContentDistribution.Walk(ctx, nil, func(_ string, val types.ContentDistribution) (stop bool, err error) {
+		genesis.ContentDistributionMap = append(genesis.ContentDistributionMap, val)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+	if err := k.ReplicaAssignment.Walk(ctx, nil, func(_ collections.Pair[string, string], val types.ReplicaAssignment) (stop bool, err error) {
+		genesis.ReplicaAssignmentList = append(genesis.ReplicaAssignmentList, val)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+	if err := k.StorageChallenge.Walk(ctx, nil, func(_ uint64, val types.StorageChallenge) (stop bool, err error) {
+		genesis.StorageChallengeList = append(genesis.StorageChallengeList, val)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+	genesis.StorageChallengeCount, err = k.StorageChallengeSeq.Peek(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.HubSync.Walk(ctx, nil, func(_ string, val types.HubSync) (stop bool, err error) {
+		genesis.HubSyncList = append(genesis.HubSyncList, val)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+	genesis.HubSyncCount, err = k.HubSyncSeq.Peek(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.PrekeyBundle.Walk(ctx, nil, func(_ string, val types.PrekeyBundle) (stop bool, err error) {
+		genesis.PrekeyBundleMap = append(genesis.PrekeyBundleMap, val)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+	if err := k.OneTimePrekey.Walk(ctx, nil, func(_ collections.Pair[string, uint32], val types.OneTimePrekey) (stop bool, err error) {
+		genesis.OneTimePrekeyList = append(genesis.OneTimePrekeyList, val)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+	if err := k.Mailbox.Walk(ctx, nil, func(_ collections.Pair[string, string], val types.SignalMessage) (stop bool, err error) {
+		genesis.SignalMessageList = append(genesis.SignalMessageList, val)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
 
 	return genesis, nil
 }
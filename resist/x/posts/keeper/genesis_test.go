@@ -5,13 +5,26 @@ import (
 
 	"resist/x/posts/types"
 
+	"cosmossdk.io/collections"
 	"github.com/stretchr/testify/require"
 )
 
 func TestGenesis(t *testing.T) {
 	genesisState := types.GenesisState{
 		Params:        types.DefaultParams(),
-		SocialPostMap: []types.SocialPost{{Index: "0"}, {Index: "1"}}, VoteMap: []types.Vote{{Index: "0"}, {Index: "1"}}, SourceMap: []types.Source{{Index: "0"}, {Index: "1"}}, PostTagMap: []types.PostTag{{Index: "0"}, {Index: "1"}}}
+		SocialPostMap: []types.SocialPost{{Index: "0", ReplyCount: 2}, {Index: "1", ParentIndex: "0", RootIndex: "0", SourceIndexes: []string{"1"}}}, SocialPostCount: 4, PostAliasMap: []types.PostAlias{{OldIndex: "1-1-creator", Index: "1"}}, VoteMap: []types.Vote{{Index: "0"}, {Index: "1"}}, SourceMap: []types.Source{{Index: "0"}, {Index: "1"}}, PostTagMap: []types.PostTag{{Index: "0"}, {Index: "1"}},
+		DeletedPostMap:         []types.SocialPost{{Index: "2", ParentIndex: "0", RootIndex: "0", ReplyCount: 1}},
+		SourceVoteList:         []types.SourceVote{{SourceIndex: "0", Voter: "voter", VoteType: "upvote"}},
+		ContentDistributionMap: []types.ContentDistribution{{ContentId: "0", IpfsHash: types.NewCID(types.RawCodec, []byte("0"))}, {ContentId: "1", IpfsHash: types.NewCID(types.RawCodec, []byte("1"))}},
+		ReplicaAssignmentList:  []types.ReplicaAssignment{{ContentId: "0", NodeId: "node-0", Status: types.ReplicaStatusPending, AckDeadline: 10}, {ContentId: "0", NodeId: "node-1", Status: types.ReplicaStatusStored}},
+		StorageChallengeList:   []types.StorageChallenge{{Id: 0, ContentId: "0", NodeId: "node-1", Status: types.ChallengeStatusPending, DeadlineHeight: 5}, {Id: 1, ContentId: "0", NodeId: "node-1", Status: types.ChallengeStatusPassed}},
+		StorageChallengeCount:  2,
+		HubSyncList:            []types.HubSync{{SyncId: "sync_0", SourceNode: "node-0", TargetNode: "node-1", Status: types.HubSyncStatusSyncing}},
+		HubSyncCount:           1,
+		PrekeyBundleMap:        []types.PrekeyBundle{{NodeId: "node-0", IdentityKey: make([]byte, 32), SignedPrekey: make([]byte, 32)}},
+		OneTimePrekeyList:      []types.OneTimePrekey{{NodeId: "node-0", Id: 1, Key: make([]byte, 32)}, {NodeId: "node-0", Id: 2, Key: make([]byte, 32)}},
+		SignalMessageList:      []types.SignalMessage{{MessageId: "msg_0", SenderNode: "node-1", RecipientNode: "node-0", ExpiryHeight: 20}},
+	}
 
 	f := initFixture(t)
 	err := f.keeper.InitGenesis(f.ctx, genesisState)
@@ -22,8 +35,50 @@ func TestGenesis(t *testing.T) {
 
 	require.EqualExportedValues(t, genesisState.Params, got.Params)
 	require.EqualExportedValues(t, genesisState.SocialPostMap, got.SocialPostMap)
+	isReply, err := f.keeper.Reply.Has(f.ctx, collections.Join("0", uint64(1)))
+	require.NoError(t, err)
+	require.True(t, isReply)
+	require.EqualExportedValues(t, genesisState.DeletedPostMap, got.DeletedPostMap)
+	isReply, err = f.keeper.Reply.Has(f.ctx, collections.Join("0", uint64(2)))
+	require.NoError(t, err)
+	require.True(t, isReply)
+	require.Equal(t, genesisState.SocialPostCount, got.SocialPostCount)
+	require.EqualExportedValues(t, genesisState.PostAliasMap, got.PostAliasMap)
 	require.EqualExportedValues(t, genesisState.VoteMap, got.VoteMap)
 	require.EqualExportedValues(t, genesisState.SourceMap, got.SourceMap)
+	require.EqualExportedValues(t, genesisState.SourceVoteList, got.SourceVoteList)
+	cited, err := f.keeper.SourcePost.Has(f.ctx, collections.Join(collections.Join("1", uint64(0)), "1"))
+	require.NoError(t, err)
+	require.True(t, cited)
 	require.EqualExportedValues(t, genesisState.PostTagMap, got.PostTagMap)
+	require.EqualExportedValues(t, genesisState.ContentDistributionMap, got.ContentDistributionMap)
+	require.EqualExportedValues(t, genesisState.ReplicaAssignmentList, got.ReplicaAssignmentList)
+	require.EqualExportedValues(t, genesisState.StorageChallengeList, got.StorageChallengeList)
+	require.Equal(t, genesisState.StorageChallengeCount, got.StorageChallengeCount)
+	require.EqualExportedValues(t, genesisState.HubSyncList, got.HubSyncList)
+	require.Equal(t, genesisState.HubSyncCount, got.HubSyncCount)
+	require.EqualExportedValues(t, genesisState.PrekeyBundleMap, got.PrekeyBundleMap)
+	require.EqualExportedValues(t, genesisState.OneTimePrekeyList, got.OneTimePrekeyList)
+	require.EqualExportedValues(t, genesisState.SignalMessageList, got.SignalMessageList)
+
+	// Pending entries are indexed by deadline
+	has, err := f.keeper.ReplicaDeadline.Has(f.ctx, collections.Join3(int64(10), "0", "node-0"))
+	require.NoError(t, err)
+	require.True(t, has)
+	has, err = f.keeper.StorageChallengeDeadline.Has(f.ctx, collections.Join(int64(5), uint64(0)))
+	require.NoError(t, err)
+	require.True(t, has)
+	pendingId, err := f.keeper.PendingStorageChallenge.Get(f.ctx, collections.Join("0", "node-1"))
+	require.NoError(t, err)
+	require.Zero(t, pendingId)
+	has, err = f.keeper.HubSyncByNode.Has(f.ctx, collections.Join("node-1", "sync_0"))
+	require.NoError(t, err)
+	require.True(t, has)
+	has, err = f.keeper.MailboxExpiry.Has(f.ctx, collections.Join3(int64(20), "node-0", "msg_0"))
+	require.NoError(t, err)
+	require.True(t, has)
+	count, err := f.keeper.MailboxSenderCount.Get(f.ctx, collections.Join("node-0", "node-1"))
+	require.NoError(t, err)
+	require.EqualValues(t, 1, count)
 
 }
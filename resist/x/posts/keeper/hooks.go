@@ -0,0 +1,80 @@
+package keeper
+
+import (
+	"context"
+	"slices"
+	"strconv"
+
+	"cosmossdk.io/collections"
+
+	identitytypes "resist/x/identity/types"
+	"resist/x/posts/types"
+	usergroupstypes "resist/x/usergroups/types"
+)
+
+var (
+	_ identitytypes.IdentityHooks        = Hooks{}
+	_ usergroupstypes.ContentReportHooks = Hooks{}
+)
+
+// Hooks re-keys the posts authored by the identities moved by x/identity, and
+// follows the outcome of the reports on posts filed in x/usergroups.
+type Hooks struct {
+	k Keeper
+}
+
+// Hooks returns the identity and content report hooks of the posts module.
+func (k Keeper) Hooks() Hooks {
+	return Hooks{k}
+}
+
+// AfterIdentityMigrated moves the authorship of the posts of oldAddress to
+// newAddress. The posts are found through the author and creator indexes.
+func (h Hooks) AfterIdentityMigrated(ctx context.Context, oldAddress, newAddress string) error {
+	var indexes []string
+	prefix := collections.NewPrefixedPairRange[collections.Pair[string, uint64], string](collections.PairPrefix[string, uint64](oldAddress))
+	if err := h.k.socialPostViews.author.Walk(ctx, prefix, func(key collections.Pair[collections.Pair[string, uint64], string]) (bool, error) {
+		indexes = append(indexes, key.K2())
+		return false, nil
+	}); err != nil {
+		return err
+	}
+	if err := h.k.SocialPost.Indexes.Creator.Walk(ctx, collections.NewPrefixedPairRange[string, string](oldAddress), func(_, index string) (bool, error) {
+		indexes = append(indexes, index)
+		return false, nil
+	}); err != nil {
+		return err
+	}
+	slices.Sort(indexes)
+
+	var posts []types.SocialPost
+	for _, index := range slices.Compact(indexes) {
+		post, err := h.k.SocialPost.Get(ctx, index)
+		if err != nil {
+			return err
+		}
+		posts = append(posts, post)
+	}
+
+	for _, post := range posts {
+		if post.Author == oldAddress {
+			post.Author = newAddress
+		}
+		if post.Creator == oldAddress {
+			post.Creator = newAddress
+		}
+		if err := h.k.SocialPost.Set(ctx, post.Index, post); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AfterContentReportStatusChanged updates the credibility of the sources
+// cited by the reported post when the report gets or loses an outcome.
+func (h Hooks) AfterContentReportStatusChanged(ctx context.Context, postId uint64, oldStatus, newStatus string) error {
+	if !isReportOutcome(oldStatus) && !isReportOutcome(newStatus) {
+		return nil
+	}
+	return h.k.recordReportOutcome(ctx, strconv.FormatUint(postId, 10), oldStatus, newStatus)
+}
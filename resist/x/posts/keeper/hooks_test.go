@@ -0,0 +1,86 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/collections"
+	"github.com/stretchr/testify/require"
+
+	"resist/x/posts/keeper"
+	"resist/x/posts/types"
+)
+
+func TestAfterIdentityMigrated(t *testing.T) {
+	f := initFixture(t)
+	hooks := f.keeper.Hooks()
+
+	posts := []types.SocialPost{
+		{Index: "0", Author: "old", Creator: "old"},
+		{Index: "1", Author: "other", Creator: "old"},
+		{Index: "2", Author: "other", Creator: "other"},
+	}
+	for _, post := range posts {
+		require.NoError(t, f.keeper.SocialPost.Set(f.ctx, post.Index, post))
+	}
+
+	require.NoError(t, hooks.AfterIdentityMigrated(f.ctx, "old", "new"))
+
+	for _, expected := range []types.SocialPost{
+		{Index: "0", Author: "new", Creator: "new"},
+		{Index: "1", Author: "other", Creator: "new"},
+		{Index: "2", Author: "other", Creator: "other"},
+	} {
+		post, err := f.keeper.SocialPost.Get(f.ctx, expected.Index)
+		require.NoError(t, err)
+		require.Equal(t, expected, post)
+	}
+}
+
+func TestAfterContentReportStatusChanged(t *testing.T) {
+	f := initFixture(t)
+	hooks := f.keeper.Hooks()
+
+	creator, err := f.addressCodec.BytesToString([]byte("creator_____________________"))
+	require.NoError(t, err)
+	require.NoError(t, f.keeper.Source.Set(f.ctx, "src", types.Source{Index: "src", CredibilityScore: 50}))
+	post := types.SocialPost{Index: "7", Creator: creator, GroupId: 3, SourceIndexes: []string{"src", "deleted"}}
+	require.NoError(t, f.keeper.SocialPost.Set(f.ctx, post.Index, post))
+
+	for _, tc := range []struct {
+		oldStatus, newStatus string
+		resolved, dismissed  uint64
+		score                int64
+	}{
+		{"", "pending", 0, 0, 50},
+		{"pending", "resolved", 1, 0, 42},
+		{"resolved", "dismissed", 0, 1, 50},
+		{"dismissed", "", 0, 0, 50},
+	} {
+		require.NoError(t, hooks.AfterContentReportStatusChanged(f.ctx, 7, tc.oldStatus, tc.newStatus))
+		source, err := f.keeper.Source.Get(f.ctx, "src")
+		require.NoError(t, err)
+		require.Equal(t, tc.resolved, source.ResolvedReports)
+		require.Equal(t, tc.dismissed, source.DismissedReports)
+		require.Equal(t, tc.score, source.CredibilityScore)
+	}
+
+	// The outcomes of the reports on deleted posts still count
+	require.NoError(t, hooks.AfterContentReportStatusChanged(f.ctx, 7, "", "resolved"))
+	_, err = keeper.NewMsgServerImpl(f.keeper).DeleteSocialPost(f.ctx, &types.MsgDeleteSocialPost{Creator: creator, Index: "7"})
+	require.NoError(t, err)
+	groupId, found, err := f.keeper.GetPostGroup(f.ctx, 7)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.EqualValues(t, 3, groupId)
+	require.NoError(t, hooks.AfterContentReportStatusChanged(f.ctx, 7, "resolved", "dismissed"))
+	source, err := f.keeper.Source.Get(f.ctx, "src")
+	require.NoError(t, err)
+	require.Zero(t, source.ResolvedReports)
+	require.EqualValues(t, 1, source.DismissedReports)
+	cited, err := f.keeper.SourcePost.Has(f.ctx, collections.Join(collections.Join("src", uint64(0)), "7"))
+	require.NoError(t, err)
+	require.False(t, cited)
+
+	// Reports on unknown posts are ignored
+	require.NoError(t, hooks.AfterContentReportStatusChanged(f.ctx, 8, "", "resolved"))
+}
@@ -0,0 +1,20 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+
+	"resist/x/posts/types"
+)
+
+// SetHubSync stores a hub sync and indexes it under its source and target nodes.
+func (k Keeper) SetHubSync(ctx context.Context, hubSync types.HubSync) error {
+	if err := k.HubSyncByNode.Set(ctx, collections.Join(hubSync.SourceNode, hubSync.SyncId)); err != nil {
+		return err
+	}
+	if err := k.HubSyncByNode.Set(ctx, collections.Join(hubSync.TargetNode, hubSync.SyncId)); err != nil {
+		return err
+	}
+	return k.HubSync.Set(ctx, hubSync.SyncId, hubSync)
+}
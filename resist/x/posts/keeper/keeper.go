@@ -18,13 +18,77 @@ type Keeper struct {
 	// Address capable of executing a MsgUpdateParams message.
 	// Typically, this should be the x/gov module account.
 	authority []byte
+	// ipfsClient holds distributed content off-chain. It is optional and never
+	// influences state transitions.
+	ipfsClient IPFSClient
+	// distributedContent holds the content distributed in the block being
+	// finalized until the IPFS backend stores it, after the block is
+	// committed.
+	distributedContent *distributedContent
 
-	Schema     collections.Schema
-	Params     collections.Item[types.Params]
-	SocialPost collections.Map[string, types.SocialPost]
-	Vote       collections.Map[string, types.Vote]
-	Source     collections.Map[string, types.Source]
+	rewardsKeeper  types.RewardsKeeper
+	identityKeeper types.IdentityKeeper
+
+	Schema collections.Schema
+	Params collections.Item[types.Params]
+	// SocialPost is keyed by post index and indexed by author, group and
+	// creation time.
+	SocialPost *collections.IndexedMap[string, types.SocialPost, SocialPostIndexes]
+	// socialPostViews paginate over the indexes of SocialPost.
+	socialPostViews socialPostIndexViews
+	Vote            collections.Map[string, types.Vote]
+	Source          collections.Map[string, types.Source]
+	// SourcePost indexes the posts citing a source by
+	// ((source index, created at), post index).
+	SourcePost collections.KeySet[collections.Pair[collections.Pair[string, uint64], string]]
+	// SourceVote is keyed by (source index, voter).
+	SourceVote collections.Map[collections.Pair[string, string], types.SourceVote]
 	PostTag    collections.Map[string, types.PostTag]
+	// PostSeq assigns the index of new posts.
+	PostSeq collections.Sequence
+	// PostAlias maps the index a post had before posts were indexed by
+	// PostSeq to its current index.
+	PostAlias collections.Map[string, string]
+	// Reply indexes replies by (parent index, reply index), the reply index
+	// being numeric so that replies are iterated in creation order.
+	Reply collections.KeySet[collections.Pair[string, uint64]]
+	// DeletedPost keeps the tombstones of the deleted posts which have replies
+	// or cite sources.
+	DeletedPost collections.Map[string, types.SocialPost]
+	// ContentDistribution is keyed by content id.
+	ContentDistribution collections.Map[string, types.ContentDistribution]
+	// ReplicaAssignment is keyed by (content id, node id).
+	ReplicaAssignment collections.Map[collections.Pair[string, string], types.ReplicaAssignment]
+	// ReplicaDeadline indexes pending replica assignments by (ack deadline, content id, node id).
+	ReplicaDeadline collections.KeySet[collections.Triple[int64, string, string]]
+	// StoredReplica indexes stored replica assignments by (key hash, content id, node id).
+	StoredReplica collections.KeySet[collections.Triple[uint64, string, string]]
+	// StorageChallenge is keyed by a sequential id.
+	StorageChallenge    collections.Map[uint64, types.StorageChallenge]
+	StorageChallengeSeq collections.Sequence
+	// StorageChallengeDeadline indexes pending storage challenges by (deadline height, id).
+	StorageChallengeDeadline collections.KeySet[collections.Pair[int64, uint64]]
+	// PendingStorageChallenge indexes the id of the pending storage challenge
+	// of each replica by (content id, node id).
+	PendingStorageChallenge collections.Map[collections.Pair[string, string], uint64]
+	// HubSync is keyed by sync id.
+	HubSync    collections.Map[string, types.HubSync]
+	HubSyncSeq collections.Sequence
+	// HubSyncByNode indexes hub syncs by (node id, sync id) for both the source and target node.
+	HubSyncByNode collections.KeySet[collections.Pair[string, string]]
+	// PrekeyBundle is keyed by node id.
+	PrekeyBundle collections.Map[string, types.PrekeyBundle]
+	// OneTimePrekey is keyed by (node id, prekey id).
+	OneTimePrekey collections.Map[collections.Pair[string, uint32], types.OneTimePrekey]
+	// Mailbox is keyed by (recipient node id, message id).
+	Mailbox collections.Map[collections.Pair[string, string], types.SignalMessage]
+	// MailboxExpiry indexes queued messages by (expiry height, recipient node id, message id).
+	MailboxExpiry collections.KeySet[collections.Triple[int64, string, string]]
+	// MailboxSize is the size in bytes of the messages queued for each node.
+	MailboxSize collections.Map[string, uint64]
+	// MailboxSenderCount is the number of messages queued for each node by
+	// each sender node, keyed by (recipient node id, sender node id).
+	MailboxSenderCount collections.Map[collections.Pair[string, string], uint64]
 }
 
 func NewKeeper(
@@ -33,6 +97,8 @@ func NewKeeper(
 	addressCodec address.Codec,
 	authority []byte,
 
+	rewardsKeeper types.RewardsKeeper,
+	identityKeeper types.IdentityKeeper,
 ) Keeper {
 	if _, err := addressCodec.BytesToString(authority); err != nil {
 		panic(fmt.Sprintf("invalid authority address %s: %s", authority, err))
@@ -46,11 +112,47 @@ func NewKeeper(
 		addressCodec: addressCodec,
 		authority:    authority,
 
+		rewardsKeeper:  rewardsKeeper,
+		identityKeeper: identityKeeper,
+
+		socialPostViews:    newSocialPostIndexViews(storeService),
+		distributedContent: newDistributedContent(),
+
 		Params:     collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
-		SocialPost: collections.NewMap(sb, types.SocialPostKey, "socialPost", collections.StringKey, codec.CollValue[types.SocialPost](cdc)),
+		SocialPost: collections.NewIndexedMap(sb, types.SocialPostKey, "socialPost", collections.StringKey, codec.CollValue[types.SocialPost](cdc), newSocialPostIndexes(sb)),
 		Vote:       collections.NewMap(sb, types.VoteKey, "vote", collections.StringKey, codec.CollValue[types.Vote](cdc)),
 		Source:     collections.NewMap(sb, types.SourceKey, "source", collections.StringKey, codec.CollValue[types.Source](cdc)),
+		SourcePost: collections.NewKeySet(sb, types.SourcePostKey, "sourcePost", collections.PairKeyCodec(collections.PairKeyCodec(collections.StringKey, collections.Uint64Key), collections.StringKey)),
+		SourceVote: collections.NewMap(sb, types.SourceVoteKey, "sourceVote", collections.PairKeyCodec(collections.StringKey, collections.StringKey), codec.CollValue[types.SourceVote](cdc)),
 		PostTag:    collections.NewMap(sb, types.PostTagKey, "postTag", collections.StringKey, codec.CollValue[types.PostTag](cdc)),
+		Reply:      collections.NewKeySet(sb, types.ReplyKey, "reply", collections.PairKeyCodec(collections.StringKey, collections.Uint64Key)),
+		PostSeq:    collections.NewSequence(sb, types.SocialPostCountKey, "socialPostSequence"),
+		PostAlias:  collections.NewMap(sb, types.PostAliasKey, "postAlias", collections.StringKey, collections.StringValue),
+
+		DeletedPost: collections.NewMap(sb, types.DeletedPostKey, "deletedPost", collections.StringKey, codec.CollValue[types.SocialPost](cdc)),
+
+		ContentDistribution: collections.NewMap(sb, types.ContentDistributionKey, "contentDistribution", collections.StringKey, codec.CollValue[types.ContentDistribution](cdc)),
+		ReplicaAssignment:   collections.NewMap(sb, types.ReplicaAssignmentKey, "replicaAssignment", collections.PairKeyCodec(collections.StringKey, collections.StringKey), codec.CollValue[types.ReplicaAssignment](cdc)),
+		ReplicaDeadline:     collections.NewKeySet(sb, types.ReplicaDeadlineKey, "replicaDeadline", collections.TripleKeyCodec(collections.Int64Key, collections.StringKey, collections.StringKey)),
+		StoredReplica:       collections.NewKeySet(sb, types.StoredReplicaKey, "storedReplica", collections.TripleKeyCodec(collections.Uint64Key, collections.StringKey, collections.StringKey)),
+
+		StorageChallenge:         collections.NewMap(sb, types.StorageChallengeKey, "storageChallenge", collections.Uint64Key, codec.CollValue[types.StorageChallenge](cdc)),
+		StorageChallengeSeq:      collections.NewSequence(sb, types.StorageChallengeCountKey, "storageChallengeSequence"),
+		StorageChallengeDeadline: collections.NewKeySet(sb, types.StorageChallengeDeadlineKey, "storageChallengeDeadline", collections.PairKeyCodec(collections.Int64Key, collections.Uint64Key)),
+		PendingStorageChallenge:  collections.NewMap(sb, types.PendingStorageChallengeKey, "pendingStorageChallenge", collections.PairKeyCodec(collections.StringKey, collections.StringKey), collections.Uint64Value),
+
+		HubSync:       collections.NewMap(sb, types.HubSyncKey, "hubSync", collections.StringKey, codec.CollValue[types.HubSync](cdc)),
+		HubSyncSeq:    collections.NewSequence(sb, types.HubSyncCountKey, "hubSyncSequence"),
+		HubSyncByNode: collections.NewKeySet(sb, types.HubSyncByNodeKey, "hubSyncByNode", collections.PairKeyCodec(collections.StringKey, collections.StringKey)),
+
+		PrekeyBundle:  collections.NewMap(sb, types.PrekeyBundleKey, "prekeyBundle", collections.StringKey, codec.CollValue[types.PrekeyBundle](cdc)),
+		OneTimePrekey: collections.NewMap(sb, types.OneTimePrekeyKey, "oneTimePrekey", collections.PairKeyCodec(collections.StringKey, collections.Uint32Key), codec.CollValue[types.OneTimePrekey](cdc)),
+
+		Mailbox:       collections.NewMap(sb, types.MailboxKey, "mailbox", collections.PairKeyCodec(collections.StringKey, collections.StringKey), codec.CollValue[types.SignalMessage](cdc)),
+		MailboxExpiry: collections.NewKeySet(sb, types.MailboxExpiryKey, "mailboxExpiry", collections.TripleKeyCodec(collections.Int64Key, collections.StringKey, collections.StringKey)),
+		MailboxSize:   collections.NewMap(sb, types.MailboxSizeKey, "mailboxSize", collections.StringKey, collections.Uint64Value),
+
+		MailboxSenderCount: collections.NewMap(sb, types.MailboxSenderCountKey, "mailboxSenderCount", collections.PairKeyCodec(collections.StringKey, collections.StringKey), collections.Uint64Value),
 	}
 
 	schema, err := sb.Build()
@@ -66,3 +168,19 @@ func NewKeeper(
 func (k Keeper) GetAuthority() []byte {
 	return k.authority
 }
+
+// SetIPFSClient sets the off-chain store used to hold distributed content.
+func (k *Keeper) SetIPFSClient(client IPFSClient) {
+	k.ipfsClient = client
+}
+
+// TakeDistributedContent returns the bytes of the content distributed in the
+// blocks finalized since the last call, by CID, and forgets them.
+func (k Keeper) TakeDistributedContent() map[string][]byte {
+	return k.distributedContent.take()
+}
+
+// IPFSClient returns the off-chain content store, or nil if none is configured.
+func (k Keeper) IPFSClient() IPFSClient {
+	return k.ipfsClient
+}
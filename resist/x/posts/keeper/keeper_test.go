@@ -2,9 +2,11 @@ package keeper_test
 
 import (
 	"context"
+	"sort"
 	"testing"
 
 	"cosmossdk.io/core/address"
+	errorsmod "cosmossdk.io/errors"
 	storetypes "cosmossdk.io/store/types"
 	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
 	"github.com/cosmos/cosmos-sdk/runtime"
@@ -16,12 +18,88 @@ import (
 	"resist/x/posts/keeper"
 	module "resist/x/posts/module"
 	"resist/x/posts/types"
+	rewardstypes "resist/x/rewards/types"
 )
 
 type fixture struct {
-	ctx          context.Context
-	keeper       keeper.Keeper
-	addressCodec address.Codec
+	ctx            context.Context
+	storeKey       *storetypes.KVStoreKey
+	keeper         keeper.Keeper
+	addressCodec   address.Codec
+	rewardsKeeper  *mockRewardsKeeper
+	identityKeeper *mockIdentityKeeper
+}
+
+// mockRewardsKeeper is an in-memory node registry.
+type mockRewardsKeeper struct {
+	nodes   map[string]rewardstypes.Node
+	metrics map[string]rewardstypes.HubMetrics
+}
+
+func newMockRewardsKeeper() *mockRewardsKeeper {
+	return &mockRewardsKeeper{
+		nodes:   make(map[string]rewardstypes.Node),
+		metrics: make(map[string]rewardstypes.HubMetrics),
+	}
+}
+
+func (m *mockRewardsKeeper) GetNode(_ context.Context, nodeId string) (rewardstypes.Node, error) {
+	node, ok := m.nodes[nodeId]
+	if !ok {
+		return rewardstypes.Node{}, errorsmod.Wrapf(rewardstypes.ErrNodeNotFound, "node %s", nodeId)
+	}
+	return node, nil
+}
+
+func (m *mockRewardsKeeper) GetActiveNodes(context.Context) ([]rewardstypes.Node, error) {
+	var nodes []rewardstypes.Node
+	for _, node := range m.nodes {
+		if node.IsActive {
+			nodes = append(nodes, node)
+		}
+	}
+	sort.Slice(nodes, func(i, j int) bool { return nodes[i].NodeId < nodes[j].NodeId })
+	return nodes, nil
+}
+
+func (m *mockRewardsKeeper) GetHubMetrics(_ context.Context, nodeId string) (rewardstypes.HubMetrics, error) {
+	return m.metrics[nodeId], nil
+}
+
+func (m *mockRewardsKeeper) RecordStorageAudit(ctx context.Context, nodeId string, passed bool, uptimePenalty uint64) error {
+	node, err := m.GetNode(ctx, nodeId)
+	if err != nil {
+		return err
+	}
+	metrics := m.metrics[nodeId]
+	if passed {
+		metrics.StorageAuditsPassed++
+	} else {
+		metrics.StorageAuditsFailed++
+		node.UptimePercentage -= min(node.UptimePercentage, uptimePenalty)
+		m.nodes[nodeId] = node
+	}
+	m.metrics[nodeId] = metrics
+	return nil
+}
+
+// mockIdentityKeeper is an in-memory set of (blocker, blocked) pairs and of
+// attestation issuers.
+type mockIdentityKeeper struct {
+	blocks  map[[2]string]bool
+	issuers map[string]bool
+}
+
+func newMockIdentityKeeper() *mockIdentityKeeper {
+	return &mockIdentityKeeper{blocks: make(map[[2]string]bool), issuers: make(map[string]bool)}
+}
+
+func (m *mockIdentityKeeper) IsBlocked(_ context.Context, blocker, blocked string) (bool, error) {
+	return m.blocks[[2]string{blocker, blocked}], nil
+}
+
+func (m *mockIdentityKeeper) IsIssuer(_ context.Context, address string) (bool, error) {
+	return m.issuers[address], nil
 }
 
 func initFixture(t *testing.T) *fixture {
@@ -35,12 +113,16 @@ func initFixture(t *testing.T) *fixture {
 	ctx := testutil.DefaultContextWithDB(t, storeKey, storetypes.NewTransientStoreKey("transient_test")).Ctx
 
 	authority := authtypes.NewModuleAddress(types.GovModuleName)
+	rewardsKeeper := newMockRewardsKeeper()
+	identityKeeper := newMockIdentityKeeper()
 
 	k := keeper.NewKeeper(
 		storeService,
 		encCfg.Codec,
 		addressCodec,
 		authority,
+		rewardsKeeper,
+		identityKeeper,
 	)
 
 	// Initialize params
@@ -49,8 +131,11 @@ func initFixture(t *testing.T) *fixture {
 	}
 
 	return &fixture{
-		ctx:          ctx,
-		keeper:       k,
-		addressCodec: addressCodec,
+		ctx:            ctx,
+		storeKey:       storeKey,
+		keeper:         k,
+		addressCodec:   addressCodec,
+		rewardsKeeper:  rewardsKeeper,
+		identityKeeper: identityKeeper,
 	}
 }
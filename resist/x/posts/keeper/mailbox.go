@@ -0,0 +1,108 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"resist/x/posts/types"
+)
+
+// maxExpiredMessagesPerBlock bounds the number of messages pruned by the
+// EndBlocker. Expired messages left over are pruned in the following blocks.
+const maxExpiredMessagesPerBlock = 100
+
+// QueueSignalMessage stores a message in the mailbox of its recipient node
+// until it is acknowledged or expires.
+func (k Keeper) QueueSignalMessage(ctx context.Context, message types.SignalMessage) error {
+	size, err := k.MailboxSize.Get(ctx, message.RecipientNode)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+	if err := k.MailboxSize.Set(ctx, message.RecipientNode, size+uint64(message.Size())); err != nil {
+		return err
+	}
+	senderKey := collections.Join(message.RecipientNode, message.SenderNode)
+	count, err := k.MailboxSenderCount.Get(ctx, senderKey)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+	if err := k.MailboxSenderCount.Set(ctx, senderKey, count+1); err != nil {
+		return err
+	}
+	if err := k.MailboxExpiry.Set(ctx, collections.Join3(message.ExpiryHeight, message.RecipientNode, message.MessageId)); err != nil {
+		return err
+	}
+	return k.Mailbox.Set(ctx, collections.Join(message.RecipientNode, message.MessageId), message)
+}
+
+// RemoveSignalMessage removes a message from the mailbox of its recipient node.
+func (k Keeper) RemoveSignalMessage(ctx context.Context, message types.SignalMessage) error {
+	size, err := k.MailboxSize.Get(ctx, message.RecipientNode)
+	if err != nil {
+		return err
+	}
+	if size -= min(size, uint64(message.Size())); size == 0 {
+		err = k.MailboxSize.Remove(ctx, message.RecipientNode)
+	} else {
+		err = k.MailboxSize.Set(ctx, message.RecipientNode, size)
+	}
+	if err != nil {
+		return err
+	}
+	senderKey := collections.Join(message.RecipientNode, message.SenderNode)
+	count, err := k.MailboxSenderCount.Get(ctx, senderKey)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+	if count <= 1 {
+		err = k.MailboxSenderCount.Remove(ctx, senderKey)
+	} else {
+		err = k.MailboxSenderCount.Set(ctx, senderKey, count-1)
+	}
+	if err != nil {
+		return err
+	}
+	if err := k.MailboxExpiry.Remove(ctx, collections.Join3(message.ExpiryHeight, message.RecipientNode, message.MessageId)); err != nil {
+		return err
+	}
+	return k.Mailbox.Remove(ctx, collections.Join(message.RecipientNode, message.MessageId))
+}
+
+// expireSignalMessages prunes the messages that were not acknowledged before
+// their expiry height.
+func (k Keeper) expireSignalMessages(ctx context.Context) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	height := sdkCtx.BlockHeight()
+
+	var expired []collections.Triple[int64, string, string]
+	if err := k.MailboxExpiry.Walk(ctx, nil, func(key collections.Triple[int64, string, string]) (bool, error) {
+		if key.K1() >= height || len(expired) == maxExpiredMessagesPerBlock {
+			return true, nil
+		}
+		expired = append(expired, key)
+		return false, nil
+	}); err != nil {
+		return err
+	}
+
+	for _, key := range expired {
+		message, err := k.Mailbox.Get(ctx, collections.Join(key.K2(), key.K3()))
+		if err != nil {
+			return err
+		}
+		if err := k.RemoveSignalMessage(ctx, message); err != nil {
+			return err
+		}
+		sdkCtx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				"signal_message_expired",
+				sdk.NewAttribute("message_id", message.MessageId),
+				sdk.NewAttribute("recipient_node", message.RecipientNode),
+			),
+		)
+	}
+	return nil
+}
@@ -0,0 +1,235 @@
+package keeper
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"sort"
+	"strconv"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"resist/x/posts/types"
+)
+
+// Migrator handles the in-place store migrations of the module.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates the store from version 1, which had no params, indexed
+// the posts by height-time-creator or by the client and let the creator of a
+// source set its credibility score.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	if err := m.keeper.Params.Set(ctx, types.DefaultParams()); err != nil {
+		return err
+	}
+	if err := m.migratePosts(ctx); err != nil {
+		return err
+	}
+	if err := m.migrateSources(ctx); err != nil {
+		return err
+	}
+	return m.indexStoredReplicas(ctx)
+}
+
+// migratePosts re-keys the posts with sequential indexes in creation order.
+// The old index of every post is kept as an alias, and the indexes of the
+// posts referenced by threads, votes and tags are updated. Setting the posts
+// back adds them to the indexes by author, creator, group and creation time.
+// The sources cited in the deprecated JSON sources of the posts are moved to
+// their source indexes.
+func (m Migrator) migratePosts(ctx sdk.Context) error {
+	k := m.keeper
+
+	var posts []types.SocialPost
+	if err := k.SocialPost.Walk(ctx, nil, func(_ string, post types.SocialPost) (bool, error) {
+		posts = append(posts, post)
+		return false, nil
+	}); err != nil {
+		return err
+	}
+	sort.SliceStable(posts, func(i, j int) bool {
+		if posts[i].CreatedAt != posts[j].CreatedAt {
+			return posts[i].CreatedAt < posts[j].CreatedAt
+		}
+		return posts[i].Index < posts[j].Index
+	})
+
+	// New indexes may equal old ones, so every post is removed before any is
+	// written back.
+	indexes := make(map[string]string, len(posts))
+	for i, post := range posts {
+		indexes[post.Index] = strconv.Itoa(i)
+		if err := k.SocialPost.Remove(ctx, post.Index); err != nil {
+			return err
+		}
+	}
+	// Clearing deletes the keys of the replies without decoding them
+	if err := k.Reply.Clear(ctx, nil); err != nil {
+		return err
+	}
+	remap := func(index string) string {
+		if newIndex, ok := indexes[index]; ok {
+			return newIndex
+		}
+		return index
+	}
+
+	for i, post := range posts {
+		oldIndex := post.Index
+		post.Index = indexes[oldIndex]
+		post.ParentIndex = remap(post.ParentIndex)
+		post.RootIndex = remap(post.RootIndex)
+		post.QuotedIndex = remap(post.QuotedIndex)
+		sourceIndexes, err := m.legacySourceIndexes(ctx, post.Sources) //nolint:staticcheck // migrating the deprecated field
+		if err != nil {
+			return err
+		}
+		post.SourceIndexes = sourceIndexes
+		post.Sources = "" //nolint:staticcheck // migrating the deprecated field
+		if err := k.SocialPost.Set(ctx, post.Index, post); err != nil {
+			return err
+		}
+		if err := k.indexPostSources(ctx, post); err != nil {
+			return err
+		}
+		if post.ParentIndex != "" {
+			if err := k.Reply.Set(ctx, collections.Join(post.ParentIndex, uint64(i))); err != nil {
+				return err
+			}
+		}
+		if oldIndex != post.Index {
+			if err := k.PostAlias.Set(ctx, oldIndex, post.Index); err != nil {
+				return err
+			}
+		}
+	}
+	if err := k.PostSeq.Set(ctx, uint64(len(posts))); err != nil {
+		return err
+	}
+
+	if err := m.migrateVotes(ctx, remap); err != nil {
+		return err
+	}
+	return m.migratePostTags(ctx, remap)
+}
+
+// legacySourceIndexes returns the distinct existing sources of the JSON array
+// sources, up to types.MaxPostSources. Malformed arrays cite no sources.
+func (m Migrator) legacySourceIndexes(ctx sdk.Context, sources string) ([]string, error) {
+	var indexes []string
+	if err := json.Unmarshal([]byte(sources), &indexes); err != nil {
+		return nil, nil
+	}
+
+	var cited []string
+	for _, index := range indexes {
+		if len(cited) == types.MaxPostSources {
+			break
+		}
+		if slices.Contains(cited, index) {
+			continue
+		}
+		has, err := m.keeper.Source.Has(ctx, index)
+		if err != nil {
+			return nil, err
+		} else if has {
+			cited = append(cited, index)
+		}
+	}
+	return cited, nil
+}
+
+// migrateSources replaces the credibility score of the sources with the score
+// computed from whether they are verified, no votes nor report outcomes being
+// recorded before.
+func (m Migrator) migrateSources(ctx sdk.Context) error {
+	var sources []types.Source
+	if err := m.keeper.Source.Walk(ctx, nil, func(_ string, source types.Source) (bool, error) {
+		sources = append(sources, source)
+		return false, nil
+	}); err != nil {
+		return err
+	}
+	for _, source := range sources {
+		if err := m.keeper.setSource(ctx, source); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// indexStoredReplicas adds the stored replica assignments to the index the
+// storage audits pick replicas from.
+func (m Migrator) indexStoredReplicas(ctx sdk.Context) error {
+	return m.keeper.ReplicaAssignment.Walk(ctx, nil, func(_ collections.Pair[string, string], assignment types.ReplicaAssignment) (bool, error) {
+		if assignment.Status != types.ReplicaStatusStored {
+			return false, nil
+		}
+		return false, m.keeper.StoredReplica.Set(ctx, storedReplicaKey(assignment))
+	})
+}
+
+// migrateVotes updates the post index of the votes, and re-keys the votes
+// keyed by voter:post index by VotePost.
+func (m Migrator) migrateVotes(ctx sdk.Context, remap func(string) string) error {
+	k := m.keeper
+
+	var votes []types.Vote
+	if err := k.Vote.Walk(ctx, nil, func(_ string, vote types.Vote) (bool, error) {
+		votes = append(votes, vote)
+		return false, nil
+	}); err != nil {
+		return err
+	}
+
+	// As for posts, re-keyed votes are all removed before any is written back.
+	for i, vote := range votes {
+		postIndex := remap(vote.PostIndex)
+		if postIndex == vote.PostIndex {
+			continue
+		}
+		if vote.Index == fmt.Sprintf("%s:%s", vote.VoterAddress, vote.PostIndex) {
+			if err := k.Vote.Remove(ctx, vote.Index); err != nil {
+				return err
+			}
+			votes[i].Index = fmt.Sprintf("%s:%s", vote.VoterAddress, postIndex)
+		}
+		votes[i].PostIndex = postIndex
+	}
+	for _, vote := range votes {
+		if err := k.Vote.Set(ctx, vote.Index, vote); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// migratePostTags updates the post index of the tags.
+func (m Migrator) migratePostTags(ctx sdk.Context, remap func(string) string) error {
+	k := m.keeper
+
+	var tags []types.PostTag
+	if err := k.PostTag.Walk(ctx, nil, func(_ string, tag types.PostTag) (bool, error) {
+		if postIndex := remap(tag.PostIndex); postIndex != tag.PostIndex {
+			tag.PostIndex = postIndex
+			tags = append(tags, tag)
+		}
+		return false, nil
+	}); err != nil {
+		return err
+	}
+	for _, tag := range tags {
+		if err := k.PostTag.Set(ctx, tag.Index, tag); err != nil {
+			return err
+		}
+	}
+	return nil
+}
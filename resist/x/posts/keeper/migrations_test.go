@@ -0,0 +1,171 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"resist/x/posts/keeper"
+	"resist/x/posts/types"
+)
+
+func TestMigrate1to2(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockHeight(10)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	author, err := f.addressCodec.BytesToString([]byte("author______________________"))
+	require.NoError(t, err)
+	voter, err := f.addressCodec.BytesToString([]byte("voter_______________________"))
+	require.NoError(t, err)
+
+	// The params had no fields at version 1, which must not halt the
+	// EndBlocker before the migration
+	require.NoError(t, f.keeper.Params.Set(ctx, types.Params{}))
+	require.NoError(t, f.keeper.EndBlocker(ctx))
+
+	// Posts indexed by height-time-creator or by the client, one of which
+	// already has the index "1"
+	posts := []types.SocialPost{
+		{Index: "2-20-" + author, Author: author, Creator: author, GroupId: 1, CreatedAt: 20, QuotedIndex: "1"},
+		{Index: "1", Author: author, Creator: author, CreatedAt: 10, ReplyCount: 1, Sources: `["b", "missing", "a", "b"]`},
+		{Index: "3-30-" + author, Author: author, Creator: author, CreatedAt: 30, ParentIndex: "1", RootIndex: "1", Sources: "not json"},
+	}
+	for _, post := range posts {
+		require.NoError(t, f.keeper.SocialPost.Set(ctx, post.Index, post))
+	}
+	// Posts stored at version 1 are in none of the indexes
+	getPost := func(post types.SocialPost) func() (types.SocialPost, error) {
+		return func() (types.SocialPost, error) { return post, nil }
+	}
+	for _, post := range posts {
+		require.NoError(t, f.keeper.SocialPost.Indexes.Author.Unreference(ctx, post.Index, getPost(post)))
+		require.NoError(t, f.keeper.SocialPost.Indexes.Creator.Unreference(ctx, post.Index, getPost(post)))
+		require.NoError(t, f.keeper.SocialPost.Indexes.Group.Unreference(ctx, post.Index, getPost(post)))
+		require.NoError(t, f.keeper.SocialPost.Indexes.CreatedAt.Unreference(ctx, post.Index, getPost(post)))
+	}
+	// Reply keys of another encoding are dropped
+	legacyReplyKey, err := collections.EncodeKeyWithPrefix(types.ReplyKey, collections.PairKeyCodec(collections.StringKey, collections.StringKey), collections.Join("1", "3-30-"+author))
+	require.NoError(t, err)
+	store := ctx.KVStore(f.storeKey)
+	store.Set(legacyReplyKey, []byte{})
+	require.NoError(t, f.keeper.Vote.Set(ctx, voter+":1", types.Vote{Index: voter + ":1", VoterAddress: voter, PostIndex: "1", VoteType: "upvote"}))
+	require.NoError(t, f.keeper.Vote.Set(ctx, "custom", types.Vote{Index: "custom", VoterAddress: voter, PostIndex: "2-20-" + author}))
+	require.NoError(t, f.keeper.PostTag.Set(ctx, "tag", types.PostTag{Index: "tag", PostIndex: "3-30-" + author}))
+	// Scores were set by the creator of the sources
+	require.NoError(t, f.keeper.Source.Set(ctx, "a", types.Source{Index: "a", CredibilityScore: 100}))
+	require.NoError(t, f.keeper.Source.Set(ctx, "b", types.Source{Index: "b", CredibilityScore: 0, Verified: true}))
+	stored := types.ReplicaAssignment{ContentId: "c", NodeId: "n1", Status: types.ReplicaStatusStored}
+	failed := types.ReplicaAssignment{ContentId: "c", NodeId: "n2", Status: types.ReplicaStatusFailed}
+	for _, assignment := range []types.ReplicaAssignment{stored, failed} {
+		require.NoError(t, f.keeper.ReplicaAssignment.Set(ctx, collections.Join(assignment.ContentId, assignment.NodeId), assignment))
+	}
+
+	require.NoError(t, keeper.NewMigrator(f.keeper).Migrate1to2(ctx))
+
+	params, err := f.keeper.Params.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, types.DefaultParams(), params)
+
+	// Posts are re-keyed in creation order
+	first, err := f.keeper.SocialPost.Get(ctx, "0")
+	require.NoError(t, err)
+	require.EqualValues(t, 10, first.CreatedAt)
+	require.EqualValues(t, 1, first.ReplyCount)
+	quote, err := f.keeper.SocialPost.Get(ctx, "1")
+	require.NoError(t, err)
+	require.Equal(t, "0", quote.QuotedIndex)
+	reply, err := f.keeper.SocialPost.Get(ctx, "2")
+	require.NoError(t, err)
+	require.Equal(t, "0", reply.ParentIndex)
+	require.Equal(t, "0", reply.RootIndex)
+	has, err := f.keeper.SocialPost.Has(ctx, "2-20-"+author)
+	require.NoError(t, err)
+	require.False(t, has)
+
+	has, err = f.keeper.Reply.Has(ctx, collections.Join("0", uint64(2)))
+	require.NoError(t, err)
+	require.True(t, has)
+	require.False(t, store.Has(legacyReplyKey))
+
+	vote, err := f.keeper.Vote.Get(ctx, voter+":0")
+	require.NoError(t, err)
+	require.Equal(t, "0", vote.PostIndex)
+	has, err = f.keeper.Vote.Has(ctx, voter+":1")
+	require.NoError(t, err)
+	require.False(t, has)
+	vote, err = f.keeper.Vote.Get(ctx, "custom")
+	require.NoError(t, err)
+	require.Equal(t, "1", vote.PostIndex)
+	tag, err := f.keeper.PostTag.Get(ctx, "tag")
+	require.NoError(t, err)
+	require.Equal(t, "2", tag.PostIndex)
+
+	// Posts are indexed by author, creator, group and creation time
+	byAuthor, err := qs.ListPostsByAuthor(ctx, &types.QueryListPostsByAuthorRequest{Author: author})
+	require.NoError(t, err)
+	require.Len(t, byAuthor.SocialPost, 3)
+	var byCreator []string
+	require.NoError(t, f.keeper.SocialPost.Indexes.Creator.Walk(ctx, collections.NewPrefixedPairRange[string, string](author), func(_, index string) (bool, error) {
+		byCreator = append(byCreator, index)
+		return false, nil
+	}))
+	require.Len(t, byCreator, 3)
+	byGroup, err := qs.ListPostsByGroup(ctx, &types.QueryListPostsByGroupRequest{GroupId: 1})
+	require.NoError(t, err)
+	require.Equal(t, []string{"1"}, postIndexes(byGroup.SocialPost))
+	since, err := qs.ListPostsSince(ctx, &types.QueryListPostsSinceRequest{Since: 20})
+	require.NoError(t, err)
+	require.Len(t, since.SocialPost, 2)
+
+	// The sources of the JSON array of the posts are cited
+	require.Equal(t, []string{"b", "a"}, first.SourceIndexes)
+	require.Empty(t, first.Sources)
+	require.Empty(t, reply.SourceIndexes)
+	require.Empty(t, reply.Sources)
+	cited, err := qs.ListSourcePosts(ctx, &types.QueryListSourcePostsRequest{SourceIndex: "a"})
+	require.NoError(t, err)
+	require.Equal(t, []string{"0"}, postIndexes(cited.SocialPost))
+
+	// The scores of the sources are computed
+	a, err := f.keeper.Source.Get(ctx, "a")
+	require.NoError(t, err)
+	require.EqualValues(t, 50, a.CredibilityScore)
+	b, err := f.keeper.Source.Get(ctx, "b")
+	require.NoError(t, err)
+	require.EqualValues(t, 70, b.CredibilityScore)
+
+	// Stored replicas are indexed for the audits
+	var indexed []string
+	require.NoError(t, f.keeper.StoredReplica.Walk(ctx, nil, func(key collections.Triple[uint64, string, string]) (bool, error) {
+		indexed = append(indexed, key.K3())
+		return false, nil
+	}))
+	require.Equal(t, []string{"n1"}, indexed)
+
+	// Old indexes resolve through their alias, except "1" which is now the
+	// index of another post
+	alias, err := f.keeper.PostAlias.Get(ctx, "3-30-"+author)
+	require.NoError(t, err)
+	require.Equal(t, "2", alias)
+	resp, err := qs.GetSocialPost(ctx, &types.QueryGetSocialPostRequest{Index: "3-30-" + author})
+	require.NoError(t, err)
+	require.Equal(t, "2", resp.SocialPost.Index)
+	resp, err = qs.GetSocialPost(ctx, &types.QueryGetSocialPostRequest{Index: "1"})
+	require.NoError(t, err)
+	require.EqualValues(t, 20, resp.SocialPost.CreatedAt)
+
+	_, err = srv.VotePost(ctx, &types.MsgVotePost{Creator: voter, PostIndex: "2-20-" + author, VoteType: "upvote"})
+	require.NoError(t, err)
+	vote, err = f.keeper.Vote.Get(ctx, voter+":1")
+	require.NoError(t, err)
+	require.Equal(t, "1", vote.PostIndex)
+
+	// New posts follow the migrated ones
+	created, err := srv.CreatePost(ctx, &types.MsgCreatePost{Creator: author, Title: "title", Content: "content"})
+	require.NoError(t, err)
+	require.Equal(t, "3", created.Index)
+}
@@ -0,0 +1,83 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"resist/x/posts/types"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func (k msgServer) AckReplica(ctx context.Context, msg *types.MsgAckReplica) (*types.MsgAckReplicaResponse, error) {
+	if _, err := k.addressCodec.StringToBytes(msg.Creator); err != nil {
+		return nil, errorsmod.Wrap(err, "invalid creator address")
+	}
+
+	distribution, err := k.ContentDistribution.Get(ctx, msg.ContentId)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, errorsmod.Wrapf(types.ErrContentNotFound, "content %s", msg.ContentId)
+		}
+		return nil, err
+	}
+
+	assignment, err := k.ReplicaAssignment.Get(ctx, collections.Join(msg.ContentId, msg.NodeId))
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, errorsmod.Wrapf(types.ErrReplicaNotAssigned, "content %s node %s", msg.ContentId, msg.NodeId)
+		}
+		return nil, err
+	}
+	if assignment.Status != types.ReplicaStatusPending {
+		return nil, errorsmod.Wrapf(types.ErrReplicaNotPending, "replica is %s", assignment.Status)
+	}
+
+	// Only the owner of the assigned node can acknowledge its replica
+	node, err := k.rewardsKeeper.GetNode(ctx, msg.NodeId)
+	if err != nil {
+		return nil, err
+	}
+	if node.Owner != msg.Creator {
+		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "incorrect node owner")
+	}
+
+	if msg.Cid != distribution.IpfsHash {
+		return nil, errorsmod.Wrapf(types.ErrReplicaCIDMismatch, "expected %s, got %s", distribution.IpfsHash, msg.Cid)
+	}
+
+	blockTime := sdk.UnwrapSDKContext(ctx).BlockTime().Unix()
+	assignment.Status = types.ReplicaStatusStored
+	assignment.AcknowledgedAt = blockTime
+	assignment.Cid = msg.Cid
+	if err := k.SetReplicaAssignment(ctx, assignment); err != nil {
+		return nil, err
+	}
+
+	if distribution.Replication == nil {
+		distribution.Replication = &types.ContentReplication{}
+	}
+	distribution.Replication.ReplicaNodes = append(distribution.Replication.ReplicaNodes, msg.NodeId)
+	distribution.Replication.CurrentReplicas = uint32(len(distribution.Replication.ReplicaNodes))
+	distribution.LastSync = blockTime
+	if err := k.ContentDistribution.Set(ctx, distribution.ContentId, distribution); err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"replica_stored",
+			sdk.NewAttribute("content_id", msg.ContentId),
+			sdk.NewAttribute("node_id", msg.NodeId),
+			sdk.NewAttribute("ipfs_hash", msg.Cid),
+			sdk.NewAttribute("current_replicas", fmt.Sprintf("%d", distribution.Replication.CurrentReplicas)),
+		),
+	)
+
+	return &types.MsgAckReplicaResponse{CurrentReplicas: distribution.Replication.CurrentReplicas}, nil
+}
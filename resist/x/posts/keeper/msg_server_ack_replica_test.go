@@ -0,0 +1,109 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"resist/x/posts/keeper"
+	"resist/x/posts/types"
+	rewardstypes "resist/x/rewards/types"
+)
+
+func TestAckReplicaMsgServer(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(time.Unix(1000, 0))
+
+	creator, err := f.addressCodec.BytesToString([]byte("signerAddr__________________"))
+	require.NoError(t, err)
+	owner, err := f.addressCodec.BytesToString([]byte("nodeOwner___________________"))
+	require.NoError(t, err)
+	f.rewardsKeeper.nodes["hub-1"] = rewardstypes.Node{NodeId: "hub-1", Owner: owner, Location: "eu", IsActive: true}
+	f.rewardsKeeper.nodes["hub-2"] = rewardstypes.Node{NodeId: "hub-2", Owner: owner, Location: "us", IsActive: true}
+
+	content := []byte("hello world")
+	resp, err := srv.DistributeContent(ctx, &types.MsgDistributeContent{
+		Creator:        creator,
+		ContentId:      "post-1",
+		ContentData:    content,
+		Metadata:       &types.ContentMetadata{},
+		TargetReplicas: 2,
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"hub-1", "hub-2"}, resp.AssignedNodes)
+
+	assignment, err := f.keeper.ReplicaAssignment.Get(ctx, collections.Join("post-1", "hub-1"))
+	require.NoError(t, err)
+	require.Equal(t, types.ReplicaStatusPending, assignment.Status)
+	require.Equal(t, int64(1000+types.DefaultReplicaAckTimeout), assignment.AckDeadline)
+
+	tests := []struct {
+		desc string
+		msg  *types.MsgAckReplica
+		err  error
+	}{
+		{
+			desc: "unknown content",
+			msg:  &types.MsgAckReplica{Creator: owner, ContentId: "post-2", NodeId: "hub-1", Cid: resp.IpfsHash},
+			err:  types.ErrContentNotFound,
+		},
+		{
+			desc: "node not assigned",
+			msg:  &types.MsgAckReplica{Creator: owner, ContentId: "post-1", NodeId: "hub-3", Cid: resp.IpfsHash},
+			err:  types.ErrReplicaNotAssigned,
+		},
+		{
+			desc: "not the node owner",
+			msg:  &types.MsgAckReplica{Creator: creator, ContentId: "post-1", NodeId: "hub-1", Cid: resp.IpfsHash},
+			err:  sdkerrors.ErrUnauthorized,
+		},
+		{
+			desc: "cid mismatch",
+			msg:  &types.MsgAckReplica{Creator: owner, ContentId: "post-1", NodeId: "hub-1", Cid: types.NewCID(types.RawCodec, []byte("other"))},
+			err:  types.ErrReplicaCIDMismatch,
+		},
+		{
+			desc: "completed",
+			msg:  &types.MsgAckReplica{Creator: owner, ContentId: "post-1", NodeId: "hub-1", Cid: resp.IpfsHash},
+		},
+		{
+			desc: "already acknowledged",
+			msg:  &types.MsgAckReplica{Creator: owner, ContentId: "post-1", NodeId: "hub-1", Cid: resp.IpfsHash},
+			err:  types.ErrReplicaNotPending,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			_, err := srv.AckReplica(ctx, tc.msg)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+
+	assignment, err = f.keeper.ReplicaAssignment.Get(ctx, collections.Join("post-1", "hub-1"))
+	require.NoError(t, err)
+	require.Equal(t, types.ReplicaStatusStored, assignment.Status)
+	require.Equal(t, resp.IpfsHash, assignment.Cid)
+	require.Equal(t, int64(1000), assignment.AcknowledgedAt)
+
+	dist, err := f.keeper.ContentDistribution.Get(ctx, "post-1")
+	require.NoError(t, err)
+	require.Equal(t, uint32(1), dist.Replication.CurrentReplicas)
+	require.Equal(t, []string{"hub-1"}, dist.Replication.ReplicaNodes)
+
+	// Only the pending assignment is left in the deadline index
+	has, err := f.keeper.ReplicaDeadline.Has(ctx, collections.Join3(assignment.AckDeadline, "post-1", "hub-1"))
+	require.NoError(t, err)
+	require.False(t, has)
+	has, err = f.keeper.ReplicaDeadline.Has(ctx, collections.Join3(assignment.AckDeadline, "post-1", "hub-2"))
+	require.NoError(t, err)
+	require.True(t, has)
+}
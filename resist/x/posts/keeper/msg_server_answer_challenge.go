@@ -0,0 +1,62 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"resist/x/posts/types"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func (k msgServer) AnswerChallenge(ctx context.Context, msg *types.MsgAnswerChallenge) (*types.MsgAnswerChallengeResponse, error) {
+	if _, err := k.addressCodec.StringToBytes(msg.Creator); err != nil {
+		return nil, errorsmod.Wrap(err, "invalid creator address")
+	}
+
+	challenge, err := k.StorageChallenge.Get(ctx, msg.ChallengeId)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, errorsmod.Wrapf(types.ErrChallengeNotFound, "challenge %d", msg.ChallengeId)
+		}
+		return nil, err
+	}
+	if challenge.Status != types.ChallengeStatusPending || sdk.UnwrapSDKContext(ctx).BlockHeight() > challenge.DeadlineHeight {
+		return nil, errorsmod.Wrapf(types.ErrChallengeClosed, "challenge %d is %s", challenge.Id, challenge.Status)
+	}
+
+	// Only the owner of the challenged node can answer
+	node, err := k.rewardsKeeper.GetNode(ctx, challenge.NodeId)
+	if err != nil {
+		return nil, err
+	}
+	if node.Owner != msg.Creator {
+		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "incorrect node owner")
+	}
+
+	distribution, err := k.ContentDistribution.Get(ctx, challenge.ContentId)
+	if err != nil {
+		return nil, err
+	}
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// An invalid proof fails the challenge; the transaction itself succeeds so
+	// that the failure is recorded.
+	if err := types.VerifyChunkProof(distribution.IpfsHash, challenge.ChunkIndex, msg.Chunk, msg.Proof); err != nil {
+		if err := k.failStorageChallenge(ctx, challenge, "invalid proof: "+err.Error(), params); err != nil {
+			return nil, err
+		}
+		return &types.MsgAnswerChallengeResponse{Passed: false}, nil
+	}
+
+	if err := k.passStorageChallenge(ctx, challenge); err != nil {
+		return nil, err
+	}
+	return &types.MsgAnswerChallengeResponse{Passed: true}, nil
+}
@@ -0,0 +1,194 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"resist/x/posts/keeper"
+	"resist/x/posts/types"
+	rewardstypes "resist/x/rewards/types"
+)
+
+func TestStorageAudit(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	params := types.DefaultParams()
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(time.Unix(1000, 0)).WithBlockHeight(params.AuditEpochBlocks - 1)
+
+	owner, err := f.addressCodec.BytesToString([]byte("nodeOwner___________________"))
+	require.NoError(t, err)
+	for _, node := range []rewardstypes.Node{
+		{NodeId: "eu-1", Owner: owner, Location: "eu", IsActive: true, UptimePercentage: 99},
+		{NodeId: "us-1", Owner: owner, Location: "us", IsActive: true, UptimePercentage: 99},
+		{NodeId: "asia-1", Owner: owner, Location: "asia", IsActive: true, UptimePercentage: 99},
+	} {
+		f.rewardsKeeper.nodes[node.NodeId] = node
+	}
+
+	content := []byte("hello world")
+	resp, err := srv.DistributeContent(ctx, &types.MsgDistributeContent{
+		Creator:        owner,
+		ContentId:      "post-1",
+		ContentData:    content,
+		Metadata:       &types.ContentMetadata{},
+		TargetReplicas: 2,
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"asia-1", "eu-1"}, resp.AssignedNodes)
+	for _, nodeId := range resp.AssignedNodes {
+		_, err := srv.AckReplica(ctx, &types.MsgAckReplica{Creator: owner, ContentId: "post-1", NodeId: nodeId, Cid: resp.IpfsHash})
+		require.NoError(t, err)
+	}
+
+	// No challenge outside of an epoch boundary
+	require.NoError(t, f.keeper.EndBlocker(ctx))
+	next, err := f.keeper.StorageChallengeSeq.Peek(ctx)
+	require.NoError(t, err)
+	require.Zero(t, next)
+
+	// Both replicas are challenged at the epoch boundary
+	ctx = ctx.WithBlockHeight(params.AuditEpochBlocks)
+	require.NoError(t, f.keeper.EndBlocker(ctx))
+	challenges := make(map[string]types.StorageChallenge)
+	for id := range uint64(2) {
+		challenge, err := f.keeper.StorageChallenge.Get(ctx, id)
+		require.NoError(t, err)
+		require.Equal(t, types.ChallengeStatusPending, challenge.Status)
+		require.Zero(t, challenge.ChunkIndex)
+		require.Equal(t, params.AuditEpochBlocks+params.AuditResponseBlocks, challenge.DeadlineHeight)
+		challenges[challenge.NodeId] = challenge
+	}
+
+	chunk, proof, err := types.BuildChunkProof(content, 0)
+	require.NoError(t, err)
+
+	t.Run("not the node owner", func(t *testing.T) {
+		other, err := f.addressCodec.BytesToString([]byte("signerAddr__________________"))
+		require.NoError(t, err)
+		_, err = srv.AnswerChallenge(ctx, &types.MsgAnswerChallenge{Creator: other, ChallengeId: challenges["eu-1"].Id, Chunk: chunk, Proof: proof})
+		require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	})
+
+	t.Run("unknown challenge", func(t *testing.T) {
+		_, err := srv.AnswerChallenge(ctx, &types.MsgAnswerChallenge{Creator: owner, ChallengeId: 7, Chunk: chunk, Proof: proof})
+		require.ErrorIs(t, err, types.ErrChallengeNotFound)
+	})
+
+	t.Run("valid proof", func(t *testing.T) {
+		res, err := srv.AnswerChallenge(ctx, &types.MsgAnswerChallenge{Creator: owner, ChallengeId: challenges["eu-1"].Id, Chunk: chunk, Proof: proof})
+		require.NoError(t, err)
+		require.True(t, res.Passed)
+		require.Equal(t, uint64(1), f.rewardsKeeper.metrics["eu-1"].StorageAuditsPassed)
+
+		_, err = srv.AnswerChallenge(ctx, &types.MsgAnswerChallenge{Creator: owner, ChallengeId: challenges["eu-1"].Id, Chunk: chunk, Proof: proof})
+		require.ErrorIs(t, err, types.ErrChallengeClosed)
+	})
+
+	t.Run("invalid proof", func(t *testing.T) {
+		res, err := srv.AnswerChallenge(ctx, &types.MsgAnswerChallenge{Creator: owner, ChallengeId: challenges["asia-1"].Id, Chunk: []byte("hello there")})
+		require.NoError(t, err)
+		require.False(t, res.Passed)
+
+		challenge, err := f.keeper.StorageChallenge.Get(ctx, challenges["asia-1"].Id)
+		require.NoError(t, err)
+		require.Equal(t, types.ChallengeStatusFailed, challenge.Status)
+		require.Equal(t, uint64(99-params.AuditUptimePenalty), f.rewardsKeeper.nodes["asia-1"].UptimePercentage)
+
+		// The node loses its replica, which goes to the remaining node
+		dist, err := f.keeper.ContentDistribution.Get(ctx, "post-1")
+		require.NoError(t, err)
+		require.Equal(t, []string{"eu-1"}, dist.Replication.ReplicaNodes)
+		require.Equal(t, uint32(1), dist.Replication.CurrentReplicas)
+
+		assignment, err := f.keeper.ReplicaAssignment.Get(ctx, collections.Join("post-1", "asia-1"))
+		require.NoError(t, err)
+		require.Equal(t, types.ReplicaStatusFailed, assignment.Status)
+		assignment, err = f.keeper.ReplicaAssignment.Get(ctx, collections.Join("post-1", "us-1"))
+		require.NoError(t, err)
+		require.Equal(t, types.ReplicaStatusPending, assignment.Status)
+	})
+
+	t.Run("missed deadline", func(t *testing.T) {
+		ctx := ctx.WithBlockHeight(2 * params.AuditEpochBlocks)
+		require.NoError(t, f.keeper.EndBlocker(ctx))
+		challenge, err := f.keeper.StorageChallenge.Get(ctx, 2)
+		require.NoError(t, err)
+		require.Equal(t, "eu-1", challenge.NodeId)
+
+		require.NoError(t, f.keeper.EndBlocker(ctx.WithBlockHeight(challenge.DeadlineHeight)))
+		challenge, err = f.keeper.StorageChallenge.Get(ctx, 2)
+		require.NoError(t, err)
+		require.Equal(t, types.ChallengeStatusPending, challenge.Status)
+
+		ctx = ctx.WithBlockHeight(challenge.DeadlineHeight + 1)
+		_, err = srv.AnswerChallenge(ctx, &types.MsgAnswerChallenge{Creator: owner, ChallengeId: challenge.Id, Chunk: chunk, Proof: proof})
+		require.ErrorIs(t, err, types.ErrChallengeClosed)
+
+		require.NoError(t, f.keeper.EndBlocker(ctx))
+		challenge, err = f.keeper.StorageChallenge.Get(ctx, 2)
+		require.NoError(t, err)
+		require.Equal(t, types.ChallengeStatusFailed, challenge.Status)
+		require.Equal(t, uint64(1), f.rewardsKeeper.metrics["eu-1"].StorageAuditsFailed)
+
+		dist, err := f.keeper.ContentDistribution.Get(ctx, "post-1")
+		require.NoError(t, err)
+		require.Empty(t, dist.Replication.ReplicaNodes)
+	})
+}
+
+func TestStorageAuditSkipsPendingChallenges(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	params := types.DefaultParams()
+	params.AuditResponseBlocks = 3 * params.AuditEpochBlocks
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(time.Unix(1000, 0)).WithBlockHeight(params.AuditEpochBlocks)
+	require.NoError(t, f.keeper.Params.Set(ctx, params))
+
+	owner, err := f.addressCodec.BytesToString([]byte("nodeOwner___________________"))
+	require.NoError(t, err)
+	f.rewardsKeeper.nodes["eu-1"] = rewardstypes.Node{NodeId: "eu-1", Owner: owner, Location: "eu", IsActive: true, UptimePercentage: 99}
+
+	content := []byte("hello world")
+	resp, err := srv.DistributeContent(ctx, &types.MsgDistributeContent{
+		Creator:        owner,
+		ContentId:      "post-1",
+		ContentData:    content,
+		Metadata:       &types.ContentMetadata{},
+		TargetReplicas: 1,
+	})
+	require.NoError(t, err)
+	_, err = srv.AckReplica(ctx, &types.MsgAckReplica{Creator: owner, ContentId: "post-1", NodeId: "eu-1", Cid: resp.IpfsHash})
+	require.NoError(t, err)
+
+	require.NoError(t, f.keeper.EndBlocker(ctx))
+	next, err := f.keeper.StorageChallengeSeq.Peek(ctx)
+	require.NoError(t, err)
+	require.EqualValues(t, 1, next)
+
+	// The replica is not challenged again while its challenge is pending
+	ctx = ctx.WithBlockHeight(2 * params.AuditEpochBlocks)
+	require.NoError(t, f.keeper.EndBlocker(ctx))
+	next, err = f.keeper.StorageChallengeSeq.Peek(ctx)
+	require.NoError(t, err)
+	require.EqualValues(t, 1, next)
+
+	chunk, proof, err := types.BuildChunkProof(content, 0)
+	require.NoError(t, err)
+	_, err = srv.AnswerChallenge(ctx, &types.MsgAnswerChallenge{Creator: owner, ChallengeId: 0, Chunk: chunk, Proof: proof})
+	require.NoError(t, err)
+	has, err := f.keeper.PendingStorageChallenge.Has(ctx, collections.Join("post-1", "eu-1"))
+	require.NoError(t, err)
+	require.False(t, has)
+
+	ctx = ctx.WithBlockHeight(3 * params.AuditEpochBlocks)
+	require.NoError(t, f.keeper.EndBlocker(ctx))
+	challenge, err := f.keeper.StorageChallenge.Get(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, "eu-1", challenge.NodeId)
+	require.Equal(t, types.ChallengeStatusPending, challenge.Status)
+}
@@ -2,9 +2,7 @@ package keeper
 
 import (
 	"context"
-	"fmt"
 	"strconv"
-	"time"
 
 	"resist/x/posts/types"
 
@@ -25,13 +23,21 @@ func (k msgServer) CreatePost(ctx context.Context, msg *types.MsgCreatePost) (*t
 		return nil, errorsmod.Wrap(types.ErrInvalidInput, "content cannot be empty")
 	}
 
-	// Generate unique index for the post
-	sdkCtx := sdk.UnwrapSDKContext(ctx)
-	blockHeight := sdkCtx.BlockHeight()
-	blockTime := sdkCtx.BlockTime().Unix()
-	postIndex := fmt.Sprintf("%d-%d-%s", blockHeight, blockTime, msg.Creator)
+	quotedIndex, err := k.checkQuotable(ctx, msg.QuotedIndex, msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.checkSources(ctx, msg.SourceIndexes); err != nil {
+		return nil, err
+	}
+
+	postIndex, err := k.nextPostIndex(ctx)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to get post index")
+	}
 
 	// Create the social post
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	socialPost := types.SocialPost{
 		Index:              postIndex,
 		Title:              msg.Title,
@@ -42,18 +48,22 @@ func (k msgServer) CreatePost(ctx context.Context, msg *types.MsgCreatePost) (*t
 		Author:             msg.Creator,
 		Upvotes:            0,
 		Downvotes:          0,
-		CreatedAt:          uint64(time.Now().Unix()),
+		CreatedAt:          uint64(sdkCtx.BlockTime().Unix()),
 		Creator:            msg.Creator,
-		Sources:            "[]", // Empty JSON array for sources
 		Intent:             "discuss", // Default intent
 		ContextType:        "opinion", // Default context type
 		RequiresModeration: false,     // Default to not requiring moderation
+		QuotedIndex:        quotedIndex,
+		SourceIndexes:      msg.SourceIndexes,
 	}
 
 	// Store the social post
 	if err := k.SocialPost.Set(ctx, postIndex, socialPost); err != nil {
 		return nil, errorsmod.Wrap(err, "failed to store social post")
 	}
+	if err := k.indexPostSources(ctx, socialPost); err != nil {
+		return nil, errorsmod.Wrap(err, "failed to index post sources")
+	}
 
 	// Emit event
 	sdkCtx.EventManager().EmitEvent(
@@ -66,5 +76,6 @@ func (k msgServer) CreatePost(ctx context.Context, msg *types.MsgCreatePost) (*t
 		),
 	)
 
-	return &types.MsgCreatePostResponse{}, nil
+	return &types.MsgCreatePostResponse{Index: postIndex}, nil
 }
+
@@ -36,18 +36,22 @@ func (k msgServer) DistributeContent(ctx context.Context, msg *types.MsgDistribu
 		msg.ReplicationStrategy = "geographic" // Default strategy
 	}
 
+	// The metadata travels with the distribution record and must describe this content
+	if msg.Metadata.ContentId == "" {
+		msg.Metadata.ContentId = msg.ContentId
+	} else if msg.Metadata.ContentId != msg.ContentId {
+		return nil, errorsmod.Wrap(types.ErrInvalidInput, "metadata content ID does not match content ID")
+	}
+	msg.Metadata.SizeBytes = uint64(len(msg.ContentData))
+
 	// Initialize content distribution service
 	distributionService := NewContentDistributionService(&k.Keeper)
 
-	// Distribute content to IPFS
-	ipfsHash, err := distributionService.DistributeToIPFS(ctx, msg.ContentData, msg.Metadata)
-	if err != nil {
-		return nil, errorsmod.Wrap(err, "failed to distribute content to IPFS")
-	}
-
 	// Select nodes for replication
 	selectedNodes, err := distributionService.SelectReplicationNodes(
 		ctx,
+		msg.ContentId,
+		msg.Metadata.ContentType,
 		msg.ReplicationStrategy,
 		msg.TargetReplicas,
 		msg.PreferredNodes,
@@ -56,22 +60,38 @@ func (k msgServer) DistributeContent(ctx context.Context, msg *types.MsgDistribu
 		return nil, errorsmod.Wrap(err, "failed to select replication nodes")
 	}
 
+	// Address the content and record its distribution
+	distribution, err := distributionService.DistributeToIPFS(
+		ctx,
+		msg.Creator,
+		msg.ContentData,
+		msg.Metadata,
+		msg.ReplicationStrategy,
+		msg.TargetReplicas,
+	)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to distribute content to IPFS")
+	}
+	ipfsHash := distribution.IpfsHash
+
+	// Selected nodes must acknowledge their replica before the deadline
+	if err := k.AssignReplicas(ctx, msg.ContentId, selectedNodes); err != nil {
+		return nil, err
+	}
+
 	// Generate distribution ID
 	distributionId := fmt.Sprintf("dist_%s_%d", msg.ContentId, sdk.UnwrapSDKContext(ctx).BlockTime().Unix())
 
 	// In a production environment, this would:
-	// 1. Store content metadata in blockchain state
-	// 2. Initiate replication to selected nodes
-	// 3. Set up monitoring for replication status
-	// 4. Handle payment for storage resources
+	// 1. Handle payment for storage resources
 
 	// Emit event
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	sdkCtx.EventManager().EmitEvent(
 		sdk.NewEvent(
-			"content_distributed",
+			types.EventTypeContentDistributed,
 			sdk.NewAttribute("content_id", msg.ContentId),
-			sdk.NewAttribute("ipfs_hash", ipfsHash),
+			sdk.NewAttribute(types.AttributeKeyIpfsHash, ipfsHash),
 			sdk.NewAttribute("distribution_id", distributionId),
 			sdk.NewAttribute("target_replicas", fmt.Sprintf("%d", msg.TargetReplicas)),
 			sdk.NewAttribute("replication_strategy", msg.ReplicationStrategy),
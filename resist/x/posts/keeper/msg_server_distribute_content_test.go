@@ -0,0 +1,64 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"resist/x/posts/keeper"
+	"resist/x/posts/types"
+	rewardstypes "resist/x/rewards/types"
+)
+
+func TestDistributeContentMsgServer(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	creator, err := f.addressCodec.BytesToString([]byte("signerAddr__________________"))
+	require.NoError(t, err)
+
+	f.rewardsKeeper.nodes["hub-1"] = rewardstypes.Node{NodeId: "hub-1", Location: "eu", IsActive: true}
+	content := []byte("hello world")
+	expectedCID, err := types.ComputeContentCID(content)
+	require.NoError(t, err)
+
+	msg := &types.MsgDistributeContent{
+		Creator:     creator,
+		ContentId:   "post-1",
+		ContentData: content,
+		Metadata:    &types.ContentMetadata{ContentType: "text", Title: "greeting"},
+	}
+	resp, err := srv.DistributeContent(f.ctx, msg)
+	require.NoError(t, err)
+	require.Equal(t, expectedCID, resp.IpfsHash)
+	require.Equal(t, []string{"hub-1"}, resp.AssignedNodes)
+
+	dist, err := f.keeper.ContentDistribution.Get(f.ctx, "post-1")
+	require.NoError(t, err)
+	require.Equal(t, expectedCID, dist.IpfsHash)
+	require.Equal(t, creator, dist.Creator)
+	require.Equal(t, "post-1", dist.Metadata.ContentId)
+	require.Equal(t, uint64(len(content)), dist.Metadata.SizeBytes)
+	require.Equal(t, uint32(3), dist.Replication.TargetReplicas)
+	require.Equal(t, "geographic", dist.Replication.ReplicationStrategy)
+	require.Equal(t, uint64(len(content)), dist.Replication.TotalSizeBytes)
+
+	t.Run("already distributed", func(t *testing.T) {
+		_, err := srv.DistributeContent(f.ctx, &types.MsgDistributeContent{
+			Creator:     creator,
+			ContentId:   "post-1",
+			ContentData: []byte("other"),
+			Metadata:    &types.ContentMetadata{},
+		})
+		require.ErrorIs(t, err, types.ErrInvalidInput)
+	})
+
+	t.Run("metadata mismatch", func(t *testing.T) {
+		_, err := srv.DistributeContent(f.ctx, &types.MsgDistributeContent{
+			Creator:     creator,
+			ContentId:   "post-2",
+			ContentData: content,
+			Metadata:    &types.ContentMetadata{ContentId: "post-3"},
+		})
+		require.ErrorIs(t, err, types.ErrInvalidInput)
+	})
+}
@@ -0,0 +1,138 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"resist/x/posts/types"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func (k msgServer) ReportSyncProgress(ctx context.Context, msg *types.MsgReportSyncProgress) (*types.MsgReportSyncProgressResponse, error) {
+	hubSync, err := k.openHubSync(ctx, msg.Creator, msg.SyncId)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkBytesTransferred(hubSync, msg.BytesTransferred); err != nil {
+		return nil, err
+	}
+
+	hubSync.Status = types.HubSyncStatusSyncing
+	hubSync.BytesTransferred = msg.BytesTransferred
+	hubSync.UpdatedAt = sdk.UnwrapSDKContext(ctx).BlockTime().Unix()
+	if err := k.SetHubSync(ctx, hubSync); err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"hub_sync_progress",
+			sdk.NewAttribute("sync_id", hubSync.SyncId),
+			sdk.NewAttribute("bytes_transferred", fmt.Sprintf("%d", hubSync.BytesTransferred)),
+			sdk.NewAttribute("total_bytes", fmt.Sprintf("%d", hubSync.TotalBytes)),
+		),
+	)
+
+	return &types.MsgReportSyncProgressResponse{}, nil
+}
+
+func (k msgServer) CompleteSync(ctx context.Context, msg *types.MsgCompleteSync) (*types.MsgCompleteSyncResponse, error) {
+	hubSync, err := k.openHubSync(ctx, msg.Creator, msg.SyncId)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkBytesTransferred(hubSync, msg.BytesTransferred); err != nil {
+		return nil, err
+	}
+
+	blockTime := sdk.UnwrapSDKContext(ctx).BlockTime().Unix()
+	hubSync.BytesTransferred = msg.BytesTransferred
+	hubSync.UpdatedAt = blockTime
+	hubSync.CompletedAt = blockTime
+	if msg.Success {
+		hubSync.Status = types.HubSyncStatusCompleted
+	} else {
+		hubSync.Status = types.HubSyncStatusFailed
+		hubSync.FailureReason = msg.FailureReason
+	}
+	if err := k.SetHubSync(ctx, hubSync); err != nil {
+		return nil, err
+	}
+
+	// The target hub now mirrors the synced content
+	if msg.Success {
+		for _, contentId := range hubSync.ContentIds {
+			distribution, err := k.ContentDistribution.Get(ctx, contentId)
+			if err != nil {
+				return nil, err
+			}
+			if !slices.Contains(distribution.MirrorNodes, hubSync.TargetNode) {
+				distribution.MirrorNodes = append(distribution.MirrorNodes, hubSync.TargetNode)
+			}
+			distribution.LastSync = blockTime
+			if err := k.ContentDistribution.Set(ctx, contentId, distribution); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"hub_sync_completed",
+			sdk.NewAttribute("sync_id", hubSync.SyncId),
+			sdk.NewAttribute("status", hubSync.Status),
+			sdk.NewAttribute("bytes_transferred", fmt.Sprintf("%d", hubSync.BytesTransferred)),
+		),
+	)
+
+	return &types.MsgCompleteSyncResponse{}, nil
+}
+
+// openHubSync returns the hub sync if it is still open and the creator owns
+// its target node.
+func (k msgServer) openHubSync(ctx context.Context, creator, syncId string) (types.HubSync, error) {
+	if _, err := k.addressCodec.StringToBytes(creator); err != nil {
+		return types.HubSync{}, errorsmod.Wrap(err, "invalid creator address")
+	}
+
+	hubSync, err := k.HubSync.Get(ctx, syncId)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return types.HubSync{}, errorsmod.Wrapf(types.ErrHubSyncNotFound, "sync %s", syncId)
+		}
+		return types.HubSync{}, err
+	}
+	if hubSync.Status != types.HubSyncStatusPending && hubSync.Status != types.HubSyncStatusSyncing {
+		return types.HubSync{}, errorsmod.Wrapf(types.ErrHubSyncClosed, "sync %s is %s", syncId, hubSync.Status)
+	}
+
+	// Only the owner of the target node reports on the transfer
+	node, err := k.rewardsKeeper.GetNode(ctx, hubSync.TargetNode)
+	if err != nil {
+		return types.HubSync{}, err
+	}
+	if node.Owner != creator {
+		return types.HubSync{}, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "incorrect target node owner")
+	}
+
+	return hubSync, nil
+}
+
+// checkBytesTransferred rejects progress going backwards or past the size of the content.
+func checkBytesTransferred(hubSync types.HubSync, bytesTransferred uint64) error {
+	if bytesTransferred < hubSync.BytesTransferred {
+		return errorsmod.Wrapf(types.ErrInvalidInput, "bytes transferred cannot decrease from %d to %d", hubSync.BytesTransferred, bytesTransferred)
+	}
+	if bytesTransferred > hubSync.TotalBytes {
+		return errorsmod.Wrapf(types.ErrInvalidInput, "bytes transferred %d exceed the %d bytes to sync", bytesTransferred, hubSync.TotalBytes)
+	}
+	return nil
+}
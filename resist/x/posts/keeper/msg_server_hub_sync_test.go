@@ -0,0 +1,122 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"resist/x/posts/keeper"
+	"resist/x/posts/types"
+	rewardstypes "resist/x/rewards/types"
+)
+
+func TestHubSyncMsgServer(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(time.Unix(1000, 0))
+
+	sourceOwner, err := f.addressCodec.BytesToString([]byte("sourceOwner_________________"))
+	require.NoError(t, err)
+	targetOwner, err := f.addressCodec.BytesToString([]byte("targetOwner_________________"))
+	require.NoError(t, err)
+	f.rewardsKeeper.nodes["hub-a"] = rewardstypes.Node{NodeId: "hub-a", Owner: sourceOwner, IsActive: true, BandwidthMbps: 100}
+	f.rewardsKeeper.nodes["hub-b"] = rewardstypes.Node{NodeId: "hub-b", Owner: targetOwner, IsActive: true, BandwidthMbps: 8}
+	f.rewardsKeeper.nodes["hub-c"] = rewardstypes.Node{NodeId: "hub-c", Owner: targetOwner, IsActive: true}
+
+	for _, contentId := range []string{"post-1", "post-2"} {
+		require.NoError(t, f.keeper.ContentDistribution.Set(ctx, contentId, types.ContentDistribution{
+			ContentId:   contentId,
+			Replication: &types.ContentReplication{TotalSizeBytes: 1_500_000},
+		}))
+	}
+
+	_, err = srv.SyncHubContent(ctx, &types.MsgSyncHubContent{Creator: sourceOwner, SourceNode: "hub-a", TargetNode: "hub-b", ContentIds: []string{"post-1", "post-3"}})
+	require.ErrorIs(t, err, types.ErrContentNotFound)
+
+	resp, err := srv.SyncHubContent(ctx, &types.MsgSyncHubContent{Creator: sourceOwner, SourceNode: "hub-a", TargetNode: "hub-b", ContentIds: []string{"post-1", "post-2"}})
+	require.NoError(t, err)
+	require.Equal(t, "sync_0", resp.SyncId)
+	require.Equal(t, uint64(3_000_000), resp.EstimatedBytes)
+	// 3MB at the 8Mbps of the slower hub
+	require.Equal(t, int64(3), resp.EstimatedDuration)
+
+	hubSync, err := f.keeper.HubSync.Get(ctx, resp.SyncId)
+	require.NoError(t, err)
+	require.Equal(t, types.HubSyncStatusPending, hubSync.Status)
+	require.Equal(t, int64(1000), hubSync.StartedAt)
+	require.Equal(t, "incremental", hubSync.SyncMethod)
+
+	_, err = srv.ReportSyncProgress(ctx, &types.MsgReportSyncProgress{Creator: sourceOwner, SyncId: resp.SyncId, BytesTransferred: 10})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	_, err = srv.ReportSyncProgress(ctx, &types.MsgReportSyncProgress{Creator: targetOwner, SyncId: resp.SyncId, BytesTransferred: 1_000_000})
+	require.NoError(t, err)
+	hubSync, err = f.keeper.HubSync.Get(ctx, resp.SyncId)
+	require.NoError(t, err)
+	require.Equal(t, types.HubSyncStatusSyncing, hubSync.Status)
+	require.Equal(t, uint64(1_000_000), hubSync.BytesTransferred)
+
+	_, err = srv.ReportSyncProgress(ctx, &types.MsgReportSyncProgress{Creator: targetOwner, SyncId: resp.SyncId, BytesTransferred: 10})
+	require.ErrorIs(t, err, types.ErrInvalidInput)
+	_, err = srv.ReportSyncProgress(ctx, &types.MsgReportSyncProgress{Creator: targetOwner, SyncId: resp.SyncId, BytesTransferred: 3_000_001})
+	require.ErrorIs(t, err, types.ErrInvalidInput)
+
+	ctx = ctx.WithBlockTime(time.Unix(2000, 0))
+	_, err = srv.CompleteSync(ctx, &types.MsgCompleteSync{Creator: targetOwner, SyncId: resp.SyncId, Success: true, BytesTransferred: 3_000_000})
+	require.NoError(t, err)
+	hubSync, err = f.keeper.HubSync.Get(ctx, resp.SyncId)
+	require.NoError(t, err)
+	require.Equal(t, types.HubSyncStatusCompleted, hubSync.Status)
+	require.Equal(t, int64(2000), hubSync.CompletedAt)
+
+	dist, err := f.keeper.ContentDistribution.Get(ctx, "post-1")
+	require.NoError(t, err)
+	require.Equal(t, []string{"hub-b"}, dist.MirrorNodes)
+	require.Equal(t, int64(2000), dist.LastSync)
+
+	_, err = srv.CompleteSync(ctx, &types.MsgCompleteSync{Creator: targetOwner, SyncId: resp.SyncId, Success: true, BytesTransferred: 3_000_000})
+	require.ErrorIs(t, err, types.ErrHubSyncClosed)
+	_, err = srv.ReportSyncProgress(ctx, &types.MsgReportSyncProgress{Creator: targetOwner, SyncId: "sync_9"})
+	require.ErrorIs(t, err, types.ErrHubSyncNotFound)
+
+	// A second sync that fails
+	resp, err = srv.SyncHubContent(ctx, &types.MsgSyncHubContent{Creator: targetOwner, SourceNode: "hub-b", TargetNode: "hub-c", ContentIds: []string{"post-2"}, SyncMethod: "full"})
+	require.NoError(t, err)
+	require.Equal(t, "sync_1", resp.SyncId)
+	require.Zero(t, resp.EstimatedDuration)
+	_, err = srv.CompleteSync(ctx, &types.MsgCompleteSync{Creator: targetOwner, SyncId: resp.SyncId, FailureReason: "source unreachable"})
+	require.NoError(t, err)
+	hubSync, err = f.keeper.HubSync.Get(ctx, resp.SyncId)
+	require.NoError(t, err)
+	require.Equal(t, types.HubSyncStatusFailed, hubSync.Status)
+	require.Equal(t, "source unreachable", hubSync.FailureReason)
+
+	_, err = srv.SyncHubContent(ctx, &types.MsgSyncHubContent{Creator: sourceOwner, SourceNode: "hub-b", TargetNode: "hub-c", ContentIds: []string{"post-2"}})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	t.Run("list by node", func(t *testing.T) {
+		for node, expected := range map[string][]string{
+			"":      {"sync_0", "sync_1"},
+			"hub-a": {"sync_0"},
+			"hub-b": {"sync_0", "sync_1"},
+			"hub-c": {"sync_1"},
+			"hub-d": nil,
+		} {
+			res, err := qs.ListHubSync(ctx, &types.QueryAllHubSyncRequest{NodeId: node})
+			require.NoError(t, err)
+			var ids []string
+			for _, hubSync := range res.HubSync {
+				ids = append(ids, hubSync.SyncId)
+			}
+			require.Equal(t, expected, ids, node)
+		}
+
+		res, err := qs.GetHubSync(ctx, &types.QueryGetHubSyncRequest{SyncId: "sync_1"})
+		require.NoError(t, err)
+		require.Equal(t, "hub-c", res.HubSync.TargetNode)
+	})
+}
@@ -0,0 +1,135 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"resist/x/posts/keeper"
+	"resist/x/posts/types"
+	rewardstypes "resist/x/rewards/types"
+)
+
+func TestMailbox(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockHeight(10)
+
+	senderOwner, err := f.addressCodec.BytesToString([]byte("senderOwner_________________"))
+	require.NoError(t, err)
+	recipientOwner, err := f.addressCodec.BytesToString([]byte("recipientOwner______________"))
+	require.NoError(t, err)
+	f.rewardsKeeper.nodes["hub-a"] = rewardstypes.Node{NodeId: "hub-a", Owner: senderOwner, IsActive: true}
+	f.rewardsKeeper.nodes["mobile-b"] = rewardstypes.Node{NodeId: "mobile-b", Owner: recipientOwner}
+
+	params := types.DefaultParams()
+	params.MaxSignalMessageBytes = 2048
+	params.MaxMailboxBytes = 1 << 20
+	params.MailboxTtlBlocks = 5
+	require.NoError(t, f.keeper.Params.Set(ctx, params))
+
+	send := func(ctx sdk.Context, plaintext string) (*types.MsgSendSignalMessageResponse, error) {
+		return srv.SendSignalMessage(ctx, &types.MsgSendSignalMessage{
+			Creator:          senderOwner,
+			SenderNode:       "hub-a",
+			RecipientNode:    "mobile-b",
+			ChannelId:        "channel_1",
+			EncryptedPayload: encryptedPayload(t, plaintext),
+			MessageType:      keeper.MessageTypeSyncRequest,
+		})
+	}
+
+	// Only the owner of the sender node can send
+	_, err = srv.SendSignalMessage(ctx, &types.MsgSendSignalMessage{Creator: recipientOwner, SenderNode: "hub-a", RecipientNode: "mobile-b"})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	first, err := send(ctx, "first")
+	require.NoError(t, err)
+	second, err := send(ctx.WithBlockHeight(12), "second")
+	require.NoError(t, err)
+
+	pending, err := qs.ListPendingMessages(ctx, &types.QueryListPendingMessagesRequest{NodeId: "mobile-b"})
+	require.NoError(t, err)
+	require.Len(t, pending.Messages, 2)
+	require.Equal(t, "hub-a", pending.Messages[0].SenderNode)
+	pending, err = qs.ListPendingMessages(ctx, &types.QueryListPendingMessagesRequest{NodeId: "hub-a"})
+	require.NoError(t, err)
+	require.Empty(t, pending.Messages)
+
+	// Size limits per message and per recipient, the mailbox has room for
+	// one more message
+	_, err = send(ctx, string(make([]byte, 2048)))
+	require.ErrorIs(t, err, types.ErrInvalidInput)
+	size, err := f.keeper.MailboxSize.Get(ctx, "mobile-b")
+	require.NoError(t, err)
+	params.MaxMailboxBytes = size*3/2 + 16
+	require.NoError(t, f.keeper.Params.Set(ctx, params))
+	_, err = send(ctx, "third")
+	require.NoError(t, err)
+	_, err = send(ctx, "fourth")
+	require.ErrorIs(t, err, types.ErrMailboxFull)
+
+	_, err = srv.AckMessages(ctx, &types.MsgAckMessages{Creator: senderOwner, NodeId: "mobile-b", MessageIds: []string{first.MessageId}})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	ack, err := srv.AckMessages(ctx, &types.MsgAckMessages{Creator: recipientOwner, NodeId: "mobile-b", MessageIds: []string{first.MessageId, first.MessageId, "msg_unknown"}})
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), ack.Acked)
+	_, err = send(ctx, "fourth")
+	require.NoError(t, err)
+
+	// Messages not acknowledged in time are pruned
+	require.NoError(t, f.keeper.EndBlocker(ctx.WithBlockHeight(15)))
+	pending, err = qs.ListPendingMessages(ctx, &types.QueryListPendingMessagesRequest{NodeId: "mobile-b"})
+	require.NoError(t, err)
+	require.Len(t, pending.Messages, 3)
+	require.NoError(t, f.keeper.EndBlocker(ctx.WithBlockHeight(16)))
+	pending, err = qs.ListPendingMessages(ctx, &types.QueryListPendingMessagesRequest{NodeId: "mobile-b"})
+	require.NoError(t, err)
+	require.Len(t, pending.Messages, 1)
+	require.Equal(t, second.MessageId, pending.Messages[0].MessageId)
+
+	require.NoError(t, f.keeper.EndBlocker(ctx.WithBlockHeight(18)))
+	has, err := f.keeper.MailboxSize.Has(ctx, "mobile-b")
+	require.NoError(t, err)
+	require.False(t, has)
+	has, err = f.keeper.MailboxSenderCount.Has(ctx, collections.Join("mobile-b", "hub-a"))
+	require.NoError(t, err)
+	require.False(t, has)
+
+	// Each sender has a limited number of messages queued per recipient
+	params.MaxMailboxBytes = 1 << 20
+	params.MaxMailboxMessagesPerSender = 2
+	require.NoError(t, f.keeper.Params.Set(ctx, params))
+	ctx = ctx.WithBlockHeight(20)
+	first, err = send(ctx, "first")
+	require.NoError(t, err)
+	_, err = send(ctx, "second")
+	require.NoError(t, err)
+	_, err = send(ctx, "third")
+	require.ErrorIs(t, err, types.ErrMailboxFull)
+
+	otherOwner, err := f.addressCodec.BytesToString([]byte("otherOwner__________________"))
+	require.NoError(t, err)
+	f.rewardsKeeper.nodes["hub-c"] = rewardstypes.Node{NodeId: "hub-c", Owner: otherOwner, IsActive: true}
+	_, err = srv.SendSignalMessage(ctx, &types.MsgSendSignalMessage{
+		Creator:          otherOwner,
+		SenderNode:       "hub-c",
+		RecipientNode:    "mobile-b",
+		ChannelId:        "channel_2",
+		EncryptedPayload: encryptedPayload(t, "third"),
+		MessageType:      keeper.MessageTypeSyncRequest,
+	})
+	require.NoError(t, err)
+
+	_, err = srv.AckMessages(ctx, &types.MsgAckMessages{Creator: recipientOwner, NodeId: "mobile-b", MessageIds: []string{first.MessageId}})
+	require.NoError(t, err)
+	count, err := f.keeper.MailboxSenderCount.Get(ctx, collections.Join("mobile-b", "hub-a"))
+	require.NoError(t, err)
+	require.EqualValues(t, 1, count)
+	_, err = send(ctx, "third")
+	require.NoError(t, err)
+}
@@ -0,0 +1,155 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+
+	"resist/x/posts/signal"
+	"resist/x/posts/types"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func (k msgServer) PublishPrekeyBundle(ctx context.Context, msg *types.MsgPublishPrekeyBundle) (*types.MsgPublishPrekeyBundleResponse, error) {
+	if err := k.checkNodeOwner(ctx, msg.Creator, msg.NodeId); err != nil {
+		return nil, err
+	}
+
+	bundle := signal.PrekeyBundle{
+		IdentityKey:           msg.IdentityKey,
+		SigningKey:            msg.SigningKey,
+		SignedPrekeyId:        msg.SignedPrekeyId,
+		SignedPrekey:          msg.SignedPrekey,
+		SignedPrekeySignature: msg.SignedPrekeySignature,
+	}
+	if err := bundle.Verify(); err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidInput, err.Error())
+	}
+
+	// One-time prekeys are bound to the identity they were generated with
+	previous, err := k.PrekeyBundle.Get(ctx, msg.NodeId)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return nil, err
+	}
+	if err == nil && (!bytes.Equal(previous.IdentityKey, msg.IdentityKey) || !bytes.Equal(previous.SigningKey, msg.SigningKey)) {
+		if err := k.removeOneTimePrekeys(ctx, msg.NodeId); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := k.PrekeyBundle.Set(ctx, msg.NodeId, types.PrekeyBundle{
+		NodeId:                msg.NodeId,
+		IdentityKey:           msg.IdentityKey,
+		SigningKey:            msg.SigningKey,
+		SignedPrekeyId:        msg.SignedPrekeyId,
+		SignedPrekey:          msg.SignedPrekey,
+		SignedPrekeySignature: msg.SignedPrekeySignature,
+		UpdatedAt:             sdk.UnwrapSDKContext(ctx).BlockTime().Unix(),
+	}); err != nil {
+		return nil, err
+	}
+	count, err := k.addOneTimePrekeys(ctx, msg.NodeId, msg.OneTimePrekeys)
+	if err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"prekey_bundle_published",
+			sdk.NewAttribute("node_id", msg.NodeId),
+			sdk.NewAttribute("signed_prekey_id", fmt.Sprintf("%d", msg.SignedPrekeyId)),
+			sdk.NewAttribute("one_time_prekey_count", fmt.Sprintf("%d", count)),
+		),
+	)
+
+	return &types.MsgPublishPrekeyBundleResponse{}, nil
+}
+
+func (k msgServer) ReplenishOneTimePrekeys(ctx context.Context, msg *types.MsgReplenishOneTimePrekeys) (*types.MsgReplenishOneTimePrekeysResponse, error) {
+	if err := k.checkNodeOwner(ctx, msg.Creator, msg.NodeId); err != nil {
+		return nil, err
+	}
+	if has, err := k.PrekeyBundle.Has(ctx, msg.NodeId); err != nil {
+		return nil, err
+	} else if !has {
+		return nil, errorsmod.Wrapf(types.ErrPrekeyBundleNotFound, "node %s", msg.NodeId)
+	}
+
+	count, err := k.addOneTimePrekeys(ctx, msg.NodeId, msg.OneTimePrekeys)
+	if err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"one_time_prekeys_replenished",
+			sdk.NewAttribute("node_id", msg.NodeId),
+			sdk.NewAttribute("one_time_prekey_count", fmt.Sprintf("%d", count)),
+		),
+	)
+
+	return &types.MsgReplenishOneTimePrekeysResponse{OneTimePrekeyCount: count}, nil
+}
+
+// ClaimPrekeyBundle returns the prekey bundle of a node along with one of its
+// one-time prekeys, which is removed so that no other channel uses it. Only
+// node owners can claim, which keeps anonymous accounts from draining the
+// one-time prekeys of a node.
+func (k msgServer) ClaimPrekeyBundle(ctx context.Context, msg *types.MsgClaimPrekeyBundle) (*types.MsgClaimPrekeyBundleResponse, error) {
+	if err := k.checkNodeOwner(ctx, msg.Creator, msg.ClaimerNodeId); err != nil {
+		return nil, err
+	}
+	if msg.ClaimerNodeId == msg.NodeId {
+		return nil, errorsmod.Wrap(types.ErrInvalidInput, "cannot claim the prekey bundle of the claimer node")
+	}
+
+	bundle, err := k.PrekeyBundle.Get(ctx, msg.NodeId)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, errorsmod.Wrapf(types.ErrPrekeyBundleNotFound, "node %s", msg.NodeId)
+		}
+		return nil, err
+	}
+	oneTimePrekey, err := k.ClaimOneTimePrekey(ctx, msg.NodeId)
+	if err != nil {
+		return nil, err
+	}
+
+	var oneTimePrekeyId uint32
+	if oneTimePrekey != nil {
+		oneTimePrekeyId = oneTimePrekey.Id
+	}
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"prekey_bundle_claimed",
+			sdk.NewAttribute("node_id", msg.NodeId),
+			sdk.NewAttribute("claimer_node_id", msg.ClaimerNodeId),
+			sdk.NewAttribute("one_time_prekey_id", fmt.Sprintf("%d", oneTimePrekeyId)),
+		),
+	)
+
+	return &types.MsgClaimPrekeyBundleResponse{PrekeyBundle: bundle, OneTimePrekey: oneTimePrekey}, nil
+}
+
+// checkNodeOwner checks that creator is the owner of a registered node.
+func (k msgServer) checkNodeOwner(ctx context.Context, creator, nodeId string) error {
+	if _, err := k.addressCodec.StringToBytes(creator); err != nil {
+		return errorsmod.Wrap(err, "invalid creator address")
+	}
+	node, err := k.rewardsKeeper.GetNode(ctx, nodeId)
+	if err != nil {
+		return err
+	}
+	if node.Owner != creator {
+		return errorsmod.Wrap(sdkerrors.ErrUnauthorized, "incorrect node owner")
+	}
+	return nil
+}
@@ -0,0 +1,124 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"resist/x/posts/keeper"
+	"resist/x/posts/signal"
+	"resist/x/posts/types"
+	rewardstypes "resist/x/rewards/types"
+)
+
+func TestPrekeyBundleMsgServer(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	aliceOwner, err := f.addressCodec.BytesToString([]byte("aliceOwner__________________"))
+	require.NoError(t, err)
+	bobOwner, err := f.addressCodec.BytesToString([]byte("bobOwner____________________"))
+	require.NoError(t, err)
+	f.rewardsKeeper.nodes["hub-a"] = rewardstypes.Node{NodeId: "hub-a", Owner: aliceOwner, IsActive: true}
+	f.rewardsKeeper.nodes["hub-b"] = rewardstypes.Node{NodeId: "hub-b", Owner: bobOwner, IsActive: true}
+
+	aliceStore, err := signal.OpenStore(t.TempDir())
+	require.NoError(t, err)
+	bobStore, err := signal.OpenStore(t.TempDir())
+	require.NoError(t, err)
+
+	bundle := bobStore.Bundle()
+	publish := &types.MsgPublishPrekeyBundle{
+		Creator:               bobOwner,
+		NodeId:                "hub-b",
+		IdentityKey:           bundle.IdentityKey,
+		SigningKey:            bundle.SigningKey,
+		SignedPrekeyId:        bundle.SignedPrekeyId,
+		SignedPrekey:          bundle.SignedPrekey,
+		SignedPrekeySignature: bundle.SignedPrekeySignature,
+		OneTimePrekeys:        oneTimePrekeys(t, bobStore, 1),
+	}
+
+	publish.Creator = aliceOwner
+	_, err = srv.PublishPrekeyBundle(f.ctx, publish)
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	publish.Creator = bobOwner
+
+	forged := *publish
+	forged.SignedPrekey = aliceStore.Bundle().SignedPrekey
+	_, err = srv.PublishPrekeyBundle(f.ctx, &forged)
+	require.ErrorIs(t, err, types.ErrInvalidInput)
+
+	_, err = srv.PublishPrekeyBundle(f.ctx, publish)
+	require.NoError(t, err)
+
+	query, err := qs.GetPrekeyBundle(f.ctx, &types.QueryGetPrekeyBundleRequest{NodeId: "hub-b"})
+	require.NoError(t, err)
+	require.Equal(t, bundle.SignedPrekey, query.PrekeyBundle.SignedPrekey)
+	require.Equal(t, uint64(1), query.OneTimePrekeyCount)
+
+	// Only node owners can claim bundles
+	_, err = srv.ClaimPrekeyBundle(f.ctx, &types.MsgClaimPrekeyBundle{Creator: bobOwner, ClaimerNodeId: "hub-a", NodeId: "hub-b"})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	_, err = srv.ClaimPrekeyBundle(f.ctx, &types.MsgClaimPrekeyBundle{Creator: bobOwner, ClaimerNodeId: "hub-b", NodeId: "hub-a"})
+	require.ErrorIs(t, err, types.ErrPrekeyBundleNotFound)
+
+	claim, err := srv.ClaimPrekeyBundle(f.ctx, &types.MsgClaimPrekeyBundle{Creator: aliceOwner, ClaimerNodeId: "hub-a", NodeId: "hub-b"})
+	require.NoError(t, err)
+	require.NotNil(t, claim.OneTimePrekey)
+	require.Equal(t, publish.OneTimePrekeys[0].Key, claim.OneTimePrekey.Key)
+
+	// The claimed bundle opens a channel consuming the one-time prekey
+	_, err = aliceStore.InitiateSession("channel_1", keeper.ClaimedPrekeyBundle(claim))
+	require.NoError(t, err)
+	envelope, err := aliceStore.Encrypt("channel_1", []byte("hello bob"))
+	require.NoError(t, err)
+	require.Equal(t, claim.OneTimePrekey.Id, envelope.PreKey.OneTimePrekeyId)
+	plaintext, err := bobStore.Decrypt("channel_1", envelope)
+	require.NoError(t, err)
+	require.Equal(t, "hello bob", string(plaintext))
+
+	// Once exhausted, bundles are claimed without one-time prekey
+	claim, err = srv.ClaimPrekeyBundle(f.ctx, &types.MsgClaimPrekeyBundle{Creator: aliceOwner, ClaimerNodeId: "hub-a", NodeId: "hub-b"})
+	require.NoError(t, err)
+	require.Nil(t, claim.OneTimePrekey)
+	require.NoError(t, keeper.ClaimedPrekeyBundle(claim).Verify())
+
+	replenished, err := srv.ReplenishOneTimePrekeys(f.ctx, &types.MsgReplenishOneTimePrekeys{Creator: bobOwner, NodeId: "hub-b", OneTimePrekeys: oneTimePrekeys(t, bobStore, 3)})
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), replenished.OneTimePrekeyCount)
+
+	_, err = srv.ReplenishOneTimePrekeys(f.ctx, &types.MsgReplenishOneTimePrekeys{Creator: bobOwner, NodeId: "hub-b", OneTimePrekeys: oneTimePrekeys(t, bobStore, int(types.DefaultMaxOneTimePrekeys))})
+	require.ErrorIs(t, err, types.ErrInvalidInput)
+
+	// A new identity invalidates the one-time prekeys of the previous one
+	newStore, err := signal.OpenStore(t.TempDir())
+	require.NoError(t, err)
+	bundle = newStore.Bundle()
+	_, err = srv.PublishPrekeyBundle(f.ctx, &types.MsgPublishPrekeyBundle{
+		Creator:               bobOwner,
+		NodeId:                "hub-b",
+		IdentityKey:           bundle.IdentityKey,
+		SigningKey:            bundle.SigningKey,
+		SignedPrekeyId:        bundle.SignedPrekeyId,
+		SignedPrekey:          bundle.SignedPrekey,
+		SignedPrekeySignature: bundle.SignedPrekeySignature,
+	})
+	require.NoError(t, err)
+	query, err = qs.GetPrekeyBundle(f.ctx, &types.QueryGetPrekeyBundleRequest{NodeId: "hub-b"})
+	require.NoError(t, err)
+	require.Zero(t, query.OneTimePrekeyCount)
+}
+
+func oneTimePrekeys(t *testing.T, store *signal.Store, n int) []types.OneTimePrekey {
+	t.Helper()
+	generated, err := store.GenerateOneTimePrekeys(n)
+	require.NoError(t, err)
+	prekeys := make([]types.OneTimePrekey, 0, n)
+	for id, key := range generated {
+		prekeys = append(prekeys, types.OneTimePrekey{Id: id, Key: key})
+	}
+	return prekeys
+}
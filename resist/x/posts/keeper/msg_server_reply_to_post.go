@@ -0,0 +1,103 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"resist/x/posts/types"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func (k msgServer) ReplyToPost(ctx context.Context, msg *types.MsgReplyToPost) (*types.MsgReplyToPostResponse, error) {
+	if _, err := k.addressCodec.StringToBytes(msg.Creator); err != nil {
+		return nil, errorsmod.Wrap(err, "invalid creator address")
+	}
+	if msg.Content == "" {
+		return nil, errorsmod.Wrap(types.ErrInvalidInput, "content cannot be empty")
+	}
+
+	parent, err := k.getPost(ctx, msg.ParentIndex)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.checkNotBlocked(ctx, parent, msg.Creator); err != nil {
+		return nil, err
+	}
+	quotedIndex, err := k.checkQuotable(ctx, msg.QuotedIndex, msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+
+	postIndex, err := k.nextPostIndex(ctx)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to get post index")
+	}
+
+	rootIndex := parent.RootIndex
+	if rootIndex == "" {
+		rootIndex = parent.Index
+	}
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	reply := types.SocialPost{
+		Index:       postIndex,
+		Content:     msg.Content,
+		MediaUrl:    msg.MediaUrl,
+		MediaType:   msg.MediaType,
+		GroupId:     parent.GroupId,
+		Author:      msg.Creator,
+		CreatedAt:   uint64(sdkCtx.BlockTime().Unix()),
+		Creator:     msg.Creator,
+		Intent:      "discuss",
+		ContextType: "opinion",
+		ParentIndex: parent.Index,
+		RootIndex:   rootIndex,
+		QuotedIndex: quotedIndex,
+	}
+	if err := k.SocialPost.Set(ctx, postIndex, reply); err != nil {
+		return nil, errorsmod.Wrap(err, "failed to store reply")
+	}
+	if err := k.setReply(ctx, reply); err != nil {
+		return nil, errorsmod.Wrap(err, "failed to index reply")
+	}
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"post_replied",
+			sdk.NewAttribute("post_index", postIndex),
+			sdk.NewAttribute("parent_index", parent.Index),
+			sdk.NewAttribute("root_index", rootIndex),
+			sdk.NewAttribute("creator", msg.Creator),
+		),
+	)
+
+	return &types.MsgReplyToPostResponse{Index: postIndex}, nil
+}
+
+// getPost returns the post at index or at the alias index, or
+// ErrPostNotFound.
+func (k Keeper) getPost(ctx context.Context, index string) (types.SocialPost, error) {
+	post, err := k.lookupPost(ctx, index)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.SocialPost{}, errorsmod.Wrapf(types.ErrPostNotFound, "post %q", index)
+	} else if err != nil {
+		return types.SocialPost{}, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+	return post, nil
+}
+
+// checkQuotable checks that the post at quotedIndex, if any, exists and can
+// be quoted by address, and returns its current index.
+func (k Keeper) checkQuotable(ctx context.Context, quotedIndex, address string) (string, error) {
+	if quotedIndex == "" {
+		return "", nil
+	}
+	quoted, err := k.getPost(ctx, quotedIndex)
+	if err != nil {
+		return "", err
+	}
+	return quoted.Index, k.checkNotBlocked(ctx, quoted, address)
+}
@@ -0,0 +1,225 @@
+package keeper_test
+
+import (
+	"strconv"
+	"testing"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+
+	"resist/x/posts/keeper"
+	"resist/x/posts/types"
+)
+
+func TestReplyToPost(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	ctx := sdk.UnwrapSDKContext(f.ctx)
+
+	author, err := f.addressCodec.BytesToString([]byte("author______________________"))
+	require.NoError(t, err)
+	replier, err := f.addressCodec.BytesToString([]byte("replier_____________________"))
+	require.NoError(t, err)
+	require.NoError(t, f.keeper.SocialPost.Set(ctx, "root", types.SocialPost{Index: "root", Author: author, Creator: author, GroupId: 7}))
+
+	reply := func(height int64, creator, parent string) (string, error) {
+		resp, err := srv.ReplyToPost(ctx.WithBlockHeight(height), &types.MsgReplyToPost{Creator: creator, ParentIndex: parent, Content: "reply"})
+		if err != nil {
+			return "", err
+		}
+		return resp.Index, nil
+	}
+
+	_, err = reply(1, replier, "missing")
+	require.ErrorIs(t, err, types.ErrPostNotFound)
+	_, err = srv.ReplyToPost(ctx, &types.MsgReplyToPost{Creator: replier, ParentIndex: "root"})
+	require.ErrorIs(t, err, types.ErrInvalidInput)
+
+	// Replies created in the same block do not collide
+	first, err := reply(1, replier, "root")
+	require.NoError(t, err)
+	second, err := reply(1, author, "root")
+	require.NoError(t, err)
+	require.NotEqual(t, first, second)
+	nested, err := reply(3, author, first)
+	require.NoError(t, err)
+	deepest, err := reply(4, replier, nested)
+	require.NoError(t, err)
+
+	post, err := f.keeper.SocialPost.Get(ctx, deepest)
+	require.NoError(t, err)
+	require.Equal(t, nested, post.ParentIndex)
+	require.Equal(t, "root", post.RootIndex)
+	require.EqualValues(t, 7, post.GroupId)
+	root, err := f.keeper.SocialPost.Get(ctx, "root")
+	require.NoError(t, err)
+	require.EqualValues(t, 2, root.ReplyCount)
+
+	// Blocked addresses cannot reply to, nor quote, the posts of the blocker
+	f.identityKeeper.blocks[[2]string{author, replier}] = true
+	_, err = reply(5, replier, "root")
+	require.ErrorIs(t, err, types.ErrBlocked)
+	_, err = srv.ReplyToPost(ctx.WithBlockHeight(5), &types.MsgReplyToPost{Creator: replier, ParentIndex: deepest, Content: "quote", QuotedIndex: second})
+	require.ErrorIs(t, err, types.ErrBlocked)
+	_, err = srv.CreatePost(ctx.WithBlockHeight(5), &types.MsgCreatePost{Creator: replier, Title: "quote", Content: "quote", QuotedIndex: second})
+	require.ErrorIs(t, err, types.ErrBlocked)
+	delete(f.identityKeeper.blocks, [2]string{author, replier})
+
+	_, err = srv.CreatePost(ctx.WithBlockHeight(5), &types.MsgCreatePost{Creator: replier, Title: "quote", Content: "quote", QuotedIndex: "missing"})
+	require.ErrorIs(t, err, types.ErrPostNotFound)
+	_, err = srv.CreatePost(ctx.WithBlockHeight(5), &types.MsgCreatePost{Creator: replier, Title: "quote", Content: "quote", QuotedIndex: second})
+	require.NoError(t, err)
+
+	// Threads are paginated over the direct replies and depth limited
+	thread, err := qs.GetThread(ctx, &types.QueryGetThreadRequest{Index: "root", MaxDepth: 2})
+	require.NoError(t, err)
+	require.Equal(t, "root", thread.Post.Index)
+	require.Len(t, thread.Replies, 2)
+	require.Equal(t, first, thread.Replies[0].Post.Index)
+	require.Len(t, thread.Replies[0].Replies, 1)
+	require.Equal(t, nested, thread.Replies[0].Replies[0].Post.Index)
+	require.Empty(t, thread.Replies[0].Replies[0].Replies)
+	require.EqualValues(t, 1, thread.Replies[0].Replies[0].Post.ReplyCount)
+
+	thread, err = qs.GetThread(ctx, &types.QueryGetThreadRequest{Index: "root", Pagination: &query.PageRequest{Limit: 1, CountTotal: true}})
+	require.NoError(t, err)
+	require.Len(t, thread.Replies, 1)
+	require.EqualValues(t, 2, thread.Pagination.Total)
+	require.Equal(t, deepest, thread.Replies[0].Replies[0].Replies[0].Post.Index)
+
+	_, err = qs.GetThread(ctx, &types.QueryGetThreadRequest{Index: "missing"})
+	require.Error(t, err)
+
+	// Updates keep the thread, and deleting a reply uncounts it
+	_, err = srv.UpdateSocialPost(ctx, &types.MsgUpdateSocialPost{Creator: author, Index: second, Content: "edited"})
+	require.NoError(t, err)
+	post, err = f.keeper.SocialPost.Get(ctx, second)
+	require.NoError(t, err)
+	require.Equal(t, "root", post.ParentIndex)
+	require.Equal(t, "root", post.RootIndex)
+
+	_, err = srv.DeleteSocialPost(ctx, &types.MsgDeleteSocialPost{Creator: author, Index: second})
+	require.NoError(t, err)
+	root, err = f.keeper.SocialPost.Get(ctx, "root")
+	require.NoError(t, err)
+	require.EqualValues(t, 1, root.ReplyCount)
+	thread, err = qs.GetThread(ctx, &types.QueryGetThreadRequest{Index: "root"})
+	require.NoError(t, err)
+	require.Len(t, thread.Replies, 1)
+
+	// Deleting a replied post leaves its tombstone in the thread, which
+	// cannot be replied to
+	_, err = srv.DeleteSocialPost(ctx, &types.MsgDeleteSocialPost{Creator: replier, Index: first})
+	require.NoError(t, err)
+	_, err = srv.DeleteSocialPost(ctx, &types.MsgDeleteSocialPost{Creator: author, Index: nested})
+	require.NoError(t, err)
+	root, err = f.keeper.SocialPost.Get(ctx, "root")
+	require.NoError(t, err)
+	require.EqualValues(t, 1, root.ReplyCount)
+	_, err = reply(6, author, first)
+	require.ErrorIs(t, err, types.ErrPostNotFound)
+
+	thread, err = qs.GetThread(ctx, &types.QueryGetThreadRequest{Index: "root"})
+	require.NoError(t, err)
+	require.Len(t, thread.Replies, 1)
+	require.True(t, thread.Replies[0].Deleted)
+	require.Equal(t, first, thread.Replies[0].Post.Index)
+	require.Empty(t, thread.Replies[0].Post.Content)
+	require.Empty(t, thread.Replies[0].Post.Author)
+	require.True(t, thread.Replies[0].Replies[0].Deleted)
+	require.Equal(t, deepest, thread.Replies[0].Replies[0].Replies[0].Post.Index)
+	thread, err = qs.GetThread(ctx, &types.QueryGetThreadRequest{Index: first})
+	require.NoError(t, err)
+	require.True(t, thread.Deleted)
+	require.Len(t, thread.Replies, 1)
+
+	// Deleting the last reply below tombstones removes them from the thread
+	_, err = srv.DeleteSocialPost(ctx, &types.MsgDeleteSocialPost{Creator: replier, Index: deepest})
+	require.NoError(t, err)
+	root, err = f.keeper.SocialPost.Get(ctx, "root")
+	require.NoError(t, err)
+	require.Zero(t, root.ReplyCount)
+	for _, index := range []string{first, nested} {
+		has, err := f.keeper.DeletedPost.Has(ctx, index)
+		require.NoError(t, err)
+		require.False(t, has)
+	}
+	hasReplies := false
+	require.NoError(t, f.keeper.Reply.Walk(ctx, nil, func(collections.Pair[string, uint64]) (bool, error) {
+		hasReplies = true
+		return true, nil
+	}))
+	require.False(t, hasReplies)
+	_, err = qs.GetThread(ctx, &types.QueryGetThreadRequest{Index: first})
+	require.Error(t, err)
+}
+
+func TestThreadReplyOrder(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	ctx := sdk.UnwrapSDKContext(f.ctx)
+
+	author, err := f.addressCodec.BytesToString([]byte("author______________________"))
+	require.NoError(t, err)
+	require.NoError(t, f.keeper.SocialPost.Set(ctx, "root", types.SocialPost{Index: "root", Author: author, Creator: author}))
+
+	// Replies are listed in creation order past the first ten
+	var indexes []string
+	for range 12 {
+		resp, err := srv.ReplyToPost(ctx, &types.MsgReplyToPost{Creator: author, ParentIndex: "root", Content: "reply"})
+		require.NoError(t, err)
+		indexes = append(indexes, resp.Index)
+	}
+	thread, err := qs.GetThread(ctx, &types.QueryGetThreadRequest{Index: "root"})
+	require.NoError(t, err)
+	var replies []string
+	for _, node := range thread.Replies {
+		replies = append(replies, node.Post.Index)
+	}
+	require.Equal(t, indexes, replies)
+}
+
+func TestThreadNodeBudget(t *testing.T) {
+	f := initFixture(t)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	ctx := sdk.UnwrapSDKContext(f.ctx)
+
+	// A reply with 20 replies of 20 replies each
+	var next uint64
+	addPost := func(parent string) string {
+		index := strconv.FormatUint(next, 10)
+		require.NoError(t, f.keeper.SocialPost.Set(ctx, index, types.SocialPost{Index: index, ParentIndex: parent}))
+		if parent != "" {
+			require.NoError(t, f.keeper.Reply.Set(ctx, collections.Join(parent, next)))
+		}
+		next++
+		return index
+	}
+	root := addPost("")
+	reply := addPost(root)
+	for range 20 {
+		child := addPost(reply)
+		for range 20 {
+			addPost(child)
+		}
+	}
+
+	// The posts below the direct replies are capped in total
+	var count func(nodes []types.ThreadNode) int
+	count = func(nodes []types.ThreadNode) int {
+		n := len(nodes)
+		for _, node := range nodes {
+			n += count(node.Replies)
+		}
+		return n
+	}
+	thread, err := qs.GetThread(ctx, &types.QueryGetThreadRequest{Index: root, MaxDepth: 3})
+	require.NoError(t, err)
+	require.Len(t, thread.Replies, 1)
+	require.Equal(t, 200, count(thread.Replies[0].Replies))
+	require.Len(t, thread.Replies[0].Replies, 10)
+}
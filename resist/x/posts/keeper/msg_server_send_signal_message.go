@@ -2,22 +2,35 @@ package keeper
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
 
+	"resist/x/posts/signal"
 	"resist/x/posts/types"
 
+	"cosmossdk.io/collections"
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+// SendSignalMessage queues an encrypted message in the mailbox of the
+// recipient node, where it stays until the recipient acknowledges it with
+// MsgAckMessages or it expires.
 func (k msgServer) SendSignalMessage(ctx context.Context, msg *types.MsgSendSignalMessage) (*types.MsgSendSignalMessageResponse, error) {
-	if _, err := k.addressCodec.StringToBytes(msg.Creator); err != nil {
-		return nil, errorsmod.Wrap(err, "invalid creator address")
+	// Only node owners can fill mailboxes
+	if err := k.checkNodeOwner(ctx, msg.Creator, msg.SenderNode); err != nil {
+		return nil, err
 	}
 
 	// Validate input
 	if msg.RecipientNode == "" {
 		return nil, errorsmod.Wrap(types.ErrInvalidInput, "recipient node cannot be empty")
 	}
+	if _, err := k.rewardsKeeper.GetNode(ctx, msg.RecipientNode); err != nil {
+		return nil, err
+	}
 
 	if msg.ChannelId == "" {
 		return nil, errorsmod.Wrap(types.ErrInvalidInput, "channel ID cannot be empty")
@@ -27,6 +40,11 @@ func (k msgServer) SendSignalMessage(ctx context.Context, msg *types.MsgSendSign
 		return nil, errorsmod.Wrap(types.ErrInvalidInput, "encrypted payload cannot be empty")
 	}
 
+	// Only Double Ratchet envelopes are relayed, never plaintext
+	if _, err := signal.UnmarshalEnvelope(msg.EncryptedPayload); err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidInput, err.Error())
+	}
+
 	if msg.MessageType == "" {
 		return nil, errorsmod.Wrap(types.ErrInvalidInput, "message type cannot be empty")
 	}
@@ -45,52 +63,116 @@ func (k msgServer) SendSignalMessage(ctx context.Context, msg *types.MsgSendSign
 		return nil, errorsmod.Wrap(types.ErrInvalidInput, "invalid message type")
 	}
 
-	// Initialize Signal protocol service
-	signalService := NewSignalProtocolService(&k.Keeper)
-
-	// In production, this would validate the channel exists and sender has permission
-	// For now, we'll assume the channel is valid
-
-	// Generate message ID and send the message
-	messageId, err := signalService.SendSecureMessage(
-		ctx,
-		msg.Creator, // sender node (derived from creator address)
-		msg.RecipientNode,
-		msg.ChannelId,
-		msg.MessageType,
-		msg.EncryptedPayload,
-	)
+	params, err := k.Params.Get(ctx)
 	if err != nil {
-		return nil, errorsmod.Wrap(err, "failed to send Signal message")
+		return nil, err
 	}
 
-	// In a production environment, this would:
-	// 1. Validate that the recipient node exists and is reachable
-	// 2. Verify the sender has permission to send to this channel
-	// 3. Queue the message for delivery if recipient is offline
-	// 4. Implement delivery confirmations and retry logic
-	// 5. Handle forward secrecy key rotation
-	// 6. Rate limit messages to prevent spam
-
-	// For demo purposes, assume delivery is always confirmed
-	deliveryConfirmed := true
-
-	// Emit event
+	// The payload is encrypted by the sender node with its SignalProtocolService,
+	// channel keys never reach the chain. The message id commits to the envelope.
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	message := types.SignalMessage{
+		MessageId:        signalMessageID(sdkCtx.BlockHeight(), msg),
+		SenderNode:       msg.SenderNode,
+		RecipientNode:    msg.RecipientNode,
+		ChannelId:        msg.ChannelId,
+		EncryptedPayload: msg.EncryptedPayload,
+		MessageType:      msg.MessageType,
+		Timestamp:        sdkCtx.BlockTime().Unix(),
+		Signature:        msg.Signature,
+		ExpiryHeight:     sdkCtx.BlockHeight() + params.MailboxTtlBlocks,
+	}
+	size := uint64(message.Size())
+	if size > params.MaxSignalMessageBytes {
+		return nil, errorsmod.Wrapf(types.ErrInvalidInput, "message of %d bytes exceeds the limit of %d bytes", size, params.MaxSignalMessageBytes)
+	}
+	mailboxSize, err := k.MailboxSize.Get(ctx, msg.RecipientNode)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return nil, err
+	}
+	if mailboxSize+size > params.MaxMailboxBytes {
+		return nil, errorsmod.Wrapf(types.ErrMailboxFull, "node %s holds %d bytes of messages", msg.RecipientNode, mailboxSize)
+	}
+	// No sender can fill the mailbox of a node alone
+	senderCount, err := k.MailboxSenderCount.Get(ctx, collections.Join(msg.RecipientNode, msg.SenderNode))
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return nil, err
+	}
+	if senderCount >= params.MaxMailboxMessagesPerSender {
+		return nil, errorsmod.Wrapf(types.ErrMailboxFull, "node %s holds %d messages from node %s", msg.RecipientNode, senderCount, msg.SenderNode)
+	}
+	if has, err := k.Mailbox.Has(ctx, collections.Join(message.RecipientNode, message.MessageId)); err != nil {
+		return nil, err
+	} else if has {
+		return nil, errorsmod.Wrapf(types.ErrInvalidInput, "message %s already queued", message.MessageId)
+	}
+	if err := k.QueueSignalMessage(ctx, message); err != nil {
+		return nil, err
+	}
+
 	sdkCtx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			"signal_message_sent",
-			sdk.NewAttribute("message_id", messageId),
+			sdk.NewAttribute("message_id", message.MessageId),
 			sdk.NewAttribute("sender", msg.Creator),
+			sdk.NewAttribute("sender_node", msg.SenderNode),
 			sdk.NewAttribute("recipient_node", msg.RecipientNode),
 			sdk.NewAttribute("channel_id", msg.ChannelId),
 			sdk.NewAttribute("message_type", msg.MessageType),
-			sdk.NewAttribute("delivery_confirmed", "true"),
+			sdk.NewAttribute("expiry_height", fmt.Sprintf("%d", message.ExpiryHeight)),
 		),
 	)
 
+	// Delivery is confirmed later, when the recipient acknowledges the message
 	return &types.MsgSendSignalMessageResponse{
-		MessageId:         messageId,
-		DeliveryConfirmed: deliveryConfirmed,
+		MessageId:         message.MessageId,
+		DeliveryConfirmed: false,
 	}, nil
-}
\ No newline at end of file
+}
+
+func (k msgServer) AckMessages(ctx context.Context, msg *types.MsgAckMessages) (*types.MsgAckMessagesResponse, error) {
+	if err := k.checkNodeOwner(ctx, msg.Creator, msg.NodeId); err != nil {
+		return nil, err
+	}
+	if len(msg.MessageIds) == 0 {
+		return nil, errorsmod.Wrap(types.ErrInvalidInput, "message ids cannot be empty")
+	}
+
+	// Messages already pruned or acknowledged are skipped
+	var acked uint64
+	for _, messageId := range msg.MessageIds {
+		message, err := k.Mailbox.Get(ctx, collections.Join(msg.NodeId, messageId))
+		if errors.Is(err, collections.ErrNotFound) {
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+		if err := k.RemoveSignalMessage(ctx, message); err != nil {
+			return nil, err
+		}
+		acked++
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"signal_messages_acked",
+			sdk.NewAttribute("node_id", msg.NodeId),
+			sdk.NewAttribute("acked", fmt.Sprintf("%d", acked)),
+		),
+	)
+
+	return &types.MsgAckMessagesResponse{Acked: acked}, nil
+}
+
+// signalMessageID derives the id of a relayed message from its content, so
+// that every validator computes the same id.
+func signalMessageID(height int64, msg *types.MsgSendSignalMessage) string {
+	h := sha256.New()
+	h.Write(binary.BigEndian.AppendUint64(nil, uint64(height)))
+	for _, field := range [][]byte{[]byte(msg.Creator), []byte(msg.RecipientNode), []byte(msg.ChannelId), []byte(msg.MessageType), msg.EncryptedPayload} {
+		h.Write(binary.BigEndian.AppendUint32(nil, uint32(len(field))))
+		h.Write(field)
+	}
+	return fmt.Sprintf("msg_%x", h.Sum(nil)[:16])
+}
@@ -9,6 +9,7 @@ import (
 
 	"cosmossdk.io/collections"
 	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
@@ -17,33 +18,31 @@ func (k msgServer) CreateSocialPost(ctx context.Context, msg *types.MsgCreateSoc
 		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid address: %s", err))
 	}
 
-	// Check if the value already exists
-	ok, err := k.SocialPost.Has(ctx, msg.Index)
+	// The index of the post is assigned by the chain, msg.Index is ignored
+	index, err := k.nextPostIndex(ctx)
 	if err != nil {
 		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
-	} else if ok {
-		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "index already set")
 	}
 
+	// The author, votes and creation time are set by the chain, the values of
+	// the message are ignored
 	var socialPost = types.SocialPost{
 		Creator:   msg.Creator,
-		Index:     msg.Index,
+		Index:     index,
 		Title:     msg.Title,
 		Content:   msg.Content,
 		MediaUrl:  msg.MediaUrl,
 		MediaType: msg.MediaType,
 		GroupId:   msg.GroupId,
-		Author:    msg.Author,
-		Upvotes:   msg.Upvotes,
-		Downvotes: msg.Downvotes,
-		CreatedAt: msg.CreatedAt,
+		Author:    msg.Creator,
+		CreatedAt: uint64(sdk.UnwrapSDKContext(ctx).BlockTime().Unix()),
 	}
 
 	if err := k.SocialPost.Set(ctx, socialPost.Index, socialPost); err != nil {
 		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
 	}
 
-	return &types.MsgCreateSocialPostResponse{}, nil
+	return &types.MsgCreateSocialPostResponse{Index: index}, nil
 }
 
 func (k msgServer) UpdateSocialPost(ctx context.Context, msg *types.MsgUpdateSocialPost) (*types.MsgUpdateSocialPostResponse, error) {
@@ -52,7 +51,7 @@ func (k msgServer) UpdateSocialPost(ctx context.Context, msg *types.MsgUpdateSoc
 	}
 
 	// Check if the value exists
-	val, err := k.SocialPost.Get(ctx, msg.Index)
+	val, err := k.lookupPost(ctx, msg.Index)
 	if err != nil {
 		if errors.Is(err, collections.ErrNotFound) {
 			return nil, errorsmod.Wrap(sdkerrors.ErrKeyNotFound, "index not set")
@@ -68,16 +67,23 @@ func (k msgServer) UpdateSocialPost(ctx context.Context, msg *types.MsgUpdateSoc
 
 	var socialPost = types.SocialPost{
 		Creator:   msg.Creator,
-		Index:     msg.Index,
+		Index:     val.Index,
 		Title:     msg.Title,
 		Content:   msg.Content,
 		MediaUrl:  msg.MediaUrl,
 		MediaType: msg.MediaType,
 		GroupId:   msg.GroupId,
-		Author:    msg.Author,
-		Upvotes:   msg.Upvotes,
-		Downvotes: msg.Downvotes,
-		CreatedAt: msg.CreatedAt,
+		// The author, votes, creation time, thread and sources of a post
+		// cannot change
+		Author:        val.Author,
+		Upvotes:       val.Upvotes,
+		Downvotes:     val.Downvotes,
+		CreatedAt:     val.CreatedAt,
+		ParentIndex:   val.ParentIndex,
+		RootIndex:     val.RootIndex,
+		QuotedIndex:   val.QuotedIndex,
+		ReplyCount:    val.ReplyCount,
+		SourceIndexes: val.SourceIndexes,
 	}
 
 	if err := k.SocialPost.Set(ctx, socialPost.Index, socialPost); err != nil {
@@ -93,7 +99,7 @@ func (k msgServer) DeleteSocialPost(ctx context.Context, msg *types.MsgDeleteSoc
 	}
 
 	// Check if the value exists
-	val, err := k.SocialPost.Get(ctx, msg.Index)
+	val, err := k.lookupPost(ctx, msg.Index)
 	if err != nil {
 		if errors.Is(err, collections.ErrNotFound) {
 			return nil, errorsmod.Wrap(sdkerrors.ErrKeyNotFound, "index not set")
@@ -107,9 +113,12 @@ func (k msgServer) DeleteSocialPost(ctx context.Context, msg *types.MsgDeleteSoc
 		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "incorrect owner")
 	}
 
-	if err := k.SocialPost.Remove(ctx, msg.Index); err != nil {
+	if err := k.deletePost(ctx, val); err != nil {
 		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to remove socialPost")
 	}
+	if err := k.unindexPostSources(ctx, val); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to remove post sources")
+	}
 
 	return &types.MsgDeleteSocialPostResponse{}, nil
 }
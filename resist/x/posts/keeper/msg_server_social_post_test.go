@@ -3,7 +3,9 @@ package keeper_test
 import (
 	"strconv"
 	"testing"
+	"time"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/stretchr/testify/require"
 
@@ -18,15 +20,33 @@ func TestSocialPostMsgServerCreate(t *testing.T) {
 	require.NoError(t, err)
 
 	for i := 0; i < 5; i++ {
-		expected := &types.MsgCreateSocialPost{Creator: creator,
-			Index: strconv.Itoa(i),
-		}
-		_, err := srv.CreateSocialPost(f.ctx, expected)
+		expected := &types.MsgCreateSocialPost{Creator: creator}
+		resp, err := srv.CreateSocialPost(f.ctx, expected)
 		require.NoError(t, err)
-		rst, err := f.keeper.SocialPost.Get(f.ctx, expected.Index)
+		require.Equal(t, strconv.Itoa(i), resp.Index)
+		rst, err := f.keeper.SocialPost.Get(f.ctx, resp.Index)
 		require.NoError(t, err)
 		require.Equal(t, expected.Creator, rst.Creator)
 	}
+
+	// The author, votes and creation time given by the creator are ignored
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(time.Unix(1000, 0))
+	other, err := f.addressCodec.BytesToString([]byte("otherAddr___________________"))
+	require.NoError(t, err)
+	resp, err := srv.CreateSocialPost(ctx, &types.MsgCreateSocialPost{Creator: creator, Author: other, Upvotes: 100, Downvotes: 3, CreatedAt: 1})
+	require.NoError(t, err)
+	rst, err := f.keeper.SocialPost.Get(ctx, resp.Index)
+	require.NoError(t, err)
+	require.Equal(t, creator, rst.Author)
+	require.Zero(t, rst.Upvotes)
+	require.Zero(t, rst.Downvotes)
+	require.EqualValues(t, 1000, rst.CreatedAt)
+
+	_, err = srv.UpdateSocialPost(ctx, &types.MsgUpdateSocialPost{Creator: creator, Index: resp.Index, Author: other, Upvotes: 100, Downvotes: 3, CreatedAt: 1})
+	require.NoError(t, err)
+	updated, err := f.keeper.SocialPost.Get(ctx, resp.Index)
+	require.NoError(t, err)
+	require.Equal(t, rst, updated)
 }
 
 func TestSocialPostMsgServerUpdate(t *testing.T) {
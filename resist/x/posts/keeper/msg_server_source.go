@@ -25,18 +25,18 @@ func (k msgServer) CreateSource(ctx context.Context, msg *types.MsgCreateSource)
 		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "index already set")
 	}
 
+	// The credibility score of the source is computed, not taken from msg, and
+	// a source is only verified through MsgVerifySource
 	var source = types.Source{
-		Creator:          msg.Creator,
-		Index:            msg.Index,
-		Url:              msg.Url,
-		Title:            msg.Title,
-		Description:      msg.Description,
-		CredibilityScore: msg.CredibilityScore,
-		AnalysisSummary:  msg.AnalysisSummary,
-		Verified:         msg.Verified,
+		Creator:         msg.Creator,
+		Index:           msg.Index,
+		Url:             msg.Url,
+		Title:           msg.Title,
+		Description:     msg.Description,
+		AnalysisSummary: msg.AnalysisSummary,
 	}
 
-	if err := k.Source.Set(ctx, source.Index, source); err != nil {
+	if err := k.setSource(ctx, source); err != nil {
 		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
 	}
 
@@ -64,17 +64,21 @@ func (k msgServer) UpdateSource(ctx context.Context, msg *types.MsgUpdateSource)
 	}
 
 	var source = types.Source{
-		Creator:          msg.Creator,
-		Index:            msg.Index,
-		Url:              msg.Url,
-		Title:            msg.Title,
-		Description:      msg.Description,
-		CredibilityScore: msg.CredibilityScore,
-		AnalysisSummary:  msg.AnalysisSummary,
-		Verified:         msg.Verified,
+		Creator:         msg.Creator,
+		Index:           msg.Index,
+		Url:             msg.Url,
+		Title:           msg.Title,
+		Description:     msg.Description,
+		AnalysisSummary: msg.AnalysisSummary,
+		// The verification, votes and report outcomes of the source are kept
+		Verified:         val.Verified,
+		Upvotes:          val.Upvotes,
+		Downvotes:        val.Downvotes,
+		ResolvedReports:  val.ResolvedReports,
+		DismissedReports: val.DismissedReports,
 	}
 
-	if err := k.Source.Set(ctx, source.Index, source); err != nil {
+	if err := k.setSource(ctx, source); err != nil {
 		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to update source")
 	}
 
@@ -101,9 +105,20 @@ func (k msgServer) DeleteSource(ctx context.Context, msg *types.MsgDeleteSource)
 		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "incorrect owner")
 	}
 
+	// Sources cited by posts are kept, as their credibility informs readers
+	cited, err := k.isCited(ctx, msg.Index)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	} else if cited {
+		return nil, errorsmod.Wrap(types.ErrSourceCited, msg.Index)
+	}
+
 	if err := k.Source.Remove(ctx, msg.Index); err != nil {
 		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to remove source")
 	}
+	if err := k.SourceVote.Clear(ctx, collections.NewPrefixedPairRange[string, string](msg.Index)); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to remove source votes")
+	}
 
 	return &types.MsgDeleteSourceResponse{}, nil
 }
@@ -8,6 +8,7 @@ import (
 
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 func (k msgServer) SyncHubContent(ctx context.Context, msg *types.MsgSyncHubContent) (*types.MsgSyncHubContentResponse, error) {
@@ -38,12 +39,30 @@ func (k msgServer) SyncHubContent(ctx context.Context, msg *types.MsgSyncHubCont
 		return nil, errorsmod.Wrap(types.ErrInvalidInput, "invalid sync method")
 	}
 
+	if msg.SourceNode == msg.TargetNode {
+		return nil, errorsmod.Wrap(types.ErrInvalidInput, "source and target nodes must differ")
+	}
+
+	// Both hubs must be registered and operated by the creator on one side
+	sourceNode, err := k.rewardsKeeper.GetNode(ctx, msg.SourceNode)
+	if err != nil {
+		return nil, err
+	}
+	targetNode, err := k.rewardsKeeper.GetNode(ctx, msg.TargetNode)
+	if err != nil {
+		return nil, err
+	}
+	if sourceNode.Owner != msg.Creator && targetNode.Owner != msg.Creator {
+		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "creator owns neither the source nor the target node")
+	}
+
 	// Initialize content distribution service
 	distributionService := NewContentDistributionService(&k.Keeper)
 
 	// Initiate hub synchronization
-	syncId, err := distributionService.InitiateHubSync(
+	hubSync, err := distributionService.InitiateHubSync(
 		ctx,
+		msg.Creator,
 		msg.SourceNode,
 		msg.TargetNode,
 		msg.ContentIds,
@@ -52,19 +71,11 @@ func (k msgServer) SyncHubContent(ctx context.Context, msg *types.MsgSyncHubCont
 	if err != nil {
 		return nil, errorsmod.Wrap(err, "failed to initiate hub sync")
 	}
+	syncId := hubSync.SyncId
 
-	// Calculate estimated transfer size and duration
-	// In production, this would query actual content sizes and network conditions
-	estimatedBytes := uint64(len(msg.ContentIds)) * 1024 * 1024 // Assume 1MB per content item
-	estimatedDuration := int64(len(msg.ContentIds)) * 30        // Assume 30 seconds per content item
-
-	// In a production environment, this would:
-	// 1. Validate that both nodes exist and are accessible
-	// 2. Establish secure Signal protocol connection between nodes
-	// 3. Begin content transfer process
-	// 4. Monitor transfer progress
-	// 5. Handle error recovery and retries
-	// 6. Update hub metrics upon completion
+	// Estimate the transfer from the size of the content and the bandwidth of the hubs
+	estimatedBytes := hubSync.TotalBytes
+	estimatedDuration := EstimateSyncDuration(estimatedBytes, sourceNode, targetNode)
 
 	// Emit event
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
@@ -0,0 +1,58 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strconv"
+
+	"resist/x/posts/types"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func (k msgServer) VerifySource(ctx context.Context, msg *types.MsgVerifySource) (*types.MsgVerifySourceResponse, error) {
+	creator, err := k.addressCodec.StringToBytes(msg.Creator)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "invalid creator address")
+	}
+
+	// Only governance and the attestation issuers vouch for sources
+	if !bytes.Equal(k.GetAuthority(), creator) {
+		isIssuer, err := k.identityKeeper.IsIssuer(ctx, msg.Creator)
+		if err != nil {
+			return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+		} else if !isIssuer {
+			return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "only the module authority or an attestation issuer can verify a source")
+		}
+	}
+
+	source, err := k.Source.Get(ctx, msg.Index)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, errorsmod.Wrap(types.ErrSourceNotFound, msg.Index)
+		}
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
+	source.Verified = msg.Verified
+	if err := k.setSource(ctx, source); err != nil {
+		return nil, errorsmod.Wrap(err, "failed to update source")
+	}
+	score := credibilityScore(source)
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			"source_verified",
+			sdk.NewAttribute("verifier", msg.Creator),
+			sdk.NewAttribute("source_index", msg.Index),
+			sdk.NewAttribute("verified", strconv.FormatBool(msg.Verified)),
+			sdk.NewAttribute("credibility_score", strconv.FormatInt(score, 10)),
+		),
+	)
+
+	return &types.MsgVerifySourceResponse{CredibilityScore: score}, nil
+}
@@ -24,12 +24,19 @@ func (k msgServer) CreateVote(ctx context.Context, msg *types.MsgCreateVote) (*t
 	} else if ok {
 		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "index already set")
 	}
+	postIndex, err := k.resolvePostIndex(ctx, msg.PostIndex)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+	if err := k.checkNotBlockedByIndex(ctx, postIndex, msg.Creator); err != nil {
+		return nil, err
+	}
 
 	var vote = types.Vote{
 		Creator:      msg.Creator,
 		Index:        msg.Index,
 		VoterAddress: msg.VoterAddress,
-		PostIndex:    msg.PostIndex,
+		PostIndex:    postIndex,
 		VoteType:     msg.VoteType,
 		Timestamp:    msg.Timestamp,
 	}
@@ -60,12 +67,19 @@ func (k msgServer) UpdateVote(ctx context.Context, msg *types.MsgUpdateVote) (*t
 	if msg.Creator != val.Creator {
 		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "incorrect owner")
 	}
+	postIndex, err := k.resolvePostIndex(ctx, msg.PostIndex)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+	if err := k.checkNotBlockedByIndex(ctx, postIndex, msg.Creator); err != nil {
+		return nil, err
+	}
 
 	var vote = types.Vote{
 		Creator:      msg.Creator,
 		Index:        msg.Index,
 		VoterAddress: msg.VoterAddress,
-		PostIndex:    msg.PostIndex,
+		PostIndex:    postIndex,
 		VoteType:     msg.VoteType,
 		Timestamp:    msg.Timestamp,
 	}
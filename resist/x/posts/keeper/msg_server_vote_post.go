@@ -25,13 +25,16 @@ func (k msgServer) VotePost(ctx context.Context, msg *types.MsgVotePost) (*types
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 
 	// Find the social post
-	post, err := k.SocialPost.Get(ctx, msg.PostIndex)
+	post, err := k.lookupPost(ctx, msg.PostIndex)
 	if err != nil {
 		return nil, errorsmod.Wrap(types.ErrInvalidInput, "post not found")
 	}
+	if err := k.checkNotBlocked(ctx, post, msg.Creator); err != nil {
+		return nil, err
+	}
 
 	// Create unique vote key (voter_address:post_index)
-	voteKey := fmt.Sprintf("%s:%s", msg.Creator, msg.PostIndex)
+	voteKey := fmt.Sprintf("%s:%s", msg.Creator, post.Index)
 
 	// Check if user already voted
 	existingVote, err := k.Vote.Get(ctx, voteKey)
@@ -75,7 +78,7 @@ func (k msgServer) VotePost(ctx context.Context, msg *types.MsgVotePost) (*types
 			Creator:      msg.Creator,
 			Index:        voteKey,
 			VoterAddress: msg.Creator,
-			PostIndex:    msg.PostIndex,
+			PostIndex:    post.Index,
 			VoteType:     msg.VoteType,
 			Timestamp:    sdkCtx.BlockTime().Unix(),
 		}
@@ -86,7 +89,7 @@ func (k msgServer) VotePost(ctx context.Context, msg *types.MsgVotePost) (*types
 	}
 
 	// Update the post with new vote counts
-	if err := k.SocialPost.Set(ctx, msg.PostIndex, post); err != nil {
+	if err := k.SocialPost.Set(ctx, post.Index, post); err != nil {
 		return nil, errorsmod.Wrap(err, "failed to update post vote counts")
 	}
 
@@ -95,7 +98,7 @@ func (k msgServer) VotePost(ctx context.Context, msg *types.MsgVotePost) (*types
 		sdk.NewEvent(
 			"post_voted",
 			sdk.NewAttribute("voter", msg.Creator),
-			sdk.NewAttribute("post_index", msg.PostIndex),
+			sdk.NewAttribute("post_index", post.Index),
 			sdk.NewAttribute("vote_type", msg.VoteType),
 			sdk.NewAttribute("upvotes", strconv.FormatUint(post.Upvotes, 10)),
 			sdk.NewAttribute("downvotes", strconv.FormatUint(post.Downvotes, 10)),
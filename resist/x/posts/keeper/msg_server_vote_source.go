@@ -0,0 +1,79 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"resist/x/posts/types"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func (k msgServer) VoteSource(ctx context.Context, msg *types.MsgVoteSource) (*types.MsgVoteSourceResponse, error) {
+	if _, err := k.addressCodec.StringToBytes(msg.Creator); err != nil {
+		return nil, errorsmod.Wrap(err, "invalid creator address")
+	}
+
+	if msg.VoteType != "upvote" && msg.VoteType != "downvote" {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "vote type must be 'upvote' or 'downvote'")
+	}
+
+	source, err := k.Source.Get(ctx, msg.SourceIndex)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, errorsmod.Wrap(types.ErrSourceNotFound, msg.SourceIndex)
+		}
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+	if msg.Creator == source.Creator {
+		return nil, errorsmod.Wrap(types.ErrInvalidInput, "cannot vote on own source")
+	}
+
+	voteKey := collections.Join(msg.SourceIndex, msg.Creator)
+	existingVote, err := k.SourceVote.Get(ctx, voteKey)
+	switch {
+	case err == nil:
+		if existingVote.VoteType == msg.VoteType {
+			// Same vote type, no change needed
+			return &types.MsgVoteSourceResponse{CredibilityScore: source.CredibilityScore}, nil
+		}
+		// The voter is changing their vote
+		if existingVote.VoteType == "upvote" {
+			source.Upvotes--
+		} else {
+			source.Downvotes--
+		}
+	case !errors.Is(err, collections.ErrNotFound):
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+	if msg.VoteType == "upvote" {
+		source.Upvotes++
+	} else {
+		source.Downvotes++
+	}
+
+	vote := types.SourceVote{SourceIndex: msg.SourceIndex, Voter: msg.Creator, VoteType: msg.VoteType}
+	if err := k.SourceVote.Set(ctx, voteKey, vote); err != nil {
+		return nil, errorsmod.Wrap(err, "failed to store source vote")
+	}
+	if err := k.setSource(ctx, source); err != nil {
+		return nil, errorsmod.Wrap(err, "failed to update source")
+	}
+	score := credibilityScore(source)
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			"source_voted",
+			sdk.NewAttribute("voter", msg.Creator),
+			sdk.NewAttribute("source_index", msg.SourceIndex),
+			sdk.NewAttribute("vote_type", msg.VoteType),
+			sdk.NewAttribute("credibility_score", strconv.FormatInt(score, 10)),
+		),
+	)
+
+	return &types.MsgVoteSourceResponse{CredibilityScore: score}, nil
+}
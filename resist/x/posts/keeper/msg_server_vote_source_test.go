@@ -0,0 +1,98 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/collections"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"resist/x/posts/keeper"
+	"resist/x/posts/types"
+)
+
+func TestVoteSource(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+
+	creator, err := f.addressCodec.BytesToString([]byte("creator_____________________"))
+	require.NoError(t, err)
+	voter, err := f.addressCodec.BytesToString([]byte("voter_______________________"))
+	require.NoError(t, err)
+
+	// The score and verification given by the creator are ignored
+	_, err = srv.CreateSource(f.ctx, &types.MsgCreateSource{Creator: creator, Index: "src", Verified: true, CredibilityScore: 100})
+	require.NoError(t, err)
+	source, err := f.keeper.Source.Get(f.ctx, "src")
+	require.NoError(t, err)
+	require.False(t, source.Verified)
+	require.EqualValues(t, 50, source.CredibilityScore)
+
+	_, err = srv.VoteSource(f.ctx, &types.MsgVoteSource{Creator: creator, SourceIndex: "src", VoteType: "upvote"})
+	require.ErrorIs(t, err, types.ErrInvalidInput)
+	_, err = srv.VoteSource(f.ctx, &types.MsgVoteSource{Creator: voter, SourceIndex: "missing", VoteType: "upvote"})
+	require.ErrorIs(t, err, types.ErrSourceNotFound)
+	_, err = srv.VoteSource(f.ctx, &types.MsgVoteSource{Creator: voter, SourceIndex: "src", VoteType: "like"})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	resp, err := srv.VoteSource(f.ctx, &types.MsgVoteSource{Creator: voter, SourceIndex: "src", VoteType: "upvote"})
+	require.NoError(t, err)
+	require.EqualValues(t, 56, resp.CredibilityScore)
+
+	// Voting again the same way changes nothing, changing the vote moves it
+	resp, err = srv.VoteSource(f.ctx, &types.MsgVoteSource{Creator: voter, SourceIndex: "src", VoteType: "upvote"})
+	require.NoError(t, err)
+	require.EqualValues(t, 56, resp.CredibilityScore)
+	resp, err = srv.VoteSource(f.ctx, &types.MsgVoteSource{Creator: voter, SourceIndex: "src", VoteType: "downvote"})
+	require.NoError(t, err)
+	require.EqualValues(t, 44, resp.CredibilityScore)
+	source, err = f.keeper.Source.Get(f.ctx, "src")
+	require.NoError(t, err)
+	require.EqualValues(t, 0, source.Upvotes)
+	require.EqualValues(t, 1, source.Downvotes)
+	require.EqualValues(t, 44, source.CredibilityScore)
+
+	// Updating the source keeps its votes and does not verify it
+	_, err = srv.UpdateSource(f.ctx, &types.MsgUpdateSource{Creator: creator, Index: "src", Verified: true, CredibilityScore: 100})
+	require.NoError(t, err)
+	source, err = f.keeper.Source.Get(f.ctx, "src")
+	require.NoError(t, err)
+	require.EqualValues(t, 1, source.Downvotes)
+	require.False(t, source.Verified)
+	require.EqualValues(t, 44, source.CredibilityScore)
+
+	// Only the authority and the attestation issuers verify sources, which
+	// raises their score
+	_, err = srv.VerifySource(f.ctx, &types.MsgVerifySource{Creator: creator, Index: "src", Verified: true})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	authority, err := f.addressCodec.BytesToString(f.keeper.GetAuthority())
+	require.NoError(t, err)
+	_, err = srv.VerifySource(f.ctx, &types.MsgVerifySource{Creator: authority, Index: "missing", Verified: true})
+	require.ErrorIs(t, err, types.ErrSourceNotFound)
+	verifyResp, err := srv.VerifySource(f.ctx, &types.MsgVerifySource{Creator: authority, Index: "src", Verified: true})
+	require.NoError(t, err)
+	require.EqualValues(t, 64, verifyResp.CredibilityScore)
+	issuer, err := f.addressCodec.BytesToString([]byte("issuer______________________"))
+	require.NoError(t, err)
+	f.identityKeeper.issuers[issuer] = true
+	verifyResp, err = srv.VerifySource(f.ctx, &types.MsgVerifySource{Creator: issuer, Index: "src", Verified: false})
+	require.NoError(t, err)
+	require.EqualValues(t, 44, verifyResp.CredibilityScore)
+	_, err = srv.VerifySource(f.ctx, &types.MsgVerifySource{Creator: issuer, Index: "src", Verified: true})
+	require.NoError(t, err)
+
+	// Updating the source keeps it verified
+	_, err = srv.UpdateSource(f.ctx, &types.MsgUpdateSource{Creator: creator, Index: "src"})
+	require.NoError(t, err)
+	source, err = f.keeper.Source.Get(f.ctx, "src")
+	require.NoError(t, err)
+	require.True(t, source.Verified)
+	require.EqualValues(t, 64, source.CredibilityScore)
+
+	// Deleting the source deletes its votes
+	_, err = srv.DeleteSource(f.ctx, &types.MsgDeleteSource{Creator: creator, Index: "src"})
+	require.NoError(t, err)
+	has, err := f.keeper.SourceVote.Has(f.ctx, collections.Join("src", voter))
+	require.NoError(t, err)
+	require.False(t, has)
+}
@@ -155,3 +155,31 @@ func TestVoteMsgServerDelete(t *testing.T) {
 		})
 	}
 }
+
+func TestVoteBlocked(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+
+	author, err := f.addressCodec.BytesToString([]byte("author______________________"))
+	require.NoError(t, err)
+	voter, err := f.addressCodec.BytesToString([]byte("voter_______________________"))
+	require.NoError(t, err)
+	require.NoError(t, f.keeper.SocialPost.Set(f.ctx, "post", types.SocialPost{Index: "post", Author: author, Creator: author}))
+
+	f.identityKeeper.blocks[[2]string{author, voter}] = true
+	_, err = srv.VotePost(f.ctx, &types.MsgVotePost{Creator: voter, PostIndex: "post", VoteType: "upvote"})
+	require.ErrorIs(t, err, types.ErrBlocked)
+	_, err = srv.CreateVote(f.ctx, &types.MsgCreateVote{Creator: voter, Index: "vote", PostIndex: "post", VoteType: "upvote"})
+	require.ErrorIs(t, err, types.ErrBlocked)
+
+	// Blocks only restrict the blocked address
+	_, err = srv.VotePost(f.ctx, &types.MsgVotePost{Creator: author, PostIndex: "post", VoteType: "upvote"})
+	require.NoError(t, err)
+
+	delete(f.identityKeeper.blocks, [2]string{author, voter})
+	_, err = srv.VotePost(f.ctx, &types.MsgVotePost{Creator: voter, PostIndex: "post", VoteType: "upvote"})
+	require.NoError(t, err)
+	post, err := f.keeper.SocialPost.Get(f.ctx, "post")
+	require.NoError(t, err)
+	require.EqualValues(t, 2, post.Upvotes)
+}
@@ -36,12 +36,13 @@ func TestMsgUpdateParams(t *testing.T) {
 			expErrMsg: "invalid authority",
 		},
 		{
-			name: "send enabled param",
+			name: "invalid replica ack timeout",
 			input: &types.MsgUpdateParams{
 				Authority: authorityStr,
-				Params:    types.Params{},
+				Params:    types.NewParams(0, types.DefaultAuditEpochBlocks, types.DefaultAuditChallengesPerEpoch, types.DefaultAuditResponseBlocks, types.DefaultAuditUptimePenalty, types.DefaultMaxOneTimePrekeys, types.DefaultMaxSignalMessageBytes, types.DefaultMaxMailboxBytes, types.DefaultMailboxTTLBlocks, types.DefaultMaxMailboxMessagesPerSender),
 			},
-			expErr: false,
+			expErr:    true,
+			expErrMsg: "replica ack timeout must be positive",
 		},
 		{
 			name: "all good",
@@ -0,0 +1,75 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"cosmossdk.io/collections"
+
+	"resist/x/posts/types"
+)
+
+// nextPostIndex returns the index of a new post.
+func (k Keeper) nextPostIndex(ctx context.Context) (string, error) {
+	seq, err := k.PostSeq.Next(ctx)
+	if err != nil {
+		return "", err
+	}
+	return strconv.FormatUint(seq, 10), nil
+}
+
+// resolvePostIndex returns the current index of the post at index, following
+// the alias of posts re-indexed by the migration to sequential indexes.
+// Unknown indexes are returned unchanged.
+func (k Keeper) resolvePostIndex(ctx context.Context, index string) (string, error) {
+	if has, err := k.SocialPost.Has(ctx, index); err != nil || has {
+		return index, err
+	}
+	alias, err := k.PostAlias.Get(ctx, index)
+	if errors.Is(err, collections.ErrNotFound) {
+		return index, nil
+	} else if err != nil {
+		return "", err
+	}
+	return alias, nil
+}
+
+// lookupPost returns the post at index or at the alias index, or
+// collections.ErrNotFound.
+func (k Keeper) lookupPost(ctx context.Context, index string) (types.SocialPost, error) {
+	index, err := k.resolvePostIndex(ctx, index)
+	if err != nil {
+		return types.SocialPost{}, err
+	}
+	return k.SocialPost.Get(ctx, index)
+}
+
+// GetPostAlias returns the index of the post formerly at oldIndex, if it was
+// re-indexed by the migration to sequential indexes.
+func (k Keeper) GetPostAlias(ctx context.Context, oldIndex string) (string, bool, error) {
+	index, err := k.PostAlias.Get(ctx, oldIndex)
+	if errors.Is(err, collections.ErrNotFound) {
+		return "", false, nil
+	} else if err != nil {
+		return "", false, err
+	}
+	return index, true, nil
+}
+
+// GetPostGroup returns the group of the post at postId, following the alias
+// of re-indexed posts, or of its tombstone if it was deleted. A post outside
+// of any group has group 0.
+func (k Keeper) GetPostGroup(ctx context.Context, postId uint64) (uint64, bool, error) {
+	index, err := k.resolvePostIndex(ctx, strconv.FormatUint(postId, 10))
+	if err != nil {
+		return 0, false, err
+	}
+	post, _, err := k.threadPost(ctx, index)
+	if errors.Is(err, collections.ErrNotFound) {
+		return 0, false, nil
+	} else if err != nil {
+		return 0, false, err
+	}
+	return post.GroupId, true, nil
+}
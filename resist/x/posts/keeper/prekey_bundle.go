@@ -0,0 +1,82 @@
+package keeper
+
+import (
+	"context"
+
+	"resist/x/posts/types"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+)
+
+// CountOneTimePrekeys returns the number of one-time prekeys a node has left.
+func (k Keeper) CountOneTimePrekeys(ctx context.Context, nodeId string) (uint64, error) {
+	var count uint64
+	err := k.OneTimePrekey.Walk(ctx, collections.NewPrefixedPairRange[string, uint32](nodeId), func(_ collections.Pair[string, uint32], _ types.OneTimePrekey) (bool, error) {
+		count++
+		return false, nil
+	})
+	return count, err
+}
+
+// ClaimOneTimePrekey removes and returns the oldest one-time prekey of a
+// node. It returns nil if the node has none left.
+func (k Keeper) ClaimOneTimePrekey(ctx context.Context, nodeId string) (*types.OneTimePrekey, error) {
+	iter, err := k.OneTimePrekey.Iterate(ctx, collections.NewPrefixedPairRange[string, uint32](nodeId))
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Close()
+	if !iter.Valid() {
+		return nil, nil
+	}
+	kv, err := iter.KeyValue()
+	if err != nil {
+		return nil, err
+	}
+	if err := k.OneTimePrekey.Remove(ctx, kv.Key); err != nil {
+		return nil, err
+	}
+	return &kv.Value, nil
+}
+
+// addOneTimePrekeys stores new one-time prekeys of a node, up to the
+// MaxOneTimePrekeys param, and returns the number of prekeys the node has.
+func (k Keeper) addOneTimePrekeys(ctx context.Context, nodeId string, prekeys []types.OneTimePrekey) (uint64, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return 0, err
+	}
+	count, err := k.CountOneTimePrekeys(ctx, nodeId)
+	if err != nil {
+		return 0, err
+	}
+	if count+uint64(len(prekeys)) > params.MaxOneTimePrekeys {
+		return 0, errorsmod.Wrapf(types.ErrInvalidInput, "node %s would hold more than %d one-time prekeys", nodeId, params.MaxOneTimePrekeys)
+	}
+
+	for _, prekey := range prekeys {
+		if prekey.Id == 0 || len(prekey.Key) != types.PrekeySize {
+			return 0, errorsmod.Wrapf(types.ErrInvalidInput, "invalid one-time prekey %d", prekey.Id)
+		}
+		if prekey.NodeId != "" && prekey.NodeId != nodeId {
+			return 0, errorsmod.Wrapf(types.ErrInvalidInput, "one-time prekey %d belongs to node %s", prekey.Id, prekey.NodeId)
+		}
+		key := collections.Join(nodeId, prekey.Id)
+		if has, err := k.OneTimePrekey.Has(ctx, key); err != nil {
+			return 0, err
+		} else if has {
+			return 0, errorsmod.Wrapf(types.ErrInvalidInput, "duplicated one-time prekey %d", prekey.Id)
+		}
+		prekey.NodeId = nodeId
+		if err := k.OneTimePrekey.Set(ctx, key, prekey); err != nil {
+			return 0, err
+		}
+	}
+	return count + uint64(len(prekeys)), nil
+}
+
+// removeOneTimePrekeys removes all the one-time prekeys of a node.
+func (k Keeper) removeOneTimePrekeys(ctx context.Context, nodeId string) error {
+	return k.OneTimePrekey.Clear(ctx, collections.NewPrefixedPairRange[string, uint32](nodeId))
+}
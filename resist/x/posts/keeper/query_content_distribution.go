@@ -0,0 +1,71 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"resist/x/posts/types"
+
+	"cosmossdk.io/collections"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (q queryServer) ListContentDistribution(ctx context.Context, req *types.QueryAllContentDistributionRequest) (*types.QueryAllContentDistributionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	distributions, pageRes, err := query.CollectionPaginate(
+		ctx,
+		q.k.ContentDistribution,
+		req.Pagination,
+		func(_ string, value types.ContentDistribution) (types.ContentDistribution, error) {
+			return value, nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllContentDistributionResponse{ContentDistribution: distributions, Pagination: pageRes}, nil
+}
+
+func (q queryServer) GetContentDistribution(ctx context.Context, req *types.QueryGetContentDistributionRequest) (*types.QueryGetContentDistributionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	val, err := q.k.ContentDistribution.Get(ctx, req.ContentId)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "not found")
+		}
+
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return &types.QueryGetContentDistributionResponse{ContentDistribution: val}, nil
+}
+
+func (q queryServer) ListReplicaAssignment(ctx context.Context, req *types.QueryAllReplicaAssignmentRequest) (*types.QueryAllReplicaAssignmentResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	assignments, pageRes, err := query.CollectionPaginate(
+		ctx,
+		q.k.ReplicaAssignment,
+		req.Pagination,
+		func(_ collections.Pair[string, string], value types.ReplicaAssignment) (types.ReplicaAssignment, error) {
+			return value, nil
+		},
+		query.WithCollectionPaginationPairPrefix[string, string](req.ContentId),
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllReplicaAssignmentResponse{ReplicaAssignment: assignments, Pagination: pageRes}, nil
+}
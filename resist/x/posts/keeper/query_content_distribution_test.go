@@ -0,0 +1,124 @@
+package keeper_test
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"resist/x/posts/keeper"
+	"resist/x/posts/types"
+)
+
+func createNContentDistribution(keeper keeper.Keeper, ctx context.Context, n int) []types.ContentDistribution {
+	items := make([]types.ContentDistribution, n)
+	for i := range items {
+		items[i].ContentId = strconv.Itoa(i)
+		items[i].IpfsHash = types.NewCID(types.RawCodec, []byte(strconv.Itoa(i)))
+		items[i].CreatedAt = int64(i)
+		items[i].LastSync = int64(i)
+		items[i].Replication = &types.ContentReplication{TargetReplicas: 3, TotalSizeBytes: uint64(i)}
+		_ = keeper.ContentDistribution.Set(ctx, items[i].ContentId, items[i])
+	}
+	return items
+}
+
+func TestContentDistributionQuerySingle(t *testing.T) {
+	f := initFixture(t)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	msgs := createNContentDistribution(f.keeper, f.ctx, 2)
+	tests := []struct {
+		desc     string
+		request  *types.QueryGetContentDistributionRequest
+		response *types.QueryGetContentDistributionResponse
+		err      error
+	}{
+		{
+			desc: "First",
+			request: &types.QueryGetContentDistributionRequest{
+				ContentId: msgs[0].ContentId,
+			},
+			response: &types.QueryGetContentDistributionResponse{ContentDistribution: msgs[0]},
+		},
+		{
+			desc: "Second",
+			request: &types.QueryGetContentDistributionRequest{
+				ContentId: msgs[1].ContentId,
+			},
+			response: &types.QueryGetContentDistributionResponse{ContentDistribution: msgs[1]},
+		},
+		{
+			desc: "KeyNotFound",
+			request: &types.QueryGetContentDistributionRequest{
+				ContentId: strconv.Itoa(100000),
+			},
+			err: status.Error(codes.NotFound, "not found"),
+		},
+		{
+			desc: "InvalidRequest",
+			err:  status.Error(codes.InvalidArgument, "invalid request"),
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			response, err := qs.GetContentDistribution(f.ctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+				require.EqualExportedValues(t, tc.response, response)
+			}
+		})
+	}
+}
+
+func TestContentDistributionQueryPaginated(t *testing.T) {
+	f := initFixture(t)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	msgs := createNContentDistribution(f.keeper, f.ctx, 5)
+
+	request := func(next []byte, offset, limit uint64, total bool) *types.QueryAllContentDistributionRequest {
+		return &types.QueryAllContentDistributionRequest{
+			Pagination: &query.PageRequest{
+				Key:        next,
+				Offset:     offset,
+				Limit:      limit,
+				CountTotal: total,
+			},
+		}
+	}
+	t.Run("ByOffset", func(t *testing.T) {
+		step := 2
+		for i := 0; i < len(msgs); i += step {
+			resp, err := qs.ListContentDistribution(f.ctx, request(nil, uint64(i), uint64(step), false))
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(resp.ContentDistribution), step)
+			require.Subset(t, msgs, resp.ContentDistribution)
+		}
+	})
+	t.Run("ByKey", func(t *testing.T) {
+		step := 2
+		var next []byte
+		for i := 0; i < len(msgs); i += step {
+			resp, err := qs.ListContentDistribution(f.ctx, request(next, 0, uint64(step), false))
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(resp.ContentDistribution), step)
+			require.Subset(t, msgs, resp.ContentDistribution)
+			next = resp.Pagination.NextKey
+		}
+	})
+	t.Run("Total", func(t *testing.T) {
+		resp, err := qs.ListContentDistribution(f.ctx, request(nil, 0, 0, true))
+		require.NoError(t, err)
+		require.Equal(t, len(msgs), int(resp.Pagination.Total))
+		require.EqualExportedValues(t, msgs, resp.ContentDistribution)
+	})
+	t.Run("InvalidRequest", func(t *testing.T) {
+		_, err := qs.ListContentDistribution(f.ctx, nil)
+		require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+	})
+}
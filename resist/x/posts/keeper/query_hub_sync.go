@@ -0,0 +1,67 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"resist/x/posts/types"
+
+	"cosmossdk.io/collections"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (q queryServer) ListHubSync(ctx context.Context, req *types.QueryAllHubSyncRequest) (*types.QueryAllHubSyncResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var (
+		syncs   []types.HubSync
+		pageRes *query.PageResponse
+		err     error
+	)
+	if req.NodeId == "" {
+		syncs, pageRes, err = query.CollectionPaginate(
+			ctx,
+			q.k.HubSync,
+			req.Pagination,
+			func(_ string, value types.HubSync) (types.HubSync, error) {
+				return value, nil
+			},
+		)
+	} else {
+		syncs, pageRes, err = query.CollectionPaginate(
+			ctx,
+			q.k.HubSyncByNode,
+			req.Pagination,
+			func(key collections.Pair[string, string], _ collections.NoValue) (types.HubSync, error) {
+				return q.k.HubSync.Get(ctx, key.K2())
+			},
+			query.WithCollectionPaginationPairPrefix[string, string](req.NodeId),
+		)
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllHubSyncResponse{HubSync: syncs, Pagination: pageRes}, nil
+}
+
+func (q queryServer) GetHubSync(ctx context.Context, req *types.QueryGetHubSyncRequest) (*types.QueryGetHubSyncResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	val, err := q.k.HubSync.Get(ctx, req.SyncId)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "not found")
+		}
+
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return &types.QueryGetHubSyncResponse{HubSync: val}, nil
+}
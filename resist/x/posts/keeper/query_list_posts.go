@@ -0,0 +1,152 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"resist/x/posts/types"
+
+	"cosmossdk.io/collections"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (q queryServer) ListPostsByAuthor(ctx context.Context, req *types.QueryListPostsByAuthorRequest) (*types.QueryListPostsByAuthorResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if _, err := q.k.addressCodec.StringToBytes(req.Author); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid author address")
+	}
+
+	posts, pageRes, err := query.CollectionPaginate(
+		ctx,
+		q.k.socialPostViews.author,
+		req.Pagination,
+		func(key collections.Pair[collections.Pair[string, uint64], string], _ collections.NoValue) (types.SocialPost, error) {
+			return q.k.SocialPost.Get(ctx, key.K2())
+		},
+		withCreatedAtPrefix[string](req.Author),
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryListPostsByAuthorResponse{SocialPost: posts, Pagination: pageRes}, nil
+}
+
+func (q queryServer) ListPostsByGroup(ctx context.Context, req *types.QueryListPostsByGroupRequest) (*types.QueryListPostsByGroupResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	posts, pageRes, err := query.CollectionPaginate(
+		ctx,
+		q.k.socialPostViews.group,
+		req.Pagination,
+		func(key collections.Pair[collections.Pair[uint64, uint64], string], _ collections.NoValue) (types.SocialPost, error) {
+			return q.k.SocialPost.Get(ctx, key.K2())
+		},
+		withCreatedAtPrefix[uint64](req.GroupId),
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryListPostsByGroupResponse{SocialPost: posts, Pagination: pageRes}, nil
+}
+
+func (q queryServer) ListPostsSince(ctx context.Context, req *types.QueryListPostsSinceRequest) (*types.QueryListPostsSinceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	posts, pageRes, err := q.k.paginatePostsSince(ctx, req.Since, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryListPostsSinceResponse{SocialPost: posts, Pagination: pageRes}, nil
+}
+
+// paginatePostsSince paginates the posts created at or after since, oldest
+// first or newest first in reverse. The iteration is bounded by since either
+// way, so that the posts created before are never read. Page keys are the
+// keys of the creation time index, as with query.CollectionPaginate.
+func (k Keeper) paginatePostsSince(ctx context.Context, since uint64, pageReq *query.PageRequest) ([]types.SocialPost, *query.PageResponse, error) {
+	if pageReq == nil {
+		pageReq = &query.PageRequest{}
+	}
+	if len(pageReq.Key) != 0 && pageReq.Offset != 0 {
+		return nil, nil, fmt.Errorf("invalid request, either offset or key is expected, got both")
+	}
+	limit := pageReq.Limit
+	if limit == 0 {
+		limit = query.DefaultLimit
+	}
+	keyCodec := k.socialPostViews.createdAt.KeyCodec()
+
+	sinceKey := collections.PairPrefix[uint64, string](since)
+	ranger := new(collections.Range[collections.Pair[uint64, string]]).StartInclusive(sinceKey)
+	if len(pageReq.Key) != 0 {
+		_, pageKey, err := keyCodec.Decode(pageReq.Key)
+		if err != nil {
+			return nil, nil, err
+		}
+		switch {
+		case pageReq.Reverse:
+			ranger = ranger.EndInclusive(pageKey)
+		case pageKey.K1() >= since:
+			ranger = ranger.StartInclusive(pageKey)
+		}
+	}
+	if pageReq.Reverse {
+		ranger = ranger.Descending()
+	}
+
+	var (
+		posts   []types.SocialPost
+		pageRes = new(query.PageResponse)
+		seen    uint64
+	)
+	countTotal := pageReq.CountTotal && len(pageReq.Key) == 0
+	err := k.socialPostViews.createdAt.Walk(ctx, ranger, func(key collections.Pair[uint64, string]) (bool, error) {
+		seen++
+		switch {
+		case seen <= pageReq.Offset:
+			return false, nil
+		case seen > pageReq.Offset+limit:
+			if pageRes.NextKey == nil {
+				nextKey, err := collections.EncodeKeyWithPrefix(nil, keyCodec, key)
+				if err != nil {
+					return true, err
+				}
+				pageRes.NextKey = nextKey
+			}
+			return !countTotal, nil
+		}
+		post, err := k.SocialPost.Get(ctx, key.K2())
+		if err != nil {
+			return true, err
+		}
+		posts = append(posts, post)
+		return false, nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	if countTotal {
+		pageRes.Total = seen
+	}
+	return posts, pageRes, nil
+}
+
+// withCreatedAtPrefix paginates an index by (K, created at, post index) over
+// the posts with the reference key prefix.
+func withCreatedAtPrefix[K any](prefix K) func(o *query.CollectionsPaginateOptions[collections.Pair[collections.Pair[K, uint64], string]]) {
+	return func(o *query.CollectionsPaginateOptions[collections.Pair[collections.Pair[K, uint64], string]]) {
+		key := collections.PairPrefix[collections.Pair[K, uint64], string](collections.PairPrefix[K, uint64](prefix))
+		o.Prefix = &key
+	}
+}
@@ -0,0 +1,100 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+
+	"resist/x/posts/keeper"
+	"resist/x/posts/types"
+)
+
+func postIndexes(posts []types.SocialPost) []string {
+	indexes := make([]string, len(posts))
+	for i, post := range posts {
+		indexes[i] = post.Index
+	}
+	return indexes
+}
+
+func TestListPosts(t *testing.T) {
+	f := initFixture(t)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	alice, err := f.addressCodec.BytesToString([]byte("alice_______________________"))
+	require.NoError(t, err)
+	bob, err := f.addressCodec.BytesToString([]byte("bob_________________________"))
+	require.NoError(t, err)
+
+	// Indexes are not in creation order, as "10" sorts before "9"
+	for _, post := range []types.SocialPost{
+		{Index: "9", Author: alice, GroupId: 1, CreatedAt: 100},
+		{Index: "10", Author: alice, GroupId: 2, CreatedAt: 200},
+		{Index: "11", Author: bob, GroupId: 1, CreatedAt: 300},
+		{Index: "12", Author: alice, GroupId: 1, CreatedAt: 400},
+	} {
+		require.NoError(t, f.keeper.SocialPost.Set(f.ctx, post.Index, post))
+	}
+
+	byAuthor, err := qs.ListPostsByAuthor(f.ctx, &types.QueryListPostsByAuthorRequest{Author: alice})
+	require.NoError(t, err)
+	require.Equal(t, []string{"9", "10", "12"}, postIndexes(byAuthor.SocialPost))
+
+	byAuthor, err = qs.ListPostsByAuthor(f.ctx, &types.QueryListPostsByAuthorRequest{Author: alice, Pagination: &query.PageRequest{Limit: 2, Reverse: true, CountTotal: true}})
+	require.NoError(t, err)
+	require.Equal(t, []string{"12", "10"}, postIndexes(byAuthor.SocialPost))
+	require.EqualValues(t, 3, byAuthor.Pagination.Total)
+	byAuthor, err = qs.ListPostsByAuthor(f.ctx, &types.QueryListPostsByAuthorRequest{Author: alice, Pagination: &query.PageRequest{Key: byAuthor.Pagination.NextKey, Reverse: true}})
+	require.NoError(t, err)
+	require.Equal(t, []string{"9"}, postIndexes(byAuthor.SocialPost))
+
+	_, err = qs.ListPostsByAuthor(f.ctx, &types.QueryListPostsByAuthorRequest{Author: "invalid"})
+	require.Error(t, err)
+
+	byGroup, err := qs.ListPostsByGroup(f.ctx, &types.QueryListPostsByGroupRequest{GroupId: 1, Pagination: &query.PageRequest{Limit: 2}})
+	require.NoError(t, err)
+	require.Equal(t, []string{"9", "11"}, postIndexes(byGroup.SocialPost))
+	byGroup, err = qs.ListPostsByGroup(f.ctx, &types.QueryListPostsByGroupRequest{GroupId: 1, Pagination: &query.PageRequest{Key: byGroup.Pagination.NextKey}})
+	require.NoError(t, err)
+	require.Equal(t, []string{"12"}, postIndexes(byGroup.SocialPost))
+
+	since, err := qs.ListPostsSince(f.ctx, &types.QueryListPostsSinceRequest{Since: 200})
+	require.NoError(t, err)
+	require.Equal(t, []string{"10", "11", "12"}, postIndexes(since.SocialPost))
+	since, err = qs.ListPostsSince(f.ctx, &types.QueryListPostsSinceRequest{Since: 150, Pagination: &query.PageRequest{Limit: 1}})
+	require.NoError(t, err)
+	require.Equal(t, []string{"10"}, postIndexes(since.SocialPost))
+	since, err = qs.ListPostsSince(f.ctx, &types.QueryListPostsSinceRequest{Since: 150, Pagination: &query.PageRequest{Key: since.Pagination.NextKey}})
+	require.NoError(t, err)
+	require.Equal(t, []string{"11", "12"}, postIndexes(since.SocialPost))
+	since, err = qs.ListPostsSince(f.ctx, &types.QueryListPostsSinceRequest{Since: 200, Pagination: &query.PageRequest{Reverse: true}})
+	require.NoError(t, err)
+	require.Equal(t, []string{"12", "11", "10"}, postIndexes(since.SocialPost))
+
+	// Listing newest first stops at since
+	since, err = qs.ListPostsSince(f.ctx, &types.QueryListPostsSinceRequest{Since: 200, Pagination: &query.PageRequest{Limit: 2, Reverse: true, CountTotal: true}})
+	require.NoError(t, err)
+	require.Equal(t, []string{"12", "11"}, postIndexes(since.SocialPost))
+	require.EqualValues(t, 3, since.Pagination.Total)
+	since, err = qs.ListPostsSince(f.ctx, &types.QueryListPostsSinceRequest{Since: 200, Pagination: &query.PageRequest{Key: since.Pagination.NextKey, Limit: 2, Reverse: true}})
+	require.NoError(t, err)
+	require.Equal(t, []string{"10"}, postIndexes(since.SocialPost))
+	require.Nil(t, since.Pagination.NextKey)
+	since, err = qs.ListPostsSince(f.ctx, &types.QueryListPostsSinceRequest{Since: 200, Pagination: &query.PageRequest{Offset: 1}})
+	require.NoError(t, err)
+	require.Equal(t, []string{"11", "12"}, postIndexes(since.SocialPost))
+
+	// Updating and deleting posts updates the indexes
+	post, err := f.keeper.SocialPost.Get(f.ctx, "12")
+	require.NoError(t, err)
+	post.GroupId = 2
+	require.NoError(t, f.keeper.SocialPost.Set(f.ctx, post.Index, post))
+	require.NoError(t, f.keeper.SocialPost.Remove(f.ctx, "9"))
+	byGroup, err = qs.ListPostsByGroup(f.ctx, &types.QueryListPostsByGroupRequest{GroupId: 1})
+	require.NoError(t, err)
+	require.Equal(t, []string{"11"}, postIndexes(byGroup.SocialPost))
+	byAuthor, err = qs.ListPostsByAuthor(f.ctx, &types.QueryListPostsByAuthorRequest{Author: alice})
+	require.NoError(t, err)
+	require.Equal(t, []string{"10", "12"}, postIndexes(byAuthor.SocialPost))
+}
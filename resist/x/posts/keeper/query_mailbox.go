@@ -0,0 +1,36 @@
+package keeper
+
+import (
+	"context"
+
+	"resist/x/posts/types"
+
+	"cosmossdk.io/collections"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (q queryServer) ListPendingMessages(ctx context.Context, req *types.QueryListPendingMessagesRequest) (*types.QueryListPendingMessagesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.NodeId == "" {
+		return nil, status.Error(codes.InvalidArgument, "node id cannot be empty")
+	}
+
+	messages, pageRes, err := query.CollectionPaginate(
+		ctx,
+		q.k.Mailbox,
+		req.Pagination,
+		func(_ collections.Pair[string, string], value types.SignalMessage) (types.SignalMessage, error) {
+			return value, nil
+		},
+		query.WithCollectionPaginationPairPrefix[string, string](req.NodeId),
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryListPendingMessagesResponse{Messages: messages, Pagination: pageRes}, nil
+}
@@ -18,7 +18,7 @@ func createNPostTag(keeper keeper.Keeper, ctx context.Context, n int) []types.Po
 	items := make([]types.PostTag, n)
 	for i := range items {
 		items[i].Index = strconv.Itoa(i)
-		items[i].PostId = uint64(i)
+		items[i].PostIndex = strconv.Itoa(i)
 		items[i].Tag = strconv.Itoa(i)
 		items[i].Category = strconv.Itoa(i)
 		items[i].SimilarityScore = int64(i)
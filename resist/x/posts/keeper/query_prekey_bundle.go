@@ -0,0 +1,33 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"resist/x/posts/types"
+
+	"cosmossdk.io/collections"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (q queryServer) GetPrekeyBundle(ctx context.Context, req *types.QueryGetPrekeyBundleRequest) (*types.QueryGetPrekeyBundleResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	val, err := q.k.PrekeyBundle.Get(ctx, req.NodeId)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "not found")
+		}
+
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+	count, err := q.k.CountOneTimePrekeys(ctx, req.NodeId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return &types.QueryGetPrekeyBundleResponse{PrekeyBundle: val, OneTimePrekeyCount: count}, nil
+}
@@ -37,7 +37,7 @@ func (q queryServer) GetSocialPost(ctx context.Context, req *types.QueryGetSocia
 		return nil, status.Error(codes.InvalidArgument, "invalid request")
 	}
 
-	val, err := q.k.SocialPost.Get(ctx, req.Index)
+	val, err := q.k.lookupPost(ctx, req.Index)
 	if err != nil {
 		if errors.Is(err, collections.ErrNotFound) {
 			return nil, status.Error(codes.NotFound, "not found")
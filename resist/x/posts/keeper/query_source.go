@@ -48,3 +48,24 @@ func (q queryServer) GetSource(ctx context.Context, req *types.QueryGetSourceReq
 
 	return &types.QueryGetSourceResponse{Source: val}, nil
 }
+
+func (q queryServer) ListSourcePosts(ctx context.Context, req *types.QueryListSourcePostsRequest) (*types.QueryListSourcePostsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	posts, pageRes, err := query.CollectionPaginate(
+		ctx,
+		q.k.SourcePost,
+		req.Pagination,
+		func(key collections.Pair[collections.Pair[string, uint64], string], _ collections.NoValue) (types.SocialPost, error) {
+			return q.k.SocialPost.Get(ctx, key.K2())
+		},
+		withCreatedAtPrefix[string](req.SourceIndex),
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryListSourcePostsResponse{SocialPost: posts, Pagination: pageRes}, nil
+}
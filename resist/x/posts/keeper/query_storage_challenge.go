@@ -0,0 +1,50 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"resist/x/posts/types"
+
+	"cosmossdk.io/collections"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (q queryServer) ListStorageChallenge(ctx context.Context, req *types.QueryAllStorageChallengeRequest) (*types.QueryAllStorageChallengeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	challenges, pageRes, err := query.CollectionPaginate(
+		ctx,
+		q.k.StorageChallenge,
+		req.Pagination,
+		func(_ uint64, value types.StorageChallenge) (types.StorageChallenge, error) {
+			return value, nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllStorageChallengeResponse{StorageChallenge: challenges, Pagination: pageRes}, nil
+}
+
+func (q queryServer) GetStorageChallenge(ctx context.Context, req *types.QueryGetStorageChallengeRequest) (*types.QueryGetStorageChallengeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	val, err := q.k.StorageChallenge.Get(ctx, req.Id)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "not found")
+		}
+
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return &types.QueryGetStorageChallengeResponse{StorageChallenge: val}, nil
+}
@@ -0,0 +1,71 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"resist/x/posts/types"
+
+	"cosmossdk.io/collections"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (q queryServer) GetThread(ctx context.Context, req *types.QueryGetThreadRequest) (*types.QueryGetThreadResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	index, err := q.k.resolvePostIndex(ctx, req.Index)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+	post, deleted, err := q.k.threadPost(ctx, index)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "not found")
+		}
+
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	depth := req.MaxDepth
+	if depth == 0 {
+		depth = defaultThreadDepth
+	}
+	depth = min(depth, maxThreadDepth)
+
+	// The direct replies of the page are always returned, the posts below
+	// them only while the budget lasts
+	pageReq := req.Pagination
+	if pageReq != nil && pageReq.Limit > maxThreadNodes {
+		capped := *pageReq
+		capped.Limit = maxThreadNodes
+		pageReq = &capped
+	}
+	budget := maxThreadNodes
+
+	nodes, pageRes, err := query.CollectionPaginate(
+		ctx,
+		q.k.Reply,
+		pageReq,
+		func(key collections.Pair[string, uint64], _ collections.NoValue) (*types.ThreadNode, error) {
+			return q.k.threadNode(ctx, strconv.FormatUint(key.K2(), 10), depth-1, &budget)
+		},
+		query.WithCollectionPaginationPairPrefix[string, uint64](post.Index),
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	replies := make([]types.ThreadNode, 0, len(nodes))
+	for _, node := range nodes {
+		if node != nil {
+			replies = append(replies, *node)
+		}
+	}
+
+	return &types.QueryGetThreadResponse{Post: post, Replies: replies, Pagination: pageRes, Deleted: deleted}, nil
+}
@@ -19,7 +19,7 @@ func createNVote(keeper keeper.Keeper, ctx context.Context, n int) []types.Vote
 	for i := range items {
 		items[i].Index = strconv.Itoa(i)
 		items[i].VoterAddress = strconv.Itoa(i)
-		items[i].PostId = uint64(i)
+		items[i].PostIndex = strconv.Itoa(i)
 		items[i].VoteType = strconv.Itoa(i)
 		items[i].Timestamp = int64(i)
 		_ = keeper.Vote.Set(ctx, items[i].Index, items[i])
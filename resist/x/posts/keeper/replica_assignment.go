@@ -0,0 +1,92 @@
+package keeper
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"resist/x/posts/types"
+)
+
+// SetReplicaAssignment stores a replica assignment and keeps the deadline index
+// of pending assignments and the index of stored assignments in sync with its
+// status.
+func (k Keeper) SetReplicaAssignment(ctx context.Context, assignment types.ReplicaAssignment) error {
+	key := collections.Join(assignment.ContentId, assignment.NodeId)
+	prev, err := k.ReplicaAssignment.Get(ctx, key)
+	switch {
+	case err == nil:
+		if prev.Status == types.ReplicaStatusPending {
+			if err := k.ReplicaDeadline.Remove(ctx, collections.Join3(prev.AckDeadline, prev.ContentId, prev.NodeId)); err != nil {
+				return err
+			}
+		}
+		if prev.Status == types.ReplicaStatusStored {
+			if err := k.StoredReplica.Remove(ctx, storedReplicaKey(prev)); err != nil {
+				return err
+			}
+		}
+	case !errors.Is(err, collections.ErrNotFound):
+		return err
+	}
+
+	if assignment.Status == types.ReplicaStatusPending {
+		if err := k.ReplicaDeadline.Set(ctx, collections.Join3(assignment.AckDeadline, assignment.ContentId, assignment.NodeId)); err != nil {
+			return err
+		}
+	}
+	if assignment.Status == types.ReplicaStatusStored {
+		if err := k.StoredReplica.Set(ctx, storedReplicaKey(assignment)); err != nil {
+			return err
+		}
+	}
+	return k.ReplicaAssignment.Set(ctx, key, assignment)
+}
+
+// storedReplicaKey is the key of assignment in the index of stored
+// assignments. Keys are led by a hash of the assignment so that seeking a
+// random position of the index picks a random assignment.
+func storedReplicaKey(assignment types.ReplicaAssignment) collections.Triple[uint64, string, string] {
+	h := sha256.New()
+	h.Write([]byte(assignment.ContentId))
+	h.Write([]byte{0})
+	h.Write([]byte(assignment.NodeId))
+	return collections.Join3(binary.BigEndian.Uint64(h.Sum(nil)), assignment.ContentId, assignment.NodeId)
+}
+
+// AssignReplicas creates a pending replica assignment of the content for each
+// node. Nodes must acknowledge their replica within the ReplicaAckTimeout param.
+func (k Keeper) AssignReplicas(ctx context.Context, contentId string, nodeIds []string) error {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	blockTime := sdk.UnwrapSDKContext(ctx).BlockTime().Unix()
+	for _, nodeId := range nodeIds {
+		if err := k.SetReplicaAssignment(ctx, types.ReplicaAssignment{
+			ContentId:   contentId,
+			NodeId:      nodeId,
+			Status:      types.ReplicaStatusPending,
+			AssignedAt:  blockTime,
+			AckDeadline: blockTime + params.ReplicaAckTimeout,
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetReplicaAssignments returns every replica assignment of the content.
+func (k Keeper) GetReplicaAssignments(ctx context.Context, contentId string) ([]types.ReplicaAssignment, error) {
+	var assignments []types.ReplicaAssignment
+	err := k.ReplicaAssignment.Walk(ctx, collections.NewPrefixedPairRange[string, string](contentId), func(_ collections.Pair[string, string], val types.ReplicaAssignment) (bool, error) {
+		assignments = append(assignments, val)
+		return false, nil
+	})
+	return assignments, err
+}
@@ -3,97 +3,102 @@ package keeper
 import (
 	"context"
 	"crypto/rand"
+	"encoding/base64"
 	"fmt"
 	"time"
 
+	"resist/x/posts/signal"
 	"resist/x/posts/types"
 
 	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 )
 
-// SignalProtocolService handles secure messaging between nodes
+// SignalProtocolService handles secure messaging between nodes. Channels are
+// opened with X3DH against the prekey bundle of the recipient and encrypted
+// with the Double Ratchet. Keys and session state never leave the node: they
+// are kept in a signal.Store in the node home directory.
 type SignalProtocolService struct {
 	keeper *Keeper
+	store  *signal.Store
 }
 
 // NewSignalProtocolService creates a new Signal protocol service
-func NewSignalProtocolService(k *Keeper) *SignalProtocolService {
+func NewSignalProtocolService(k *Keeper, store *signal.Store) *SignalProtocolService {
 	return &SignalProtocolService{
 		keeper: k,
+		store:  store,
 	}
 }
 
-// EstablishSecureChannel creates a Signal protocol channel between two nodes
-func (s *SignalProtocolService) EstablishSecureChannel(ctx context.Context, senderNode, recipientNode string) (string, error) {
-	// Generate channel ID
+// EstablishSecureChannel creates a Signal protocol channel between two nodes,
+// running X3DH against the prekey bundle of the recipient node. The recipient
+// derives the channel from the first message sent on it.
+func (s *SignalProtocolService) EstablishSecureChannel(ctx context.Context, senderNode, recipientNode string, bundle signal.PrekeyBundle) (string, error) {
 	channelId, err := s.generateChannelID()
 	if err != nil {
 		return "", err
 	}
-
-	// In a real implementation, this would:
-	// 1. Perform X3DH key agreement protocol
-	// 2. Establish Double Ratchet encryption
-	// 3. Store session state securely
-	// 4. Return the channel ID for future communications
-
-	// For now, we'll simulate the process
+	if _, err := s.store.InitiateSession(channelId, bundle); err != nil {
+		return "", errorsmod.Wrapf(err, "failed to open channel with %s", recipientNode)
+	}
 	return channelId, nil
 }
 
+// ClaimedPrekeyBundle returns a bundle claimed with MsgClaimPrekeyBundle in
+// the form expected by EstablishSecureChannel.
+func ClaimedPrekeyBundle(resp *types.MsgClaimPrekeyBundleResponse) signal.PrekeyBundle {
+	bundle := signal.PrekeyBundle{
+		IdentityKey:           resp.PrekeyBundle.IdentityKey,
+		SigningKey:            resp.PrekeyBundle.SigningKey,
+		SignedPrekeyId:        resp.PrekeyBundle.SignedPrekeyId,
+		SignedPrekey:          resp.PrekeyBundle.SignedPrekey,
+		SignedPrekeySignature: resp.PrekeyBundle.SignedPrekeySignature,
+	}
+	if resp.OneTimePrekey != nil {
+		bundle.OneTimePrekeyId = resp.OneTimePrekey.Id
+		bundle.OneTimePrekey = resp.OneTimePrekey.Key
+	}
+	return bundle
+}
+
 // EncryptMessage encrypts a message using Signal protocol
 func (s *SignalProtocolService) EncryptMessage(ctx context.Context, channelId string, message []byte) ([]byte, error) {
-	// In a real implementation, this would:
-	// 1. Retrieve session state for the channel
-	// 2. Use Double Ratchet algorithm to encrypt the message
-	// 3. Update session state
-	// 4. Return encrypted payload
-
-	// Mock encryption (in production, use actual Signal protocol library)
-	encryptedPayload := make([]byte, len(message)+32) // Add space for encryption overhead
-	copy(encryptedPayload, message)
-
-	// Add mock encryption headers/metadata
-	copy(encryptedPayload[len(message):], s.generateMockEncryptionMetadata())
-
-	return encryptedPayload, nil
+	envelope, err := s.store.Encrypt(channelId, message)
+	if err != nil {
+		return nil, err
+	}
+	return envelope.Marshal()
 }
 
 // DecryptMessage decrypts a Signal protocol message
 func (s *SignalProtocolService) DecryptMessage(ctx context.Context, channelId string, encryptedPayload []byte) ([]byte, error) {
-	// In a real implementation, this would:
-	// 1. Retrieve session state for the channel
-	// 2. Use Double Ratchet algorithm to decrypt the message
-	// 3. Update session state
-	// 4. Return decrypted message
-
-	// Mock decryption
-	if len(encryptedPayload) < 32 {
-		return nil, errorsmod.Wrap(fmt.Errorf("invalid payload"), "encrypted payload too short")
+	envelope, err := signal.UnmarshalEnvelope(encryptedPayload)
+	if err != nil {
+		return nil, err
 	}
-
-	// Remove mock encryption overhead
-	decryptedMessage := encryptedPayload[:len(encryptedPayload)-32]
-
-	return decryptedMessage, nil
+	return s.store.Decrypt(channelId, envelope)
 }
 
-// SendSecureMessage sends an encrypted message between nodes
-func (s *SignalProtocolService) SendSecureMessage(ctx context.Context, senderNode, recipientNode, channelId string, messageType string, payload []byte) (string, error) {
+// SendSecureMessage encrypts payload on the channel and returns the signed
+// SignalMessage to relay to the recipient node.
+func (s *SignalProtocolService) SendSecureMessage(ctx context.Context, senderNode, recipientNode, channelId string, messageType string, payload []byte) (*types.SignalMessage, error) {
 	// Encrypt the payload
 	encryptedPayload, err := s.EncryptMessage(ctx, channelId, payload)
 	if err != nil {
-		return "", errorsmod.Wrap(err, "failed to encrypt message")
+		return nil, errorsmod.Wrap(err, "failed to encrypt message")
 	}
 
 	// Generate message ID
 	messageId, err := s.generateMessageID()
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
 	// Create Signal message
-	signalMessage := types.SignalMessage{
+	signalMessage := &types.SignalMessage{
 		MessageId:        messageId,
 		SenderNode:       senderNode,
 		RecipientNode:    recipientNode,
@@ -101,36 +106,101 @@ func (s *SignalProtocolService) SendSecureMessage(ctx context.Context, senderNod
 		EncryptedPayload: encryptedPayload,
 		MessageType:      messageType,
 		Timestamp:        time.Now().Unix(),
-		Signature:        s.generateMockSignature(payload), // In production, use actual cryptographic signature
+	}
+	if err := SignSignalMessage(signalMessage, s.store.Identity().SigningPrivKey()); err != nil {
+		return nil, err
+	}
+
+	return signalMessage, nil
+}
+
+// ReceiveSecureMessage checks that message is signed by the peer of its
+// channel and decrypts its payload. The first message of a channel opened by
+// the peer must be signed by the signing key of its X3DH header.
+func (s *SignalProtocolService) ReceiveSecureMessage(ctx context.Context, message *types.SignalMessage) ([]byte, error) {
+	envelope, err := signal.UnmarshalEnvelope(message.EncryptedPayload)
+	if err != nil {
+		return nil, err
 	}
 
-	// In production, this would be stored on-chain or transmitted via secure channel
-	_ = signalMessage
+	var senderPublicKey []byte
+	session, err := s.store.Session(message.ChannelId)
+	switch {
+	case err == nil:
+		senderPublicKey = session.RemoteSigningKey
+	case errorsmod.IsOf(err, signal.ErrNoSession) && envelope.PreKey != nil:
+		senderPublicKey = envelope.PreKey.SigningKey
+	default:
+		return nil, err
+	}
 
-	return messageId, nil
+	valid, err := s.ValidateMessageSignature(ctx, message, senderPublicKey)
+	if err != nil {
+		return nil, err
+	}
+	if !valid {
+		return nil, errorsmod.Wrap(types.ErrInvalidSignature, "signal message is not signed by the channel peer")
+	}
+	return s.store.Decrypt(message.ChannelId, envelope)
 }
 
-// ValidateMessageSignature validates the authenticity of a Signal message
+// ValidateMessageSignature validates the authenticity of a Signal message.
+// senderPublicKey is a 32 bytes Ed25519 or a 33 bytes compressed secp256k1
+// public key.
 func (s *SignalProtocolService) ValidateMessageSignature(ctx context.Context, message *types.SignalMessage, senderPublicKey []byte) (bool, error) {
-	// In a real implementation, this would:
-	// 1. Extract signature from message
-	// 2. Verify using sender's public key
-	// 3. Return validation result
+	var pubKey cryptotypes.PubKey
+	switch len(senderPublicKey) {
+	case ed25519.PubKeySize:
+		pubKey = &ed25519.PubKey{Key: senderPublicKey}
+	case secp256k1.PubKeySize:
+		pubKey = &secp256k1.PubKey{Key: senderPublicKey}
+	default:
+		return false, errorsmod.Wrapf(types.ErrInvalidInput, "unsupported public key of %d bytes", len(senderPublicKey))
+	}
 
-	// Mock validation - always return true for demo
-	return true, nil
+	signature, err := base64.StdEncoding.DecodeString(message.Signature)
+	if err != nil || len(signature) == 0 {
+		return false, nil
+	}
+	signBytes, err := SignalMessageSignBytes(message)
+	if err != nil {
+		return false, err
+	}
+	return pubKey.VerifySignature(signBytes, signature), nil
 }
 
-// RotateKeys performs forward secrecy key rotation
+// RotateKeys performs forward secrecy key rotation: the channel takes a new
+// Double Ratchet DH step with a fresh ratchet key and the keys kept for
+// messages not received yet are discarded. A step needs a new ratchet key
+// from the peer, so it fails with signal.ErrRotationPending until the peer
+// has answered the previous one.
 func (s *SignalProtocolService) RotateKeys(ctx context.Context, channelId string) error {
-	// In a real implementation, this would:
-	// 1. Generate new ratchet keys
-	// 2. Update session state
-	// 3. Send key rotation message to peer
+	return s.store.RotateSession(channelId)
+}
 
+// SignSignalMessage signs message with key, an Ed25519 node identity key or a
+// secp256k1 account key.
+func SignSignalMessage(message *types.SignalMessage, key cryptotypes.PrivKey) error {
+	signBytes, err := SignalMessageSignBytes(message)
+	if err != nil {
+		return err
+	}
+	signature, err := key.Sign(signBytes)
+	if err != nil {
+		return err
+	}
+	message.Signature = base64.StdEncoding.EncodeToString(signature)
 	return nil
 }
 
+// SignalMessageSignBytes returns the bytes signed by the sender of message:
+// its protobuf encoding without the signature.
+func SignalMessageSignBytes(message *types.SignalMessage) ([]byte, error) {
+	unsigned := *message
+	unsigned.Signature = ""
+	return unsigned.Marshal()
+}
+
 // Helper functions
 
 func (s *SignalProtocolService) generateChannelID() (string, error) {
@@ -149,20 +219,6 @@ func (s *SignalProtocolService) generateMessageID() (string, error) {
 	return fmt.Sprintf("msg_%x", bytes), nil
 }
 
-func (s *SignalProtocolService) generateMockEncryptionMetadata() []byte {
-	// In production, this would be actual encryption metadata
-	metadata := make([]byte, 32)
-	rand.Read(metadata)
-	return metadata
-}
-
-func (s *SignalProtocolService) generateMockSignature(payload []byte) string {
-	// In production, this would be a real cryptographic signature
-	hash := make([]byte, 16)
-	rand.Read(hash)
-	return fmt.Sprintf("sig_%x", hash)
-}
-
 // Signal Protocol Message Types
 const (
 	MessageTypeResourceRequest = "resource_request"
@@ -0,0 +1,118 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/collections"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/stretchr/testify/require"
+
+	"resist/x/posts/keeper"
+	"resist/x/posts/signal"
+	"resist/x/posts/types"
+	rewardstypes "resist/x/rewards/types"
+)
+
+func TestSignalProtocolService(t *testing.T) {
+	f := initFixture(t)
+
+	aliceStore, err := signal.OpenStore(t.TempDir())
+	require.NoError(t, err)
+	bobStore, err := signal.OpenStore(t.TempDir())
+	require.NoError(t, err)
+	alice := keeper.NewSignalProtocolService(&f.keeper, aliceStore)
+	bob := keeper.NewSignalProtocolService(&f.keeper, bobStore)
+
+	channelId, err := alice.EstablishSecureChannel(f.ctx, "hub-a", "hub-b", bobStore.Bundle())
+	require.NoError(t, err)
+
+	message, err := alice.SendSecureMessage(f.ctx, "hub-a", "hub-b", channelId, keeper.MessageTypeSyncRequest, []byte("sync post-1"))
+	require.NoError(t, err)
+	require.NotContains(t, string(message.EncryptedPayload), "sync post-1")
+
+	valid, err := bob.ValidateMessageSignature(f.ctx, message, aliceStore.Identity().SigningPubKey())
+	require.NoError(t, err)
+	require.True(t, valid)
+	valid, err = bob.ValidateMessageSignature(f.ctx, message, bobStore.Identity().SigningPubKey())
+	require.NoError(t, err)
+	require.False(t, valid)
+
+	// A relayed message altered in transit is rejected before decryption
+	altered := *message
+	altered.MessageType = keeper.MessageTypeHeartbeat
+	_, err = bob.ReceiveSecureMessage(f.ctx, &altered)
+	require.ErrorIs(t, err, types.ErrInvalidSignature)
+
+	plaintext, err := bob.ReceiveSecureMessage(f.ctx, message)
+	require.NoError(t, err)
+	require.Equal(t, "sync post-1", string(plaintext))
+
+	reply, err := bob.SendSecureMessage(f.ctx, "hub-b", "hub-a", channelId, keeper.MessageTypeSyncResponse, []byte("ok"))
+	require.NoError(t, err)
+	plaintext, err = alice.ReceiveSecureMessage(f.ctx, reply)
+	require.NoError(t, err)
+	require.Equal(t, "ok", string(plaintext))
+
+	// Alice now holds a new ratchet key of Bob and can rotate her own
+	require.NoError(t, alice.RotateKeys(f.ctx, channelId))
+	require.ErrorIs(t, alice.RotateKeys(f.ctx, channelId), signal.ErrRotationPending)
+
+	// Messages may be signed with a secp256k1 account key too
+	accountKey := secp256k1.GenPrivKey()
+	require.NoError(t, keeper.SignSignalMessage(reply, accountKey))
+	valid, err = alice.ValidateMessageSignature(f.ctx, reply, accountKey.PubKey().Bytes())
+	require.NoError(t, err)
+	require.True(t, valid)
+
+	_, err = alice.ValidateMessageSignature(f.ctx, reply, []byte("short"))
+	require.ErrorIs(t, err, types.ErrInvalidInput)
+}
+
+func TestSendSignalMessageRejectsPlaintext(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+
+	creator, err := f.addressCodec.BytesToString([]byte("signalCreator_______________"))
+	require.NoError(t, err)
+	f.rewardsKeeper.nodes["hub-a"] = rewardstypes.Node{NodeId: "hub-a", Owner: creator, IsActive: true}
+	f.rewardsKeeper.nodes["hub-b"] = rewardstypes.Node{NodeId: "hub-b", IsActive: true}
+	msg := &types.MsgSendSignalMessage{
+		Creator:          creator,
+		SenderNode:       "hub-a",
+		RecipientNode:    "hub-b",
+		ChannelId:        "channel_1",
+		EncryptedPayload: []byte("not encrypted"),
+		MessageType:      keeper.MessageTypeSyncRequest,
+	}
+	_, err = srv.SendSignalMessage(f.ctx, msg)
+	require.ErrorIs(t, err, types.ErrInvalidInput)
+
+	msg.EncryptedPayload = encryptedPayload(t, "sync post-1")
+	resp, err := srv.SendSignalMessage(f.ctx, msg)
+	require.NoError(t, err)
+	require.False(t, resp.DeliveryConfirmed)
+
+	// Message ids are derived from the message, a replay is rejected
+	_, err = srv.SendSignalMessage(f.ctx, msg)
+	require.ErrorIs(t, err, types.ErrInvalidInput)
+	queued, err := f.keeper.Mailbox.Get(f.ctx, collections.Join("hub-b", resp.MessageId))
+	require.NoError(t, err)
+	require.Equal(t, msg.EncryptedPayload, queued.EncryptedPayload)
+}
+
+// encryptedPayload returns a Double Ratchet envelope of plaintext sent on a
+// new channel.
+func encryptedPayload(t *testing.T, plaintext string) []byte {
+	t.Helper()
+	store, err := signal.OpenStore(t.TempDir())
+	require.NoError(t, err)
+	peer, err := signal.OpenStore(t.TempDir())
+	require.NoError(t, err)
+	_, err = store.InitiateSession("channel_1", peer.Bundle())
+	require.NoError(t, err)
+	envelope, err := store.Encrypt("channel_1", []byte(plaintext))
+	require.NoError(t, err)
+	payload, err := envelope.Marshal()
+	require.NoError(t, err)
+	return payload
+}
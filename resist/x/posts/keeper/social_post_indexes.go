@@ -0,0 +1,83 @@
+package keeper
+
+import (
+	"cosmossdk.io/collections"
+	"cosmossdk.io/collections/indexes"
+	corestore "cosmossdk.io/core/store"
+
+	"resist/x/posts/types"
+)
+
+// SocialPostIndexes are the secondary indexes of SocialPost. Posts are
+// ordered by creation time within an author or a group.
+type SocialPostIndexes struct {
+	// Author indexes posts by (author, created at).
+	Author *indexes.Multi[collections.Pair[string, uint64], string, types.SocialPost]
+	// Group indexes posts by (group id, created at).
+	Group *indexes.Multi[collections.Pair[uint64, uint64], string, types.SocialPost]
+	// CreatedAt indexes posts by creation time.
+	CreatedAt *indexes.Multi[uint64, string, types.SocialPost]
+	// Creator indexes posts by creator, which differs from the author of
+	// some social posts created before the author was set by the chain.
+	Creator *indexes.Multi[string, string, types.SocialPost]
+}
+
+func (i SocialPostIndexes) IndexesList() []collections.Index[string, types.SocialPost] {
+	return []collections.Index[string, types.SocialPost]{i.Author, i.Group, i.CreatedAt, i.Creator}
+}
+
+func newSocialPostIndexes(sb *collections.SchemaBuilder) SocialPostIndexes {
+	return SocialPostIndexes{
+		Author: indexes.NewMulti(
+			sb, types.SocialPostByAuthorKey, "socialPostByAuthor",
+			collections.PairKeyCodec(collections.StringKey, collections.Uint64Key), collections.StringKey,
+			func(_ string, post types.SocialPost) (collections.Pair[string, uint64], error) {
+				return collections.Join(post.Author, post.CreatedAt), nil
+			},
+		),
+		Group: indexes.NewMulti(
+			sb, types.SocialPostByGroupKey, "socialPostByGroup",
+			collections.PairKeyCodec(collections.Uint64Key, collections.Uint64Key), collections.StringKey,
+			func(_ string, post types.SocialPost) (collections.Pair[uint64, uint64], error) {
+				return collections.Join(post.GroupId, post.CreatedAt), nil
+			},
+		),
+		CreatedAt: indexes.NewMulti(
+			sb, types.SocialPostByTimeKey, "socialPostByTime",
+			collections.Uint64Key, collections.StringKey,
+			func(_ string, post types.SocialPost) (uint64, error) {
+				return post.CreatedAt, nil
+			},
+		),
+		Creator: indexes.NewMulti(
+			sb, types.SocialPostByCreatorKey, "socialPostByCreator",
+			collections.StringKey, collections.StringKey,
+			func(_ string, post types.SocialPost) (string, error) {
+				return post.Creator, nil
+			},
+		),
+	}
+}
+
+// socialPostIndexViews are key sets over the storage of the SocialPost
+// indexes. Unlike the indexes, they can be paginated with
+// query.CollectionPaginate, and must only be read.
+type socialPostIndexViews struct {
+	author    collections.KeySet[collections.Pair[collections.Pair[string, uint64], string]]
+	group     collections.KeySet[collections.Pair[collections.Pair[uint64, uint64], string]]
+	createdAt collections.KeySet[collections.Pair[uint64, string]]
+}
+
+func newSocialPostIndexViews(storeService corestore.KVStoreService) socialPostIndexViews {
+	// The views share the prefixes of the indexes, so they are kept out of
+	// the schema of the keeper.
+	sb := collections.NewSchemaBuilder(storeService)
+	return socialPostIndexViews{
+		author: collections.NewKeySet(sb, types.SocialPostByAuthorKey, "socialPostByAuthor",
+			collections.PairKeyCodec(collections.PairKeyCodec(collections.StringKey, collections.Uint64Key), collections.StringKey)),
+		group: collections.NewKeySet(sb, types.SocialPostByGroupKey, "socialPostByGroup",
+			collections.PairKeyCodec(collections.PairKeyCodec(collections.Uint64Key, collections.Uint64Key), collections.StringKey)),
+		createdAt: collections.NewKeySet(sb, types.SocialPostByTimeKey, "socialPostByTime",
+			collections.PairKeyCodec(collections.Uint64Key, collections.StringKey)),
+	}
+}
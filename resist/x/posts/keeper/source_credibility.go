@@ -0,0 +1,162 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+
+	"resist/x/posts/types"
+	usergroupstypes "resist/x/usergroups/types"
+)
+
+// Weights of the credibility score of a source, between 0 and 100. Votes
+// move the score by up to voteCredibility either way, and the reports resolved
+// against the posts citing the source lower it by up to reportCredibility.
+const (
+	baseCredibility     = 50
+	verifiedCredibility = 20
+	voteCredibility     = 30
+	reportCredibility   = 40
+	// credibilityPrior dampens the first votes and reports, so that a single
+	// one does not move the score by its full weight.
+	credibilityPrior = 4
+)
+
+// credibilityScore computes the credibility score of source from its votes,
+// whether it is verified and the outcomes of the reports on the posts citing
+// it.
+func credibilityScore(source types.Source) int64 {
+	score := int64(baseCredibility)
+	if source.Verified {
+		score += verifiedCredibility
+	}
+
+	up, down := int64(source.Upvotes), int64(source.Downvotes)
+	score += voteCredibility * (up - down) / (up + down + credibilityPrior)
+
+	resolved, dismissed := int64(source.ResolvedReports), int64(source.DismissedReports)
+	score -= reportCredibility * resolved / (resolved + dismissed + credibilityPrior)
+
+	return min(max(score, 0), 100)
+}
+
+// setSource stores source with its credibility score computed.
+func (k Keeper) setSource(ctx context.Context, source types.Source) error {
+	source.CredibilityScore = credibilityScore(source)
+	return k.Source.Set(ctx, source.Index, source)
+}
+
+// checkSources returns an error unless indexes are distinct existing sources,
+// at most types.MaxPostSources.
+func (k Keeper) checkSources(ctx context.Context, indexes []string) error {
+	if len(indexes) > types.MaxPostSources {
+		return errorsmod.Wrapf(types.ErrInvalidInput, "a post cites at most %d sources", types.MaxPostSources)
+	}
+	seen := make(map[string]struct{}, len(indexes))
+	for _, index := range indexes {
+		if _, ok := seen[index]; ok {
+			return errorsmod.Wrapf(types.ErrInvalidInput, "source %s cited twice", index)
+		}
+		seen[index] = struct{}{}
+		has, err := k.Source.Has(ctx, index)
+		if err != nil {
+			return err
+		} else if !has {
+			return errorsmod.Wrap(types.ErrSourceNotFound, index)
+		}
+	}
+	return nil
+}
+
+// sourcePostKey is the key of post in the index of the posts citing source.
+func sourcePostKey(source string, post types.SocialPost) collections.Pair[collections.Pair[string, uint64], string] {
+	return collections.Join(collections.Join(source, post.CreatedAt), post.Index)
+}
+
+// indexPostSources adds post to the index of the posts citing each of its
+// sources.
+func (k Keeper) indexPostSources(ctx context.Context, post types.SocialPost) error {
+	for _, source := range post.SourceIndexes {
+		if err := k.SourcePost.Set(ctx, sourcePostKey(source, post)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// unindexPostSources removes post from the index of the posts citing each of
+// its sources.
+func (k Keeper) unindexPostSources(ctx context.Context, post types.SocialPost) error {
+	for _, source := range post.SourceIndexes {
+		if err := k.SourcePost.Remove(ctx, sourcePostKey(source, post)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// isCited reports whether any post cites source.
+func (k Keeper) isCited(ctx context.Context, source string) (bool, error) {
+	prefix := collections.NewPrefixedPairRange[collections.Pair[string, uint64], string](collections.PairPrefix[string, uint64](source))
+	iter, err := k.SourcePost.Iterate(ctx, prefix)
+	if err != nil {
+		return false, err
+	}
+	defer iter.Close()
+	return iter.Valid(), nil
+}
+
+// recordReportOutcome moves a report on the post at postIndex from the
+// outcome oldStatus to newStatus in the tallies of the sources cited by the
+// post, and updates their credibility score. The sources of deleted posts are
+// kept on their tombstones, and reports on unknown posts are ignored.
+func (k Keeper) recordReportOutcome(ctx context.Context, postIndex string, oldStatus, newStatus string) error {
+	post, _, err := k.threadPost(ctx, postIndex)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	for _, index := range post.SourceIndexes {
+		source, err := k.Source.Get(ctx, index)
+		if errors.Is(err, collections.ErrNotFound) {
+			continue
+		} else if err != nil {
+			return err
+		}
+		countReportOutcome(&source, oldStatus, false)
+		countReportOutcome(&source, newStatus, true)
+		if err := k.setSource(ctx, source); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// isReportOutcome reports whether a report with status has an outcome
+// counted in the credibility of sources.
+func isReportOutcome(status string) bool {
+	return status == usergroupstypes.ReportStatusResolved || status == usergroupstypes.ReportStatusDismissed
+}
+
+// countReportOutcome counts, or stops counting, a report with status in the
+// tallies of source, if status is an outcome.
+func countReportOutcome(source *types.Source, status string, counted bool) {
+	var tally *uint64
+	switch status {
+	case usergroupstypes.ReportStatusResolved:
+		tally = &source.ResolvedReports
+	case usergroupstypes.ReportStatusDismissed:
+		tally = &source.DismissedReports
+	default:
+		return
+	}
+	if counted {
+		*tally++
+	} else if *tally > 0 {
+		*tally--
+	}
+}
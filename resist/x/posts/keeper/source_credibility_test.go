@@ -0,0 +1,63 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"resist/x/posts/keeper"
+	"resist/x/posts/types"
+)
+
+func TestPostSources(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	author, err := f.addressCodec.BytesToString([]byte("author______________________"))
+	require.NoError(t, err)
+	for _, index := range []string{"a", "b"} {
+		_, err = srv.CreateSource(f.ctx, &types.MsgCreateSource{Creator: author, Index: index})
+		require.NoError(t, err)
+	}
+
+	_, err = srv.CreatePost(f.ctx, &types.MsgCreatePost{Creator: author, Title: "title", Content: "content", SourceIndexes: []string{"a", "missing"}})
+	require.ErrorIs(t, err, types.ErrSourceNotFound)
+	_, err = srv.CreatePost(f.ctx, &types.MsgCreatePost{Creator: author, Title: "title", Content: "content", SourceIndexes: []string{"a", "a"}})
+	require.ErrorIs(t, err, types.ErrInvalidInput)
+
+	// Posts citing a source are listed oldest first
+	ctx := sdk.UnwrapSDKContext(f.ctx)
+	var indexes []string
+	for i, sources := range [][]string{{"a", "b"}, {"b"}, {"a"}} {
+		ctx = ctx.WithBlockTime(ctx.BlockTime().Add(10 * time.Second)).WithBlockHeight(int64(i + 1))
+		resp, err := srv.CreatePost(ctx, &types.MsgCreatePost{Creator: author, Title: "title", Content: "content", SourceIndexes: sources})
+		require.NoError(t, err)
+		indexes = append(indexes, resp.Index)
+	}
+	post, err := f.keeper.SocialPost.Get(ctx, indexes[0])
+	require.NoError(t, err)
+	require.Equal(t, []string{"a", "b"}, post.SourceIndexes)
+
+	cited, err := qs.ListSourcePosts(ctx, &types.QueryListSourcePostsRequest{SourceIndex: "a"})
+	require.NoError(t, err)
+	require.Equal(t, []string{indexes[0], indexes[2]}, postIndexes(cited.SocialPost))
+	cited, err = qs.ListSourcePosts(ctx, &types.QueryListSourcePostsRequest{SourceIndex: "b"})
+	require.NoError(t, err)
+	require.Equal(t, []string{indexes[0], indexes[1]}, postIndexes(cited.SocialPost))
+
+	// Cited sources cannot be deleted
+	_, err = srv.DeleteSource(ctx, &types.MsgDeleteSource{Creator: author, Index: "b"})
+	require.ErrorIs(t, err, types.ErrSourceCited)
+	for _, index := range indexes[:2] {
+		_, err = srv.DeleteSocialPost(ctx, &types.MsgDeleteSocialPost{Creator: author, Index: index})
+		require.NoError(t, err)
+	}
+	cited, err = qs.ListSourcePosts(ctx, &types.QueryListSourcePostsRequest{SourceIndex: "b"})
+	require.NoError(t, err)
+	require.Empty(t, cited.SocialPost)
+	_, err = srv.DeleteSource(ctx, &types.MsgDeleteSource{Creator: author, Index: "b"})
+	require.NoError(t, err)
+}
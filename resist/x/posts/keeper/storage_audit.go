@@ -0,0 +1,277 @@
+package keeper
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	mrand "math/rand/v2"
+	"slices"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"resist/x/posts/types"
+)
+
+// maxExpiredChallengesPerBlock bounds the number of missed storage challenges
+// handled in a single block.
+const maxExpiredChallengesPerBlock = 100
+
+// SetStorageChallenge stores a storage challenge and keeps the deadline and
+// replica indexes of pending challenges in sync with its status.
+func (k Keeper) SetStorageChallenge(ctx context.Context, challenge types.StorageChallenge) error {
+	prev, err := k.StorageChallenge.Get(ctx, challenge.Id)
+	switch {
+	case err == nil:
+		if prev.Status == types.ChallengeStatusPending {
+			if err := k.StorageChallengeDeadline.Remove(ctx, collections.Join(prev.DeadlineHeight, prev.Id)); err != nil {
+				return err
+			}
+			if err := k.PendingStorageChallenge.Remove(ctx, collections.Join(prev.ContentId, prev.NodeId)); err != nil {
+				return err
+			}
+		}
+	case !errors.Is(err, collections.ErrNotFound):
+		return err
+	}
+
+	if challenge.Status == types.ChallengeStatusPending {
+		if err := k.StorageChallengeDeadline.Set(ctx, collections.Join(challenge.DeadlineHeight, challenge.Id)); err != nil {
+			return err
+		}
+		if err := k.PendingStorageChallenge.Set(ctx, collections.Join(challenge.ContentId, challenge.NodeId), challenge.Id); err != nil {
+			return err
+		}
+	}
+	return k.StorageChallenge.Set(ctx, challenge.Id, challenge)
+}
+
+// issueStorageChallenges challenges randomly picked replica nodes to prove
+// that they store a randomly picked chunk of the content they acknowledged.
+// Replicas with a pending challenge are not challenged again.
+// The picks are seeded by the block hash so every validator issues the same
+// challenges, and each one seeks a random position of the index of stored
+// assignments so that the work does not grow with the number of replicas.
+func (k Keeper) issueStorageChallenges(ctx context.Context, params types.Params) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	h := sha256.New()
+	h.Write([]byte("storage-audit"))
+	h.Write(sdkCtx.HeaderHash())
+	h.Write(binary.BigEndian.AppendUint64(nil, uint64(sdkCtx.BlockHeight())))
+	var seed [32]byte
+	copy(seed[:], h.Sum(nil))
+	rng := mrand.New(mrand.NewChaCha8(seed))
+
+	picked := make(map[[2]string]struct{}, params.AuditChallengesPerEpoch)
+	for range params.AuditChallengesPerEpoch {
+		key, found, err := k.pickStoredReplica(ctx, rng.Uint64(), picked)
+		if err != nil {
+			return err
+		}
+		if !found {
+			// Every stored assignment is already challenged or pending
+			break
+		}
+		picked[[2]string{key.K2(), key.K3()}] = struct{}{}
+
+		assignment, err := k.ReplicaAssignment.Get(ctx, collections.Join(key.K2(), key.K3()))
+		if err != nil {
+			return err
+		}
+		distribution, err := k.ContentDistribution.Get(ctx, assignment.ContentId)
+		if err != nil {
+			return err
+		}
+		chunks := contentChunks(distribution)
+		if chunks == 0 {
+			continue
+		}
+
+		id, err := k.StorageChallengeSeq.Next(ctx)
+		if err != nil {
+			return err
+		}
+		challenge := types.StorageChallenge{
+			Id:             id,
+			ContentId:      assignment.ContentId,
+			NodeId:         assignment.NodeId,
+			ChunkIndex:     rng.Uint64N(chunks),
+			Status:         types.ChallengeStatusPending,
+			IssuedHeight:   sdkCtx.BlockHeight(),
+			DeadlineHeight: sdkCtx.BlockHeight() + params.AuditResponseBlocks,
+		}
+		if err := k.SetStorageChallenge(ctx, challenge); err != nil {
+			return err
+		}
+
+		sdkCtx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				"storage_challenge_issued",
+				sdk.NewAttribute("challenge_id", fmt.Sprintf("%d", challenge.Id)),
+				sdk.NewAttribute("content_id", challenge.ContentId),
+				sdk.NewAttribute("node_id", challenge.NodeId),
+				sdk.NewAttribute("chunk_index", fmt.Sprintf("%d", challenge.ChunkIndex)),
+				sdk.NewAttribute("deadline_height", fmt.Sprintf("%d", challenge.DeadlineHeight)),
+			),
+		)
+	}
+	return nil
+}
+
+// pickStoredReplica returns the key of the first stored assignment neither in
+// picked, by (content id, node id), nor with a pending challenge, at or after
+// position point of the index of stored assignments, wrapping around at its
+// end. It returns false if there is no such assignment.
+func (k Keeper) pickStoredReplica(ctx context.Context, point uint64, picked map[[2]string]struct{}) (collections.Triple[uint64, string, string], bool, error) {
+	seek := collections.TriplePrefix[uint64, string, string](point)
+	ranges := []*collections.Range[collections.Triple[uint64, string, string]]{
+		new(collections.Range[collections.Triple[uint64, string, string]]).StartInclusive(seek),
+		new(collections.Range[collections.Triple[uint64, string, string]]).EndExclusive(seek),
+	}
+
+	var (
+		key   collections.Triple[uint64, string, string]
+		found bool
+	)
+	for _, r := range ranges {
+		if err := k.StoredReplica.Walk(ctx, r, func(candidate collections.Triple[uint64, string, string]) (bool, error) {
+			if _, ok := picked[[2]string{candidate.K2(), candidate.K3()}]; ok {
+				return false, nil
+			}
+			if pending, err := k.PendingStorageChallenge.Has(ctx, collections.Join(candidate.K2(), candidate.K3())); err != nil {
+				return true, err
+			} else if pending {
+				return false, nil
+			}
+			key, found = candidate, true
+			return true, nil
+		}); err != nil || found {
+			return key, found, err
+		}
+	}
+	return key, false, nil
+}
+
+// expireStorageChallenges fails the pending challenges whose deadline passed.
+func (k Keeper) expireStorageChallenges(ctx context.Context, params types.Params) error {
+	height := sdk.UnwrapSDKContext(ctx).BlockHeight()
+
+	var expired []uint64
+	if err := k.StorageChallengeDeadline.Walk(ctx, nil, func(key collections.Pair[int64, uint64]) (bool, error) {
+		if key.K1() >= height || len(expired) == maxExpiredChallengesPerBlock {
+			return true, nil
+		}
+		expired = append(expired, key.K2())
+		return false, nil
+	}); err != nil {
+		return err
+	}
+
+	for _, id := range expired {
+		challenge, err := k.StorageChallenge.Get(ctx, id)
+		if err != nil {
+			return err
+		}
+		if err := k.failStorageChallenge(ctx, challenge, "no answer before deadline", params); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// passStorageChallenge records a valid answer to a challenge.
+func (k Keeper) passStorageChallenge(ctx context.Context, challenge types.StorageChallenge) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	challenge.Status = types.ChallengeStatusPassed
+	challenge.AnsweredHeight = sdkCtx.BlockHeight()
+	if err := k.SetStorageChallenge(ctx, challenge); err != nil {
+		return err
+	}
+	if err := k.rewardsKeeper.RecordStorageAudit(ctx, challenge.NodeId, true, 0); err != nil {
+		return err
+	}
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"storage_challenge_passed",
+			sdk.NewAttribute("challenge_id", fmt.Sprintf("%d", challenge.Id)),
+			sdk.NewAttribute("content_id", challenge.ContentId),
+			sdk.NewAttribute("node_id", challenge.NodeId),
+		),
+	)
+	return nil
+}
+
+// failStorageChallenge records a missed or invalid answer to a challenge. The
+// node is penalized and loses its replica, which is assigned to another node.
+func (k Keeper) failStorageChallenge(ctx context.Context, challenge types.StorageChallenge, reason string, params types.Params) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	challenge.Status = types.ChallengeStatusFailed
+	challenge.FailureReason = reason
+	if err := k.SetStorageChallenge(ctx, challenge); err != nil {
+		return err
+	}
+	if err := k.rewardsKeeper.RecordStorageAudit(ctx, challenge.NodeId, false, params.AuditUptimePenalty); err != nil {
+		return err
+	}
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"storage_challenge_failed",
+			sdk.NewAttribute("challenge_id", fmt.Sprintf("%d", challenge.Id)),
+			sdk.NewAttribute("content_id", challenge.ContentId),
+			sdk.NewAttribute("node_id", challenge.NodeId),
+			sdk.NewAttribute("reason", reason),
+		),
+	)
+
+	return k.dropReplica(ctx, challenge.ContentId, challenge.NodeId)
+}
+
+// dropReplica removes a node from the replicas of the content and assigns the
+// replica to another node.
+func (k Keeper) dropReplica(ctx context.Context, contentId, nodeId string) error {
+	assignment, err := k.ReplicaAssignment.Get(ctx, collections.Join(contentId, nodeId))
+	if err != nil {
+		return err
+	}
+	if assignment.Status != types.ReplicaStatusStored {
+		// The replica was already dropped, e.g. by an earlier challenge
+		return nil
+	}
+	assignment.Status = types.ReplicaStatusFailed
+	if err := k.SetReplicaAssignment(ctx, assignment); err != nil {
+		return err
+	}
+
+	distribution, err := k.ContentDistribution.Get(ctx, contentId)
+	if err != nil {
+		return err
+	}
+	if distribution.Replication != nil {
+		distribution.Replication.ReplicaNodes = slices.DeleteFunc(distribution.Replication.ReplicaNodes, func(n string) bool { return n == nodeId })
+		distribution.Replication.CurrentReplicas = uint32(len(distribution.Replication.ReplicaNodes))
+		if err := k.ContentDistribution.Set(ctx, contentId, distribution); err != nil {
+			return err
+		}
+	}
+
+	return k.reassignReplica(ctx, NewContentDistributionService(&k), assignment)
+}
+
+// contentChunks returns the number of DefaultChunkSize chunks of the content.
+func contentChunks(distribution types.ContentDistribution) uint64 {
+	var size uint64
+	if distribution.Replication != nil {
+		size = distribution.Replication.TotalSizeBytes
+	}
+	if size == 0 && distribution.Metadata != nil {
+		size = distribution.Metadata.SizeBytes
+	}
+	return (size + types.DefaultChunkSize - 1) / types.DefaultChunkSize
+}
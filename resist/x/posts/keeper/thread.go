@@ -0,0 +1,180 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"cosmossdk.io/collections"
+
+	"resist/x/posts/types"
+)
+
+const (
+	// defaultThreadDepth is the depth of the reply trees returned by default.
+	defaultThreadDepth = 3
+	// maxThreadDepth caps the depth of the reply trees.
+	maxThreadDepth = 5
+	// maxThreadReplies caps the replies returned for each post below the
+	// paginated direct replies.
+	maxThreadReplies = 20
+	// maxThreadNodes caps the direct replies in a page of a thread, and
+	// separately the posts read below them, so that a thread query reads at
+	// most twice as many posts whatever the shape of the tree.
+	maxThreadNodes = 200
+)
+
+// replyKey returns the key of reply in the index of replies.
+func replyKey(reply types.SocialPost) (collections.Pair[string, uint64], error) {
+	index, err := strconv.ParseUint(reply.Index, 10, 64)
+	if err != nil {
+		return collections.Pair[string, uint64]{}, fmt.Errorf("invalid reply index %q: %w", reply.Index, err)
+	}
+	return collections.Join(reply.ParentIndex, index), nil
+}
+
+// setReply indexes reply under its parent and counts it on the parent.
+func (k Keeper) setReply(ctx context.Context, reply types.SocialPost) error {
+	key, err := replyKey(reply)
+	if err != nil {
+		return err
+	}
+	if err := k.Reply.Set(ctx, key); err != nil {
+		return err
+	}
+	parent, err := k.SocialPost.Get(ctx, reply.ParentIndex)
+	if err != nil {
+		return err
+	}
+	parent.ReplyCount++
+	return k.SocialPost.Set(ctx, parent.Index, parent)
+}
+
+// removeReply unindexes reply and uncounts it on its parent.
+func (k Keeper) removeReply(ctx context.Context, reply types.SocialPost) error {
+	key, err := replyKey(reply)
+	if err != nil {
+		return err
+	}
+	if err := k.Reply.Remove(ctx, key); err != nil {
+		return err
+	}
+	parent, err := k.SocialPost.Get(ctx, reply.ParentIndex)
+	if errors.Is(err, collections.ErrNotFound) {
+		return k.uncountDeletedReply(ctx, reply.ParentIndex)
+	} else if err != nil {
+		return err
+	}
+	if parent.ReplyCount > 0 {
+		parent.ReplyCount--
+	}
+	return k.SocialPost.Set(ctx, parent.Index, parent)
+}
+
+// tombstone returns what a deleted post keeps: its place in its thread, and
+// the sources it cited for the outcomes of the reports on it.
+func tombstone(post types.SocialPost) types.SocialPost {
+	return types.SocialPost{
+		Index:         post.Index,
+		GroupId:       post.GroupId,
+		CreatedAt:     post.CreatedAt,
+		ParentIndex:   post.ParentIndex,
+		RootIndex:     post.RootIndex,
+		ReplyCount:    post.ReplyCount,
+		SourceIndexes: post.SourceIndexes,
+	}
+}
+
+// deletePost removes post, leaving its tombstone in its thread if it has
+// replies, and out of any thread if it only cites sources.
+func (k Keeper) deletePost(ctx context.Context, post types.SocialPost) error {
+	if err := k.SocialPost.Remove(ctx, post.Index); err != nil {
+		return err
+	}
+	if post.ReplyCount == 0 && post.ParentIndex != "" {
+		if err := k.removeReply(ctx, post); err != nil {
+			return err
+		}
+	}
+	if post.ReplyCount > 0 || len(post.SourceIndexes) > 0 {
+		return k.DeletedPost.Set(ctx, post.Index, tombstone(post))
+	}
+	return nil
+}
+
+// uncountDeletedReply uncounts a reply on the tombstone of its deleted parent,
+// and removes the tombstone from its thread once its last reply is gone. The
+// tombstones of posts citing sources are kept out of any thread.
+func (k Keeper) uncountDeletedReply(ctx context.Context, index string) error {
+	parent, err := k.DeletedPost.Get(ctx, index)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	if parent.ReplyCount > 1 {
+		parent.ReplyCount--
+		return k.DeletedPost.Set(ctx, index, parent)
+	}
+	parent.ReplyCount = 0
+	if len(parent.SourceIndexes) > 0 {
+		err = k.DeletedPost.Set(ctx, index, parent)
+	} else {
+		err = k.DeletedPost.Remove(ctx, index)
+	}
+	if err != nil || parent.ParentIndex == "" {
+		return err
+	}
+	return k.removeReply(ctx, parent)
+}
+
+// threadPost returns the post at index, or its tombstone and true if it was
+// deleted.
+func (k Keeper) threadPost(ctx context.Context, index string) (types.SocialPost, bool, error) {
+	post, err := k.SocialPost.Get(ctx, index)
+	if !errors.Is(err, collections.ErrNotFound) {
+		return post, false, err
+	}
+	post, err = k.DeletedPost.Get(ctx, index)
+	return post, true, err
+}
+
+// replyTree returns the first maxThreadReplies replies to the post at index,
+// each with its replies down to depth levels below the post. Every reply read
+// spends one of budget, and the walk stops once budget is spent.
+func (k Keeper) replyTree(ctx context.Context, index string, depth uint32, budget *int) ([]types.ThreadNode, error) {
+	if depth == 0 || *budget <= 0 {
+		return nil, nil
+	}
+
+	var replies []types.ThreadNode
+	err := k.Reply.Walk(ctx, collections.NewPrefixedPairRange[string, uint64](index), func(key collections.Pair[string, uint64]) (bool, error) {
+		*budget--
+		node, err := k.threadNode(ctx, strconv.FormatUint(key.K2(), 10), depth-1, budget)
+		if err != nil {
+			return true, err
+		}
+		if node != nil {
+			replies = append(replies, *node)
+		}
+		return len(replies) == maxThreadReplies || *budget <= 0, nil
+	})
+	return replies, err
+}
+
+// threadNode returns the post at index, or its tombstone, with its replies
+// down to depth levels within budget.
+func (k Keeper) threadNode(ctx context.Context, index string, depth uint32, budget *int) (*types.ThreadNode, error) {
+	post, deleted, err := k.threadPost(ctx, index)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	replies, err := k.replyTree(ctx, index, depth, budget)
+	if err != nil {
+		return nil, err
+	}
+	return &types.ThreadNode{Post: post, Replies: replies, Deleted: deleted}, nil
+}
@@ -65,6 +65,91 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Alias:          []string{"show-post-tag"},
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "index"}},
 				},
+				{
+					RpcMethod: "ListContentDistribution",
+					Use:       "list-content-distribution",
+					Short:     "List all content-distribution",
+				},
+				{
+					RpcMethod:      "GetContentDistribution",
+					Use:            "get-content-distribution [content-id]",
+					Short:          "Gets a content-distribution",
+					Alias:          []string{"show-content-distribution"},
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "content_id"}},
+				},
+				{
+					RpcMethod:      "ListReplicaAssignment",
+					Use:            "list-replica-assignment [content-id]",
+					Short:          "List the replica assignments of a content",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "content_id"}},
+				},
+				{
+					RpcMethod: "ListStorageChallenge",
+					Use:       "list-storage-challenge",
+					Short:     "List all storage-challenge",
+				},
+				{
+					RpcMethod:      "GetStorageChallenge",
+					Use:            "get-storage-challenge [id]",
+					Short:          "Gets a storage-challenge",
+					Alias:          []string{"show-storage-challenge"},
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}},
+				},
+				{
+					RpcMethod: "ListHubSync",
+					Use:       "list-hub-sync",
+					Short:     "List all hub-sync, optionally those of a node (--node-id)",
+				},
+				{
+					RpcMethod:      "GetHubSync",
+					Use:            "get-hub-sync [sync-id]",
+					Short:          "Gets a hub-sync",
+					Alias:          []string{"show-hub-sync"},
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "sync_id"}},
+				},
+				{
+					RpcMethod:      "GetPrekeyBundle",
+					Use:            "get-prekey-bundle [node-id]",
+					Short:          "Gets the prekey bundle of a node and its number of one-time prekeys",
+					Alias:          []string{"show-prekey-bundle"},
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "node_id"}},
+				},
+				{
+					RpcMethod:      "ListPendingMessages",
+					Use:            "list-pending-messages [node-id]",
+					Short:          "List the messages queued in the mailbox of a node",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "node_id"}},
+				},
+				{
+					RpcMethod:      "GetThread",
+					Use:            "get-thread [index]",
+					Short:          "Get a post and the tree of its replies",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "index"}},
+				},
+				{
+					RpcMethod:      "ListPostsByAuthor",
+					Use:            "list-posts-by-author [author]",
+					Short:          "List the posts of an author",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "author"}},
+				},
+				{
+					RpcMethod:      "ListPostsByGroup",
+					Use:            "list-posts-by-group [group-id]",
+					Short:          "List the posts of a group",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "group_id"}},
+				},
+				{
+					RpcMethod:      "ListPostsSince",
+					Use:            "list-posts-since [since]",
+					Short:          "List the posts created at or after a unix time",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "since"}},
+				},
+				{
+					RpcMethod:      "ListSourcePosts",
+					Use:            "list-source-posts [source-index]",
+					Short:          "List the posts citing a source",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "source_index"}},
+				},
 				// this line is used by ignite scaffolding # autocli/query
 			},
 		},
@@ -90,15 +175,15 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 				},
 				{
 					RpcMethod:      "CreateSocialPost",
-					Use:            "create-social-post [index] [title] [content] [media-url] [media-type] [group-id] [author] [upvotes] [downvotes] [created-at]",
+					Use:            "create-social-post [title] [content] [media-url] [media-type] [group-id]",
 					Short:          "Create a new social-post",
-					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "index"}, {ProtoField: "title"}, {ProtoField: "content"}, {ProtoField: "media_url"}, {ProtoField: "media_type"}, {ProtoField: "group_id"}, {ProtoField: "author"}, {ProtoField: "upvotes"}, {ProtoField: "downvotes"}, {ProtoField: "created_at"}},
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "title"}, {ProtoField: "content"}, {ProtoField: "media_url"}, {ProtoField: "media_type"}, {ProtoField: "group_id"}},
 				},
 				{
 					RpcMethod:      "UpdateSocialPost",
-					Use:            "update-social-post [index] [title] [content] [media-url] [media-type] [group-id] [author] [upvotes] [downvotes] [created-at]",
+					Use:            "update-social-post [index] [title] [content] [media-url] [media-type] [group-id]",
 					Short:          "Update social-post",
-					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "index"}, {ProtoField: "title"}, {ProtoField: "content"}, {ProtoField: "media_url"}, {ProtoField: "media_type"}, {ProtoField: "group_id"}, {ProtoField: "author"}, {ProtoField: "upvotes"}, {ProtoField: "downvotes"}, {ProtoField: "created_at"}},
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "index"}, {ProtoField: "title"}, {ProtoField: "content"}, {ProtoField: "media_url"}, {ProtoField: "media_type"}, {ProtoField: "group_id"}},
 				},
 				{
 					RpcMethod:      "DeleteSocialPost",
@@ -126,15 +211,15 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 				},
 				{
 					RpcMethod:      "CreateSource",
-					Use:            "create-source [index] [url] [title] [description] [credibility-score] [analysis-summary] [verified]",
+					Use:            "create-source [index] [url] [title] [description] [analysis-summary]",
 					Short:          "Create a new source",
-					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "index"}, {ProtoField: "url"}, {ProtoField: "title"}, {ProtoField: "description"}, {ProtoField: "credibility_score"}, {ProtoField: "analysis_summary"}, {ProtoField: "verified"}},
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "index"}, {ProtoField: "url"}, {ProtoField: "title"}, {ProtoField: "description"}, {ProtoField: "analysis_summary"}},
 				},
 				{
 					RpcMethod:      "UpdateSource",
-					Use:            "update-source [index] [url] [title] [description] [credibility-score] [analysis-summary] [verified]",
+					Use:            "update-source [index] [url] [title] [description] [analysis-summary]",
 					Short:          "Update source",
-					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "index"}, {ProtoField: "url"}, {ProtoField: "title"}, {ProtoField: "description"}, {ProtoField: "credibility_score"}, {ProtoField: "analysis_summary"}, {ProtoField: "verified"}},
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "index"}, {ProtoField: "url"}, {ProtoField: "title"}, {ProtoField: "description"}, {ProtoField: "analysis_summary"}},
 				},
 				{
 					RpcMethod:      "DeleteSource",
@@ -142,6 +227,18 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Short:          "Delete source",
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "index"}},
 				},
+				{
+					RpcMethod:      "VoteSource",
+					Use:            "vote-source [source-index] [vote-type]",
+					Short:          "Vote on the credibility of a source",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "source_index"}, {ProtoField: "vote_type"}},
+				},
+				{
+					RpcMethod:      "VerifySource",
+					Use:            "verify-source [index] [verified]",
+					Short:          "Mark a source as verified or not, as an attestation issuer",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "index"}, {ProtoField: "verified"}},
+				},
 				{
 					RpcMethod:      "CreatePostTag",
 					Use:            "create-post-tag [index] [post-index] [tag] [category] [similarity-score] [related-posts]",
@@ -160,6 +257,54 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Short:          "Delete post-tag",
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "index"}},
 				},
+				{
+					RpcMethod:      "AckReplica",
+					Use:            "ack-replica [content-id] [node-id] [cid]",
+					Short:          "Acknowledge that a node stores its assigned replica",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "content_id"}, {ProtoField: "node_id"}, {ProtoField: "cid"}},
+				},
+				{
+					RpcMethod: "AnswerChallenge",
+					Skip:      true, // skipped because proofs are built from the stored content by the node
+				},
+				{
+					RpcMethod:      "ReportSyncProgress",
+					Use:            "report-sync-progress [sync-id] [bytes-transferred]",
+					Short:          "Report the progress of a hub sync",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "sync_id"}, {ProtoField: "bytes_transferred"}},
+				},
+				{
+					RpcMethod:      "CompleteSync",
+					Use:            "complete-sync [sync-id] [success] [bytes-transferred]",
+					Short:          "Close a hub sync as completed or failed",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "sync_id"}, {ProtoField: "success"}, {ProtoField: "bytes_transferred"}},
+				},
+				{
+					RpcMethod: "PublishPrekeyBundle",
+					Skip:      true, // skipped because bundles are built from the node signal store
+				},
+				{
+					RpcMethod: "ReplenishOneTimePrekeys",
+					Skip:      true, // skipped because one-time prekeys are generated by the node signal store
+				},
+				{
+					RpcMethod:      "ClaimPrekeyBundle",
+					Use:            "claim-prekey-bundle [claimer-node-id] [node-id]",
+					Short:          "Claim the prekey bundle of a node to open a channel with it",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "claimer_node_id"}, {ProtoField: "node_id"}},
+				},
+				{
+					RpcMethod:      "AckMessages",
+					Use:            "ack-messages [node-id] [message-ids]...",
+					Short:          "Remove delivered messages from the mailbox of a node",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "node_id"}, {ProtoField: "message_ids", Varargs: true}},
+				},
+				{
+					RpcMethod:      "ReplyToPost",
+					Use:            "reply-to-post [parent-index] [content]",
+					Short:          "Reply to a post",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "parent_index"}, {ProtoField: "content"}},
+				},
 				// this line is used by ignite scaffolding # autocli/tx
 			},
 		},
@@ -7,10 +7,14 @@ import (
 	"cosmossdk.io/depinject"
 	"cosmossdk.io/depinject/appconfig"
 	"github.com/cosmos/cosmos-sdk/codec"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
+	identitytypes "resist/x/identity/types"
+	"resist/x/posts/ipfs"
 	"resist/x/posts/keeper"
 	"resist/x/posts/types"
+	usergroupstypes "resist/x/usergroups/types"
 )
 
 var _ depinject.OnePerModuleType = AppModule{}
@@ -32,16 +36,21 @@ type ModuleInputs struct {
 	StoreService store.KVStoreService
 	Cdc          codec.Codec
 	AddressCodec address.Codec
+	AppOpts      servertypes.AppOptions `optional:"true"`
 
-	AuthKeeper types.AuthKeeper
-	BankKeeper types.BankKeeper
+	AuthKeeper     types.AuthKeeper
+	BankKeeper     types.BankKeeper
+	RewardsKeeper  types.RewardsKeeper
+	IdentityKeeper types.IdentityKeeper
 }
 
 type ModuleOutputs struct {
 	depinject.Out
 
-	PostsKeeper keeper.Keeper
-	Module      appmodule.AppModule
+	PostsKeeper        keeper.Keeper
+	Module             appmodule.AppModule
+	IdentityHooks      identitytypes.IdentityHooksWrapper
+	ContentReportHooks usergroupstypes.ContentReportHooksWrapper
 }
 
 func ProvideModule(in ModuleInputs) ModuleOutputs {
@@ -55,8 +64,22 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 		in.Cdc,
 		in.AddressCodec,
 		authority,
+		in.RewardsKeeper,
+		in.IdentityKeeper,
 	)
+	if in.AppOpts != nil {
+		client, err := ipfs.NewClientFromAppOptions(in.AppOpts)
+		if err != nil {
+			panic(err)
+		}
+		k.SetIPFSClient(client)
+	}
 	m := NewAppModule(in.Cdc, k, in.AuthKeeper, in.BankKeeper)
 
-	return ModuleOutputs{PostsKeeper: k, Module: m}
+	return ModuleOutputs{
+		PostsKeeper:        k,
+		Module:             m,
+		IdentityHooks:      identitytypes.IdentityHooksWrapper{IdentityHooks: k.Hooks()},
+		ContentReportHooks: usergroupstypes.ContentReportHooksWrapper{ContentReportHooks: k.Hooks()},
+	}
 }
@@ -0,0 +1,121 @@
+package signal
+
+import (
+	"bytes"
+	"crypto/ecdh"
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+)
+
+// KeySize is the size of X25519 public and private keys.
+const KeySize = 32
+
+// ErrInvalidBundle is returned when a prekey bundle is malformed or its signed
+// prekey is not signed by its identity.
+var ErrInvalidBundle = errors.New("invalid prekey bundle")
+
+// Identity holds the long-term keys of a node. The X25519 key takes part in
+// the X3DH key agreement, the Ed25519 key signs prekeys and messages.
+type Identity struct {
+	DHKey      []byte `json:"dh_key"`
+	SigningKey []byte `json:"signing_key"`
+}
+
+// NewIdentity generates a new identity.
+func NewIdentity() (Identity, error) {
+	dh, err := ecdh.X25519().GenerateKey(rand.Reader)
+	if err != nil {
+		return Identity{}, err
+	}
+	return Identity{
+		DHKey:      dh.Bytes(),
+		SigningKey: ed25519.GenPrivKey().Key,
+	}, nil
+}
+
+// PublicKey returns the public X25519 identity key.
+func (id Identity) PublicKey() []byte {
+	return publicKey(id.DHKey)
+}
+
+// SigningPrivKey returns the Ed25519 signing key.
+func (id Identity) SigningPrivKey() *ed25519.PrivKey {
+	return &ed25519.PrivKey{Key: id.SigningKey}
+}
+
+// SigningPubKey returns the public Ed25519 signing key.
+func (id Identity) SigningPubKey() []byte {
+	return id.SigningPrivKey().PubKey().Bytes()
+}
+
+// PrekeyBundle is the public key material a node publishes so that peers can
+// open a channel with it while it is offline.
+type PrekeyBundle struct {
+	IdentityKey []byte `json:"identity_key"`
+	SigningKey  []byte `json:"signing_key"`
+
+	SignedPrekeyId        uint32 `json:"signed_prekey_id"`
+	SignedPrekey          []byte `json:"signed_prekey"`
+	SignedPrekeySignature []byte `json:"signed_prekey_signature"`
+
+	// OneTimePrekey is optional.
+	OneTimePrekeyId uint32 `json:"one_time_prekey_id,omitempty"`
+	OneTimePrekey   []byte `json:"one_time_prekey,omitempty"`
+}
+
+// Verify checks the key sizes of the bundle and the signature of its signed prekey.
+func (b PrekeyBundle) Verify() error {
+	if len(b.IdentityKey) != KeySize || len(b.SignedPrekey) != KeySize || len(b.SigningKey) != ed25519.PubKeySize {
+		return ErrInvalidBundle
+	}
+	if b.OneTimePrekey != nil && len(b.OneTimePrekey) != KeySize {
+		return ErrInvalidBundle
+	}
+	signingKey := &ed25519.PubKey{Key: b.SigningKey}
+	if !signingKey.VerifySignature(SignedPrekeyBytes(b.IdentityKey, b.SignedPrekeyId, b.SignedPrekey), b.SignedPrekeySignature) {
+		return ErrInvalidBundle
+	}
+	return nil
+}
+
+// SignedPrekeyBytes returns the bytes signed by the identity signing key to
+// certify a signed prekey. They bind the prekey to the X25519 identity key too.
+func SignedPrekeyBytes(identityKey []byte, id uint32, prekey []byte) []byte {
+	var buf bytes.Buffer
+	buf.WriteString("resist-signed-prekey")
+	buf.Write(identityKey)
+	buf.Write(binary.BigEndian.AppendUint32(nil, id))
+	buf.Write(prekey)
+	return buf.Bytes()
+}
+
+func generateKey() ([]byte, error) {
+	key, err := ecdh.X25519().GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	return key.Bytes(), nil
+}
+
+func publicKey(private []byte) []byte {
+	key, err := ecdh.X25519().NewPrivateKey(private)
+	if err != nil {
+		return nil
+	}
+	return key.PublicKey().Bytes()
+}
+
+func dh(private, public []byte) ([]byte, error) {
+	priv, err := ecdh.X25519().NewPrivateKey(private)
+	if err != nil {
+		return nil, err
+	}
+	pub, err := ecdh.X25519().NewPublicKey(public)
+	if err != nil {
+		return nil, err
+	}
+	return priv.ECDH(pub)
+}
@@ -0,0 +1,294 @@
+package signal
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hkdf"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+)
+
+// maxSkip bounds the number of message keys stored for messages that were not
+// received yet, within a chain and in total.
+const maxSkip = 1000
+
+var (
+	// ErrDecryption is returned when a message cannot be authenticated.
+	ErrDecryption = errors.New("failed to decrypt message")
+	// ErrRotationPending is returned when the ratchet cannot take a new DH
+	// step before the peer answers with its next ratchet key.
+	ErrRotationPending = errors.New("ratchet key rotation is waiting for the peer")
+)
+
+// Header is the Double Ratchet header of a message.
+type Header struct {
+	// DH is the current ratchet public key of the sender.
+	DH []byte `json:"dh"`
+	// PN is the number of messages in the previous sending chain.
+	PN uint32 `json:"pn"`
+	// N is the number of the message in the current sending chain.
+	N uint32 `json:"n"`
+}
+
+func (h Header) bytes() []byte {
+	bz := append([]byte{}, h.DH...)
+	bz = binary.BigEndian.AppendUint32(bz, h.PN)
+	return binary.BigEndian.AppendUint32(bz, h.N)
+}
+
+// Envelope is an encrypted message, as carried in SignalMessage.EncryptedPayload.
+type Envelope struct {
+	PreKey     *PreKeyHeader `json:"prekey,omitempty"`
+	Header     Header        `json:"header"`
+	Ciphertext []byte        `json:"ciphertext"`
+}
+
+// Marshal encodes the envelope.
+func (e Envelope) Marshal() ([]byte, error) {
+	return json.Marshal(e)
+}
+
+// UnmarshalEnvelope decodes an envelope.
+func UnmarshalEnvelope(bz []byte) (Envelope, error) {
+	var e Envelope
+	if err := json.Unmarshal(bz, &e); err != nil {
+		return Envelope{}, fmt.Errorf("invalid envelope: %w", err)
+	}
+	if len(e.Header.DH) != KeySize {
+		return Envelope{}, errors.New("invalid envelope: invalid ratchet key")
+	}
+	return e, nil
+}
+
+// Session is the Double Ratchet state of one end of a channel.
+type Session struct {
+	ChannelId         string `json:"channel_id"`
+	RemoteIdentityKey []byte `json:"remote_identity_key"`
+	RemoteSigningKey  []byte `json:"remote_signing_key"`
+	AD                []byte `json:"ad"`
+
+	RootKey []byte `json:"root_key"`
+	// DHs is the private ratchet key, DHr the public ratchet key of the peer.
+	DHs []byte `json:"dhs"`
+	DHr []byte `json:"dhr,omitempty"`
+	// CKs is nil until a sending chain is derived from the latest DHr.
+	CKs []byte `json:"cks,omitempty"`
+	CKr []byte `json:"ckr,omitempty"`
+	Ns  uint32 `json:"ns"`
+	Nr  uint32 `json:"nr"`
+	PN  uint32 `json:"pn"`
+
+	// Skipped holds the message keys of messages not received yet, by ratchet
+	// key and message number.
+	Skipped map[string][]byte `json:"skipped,omitempty"`
+
+	// PreKey is attached to outgoing messages until the peer replies.
+	PreKey *PreKeyHeader `json:"prekey,omitempty"`
+}
+
+// Encrypt encrypts plaintext with the next message key of the sending chain.
+func (s *Session) Encrypt(plaintext []byte) (Envelope, error) {
+	next := s.clone()
+	if next.CKs == nil {
+		if err := next.sendStep(); err != nil {
+			return Envelope{}, err
+		}
+	}
+
+	var mk []byte
+	next.CKs, mk = kdfCK(next.CKs)
+	header := Header{DH: publicKey(next.DHs), PN: next.PN, N: next.Ns}
+	next.Ns++
+
+	ciphertext, err := seal(mk, plaintext, append(bytes.Clone(next.AD), header.bytes()...))
+	if err != nil {
+		return Envelope{}, err
+	}
+	*s = *next
+	return Envelope{PreKey: s.PreKey, Header: header, Ciphertext: ciphertext}, nil
+}
+
+// Decrypt authenticates and decrypts envelope. The session is left unchanged
+// if it fails.
+func (s *Session) Decrypt(envelope Envelope) ([]byte, error) {
+	next := s.clone()
+	header := envelope.Header
+
+	mk, ok := next.Skipped[skippedKey(header.DH, header.N)]
+	if ok {
+		delete(next.Skipped, skippedKey(header.DH, header.N))
+	} else {
+		if !bytes.Equal(header.DH, next.DHr) {
+			if err := next.skip(header.PN); err != nil {
+				return nil, err
+			}
+			if err := next.receiveStep(header.DH); err != nil {
+				return nil, err
+			}
+		}
+		if err := next.skip(header.N); err != nil {
+			return nil, err
+		}
+		next.CKr, mk = kdfCK(next.CKr)
+		next.Nr++
+	}
+
+	plaintext, err := open(mk, envelope.Ciphertext, append(bytes.Clone(next.AD), header.bytes()...))
+	if err != nil {
+		return nil, err
+	}
+	// The peer holds the session once it has sent a message
+	next.PreKey = nil
+	*s = *next
+	return plaintext, nil
+}
+
+// Rotate takes a DH ratchet step with a new ratchet key and discards the keys
+// of skipped messages. A step is only possible once the peer has sent a new
+// ratchet key since the last step.
+func (s *Session) Rotate() error {
+	if s.CKs != nil {
+		return ErrRotationPending
+	}
+	next := s.clone()
+	if err := next.sendStep(); err != nil {
+		return err
+	}
+	next.Skipped = nil
+	*s = *next
+	return nil
+}
+
+// sendStep derives a new sending chain with a new ratchet key.
+func (s *Session) sendStep() error {
+	if s.DHr == nil {
+		return errors.New("no ratchet key received from the peer")
+	}
+	dhs, err := generateKey()
+	if err != nil {
+		return err
+	}
+	out, err := dh(dhs, s.DHr)
+	if err != nil {
+		return err
+	}
+	s.DHs = dhs
+	s.RootKey, s.CKs, err = kdfRK(s.RootKey, out)
+	if err != nil {
+		return err
+	}
+	s.PN = s.Ns
+	s.Ns = 0
+	return nil
+}
+
+// receiveStep derives the receiving chain of a new ratchet key of the peer.
+// The matching sending chain is derived by the next Encrypt.
+func (s *Session) receiveStep(dhr []byte) error {
+	out, err := dh(s.DHs, dhr)
+	if err != nil {
+		return err
+	}
+	s.RootKey, s.CKr, err = kdfRK(s.RootKey, out)
+	if err != nil {
+		return err
+	}
+	s.DHr = dhr
+	s.Nr = 0
+	s.CKs = nil
+	return nil
+}
+
+// skip stores the message keys of the receiving chain up to message until.
+func (s *Session) skip(until uint32) error {
+	if s.CKr == nil || until <= s.Nr {
+		return nil
+	}
+	if until-s.Nr > maxSkip || len(s.Skipped)+int(until-s.Nr) > maxSkip {
+		return fmt.Errorf("%w: too many skipped messages", ErrDecryption)
+	}
+	if s.Skipped == nil {
+		s.Skipped = make(map[string][]byte)
+	}
+	for ; s.Nr < until; s.Nr++ {
+		var mk []byte
+		s.CKr, mk = kdfCK(s.CKr)
+		s.Skipped[skippedKey(s.DHr, s.Nr)] = mk
+	}
+	return nil
+}
+
+func (s *Session) clone() *Session {
+	next := *s
+	next.Skipped = maps.Clone(s.Skipped)
+	return &next
+}
+
+func skippedKey(dh []byte, n uint32) string {
+	return fmt.Sprintf("%s:%d", hex.EncodeToString(dh), n)
+}
+
+// kdfRK derives a new root key and chain key from a DH output.
+func kdfRK(rk, dhOut []byte) ([]byte, []byte, error) {
+	out, err := hkdf.Key(sha256.New, dhOut, rk, "resist-ratchet", 2*KeySize)
+	if err != nil {
+		return nil, nil, err
+	}
+	return out[:KeySize], out[KeySize:], nil
+}
+
+// kdfCK returns the next chain key and the message key of a chain key.
+func kdfCK(ck []byte) ([]byte, []byte) {
+	return hmacSHA256(ck, 0x02), hmacSHA256(ck, 0x01)
+}
+
+func hmacSHA256(key []byte, b byte) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte{b})
+	return mac.Sum(nil)
+}
+
+// messageCipher derives the AES-256-GCM cipher and nonce of a message key.
+// Message keys are used once, so the nonce may be derived from the key.
+func messageCipher(mk []byte) (cipher.AEAD, []byte, error) {
+	out, err := hkdf.Key(sha256.New, mk, make([]byte, sha256.Size), "resist-message-keys", KeySize+12)
+	if err != nil {
+		return nil, nil, err
+	}
+	block, err := aes.NewCipher(out[:KeySize])
+	if err != nil {
+		return nil, nil, err
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, nil, err
+	}
+	return aead, out[KeySize:], nil
+}
+
+func seal(mk, plaintext, ad []byte) ([]byte, error) {
+	aead, nonce, err := messageCipher(mk)
+	if err != nil {
+		return nil, err
+	}
+	return aead.Seal(nil, nonce, plaintext, ad), nil
+}
+
+func open(mk, ciphertext, ad []byte) ([]byte, error) {
+	aead, nonce, err := messageCipher(mk)
+	if err != nil {
+		return nil, err
+	}
+	plaintext, err := aead.Open(nil, nonce, ciphertext, ad)
+	if err != nil {
+		return nil, ErrDecryption
+	}
+	return plaintext, nil
+}
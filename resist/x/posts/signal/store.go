@@ -0,0 +1,327 @@
+package signal
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sync"
+)
+
+const (
+	identityFile = "identity.json"
+	prekeysFile  = "prekeys.json"
+	sessionsDir  = "sessions"
+
+	// maxSignedPrekeys is the number of signed prekeys kept, so that channels
+	// opened against a previous bundle can still be accepted after a rotation.
+	maxSignedPrekeys = 2
+)
+
+var (
+	// ErrNoSession is returned when a channel has no session.
+	ErrNoSession = errors.New("no session for channel")
+	// ErrUnknownPrekey is returned when a message uses a prekey the store
+	// does not hold, or a one-time prekey that was already consumed.
+	ErrUnknownPrekey = errors.New("unknown prekey")
+
+	channelIdPattern = regexp.MustCompile(`^[A-Za-z0-9_-]{1,128}$`)
+)
+
+type signedPrekey struct {
+	Id         uint32 `json:"id"`
+	PrivateKey []byte `json:"private_key"`
+	Signature  []byte `json:"signature"`
+}
+
+type prekeyState struct {
+	// SignedPrekeys holds the latest signed prekeys, the current one last.
+	SignedPrekeys  []signedPrekey    `json:"signed_prekeys"`
+	OneTimePrekeys map[uint32][]byte `json:"one_time_prekeys"`
+	NextId         uint32            `json:"next_id"`
+}
+
+// Store keeps the identity, prekeys and sessions of a node in a directory. It
+// is meant to live in the node home directory and never leaves the node.
+type Store struct {
+	mu       sync.Mutex
+	dir      string
+	identity Identity
+	prekeys  prekeyState
+}
+
+// DefaultDir returns the store directory in the node home directory.
+func DefaultDir(homeDir string) string {
+	return filepath.Join(homeDir, "data", "signal")
+}
+
+// OpenStore opens (or creates) a store in dir. A new identity and signed
+// prekey are generated on creation.
+func OpenStore(dir string) (*Store, error) {
+	if err := os.MkdirAll(filepath.Join(dir, sessionsDir), 0o700); err != nil {
+		return nil, fmt.Errorf("failed to create signal store: %w", err)
+	}
+	s := &Store{dir: dir}
+
+	found, err := s.read(identityFile, &s.identity)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		if s.identity, err = NewIdentity(); err != nil {
+			return nil, err
+		}
+		if err := s.write(identityFile, s.identity); err != nil {
+			return nil, err
+		}
+	}
+
+	if _, err := s.read(prekeysFile, &s.prekeys); err != nil {
+		return nil, err
+	}
+	if s.prekeys.OneTimePrekeys == nil {
+		s.prekeys.OneTimePrekeys = make(map[uint32][]byte)
+	}
+	if len(s.prekeys.SignedPrekeys) == 0 {
+		if err := s.rotateSignedPrekey(); err != nil {
+			return nil, err
+		}
+	}
+	return s, nil
+}
+
+// Identity returns the identity of the node.
+func (s *Store) Identity() Identity {
+	return s.identity
+}
+
+// Bundle returns the prekey bundle of the node with its current signed
+// prekey. One-time prekeys are published separately.
+func (s *Store) Bundle() PrekeyBundle {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	current := s.prekeys.SignedPrekeys[len(s.prekeys.SignedPrekeys)-1]
+	return PrekeyBundle{
+		IdentityKey:           s.identity.PublicKey(),
+		SigningKey:            s.identity.SigningPubKey(),
+		SignedPrekeyId:        current.Id,
+		SignedPrekey:          publicKey(current.PrivateKey),
+		SignedPrekeySignature: current.Signature,
+	}
+}
+
+// GenerateOneTimePrekeys generates n one-time prekeys and returns their
+// public keys by id.
+func (s *Store) GenerateOneTimePrekeys(n int) (map[uint32][]byte, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	public := make(map[uint32][]byte, n)
+	for range n {
+		key, err := generateKey()
+		if err != nil {
+			return nil, err
+		}
+		id := s.nextId()
+		s.prekeys.OneTimePrekeys[id] = key
+		public[id] = publicKey(key)
+	}
+	return public, s.write(prekeysFile, s.prekeys)
+}
+
+// RotateSignedPrekey replaces the current signed prekey. The previous one is
+// kept to accept the channels opened against it in the meantime.
+func (s *Store) RotateSignedPrekey() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.rotateSignedPrekey()
+}
+
+func (s *Store) rotateSignedPrekey() error {
+	key, err := generateKey()
+	if err != nil {
+		return err
+	}
+	id := s.nextId()
+	signature, err := s.identity.SigningPrivKey().Sign(SignedPrekeyBytes(s.identity.PublicKey(), id, publicKey(key)))
+	if err != nil {
+		return err
+	}
+	s.prekeys.SignedPrekeys = append(s.prekeys.SignedPrekeys, signedPrekey{Id: id, PrivateKey: key, Signature: signature})
+	if len(s.prekeys.SignedPrekeys) > maxSignedPrekeys {
+		s.prekeys.SignedPrekeys = s.prekeys.SignedPrekeys[len(s.prekeys.SignedPrekeys)-maxSignedPrekeys:]
+	}
+	return s.write(prekeysFile, s.prekeys)
+}
+
+// nextId returns a new prekey id. Ids start at 1 so that 0 means no prekey.
+func (s *Store) nextId() uint32 {
+	s.prekeys.NextId++
+	return s.prekeys.NextId
+}
+
+// Session returns the session of a channel.
+func (s *Store) Session(channelId string) (*Session, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.session(channelId)
+}
+
+// InitiateSession opens channelId with the owner of bundle.
+func (s *Store) InitiateSession(channelId string, bundle PrekeyBundle) (*Session, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, err := s.session(channelId); !errors.Is(err, ErrNoSession) {
+		if err == nil {
+			err = fmt.Errorf("channel %s already exists", channelId)
+		}
+		return nil, err
+	}
+	session, err := InitiateSession(channelId, s.identity, bundle)
+	if err != nil {
+		return nil, err
+	}
+	return session, s.saveSession(session)
+}
+
+// Encrypt encrypts plaintext for the peer of channelId.
+func (s *Store) Encrypt(channelId string, plaintext []byte) (Envelope, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	session, err := s.session(channelId)
+	if err != nil {
+		return Envelope{}, err
+	}
+	envelope, err := session.Encrypt(plaintext)
+	if err != nil {
+		return Envelope{}, err
+	}
+	return envelope, s.saveSession(session)
+}
+
+// Decrypt decrypts an envelope received on channelId. The first message of a
+// channel opened by the peer creates its session, consuming the one-time
+// prekey it uses.
+func (s *Store) Decrypt(channelId string, envelope Envelope) ([]byte, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var oneTimePrekeyId uint32
+	session, err := s.session(channelId)
+	if errors.Is(err, ErrNoSession) && envelope.PreKey != nil {
+		session, err = s.acceptSession(channelId, *envelope.PreKey)
+		oneTimePrekeyId = envelope.PreKey.OneTimePrekeyId
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	plaintext, err := session.Decrypt(envelope)
+	if err != nil {
+		return nil, err
+	}
+	if oneTimePrekeyId != 0 {
+		delete(s.prekeys.OneTimePrekeys, oneTimePrekeyId)
+		if err := s.write(prekeysFile, s.prekeys); err != nil {
+			return nil, err
+		}
+	}
+	return plaintext, s.saveSession(session)
+}
+
+// RotateSession takes a new DH ratchet step on channelId.
+func (s *Store) RotateSession(channelId string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	session, err := s.session(channelId)
+	if err != nil {
+		return err
+	}
+	if err := session.Rotate(); err != nil {
+		return err
+	}
+	return s.saveSession(session)
+}
+
+func (s *Store) acceptSession(channelId string, header PreKeyHeader) (*Session, error) {
+	var signed []byte
+	for _, prekey := range s.prekeys.SignedPrekeys {
+		if prekey.Id == header.SignedPrekeyId {
+			signed = prekey.PrivateKey
+		}
+	}
+	if signed == nil {
+		return nil, ErrUnknownPrekey
+	}
+	var oneTime []byte
+	if header.OneTimePrekeyId != 0 {
+		var ok bool
+		if oneTime, ok = s.prekeys.OneTimePrekeys[header.OneTimePrekeyId]; !ok {
+			return nil, ErrUnknownPrekey
+		}
+	}
+	return RespondSession(channelId, s.identity, header, signed, oneTime)
+}
+
+func (s *Store) session(channelId string) (*Session, error) {
+	if !channelIdPattern.MatchString(channelId) {
+		return nil, fmt.Errorf("invalid channel id %q", channelId)
+	}
+	var session Session
+	found, err := s.read(filepath.Join(sessionsDir, channelId+".json"), &session)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, ErrNoSession
+	}
+	return &session, nil
+}
+
+func (s *Store) saveSession(session *Session) error {
+	return s.write(filepath.Join(sessionsDir, session.ChannelId+".json"), session)
+}
+
+func (s *Store) read(name string, v any) (bool, error) {
+	bz, err := os.ReadFile(filepath.Join(s.dir, name))
+	switch {
+	case errors.Is(err, fs.ErrNotExist):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("failed to read %s: %w", name, err)
+	}
+	if err := json.Unmarshal(bz, v); err != nil {
+		return false, fmt.Errorf("failed to decode %s: %w", name, err)
+	}
+	return true, nil
+}
+
+// write replaces a file atomically. Files are only readable by the node.
+func (s *Store) write(name string, v any) error {
+	bz, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	path := filepath.Join(s.dir, name)
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".tmp-*")
+	if err != nil {
+		return err
+	}
+	if _, err := tmp.Write(bz); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
@@ -0,0 +1,183 @@
+package signal_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"resist/x/posts/signal"
+)
+
+func openStores(t *testing.T) (alice, bob *signal.Store, bobDir string) {
+	t.Helper()
+	alice, err := signal.OpenStore(t.TempDir())
+	require.NoError(t, err)
+	bobDir = t.TempDir()
+	bob, err = signal.OpenStore(bobDir)
+	require.NoError(t, err)
+	return alice, bob, bobDir
+}
+
+func TestSessionRoundTrip(t *testing.T) {
+	alice, bob, bobDir := openStores(t)
+
+	bundle := bob.Bundle()
+	oneTime, err := bob.GenerateOneTimePrekeys(2)
+	require.NoError(t, err)
+	for id, key := range oneTime {
+		bundle.OneTimePrekeyId, bundle.OneTimePrekey = id, key
+		break
+	}
+	require.NoError(t, bundle.Verify())
+
+	_, err = alice.InitiateSession("channel_1", bundle)
+	require.NoError(t, err)
+
+	first, err := alice.Encrypt("channel_1", []byte("hello bob"))
+	require.NoError(t, err)
+	require.NotNil(t, first.PreKey)
+	require.NotContains(t, string(first.Ciphertext), "hello bob")
+	second, err := alice.Encrypt("channel_1", []byte("second"))
+	require.NoError(t, err)
+
+	// Out of order delivery, the first message carries the X3DH header too
+	plaintext, err := bob.Decrypt("channel_1", second)
+	require.NoError(t, err)
+	require.Equal(t, "second", string(plaintext))
+	plaintext, err = bob.Decrypt("channel_1", first)
+	require.NoError(t, err)
+	require.Equal(t, "hello bob", string(plaintext))
+
+	// Message keys are used once
+	_, err = bob.Decrypt("channel_1", first)
+	require.ErrorIs(t, err, signal.ErrDecryption)
+
+	// The session survives a restart of the responder
+	bob, err = signal.OpenStore(bobDir)
+	require.NoError(t, err)
+	session, err := bob.Session("channel_1")
+	require.NoError(t, err)
+	require.Equal(t, alice.Identity().SigningPubKey(), session.RemoteSigningKey)
+
+	reply, err := bob.Encrypt("channel_1", []byte("hello alice"))
+	require.NoError(t, err)
+	require.Nil(t, reply.PreKey)
+	plaintext, err = alice.Decrypt("channel_1", reply)
+	require.NoError(t, err)
+	require.Equal(t, "hello alice", string(plaintext))
+
+	// Once the responder replied, the X3DH header is dropped
+	next, err := alice.Encrypt("channel_1", []byte("bye"))
+	require.NoError(t, err)
+	require.Nil(t, next.PreKey)
+	require.NotEqual(t, first.Header.DH, next.Header.DH)
+	plaintext, err = bob.Decrypt("channel_1", next)
+	require.NoError(t, err)
+	require.Equal(t, "bye", string(plaintext))
+}
+
+func TestSessionRejectsTampering(t *testing.T) {
+	alice, bob, _ := openStores(t)
+	_, err := alice.InitiateSession("channel_1", bob.Bundle())
+	require.NoError(t, err)
+
+	envelope, err := alice.Encrypt("channel_1", []byte("hello bob"))
+	require.NoError(t, err)
+
+	tampered := envelope
+	tampered.Ciphertext = append([]byte{}, envelope.Ciphertext...)
+	tampered.Ciphertext[0] ^= 1
+	_, err = bob.Decrypt("channel_1", tampered)
+	require.ErrorIs(t, err, signal.ErrDecryption)
+
+	tampered = envelope
+	tampered.Header.N = 1
+	_, err = bob.Decrypt("channel_1", tampered)
+	require.ErrorIs(t, err, signal.ErrDecryption)
+
+	// A failed decryption does not open the channel
+	_, err = bob.Session("channel_1")
+	require.ErrorIs(t, err, signal.ErrNoSession)
+
+	plaintext, err := bob.Decrypt("channel_1", envelope)
+	require.NoError(t, err)
+	require.Equal(t, "hello bob", string(plaintext))
+}
+
+func TestOneTimePrekeyConsumed(t *testing.T) {
+	alice, bob, _ := openStores(t)
+
+	bundle := bob.Bundle()
+	oneTime, err := bob.GenerateOneTimePrekeys(1)
+	require.NoError(t, err)
+	for id, key := range oneTime {
+		bundle.OneTimePrekeyId, bundle.OneTimePrekey = id, key
+	}
+
+	_, err = alice.InitiateSession("channel_1", bundle)
+	require.NoError(t, err)
+	_, err = alice.InitiateSession("channel_2", bundle)
+	require.NoError(t, err)
+
+	first, err := alice.Encrypt("channel_1", []byte("first"))
+	require.NoError(t, err)
+	_, err = bob.Decrypt("channel_1", first)
+	require.NoError(t, err)
+
+	second, err := alice.Encrypt("channel_2", []byte("second"))
+	require.NoError(t, err)
+	_, err = bob.Decrypt("channel_2", second)
+	require.ErrorIs(t, err, signal.ErrUnknownPrekey)
+}
+
+func TestRotateSession(t *testing.T) {
+	alice, bob, _ := openStores(t)
+	_, err := alice.InitiateSession("channel_1", bob.Bundle())
+	require.NoError(t, err)
+
+	// The initiator must hear from the responder before a new DH step
+	require.ErrorIs(t, alice.RotateSession("channel_1"), signal.ErrRotationPending)
+
+	envelope, err := alice.Encrypt("channel_1", []byte("hello"))
+	require.NoError(t, err)
+	_, err = bob.Decrypt("channel_1", envelope)
+	require.NoError(t, err)
+
+	require.NoError(t, bob.RotateSession("channel_1"))
+	rotated, err := bob.Session("channel_1")
+	require.NoError(t, err)
+	require.ErrorIs(t, bob.RotateSession("channel_1"), signal.ErrRotationPending)
+
+	reply, err := bob.Encrypt("channel_1", []byte("rotated"))
+	require.NoError(t, err)
+	require.Equal(t, rotated.DHs, mustSession(t, bob, "channel_1").DHs)
+	plaintext, err := alice.Decrypt("channel_1", reply)
+	require.NoError(t, err)
+	require.Equal(t, "rotated", string(plaintext))
+}
+
+func TestSignedPrekeyRotation(t *testing.T) {
+	alice, bob, _ := openStores(t)
+	old := bob.Bundle()
+	require.NoError(t, bob.RotateSignedPrekey())
+	require.NotEqual(t, old.SignedPrekeyId, bob.Bundle().SignedPrekeyId)
+
+	// Channels opened against the previous signed prekey are still accepted
+	_, err := alice.InitiateSession("channel_1", old)
+	require.NoError(t, err)
+	envelope, err := alice.Encrypt("channel_1", []byte("hello"))
+	require.NoError(t, err)
+	_, err = bob.Decrypt("channel_1", envelope)
+	require.NoError(t, err)
+
+	forged := bob.Bundle()
+	forged.SignedPrekey = old.SignedPrekey
+	require.ErrorIs(t, forged.Verify(), signal.ErrInvalidBundle)
+}
+
+func mustSession(t *testing.T, s *signal.Store, channelId string) *signal.Session {
+	t.Helper()
+	session, err := s.Session(channelId)
+	require.NoError(t, err)
+	return session
+}
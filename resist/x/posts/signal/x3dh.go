@@ -0,0 +1,124 @@
+package signal
+
+import (
+	"bytes"
+	"crypto/hkdf"
+	"crypto/sha256"
+	"errors"
+)
+
+// ErrInvalidPreKeyHeader is returned when the X3DH header of a message is malformed.
+var ErrInvalidPreKeyHeader = errors.New("invalid prekey header")
+
+// PreKeyHeader carries the X3DH parameters of the initiator of a channel. It
+// is attached to the messages of the initiator until the responder replies,
+// so that the responder can derive the session from its first message.
+type PreKeyHeader struct {
+	IdentityKey     []byte `json:"identity_key"`
+	SigningKey      []byte `json:"signing_key"`
+	EphemeralKey    []byte `json:"ephemeral_key"`
+	SignedPrekeyId  uint32 `json:"signed_prekey_id"`
+	OneTimePrekeyId uint32 `json:"one_time_prekey_id,omitempty"`
+}
+
+// InitiateSession runs X3DH against the prekey bundle of the responder and
+// returns the session of the initiator.
+func InitiateSession(channelId string, id Identity, bundle PrekeyBundle) (*Session, error) {
+	if err := bundle.Verify(); err != nil {
+		return nil, err
+	}
+	ephemeral, err := generateKey()
+	if err != nil {
+		return nil, err
+	}
+
+	secrets := [][2][]byte{
+		{id.DHKey, bundle.SignedPrekey},
+		{ephemeral, bundle.IdentityKey},
+		{ephemeral, bundle.SignedPrekey},
+	}
+	if bundle.OneTimePrekey != nil {
+		secrets = append(secrets, [2][]byte{ephemeral, bundle.OneTimePrekey})
+	}
+	sk, err := x3dhSecret(secrets)
+	if err != nil {
+		return nil, err
+	}
+
+	session := &Session{
+		ChannelId:         channelId,
+		RemoteIdentityKey: bundle.IdentityKey,
+		RemoteSigningKey:  bundle.SigningKey,
+		AD:                associatedData(id.PublicKey(), id.SigningPubKey(), bundle.IdentityKey, bundle.SigningKey),
+		RootKey:           sk,
+		DHr:               bundle.SignedPrekey,
+		PreKey: &PreKeyHeader{
+			IdentityKey:    id.PublicKey(),
+			SigningKey:     id.SigningPubKey(),
+			EphemeralKey:   publicKey(ephemeral),
+			SignedPrekeyId: bundle.SignedPrekeyId,
+		},
+	}
+	if bundle.OneTimePrekey != nil {
+		session.PreKey.OneTimePrekeyId = bundle.OneTimePrekeyId
+	}
+	if err := session.sendStep(); err != nil {
+		return nil, err
+	}
+	return session, nil
+}
+
+// RespondSession runs X3DH for the header of the first message of a channel
+// and returns the session of the responder. oneTimePrekey is nil if the
+// header does not use one.
+func RespondSession(channelId string, id Identity, header PreKeyHeader, signedPrekey, oneTimePrekey []byte) (*Session, error) {
+	if len(header.IdentityKey) != KeySize || len(header.EphemeralKey) != KeySize || len(header.SigningKey) != len(id.SigningPubKey()) {
+		return nil, ErrInvalidPreKeyHeader
+	}
+
+	secrets := [][2][]byte{
+		{signedPrekey, header.IdentityKey},
+		{id.DHKey, header.EphemeralKey},
+		{signedPrekey, header.EphemeralKey},
+	}
+	if oneTimePrekey != nil {
+		secrets = append(secrets, [2][]byte{oneTimePrekey, header.EphemeralKey})
+	}
+	sk, err := x3dhSecret(secrets)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Session{
+		ChannelId:         channelId,
+		RemoteIdentityKey: header.IdentityKey,
+		RemoteSigningKey:  header.SigningKey,
+		AD:                associatedData(header.IdentityKey, header.SigningKey, id.PublicKey(), id.SigningPubKey()),
+		RootKey:           sk,
+		DHs:               signedPrekey,
+	}, nil
+}
+
+// x3dhSecret derives the shared secret from the DH outputs of the key pairs.
+func x3dhSecret(pairs [][2][]byte) ([]byte, error) {
+	// The 0xFF prefix separates the secret from the curve points, as in X3DH
+	ikm := bytes.Repeat([]byte{0xff}, KeySize)
+	for _, pair := range pairs {
+		out, err := dh(pair[0], pair[1])
+		if err != nil {
+			return nil, err
+		}
+		ikm = append(ikm, out...)
+	}
+	return hkdf.Key(sha256.New, ikm, make([]byte, sha256.Size), "resist-x3dh", KeySize)
+}
+
+// associatedData binds the session to the identities of both parties, the
+// initiator first.
+func associatedData(initiatorKey, initiatorSigningKey, responderKey, responderSigningKey []byte) []byte {
+	ad := make([]byte, 0, len(initiatorKey)+len(initiatorSigningKey)+len(responderKey)+len(responderSigningKey))
+	ad = append(ad, initiatorKey...)
+	ad = append(ad, initiatorSigningKey...)
+	ad = append(ad, responderKey...)
+	return append(ad, responderSigningKey...)
+}
@@ -2,7 +2,6 @@ package simulation
 
 import (
 	"math/rand"
-	"strconv"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/client"
@@ -24,15 +23,8 @@ func SimulateMsgCreateSocialPost(
 	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
 		simAccount, _ := simtypes.RandomAcc(r, accs)
 
-		i := r.Int()
 		msg := &types.MsgCreateSocialPost{
 			Creator: simAccount.Address.String(),
-			Index:   strconv.Itoa(i),
-		}
-
-		found, err := k.SocialPost.Has(ctx, msg.Index)
-		if err == nil && found {
-			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "SocialPost already exist"), nil, nil
 		}
 
 		txCtx := simulation.OperationInput{
@@ -0,0 +1,310 @@
+package types
+
+import (
+	"crypto/sha256"
+	"encoding/base32"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Content addressing follows the IPFS conventions used by `ipfs add --cid-version=1`:
+// content is split into fixed size raw leaves which are linked together by
+// UnixFS dag-pb nodes using the balanced layout. Content that fits into a single
+// chunk is addressed directly by its raw leaf CID.
+const (
+	// CIDVersion is the only CID version produced by this module.
+	CIDVersion = 1
+
+	// RawCodec is the multicodec code of raw binary leaves.
+	RawCodec = 0x55
+	// DagPbCodec is the multicodec code of dag-pb nodes.
+	DagPbCodec = 0x70
+	// Sha256Multihash is the multihash code of sha2-256.
+	Sha256Multihash = 0x12
+
+	// DefaultChunkSize is the size of a content chunk (raw leaf) in bytes.
+	DefaultChunkSize = 256 * 1024
+	// MaxLinksPerNode is the maximum number of children of a dag-pb node.
+	MaxLinksPerNode = 174
+
+	unixfsTypeFile = 2
+)
+
+var cidEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// Block is a content addressed block of a content DAG.
+type Block struct {
+	Cid  string
+	Data []byte
+}
+
+// DAGLink references a child block from a dag-pb node.
+type DAGLink struct {
+	Cid       string
+	TotalSize uint64
+	FileSize  uint64
+}
+
+// ContentDAG is the result of chunking content into a Merkle DAG.
+type ContentDAG struct {
+	// Root is the CID of the DAG root.
+	Root string
+	// Blocks holds every block of the DAG, leaves first, root last.
+	Blocks []Block
+	// Leaves holds the CIDs of the raw leaves in content order.
+	Leaves []string
+}
+
+// ComputeContentCID returns the deterministic CIDv1 of the given content.
+func ComputeContentCID(data []byte) (string, error) {
+	dag, err := BuildContentDAG(data, DefaultChunkSize)
+	if err != nil {
+		return "", err
+	}
+	return dag.Root, nil
+}
+
+// BuildContentDAG splits data into chunks of chunkSize bytes and builds a
+// balanced UnixFS DAG over them.
+func BuildContentDAG(data []byte, chunkSize int) (ContentDAG, error) {
+	if len(data) == 0 {
+		return ContentDAG{}, errors.New("content cannot be empty")
+	}
+	if chunkSize <= 0 {
+		return ContentDAG{}, fmt.Errorf("invalid chunk size %d", chunkSize)
+	}
+
+	var dag ContentDAG
+	level := make([]DAGLink, 0, (len(data)+chunkSize-1)/chunkSize)
+	for offset := 0; offset < len(data); offset += chunkSize {
+		end := min(offset+chunkSize, len(data))
+		chunk := data[offset:end]
+		cid := NewCID(RawCodec, chunk)
+		dag.Blocks = append(dag.Blocks, Block{Cid: cid, Data: chunk})
+		dag.Leaves = append(dag.Leaves, cid)
+		level = append(level, DAGLink{Cid: cid, TotalSize: uint64(len(chunk)), FileSize: uint64(len(chunk))})
+	}
+
+	for len(level) > 1 {
+		next := make([]DAGLink, 0, (len(level)+MaxLinksPerNode-1)/MaxLinksPerNode)
+		for i := 0; i < len(level); i += MaxLinksPerNode {
+			children := level[i:min(i+MaxLinksPerNode, len(level))]
+			node, err := EncodeFileNode(children)
+			if err != nil {
+				return ContentDAG{}, err
+			}
+			link := DAGLink{Cid: NewCID(DagPbCodec, node), TotalSize: uint64(len(node))}
+			for _, child := range children {
+				link.TotalSize += child.TotalSize
+				link.FileSize += child.FileSize
+			}
+			dag.Blocks = append(dag.Blocks, Block{Cid: link.Cid, Data: node})
+			next = append(next, link)
+		}
+		level = next
+	}
+
+	dag.Root = level[0].Cid
+	return dag, nil
+}
+
+// NewCID returns the base32 encoded CIDv1 of data for the given codec.
+func NewCID(codec uint64, data []byte) string {
+	digest := sha256.Sum256(data)
+	buf := make([]byte, 0, 4+len(digest))
+	buf = binary.AppendUvarint(buf, CIDVersion)
+	buf = binary.AppendUvarint(buf, codec)
+	buf = binary.AppendUvarint(buf, Sha256Multihash)
+	buf = binary.AppendUvarint(buf, uint64(len(digest)))
+	buf = append(buf, digest[:]...)
+	return "b" + strings.ToLower(cidEncoding.EncodeToString(buf))
+}
+
+// DecodeCID parses a base32 CIDv1 and returns its codec and sha2-256 digest.
+func DecodeCID(cid string) (codec uint64, digest []byte, err error) {
+	if len(cid) < 2 || cid[0] != 'b' {
+		return 0, nil, fmt.Errorf("unsupported cid encoding: %q", cid)
+	}
+	buf, err := cidEncoding.DecodeString(strings.ToUpper(cid[1:]))
+	if err != nil {
+		return 0, nil, fmt.Errorf("invalid cid %q: %w", cid, err)
+	}
+
+	var fields [4]uint64
+	for i := range fields {
+		v, n := binary.Uvarint(buf)
+		if n <= 0 {
+			return 0, nil, fmt.Errorf("invalid cid %q: truncated header", cid)
+		}
+		fields[i] = v
+		buf = buf[n:]
+	}
+	if fields[0] != CIDVersion {
+		return 0, nil, fmt.Errorf("unsupported cid version %d", fields[0])
+	}
+	if fields[1] != RawCodec && fields[1] != DagPbCodec {
+		return 0, nil, fmt.Errorf("unsupported cid codec 0x%x", fields[1])
+	}
+	if fields[2] != Sha256Multihash || fields[3] != sha256.Size || uint64(len(buf)) != fields[3] {
+		return 0, nil, fmt.Errorf("unsupported multihash in cid %q", cid)
+	}
+	return fields[1], buf, nil
+}
+
+// VerifyBlock checks that data hashes to the given CID.
+func VerifyBlock(cid string, data []byte) error {
+	codec, _, err := DecodeCID(cid)
+	if err != nil {
+		return err
+	}
+	if NewCID(codec, data) != cid {
+		return fmt.Errorf("block does not match cid %s", cid)
+	}
+	return nil
+}
+
+// EncodeFileNode encodes a UnixFS file node (dag-pb) linking to the given children.
+func EncodeFileNode(children []DAGLink) ([]byte, error) {
+	var fileSize uint64
+	unixfs := appendVarintField(nil, 1, unixfsTypeFile)
+	for _, child := range children {
+		fileSize += child.FileSize
+	}
+	unixfs = appendVarintField(unixfs, 3, fileSize)
+	for _, child := range children {
+		unixfs = appendVarintField(unixfs, 4, child.FileSize)
+	}
+
+	// dag-pb serializes links before data.
+	var node []byte
+	for _, child := range children {
+		hash, err := CIDBytes(child.Cid)
+		if err != nil {
+			return nil, err
+		}
+		var link []byte
+		link = appendBytesField(link, 1, hash)
+		link = appendBytesField(link, 2, nil)
+		link = appendVarintField(link, 3, child.TotalSize)
+		node = appendBytesField(node, 2, link)
+	}
+	node = appendBytesField(node, 1, unixfs)
+	return node, nil
+}
+
+// DecodeFileNode returns the child links of a UnixFS file node.
+func DecodeFileNode(node []byte) ([]DAGLink, error) {
+	var (
+		links     []DAGLink
+		unixfs    []byte
+		fileSizes []uint64
+	)
+	err := walkFields(node, func(field int, value []byte, _ uint64) error {
+		switch field {
+		case 1:
+			unixfs = value
+		case 2:
+			var link DAGLink
+			if err := walkFields(value, func(field int, value []byte, num uint64) error {
+				switch field {
+				case 1:
+					cid, err := cidFromBytes(value)
+					if err != nil {
+						return err
+					}
+					link.Cid = cid
+				case 3:
+					link.TotalSize = num
+				}
+				return nil
+			}); err != nil {
+				return err
+			}
+			links = append(links, link)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := walkFields(unixfs, func(field int, _ []byte, num uint64) error {
+		if field == 4 {
+			fileSizes = append(fileSizes, num)
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	if len(fileSizes) != len(links) {
+		return nil, errors.New("malformed unixfs node: blocksizes do not match links")
+	}
+	for i := range links {
+		links[i].FileSize = fileSizes[i]
+	}
+	return links, nil
+}
+
+// CIDBytes returns the binary form of a base32 encoded CID.
+func CIDBytes(cid string) ([]byte, error) {
+	if _, _, err := DecodeCID(cid); err != nil {
+		return nil, err
+	}
+	return cidEncoding.DecodeString(strings.ToUpper(cid[1:]))
+}
+
+func cidFromBytes(buf []byte) (string, error) {
+	cid := "b" + strings.ToLower(cidEncoding.EncodeToString(buf))
+	if _, _, err := DecodeCID(cid); err != nil {
+		return "", err
+	}
+	return cid, nil
+}
+
+func appendVarintField(buf []byte, field int, v uint64) []byte {
+	buf = binary.AppendUvarint(buf, uint64(field)<<3)
+	return binary.AppendUvarint(buf, v)
+}
+
+func appendBytesField(buf []byte, field int, v []byte) []byte {
+	buf = binary.AppendUvarint(buf, uint64(field)<<3|2)
+	buf = binary.AppendUvarint(buf, uint64(len(v)))
+	return append(buf, v...)
+}
+
+// walkFields iterates over the varint and length-delimited fields of a protobuf message.
+func walkFields(buf []byte, fn func(field int, value []byte, num uint64) error) error {
+	for len(buf) > 0 {
+		tag, n := binary.Uvarint(buf)
+		if n <= 0 {
+			return errors.New("malformed protobuf tag")
+		}
+		buf = buf[n:]
+		field := int(tag >> 3)
+		switch tag & 7 {
+		case 0:
+			v, n := binary.Uvarint(buf)
+			if n <= 0 {
+				return errors.New("malformed protobuf varint")
+			}
+			buf = buf[n:]
+			if err := fn(field, nil, v); err != nil {
+				return err
+			}
+		case 2:
+			l, n := binary.Uvarint(buf)
+			if n <= 0 || uint64(len(buf)-n) < l {
+				return errors.New("malformed protobuf length")
+			}
+			value := buf[n : n+int(l)]
+			buf = buf[n+int(l):]
+			if err := fn(field, value, 0); err != nil {
+				return err
+			}
+		default:
+			return fmt.Errorf("unsupported protobuf wire type %d", tag&7)
+		}
+	}
+	return nil
+}
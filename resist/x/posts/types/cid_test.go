@@ -0,0 +1,70 @@
+package types_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"resist/x/posts/types"
+)
+
+func TestComputeContentCID(t *testing.T) {
+	// CIDv1 (raw, sha2-256) of "hello world" as produced by `ipfs add --cid-version=1`.
+	cid, err := types.ComputeContentCID([]byte("hello world"))
+	require.NoError(t, err)
+	require.Equal(t, "bafkreifzjut3te2nhyekklss27nh3k72ysco7y32koao5eei66wof36n5e", cid)
+
+	again, err := types.ComputeContentCID([]byte("hello world"))
+	require.NoError(t, err)
+	require.Equal(t, cid, again)
+
+	_, err = types.ComputeContentCID(nil)
+	require.Error(t, err)
+}
+
+func TestBuildContentDAG(t *testing.T) {
+	chunkSize := 4
+	data := bytes.Repeat([]byte("0123456789"), 100)
+
+	dag, err := types.BuildContentDAG(data, chunkSize)
+	require.NoError(t, err)
+	require.Len(t, dag.Leaves, len(data)/chunkSize)
+
+	codec, _, err := types.DecodeCID(dag.Root)
+	require.NoError(t, err)
+	require.Equal(t, uint64(types.DagPbCodec), codec)
+
+	blocks := make(map[string][]byte)
+	for _, block := range dag.Blocks {
+		require.NoError(t, types.VerifyBlock(block.Cid, block.Data))
+		blocks[block.Cid] = block.Data
+	}
+
+	// Walking the DAG from the root must yield the original content.
+	var walk func(cid string) []byte
+	walk = func(cid string) []byte {
+		codec, _, err := types.DecodeCID(cid)
+		require.NoError(t, err)
+		if codec == types.RawCodec {
+			return blocks[cid]
+		}
+		links, err := types.DecodeFileNode(blocks[cid])
+		require.NoError(t, err)
+		var out []byte
+		for _, link := range links {
+			out = append(out, walk(link.Cid)...)
+		}
+		return out
+	}
+	require.Equal(t, data, walk(dag.Root))
+
+	require.Error(t, types.VerifyBlock(dag.Root, []byte("tampered")))
+}
+
+func TestDecodeCID(t *testing.T) {
+	for _, cid := range []string{"", "b", "Qmfoo", "bafkreifzjut3te2nhyekklss27nh3k72ysco7y32koao5eei66wof36n5"} {
+		_, _, err := types.DecodeCID(cid)
+		require.Error(t, err, cid)
+	}
+}
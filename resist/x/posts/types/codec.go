@@ -17,6 +17,8 @@ func RegisterInterfaces(registrar codectypes.InterfaceRegistry) {
 		&MsgCreateSource{},
 		&MsgUpdateSource{},
 		&MsgDeleteSource{},
+		&MsgVoteSource{},
+		&MsgVerifySource{},
 	)
 
 	registrar.RegisterImplementations((*sdk.Msg)(nil),
@@ -39,6 +41,21 @@ func RegisterInterfaces(registrar codectypes.InterfaceRegistry) {
 		&MsgCreatePost{},
 	)
 
+	registrar.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgReplyToPost{},
+	)
+
+	registrar.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgAckReplica{},
+		&MsgAnswerChallenge{},
+		&MsgReportSyncProgress{},
+		&MsgCompleteSync{},
+		&MsgPublishPrekeyBundle{},
+		&MsgReplenishOneTimePrekeys{},
+		&MsgClaimPrekeyBundle{},
+		&MsgAckMessages{},
+	)
+
 	registrar.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgUpdateParams{},
 	)
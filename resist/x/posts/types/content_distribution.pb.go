@@ -31,6 +31,8 @@ type ContentDistribution struct {
 	Replication   *ContentReplication `protobuf:"bytes,5,opt,name=replication,proto3" json:"replication,omitempty"`
 	CreatedAt     int64               `protobuf:"varint,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	LastSync      int64               `protobuf:"varint,7,opt,name=last_sync,json=lastSync,proto3" json:"last_sync,omitempty"`
+	Creator       string              `protobuf:"bytes,8,opt,name=creator,proto3" json:"creator,omitempty"`
+	Metadata      *ContentMetadata    `protobuf:"bytes,9,opt,name=metadata,proto3" json:"metadata,omitempty"`
 }
 
 func (m *ContentDistribution) Reset()         { *m = ContentDistribution{} }
@@ -115,6 +117,20 @@ func (m *ContentDistribution) GetLastSync() int64 {
 	return 0
 }
 
+func (m *ContentDistribution) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *ContentDistribution) GetMetadata() *ContentMetadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
 // ContentReplication defines replication strategy and status
 type ContentReplication struct {
 	TargetReplicas      uint32   `protobuf:"varint,1,opt,name=target_replicas,json=targetReplicas,proto3" json:"target_replicas,omitempty"`
@@ -192,6 +208,99 @@ func (m *ContentReplication) GetTotalSizeBytes() uint64 {
 	return 0
 }
 
+// ReplicaAssignment tracks a node assigned to store a replica of some content
+type ReplicaAssignment struct {
+	ContentId      string `protobuf:"bytes,1,opt,name=content_id,json=contentId,proto3" json:"content_id,omitempty"`
+	NodeId         string `protobuf:"bytes,2,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	Status         string `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	AssignedAt     int64  `protobuf:"varint,4,opt,name=assigned_at,json=assignedAt,proto3" json:"assigned_at,omitempty"`
+	AckDeadline    int64  `protobuf:"varint,5,opt,name=ack_deadline,json=ackDeadline,proto3" json:"ack_deadline,omitempty"`
+	AcknowledgedAt int64  `protobuf:"varint,6,opt,name=acknowledged_at,json=acknowledgedAt,proto3" json:"acknowledged_at,omitempty"`
+	Cid            string `protobuf:"bytes,7,opt,name=cid,proto3" json:"cid,omitempty"`
+}
+
+func (m *ReplicaAssignment) Reset()         { *m = ReplicaAssignment{} }
+func (m *ReplicaAssignment) String() string { return proto.CompactTextString(m) }
+func (*ReplicaAssignment) ProtoMessage()    {}
+func (*ReplicaAssignment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f4989ec9bddf2071, []int{2}
+}
+func (m *ReplicaAssignment) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReplicaAssignment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReplicaAssignment.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReplicaAssignment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplicaAssignment.Merge(m, src)
+}
+func (m *ReplicaAssignment) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReplicaAssignment) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplicaAssignment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplicaAssignment proto.InternalMessageInfo
+
+func (m *ReplicaAssignment) GetContentId() string {
+	if m != nil {
+		return m.ContentId
+	}
+	return ""
+}
+
+func (m *ReplicaAssignment) GetNodeId() string {
+	if m != nil {
+		return m.NodeId
+	}
+	return ""
+}
+
+func (m *ReplicaAssignment) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *ReplicaAssignment) GetAssignedAt() int64 {
+	if m != nil {
+		return m.AssignedAt
+	}
+	return 0
+}
+
+func (m *ReplicaAssignment) GetAckDeadline() int64 {
+	if m != nil {
+		return m.AckDeadline
+	}
+	return 0
+}
+
+func (m *ReplicaAssignment) GetAcknowledgedAt() int64 {
+	if m != nil {
+		return m.AcknowledgedAt
+	}
+	return 0
+}
+
+func (m *ReplicaAssignment) GetCid() string {
+	if m != nil {
+		return m.Cid
+	}
+	return ""
+}
+
 // HubSync manages synchronization between hubs
 type HubSync struct {
 	SyncId           string   `protobuf:"bytes,1,opt,name=sync_id,json=syncId,proto3" json:"sync_id,omitempty"`
@@ -203,13 +312,17 @@ type HubSync struct {
 	CompletedAt      int64    `protobuf:"varint,7,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
 	BytesTransferred uint64   `protobuf:"varint,8,opt,name=bytes_transferred,json=bytesTransferred,proto3" json:"bytes_transferred,omitempty"`
 	SyncMethod       string   `protobuf:"bytes,9,opt,name=sync_method,json=syncMethod,proto3" json:"sync_method,omitempty"`
+	Creator          string   `protobuf:"bytes,10,opt,name=creator,proto3" json:"creator,omitempty"`
+	TotalBytes       uint64   `protobuf:"varint,11,opt,name=total_bytes,json=totalBytes,proto3" json:"total_bytes,omitempty"`
+	UpdatedAt        int64    `protobuf:"varint,12,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	FailureReason    string   `protobuf:"bytes,13,opt,name=failure_reason,json=failureReason,proto3" json:"failure_reason,omitempty"`
 }
 
 func (m *HubSync) Reset()         { *m = HubSync{} }
 func (m *HubSync) String() string { return proto.CompactTextString(m) }
 func (*HubSync) ProtoMessage()    {}
 func (*HubSync) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f4989ec9bddf2071, []int{2}
+	return fileDescriptor_f4989ec9bddf2071, []int{3}
 }
 func (m *HubSync) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -301,6 +414,34 @@ func (m *HubSync) GetSyncMethod() string {
 	return ""
 }
 
+func (m *HubSync) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *HubSync) GetTotalBytes() uint64 {
+	if m != nil {
+		return m.TotalBytes
+	}
+	return 0
+}
+
+func (m *HubSync) GetUpdatedAt() int64 {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return 0
+}
+
+func (m *HubSync) GetFailureReason() string {
+	if m != nil {
+		return m.FailureReason
+	}
+	return ""
+}
+
 // SignalMessage for secure node-to-node communication
 type SignalMessage struct {
 	MessageId        string `protobuf:"bytes,1,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
@@ -311,13 +452,14 @@ type SignalMessage struct {
 	MessageType      string `protobuf:"bytes,6,opt,name=message_type,json=messageType,proto3" json:"message_type,omitempty"`
 	Timestamp        int64  `protobuf:"varint,7,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
 	Signature        string `protobuf:"bytes,8,opt,name=signature,proto3" json:"signature,omitempty"`
+	ExpiryHeight     int64  `protobuf:"varint,9,opt,name=expiry_height,json=expiryHeight,proto3" json:"expiry_height,omitempty"`
 }
 
 func (m *SignalMessage) Reset()         { *m = SignalMessage{} }
 func (m *SignalMessage) String() string { return proto.CompactTextString(m) }
 func (*SignalMessage) ProtoMessage()    {}
 func (*SignalMessage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f4989ec9bddf2071, []int{3}
+	return fileDescriptor_f4989ec9bddf2071, []int{4}
 }
 func (m *SignalMessage) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -402,6 +544,13 @@ func (m *SignalMessage) GetSignature() string {
 	return ""
 }
 
+func (m *SignalMessage) GetExpiryHeight() int64 {
+	if m != nil {
+		return m.ExpiryHeight
+	}
+	return 0
+}
+
 // ContentMetadata for efficient discovery and routing
 type ContentMetadata struct {
 	ContentId      string   `protobuf:"bytes,1,opt,name=content_id,json=contentId,proto3" json:"content_id,omitempty"`
@@ -423,7 +572,7 @@ func (m *ContentMetadata) Reset()         { *m = ContentMetadata{} }
 func (m *ContentMetadata) String() string { return proto.CompactTextString(m) }
 func (*ContentMetadata) ProtoMessage()    {}
 func (*ContentMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f4989ec9bddf2071, []int{4}
+	return fileDescriptor_f4989ec9bddf2071, []int{5}
 }
 func (m *ContentMetadata) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -546,6 +695,7 @@ func (m *ContentMetadata) GetRelatedContent() []string {
 func init() {
 	proto.RegisterType((*ContentDistribution)(nil), "resist.posts.v1.ContentDistribution")
 	proto.RegisterType((*ContentReplication)(nil), "resist.posts.v1.ContentReplication")
+	proto.RegisterType((*ReplicaAssignment)(nil), "resist.posts.v1.ReplicaAssignment")
 	proto.RegisterType((*HubSync)(nil), "resist.posts.v1.HubSync")
 	proto.RegisterType((*SignalMessage)(nil), "resist.posts.v1.SignalMessage")
 	proto.RegisterType((*ContentMetadata)(nil), "resist.posts.v1.ContentMetadata")
@@ -556,59 +706,71 @@ func init() {
 }
 
 var fileDescriptor_f4989ec9bddf2071 = []byte{
-	// 832 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x95, 0xcd, 0x6e, 0x23, 0x45,
-	0x10, 0xc7, 0xe3, 0x8f, 0xb5, 0x3d, 0xed, 0xd8, 0x09, 0xbd, 0x11, 0x8c, 0xf8, 0x30, 0xc6, 0xab,
-	0x15, 0x06, 0x24, 0x47, 0x81, 0x27, 0xc8, 0x06, 0xa4, 0xcd, 0x21, 0x2b, 0x34, 0xd9, 0x13, 0x97,
-	0x51, 0x67, 0xa6, 0x6d, 0xb7, 0x34, 0xee, 0x19, 0x75, 0x95, 0x13, 0x66, 0x9f, 0x02, 0x5e, 0x00,
-	0x89, 0x07, 0xe0, 0x3d, 0x38, 0xee, 0x91, 0x23, 0x4a, 0x6e, 0x3c, 0x05, 0xaa, 0xea, 0xf6, 0x47,
-	0xc2, 0x81, 0xdb, 0xf4, 0xaf, 0xfe, 0xd3, 0x53, 0xf5, 0xef, 0xaa, 0x1e, 0xf1, 0xb5, 0xd3, 0x60,
-	0x00, 0x4f, 0xab, 0x12, 0x10, 0x4e, 0x6f, 0xcf, 0x4e, 0xb3, 0xd2, 0xa2, 0xb6, 0x98, 0xe6, 0x06,
-	0xd0, 0x99, 0x9b, 0x35, 0x9a, 0xd2, 0xce, 0x2a, 0x57, 0x62, 0x29, 0x8f, 0xbc, 0x76, 0xc6, 0xda,
-	0xd9, 0xed, 0xd9, 0xe4, 0xb7, 0xa6, 0x78, 0x7e, 0xe1, 0xf5, 0xdf, 0xef, 0xc9, 0xe5, 0x67, 0x42,
-	0x6c, 0xb6, 0x31, 0x79, 0xdc, 0x18, 0x37, 0xa6, 0x51, 0x12, 0x05, 0x72, 0x99, 0xcb, 0x4f, 0x44,
-	0x64, 0xaa, 0x39, 0xa4, 0x4b, 0x05, 0xcb, 0xb8, 0xc9, 0xd1, 0x1e, 0x81, 0xd7, 0x0a, 0x96, 0xf2,
-	0x0b, 0x71, 0xb8, 0x32, 0xce, 0x95, 0x2e, 0xb5, 0x65, 0xae, 0x21, 0x6e, 0x8d, 0x5b, 0xd3, 0x28,
-	0xe9, 0x7b, 0xf6, 0x86, 0x90, 0x7c, 0x29, 0x86, 0x60, 0x16, 0x56, 0x15, 0x69, 0xb6, 0x54, 0xd6,
-	0xea, 0x22, 0x6e, 0xf3, 0x26, 0x03, 0x4f, 0x2f, 0x3c, 0x94, 0x3f, 0x88, 0xbe, 0xd3, 0x55, 0x61,
-	0x32, 0x45, 0x49, 0xc5, 0xcf, 0xc6, 0x8d, 0x69, 0xff, 0xdb, 0x17, 0xb3, 0x27, 0x45, 0xcc, 0x42,
-	0x01, 0xc9, 0x4e, 0x9a, 0xec, 0xbf, 0xc7, 0xc5, 0x38, 0xad, 0x50, 0xe7, 0xa9, 0xc2, 0xb8, 0x33,
-	0x6e, 0x4c, 0x5b, 0x49, 0x14, 0xc8, 0x39, 0x52, 0x31, 0x85, 0x02, 0x4c, 0xa1, 0xb6, 0x59, 0xdc,
-	0xe5, 0x68, 0x8f, 0xc0, 0x75, 0x6d, 0xb3, 0xc9, 0x3f, 0x0d, 0x21, 0xff, 0xbb, 0xbf, 0xfc, 0x52,
-	0x1c, 0xa1, 0x72, 0x0b, 0x8d, 0x69, 0xf8, 0x10, 0xb0, 0x49, 0x83, 0x64, 0xe8, 0x71, 0xd0, 0x82,
-	0xfc, 0x4a, 0x1c, 0x67, 0x6b, 0xe7, 0xc8, 0xc8, 0xad, 0xb2, 0xc9, 0xca, 0xa3, 0xc0, 0xb7, 0xd2,
-	0x17, 0x62, 0x10, 0x24, 0x8f, 0x8c, 0x3b, 0x0c, 0xd0, 0x3b, 0x77, 0x26, 0x4e, 0xf6, 0x4a, 0x4b,
-	0x01, 0x9d, 0x42, 0xbd, 0xa8, 0x83, 0x7f, 0xcf, 0xf7, 0x62, 0xd7, 0x21, 0x24, 0xa7, 0xe2, 0x18,
-	0x4b, 0x54, 0x45, 0x0a, 0xe6, 0x9d, 0x4e, 0x6f, 0x6a, 0xd4, 0xc0, 0x56, 0xb6, 0x93, 0x21, 0xf3,
-	0x6b, 0xf3, 0x4e, 0xbf, 0x22, 0x3a, 0xf9, 0xa3, 0x29, 0xba, 0xaf, 0xd7, 0x37, 0x54, 0xb8, 0xfc,
-	0x48, 0x74, 0xc9, 0x90, 0xdd, 0xf1, 0x77, 0x68, 0x79, 0x99, 0xcb, 0xcf, 0x45, 0x1f, 0xca, 0xb5,
-	0xcb, 0x34, 0x67, 0x19, 0x4e, 0x5f, 0x78, 0x44, 0x39, 0x92, 0x20, 0x78, 0xc3, 0x82, 0x96, 0x17,
-	0x78, 0xb4, 0x11, 0xec, 0x9a, 0x0b, 0xe2, 0x36, 0x97, 0x29, 0xb6, 0xdd, 0x05, 0xf2, 0x43, 0xd1,
-	0x01, 0x54, 0xb8, 0xf6, 0x79, 0xd2, 0xa7, 0x79, 0x45, 0x07, 0x09, 0xa8, 0xdc, 0xe3, 0x83, 0x0c,
-	0xe4, 0x1c, 0xa9, 0xf1, 0xb2, 0x72, 0x55, 0x15, 0x3a, 0x08, 0xfc, 0x59, 0xf6, 0xb7, 0xec, 0x1c,
-	0xe5, 0x37, 0xe2, 0x03, 0x36, 0x20, 0x45, 0xa7, 0x2c, 0xcc, 0xb5, 0x73, 0x3a, 0x8f, 0x7b, 0x6c,
-	0xc6, 0x31, 0x07, 0xde, 0xee, 0x38, 0x57, 0x4a, 0x16, 0xac, 0x34, 0x2e, 0xcb, 0x3c, 0x8e, 0x42,
-	0xa5, 0xb5, 0xcd, 0xae, 0x98, 0x4c, 0x7e, 0x6f, 0x8a, 0xc1, 0x35, 0x77, 0xec, 0x95, 0x06, 0x50,
-	0x0b, 0x4d, 0x19, 0xae, 0xfc, 0xe3, 0xde, 0xdc, 0x04, 0x12, 0xbc, 0xd3, 0x36, 0xd7, 0xee, 0xb1,
-	0x77, 0x8c, 0xd8, 0x9a, 0x97, 0x62, 0xe8, 0x74, 0x66, 0x2a, 0x43, 0xe6, 0xec, 0xd9, 0x37, 0xd8,
-	0x52, 0x96, 0x51, 0x47, 0xfb, 0x19, 0xa1, 0xcf, 0xb4, 0xc3, 0x78, 0x7a, 0x72, 0x99, 0x53, 0x95,
-	0xda, 0x66, 0xae, 0xae, 0xc8, 0x88, 0x4a, 0xd5, 0x45, 0xa9, 0x72, 0xb6, 0xf2, 0x30, 0x39, 0xde,
-	0x06, 0x7e, 0xf4, 0x9c, 0xc7, 0x35, 0xa4, 0x8c, 0x75, 0xa5, 0xd9, 0x56, 0x1a, 0x57, 0xcf, 0xde,
-	0xd6, 0x95, 0x96, 0x9f, 0x8a, 0x08, 0xcd, 0x4a, 0x03, 0xaa, 0x55, 0x15, 0x5c, 0xdd, 0x01, 0x8a,
-	0xf2, 0xd8, 0xe2, 0xda, 0x69, 0xf6, 0x32, 0x4a, 0x76, 0x60, 0xf2, 0x6b, 0x4b, 0x1c, 0x85, 0x01,
-	0xba, 0xd2, 0xa8, 0x72, 0x85, 0xea, 0xff, 0x6e, 0x17, 0x3e, 0x47, 0x1f, 0xe6, 0x8c, 0xbc, 0x4d,
-	0x9b, 0x9e, 0xe1, 0x8c, 0x4e, 0xc4, 0x33, 0x34, 0x58, 0x6c, 0xec, 0xf1, 0x0b, 0x39, 0x16, 0xfd,
-	0x5c, 0x43, 0xe6, 0x4c, 0xc5, 0xf7, 0x85, 0xf7, 0x65, 0x1f, 0x49, 0x29, 0xda, 0xa8, 0x16, 0xd4,
-	0x57, 0xd4, 0x73, 0xfc, 0xcc, 0x5d, 0xb5, 0x9b, 0x8c, 0x0e, 0x37, 0x43, 0x04, 0x9b, 0xa1, 0xa0,
-	0xeb, 0x61, 0x65, 0x56, 0xc1, 0x9c, 0xae, 0xbf, 0xeb, 0x08, 0x70, 0x1e, 0x1f, 0x8b, 0x5e, 0xa1,
-	0xec, 0x62, 0xad, 0x16, 0x9b, 0xd2, 0xb7, 0x6b, 0x9a, 0x20, 0x03, 0xa9, 0x85, 0xf9, 0x1d, 0xb7,
-	0x4e, 0x2f, 0xe9, 0x18, 0x78, 0x03, 0xf3, 0xbb, 0x27, 0xf7, 0x91, 0x78, 0x7a, 0x1f, 0xc5, 0xa2,
-	0xcb, 0x8b, 0xd2, 0xc5, 0x7d, 0xde, 0x72, 0xb3, 0xa4, 0x17, 0x6f, 0x8d, 0xbe, 0x4b, 0xb3, 0x72,
-	0x6d, 0x31, 0x3e, 0xf4, 0x99, 0x12, 0xb9, 0x20, 0x40, 0x97, 0x92, 0xd3, 0x05, 0xef, 0x1b, 0xbc,
-	0x8a, 0x07, 0x5c, 0xe7, 0x30, 0xe0, 0x70, 0x0e, 0xaf, 0x66, 0x7f, 0xde, 0x8f, 0x1a, 0xef, 0xef,
-	0x47, 0x8d, 0xbf, 0xef, 0x47, 0x8d, 0x5f, 0x1e, 0x46, 0x07, 0xef, 0x1f, 0x46, 0x07, 0x7f, 0x3d,
-	0x8c, 0x0e, 0x7e, 0x3a, 0x09, 0x3f, 0x93, 0x9f, 0xc3, 0xef, 0x84, 0x8a, 0x86, 0x9b, 0x0e, 0xff,
-	0x3d, 0xbe, 0xfb, 0x37, 0x00, 0x00, 0xff, 0xff, 0xef, 0x47, 0xc0, 0xa8, 0x6b, 0x06, 0x00, 0x00,
+	// 1017 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x96, 0xc1, 0x6e, 0xdb, 0x46,
+	0x10, 0x86, 0x23, 0x4b, 0x96, 0xc5, 0x91, 0x25, 0x3b, 0x1b, 0xa3, 0x21, 0xda, 0x5a, 0x55, 0x15,
+	0x04, 0x75, 0x5b, 0x40, 0x86, 0xdb, 0x6b, 0x2f, 0x8a, 0x53, 0xc0, 0x3e, 0x38, 0x28, 0xe8, 0x9c,
+	0x7a, 0x21, 0xd6, 0xe4, 0x5a, 0x5a, 0x98, 0x5c, 0x12, 0xbb, 0x4b, 0x3b, 0xcc, 0x53, 0xb4, 0x2f,
+	0xd2, 0xc7, 0x28, 0x7a, 0xcc, 0xb1, 0xc7, 0xc2, 0xbe, 0xf5, 0x01, 0x7a, 0x2e, 0x66, 0x76, 0x25,
+	0x51, 0x2e, 0x8a, 0xdc, 0xb4, 0xdf, 0x8e, 0xa8, 0x99, 0xf9, 0xff, 0x19, 0x0a, 0xbe, 0xd1, 0xc2,
+	0x48, 0x63, 0x8f, 0xcb, 0xc2, 0x58, 0x73, 0x7c, 0x7b, 0x72, 0x9c, 0x14, 0xca, 0x0a, 0x65, 0xe3,
+	0x54, 0x1a, 0xab, 0xe5, 0x55, 0x65, 0x65, 0xa1, 0xa6, 0xa5, 0x2e, 0x6c, 0xc1, 0xf6, 0x5c, 0xec,
+	0x94, 0x62, 0xa7, 0xb7, 0x27, 0x93, 0x7f, 0xb6, 0xe0, 0xd9, 0xa9, 0x8b, 0x7f, 0xdd, 0x08, 0x67,
+	0x87, 0x00, 0xcb, 0xc7, 0xc8, 0x34, 0x6c, 0x8d, 0x5b, 0x47, 0x41, 0x14, 0x78, 0x72, 0x9e, 0xb2,
+	0xcf, 0x20, 0x90, 0xe5, 0xb5, 0x89, 0x17, 0xdc, 0x2c, 0xc2, 0x2d, 0xba, 0xed, 0x21, 0x38, 0xe3,
+	0x66, 0xc1, 0xbe, 0x84, 0xdd, 0x5c, 0x6a, 0x5d, 0xe8, 0x58, 0x15, 0xa9, 0x30, 0x61, 0x7b, 0xdc,
+	0x3e, 0x0a, 0xa2, 0xbe, 0x63, 0x6f, 0x10, 0xb1, 0x97, 0x30, 0x34, 0x72, 0xae, 0x78, 0x16, 0x27,
+	0x0b, 0xae, 0x94, 0xc8, 0xc2, 0x0e, 0x3d, 0x64, 0xe0, 0xe8, 0xa9, 0x83, 0xec, 0x47, 0xe8, 0x6b,
+	0x51, 0x66, 0x32, 0xe1, 0x98, 0x54, 0xb8, 0x3d, 0x6e, 0x1d, 0xf5, 0xbf, 0x7b, 0x31, 0x7d, 0x54,
+	0xc4, 0xd4, 0x17, 0x10, 0xad, 0x43, 0xa3, 0xe6, 0xf7, 0xa8, 0x18, 0x2d, 0xb8, 0x15, 0x69, 0xcc,
+	0x6d, 0xd8, 0x1d, 0xb7, 0x8e, 0xda, 0x51, 0xe0, 0xc9, 0xcc, 0x62, 0x31, 0x19, 0x37, 0x36, 0x36,
+	0xb5, 0x4a, 0xc2, 0x1d, 0xba, 0xed, 0x21, 0xb8, 0xac, 0x55, 0xc2, 0x42, 0xd8, 0xa1, 0xc8, 0x42,
+	0x87, 0x3d, 0x4a, 0x71, 0x79, 0x64, 0x3f, 0x40, 0x2f, 0x17, 0x96, 0xa7, 0xdc, 0xf2, 0x30, 0xa0,
+	0xcc, 0xc6, 0xff, 0x97, 0xd9, 0x85, 0x8f, 0x8b, 0x56, 0xdf, 0x98, 0xfc, 0xdd, 0x02, 0xf6, 0xdf,
+	0xbc, 0xd9, 0x57, 0xb0, 0x67, 0xb9, 0x9e, 0x0b, 0x1b, 0xfb, 0x02, 0x0c, 0x35, 0x7f, 0x10, 0x0d,
+	0x1d, 0xf6, 0xb1, 0x86, 0x7d, 0x0d, 0xfb, 0x49, 0xa5, 0x35, 0x0a, 0xb4, 0x8a, 0xdc, 0xa2, 0xc8,
+	0x3d, 0xcf, 0x57, 0xa1, 0x2f, 0x60, 0xe0, 0x43, 0x36, 0x04, 0xd9, 0xf5, 0xd0, 0x29, 0x72, 0x02,
+	0x07, 0x8d, 0x96, 0xc5, 0xc6, 0x6a, 0x6e, 0xc5, 0xbc, 0xf6, 0xba, 0x3c, 0x6b, 0xdc, 0x5d, 0xfa,
+	0x2b, 0x76, 0x04, 0xfb, 0xb6, 0xb0, 0x3c, 0x8b, 0x8d, 0x7c, 0x2f, 0xe2, 0xab, 0xda, 0x0a, 0x43,
+	0x12, 0x75, 0xa2, 0x21, 0xf1, 0x4b, 0xf9, 0x5e, 0xbc, 0x42, 0x3a, 0xb9, 0x6f, 0xc1, 0x53, 0x9f,
+	0xce, 0xcc, 0xa0, 0xc4, 0xb9, 0x50, 0xf6, 0x63, 0x1e, 0x7b, 0x0e, 0x3b, 0x98, 0x2e, 0xde, 0x39,
+	0x87, 0x75, 0xf1, 0x78, 0x9e, 0xb2, 0x4f, 0xa0, 0x6b, 0x2c, 0xb7, 0x15, 0x16, 0x42, 0xdc, 0x9d,
+	0xd8, 0x17, 0xd0, 0xe7, 0xf4, 0x74, 0xa7, 0x73, 0x87, 0x94, 0x84, 0x25, 0x9a, 0x59, 0x34, 0x26,
+	0x4f, 0x6e, 0xe2, 0x54, 0xf0, 0x34, 0x93, 0x4a, 0x50, 0xb2, 0xed, 0xa8, 0xcf, 0x93, 0x9b, 0xd7,
+	0x1e, 0x61, 0xff, 0x79, 0x72, 0xa3, 0x8a, 0xbb, 0x4c, 0xa4, 0xf3, 0xa6, 0x5f, 0x86, 0x4d, 0x3c,
+	0xb3, 0x6c, 0x1f, 0xda, 0x89, 0x4c, 0xc9, 0x2e, 0x41, 0x84, 0x1f, 0x27, 0xbf, 0xb5, 0x61, 0xe7,
+	0xac, 0xba, 0x22, 0xd7, 0x3c, 0x87, 0x1d, 0x74, 0xd3, 0xba, 0xae, 0x2e, 0x1e, 0xcf, 0x53, 0xcc,
+	0xd1, 0x14, 0x95, 0x4e, 0x04, 0x49, 0xe1, 0x0b, 0x03, 0x87, 0x50, 0x08, 0x0c, 0xf0, 0x06, 0xa0,
+	0x00, 0x57, 0x21, 0x38, 0xb4, 0x0c, 0x58, 0x77, 0xcd, 0x84, 0x1d, 0xd2, 0x12, 0x56, 0x6d, 0x33,
+	0x8d, 0xf6, 0x6c, 0x6f, 0xb4, 0xe7, 0x10, 0xc0, 0x58, 0xae, 0x37, 0xa7, 0xc0, 0x13, 0xd7, 0x9c,
+	0xa4, 0xc8, 0xcb, 0x4c, 0xf8, 0x00, 0x37, 0x08, 0xfd, 0x15, 0x9b, 0x59, 0xf6, 0x2d, 0x3c, 0x25,
+	0x95, 0x63, 0xab, 0xb9, 0x32, 0xd7, 0x42, 0x6b, 0x91, 0xd2, 0x54, 0x74, 0xa2, 0x7d, 0xba, 0x78,
+	0xbb, 0xe6, 0x54, 0x29, 0xb6, 0x20, 0x17, 0x76, 0x51, 0xa4, 0x34, 0x21, 0x58, 0x69, 0xad, 0x92,
+	0x0b, 0x22, 0xcd, 0xc9, 0x82, 0xcd, 0xc9, 0xc2, 0x1e, 0x90, 0xb1, 0x9c, 0xa7, 0xfa, 0xf4, 0x0b,
+	0x40, 0x88, 0xfc, 0x84, 0xa5, 0x54, 0x65, 0xba, 0x1c, 0xe8, 0x5d, 0x57, 0x8a, 0x27, 0x33, 0x8b,
+	0xdb, 0xe5, 0x9a, 0xcb, 0xac, 0xd2, 0x22, 0xd6, 0x82, 0x9b, 0x42, 0x85, 0x03, 0xb7, 0x5d, 0x3c,
+	0x8d, 0x08, 0x4e, 0x7e, 0xdf, 0x82, 0xc1, 0x25, 0xed, 0x9b, 0x0b, 0x61, 0x0c, 0x9f, 0x0b, 0x7c,
+	0x6e, 0xee, 0x3e, 0x36, 0x1c, 0xe9, 0x89, 0x17, 0x4f, 0xa8, 0x54, 0xe8, 0x4d, 0xf1, 0x08, 0x91,
+	0x36, 0x2f, 0x61, 0xa8, 0x45, 0x22, 0x4b, 0x89, 0xea, 0x34, 0xf4, 0x1b, 0xac, 0x28, 0x85, 0xa1,
+	0xf1, 0xdd, 0x86, 0xc3, 0x9f, 0xe9, 0x78, 0xe3, 0x3b, 0x72, 0x9e, 0x62, 0x9b, 0x85, 0x4a, 0x74,
+	0x5d, 0x62, 0x7d, 0x25, 0xaf, 0xb3, 0x82, 0xa7, 0xa4, 0xe5, 0x6e, 0xb4, 0xbf, 0xba, 0xf8, 0xc9,
+	0x71, 0x5a, 0xb6, 0x3e, 0x65, 0x5b, 0x97, 0x82, 0x74, 0xc5, 0x65, 0xeb, 0xd8, 0xdb, 0xba, 0x14,
+	0xec, 0x73, 0x08, 0xac, 0xcc, 0x85, 0xb1, 0x3c, 0x2f, 0xbd, 0xac, 0x6b, 0x80, 0xb7, 0xb4, 0x74,
+	0x6d, 0xa5, 0x85, 0x5f, 0x71, 0x6b, 0x80, 0xbb, 0x43, 0xbc, 0x2b, 0xa5, 0xae, 0xe3, 0x85, 0x90,
+	0xf3, 0x85, 0x25, 0x1d, 0xdb, 0xd1, 0xae, 0x83, 0x67, 0xc4, 0x26, 0xbf, 0xb6, 0x61, 0xef, 0xd1,
+	0xa6, 0xfb, 0xd8, 0x70, 0x93, 0xdb, 0xdc, 0x35, 0xa5, 0xed, 0x7a, 0xb9, 0x74, 0x36, 0xa5, 0x7d,
+	0x00, 0xdb, 0x56, 0xda, 0x6c, 0xd9, 0x43, 0x77, 0x60, 0x63, 0xe8, 0xa7, 0xc2, 0x24, 0x5a, 0x96,
+	0xf4, 0x4a, 0x70, 0xcd, 0x6b, 0x22, 0xc6, 0xa0, 0x63, 0xf9, 0x1c, 0xdd, 0x8f, 0x93, 0x41, 0x9f,
+	0xc9, 0xfb, 0xeb, 0x25, 0xd5, 0x25, 0x43, 0x05, 0x66, 0xb9, 0x9f, 0xf0, 0x0d, 0x90, 0xcb, 0xdc,
+	0x77, 0xd0, 0x8d, 0x74, 0x0f, 0x01, 0xe5, 0xf1, 0x29, 0xf4, 0x32, 0xae, 0xe6, 0x15, 0x9f, 0x2f,
+	0xfb, 0xb3, 0x3a, 0xe3, 0x9c, 0x4b, 0x13, 0x2b, 0x73, 0x7d, 0x47, 0x8d, 0xe9, 0x45, 0x5d, 0x69,
+	0xde, 0x98, 0xeb, 0xbb, 0x47, 0xaf, 0x1c, 0x78, 0xfc, 0xca, 0x69, 0x78, 0xbf, 0xbf, 0xe9, 0xfd,
+	0x43, 0x80, 0x5b, 0x29, 0xee, 0xe2, 0xa4, 0xa8, 0x94, 0xb3, 0x76, 0x27, 0x0a, 0x90, 0x9c, 0x22,
+	0xc0, 0xfd, 0xa4, 0x45, 0x46, 0xcf, 0xf5, 0xbd, 0x0a, 0x07, 0x54, 0xe7, 0xd0, 0x63, 0xaf, 0xc3,
+	0xab, 0xe9, 0x1f, 0xf7, 0xa3, 0xd6, 0x87, 0xfb, 0x51, 0xeb, 0xaf, 0xfb, 0x51, 0xeb, 0x97, 0x87,
+	0xd1, 0x93, 0x0f, 0x0f, 0xa3, 0x27, 0x7f, 0x3e, 0x8c, 0x9e, 0xfc, 0x7c, 0xe0, 0xff, 0x2f, 0xbc,
+	0xf3, 0xff, 0x18, 0xb0, 0x68, 0x73, 0xd5, 0xa5, 0x3f, 0x08, 0xdf, 0xff, 0x1b, 0x00, 0x00, 0xff,
+	0xff, 0xf1, 0x46, 0xca, 0x84, 0x4e, 0x08, 0x00, 0x00,
 }
 
 func (m *ContentDistribution) Marshal() (dAtA []byte, err error) {
@@ -631,6 +793,25 @@ func (m *ContentDistribution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Metadata != nil {
+		{
+			size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintContentDistribution(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintContentDistribution(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x42
+	}
 	if m.LastSync != 0 {
 		i = encodeVarintContentDistribution(dAtA, i, uint64(m.LastSync))
 		i--
@@ -740,6 +921,72 @@ func (m *ContentReplication) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ReplicaAssignment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReplicaAssignment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReplicaAssignment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Cid) > 0 {
+		i -= len(m.Cid)
+		copy(dAtA[i:], m.Cid)
+		i = encodeVarintContentDistribution(dAtA, i, uint64(len(m.Cid)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.AcknowledgedAt != 0 {
+		i = encodeVarintContentDistribution(dAtA, i, uint64(m.AcknowledgedAt))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.AckDeadline != 0 {
+		i = encodeVarintContentDistribution(dAtA, i, uint64(m.AckDeadline))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.AssignedAt != 0 {
+		i = encodeVarintContentDistribution(dAtA, i, uint64(m.AssignedAt))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintContentDistribution(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.NodeId) > 0 {
+		i -= len(m.NodeId)
+		copy(dAtA[i:], m.NodeId)
+		i = encodeVarintContentDistribution(dAtA, i, uint64(len(m.NodeId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ContentId) > 0 {
+		i -= len(m.ContentId)
+		copy(dAtA[i:], m.ContentId)
+		i = encodeVarintContentDistribution(dAtA, i, uint64(len(m.ContentId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *HubSync) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -760,6 +1007,30 @@ func (m *HubSync) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.FailureReason) > 0 {
+		i -= len(m.FailureReason)
+		copy(dAtA[i:], m.FailureReason)
+		i = encodeVarintContentDistribution(dAtA, i, uint64(len(m.FailureReason)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.UpdatedAt != 0 {
+		i = encodeVarintContentDistribution(dAtA, i, uint64(m.UpdatedAt))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.TotalBytes != 0 {
+		i = encodeVarintContentDistribution(dAtA, i, uint64(m.TotalBytes))
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintContentDistribution(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x52
+	}
 	if len(m.SyncMethod) > 0 {
 		i -= len(m.SyncMethod)
 		copy(dAtA[i:], m.SyncMethod)
@@ -842,6 +1113,11 @@ func (m *SignalMessage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ExpiryHeight != 0 {
+		i = encodeVarintContentDistribution(dAtA, i, uint64(m.ExpiryHeight))
+		i--
+		dAtA[i] = 0x48
+	}
 	if len(m.Signature) > 0 {
 		i -= len(m.Signature)
 		copy(dAtA[i:], m.Signature)
@@ -1059,6 +1335,14 @@ func (m *ContentDistribution) Size() (n int) {
 	if m.LastSync != 0 {
 		n += 1 + sovContentDistribution(uint64(m.LastSync))
 	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovContentDistribution(uint64(l))
+	}
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovContentDistribution(uint64(l))
+	}
 	return n
 }
 
@@ -1090,6 +1374,40 @@ func (m *ContentReplication) Size() (n int) {
 	return n
 }
 
+func (m *ReplicaAssignment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContentId)
+	if l > 0 {
+		n += 1 + l + sovContentDistribution(uint64(l))
+	}
+	l = len(m.NodeId)
+	if l > 0 {
+		n += 1 + l + sovContentDistribution(uint64(l))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovContentDistribution(uint64(l))
+	}
+	if m.AssignedAt != 0 {
+		n += 1 + sovContentDistribution(uint64(m.AssignedAt))
+	}
+	if m.AckDeadline != 0 {
+		n += 1 + sovContentDistribution(uint64(m.AckDeadline))
+	}
+	if m.AcknowledgedAt != 0 {
+		n += 1 + sovContentDistribution(uint64(m.AcknowledgedAt))
+	}
+	l = len(m.Cid)
+	if l > 0 {
+		n += 1 + l + sovContentDistribution(uint64(l))
+	}
+	return n
+}
+
 func (m *HubSync) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1131,6 +1449,20 @@ func (m *HubSync) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovContentDistribution(uint64(l))
 	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovContentDistribution(uint64(l))
+	}
+	if m.TotalBytes != 0 {
+		n += 1 + sovContentDistribution(uint64(m.TotalBytes))
+	}
+	if m.UpdatedAt != 0 {
+		n += 1 + sovContentDistribution(uint64(m.UpdatedAt))
+	}
+	l = len(m.FailureReason)
+	if l > 0 {
+		n += 1 + l + sovContentDistribution(uint64(l))
+	}
 	return n
 }
 
@@ -1171,6 +1503,9 @@ func (m *SignalMessage) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovContentDistribution(uint64(l))
 	}
+	if m.ExpiryHeight != 0 {
+		n += 1 + sovContentDistribution(uint64(m.ExpiryHeight))
+	}
 	return n
 }
 
@@ -1472,19 +1807,87 @@ func (m *ContentDistribution) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipContentDistribution(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowContentDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthContentDistribution
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthContentDistribution
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowContentDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthContentDistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthContentDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &ContentMetadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipContentDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthContentDistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
 
@@ -1664,6 +2067,241 @@ func (m *ContentReplication) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ReplicaAssignment) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowContentDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReplicaAssignment: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReplicaAssignment: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContentId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowContentDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthContentDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthContentDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContentId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowContentDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthContentDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthContentDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NodeId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowContentDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthContentDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthContentDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssignedAt", wireType)
+			}
+			m.AssignedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowContentDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AssignedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AckDeadline", wireType)
+			}
+			m.AckDeadline = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowContentDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AckDeadline |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AcknowledgedAt", wireType)
+			}
+			m.AcknowledgedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowContentDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AcknowledgedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowContentDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthContentDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthContentDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipContentDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthContentDistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *HubSync) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1942,6 +2580,108 @@ func (m *HubSync) Unmarshal(dAtA []byte) error {
 			}
 			m.SyncMethod = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowContentDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthContentDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthContentDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalBytes", wireType)
+			}
+			m.TotalBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowContentDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+			}
+			m.UpdatedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowContentDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpdatedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailureReason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowContentDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthContentDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthContentDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FailureReason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipContentDistribution(dAtA[iNdEx:])
@@ -2237,6 +2977,25 @@ func (m *SignalMessage) Unmarshal(dAtA []byte) error {
 			}
 			m.Signature = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryHeight", wireType)
+			}
+			m.ExpiryHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowContentDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiryHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipContentDistribution(dAtA[iNdEx:])
@@ -10,4 +10,20 @@ import (
 var (
 	ErrInvalidSigner = errors.Register(ModuleName, 1100, "expected gov account as only signer for proposal message")
 	ErrInvalidInput  = errors.Register(ModuleName, 1101, "invalid input")
+
+	ErrContentNotFound      = errors.Register(ModuleName, 1102, "content not found")
+	ErrReplicaNotAssigned   = errors.Register(ModuleName, 1103, "replica not assigned to node")
+	ErrReplicaNotPending    = errors.Register(ModuleName, 1104, "replica assignment is not pending")
+	ErrReplicaCIDMismatch   = errors.Register(ModuleName, 1105, "replica cid does not match content cid")
+	ErrChallengeNotFound    = errors.Register(ModuleName, 1106, "storage challenge not found")
+	ErrChallengeClosed      = errors.Register(ModuleName, 1107, "storage challenge is closed")
+	ErrHubSyncNotFound      = errors.Register(ModuleName, 1108, "hub sync not found")
+	ErrHubSyncClosed        = errors.Register(ModuleName, 1109, "hub sync is closed")
+	ErrInvalidSignature     = errors.Register(ModuleName, 1110, "invalid signal message signature")
+	ErrPrekeyBundleNotFound = errors.Register(ModuleName, 1111, "prekey bundle not found")
+	ErrMailboxFull          = errors.Register(ModuleName, 1112, "node mailbox is full")
+	ErrBlocked              = errors.Register(ModuleName, 1113, "blocked by the post author")
+	ErrPostNotFound         = errors.Register(ModuleName, 1114, "post not found")
+	ErrSourceNotFound       = errors.Register(ModuleName, 1115, "source not found")
+	ErrSourceCited          = errors.Register(ModuleName, 1116, "source is cited by posts")
 )
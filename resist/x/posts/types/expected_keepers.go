@@ -5,6 +5,8 @@ import (
 
 	"cosmossdk.io/core/address"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	rewardstypes "resist/x/rewards/types"
 )
 
 // AuthKeeper defines the expected interface for the Auth module.
@@ -20,6 +22,20 @@ type BankKeeper interface {
 	// Methods imported from bank should be defined here
 }
 
+// RewardsKeeper defines the expected interface for the Rewards module.
+type RewardsKeeper interface {
+	GetNode(context.Context, string) (rewardstypes.Node, error)
+	GetActiveNodes(context.Context) ([]rewardstypes.Node, error)
+	GetHubMetrics(context.Context, string) (rewardstypes.HubMetrics, error)
+	RecordStorageAudit(ctx context.Context, nodeId string, passed bool, uptimePenalty uint64) error
+}
+
+// IdentityKeeper defines the expected interface for the Identity module.
+type IdentityKeeper interface {
+	IsBlocked(ctx context.Context, blocker, blocked string) (bool, error)
+	IsIssuer(ctx context.Context, address string) (bool, error)
+}
+
 // ParamSubspace defines the expected Subspace interface for parameters.
 type ParamSubspace interface {
 	Get(context.Context, []byte, interface{})
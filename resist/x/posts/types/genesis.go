@@ -1,26 +1,48 @@
 package types
 
-import "fmt"
+import (
+	"fmt"
+	"strconv"
+)
 
 // DefaultGenesis returns the default genesis state
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
 		Params:        DefaultParams(),
-		SocialPostMap: []SocialPost{}, VoteMap: []Vote{}, SourceMap: []Source{}, PostTagMap: []PostTag{}}
+		SocialPostMap: []SocialPost{}, VoteMap: []Vote{}, SourceMap: []Source{}, PostTagMap: []PostTag{}, ContentDistributionMap: []ContentDistribution{}, ReplicaAssignmentList: []ReplicaAssignment{}, StorageChallengeList: []StorageChallenge{}, HubSyncList: []HubSync{}, PrekeyBundleMap: []PrekeyBundle{}, OneTimePrekeyList: []OneTimePrekey{}, SignalMessageList: []SignalMessage{}, PostAliasMap: []PostAlias{}, SourceVoteList: []SourceVote{}, DeletedPostMap: []SocialPost{}}
 }
 
 // Validate performs basic genesis state validation returning an error upon any
 // failure.
 func (gs GenesisState) Validate() error {
 	socialPostIndexMap := make(map[string]struct{})
-
+	socialPostCount := gs.GetSocialPostCount()
 	for _, elem := range gs.SocialPostMap {
 		index := fmt.Sprint(elem.Index)
 		if _, ok := socialPostIndexMap[index]; ok {
 			return fmt.Errorf("duplicated index for socialPost")
 		}
+		if id, err := strconv.ParseUint(index, 10, 64); err != nil || id >= socialPostCount {
+			return fmt.Errorf("socialPost index %q should be a number lower than the post count", index)
+		}
 		socialPostIndexMap[index] = struct{}{}
 	}
+	for _, elem := range gs.DeletedPostMap {
+		if _, ok := socialPostIndexMap[elem.Index]; ok {
+			return fmt.Errorf("duplicated index for deletedPost")
+		}
+		if id, err := strconv.ParseUint(elem.Index, 10, 64); err != nil || id >= socialPostCount {
+			return fmt.Errorf("deletedPost index %q should be a number lower than the post count", elem.Index)
+		}
+		socialPostIndexMap[elem.Index] = struct{}{}
+	}
+	postAliasIndexMap := make(map[string]struct{})
+	for _, elem := range gs.PostAliasMap {
+		if _, ok := postAliasIndexMap[elem.OldIndex]; ok {
+			return fmt.Errorf("duplicated index for postAlias")
+		}
+		postAliasIndexMap[elem.OldIndex] = struct{}{}
+	}
 	voteIndexMap := make(map[string]struct{})
 
 	for _, elem := range gs.VoteMap {
@@ -39,6 +61,28 @@ func (gs GenesisState) Validate() error {
 		}
 		sourceIndexMap[index] = struct{}{}
 	}
+	for _, elem := range gs.SocialPostMap {
+		for _, source := range elem.SourceIndexes {
+			if _, ok := sourceIndexMap[source]; !ok {
+				return fmt.Errorf("socialPost %s cites unknown source %s", elem.Index, source)
+			}
+		}
+	}
+	sourceVoteIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.SourceVoteList {
+		index := elem.SourceIndex + "/" + elem.Voter
+		if _, ok := sourceVoteIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for sourceVote")
+		}
+		if _, ok := sourceIndexMap[elem.SourceIndex]; !ok {
+			return fmt.Errorf("sourceVote %s references unknown source", index)
+		}
+		if elem.VoteType != "upvote" && elem.VoteType != "downvote" {
+			return fmt.Errorf("invalid vote type %q for sourceVote %s", elem.VoteType, index)
+		}
+		sourceVoteIndexMap[index] = struct{}{}
+	}
 	postTagIndexMap := make(map[string]struct{})
 
 	for _, elem := range gs.PostTagMap {
@@ -48,6 +92,119 @@ func (gs GenesisState) Validate() error {
 		}
 		postTagIndexMap[index] = struct{}{}
 	}
+	contentDistributionIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.ContentDistributionMap {
+		if _, ok := contentDistributionIndexMap[elem.ContentId]; ok {
+			return fmt.Errorf("duplicated content id for contentDistribution")
+		}
+		if _, _, err := DecodeCID(elem.IpfsHash); err != nil {
+			return fmt.Errorf("invalid ipfs hash for contentDistribution %s: %w", elem.ContentId, err)
+		}
+		contentDistributionIndexMap[elem.ContentId] = struct{}{}
+	}
+	replicaAssignmentIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.ReplicaAssignmentList {
+		index := elem.ContentId + "/" + elem.NodeId
+		if _, ok := replicaAssignmentIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for replicaAssignment")
+		}
+		if _, ok := contentDistributionIndexMap[elem.ContentId]; !ok {
+			return fmt.Errorf("replicaAssignment %s references unknown content", index)
+		}
+		switch elem.Status {
+		case ReplicaStatusPending, ReplicaStatusStored, ReplicaStatusFailed:
+		default:
+			return fmt.Errorf("invalid status %q for replicaAssignment %s", elem.Status, index)
+		}
+		replicaAssignmentIndexMap[index] = struct{}{}
+	}
+	storageChallengeIdMap := make(map[uint64]struct{})
+	pendingStorageChallengeMap := make(map[string]struct{})
+
+	for _, elem := range gs.StorageChallengeList {
+		if _, ok := storageChallengeIdMap[elem.Id]; ok {
+			return fmt.Errorf("duplicated id for storageChallenge")
+		}
+		if elem.Id >= gs.StorageChallengeCount {
+			return fmt.Errorf("storageChallenge id should be lower or equal than the last id")
+		}
+		if _, ok := contentDistributionIndexMap[elem.ContentId]; !ok {
+			return fmt.Errorf("storageChallenge %d references unknown content", elem.Id)
+		}
+		switch elem.Status {
+		case ChallengeStatusPending, ChallengeStatusPassed, ChallengeStatusFailed:
+		default:
+			return fmt.Errorf("invalid status %q for storageChallenge %d", elem.Status, elem.Id)
+		}
+		if elem.Status == ChallengeStatusPending {
+			replica := elem.ContentId + "/" + elem.NodeId
+			if _, ok := pendingStorageChallengeMap[replica]; ok {
+				return fmt.Errorf("duplicated pending storageChallenge for replica %s", replica)
+			}
+			pendingStorageChallengeMap[replica] = struct{}{}
+		}
+		storageChallengeIdMap[elem.Id] = struct{}{}
+	}
+	hubSyncIdMap := make(map[string]struct{})
+
+	for _, elem := range gs.HubSyncList {
+		if _, ok := hubSyncIdMap[elem.SyncId]; ok {
+			return fmt.Errorf("duplicated sync id for hubSync")
+		}
+		var seq uint64
+		if _, err := fmt.Sscanf(elem.SyncId, "sync_%d", &seq); err != nil || elem.SyncId != fmt.Sprintf("sync_%d", seq) {
+			return fmt.Errorf("invalid sync id %q for hubSync", elem.SyncId)
+		}
+		if seq >= gs.HubSyncCount {
+			return fmt.Errorf("hubSync id should be lower or equal than the last id")
+		}
+		switch elem.Status {
+		case HubSyncStatusPending, HubSyncStatusSyncing, HubSyncStatusCompleted, HubSyncStatusFailed:
+		default:
+			return fmt.Errorf("invalid status %q for hubSync %s", elem.Status, elem.SyncId)
+		}
+		hubSyncIdMap[elem.SyncId] = struct{}{}
+	}
+	prekeyBundleIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.PrekeyBundleMap {
+		if _, ok := prekeyBundleIndexMap[elem.NodeId]; ok {
+			return fmt.Errorf("duplicated node id for prekeyBundle")
+		}
+		if len(elem.IdentityKey) != PrekeySize || len(elem.SignedPrekey) != PrekeySize {
+			return fmt.Errorf("invalid keys for prekeyBundle %s", elem.NodeId)
+		}
+		prekeyBundleIndexMap[elem.NodeId] = struct{}{}
+	}
+	oneTimePrekeyIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.OneTimePrekeyList {
+		index := fmt.Sprintf("%s/%d", elem.NodeId, elem.Id)
+		if _, ok := oneTimePrekeyIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for oneTimePrekey")
+		}
+		if _, ok := prekeyBundleIndexMap[elem.NodeId]; !ok {
+			return fmt.Errorf("oneTimePrekey %s references unknown prekeyBundle", index)
+		}
+		if elem.Id == 0 || len(elem.Key) != PrekeySize {
+			return fmt.Errorf("invalid oneTimePrekey %s", index)
+		}
+		oneTimePrekeyIndexMap[index] = struct{}{}
+	}
+	signalMessageIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.SignalMessageList {
+		if elem.RecipientNode == "" || elem.MessageId == "" {
+			return fmt.Errorf("signalMessage without recipient node or message id")
+		}
+		index := elem.RecipientNode + "/" + elem.MessageId
+		if _, ok := signalMessageIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for signalMessage")
+		}
+		signalMessageIndexMap[index] = struct{}{}
+	}
 
 	return gs.Params.Validate()
 }
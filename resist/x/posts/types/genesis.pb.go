@@ -27,11 +27,26 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // GenesisState defines the posts module's genesis state.
 type GenesisState struct {
 	// params defines all the parameters of the module.
-	Params        Params       `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
-	SocialPostMap []SocialPost `protobuf:"bytes,2,rep,name=social_post_map,json=socialPostMap,proto3" json:"social_post_map"`
-	VoteMap       []Vote       `protobuf:"bytes,3,rep,name=vote_map,json=voteMap,proto3" json:"vote_map"`
-	SourceMap     []Source     `protobuf:"bytes,4,rep,name=source_map,json=sourceMap,proto3" json:"source_map"`
-	PostTagMap    []PostTag    `protobuf:"bytes,5,rep,name=post_tag_map,json=postTagMap,proto3" json:"post_tag_map"`
+	Params                 Params                `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	SocialPostMap          []SocialPost          `protobuf:"bytes,2,rep,name=social_post_map,json=socialPostMap,proto3" json:"social_post_map"`
+	VoteMap                []Vote                `protobuf:"bytes,3,rep,name=vote_map,json=voteMap,proto3" json:"vote_map"`
+	SourceMap              []Source              `protobuf:"bytes,4,rep,name=source_map,json=sourceMap,proto3" json:"source_map"`
+	PostTagMap             []PostTag             `protobuf:"bytes,5,rep,name=post_tag_map,json=postTagMap,proto3" json:"post_tag_map"`
+	ContentDistributionMap []ContentDistribution `protobuf:"bytes,6,rep,name=content_distribution_map,json=contentDistributionMap,proto3" json:"content_distribution_map"`
+	ReplicaAssignmentList  []ReplicaAssignment   `protobuf:"bytes,7,rep,name=replica_assignment_list,json=replicaAssignmentList,proto3" json:"replica_assignment_list"`
+	StorageChallengeList   []StorageChallenge    `protobuf:"bytes,8,rep,name=storage_challenge_list,json=storageChallengeList,proto3" json:"storage_challenge_list"`
+	StorageChallengeCount  uint64                `protobuf:"varint,9,opt,name=storage_challenge_count,json=storageChallengeCount,proto3" json:"storage_challenge_count,omitempty"`
+	HubSyncList            []HubSync             `protobuf:"bytes,10,rep,name=hub_sync_list,json=hubSyncList,proto3" json:"hub_sync_list"`
+	HubSyncCount           uint64                `protobuf:"varint,11,opt,name=hub_sync_count,json=hubSyncCount,proto3" json:"hub_sync_count,omitempty"`
+	PrekeyBundleMap        []PrekeyBundle        `protobuf:"bytes,12,rep,name=prekey_bundle_map,json=prekeyBundleMap,proto3" json:"prekey_bundle_map"`
+	OneTimePrekeyList      []OneTimePrekey       `protobuf:"bytes,13,rep,name=one_time_prekey_list,json=oneTimePrekeyList,proto3" json:"one_time_prekey_list"`
+	SignalMessageList      []SignalMessage       `protobuf:"bytes,14,rep,name=signal_message_list,json=signalMessageList,proto3" json:"signal_message_list"`
+	SocialPostCount        uint64                `protobuf:"varint,15,opt,name=social_post_count,json=socialPostCount,proto3" json:"social_post_count,omitempty"`
+	PostAliasMap           []PostAlias           `protobuf:"bytes,16,rep,name=post_alias_map,json=postAliasMap,proto3" json:"post_alias_map"`
+	SourceVoteList         []SourceVote          `protobuf:"bytes,17,rep,name=source_vote_list,json=sourceVoteList,proto3" json:"source_vote_list"`
+	// deleted_post_map are the tombstones of the deleted posts which have
+	// replies or cite sources.
+	DeletedPostMap []SocialPost `protobuf:"bytes,18,rep,name=deleted_post_map,json=deletedPostMap,proto3" json:"deleted_post_map"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -102,6 +117,97 @@ func (m *GenesisState) GetPostTagMap() []PostTag {
 	return nil
 }
 
+func (m *GenesisState) GetContentDistributionMap() []ContentDistribution {
+	if m != nil {
+		return m.ContentDistributionMap
+	}
+	return nil
+}
+
+func (m *GenesisState) GetReplicaAssignmentList() []ReplicaAssignment {
+	if m != nil {
+		return m.ReplicaAssignmentList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetStorageChallengeList() []StorageChallenge {
+	if m != nil {
+		return m.StorageChallengeList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetStorageChallengeCount() uint64 {
+	if m != nil {
+		return m.StorageChallengeCount
+	}
+	return 0
+}
+
+func (m *GenesisState) GetHubSyncList() []HubSync {
+	if m != nil {
+		return m.HubSyncList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetHubSyncCount() uint64 {
+	if m != nil {
+		return m.HubSyncCount
+	}
+	return 0
+}
+
+func (m *GenesisState) GetPrekeyBundleMap() []PrekeyBundle {
+	if m != nil {
+		return m.PrekeyBundleMap
+	}
+	return nil
+}
+
+func (m *GenesisState) GetOneTimePrekeyList() []OneTimePrekey {
+	if m != nil {
+		return m.OneTimePrekeyList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSignalMessageList() []SignalMessage {
+	if m != nil {
+		return m.SignalMessageList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSocialPostCount() uint64 {
+	if m != nil {
+		return m.SocialPostCount
+	}
+	return 0
+}
+
+func (m *GenesisState) GetPostAliasMap() []PostAlias {
+	if m != nil {
+		return m.PostAliasMap
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSourceVoteList() []SourceVote {
+	if m != nil {
+		return m.SourceVoteList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDeletedPostMap() []SocialPost {
+	if m != nil {
+		return m.DeletedPostMap
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "resist.posts.v1.GenesisState")
 }
@@ -109,29 +215,53 @@ func init() {
 func init() { proto.RegisterFile("resist/posts/v1/genesis.proto", fileDescriptor_15c4136b623cb9bc) }
 
 var fileDescriptor_15c4136b623cb9bc = []byte{
-	// 351 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x91, 0x4f, 0x4b, 0x02, 0x41,
-	0x18, 0xc6, 0x77, 0xd5, 0x2c, 0x47, 0x43, 0x5a, 0x0c, 0x65, 0xab, 0xc9, 0x3a, 0x49, 0x87, 0x5d,
-	0x34, 0xe8, 0x10, 0x1d, 0xc2, 0x4b, 0x74, 0x10, 0x44, 0xa3, 0x43, 0x17, 0x99, 0x64, 0x58, 0x84,
-	0xf4, 0x1d, 0x76, 0x26, 0xa9, 0x6f, 0xd1, 0xc7, 0xe8, 0xd8, 0xc7, 0xf0, 0x12, 0x78, 0xec, 0x14,
-	0xe1, 0x1e, 0xfa, 0x1a, 0x31, 0xef, 0x4c, 0x11, 0xbb, 0x75, 0x19, 0x66, 0xde, 0xe7, 0xfd, 0x3d,
-	0xf3, 0xfe, 0x21, 0x7b, 0x31, 0x97, 0x13, 0xa9, 0x42, 0x01, 0x52, 0xc9, 0x70, 0xde, 0x0e, 0x23,
-	0x3e, 0xd3, 0x91, 0x40, 0xc4, 0xa0, 0xc0, 0xab, 0x1a, 0x39, 0x40, 0x39, 0x98, 0xb7, 0xfd, 0x2d,
-	0x36, 0x9d, 0xcc, 0x20, 0xc4, 0xd3, 0xe4, 0xf8, 0xb5, 0x08, 0x22, 0xc0, 0x6b, 0xa8, 0x6f, 0x36,
-	0xba, 0x9b, 0x36, 0x16, 0x2c, 0x66, 0x53, 0xeb, 0xeb, 0xd3, 0x8c, 0x0a, 0x52, 0x8d, 0x14, 0x8b,
-	0xac, 0x7e, 0x90, 0xd6, 0x25, 0x8c, 0x27, 0xec, 0x6e, 0xa4, 0xdf, 0xff, 0x7d, 0x20, 0xe1, 0x3e,
-	0x1e, 0x73, 0xab, 0xfa, 0x69, 0x75, 0x0e, 0xca, 0x6a, 0x87, 0xaf, 0x39, 0x52, 0xb9, 0x30, 0x6d,
-	0x0e, 0x15, 0x53, 0xdc, 0x3b, 0x25, 0x45, 0x53, 0x5d, 0xc3, 0x6d, 0xba, 0xad, 0x72, 0xa7, 0x1e,
-	0xa4, 0xda, 0x0e, 0xfa, 0x28, 0x77, 0x4b, 0x8b, 0xf7, 0x7d, 0xe7, 0xf9, 0xf3, 0xe5, 0xc8, 0x1d,
-	0x58, 0xc2, 0xbb, 0x24, 0xd5, 0x5f, 0xb5, 0x8d, 0xa6, 0x4c, 0x34, 0x72, 0xcd, 0x7c, 0xab, 0xdc,
-	0xd9, 0xc9, 0x98, 0x0c, 0x31, 0xaf, 0x0f, 0x52, 0x75, 0x0b, 0xda, 0x68, 0xb0, 0x29, 0x7f, 0x22,
-	0x3d, 0x26, 0xbc, 0x13, 0xb2, 0xa1, 0xab, 0x44, 0x8f, 0x3c, 0x7a, 0x6c, 0x67, 0x3c, 0xae, 0x41,
-	0x71, 0x4b, 0xaf, 0xeb, 0x64, 0xcd, 0x9d, 0x11, 0x62, 0x7a, 0x47, 0xb2, 0x80, 0x64, 0xfd, 0x8f,
-	0xdf, 0x75, 0x8a, 0x65, 0x4b, 0x06, 0xd0, 0xf4, 0x39, 0xa9, 0x7c, 0x0f, 0x1f, 0xf9, 0x35, 0xe4,
-	0x1b, 0xd9, 0x11, 0x80, 0x54, 0x57, 0x2c, 0xb2, 0x06, 0x44, 0x98, 0x67, 0x8f, 0x89, 0x6e, 0xb0,
-	0x58, 0x51, 0x77, 0xb9, 0xa2, 0xee, 0xc7, 0x8a, 0xba, 0x4f, 0x09, 0x75, 0x96, 0x09, 0x75, 0xde,
-	0x12, 0xea, 0xdc, 0xd4, 0xec, 0x16, 0x1e, 0xec, 0x1e, 0xd4, 0xa3, 0xe0, 0xf2, 0xb6, 0x88, 0x6b,
-	0x38, 0xfe, 0x0a, 0x00, 0x00, 0xff, 0xff, 0x6b, 0x53, 0xb0, 0x4b, 0x7c, 0x02, 0x00, 0x00,
+	// 725 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0xcd, 0x4e, 0x1b, 0x3b,
+	0x14, 0xce, 0x5c, 0xb8, 0xfc, 0x38, 0x21, 0x21, 0x73, 0x03, 0x89, 0x72, 0xcb, 0x14, 0x28, 0x52,
+	0x11, 0x8b, 0x44, 0x50, 0x89, 0x45, 0xd5, 0x45, 0x09, 0x55, 0x7f, 0x44, 0x11, 0x28, 0xa1, 0x5d,
+	0x54, 0xaa, 0xa6, 0xce, 0xc4, 0x1a, 0xac, 0xce, 0xd8, 0xa3, 0xb1, 0x83, 0x9a, 0xb7, 0xe8, 0x33,
+	0x74, 0xd5, 0x65, 0x1f, 0x83, 0x25, 0xcb, 0xae, 0xaa, 0x0a, 0x16, 0x7d, 0x8d, 0xca, 0xc7, 0x4e,
+	0x98, 0x8e, 0x89, 0xd4, 0x4d, 0x94, 0x39, 0xe7, 0xfb, 0xb1, 0xcf, 0xf1, 0x39, 0x68, 0x2d, 0x25,
+	0x82, 0x0a, 0xd9, 0x4e, 0xb8, 0x90, 0xa2, 0x7d, 0xb1, 0xdb, 0x0e, 0x09, 0x53, 0x91, 0x56, 0x92,
+	0x72, 0xc9, 0xdd, 0x8a, 0x4e, 0xb7, 0x20, 0xdd, 0xba, 0xd8, 0x6d, 0x56, 0x71, 0x4c, 0x19, 0x6f,
+	0xc3, 0xaf, 0xc6, 0x34, 0x6b, 0x21, 0x0f, 0x39, 0xfc, 0x6d, 0xab, 0x7f, 0x26, 0xba, 0x93, 0x17,
+	0x0e, 0x38, 0x93, 0x84, 0x49, 0x7f, 0x40, 0x85, 0x4c, 0x69, 0x7f, 0x28, 0x29, 0x67, 0x06, 0x7b,
+	0x2f, 0x8f, 0x4d, 0x70, 0x8a, 0x63, 0x73, 0x86, 0xa6, 0x67, 0x65, 0xb9, 0x90, 0xbe, 0xc4, 0xa1,
+	0xc9, 0x3f, 0xb0, 0xf2, 0x29, 0xf9, 0x48, 0x46, 0x7e, 0x7f, 0xc8, 0x06, 0x11, 0x31, 0xa0, 0x8d,
+	0x3c, 0x48, 0xf0, 0x80, 0xe2, 0xc8, 0x57, 0xdf, 0xd3, 0x4e, 0x21, 0xf8, 0x30, 0x0d, 0xc6, 0x02,
+	0x0f, 0xad, 0xac, 0xe4, 0x29, 0x0e, 0x89, 0x1f, 0x9c, 0xe3, 0x28, 0x22, 0x2c, 0x1c, 0x03, 0x9b,
+	0x79, 0xe0, 0x05, 0x97, 0x26, 0xb7, 0xf9, 0x05, 0xa1, 0xd2, 0x0b, 0x5d, 0xe0, 0x9e, 0xc4, 0x92,
+	0xb8, 0x8f, 0xd1, 0x9c, 0xbe, 0x6b, 0xc3, 0x59, 0x77, 0xb6, 0x8b, 0x7b, 0xf5, 0x56, 0xae, 0xe0,
+	0xad, 0x53, 0x48, 0x77, 0x16, 0x2f, 0x7f, 0xdc, 0x2f, 0x7c, 0xfd, 0xf5, 0x6d, 0xc7, 0xe9, 0x1a,
+	0x86, 0xfb, 0x0a, 0x55, 0x32, 0x97, 0xf0, 0x63, 0x9c, 0x34, 0xfe, 0x59, 0x9f, 0xd9, 0x2e, 0xee,
+	0xfd, 0x6f, 0x89, 0xf4, 0x00, 0x77, 0xca, 0x85, 0xec, 0xcc, 0x2a, 0xa1, 0xee, 0x92, 0x98, 0x44,
+	0x8e, 0x71, 0xe2, 0xee, 0xa3, 0x05, 0x75, 0x4a, 0xd0, 0x98, 0x01, 0x8d, 0x15, 0x4b, 0xe3, 0x2d,
+	0x97, 0xc4, 0xb0, 0xe7, 0x15, 0x58, 0xf1, 0x9e, 0x20, 0xa4, 0x8b, 0x04, 0xcc, 0x59, 0x60, 0xd6,
+	0xef, 0x70, 0x57, 0x10, 0xc3, 0x5d, 0xd4, 0x04, 0xc5, 0x7e, 0x8a, 0x4a, 0xe3, 0x56, 0x02, 0xff,
+	0x5f, 0xe0, 0x37, 0xec, 0x12, 0x70, 0x21, 0xcf, 0x70, 0x68, 0x04, 0x50, 0xa2, 0x3f, 0x95, 0xc2,
+	0x00, 0x35, 0xee, 0x7a, 0x56, 0xa0, 0x36, 0x07, 0x6a, 0x5b, 0x96, 0xda, 0xa1, 0x26, 0x3c, 0xcb,
+	0xe0, 0x8d, 0xf2, 0x6a, 0x60, 0xa7, 0x94, 0xcb, 0x07, 0x54, 0x4f, 0x49, 0x12, 0xd1, 0x00, 0xfb,
+	0x58, 0x08, 0x1a, 0xb2, 0x58, 0x19, 0x46, 0x54, 0xc8, 0xc6, 0x3c, 0x98, 0x6c, 0x5a, 0x26, 0x5d,
+	0x8d, 0x3f, 0x98, 0xc0, 0x8d, 0xc5, 0x4a, 0x9a, 0x4f, 0xbc, 0xa6, 0x42, 0xba, 0xef, 0xd1, 0xaa,
+	0xf5, 0x9c, 0xb4, 0xc1, 0x02, 0x18, 0x6c, 0xd8, 0x35, 0xd5, 0xf0, 0xc3, 0x31, 0xda, 0xe8, 0xd7,
+	0x44, 0x2e, 0x0e, 0xf2, 0xfb, 0xa8, 0x6e, 0xcb, 0x07, 0x7c, 0xc8, 0x64, 0x63, 0x71, 0xdd, 0xd9,
+	0x9e, 0xed, 0xae, 0xe4, 0x69, 0x87, 0x2a, 0xe9, 0x76, 0xd0, 0xd2, 0xf9, 0xb0, 0xef, 0x8b, 0x11,
+	0x0b, 0xf4, 0x69, 0xd0, 0x94, 0x0e, 0xbd, 0x1c, 0xf6, 0x7b, 0x23, 0x16, 0x98, 0x43, 0x14, 0xcf,
+	0xf5, 0x27, 0x78, 0x6f, 0xa1, 0xf2, 0x44, 0x43, 0x5b, 0x16, 0xc1, 0xb2, 0x64, 0x40, 0xda, 0xe9,
+	0x04, 0x55, 0xff, 0x98, 0x5a, 0xe8, 0x60, 0x09, 0xdc, 0xd6, 0xec, 0xf7, 0x00, 0xc8, 0x0e, 0x00,
+	0x8d, 0x65, 0x25, 0xc9, 0xc4, 0x54, 0xcf, 0xde, 0xa0, 0x1a, 0x67, 0xc4, 0x97, 0x34, 0x26, 0xbe,
+	0x51, 0x86, 0x1b, 0x2c, 0x81, 0xa6, 0x67, 0x69, 0x9e, 0x30, 0x72, 0x46, 0x63, 0x62, 0xa4, 0xb5,
+	0x68, 0x95, 0x67, 0x83, 0x70, 0x9b, 0x33, 0xf4, 0x9f, 0x6a, 0x1c, 0x8e, 0xfc, 0x98, 0x08, 0x81,
+	0xc7, 0x5d, 0x2a, 0x4f, 0x51, 0xed, 0x01, 0xf6, 0x58, 0x43, 0xc7, 0xaa, 0x22, 0x1b, 0x04, 0xd5,
+	0x1d, 0x54, 0xcd, 0x4e, 0xb2, 0x2e, 0x53, 0x05, 0xca, 0x54, 0xb9, 0x1d, 0x54, 0x5d, 0xa9, 0xe7,
+	0xa8, 0x0c, 0x20, 0x1c, 0x51, 0x2c, 0xa0, 0x4c, 0xcb, 0x60, 0xde, 0xbc, 0x73, 0x6c, 0x0e, 0x14,
+	0xca, 0x18, 0xc3, 0xb0, 0x41, 0x40, 0x15, 0xe8, 0x08, 0x2d, 0x9b, 0xd1, 0x85, 0xc9, 0x87, 0x6b,
+	0x54, 0xa7, 0xae, 0x0f, 0x05, 0xcc, 0x2c, 0x80, 0xb2, 0x98, 0x44, 0xe0, 0x02, 0x47, 0x68, 0x79,
+	0x40, 0x22, 0x22, 0xc9, 0xe0, 0x76, 0x17, 0xb9, 0x7f, 0xbb, 0x8b, 0xca, 0x86, 0x6a, 0x96, 0x51,
+	0xa7, 0x75, 0x79, 0xed, 0x39, 0x57, 0xd7, 0x9e, 0xf3, 0xf3, 0xda, 0x73, 0x3e, 0xdf, 0x78, 0x85,
+	0xab, 0x1b, 0xaf, 0xf0, 0xfd, 0xc6, 0x2b, 0xbc, 0xab, 0x99, 0xd5, 0xfa, 0xc9, 0x2c, 0x57, 0x39,
+	0x4a, 0x88, 0xe8, 0xcf, 0xc1, 0x6e, 0x7d, 0xf4, 0x3b, 0x00, 0x00, 0xff, 0xff, 0x56, 0xa7, 0x52,
+	0x88, 0xcb, 0x06, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -154,6 +284,167 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.DeletedPostMap) > 0 {
+		for iNdEx := len(m.DeletedPostMap) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DeletedPostMap[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if len(m.SourceVoteList) > 0 {
+		for iNdEx := len(m.SourceVoteList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SourceVoteList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if len(m.PostAliasMap) > 0 {
+		for iNdEx := len(m.PostAliasMap) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PostAliasMap[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if m.SocialPostCount != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.SocialPostCount))
+		i--
+		dAtA[i] = 0x78
+	}
+	if len(m.SignalMessageList) > 0 {
+		for iNdEx := len(m.SignalMessageList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SignalMessageList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.OneTimePrekeyList) > 0 {
+		for iNdEx := len(m.OneTimePrekeyList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OneTimePrekeyList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.PrekeyBundleMap) > 0 {
+		for iNdEx := len(m.PrekeyBundleMap) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PrekeyBundleMap[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if m.HubSyncCount != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.HubSyncCount))
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.HubSyncList) > 0 {
+		for iNdEx := len(m.HubSyncList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HubSyncList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if m.StorageChallengeCount != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.StorageChallengeCount))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.StorageChallengeList) > 0 {
+		for iNdEx := len(m.StorageChallengeList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StorageChallengeList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.ReplicaAssignmentList) > 0 {
+		for iNdEx := len(m.ReplicaAssignmentList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ReplicaAssignmentList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.ContentDistributionMap) > 0 {
+		for iNdEx := len(m.ContentDistributionMap) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ContentDistributionMap[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if len(m.PostTagMap) > 0 {
 		for iNdEx := len(m.PostTagMap) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -266,6 +557,75 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.ContentDistributionMap) > 0 {
+		for _, e := range m.ContentDistributionMap {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ReplicaAssignmentList) > 0 {
+		for _, e := range m.ReplicaAssignmentList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.StorageChallengeList) > 0 {
+		for _, e := range m.StorageChallengeList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.StorageChallengeCount != 0 {
+		n += 1 + sovGenesis(uint64(m.StorageChallengeCount))
+	}
+	if len(m.HubSyncList) > 0 {
+		for _, e := range m.HubSyncList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.HubSyncCount != 0 {
+		n += 1 + sovGenesis(uint64(m.HubSyncCount))
+	}
+	if len(m.PrekeyBundleMap) > 0 {
+		for _, e := range m.PrekeyBundleMap {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.OneTimePrekeyList) > 0 {
+		for _, e := range m.OneTimePrekeyList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SignalMessageList) > 0 {
+		for _, e := range m.SignalMessageList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.SocialPostCount != 0 {
+		n += 1 + sovGenesis(uint64(m.SocialPostCount))
+	}
+	if len(m.PostAliasMap) > 0 {
+		for _, e := range m.PostAliasMap {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SourceVoteList) > 0 {
+		for _, e := range m.SourceVoteList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DeletedPostMap) > 0 {
+		for _, e := range m.DeletedPostMap {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -473,6 +833,403 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContentDistributionMap", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContentDistributionMap = append(m.ContentDistributionMap, ContentDistribution{})
+			if err := m.ContentDistributionMap[len(m.ContentDistributionMap)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReplicaAssignmentList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReplicaAssignmentList = append(m.ReplicaAssignmentList, ReplicaAssignment{})
+			if err := m.ReplicaAssignmentList[len(m.ReplicaAssignmentList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageChallengeList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StorageChallengeList = append(m.StorageChallengeList, StorageChallenge{})
+			if err := m.StorageChallengeList[len(m.StorageChallengeList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageChallengeCount", wireType)
+			}
+			m.StorageChallengeCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StorageChallengeCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HubSyncList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HubSyncList = append(m.HubSyncList, HubSync{})
+			if err := m.HubSyncList[len(m.HubSyncList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HubSyncCount", wireType)
+			}
+			m.HubSyncCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HubSyncCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrekeyBundleMap", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PrekeyBundleMap = append(m.PrekeyBundleMap, PrekeyBundle{})
+			if err := m.PrekeyBundleMap[len(m.PrekeyBundleMap)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OneTimePrekeyList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OneTimePrekeyList = append(m.OneTimePrekeyList, OneTimePrekey{})
+			if err := m.OneTimePrekeyList[len(m.OneTimePrekeyList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignalMessageList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SignalMessageList = append(m.SignalMessageList, SignalMessage{})
+			if err := m.SignalMessageList[len(m.SignalMessageList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SocialPostCount", wireType)
+			}
+			m.SocialPostCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SocialPostCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PostAliasMap", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PostAliasMap = append(m.PostAliasMap, PostAlias{})
+			if err := m.PostAliasMap[len(m.PostAliasMap)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceVoteList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceVoteList = append(m.SourceVoteList, SourceVote{})
+			if err := m.SourceVoteList[len(m.SourceVoteList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeletedPostMap", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeletedPostMap = append(m.DeletedPostMap, SocialPost{})
+			if err := m.DeletedPostMap[len(m.DeletedPostMap)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
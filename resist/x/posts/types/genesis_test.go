@@ -21,8 +21,36 @@ func TestGenesisState_Validate(t *testing.T) {
 		},
 		{
 			desc:     "valid genesis state",
-			genState: &types.GenesisState{SocialPostMap: []types.SocialPost{{Index: "0"}, {Index: "1"}}, VoteMap: []types.Vote{{Index: "0"}, {Index: "1"}}, SourceMap: []types.Source{{Index: "0"}, {Index: "1"}}, PostTagMap: []types.PostTag{{Index: "0"}, {Index: "1"}}},
+			genState: &types.GenesisState{Params: types.DefaultParams(), SocialPostMap: []types.SocialPost{{Index: "0", ReplyCount: 1}, {Index: "1", SourceIndexes: []string{"0"}}}, DeletedPostMap: []types.SocialPost{{Index: "2", ParentIndex: "0", RootIndex: "0", ReplyCount: 1}}, SocialPostCount: 3, PostAliasMap: []types.PostAlias{{OldIndex: "1-1-creator", Index: "1"}}, VoteMap: []types.Vote{{Index: "0"}, {Index: "1"}}, SourceMap: []types.Source{{Index: "0"}, {Index: "1"}}, SourceVoteList: []types.SourceVote{{SourceIndex: "0", Voter: "a", VoteType: "upvote"}}, PostTagMap: []types.PostTag{{Index: "0"}, {Index: "1"}}, ContentDistributionMap: []types.ContentDistribution{{ContentId: "0", IpfsHash: types.NewCID(types.RawCodec, []byte("0"))}}, ReplicaAssignmentList: []types.ReplicaAssignment{{ContentId: "0", NodeId: "node-0", Status: types.ReplicaStatusPending}, {ContentId: "0", NodeId: "node-1", Status: types.ReplicaStatusStored}}, StorageChallengeList: []types.StorageChallenge{{Id: 0, ContentId: "0", NodeId: "node-1", Status: types.ChallengeStatusPending}}, StorageChallengeCount: 1, HubSyncList: []types.HubSync{{SyncId: "sync_0", Status: types.HubSyncStatusCompleted}}, HubSyncCount: 1},
 			valid:    true,
+		}, {
+			desc: "socialPost index above count",
+			genState: &types.GenesisState{
+				SocialPostMap:   []types.SocialPost{{Index: "0"}, {Index: "1"}},
+				SocialPostCount: 1,
+			},
+			valid: false,
+		}, {
+			desc: "deletedPost sharing a socialPost index",
+			genState: &types.GenesisState{
+				SocialPostMap:   []types.SocialPost{{Index: "0"}},
+				DeletedPostMap:  []types.SocialPost{{Index: "0"}},
+				SocialPostCount: 1,
+			},
+			valid: false,
+		}, {
+			desc: "deletedPost index above count",
+			genState: &types.GenesisState{
+				DeletedPostMap:  []types.SocialPost{{Index: "1"}},
+				SocialPostCount: 1,
+			},
+			valid: false,
+		}, {
+			desc: "duplicated postAlias",
+			genState: &types.GenesisState{
+				PostAliasMap: []types.PostAlias{{OldIndex: "a", Index: "0"}, {OldIndex: "a", Index: "1"}},
+			},
+			valid: false,
 		}, {
 			desc: "duplicated socialPost",
 			genState: &types.GenesisState{
@@ -62,6 +90,152 @@ func TestGenesisState_Validate(t *testing.T) {
 				},
 				PostTagMap: []types.PostTag{{Index: "0"}, {Index: "1"}}},
 			valid: false,
+		}, {
+			desc: "socialPost citing unknown source",
+			genState: &types.GenesisState{
+				SocialPostMap:   []types.SocialPost{{Index: "0", SourceIndexes: []string{"1"}}},
+				SocialPostCount: 1,
+				SourceMap:       []types.Source{{Index: "0"}},
+			},
+			valid: false,
+		}, {
+			desc: "duplicated sourceVote",
+			genState: &types.GenesisState{
+				SourceMap:      []types.Source{{Index: "0"}},
+				SourceVoteList: []types.SourceVote{{SourceIndex: "0", Voter: "a", VoteType: "upvote"}, {SourceIndex: "0", Voter: "a", VoteType: "downvote"}},
+			},
+			valid: false,
+		}, {
+			desc: "sourceVote for unknown source",
+			genState: &types.GenesisState{
+				SourceVoteList: []types.SourceVote{{SourceIndex: "0", Voter: "a", VoteType: "upvote"}},
+			},
+			valid: false,
+		}, {
+			desc: "invalid sourceVote type",
+			genState: &types.GenesisState{
+				SourceMap:      []types.Source{{Index: "0"}},
+				SourceVoteList: []types.SourceVote{{SourceIndex: "0", Voter: "a", VoteType: "like"}},
+			},
+			valid: false,
+		}, {
+			desc: "duplicated contentDistribution",
+			genState: &types.GenesisState{
+				ContentDistributionMap: []types.ContentDistribution{
+					{
+						ContentId: "0",
+						IpfsHash:  types.NewCID(types.RawCodec, []byte("0")),
+					},
+					{
+						ContentId: "0",
+						IpfsHash:  types.NewCID(types.RawCodec, []byte("0")),
+					},
+				},
+			},
+			valid: false,
+		}, {
+			desc: "invalid contentDistribution ipfs hash",
+			genState: &types.GenesisState{
+				ContentDistributionMap: []types.ContentDistribution{
+					{
+						ContentId: "0",
+						IpfsHash:  "Qm0000",
+					},
+				},
+			},
+			valid: false,
+		}, {
+			desc: "duplicated replicaAssignment",
+			genState: &types.GenesisState{
+				ContentDistributionMap: []types.ContentDistribution{{ContentId: "0", IpfsHash: types.NewCID(types.RawCodec, []byte("0"))}},
+				ReplicaAssignmentList: []types.ReplicaAssignment{
+					{ContentId: "0", NodeId: "node-0", Status: types.ReplicaStatusPending},
+					{ContentId: "0", NodeId: "node-0", Status: types.ReplicaStatusStored},
+				},
+			},
+			valid: false,
+		}, {
+			desc: "replicaAssignment for unknown content",
+			genState: &types.GenesisState{
+				ReplicaAssignmentList: []types.ReplicaAssignment{{ContentId: "0", NodeId: "node-0", Status: types.ReplicaStatusPending}},
+			},
+			valid: false,
+		}, {
+			desc: "invalid replicaAssignment status",
+			genState: &types.GenesisState{
+				ContentDistributionMap: []types.ContentDistribution{{ContentId: "0", IpfsHash: types.NewCID(types.RawCodec, []byte("0"))}},
+				ReplicaAssignmentList:  []types.ReplicaAssignment{{ContentId: "0", NodeId: "node-0", Status: "lost"}},
+			},
+			valid: false,
+		}, {
+			desc: "duplicated pending storageChallenge for a replica",
+			genState: &types.GenesisState{
+				ContentDistributionMap: []types.ContentDistribution{{ContentId: "0", IpfsHash: types.NewCID(types.RawCodec, []byte("0"))}},
+				StorageChallengeList: []types.StorageChallenge{
+					{Id: 0, ContentId: "0", NodeId: "node-0", Status: types.ChallengeStatusPending},
+					{Id: 1, ContentId: "0", NodeId: "node-0", Status: types.ChallengeStatusPending},
+				},
+				StorageChallengeCount: 2,
+			},
+			valid: false,
+		}, {
+			desc: "duplicated storageChallenge",
+			genState: &types.GenesisState{
+				ContentDistributionMap: []types.ContentDistribution{{ContentId: "0", IpfsHash: types.NewCID(types.RawCodec, []byte("0"))}},
+				StorageChallengeList: []types.StorageChallenge{
+					{Id: 0, ContentId: "0", NodeId: "node-0", Status: types.ChallengeStatusPending},
+					{Id: 0, ContentId: "0", NodeId: "node-1", Status: types.ChallengeStatusPending},
+				},
+				StorageChallengeCount: 2,
+			},
+			valid: false,
+		}, {
+			desc: "invalid storageChallenge count",
+			genState: &types.GenesisState{
+				ContentDistributionMap: []types.ContentDistribution{{ContentId: "0", IpfsHash: types.NewCID(types.RawCodec, []byte("0"))}},
+				StorageChallengeList:   []types.StorageChallenge{{Id: 1, ContentId: "0", NodeId: "node-0", Status: types.ChallengeStatusPassed}},
+				StorageChallengeCount:  1,
+			},
+			valid: false,
+		}, {
+			desc: "duplicated hubSync",
+			genState: &types.GenesisState{
+				HubSyncList:  []types.HubSync{{SyncId: "sync_0", Status: types.HubSyncStatusPending}, {SyncId: "sync_0", Status: types.HubSyncStatusPending}},
+				HubSyncCount: 2,
+			},
+			valid: false,
+		}, {
+			desc: "invalid hubSync id",
+			genState: &types.GenesisState{
+				HubSyncList:  []types.HubSync{{SyncId: "sync_1", Status: types.HubSyncStatusPending}},
+				HubSyncCount: 1,
+			},
+			valid: false,
+		}, {
+			desc: "duplicated prekeyBundle",
+			genState: &types.GenesisState{
+				PrekeyBundleMap: []types.PrekeyBundle{{NodeId: "node-0", IdentityKey: make([]byte, 32), SignedPrekey: make([]byte, 32)}, {NodeId: "node-0", IdentityKey: make([]byte, 32), SignedPrekey: make([]byte, 32)}},
+			},
+			valid: false,
+		}, {
+			desc: "oneTimePrekey for unknown prekeyBundle",
+			genState: &types.GenesisState{
+				PrekeyBundleMap:   []types.PrekeyBundle{{NodeId: "node-0", IdentityKey: make([]byte, 32), SignedPrekey: make([]byte, 32)}},
+				OneTimePrekeyList: []types.OneTimePrekey{{NodeId: "node-1", Id: 1, Key: make([]byte, 32)}},
+			},
+			valid: false,
+		}, {
+			desc: "duplicated signalMessage",
+			genState: &types.GenesisState{
+				SignalMessageList: []types.SignalMessage{{MessageId: "msg_0", RecipientNode: "node-0"}, {MessageId: "msg_0", RecipientNode: "node-0"}},
+			},
+			valid: false,
+		}, {
+			desc: "invalid params",
+			genState: &types.GenesisState{
+				Params: types.NewParams(0, types.DefaultAuditEpochBlocks, types.DefaultAuditChallengesPerEpoch, types.DefaultAuditResponseBlocks, types.DefaultAuditUptimePenalty, types.DefaultMaxOneTimePrekeys, types.DefaultMaxSignalMessageBytes, types.DefaultMaxMailboxBytes, types.DefaultMailboxTTLBlocks, types.DefaultMaxMailboxMessagesPerSender),
+			},
+			valid: false,
 		}, {
 			desc: "duplicated postTag",
 			genState: &types.GenesisState{
@@ -0,0 +1,13 @@
+package types
+
+import "cosmossdk.io/collections"
+
+// ContentDistributionKey is the prefix to retrieve all ContentDistribution
+var ContentDistributionKey = collections.NewPrefix("contentDistribution/value/")
+
+// EventTypeContentDistributed is emitted for every distributed content, with
+// its CID in the AttributeKeyIpfsHash attribute.
+const (
+	EventTypeContentDistributed = "content_distributed"
+	AttributeKeyIpfsHash        = "ipfs_hash"
+)
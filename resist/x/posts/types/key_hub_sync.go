@@ -0,0 +1,20 @@
+package types
+
+import "cosmossdk.io/collections"
+
+// HubSyncKey is the prefix to retrieve all HubSync
+var HubSyncKey = collections.NewPrefix("hubSync/value/")
+
+// HubSyncCountKey is the prefix of the HubSync id sequence
+var HubSyncCountKey = collections.NewPrefix("hubSync/count/")
+
+// HubSyncByNodeKey is the prefix of the index of HubSync by source and target node
+var HubSyncByNodeKey = collections.NewPrefix("hubSync/node/")
+
+// Hub sync statuses
+const (
+	HubSyncStatusPending   = "pending"
+	HubSyncStatusSyncing   = "syncing"
+	HubSyncStatusCompleted = "completed"
+	HubSyncStatusFailed    = "failed"
+)
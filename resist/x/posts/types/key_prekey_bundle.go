@@ -0,0 +1,12 @@
+package types
+
+import "cosmossdk.io/collections"
+
+// PrekeyBundleKey is the prefix to retrieve all PrekeyBundle
+var PrekeyBundleKey = collections.NewPrefix("prekeyBundle/value/")
+
+// OneTimePrekeyKey is the prefix to retrieve all OneTimePrekey
+var OneTimePrekeyKey = collections.NewPrefix("oneTimePrekey/value/")
+
+// PrekeySize is the size of the X25519 identity key and prekeys of a bundle.
+const PrekeySize = 32
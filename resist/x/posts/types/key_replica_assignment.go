@@ -0,0 +1,20 @@
+package types
+
+import "cosmossdk.io/collections"
+
+// ReplicaAssignmentKey is the prefix to retrieve all ReplicaAssignment
+var ReplicaAssignmentKey = collections.NewPrefix("replicaAssignment/value/")
+
+// ReplicaDeadlineKey is the prefix of the index of pending replica assignments by deadline
+var ReplicaDeadlineKey = collections.NewPrefix("replicaAssignment/deadline/")
+
+// Replica assignment statuses
+const (
+	ReplicaStatusPending = "pending"
+	ReplicaStatusStored  = "stored"
+	ReplicaStatusFailed  = "failed"
+)
+
+// StoredReplicaKey is the prefix of the index of stored replica assignments by
+// key hash, from which storage challenges are picked
+var StoredReplicaKey = collections.NewPrefix("replicaAssignment/stored/")
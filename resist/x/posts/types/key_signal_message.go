@@ -0,0 +1,16 @@
+package types
+
+import "cosmossdk.io/collections"
+
+// MailboxKey is the prefix to retrieve all SignalMessage queued in node mailboxes
+var MailboxKey = collections.NewPrefix("mailbox/value/")
+
+// MailboxExpiryKey is the prefix of the index of queued SignalMessage by expiry height
+var MailboxExpiryKey = collections.NewPrefix("mailbox/expiry/")
+
+// MailboxSizeKey is the prefix of the size of the messages queued in each node mailbox
+var MailboxSizeKey = collections.NewPrefix("mailbox/size/")
+
+// MailboxSenderCountKey is the prefix of the number of messages queued in each
+// node mailbox by each sender node
+var MailboxSenderCountKey = collections.NewPrefix("mailbox/senderCount/")
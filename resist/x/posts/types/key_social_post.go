@@ -4,3 +4,33 @@ import "cosmossdk.io/collections"
 
 // SocialPostKey is the prefix to retrieve all SocialPost
 var SocialPostKey = collections.NewPrefix("socialPost/value/")
+
+// SocialPostCountKey is the prefix of the SocialPost index sequence
+var SocialPostCountKey = collections.NewPrefix("socialPost/count/")
+
+// PostAliasKey is the prefix to retrieve all PostAlias by old index
+var PostAliasKey = collections.NewPrefix("socialPost/alias/")
+
+// ReplyKey is the prefix of the index of SocialPost replies by
+// (parent index, reply index)
+var ReplyKey = collections.NewPrefix("socialPost/reply/")
+
+// DeletedPostKey is the prefix to retrieve the tombstones of deleted
+// SocialPost
+var DeletedPostKey = collections.NewPrefix("socialPost/deleted/")
+
+// SocialPostByAuthorKey is the prefix of the index of SocialPost by
+// (author, created at, index)
+var SocialPostByAuthorKey = collections.NewPrefix("socialPost/byAuthor/")
+
+// SocialPostByGroupKey is the prefix of the index of SocialPost by
+// (group id, created at, index)
+var SocialPostByGroupKey = collections.NewPrefix("socialPost/byGroup/")
+
+// SocialPostByTimeKey is the prefix of the index of SocialPost by
+// (created at, index)
+var SocialPostByTimeKey = collections.NewPrefix("socialPost/byTime/")
+
+// SocialPostByCreatorKey is the prefix of the index of SocialPost by
+// (creator, index)
+var SocialPostByCreatorKey = collections.NewPrefix("socialPost/byCreator/")
@@ -4,3 +4,14 @@ import "cosmossdk.io/collections"
 
 // SourceKey is the prefix to retrieve all Source
 var SourceKey = collections.NewPrefix("source/value/")
+
+// SourcePostKey is the prefix of the index of the posts citing a Source by
+// ((source index, created at), post index)
+var SourcePostKey = collections.NewPrefix("source/posts/")
+
+// SourceVoteKey is the prefix to retrieve all SourceVote by
+// (source index, voter)
+var SourceVoteKey = collections.NewPrefix("source/vote/")
+
+// MaxPostSources is the maximum number of sources cited by a post.
+const MaxPostSources = 10
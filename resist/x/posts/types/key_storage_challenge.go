@@ -0,0 +1,22 @@
+package types
+
+import "cosmossdk.io/collections"
+
+// StorageChallengeKey is the prefix to retrieve all StorageChallenge
+var StorageChallengeKey = collections.NewPrefix("storageChallenge/value/")
+
+// StorageChallengeCountKey is the prefix of the StorageChallenge id sequence
+var StorageChallengeCountKey = collections.NewPrefix("storageChallenge/count/")
+
+// StorageChallengeDeadlineKey is the prefix of the index of pending storage challenges by deadline
+var StorageChallengeDeadlineKey = collections.NewPrefix("storageChallenge/deadline/")
+
+// PendingStorageChallengeKey is the prefix of the index of pending storage challenges by replica
+var PendingStorageChallengeKey = collections.NewPrefix("storageChallenge/pending/")
+
+// Storage challenge statuses
+const (
+	ChallengeStatusPending = "pending"
+	ChallengeStatusPassed  = "passed"
+	ChallengeStatusFailed  = "failed"
+)
@@ -1,17 +1,115 @@
 package types
 
+import "fmt"
+
+// Default parameter values
+const (
+	// DefaultReplicaAckTimeout is the default number of seconds an assigned
+	// node has to acknowledge its replica.
+	DefaultReplicaAckTimeout int64 = 60 * 60
+	// DefaultAuditEpochBlocks is the default number of blocks between two
+	// rounds of storage challenges.
+	DefaultAuditEpochBlocks int64 = 100
+	// DefaultAuditChallengesPerEpoch is the default number of storage
+	// challenges issued each epoch.
+	DefaultAuditChallengesPerEpoch uint32 = 10
+	// DefaultAuditResponseBlocks is the default number of blocks a node has to
+	// answer a storage challenge.
+	DefaultAuditResponseBlocks int64 = 50
+	// DefaultAuditUptimePenalty is the default number of uptime percentage
+	// points lost per failed storage challenge.
+	DefaultAuditUptimePenalty uint64 = 5
+	// DefaultMaxOneTimePrekeys is the default number of one-time prekeys a
+	// node can have published at once.
+	DefaultMaxOneTimePrekeys uint64 = 100
+	// DefaultMaxSignalMessageBytes is the default maximum size of a message
+	// queued in a node mailbox.
+	DefaultMaxSignalMessageBytes uint64 = 64 << 10
+	// DefaultMaxMailboxBytes is the default maximum size of the messages
+	// queued in a node mailbox.
+	DefaultMaxMailboxBytes uint64 = 4 << 20
+	// DefaultMailboxTTLBlocks is the default number of blocks a message stays
+	// in a node mailbox, about a week with 6s blocks.
+	DefaultMailboxTTLBlocks int64 = 100_800
+	// DefaultMaxMailboxMessagesPerSender is the default maximum number of
+	// messages a node can have queued in another node mailbox, so that no
+	// sender fills it alone.
+	DefaultMaxMailboxMessagesPerSender uint64 = 16
+)
+
 // NewParams creates a new Params instance.
-func NewParams() Params {
-	return Params{}
+func NewParams(
+	replicaAckTimeout int64,
+	auditEpochBlocks int64,
+	auditChallengesPerEpoch uint32,
+	auditResponseBlocks int64,
+	auditUptimePenalty uint64,
+	maxOneTimePrekeys uint64,
+	maxSignalMessageBytes uint64,
+	maxMailboxBytes uint64,
+	mailboxTTLBlocks int64,
+	maxMailboxMessagesPerSender uint64,
+) Params {
+	return Params{
+		ReplicaAckTimeout:       replicaAckTimeout,
+		AuditEpochBlocks:        auditEpochBlocks,
+		AuditChallengesPerEpoch: auditChallengesPerEpoch,
+		AuditResponseBlocks:     auditResponseBlocks,
+		AuditUptimePenalty:      auditUptimePenalty,
+		MaxOneTimePrekeys:       maxOneTimePrekeys,
+		MaxSignalMessageBytes:   maxSignalMessageBytes,
+		MaxMailboxBytes:         maxMailboxBytes,
+		MailboxTtlBlocks:        mailboxTTLBlocks,
+
+		MaxMailboxMessagesPerSender: maxMailboxMessagesPerSender,
+	}
 }
 
 // DefaultParams returns a default set of parameters.
 func DefaultParams() Params {
-	return NewParams()
+	return NewParams(
+		DefaultReplicaAckTimeout,
+		DefaultAuditEpochBlocks,
+		DefaultAuditChallengesPerEpoch,
+		DefaultAuditResponseBlocks,
+		DefaultAuditUptimePenalty,
+		DefaultMaxOneTimePrekeys,
+		DefaultMaxSignalMessageBytes,
+		DefaultMaxMailboxBytes,
+		DefaultMailboxTTLBlocks,
+		DefaultMaxMailboxMessagesPerSender,
+	)
 }
 
 // Validate validates the set of params.
 func (p Params) Validate() error {
+	if p.ReplicaAckTimeout <= 0 {
+		return fmt.Errorf("replica ack timeout must be positive: %d", p.ReplicaAckTimeout)
+	}
+	if p.AuditEpochBlocks <= 0 {
+		return fmt.Errorf("audit epoch blocks must be positive: %d", p.AuditEpochBlocks)
+	}
+	if p.AuditResponseBlocks <= 0 {
+		return fmt.Errorf("audit response blocks must be positive: %d", p.AuditResponseBlocks)
+	}
+	if p.AuditUptimePenalty > 100 {
+		return fmt.Errorf("audit uptime penalty must not exceed 100: %d", p.AuditUptimePenalty)
+	}
+	if p.MaxOneTimePrekeys == 0 {
+		return fmt.Errorf("max one-time prekeys must be positive")
+	}
+	if p.MaxSignalMessageBytes == 0 {
+		return fmt.Errorf("max signal message bytes must be positive")
+	}
+	if p.MaxMailboxBytes < p.MaxSignalMessageBytes {
+		return fmt.Errorf("max mailbox bytes must be at least max signal message bytes: %d < %d", p.MaxMailboxBytes, p.MaxSignalMessageBytes)
+	}
+	if p.MailboxTtlBlocks <= 0 {
+		return fmt.Errorf("mailbox ttl blocks must be positive: %d", p.MailboxTtlBlocks)
+	}
+	if p.MaxMailboxMessagesPerSender == 0 {
+		return fmt.Errorf("max mailbox messages per sender must be positive")
+	}
 
 	return nil
 }
@@ -26,6 +26,36 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // Params defines the parameters for the module.
 type Params struct {
+	// replica_ack_timeout is the number of seconds an assigned node has to
+	// acknowledge that it stores its replica before it is replaced.
+	ReplicaAckTimeout int64 `protobuf:"varint,1,opt,name=replica_ack_timeout,json=replicaAckTimeout,proto3" json:"replica_ack_timeout,omitempty"`
+	// audit_epoch_blocks is the number of blocks between two rounds of storage
+	// challenges.
+	AuditEpochBlocks int64 `protobuf:"varint,2,opt,name=audit_epoch_blocks,json=auditEpochBlocks,proto3" json:"audit_epoch_blocks,omitempty"`
+	// audit_challenges_per_epoch is the number of storage challenges issued each
+	// epoch. Zero disables storage audits.
+	AuditChallengesPerEpoch uint32 `protobuf:"varint,3,opt,name=audit_challenges_per_epoch,json=auditChallengesPerEpoch,proto3" json:"audit_challenges_per_epoch,omitempty"`
+	// audit_response_blocks is the number of blocks a node has to answer a
+	// storage challenge.
+	AuditResponseBlocks int64 `protobuf:"varint,4,opt,name=audit_response_blocks,json=auditResponseBlocks,proto3" json:"audit_response_blocks,omitempty"`
+	// audit_uptime_penalty is the number of uptime percentage points a node
+	// loses for each failed storage challenge.
+	AuditUptimePenalty uint64 `protobuf:"varint,5,opt,name=audit_uptime_penalty,json=auditUptimePenalty,proto3" json:"audit_uptime_penalty,omitempty"`
+	// max_one_time_prekeys is the number of one-time prekeys a node can have
+	// published at once.
+	MaxOneTimePrekeys uint64 `protobuf:"varint,6,opt,name=max_one_time_prekeys,json=maxOneTimePrekeys,proto3" json:"max_one_time_prekeys,omitempty"`
+	// max_signal_message_bytes is the maximum size of a message queued in the
+	// mailbox of a node.
+	MaxSignalMessageBytes uint64 `protobuf:"varint,7,opt,name=max_signal_message_bytes,json=maxSignalMessageBytes,proto3" json:"max_signal_message_bytes,omitempty"`
+	// max_mailbox_bytes is the maximum size of the messages queued in the
+	// mailbox of a node.
+	MaxMailboxBytes uint64 `protobuf:"varint,8,opt,name=max_mailbox_bytes,json=maxMailboxBytes,proto3" json:"max_mailbox_bytes,omitempty"`
+	// mailbox_ttl_blocks is the number of blocks a message stays in the mailbox
+	// of a node before it is pruned if not acknowledged.
+	MailboxTtlBlocks int64 `protobuf:"varint,9,opt,name=mailbox_ttl_blocks,json=mailboxTtlBlocks,proto3" json:"mailbox_ttl_blocks,omitempty"`
+	// max_mailbox_messages_per_sender is the maximum number of messages a node
+	// can have queued in the mailbox of another node.
+	MaxMailboxMessagesPerSender uint64 `protobuf:"varint,10,opt,name=max_mailbox_messages_per_sender,json=maxMailboxMessagesPerSender,proto3" json:"max_mailbox_messages_per_sender,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -61,6 +91,76 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
+func (m *Params) GetReplicaAckTimeout() int64 {
+	if m != nil {
+		return m.ReplicaAckTimeout
+	}
+	return 0
+}
+
+func (m *Params) GetAuditEpochBlocks() int64 {
+	if m != nil {
+		return m.AuditEpochBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetAuditChallengesPerEpoch() uint32 {
+	if m != nil {
+		return m.AuditChallengesPerEpoch
+	}
+	return 0
+}
+
+func (m *Params) GetAuditResponseBlocks() int64 {
+	if m != nil {
+		return m.AuditResponseBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetAuditUptimePenalty() uint64 {
+	if m != nil {
+		return m.AuditUptimePenalty
+	}
+	return 0
+}
+
+func (m *Params) GetMaxOneTimePrekeys() uint64 {
+	if m != nil {
+		return m.MaxOneTimePrekeys
+	}
+	return 0
+}
+
+func (m *Params) GetMaxSignalMessageBytes() uint64 {
+	if m != nil {
+		return m.MaxSignalMessageBytes
+	}
+	return 0
+}
+
+func (m *Params) GetMaxMailboxBytes() uint64 {
+	if m != nil {
+		return m.MaxMailboxBytes
+	}
+	return 0
+}
+
+func (m *Params) GetMailboxTtlBlocks() int64 {
+	if m != nil {
+		return m.MailboxTtlBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetMaxMailboxMessagesPerSender() uint64 {
+	if m != nil {
+		return m.MaxMailboxMessagesPerSender
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "resist.posts.v1.Params")
 }
@@ -68,17 +168,36 @@ func init() {
 func init() { proto.RegisterFile("resist/posts/v1/params.proto", fileDescriptor_e0fd7825e28edb6e) }
 
 var fileDescriptor_e0fd7825e28edb6e = []byte{
-	// 155 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x29, 0x4a, 0x2d, 0xce,
-	0x2c, 0x2e, 0xd1, 0x2f, 0xc8, 0x2f, 0x2e, 0x29, 0xd6, 0x2f, 0x33, 0xd4, 0x2f, 0x48, 0x2c, 0x4a,
-	0xcc, 0x2d, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x87, 0xc8, 0xea, 0x81, 0x65, 0xf5,
-	0xca, 0x0c, 0xa5, 0x04, 0x13, 0x73, 0x33, 0xf3, 0xf2, 0xf5, 0xc1, 0x24, 0x44, 0x8d, 0x94, 0x48,
-	0x7a, 0x7e, 0x7a, 0x3e, 0x98, 0xa9, 0x0f, 0x62, 0x41, 0x44, 0x95, 0x34, 0xb8, 0xd8, 0x02, 0xc0,
-	0x26, 0x59, 0xc9, 0xbd, 0x58, 0x20, 0xcf, 0xd8, 0xf5, 0x7c, 0x83, 0x96, 0x28, 0xd4, 0xaa, 0x0a,
-	0xa8, 0x65, 0x10, 0x79, 0x27, 0xbd, 0x13, 0x8f, 0xe4, 0x18, 0x2f, 0x3c, 0x92, 0x63, 0x7c, 0xf0,
-	0x48, 0x8e, 0x71, 0xc2, 0x63, 0x39, 0x86, 0x0b, 0x8f, 0xe5, 0x18, 0x6e, 0x3c, 0x96, 0x63, 0x88,
-	0x12, 0x41, 0xd3, 0x50, 0x52, 0x59, 0x90, 0x5a, 0x9c, 0xc4, 0x06, 0xb6, 0xc0, 0x18, 0x10, 0x00,
-	0x00, 0xff, 0xff, 0x04, 0x0e, 0xb9, 0xef, 0xba, 0x00, 0x00, 0x00,
+	// 456 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x92, 0xcf, 0x6e, 0x13, 0x31,
+	0x10, 0xc6, 0xb3, 0x34, 0x04, 0xb0, 0x84, 0x4a, 0xdc, 0x44, 0xac, 0x02, 0xda, 0x46, 0x9c, 0xa2,
+	0xaa, 0xda, 0xa5, 0x70, 0x40, 0x82, 0x13, 0x01, 0x8e, 0x15, 0x51, 0x5a, 0x2e, 0x5c, 0x2c, 0x67,
+	0x3b, 0xda, 0xae, 0xb2, 0x5e, 0x5b, 0xb6, 0x53, 0x6d, 0x5e, 0x81, 0x13, 0x8f, 0xc0, 0x23, 0x20,
+	0xf1, 0x12, 0x1c, 0x7b, 0xe4, 0x88, 0x92, 0x03, 0x3c, 0x06, 0xda, 0x19, 0x07, 0x50, 0x2f, 0x96,
+	0x35, 0xbf, 0xef, 0x9b, 0x7f, 0x1a, 0xf6, 0xd8, 0x82, 0x2b, 0x9d, 0xcf, 0x8c, 0x76, 0xde, 0x65,
+	0x57, 0x27, 0x99, 0x91, 0x56, 0x2a, 0x97, 0x1a, 0xab, 0xbd, 0xe6, 0xfb, 0x44, 0x53, 0xa4, 0xe9,
+	0xd5, 0xc9, 0xa8, 0x2f, 0x55, 0x59, 0xeb, 0x0c, 0x5f, 0xd2, 0x8c, 0x06, 0x85, 0x2e, 0x34, 0x7e,
+	0xb3, 0xf6, 0x47, 0xd1, 0x27, 0xdf, 0xba, 0xac, 0x37, 0xc3, 0x54, 0x3c, 0x65, 0x07, 0x16, 0x4c,
+	0x55, 0xe6, 0x52, 0xc8, 0x7c, 0x29, 0x7c, 0xa9, 0x40, 0xaf, 0x7c, 0x1c, 0x8d, 0xa3, 0xc9, 0xde,
+	0xbc, 0x1f, 0xd0, 0xeb, 0x7c, 0x79, 0x4e, 0x80, 0x1f, 0x33, 0x2e, 0x57, 0x17, 0xa5, 0x17, 0x60,
+	0x74, 0x7e, 0x29, 0x16, 0x95, 0xce, 0x97, 0x2e, 0xbe, 0x85, 0xf2, 0x07, 0x48, 0xde, 0xb5, 0x60,
+	0x8a, 0x71, 0xfe, 0x8a, 0x8d, 0x48, 0x9d, 0x5f, 0xca, 0xaa, 0x82, 0xba, 0x00, 0x27, 0x0c, 0x58,
+	0x32, 0xc7, 0x7b, 0xe3, 0x68, 0x72, 0x7f, 0xfe, 0x10, 0x15, 0x6f, 0xfe, 0x0a, 0x66, 0x60, 0x31,
+	0x05, 0x7f, 0xc6, 0x86, 0x64, 0xb6, 0xe0, 0x8c, 0xae, 0x1d, 0xec, 0xaa, 0x75, 0xb1, 0xda, 0x01,
+	0xc2, 0x79, 0x60, 0xa1, 0xe0, 0x53, 0x36, 0x20, 0xcf, 0xca, 0xb4, 0xa3, 0x08, 0x03, 0xb5, 0xac,
+	0xfc, 0x3a, 0xbe, 0x3d, 0x8e, 0x26, 0xdd, 0x39, 0xb5, 0xfe, 0x01, 0xd1, 0x8c, 0x08, 0xcf, 0xd8,
+	0x40, 0xc9, 0x46, 0xe8, 0x1a, 0x04, 0x39, 0x2c, 0x2c, 0x61, 0xed, 0xe2, 0x1e, 0x3a, 0xfa, 0x4a,
+	0x36, 0xef, 0x6b, 0x68, 0xa7, 0x9f, 0x11, 0xe0, 0x2f, 0x58, 0xdc, 0x1a, 0x5c, 0x59, 0xd4, 0xb2,
+	0x12, 0x0a, 0x9c, 0x93, 0x05, 0x88, 0xc5, 0xda, 0x83, 0x8b, 0xef, 0xa0, 0x69, 0xa8, 0x64, 0x73,
+	0x86, 0xf8, 0x94, 0xe8, 0xb4, 0x85, 0xfc, 0x88, 0xb5, 0xd9, 0x84, 0x92, 0x65, 0xb5, 0xd0, 0x4d,
+	0x70, 0xdc, 0x45, 0xc7, 0xbe, 0x92, 0xcd, 0x29, 0xc5, 0x49, 0x7b, 0xcc, 0xf8, 0x4e, 0xe7, 0x7d,
+	0xb5, 0x1b, 0xfc, 0x1e, 0xad, 0x39, 0x90, 0x73, 0x5f, 0x85, 0xa9, 0xdf, 0xb2, 0xc3, 0xff, 0x33,
+	0x87, 0x9e, 0x68, 0xd5, 0x0e, 0xea, 0x0b, 0xb0, 0x31, 0xc3, 0x3a, 0x8f, 0xfe, 0xd5, 0x09, 0xad,
+	0xb5, 0xeb, 0x3e, 0x43, 0xc9, 0xcb, 0xe4, 0xf7, 0x97, 0xc3, 0xe8, 0xd3, 0xaf, 0xaf, 0x47, 0xc3,
+	0x70, 0x76, 0x4d, 0x38, 0x3c, 0x3a, 0x95, 0x69, 0xfa, 0x7d, 0x93, 0x44, 0xd7, 0x9b, 0x24, 0xfa,
+	0xb9, 0x49, 0xa2, 0xcf, 0xdb, 0xa4, 0x73, 0xbd, 0x4d, 0x3a, 0x3f, 0xb6, 0x49, 0xe7, 0xe3, 0xe0,
+	0x86, 0xc1, 0xaf, 0x0d, 0xb8, 0x45, 0x0f, 0x8f, 0xed, 0xf9, 0x9f, 0x00, 0x00, 0x00, 0xff, 0xff,
+	0x26, 0x77, 0x35, 0x0e, 0xc6, 0x02, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -100,6 +219,36 @@ func (this *Params) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
+	if this.ReplicaAckTimeout != that1.ReplicaAckTimeout {
+		return false
+	}
+	if this.AuditEpochBlocks != that1.AuditEpochBlocks {
+		return false
+	}
+	if this.AuditChallengesPerEpoch != that1.AuditChallengesPerEpoch {
+		return false
+	}
+	if this.AuditResponseBlocks != that1.AuditResponseBlocks {
+		return false
+	}
+	if this.AuditUptimePenalty != that1.AuditUptimePenalty {
+		return false
+	}
+	if this.MaxOneTimePrekeys != that1.MaxOneTimePrekeys {
+		return false
+	}
+	if this.MaxSignalMessageBytes != that1.MaxSignalMessageBytes {
+		return false
+	}
+	if this.MaxMailboxBytes != that1.MaxMailboxBytes {
+		return false
+	}
+	if this.MailboxTtlBlocks != that1.MailboxTtlBlocks {
+		return false
+	}
+	if this.MaxMailboxMessagesPerSender != that1.MaxMailboxMessagesPerSender {
+		return false
+	}
 	return true
 }
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -122,6 +271,56 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxMailboxMessagesPerSender != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxMailboxMessagesPerSender))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.MailboxTtlBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MailboxTtlBlocks))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.MaxMailboxBytes != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxMailboxBytes))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.MaxSignalMessageBytes != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxSignalMessageBytes))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.MaxOneTimePrekeys != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxOneTimePrekeys))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.AuditUptimePenalty != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.AuditUptimePenalty))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.AuditResponseBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.AuditResponseBlocks))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.AuditChallengesPerEpoch != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.AuditChallengesPerEpoch))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.AuditEpochBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.AuditEpochBlocks))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ReplicaAckTimeout != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ReplicaAckTimeout))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -142,6 +341,36 @@ func (m *Params) Size() (n int) {
 	}
 	var l int
 	_ = l
+	if m.ReplicaAckTimeout != 0 {
+		n += 1 + sovParams(uint64(m.ReplicaAckTimeout))
+	}
+	if m.AuditEpochBlocks != 0 {
+		n += 1 + sovParams(uint64(m.AuditEpochBlocks))
+	}
+	if m.AuditChallengesPerEpoch != 0 {
+		n += 1 + sovParams(uint64(m.AuditChallengesPerEpoch))
+	}
+	if m.AuditResponseBlocks != 0 {
+		n += 1 + sovParams(uint64(m.AuditResponseBlocks))
+	}
+	if m.AuditUptimePenalty != 0 {
+		n += 1 + sovParams(uint64(m.AuditUptimePenalty))
+	}
+	if m.MaxOneTimePrekeys != 0 {
+		n += 1 + sovParams(uint64(m.MaxOneTimePrekeys))
+	}
+	if m.MaxSignalMessageBytes != 0 {
+		n += 1 + sovParams(uint64(m.MaxSignalMessageBytes))
+	}
+	if m.MaxMailboxBytes != 0 {
+		n += 1 + sovParams(uint64(m.MaxMailboxBytes))
+	}
+	if m.MailboxTtlBlocks != 0 {
+		n += 1 + sovParams(uint64(m.MailboxTtlBlocks))
+	}
+	if m.MaxMailboxMessagesPerSender != 0 {
+		n += 1 + sovParams(uint64(m.MaxMailboxMessagesPerSender))
+	}
 	return n
 }
 
@@ -180,6 +409,196 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReplicaAckTimeout", wireType)
+			}
+			m.ReplicaAckTimeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReplicaAckTimeout |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuditEpochBlocks", wireType)
+			}
+			m.AuditEpochBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuditEpochBlocks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuditChallengesPerEpoch", wireType)
+			}
+			m.AuditChallengesPerEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuditChallengesPerEpoch |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuditResponseBlocks", wireType)
+			}
+			m.AuditResponseBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuditResponseBlocks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuditUptimePenalty", wireType)
+			}
+			m.AuditUptimePenalty = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuditUptimePenalty |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxOneTimePrekeys", wireType)
+			}
+			m.MaxOneTimePrekeys = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxOneTimePrekeys |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSignalMessageBytes", wireType)
+			}
+			m.MaxSignalMessageBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxSignalMessageBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMailboxBytes", wireType)
+			}
+			m.MaxMailboxBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxMailboxBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MailboxTtlBlocks", wireType)
+			}
+			m.MailboxTtlBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MailboxTtlBlocks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMailboxMessagesPerSender", wireType)
+			}
+			m.MaxMailboxMessagesPerSender = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxMailboxMessagesPerSender |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
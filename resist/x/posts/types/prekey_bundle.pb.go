@@ -0,0 +1,869 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: resist/posts/v1/prekey_bundle.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// PrekeyBundle holds the public keys a node publishes so that other nodes can
+// open an end-to-end encrypted channel with it through X3DH
+type PrekeyBundle struct {
+	NodeId                string `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	IdentityKey           []byte `protobuf:"bytes,2,opt,name=identity_key,json=identityKey,proto3" json:"identity_key,omitempty"`
+	SigningKey            []byte `protobuf:"bytes,3,opt,name=signing_key,json=signingKey,proto3" json:"signing_key,omitempty"`
+	SignedPrekeyId        uint32 `protobuf:"varint,4,opt,name=signed_prekey_id,json=signedPrekeyId,proto3" json:"signed_prekey_id,omitempty"`
+	SignedPrekey          []byte `protobuf:"bytes,5,opt,name=signed_prekey,json=signedPrekey,proto3" json:"signed_prekey,omitempty"`
+	SignedPrekeySignature []byte `protobuf:"bytes,6,opt,name=signed_prekey_signature,json=signedPrekeySignature,proto3" json:"signed_prekey_signature,omitempty"`
+	UpdatedAt             int64  `protobuf:"varint,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+}
+
+func (m *PrekeyBundle) Reset()         { *m = PrekeyBundle{} }
+func (m *PrekeyBundle) String() string { return proto.CompactTextString(m) }
+func (*PrekeyBundle) ProtoMessage()    {}
+func (*PrekeyBundle) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1080d08be20b8c16, []int{0}
+}
+func (m *PrekeyBundle) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PrekeyBundle) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PrekeyBundle.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PrekeyBundle) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PrekeyBundle.Merge(m, src)
+}
+func (m *PrekeyBundle) XXX_Size() int {
+	return m.Size()
+}
+func (m *PrekeyBundle) XXX_DiscardUnknown() {
+	xxx_messageInfo_PrekeyBundle.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PrekeyBundle proto.InternalMessageInfo
+
+func (m *PrekeyBundle) GetNodeId() string {
+	if m != nil {
+		return m.NodeId
+	}
+	return ""
+}
+
+func (m *PrekeyBundle) GetIdentityKey() []byte {
+	if m != nil {
+		return m.IdentityKey
+	}
+	return nil
+}
+
+func (m *PrekeyBundle) GetSigningKey() []byte {
+	if m != nil {
+		return m.SigningKey
+	}
+	return nil
+}
+
+func (m *PrekeyBundle) GetSignedPrekeyId() uint32 {
+	if m != nil {
+		return m.SignedPrekeyId
+	}
+	return 0
+}
+
+func (m *PrekeyBundle) GetSignedPrekey() []byte {
+	if m != nil {
+		return m.SignedPrekey
+	}
+	return nil
+}
+
+func (m *PrekeyBundle) GetSignedPrekeySignature() []byte {
+	if m != nil {
+		return m.SignedPrekeySignature
+	}
+	return nil
+}
+
+func (m *PrekeyBundle) GetUpdatedAt() int64 {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return 0
+}
+
+// OneTimePrekey is an X25519 prekey of a node, handed out to a single peer
+type OneTimePrekey struct {
+	NodeId string `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	Id     uint32 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	Key    []byte `protobuf:"bytes,3,opt,name=key,proto3" json:"key,omitempty"`
+}
+
+func (m *OneTimePrekey) Reset()         { *m = OneTimePrekey{} }
+func (m *OneTimePrekey) String() string { return proto.CompactTextString(m) }
+func (*OneTimePrekey) ProtoMessage()    {}
+func (*OneTimePrekey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1080d08be20b8c16, []int{1}
+}
+func (m *OneTimePrekey) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OneTimePrekey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OneTimePrekey.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OneTimePrekey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OneTimePrekey.Merge(m, src)
+}
+func (m *OneTimePrekey) XXX_Size() int {
+	return m.Size()
+}
+func (m *OneTimePrekey) XXX_DiscardUnknown() {
+	xxx_messageInfo_OneTimePrekey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OneTimePrekey proto.InternalMessageInfo
+
+func (m *OneTimePrekey) GetNodeId() string {
+	if m != nil {
+		return m.NodeId
+	}
+	return ""
+}
+
+func (m *OneTimePrekey) GetId() uint32 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *OneTimePrekey) GetKey() []byte {
+	if m != nil {
+		return m.Key
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*PrekeyBundle)(nil), "resist.posts.v1.PrekeyBundle")
+	proto.RegisterType((*OneTimePrekey)(nil), "resist.posts.v1.OneTimePrekey")
+}
+
+func init() {
+	proto.RegisterFile("resist/posts/v1/prekey_bundle.proto", fileDescriptor_1080d08be20b8c16)
+}
+
+var fileDescriptor_1080d08be20b8c16 = []byte{
+	// 315 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x91, 0xc1, 0x4e, 0xf2, 0x40,
+	0x14, 0x85, 0x99, 0xf2, 0xff, 0x10, 0x2e, 0x2d, 0x92, 0x89, 0x86, 0x6e, 0xac, 0x15, 0x36, 0x5d,
+	0x95, 0x10, 0x13, 0xf7, 0xb2, 0x43, 0x17, 0x9a, 0xea, 0xca, 0x4d, 0x03, 0xb9, 0x37, 0x64, 0x82,
+	0x4e, 0x9b, 0xce, 0x40, 0xec, 0x5b, 0xe8, 0x5b, 0xb9, 0x64, 0xe9, 0xd2, 0xc0, 0x8b, 0x98, 0x0e,
+	0x43, 0x84, 0x85, 0xbb, 0x39, 0x67, 0xbe, 0xdc, 0xb9, 0x73, 0x0e, 0x0c, 0x0a, 0x52, 0x42, 0xe9,
+	0x61, 0x9e, 0x29, 0xad, 0x86, 0xab, 0xd1, 0x30, 0x2f, 0x68, 0x41, 0x65, 0x3a, 0x5b, 0x4a, 0x7c,
+	0xa1, 0x38, 0x2f, 0x32, 0x9d, 0xf1, 0x93, 0x1d, 0x14, 0x1b, 0x28, 0x5e, 0x8d, 0xfa, 0x1f, 0x0e,
+	0xb8, 0x0f, 0x06, 0x1c, 0x1b, 0x8e, 0xf7, 0xa0, 0x29, 0x33, 0xa4, 0x54, 0xa0, 0xcf, 0x42, 0x16,
+	0xb5, 0x92, 0x46, 0x25, 0x27, 0xc8, 0x2f, 0xc1, 0x15, 0x48, 0x52, 0x0b, 0x5d, 0xa6, 0x0b, 0x2a,
+	0x7d, 0x27, 0x64, 0x91, 0x9b, 0xb4, 0xf7, 0xde, 0x1d, 0x95, 0xfc, 0x02, 0xda, 0x4a, 0xcc, 0xa5,
+	0x90, 0x73, 0x43, 0xd4, 0x0d, 0x01, 0xd6, 0xaa, 0x80, 0x08, 0xba, 0x95, 0x22, 0x4c, 0xed, 0x72,
+	0x02, 0xfd, 0x7f, 0x21, 0x8b, 0xbc, 0xa4, 0xb3, 0xf3, 0x77, 0xab, 0x4c, 0x90, 0x0f, 0xc0, 0x3b,
+	0x22, 0xfd, 0xff, 0x66, 0x98, 0x7b, 0x88, 0xf1, 0x6b, 0xe8, 0x1d, 0x8f, 0xab, 0xd4, 0x54, 0x2f,
+	0x0b, 0xf2, 0x1b, 0x06, 0x3f, 0x3b, 0xc4, 0x1f, 0xf7, 0x97, 0xfc, 0x1c, 0x60, 0x99, 0xe3, 0x54,
+	0x13, 0xa6, 0x53, 0xed, 0x37, 0x43, 0x16, 0xd5, 0x93, 0x96, 0x75, 0x6e, 0x74, 0xff, 0x16, 0xbc,
+	0x7b, 0x49, 0x4f, 0xe2, 0x95, 0xec, 0x3b, 0x7f, 0x66, 0xd2, 0x01, 0x47, 0xa0, 0x49, 0xc2, 0x4b,
+	0x1c, 0x81, 0xbc, 0x0b, 0xf5, 0xdf, 0x8f, 0x57, 0xc7, 0x71, 0xfc, 0xb9, 0x09, 0xd8, 0x7a, 0x13,
+	0xb0, 0xef, 0x4d, 0xc0, 0xde, 0xb7, 0x41, 0x6d, 0xbd, 0x0d, 0x6a, 0x5f, 0xdb, 0xa0, 0xf6, 0x7c,
+	0x6a, 0xfb, 0x7a, 0xb3, 0x8d, 0xe9, 0x32, 0x27, 0x35, 0x6b, 0x98, 0x9e, 0xae, 0x7e, 0x02, 0x00,
+	0x00, 0xff, 0xff, 0x64, 0x14, 0xcb, 0x8c, 0xce, 0x01, 0x00, 0x00,
+}
+
+func (m *PrekeyBundle) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PrekeyBundle) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PrekeyBundle) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UpdatedAt != 0 {
+		i = encodeVarintPrekeyBundle(dAtA, i, uint64(m.UpdatedAt))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.SignedPrekeySignature) > 0 {
+		i -= len(m.SignedPrekeySignature)
+		copy(dAtA[i:], m.SignedPrekeySignature)
+		i = encodeVarintPrekeyBundle(dAtA, i, uint64(len(m.SignedPrekeySignature)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.SignedPrekey) > 0 {
+		i -= len(m.SignedPrekey)
+		copy(dAtA[i:], m.SignedPrekey)
+		i = encodeVarintPrekeyBundle(dAtA, i, uint64(len(m.SignedPrekey)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.SignedPrekeyId != 0 {
+		i = encodeVarintPrekeyBundle(dAtA, i, uint64(m.SignedPrekeyId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.SigningKey) > 0 {
+		i -= len(m.SigningKey)
+		copy(dAtA[i:], m.SigningKey)
+		i = encodeVarintPrekeyBundle(dAtA, i, uint64(len(m.SigningKey)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.IdentityKey) > 0 {
+		i -= len(m.IdentityKey)
+		copy(dAtA[i:], m.IdentityKey)
+		i = encodeVarintPrekeyBundle(dAtA, i, uint64(len(m.IdentityKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.NodeId) > 0 {
+		i -= len(m.NodeId)
+		copy(dAtA[i:], m.NodeId)
+		i = encodeVarintPrekeyBundle(dAtA, i, uint64(len(m.NodeId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OneTimePrekey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OneTimePrekey) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OneTimePrekey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintPrekeyBundle(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Id != 0 {
+		i = encodeVarintPrekeyBundle(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.NodeId) > 0 {
+		i -= len(m.NodeId)
+		copy(dAtA[i:], m.NodeId)
+		i = encodeVarintPrekeyBundle(dAtA, i, uint64(len(m.NodeId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintPrekeyBundle(dAtA []byte, offset int, v uint64) int {
+	offset -= sovPrekeyBundle(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *PrekeyBundle) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NodeId)
+	if l > 0 {
+		n += 1 + l + sovPrekeyBundle(uint64(l))
+	}
+	l = len(m.IdentityKey)
+	if l > 0 {
+		n += 1 + l + sovPrekeyBundle(uint64(l))
+	}
+	l = len(m.SigningKey)
+	if l > 0 {
+		n += 1 + l + sovPrekeyBundle(uint64(l))
+	}
+	if m.SignedPrekeyId != 0 {
+		n += 1 + sovPrekeyBundle(uint64(m.SignedPrekeyId))
+	}
+	l = len(m.SignedPrekey)
+	if l > 0 {
+		n += 1 + l + sovPrekeyBundle(uint64(l))
+	}
+	l = len(m.SignedPrekeySignature)
+	if l > 0 {
+		n += 1 + l + sovPrekeyBundle(uint64(l))
+	}
+	if m.UpdatedAt != 0 {
+		n += 1 + sovPrekeyBundle(uint64(m.UpdatedAt))
+	}
+	return n
+}
+
+func (m *OneTimePrekey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NodeId)
+	if l > 0 {
+		n += 1 + l + sovPrekeyBundle(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovPrekeyBundle(uint64(m.Id))
+	}
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovPrekeyBundle(uint64(l))
+	}
+	return n
+}
+
+func sovPrekeyBundle(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozPrekeyBundle(x uint64) (n int) {
+	return sovPrekeyBundle(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *PrekeyBundle) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPrekeyBundle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PrekeyBundle: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PrekeyBundle: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPrekeyBundle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPrekeyBundle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPrekeyBundle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NodeId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IdentityKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPrekeyBundle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPrekeyBundle
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPrekeyBundle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IdentityKey = append(m.IdentityKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.IdentityKey == nil {
+				m.IdentityKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SigningKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPrekeyBundle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPrekeyBundle
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPrekeyBundle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SigningKey = append(m.SigningKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.SigningKey == nil {
+				m.SigningKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignedPrekeyId", wireType)
+			}
+			m.SignedPrekeyId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPrekeyBundle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignedPrekeyId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignedPrekey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPrekeyBundle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPrekeyBundle
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPrekeyBundle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SignedPrekey = append(m.SignedPrekey[:0], dAtA[iNdEx:postIndex]...)
+			if m.SignedPrekey == nil {
+				m.SignedPrekey = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignedPrekeySignature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPrekeyBundle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPrekeyBundle
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPrekeyBundle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SignedPrekeySignature = append(m.SignedPrekeySignature[:0], dAtA[iNdEx:postIndex]...)
+			if m.SignedPrekeySignature == nil {
+				m.SignedPrekeySignature = []byte{}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+			}
+			m.UpdatedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPrekeyBundle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpdatedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPrekeyBundle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPrekeyBundle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OneTimePrekey) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPrekeyBundle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OneTimePrekey: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OneTimePrekey: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPrekeyBundle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPrekeyBundle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPrekeyBundle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NodeId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPrekeyBundle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPrekeyBundle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPrekeyBundle
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPrekeyBundle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
+			if m.Key == nil {
+				m.Key = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPrekeyBundle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPrekeyBundle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipPrekeyBundle(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowPrekeyBundle
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPrekeyBundle
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPrekeyBundle
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthPrekeyBundle
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupPrekeyBundle
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthPrekeyBundle
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthPrekeyBundle        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowPrekeyBundle          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupPrekeyBundle = fmt.Errorf("proto: unexpected end of group")
+)
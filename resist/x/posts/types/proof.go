@@ -0,0 +1,93 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+)
+
+// A chunk proof is the list of dag-pb nodes on the path from the content root
+// to the raw leaf holding the chunk. Each node is checked against the CID
+// linking to it, so a valid proof ties the chunk to the content's root CID.
+
+// BuildChunkProof returns the chunk at chunkIndex of the content together with
+// the proof that it belongs to the content's DAG.
+func BuildChunkProof(data []byte, chunkIndex uint64) ([]byte, [][]byte, error) {
+	dag, err := BuildContentDAG(data, DefaultChunkSize)
+	if err != nil {
+		return nil, nil, err
+	}
+	blocks := make(map[string][]byte, len(dag.Blocks))
+	for _, block := range dag.Blocks {
+		blocks[block.Cid] = block.Data
+	}
+
+	var proof [][]byte
+	cid, offset := dag.Root, chunkIndex*DefaultChunkSize
+	for {
+		codec, _, err := DecodeCID(cid)
+		if err != nil {
+			return nil, nil, err
+		}
+		if codec == RawCodec {
+			break
+		}
+		node := blocks[cid]
+		proof = append(proof, node)
+		if cid, offset, err = childContaining(node, offset); err != nil {
+			return nil, nil, err
+		}
+	}
+	if offset != 0 {
+		return nil, nil, fmt.Errorf("chunk index %d out of range", chunkIndex)
+	}
+	return blocks[cid], proof, nil
+}
+
+// VerifyChunkProof checks that chunk is the chunk at chunkIndex of the content
+// addressed by root.
+func VerifyChunkProof(root string, chunkIndex uint64, chunk []byte, proof [][]byte) error {
+	cid, offset := root, chunkIndex*DefaultChunkSize
+	for _, node := range proof {
+		codec, _, err := DecodeCID(cid)
+		if err != nil {
+			return err
+		}
+		if codec != DagPbCodec {
+			return errors.New("proof is longer than the path to the chunk")
+		}
+		if err := VerifyBlock(cid, node); err != nil {
+			return err
+		}
+		if cid, offset, err = childContaining(node, offset); err != nil {
+			return err
+		}
+	}
+
+	codec, _, err := DecodeCID(cid)
+	if err != nil {
+		return err
+	}
+	if codec != RawCodec {
+		return errors.New("proof does not reach a chunk")
+	}
+	if offset != 0 {
+		return fmt.Errorf("chunk index %d out of range", chunkIndex)
+	}
+	return VerifyBlock(cid, chunk)
+}
+
+// childContaining returns the child of a file node holding the byte at offset
+// and the offset relative to that child.
+func childContaining(node []byte, offset uint64) (string, uint64, error) {
+	links, err := DecodeFileNode(node)
+	if err != nil {
+		return "", 0, err
+	}
+	for _, link := range links {
+		if offset < link.FileSize {
+			return link.Cid, offset, nil
+		}
+		offset -= link.FileSize
+	}
+	return "", 0, errors.New("offset out of range")
+}
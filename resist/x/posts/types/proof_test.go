@@ -0,0 +1,59 @@
+package types_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"resist/x/posts/types"
+)
+
+func TestChunkProof(t *testing.T) {
+	t.Run("single chunk", func(t *testing.T) {
+		data := []byte("hello world")
+		root, err := types.ComputeContentCID(data)
+		require.NoError(t, err)
+
+		chunk, proof, err := types.BuildChunkProof(data, 0)
+		require.NoError(t, err)
+		require.Equal(t, data, chunk)
+		require.Empty(t, proof)
+		require.NoError(t, types.VerifyChunkProof(root, 0, chunk, proof))
+
+		_, _, err = types.BuildChunkProof(data, 1)
+		require.Error(t, err)
+		require.Error(t, types.VerifyChunkProof(root, 0, []byte("hello there"), nil))
+	})
+
+	t.Run("two levels", func(t *testing.T) {
+		// One chunk more than a single node can link to
+		chunks := types.MaxLinksPerNode + 1
+		data := make([]byte, 0, chunks*types.DefaultChunkSize)
+		for i := range chunks {
+			data = append(data, bytes.Repeat([]byte{byte(i)}, types.DefaultChunkSize)...)
+		}
+		data = data[:len(data)-10]
+		root, err := types.ComputeContentCID(data)
+		require.NoError(t, err)
+
+		for _, index := range []uint64{0, 1, types.MaxLinksPerNode - 1, types.MaxLinksPerNode} {
+			chunk, proof, err := types.BuildChunkProof(data, index)
+			require.NoError(t, err)
+			require.Len(t, proof, 2)
+			require.Equal(t, data[index*types.DefaultChunkSize:min((index+1)*types.DefaultChunkSize, uint64(len(data)))], chunk)
+			require.NoError(t, types.VerifyChunkProof(root, index, chunk, proof))
+
+			// The proof of a chunk does not prove any other chunk
+			require.Error(t, types.VerifyChunkProof(root, (index+1)%uint64(chunks), chunk, proof))
+			// Proofs cannot be truncated or tampered with
+			require.Error(t, types.VerifyChunkProof(root, index, chunk, proof[:1]))
+			tampered := append([]byte(nil), proof[1]...)
+			tampered[len(tampered)-1] ^= 1
+			require.Error(t, types.VerifyChunkProof(root, index, chunk, [][]byte{proof[0], tampered}))
+		}
+
+		_, _, err = types.BuildChunkProof(data, uint64(chunks))
+		require.Error(t, err)
+	})
+}
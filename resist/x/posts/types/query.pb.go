@@ -302,23 +302,27 @@ func (m *QueryAllSocialPostResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
-// QueryGetVoteRequest defines the QueryGetVoteRequest message.
-type QueryGetVoteRequest struct {
+// QueryGetThreadRequest defines the QueryGetThreadRequest message.
+type QueryGetThreadRequest struct {
 	Index string `protobuf:"bytes,1,opt,name=index,proto3" json:"index,omitempty"`
+	// max_depth is the depth of the returned reply tree, the direct replies
+	// being at depth 1. It defaults to 3 and is capped at 5.
+	MaxDepth   uint32             `protobuf:"varint,2,opt,name=max_depth,json=maxDepth,proto3" json:"max_depth,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryGetVoteRequest) Reset()         { *m = QueryGetVoteRequest{} }
-func (m *QueryGetVoteRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryGetVoteRequest) ProtoMessage()    {}
-func (*QueryGetVoteRequest) Descriptor() ([]byte, []int) {
+func (m *QueryGetThreadRequest) Reset()         { *m = QueryGetThreadRequest{} }
+func (m *QueryGetThreadRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetThreadRequest) ProtoMessage()    {}
+func (*QueryGetThreadRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_599c3295223c14f2, []int{6}
 }
-func (m *QueryGetVoteRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryGetThreadRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryGetVoteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryGetThreadRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryGetVoteRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryGetThreadRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -328,42 +332,65 @@ func (m *QueryGetVoteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *QueryGetVoteRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryGetVoteRequest.Merge(m, src)
+func (m *QueryGetThreadRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetThreadRequest.Merge(m, src)
 }
-func (m *QueryGetVoteRequest) XXX_Size() int {
+func (m *QueryGetThreadRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryGetVoteRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryGetVoteRequest.DiscardUnknown(m)
+func (m *QueryGetThreadRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetThreadRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryGetVoteRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryGetThreadRequest proto.InternalMessageInfo
 
-func (m *QueryGetVoteRequest) GetIndex() string {
+func (m *QueryGetThreadRequest) GetIndex() string {
 	if m != nil {
 		return m.Index
 	}
 	return ""
 }
 
-// QueryGetVoteResponse defines the QueryGetVoteResponse message.
-type QueryGetVoteResponse struct {
-	Vote Vote `protobuf:"bytes,1,opt,name=vote,proto3" json:"vote"`
+func (m *QueryGetThreadRequest) GetMaxDepth() uint32 {
+	if m != nil {
+		return m.MaxDepth
+	}
+	return 0
 }
 
-func (m *QueryGetVoteResponse) Reset()         { *m = QueryGetVoteResponse{} }
-func (m *QueryGetVoteResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryGetVoteResponse) ProtoMessage()    {}
-func (*QueryGetVoteResponse) Descriptor() ([]byte, []int) {
+func (m *QueryGetThreadRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryGetThreadResponse defines the QueryGetThreadResponse message. A page
+// holds at most 200 direct replies. Replies below the direct replies are
+// limited to the first 20 of each post, and to 200 in total; a reply with a
+// reply_count above its number of returned replies can be queried with its
+// own thread.
+type QueryGetThreadResponse struct {
+	Post       SocialPost          `protobuf:"bytes,1,opt,name=post,proto3" json:"post"`
+	Replies    []ThreadNode        `protobuf:"bytes,2,rep,name=replies,proto3" json:"replies"`
+	Pagination *query.PageResponse `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// deleted is set when the post was deleted, post then only keeping its
+	// place in the thread and its sources.
+	Deleted bool `protobuf:"varint,4,opt,name=deleted,proto3" json:"deleted,omitempty"`
+}
+
+func (m *QueryGetThreadResponse) Reset()         { *m = QueryGetThreadResponse{} }
+func (m *QueryGetThreadResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetThreadResponse) ProtoMessage()    {}
+func (*QueryGetThreadResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_599c3295223c14f2, []int{7}
 }
-func (m *QueryGetVoteResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryGetThreadResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryGetVoteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryGetThreadResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryGetVoteResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryGetThreadResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -373,42 +400,65 @@ func (m *QueryGetVoteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return b[:n], nil
 	}
 }
-func (m *QueryGetVoteResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryGetVoteResponse.Merge(m, src)
+func (m *QueryGetThreadResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetThreadResponse.Merge(m, src)
 }
-func (m *QueryGetVoteResponse) XXX_Size() int {
+func (m *QueryGetThreadResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryGetVoteResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryGetVoteResponse.DiscardUnknown(m)
+func (m *QueryGetThreadResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetThreadResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryGetVoteResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryGetThreadResponse proto.InternalMessageInfo
 
-func (m *QueryGetVoteResponse) GetVote() Vote {
+func (m *QueryGetThreadResponse) GetPost() SocialPost {
 	if m != nil {
-		return m.Vote
+		return m.Post
 	}
-	return Vote{}
+	return SocialPost{}
 }
 
-// QueryAllVoteRequest defines the QueryAllVoteRequest message.
-type QueryAllVoteRequest struct {
-	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+func (m *QueryGetThreadResponse) GetReplies() []ThreadNode {
+	if m != nil {
+		return m.Replies
+	}
+	return nil
 }
 
-func (m *QueryAllVoteRequest) Reset()         { *m = QueryAllVoteRequest{} }
-func (m *QueryAllVoteRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryAllVoteRequest) ProtoMessage()    {}
-func (*QueryAllVoteRequest) Descriptor() ([]byte, []int) {
+func (m *QueryGetThreadResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+func (m *QueryGetThreadResponse) GetDeleted() bool {
+	if m != nil {
+		return m.Deleted
+	}
+	return false
+}
+
+// QueryListPostsByAuthorRequest defines the QueryListPostsByAuthorRequest
+// message. Set pagination.reverse to list the newest posts first.
+type QueryListPostsByAuthorRequest struct {
+	Author     string             `protobuf:"bytes,1,opt,name=author,proto3" json:"author,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryListPostsByAuthorRequest) Reset()         { *m = QueryListPostsByAuthorRequest{} }
+func (m *QueryListPostsByAuthorRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryListPostsByAuthorRequest) ProtoMessage()    {}
+func (*QueryListPostsByAuthorRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_599c3295223c14f2, []int{8}
 }
-func (m *QueryAllVoteRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryListPostsByAuthorRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryAllVoteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryListPostsByAuthorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryAllVoteRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryListPostsByAuthorRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -418,43 +468,51 @@ func (m *QueryAllVoteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *QueryAllVoteRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryAllVoteRequest.Merge(m, src)
+func (m *QueryListPostsByAuthorRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryListPostsByAuthorRequest.Merge(m, src)
 }
-func (m *QueryAllVoteRequest) XXX_Size() int {
+func (m *QueryListPostsByAuthorRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryAllVoteRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryAllVoteRequest.DiscardUnknown(m)
+func (m *QueryListPostsByAuthorRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryListPostsByAuthorRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryAllVoteRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryListPostsByAuthorRequest proto.InternalMessageInfo
 
-func (m *QueryAllVoteRequest) GetPagination() *query.PageRequest {
+func (m *QueryListPostsByAuthorRequest) GetAuthor() string {
+	if m != nil {
+		return m.Author
+	}
+	return ""
+}
+
+func (m *QueryListPostsByAuthorRequest) GetPagination() *query.PageRequest {
 	if m != nil {
 		return m.Pagination
 	}
 	return nil
 }
 
-// QueryAllVoteResponse defines the QueryAllVoteResponse message.
-type QueryAllVoteResponse struct {
-	Vote       []Vote              `protobuf:"bytes,1,rep,name=vote,proto3" json:"vote"`
+// QueryListPostsByAuthorResponse defines the QueryListPostsByAuthorResponse
+// message.
+type QueryListPostsByAuthorResponse struct {
+	SocialPost []SocialPost        `protobuf:"bytes,1,rep,name=social_post,json=socialPost,proto3" json:"social_post"`
 	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryAllVoteResponse) Reset()         { *m = QueryAllVoteResponse{} }
-func (m *QueryAllVoteResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryAllVoteResponse) ProtoMessage()    {}
-func (*QueryAllVoteResponse) Descriptor() ([]byte, []int) {
+func (m *QueryListPostsByAuthorResponse) Reset()         { *m = QueryListPostsByAuthorResponse{} }
+func (m *QueryListPostsByAuthorResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryListPostsByAuthorResponse) ProtoMessage()    {}
+func (*QueryListPostsByAuthorResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_599c3295223c14f2, []int{9}
 }
-func (m *QueryAllVoteResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryListPostsByAuthorResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryAllVoteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryListPostsByAuthorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryAllVoteResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryListPostsByAuthorResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -464,49 +522,51 @@ func (m *QueryAllVoteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return b[:n], nil
 	}
 }
-func (m *QueryAllVoteResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryAllVoteResponse.Merge(m, src)
+func (m *QueryListPostsByAuthorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryListPostsByAuthorResponse.Merge(m, src)
 }
-func (m *QueryAllVoteResponse) XXX_Size() int {
+func (m *QueryListPostsByAuthorResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryAllVoteResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryAllVoteResponse.DiscardUnknown(m)
+func (m *QueryListPostsByAuthorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryListPostsByAuthorResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryAllVoteResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryListPostsByAuthorResponse proto.InternalMessageInfo
 
-func (m *QueryAllVoteResponse) GetVote() []Vote {
+func (m *QueryListPostsByAuthorResponse) GetSocialPost() []SocialPost {
 	if m != nil {
-		return m.Vote
+		return m.SocialPost
 	}
 	return nil
 }
 
-func (m *QueryAllVoteResponse) GetPagination() *query.PageResponse {
+func (m *QueryListPostsByAuthorResponse) GetPagination() *query.PageResponse {
 	if m != nil {
 		return m.Pagination
 	}
 	return nil
 }
 
-// QueryGetSourceRequest defines the QueryGetSourceRequest message.
-type QueryGetSourceRequest struct {
-	Index string `protobuf:"bytes,1,opt,name=index,proto3" json:"index,omitempty"`
+// QueryListPostsByGroupRequest defines the QueryListPostsByGroupRequest
+// message. Set pagination.reverse to list the newest posts first.
+type QueryListPostsByGroupRequest struct {
+	GroupId    uint64             `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryGetSourceRequest) Reset()         { *m = QueryGetSourceRequest{} }
-func (m *QueryGetSourceRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryGetSourceRequest) ProtoMessage()    {}
-func (*QueryGetSourceRequest) Descriptor() ([]byte, []int) {
+func (m *QueryListPostsByGroupRequest) Reset()         { *m = QueryListPostsByGroupRequest{} }
+func (m *QueryListPostsByGroupRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryListPostsByGroupRequest) ProtoMessage()    {}
+func (*QueryListPostsByGroupRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_599c3295223c14f2, []int{10}
 }
-func (m *QueryGetSourceRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryListPostsByGroupRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryGetSourceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryListPostsByGroupRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryGetSourceRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryListPostsByGroupRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -516,42 +576,51 @@ func (m *QueryGetSourceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return b[:n], nil
 	}
 }
-func (m *QueryGetSourceRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryGetSourceRequest.Merge(m, src)
+func (m *QueryListPostsByGroupRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryListPostsByGroupRequest.Merge(m, src)
 }
-func (m *QueryGetSourceRequest) XXX_Size() int {
+func (m *QueryListPostsByGroupRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryGetSourceRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryGetSourceRequest.DiscardUnknown(m)
+func (m *QueryListPostsByGroupRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryListPostsByGroupRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryGetSourceRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryListPostsByGroupRequest proto.InternalMessageInfo
 
-func (m *QueryGetSourceRequest) GetIndex() string {
+func (m *QueryListPostsByGroupRequest) GetGroupId() uint64 {
 	if m != nil {
-		return m.Index
+		return m.GroupId
 	}
-	return ""
+	return 0
 }
 
-// QueryGetSourceResponse defines the QueryGetSourceResponse message.
-type QueryGetSourceResponse struct {
-	Source Source `protobuf:"bytes,1,opt,name=source,proto3" json:"source"`
+func (m *QueryListPostsByGroupRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryGetSourceResponse) Reset()         { *m = QueryGetSourceResponse{} }
-func (m *QueryGetSourceResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryGetSourceResponse) ProtoMessage()    {}
-func (*QueryGetSourceResponse) Descriptor() ([]byte, []int) {
+// QueryListPostsByGroupResponse defines the QueryListPostsByGroupResponse
+// message.
+type QueryListPostsByGroupResponse struct {
+	SocialPost []SocialPost        `protobuf:"bytes,1,rep,name=social_post,json=socialPost,proto3" json:"social_post"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryListPostsByGroupResponse) Reset()         { *m = QueryListPostsByGroupResponse{} }
+func (m *QueryListPostsByGroupResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryListPostsByGroupResponse) ProtoMessage()    {}
+func (*QueryListPostsByGroupResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_599c3295223c14f2, []int{11}
 }
-func (m *QueryGetSourceResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryListPostsByGroupResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryGetSourceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryListPostsByGroupResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryGetSourceResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryListPostsByGroupResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -561,42 +630,52 @@ func (m *QueryGetSourceResponse) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return b[:n], nil
 	}
 }
-func (m *QueryGetSourceResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryGetSourceResponse.Merge(m, src)
+func (m *QueryListPostsByGroupResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryListPostsByGroupResponse.Merge(m, src)
 }
-func (m *QueryGetSourceResponse) XXX_Size() int {
+func (m *QueryListPostsByGroupResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryGetSourceResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryGetSourceResponse.DiscardUnknown(m)
+func (m *QueryListPostsByGroupResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryListPostsByGroupResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryGetSourceResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryListPostsByGroupResponse proto.InternalMessageInfo
 
-func (m *QueryGetSourceResponse) GetSource() Source {
+func (m *QueryListPostsByGroupResponse) GetSocialPost() []SocialPost {
 	if m != nil {
-		return m.Source
+		return m.SocialPost
 	}
-	return Source{}
+	return nil
 }
 
-// QueryAllSourceRequest defines the QueryAllSourceRequest message.
-type QueryAllSourceRequest struct {
-	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+func (m *QueryListPostsByGroupResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryAllSourceRequest) Reset()         { *m = QueryAllSourceRequest{} }
-func (m *QueryAllSourceRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryAllSourceRequest) ProtoMessage()    {}
-func (*QueryAllSourceRequest) Descriptor() ([]byte, []int) {
+// QueryListPostsSinceRequest defines the QueryListPostsSinceRequest message.
+// since is a unix time in seconds. Set pagination.reverse to list the newest
+// posts first.
+type QueryListPostsSinceRequest struct {
+	Since      uint64             `protobuf:"varint,1,opt,name=since,proto3" json:"since,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryListPostsSinceRequest) Reset()         { *m = QueryListPostsSinceRequest{} }
+func (m *QueryListPostsSinceRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryListPostsSinceRequest) ProtoMessage()    {}
+func (*QueryListPostsSinceRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_599c3295223c14f2, []int{12}
 }
-func (m *QueryAllSourceRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryListPostsSinceRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryAllSourceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryListPostsSinceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryAllSourceRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryListPostsSinceRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -606,43 +685,50 @@ func (m *QueryAllSourceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return b[:n], nil
 	}
 }
-func (m *QueryAllSourceRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryAllSourceRequest.Merge(m, src)
+func (m *QueryListPostsSinceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryListPostsSinceRequest.Merge(m, src)
 }
-func (m *QueryAllSourceRequest) XXX_Size() int {
+func (m *QueryListPostsSinceRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryAllSourceRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryAllSourceRequest.DiscardUnknown(m)
+func (m *QueryListPostsSinceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryListPostsSinceRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryAllSourceRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryListPostsSinceRequest proto.InternalMessageInfo
 
-func (m *QueryAllSourceRequest) GetPagination() *query.PageRequest {
+func (m *QueryListPostsSinceRequest) GetSince() uint64 {
+	if m != nil {
+		return m.Since
+	}
+	return 0
+}
+
+func (m *QueryListPostsSinceRequest) GetPagination() *query.PageRequest {
 	if m != nil {
 		return m.Pagination
 	}
 	return nil
 }
 
-// QueryAllSourceResponse defines the QueryAllSourceResponse message.
-type QueryAllSourceResponse struct {
-	Source     []Source            `protobuf:"bytes,1,rep,name=source,proto3" json:"source"`
+// QueryListPostsSinceResponse defines the QueryListPostsSinceResponse message.
+type QueryListPostsSinceResponse struct {
+	SocialPost []SocialPost        `protobuf:"bytes,1,rep,name=social_post,json=socialPost,proto3" json:"social_post"`
 	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryAllSourceResponse) Reset()         { *m = QueryAllSourceResponse{} }
-func (m *QueryAllSourceResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryAllSourceResponse) ProtoMessage()    {}
-func (*QueryAllSourceResponse) Descriptor() ([]byte, []int) {
+func (m *QueryListPostsSinceResponse) Reset()         { *m = QueryListPostsSinceResponse{} }
+func (m *QueryListPostsSinceResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryListPostsSinceResponse) ProtoMessage()    {}
+func (*QueryListPostsSinceResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_599c3295223c14f2, []int{13}
 }
-func (m *QueryAllSourceResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryListPostsSinceResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryAllSourceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryListPostsSinceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryAllSourceResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryListPostsSinceResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -652,49 +738,49 @@ func (m *QueryAllSourceResponse) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return b[:n], nil
 	}
 }
-func (m *QueryAllSourceResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryAllSourceResponse.Merge(m, src)
+func (m *QueryListPostsSinceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryListPostsSinceResponse.Merge(m, src)
 }
-func (m *QueryAllSourceResponse) XXX_Size() int {
+func (m *QueryListPostsSinceResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryAllSourceResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryAllSourceResponse.DiscardUnknown(m)
+func (m *QueryListPostsSinceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryListPostsSinceResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryAllSourceResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryListPostsSinceResponse proto.InternalMessageInfo
 
-func (m *QueryAllSourceResponse) GetSource() []Source {
+func (m *QueryListPostsSinceResponse) GetSocialPost() []SocialPost {
 	if m != nil {
-		return m.Source
+		return m.SocialPost
 	}
 	return nil
 }
 
-func (m *QueryAllSourceResponse) GetPagination() *query.PageResponse {
+func (m *QueryListPostsSinceResponse) GetPagination() *query.PageResponse {
 	if m != nil {
 		return m.Pagination
 	}
 	return nil
 }
 
-// QueryGetPostTagRequest defines the QueryGetPostTagRequest message.
-type QueryGetPostTagRequest struct {
+// QueryGetVoteRequest defines the QueryGetVoteRequest message.
+type QueryGetVoteRequest struct {
 	Index string `protobuf:"bytes,1,opt,name=index,proto3" json:"index,omitempty"`
 }
 
-func (m *QueryGetPostTagRequest) Reset()         { *m = QueryGetPostTagRequest{} }
-func (m *QueryGetPostTagRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryGetPostTagRequest) ProtoMessage()    {}
-func (*QueryGetPostTagRequest) Descriptor() ([]byte, []int) {
+func (m *QueryGetVoteRequest) Reset()         { *m = QueryGetVoteRequest{} }
+func (m *QueryGetVoteRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetVoteRequest) ProtoMessage()    {}
+func (*QueryGetVoteRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_599c3295223c14f2, []int{14}
 }
-func (m *QueryGetPostTagRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryGetVoteRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryGetPostTagRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryGetVoteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryGetPostTagRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryGetVoteRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -704,42 +790,42 @@ func (m *QueryGetPostTagRequest) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return b[:n], nil
 	}
 }
-func (m *QueryGetPostTagRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryGetPostTagRequest.Merge(m, src)
+func (m *QueryGetVoteRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetVoteRequest.Merge(m, src)
 }
-func (m *QueryGetPostTagRequest) XXX_Size() int {
+func (m *QueryGetVoteRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryGetPostTagRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryGetPostTagRequest.DiscardUnknown(m)
+func (m *QueryGetVoteRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetVoteRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryGetPostTagRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryGetVoteRequest proto.InternalMessageInfo
 
-func (m *QueryGetPostTagRequest) GetIndex() string {
+func (m *QueryGetVoteRequest) GetIndex() string {
 	if m != nil {
 		return m.Index
 	}
 	return ""
 }
 
-// QueryGetPostTagResponse defines the QueryGetPostTagResponse message.
-type QueryGetPostTagResponse struct {
-	PostTag PostTag `protobuf:"bytes,1,opt,name=post_tag,json=postTag,proto3" json:"post_tag"`
+// QueryGetVoteResponse defines the QueryGetVoteResponse message.
+type QueryGetVoteResponse struct {
+	Vote Vote `protobuf:"bytes,1,opt,name=vote,proto3" json:"vote"`
 }
 
-func (m *QueryGetPostTagResponse) Reset()         { *m = QueryGetPostTagResponse{} }
-func (m *QueryGetPostTagResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryGetPostTagResponse) ProtoMessage()    {}
-func (*QueryGetPostTagResponse) Descriptor() ([]byte, []int) {
+func (m *QueryGetVoteResponse) Reset()         { *m = QueryGetVoteResponse{} }
+func (m *QueryGetVoteResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetVoteResponse) ProtoMessage()    {}
+func (*QueryGetVoteResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_599c3295223c14f2, []int{15}
 }
-func (m *QueryGetPostTagResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryGetVoteResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryGetPostTagResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryGetVoteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryGetPostTagResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryGetVoteResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -749,42 +835,42 @@ func (m *QueryGetPostTagResponse) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *QueryGetPostTagResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryGetPostTagResponse.Merge(m, src)
+func (m *QueryGetVoteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetVoteResponse.Merge(m, src)
 }
-func (m *QueryGetPostTagResponse) XXX_Size() int {
+func (m *QueryGetVoteResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryGetPostTagResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryGetPostTagResponse.DiscardUnknown(m)
+func (m *QueryGetVoteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetVoteResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryGetPostTagResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryGetVoteResponse proto.InternalMessageInfo
 
-func (m *QueryGetPostTagResponse) GetPostTag() PostTag {
+func (m *QueryGetVoteResponse) GetVote() Vote {
 	if m != nil {
-		return m.PostTag
+		return m.Vote
 	}
-	return PostTag{}
+	return Vote{}
 }
 
-// QueryAllPostTagRequest defines the QueryAllPostTagRequest message.
-type QueryAllPostTagRequest struct {
+// QueryAllVoteRequest defines the QueryAllVoteRequest message.
+type QueryAllVoteRequest struct {
 	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryAllPostTagRequest) Reset()         { *m = QueryAllPostTagRequest{} }
-func (m *QueryAllPostTagRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryAllPostTagRequest) ProtoMessage()    {}
-func (*QueryAllPostTagRequest) Descriptor() ([]byte, []int) {
+func (m *QueryAllVoteRequest) Reset()         { *m = QueryAllVoteRequest{} }
+func (m *QueryAllVoteRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllVoteRequest) ProtoMessage()    {}
+func (*QueryAllVoteRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_599c3295223c14f2, []int{16}
 }
-func (m *QueryAllPostTagRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryAllVoteRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryAllPostTagRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryAllVoteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryAllPostTagRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryAllVoteRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -794,43 +880,43 @@ func (m *QueryAllPostTagRequest) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return b[:n], nil
 	}
 }
-func (m *QueryAllPostTagRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryAllPostTagRequest.Merge(m, src)
+func (m *QueryAllVoteRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllVoteRequest.Merge(m, src)
 }
-func (m *QueryAllPostTagRequest) XXX_Size() int {
+func (m *QueryAllVoteRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryAllPostTagRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryAllPostTagRequest.DiscardUnknown(m)
+func (m *QueryAllVoteRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllVoteRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryAllPostTagRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryAllVoteRequest proto.InternalMessageInfo
 
-func (m *QueryAllPostTagRequest) GetPagination() *query.PageRequest {
+func (m *QueryAllVoteRequest) GetPagination() *query.PageRequest {
 	if m != nil {
 		return m.Pagination
 	}
 	return nil
 }
 
-// QueryAllPostTagResponse defines the QueryAllPostTagResponse message.
-type QueryAllPostTagResponse struct {
-	PostTag    []PostTag           `protobuf:"bytes,1,rep,name=post_tag,json=postTag,proto3" json:"post_tag"`
+// QueryAllVoteResponse defines the QueryAllVoteResponse message.
+type QueryAllVoteResponse struct {
+	Vote       []Vote              `protobuf:"bytes,1,rep,name=vote,proto3" json:"vote"`
 	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryAllPostTagResponse) Reset()         { *m = QueryAllPostTagResponse{} }
-func (m *QueryAllPostTagResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryAllPostTagResponse) ProtoMessage()    {}
-func (*QueryAllPostTagResponse) Descriptor() ([]byte, []int) {
+func (m *QueryAllVoteResponse) Reset()         { *m = QueryAllVoteResponse{} }
+func (m *QueryAllVoteResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllVoteResponse) ProtoMessage()    {}
+func (*QueryAllVoteResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_599c3295223c14f2, []int{17}
 }
-func (m *QueryAllPostTagResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryAllVoteResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryAllPostTagResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryAllVoteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryAllPostTagResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryAllVoteResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -840,1406 +926,7894 @@ func (m *QueryAllPostTagResponse) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *QueryAllPostTagResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryAllPostTagResponse.Merge(m, src)
+func (m *QueryAllVoteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllVoteResponse.Merge(m, src)
 }
-func (m *QueryAllPostTagResponse) XXX_Size() int {
+func (m *QueryAllVoteResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryAllPostTagResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryAllPostTagResponse.DiscardUnknown(m)
+func (m *QueryAllVoteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllVoteResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryAllPostTagResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryAllVoteResponse proto.InternalMessageInfo
 
-func (m *QueryAllPostTagResponse) GetPostTag() []PostTag {
+func (m *QueryAllVoteResponse) GetVote() []Vote {
 	if m != nil {
-		return m.PostTag
+		return m.Vote
 	}
 	return nil
 }
 
-func (m *QueryAllPostTagResponse) GetPagination() *query.PageResponse {
+func (m *QueryAllVoteResponse) GetPagination() *query.PageResponse {
 	if m != nil {
 		return m.Pagination
 	}
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "resist.posts.v1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "resist.posts.v1.QueryParamsResponse")
-	proto.RegisterType((*QueryGetSocialPostRequest)(nil), "resist.posts.v1.QueryGetSocialPostRequest")
-	proto.RegisterType((*QueryGetSocialPostResponse)(nil), "resist.posts.v1.QueryGetSocialPostResponse")
-	proto.RegisterType((*QueryAllSocialPostRequest)(nil), "resist.posts.v1.QueryAllSocialPostRequest")
-	proto.RegisterType((*QueryAllSocialPostResponse)(nil), "resist.posts.v1.QueryAllSocialPostResponse")
-	proto.RegisterType((*QueryGetVoteRequest)(nil), "resist.posts.v1.QueryGetVoteRequest")
-	proto.RegisterType((*QueryGetVoteResponse)(nil), "resist.posts.v1.QueryGetVoteResponse")
-	proto.RegisterType((*QueryAllVoteRequest)(nil), "resist.posts.v1.QueryAllVoteRequest")
-	proto.RegisterType((*QueryAllVoteResponse)(nil), "resist.posts.v1.QueryAllVoteResponse")
-	proto.RegisterType((*QueryGetSourceRequest)(nil), "resist.posts.v1.QueryGetSourceRequest")
-	proto.RegisterType((*QueryGetSourceResponse)(nil), "resist.posts.v1.QueryGetSourceResponse")
-	proto.RegisterType((*QueryAllSourceRequest)(nil), "resist.posts.v1.QueryAllSourceRequest")
-	proto.RegisterType((*QueryAllSourceResponse)(nil), "resist.posts.v1.QueryAllSourceResponse")
-	proto.RegisterType((*QueryGetPostTagRequest)(nil), "resist.posts.v1.QueryGetPostTagRequest")
-	proto.RegisterType((*QueryGetPostTagResponse)(nil), "resist.posts.v1.QueryGetPostTagResponse")
-	proto.RegisterType((*QueryAllPostTagRequest)(nil), "resist.posts.v1.QueryAllPostTagRequest")
-	proto.RegisterType((*QueryAllPostTagResponse)(nil), "resist.posts.v1.QueryAllPostTagResponse")
+// QueryGetSourceRequest defines the QueryGetSourceRequest message.
+type QueryGetSourceRequest struct {
+	Index string `protobuf:"bytes,1,opt,name=index,proto3" json:"index,omitempty"`
 }
 
-func init() { proto.RegisterFile("resist/posts/v1/query.proto", fileDescriptor_599c3295223c14f2) }
-
-var fileDescriptor_599c3295223c14f2 = []byte{
-	// 863 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x96, 0xcf, 0x4f, 0x13, 0x5b,
-	0x14, 0xc7, 0x3b, 0x14, 0x0a, 0xbd, 0xe4, 0xbd, 0x97, 0x77, 0x5f, 0x79, 0x85, 0x01, 0xa6, 0xaf,
-	0x43, 0xf9, 0xf1, 0x40, 0xe7, 0xa6, 0x18, 0x17, 0xba, 0xa3, 0x0b, 0xbb, 0x31, 0x11, 0x2a, 0x71,
-	0x61, 0x62, 0x60, 0xa8, 0x93, 0xc9, 0x24, 0xa5, 0x77, 0xe8, 0xbd, 0x34, 0x10, 0x83, 0x0b, 0x8d,
-	0x1b, 0x37, 0x92, 0x98, 0x18, 0x37, 0xee, 0x75, 0xe7, 0xda, 0xbf, 0x80, 0x25, 0x89, 0x1b, 0x57,
-	0xc6, 0x80, 0x89, 0xff, 0x86, 0x99, 0x7b, 0x0f, 0xed, 0x74, 0x7e, 0xb5, 0x24, 0xdd, 0x34, 0x9d,
-	0x39, 0xe7, 0xdc, 0xef, 0xe7, 0x9c, 0x7b, 0xef, 0x39, 0x83, 0x66, 0x5b, 0x16, 0x73, 0x18, 0x27,
-	0x2e, 0x65, 0x9c, 0x91, 0x76, 0x99, 0x1c, 0x1c, 0x5a, 0xad, 0x63, 0xc3, 0x6d, 0x51, 0x4e, 0xf1,
-	0x5f, 0xd2, 0x68, 0x08, 0xa3, 0xd1, 0x2e, 0xab, 0x7f, 0x9b, 0xfb, 0x4e, 0x93, 0x12, 0xf1, 0x2b,
-	0x7d, 0xd4, 0xd5, 0x3a, 0x65, 0xfb, 0x94, 0x91, 0x3d, 0x93, 0x59, 0x32, 0x98, 0xb4, 0xcb, 0x7b,
-	0x16, 0x37, 0xcb, 0xc4, 0x35, 0x6d, 0xa7, 0x69, 0x72, 0x87, 0x36, 0xc1, 0x37, 0x67, 0x53, 0x9b,
-	0x8a, 0xbf, 0xc4, 0xfb, 0x07, 0x6f, 0xe7, 0x6c, 0x4a, 0xed, 0x86, 0x45, 0x4c, 0xd7, 0x21, 0x66,
-	0xb3, 0x49, 0xb9, 0x08, 0x61, 0x57, 0xd6, 0x20, 0xa0, 0x6b, 0xb6, 0xcc, 0xfd, 0x2b, 0xab, 0x16,
-	0xb2, 0x52, 0xc6, 0x77, 0xb8, 0x69, 0x83, 0xbd, 0x18, 0xb4, 0x33, 0x5a, 0x77, 0xcc, 0xc6, 0x8e,
-	0xf7, 0x1c, 0x27, 0xc0, 0xe8, 0x61, 0xab, 0x6e, 0x81, 0x55, 0x0d, 0x5a, 0xdb, 0x94, 0x83, 0x4d,
-	0xcf, 0x21, 0xbc, 0xe5, 0x25, 0xbc, 0x29, 0x88, 0x6a, 0xd6, 0xc1, 0xa1, 0xc5, 0xb8, 0xbe, 0x85,
-	0xfe, 0xe9, 0x79, 0xcb, 0x5c, 0xda, 0x64, 0x16, 0xbe, 0x8b, 0x32, 0x92, 0x7c, 0x5a, 0xf9, 0x4f,
-	0x59, 0x99, 0x5c, 0xcf, 0x1b, 0x81, 0xe2, 0x1a, 0x32, 0xa0, 0x92, 0x3d, 0xfb, 0x5e, 0x48, 0x7d,
-	0xfc, 0xf5, 0x79, 0x55, 0xa9, 0x41, 0x84, 0x5e, 0x46, 0x33, 0x62, 0xc9, 0xaa, 0xc5, 0x1f, 0x0a,
-	0xfe, 0x4d, 0xca, 0x38, 0xe8, 0xe1, 0x1c, 0x1a, 0x73, 0x9a, 0x4f, 0xad, 0x23, 0xb1, 0x6e, 0xb6,
-	0x26, 0x1f, 0xf4, 0x5d, 0xa4, 0x46, 0x85, 0x00, 0x4c, 0x05, 0x4d, 0xfa, 0x0a, 0x01, 0x44, 0xb3,
-	0x21, 0xa2, 0x6e, 0x64, 0x65, 0xd4, 0xa3, 0xaa, 0x21, 0xd6, 0x79, 0xa3, 0xd7, 0x01, 0x6a, 0xa3,
-	0xd1, 0x08, 0x43, 0xdd, 0x43, 0xa8, 0xbb, 0xfb, 0xb0, 0xfe, 0x92, 0x21, 0x8f, 0x8a, 0xe1, 0x1d,
-	0x15, 0x43, 0x9e, 0x33, 0x38, 0x2a, 0xc6, 0xa6, 0x69, 0x5b, 0x10, 0x5b, 0xf3, 0x45, 0xea, 0x9f,
-	0x14, 0xc8, 0x23, 0xa0, 0x12, 0x97, 0x47, 0xfa, 0xda, 0x79, 0xe0, 0x6a, 0x0f, 0xea, 0x88, 0x40,
-	0x5d, 0xee, 0x8b, 0x2a, 0x01, 0x7a, 0x58, 0xd7, 0x60, 0xe3, 0xab, 0x16, 0x7f, 0x44, 0xb9, 0x95,
-	0xbc, 0x3f, 0x55, 0x94, 0xeb, 0x75, 0x86, 0x8c, 0x08, 0x1a, 0xf5, 0x4e, 0x18, 0x94, 0x6c, 0x2a,
-	0x94, 0x8a, 0xe7, 0x0c, 0x49, 0x08, 0x47, 0xfd, 0x09, 0xa8, 0x6e, 0x34, 0x1a, 0x7e, 0xd5, 0x61,
-	0x6d, 0xc0, 0xa9, 0x02, 0xa0, 0x9d, 0xf5, 0x43, 0xa0, 0xe9, 0x81, 0x40, 0x87, 0x57, 0xe7, 0x9b,
-	0x68, 0xaa, 0x7b, 0xb4, 0xbd, 0xab, 0x9a, 0x5c, 0xe9, 0x07, 0xe8, 0xdf, 0xa0, 0x3b, 0xa4, 0x70,
-	0x1b, 0x65, 0xe4, 0x5d, 0x8f, 0xbd, 0x92, 0x32, 0x00, 0xd2, 0x00, 0x67, 0x7d, 0x07, 0xf4, 0xc5,
-	0x91, 0xf4, 0xeb, 0x0f, 0xab, 0xe6, 0xef, 0x15, 0x40, 0xf6, 0x29, 0x44, 0x20, 0xa7, 0x07, 0x46,
-	0x1e, 0x5e, 0xed, 0x8d, 0x6e, 0x31, 0xbd, 0xcb, 0xb3, 0x6d, 0xda, 0xc9, 0xc5, 0xdf, 0x46, 0xf9,
-	0x90, 0x3f, 0xa4, 0x72, 0x07, 0x4d, 0x5c, 0x35, 0x6b, 0xa8, 0xd5, 0x74, 0xb8, 0x25, 0xca, 0x18,
-	0xc8, 0x66, 0xdc, 0x95, 0x8f, 0xfa, 0x6e, 0xb7, 0x3e, 0x01, 0x8a, 0x61, 0x6d, 0xc1, 0x07, 0x05,
-	0xc0, 0xfd, 0x12, 0x91, 0xe0, 0xe9, 0x6b, 0x80, 0x0f, 0x6d, 0x1f, 0xd6, 0xbf, 0x64, 0xd1, 0x98,
-	0xe0, 0xc3, 0x1c, 0x65, 0xe4, 0xe0, 0xc0, 0x0b, 0x21, 0x8a, 0xf0, 0x74, 0x52, 0x4b, 0xc9, 0x4e,
-	0x52, 0x4a, 0x2f, 0xbc, 0xf8, 0xfa, 0xf3, 0xed, 0xc8, 0x0c, 0xce, 0x93, 0xe8, 0xe9, 0x8b, 0xdf,
-	0x29, 0xe8, 0x8f, 0x9e, 0xd1, 0x82, 0x57, 0xa3, 0x17, 0x8e, 0x1a, 0x59, 0xea, 0xda, 0x40, 0xbe,
-	0xc0, 0x72, 0x43, 0xb0, 0x2c, 0xe1, 0x12, 0x49, 0x98, 0xe5, 0xe4, 0x99, 0x38, 0x6f, 0x27, 0xf8,
-	0x8d, 0x82, 0xfe, 0xbc, 0xef, 0xb0, 0x01, 0xc8, 0xa2, 0xe6, 0x56, 0x1c, 0x59, 0xe4, 0xf4, 0xd1,
-	0x4b, 0x82, 0x4c, 0xc3, 0x73, 0x49, 0x64, 0xf8, 0x04, 0x8d, 0x43, 0x93, 0xc7, 0xa5, 0xd8, 0xbc,
-	0x7d, 0xad, 0x5b, 0x5d, 0xec, 0xe3, 0x05, 0xea, 0x8b, 0x42, 0xbd, 0x80, 0xe7, 0x49, 0xd4, 0x27,
-	0x4a, 0xa7, 0x20, 0x6d, 0x34, 0xe1, 0xd5, 0x23, 0x49, 0xbf, 0x77, 0x74, 0xc4, 0xe9, 0x07, 0x06,
-	0x80, 0x3e, 0x2f, 0xf4, 0xf3, 0x78, 0x2a, 0x52, 0x1f, 0xbf, 0x52, 0x50, 0xb6, 0xd3, 0x72, 0xf1,
-	0x52, 0xc2, 0x8e, 0xfb, 0x5a, 0xa8, 0xba, 0xdc, 0xd7, 0x0f, 0xd4, 0x97, 0x85, 0x7a, 0x11, 0x17,
-	0x48, 0xf4, 0xe7, 0x5b, 0x27, 0xff, 0xe7, 0x08, 0xc9, 0xf3, 0x90, 0xc4, 0x11, 0x6c, 0xe5, 0x71,
-	0x1c, 0xa1, 0x86, 0x9c, 0x70, 0x53, 0xa0, 0xf5, 0xbe, 0x56, 0x10, 0xea, 0x76, 0x3f, 0x1c, 0x9f,
-	0x60, 0x6f, 0x27, 0x53, 0x57, 0xfa, 0x3b, 0x02, 0xc2, 0xff, 0x02, 0x61, 0x01, 0x17, 0x49, 0xdc,
-	0xc7, 0x70, 0xa7, 0x18, 0x2f, 0x15, 0x34, 0xe9, 0x55, 0xa3, 0x0f, 0x4d, 0xa8, 0xaf, 0xc6, 0xd1,
-	0x84, 0xbb, 0xa3, 0x5e, 0x14, 0x34, 0xb3, 0x78, 0x26, 0x96, 0xa6, 0x62, 0x9c, 0x5d, 0x68, 0xca,
-	0xf9, 0x85, 0xa6, 0xfc, 0xb8, 0xd0, 0x94, 0xd3, 0x4b, 0x2d, 0x75, 0x7e, 0xa9, 0xa5, 0xbe, 0x5d,
-	0x6a, 0xa9, 0xc7, 0x39, 0x88, 0x39, 0x82, 0x28, 0x7e, 0xec, 0x5a, 0x6c, 0x2f, 0x23, 0x3e, 0xb7,
-	0x6f, 0xfd, 0x0e, 0x00, 0x00, 0xff, 0xff, 0xb7, 0xbd, 0x22, 0x99, 0xac, 0x0c, 0x00, 0x00,
+func (m *QueryGetSourceRequest) Reset()         { *m = QueryGetSourceRequest{} }
+func (m *QueryGetSourceRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetSourceRequest) ProtoMessage()    {}
+func (*QueryGetSourceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_599c3295223c14f2, []int{18}
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Parameters queries the parameters of the module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// ListSocialPost Queries a list of SocialPost items.
-	GetSocialPost(ctx context.Context, in *QueryGetSocialPostRequest, opts ...grpc.CallOption) (*QueryGetSocialPostResponse, error)
-	// ListSocialPost defines the ListSocialPost RPC.
-	ListSocialPost(ctx context.Context, in *QueryAllSocialPostRequest, opts ...grpc.CallOption) (*QueryAllSocialPostResponse, error)
-	// ListVote Queries a list of Vote items.
-	GetVote(ctx context.Context, in *QueryGetVoteRequest, opts ...grpc.CallOption) (*QueryGetVoteResponse, error)
-	// ListVote defines the ListVote RPC.
-	ListVote(ctx context.Context, in *QueryAllVoteRequest, opts ...grpc.CallOption) (*QueryAllVoteResponse, error)
-	// ListSource Queries a list of Source items.
-	GetSource(ctx context.Context, in *QueryGetSourceRequest, opts ...grpc.CallOption) (*QueryGetSourceResponse, error)
-	// ListSource defines the ListSource RPC.
-	ListSource(ctx context.Context, in *QueryAllSourceRequest, opts ...grpc.CallOption) (*QueryAllSourceResponse, error)
-	// ListPostTag Queries a list of PostTag items.
-	GetPostTag(ctx context.Context, in *QueryGetPostTagRequest, opts ...grpc.CallOption) (*QueryGetPostTagResponse, error)
-	// ListPostTag defines the ListPostTag RPC.
-	ListPostTag(ctx context.Context, in *QueryAllPostTagRequest, opts ...grpc.CallOption) (*QueryAllPostTagResponse, error)
+func (m *QueryGetSourceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryGetSourceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetSourceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryGetSourceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetSourceRequest.Merge(m, src)
 }
-
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/resist.posts.v1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryGetSourceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetSourceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetSourceRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) GetSocialPost(ctx context.Context, in *QueryGetSocialPostRequest, opts ...grpc.CallOption) (*QueryGetSocialPostResponse, error) {
-	out := new(QueryGetSocialPostResponse)
-	err := c.cc.Invoke(ctx, "/resist.posts.v1.Query/GetSocialPost", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetSourceRequest proto.InternalMessageInfo
+
+func (m *QueryGetSourceRequest) GetIndex() string {
+	if m != nil {
+		return m.Index
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) ListSocialPost(ctx context.Context, in *QueryAllSocialPostRequest, opts ...grpc.CallOption) (*QueryAllSocialPostResponse, error) {
-	out := new(QueryAllSocialPostResponse)
-	err := c.cc.Invoke(ctx, "/resist.posts.v1.Query/ListSocialPost", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// QueryGetSourceResponse defines the QueryGetSourceResponse message.
+type QueryGetSourceResponse struct {
+	Source Source `protobuf:"bytes,1,opt,name=source,proto3" json:"source"`
 }
 
-func (c *queryClient) GetVote(ctx context.Context, in *QueryGetVoteRequest, opts ...grpc.CallOption) (*QueryGetVoteResponse, error) {
-	out := new(QueryGetVoteResponse)
-	err := c.cc.Invoke(ctx, "/resist.posts.v1.Query/GetVote", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryGetSourceResponse) Reset()         { *m = QueryGetSourceResponse{} }
+func (m *QueryGetSourceResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetSourceResponse) ProtoMessage()    {}
+func (*QueryGetSourceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_599c3295223c14f2, []int{19}
+}
+func (m *QueryGetSourceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetSourceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetSourceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryGetSourceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetSourceResponse.Merge(m, src)
+}
+func (m *QueryGetSourceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetSourceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetSourceResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) ListVote(ctx context.Context, in *QueryAllVoteRequest, opts ...grpc.CallOption) (*QueryAllVoteResponse, error) {
-	out := new(QueryAllVoteResponse)
-	err := c.cc.Invoke(ctx, "/resist.posts.v1.Query/ListVote", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetSourceResponse proto.InternalMessageInfo
+
+func (m *QueryGetSourceResponse) GetSource() Source {
+	if m != nil {
+		return m.Source
 	}
-	return out, nil
+	return Source{}
 }
 
-func (c *queryClient) GetSource(ctx context.Context, in *QueryGetSourceRequest, opts ...grpc.CallOption) (*QueryGetSourceResponse, error) {
-	out := new(QueryGetSourceResponse)
-	err := c.cc.Invoke(ctx, "/resist.posts.v1.Query/GetSource", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// QueryAllSourceRequest defines the QueryAllSourceRequest message.
+type QueryAllSourceRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (c *queryClient) ListSource(ctx context.Context, in *QueryAllSourceRequest, opts ...grpc.CallOption) (*QueryAllSourceResponse, error) {
-	out := new(QueryAllSourceResponse)
-	err := c.cc.Invoke(ctx, "/resist.posts.v1.Query/ListSource", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryAllSourceRequest) Reset()         { *m = QueryAllSourceRequest{} }
+func (m *QueryAllSourceRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllSourceRequest) ProtoMessage()    {}
+func (*QueryAllSourceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_599c3295223c14f2, []int{20}
 }
-
-func (c *queryClient) GetPostTag(ctx context.Context, in *QueryGetPostTagRequest, opts ...grpc.CallOption) (*QueryGetPostTagResponse, error) {
-	out := new(QueryGetPostTagResponse)
-	err := c.cc.Invoke(ctx, "/resist.posts.v1.Query/GetPostTag", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryAllSourceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllSourceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllSourceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryAllSourceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllSourceRequest.Merge(m, src)
+}
+func (m *QueryAllSourceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllSourceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllSourceRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) ListPostTag(ctx context.Context, in *QueryAllPostTagRequest, opts ...grpc.CallOption) (*QueryAllPostTagResponse, error) {
-	out := new(QueryAllPostTagResponse)
-	err := c.cc.Invoke(ctx, "/resist.posts.v1.Query/ListPostTag", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAllSourceRequest proto.InternalMessageInfo
+
+func (m *QueryAllSourceRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Parameters queries the parameters of the module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// ListSocialPost Queries a list of SocialPost items.
-	GetSocialPost(context.Context, *QueryGetSocialPostRequest) (*QueryGetSocialPostResponse, error)
-	// ListSocialPost defines the ListSocialPost RPC.
-	ListSocialPost(context.Context, *QueryAllSocialPostRequest) (*QueryAllSocialPostResponse, error)
-	// ListVote Queries a list of Vote items.
-	GetVote(context.Context, *QueryGetVoteRequest) (*QueryGetVoteResponse, error)
-	// ListVote defines the ListVote RPC.
-	ListVote(context.Context, *QueryAllVoteRequest) (*QueryAllVoteResponse, error)
-	// ListSource Queries a list of Source items.
-	GetSource(context.Context, *QueryGetSourceRequest) (*QueryGetSourceResponse, error)
-	// ListSource defines the ListSource RPC.
-	ListSource(context.Context, *QueryAllSourceRequest) (*QueryAllSourceResponse, error)
-	// ListPostTag Queries a list of PostTag items.
-	GetPostTag(context.Context, *QueryGetPostTagRequest) (*QueryGetPostTagResponse, error)
-	// ListPostTag defines the ListPostTag RPC.
-	ListPostTag(context.Context, *QueryAllPostTagRequest) (*QueryAllPostTagResponse, error)
+// QueryAllSourceResponse defines the QueryAllSourceResponse message.
+type QueryAllSourceResponse struct {
+	Source     []Source            `protobuf:"bytes,1,rep,name=source,proto3" json:"source"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QueryAllSourceResponse) Reset()         { *m = QueryAllSourceResponse{} }
+func (m *QueryAllSourceResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllSourceResponse) ProtoMessage()    {}
+func (*QueryAllSourceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_599c3295223c14f2, []int{21}
+}
+func (m *QueryAllSourceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllSourceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllSourceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllSourceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllSourceResponse.Merge(m, src)
+}
+func (m *QueryAllSourceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllSourceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllSourceResponse.DiscardUnknown(m)
 }
 
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+var xxx_messageInfo_QueryAllSourceResponse proto.InternalMessageInfo
+
+func (m *QueryAllSourceResponse) GetSource() []Source {
+	if m != nil {
+		return m.Source
+	}
+	return nil
 }
-func (*UnimplementedQueryServer) GetSocialPost(ctx context.Context, req *QueryGetSocialPostRequest) (*QueryGetSocialPostResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetSocialPost not implemented")
+
+func (m *QueryAllSourceResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
-func (*UnimplementedQueryServer) ListSocialPost(ctx context.Context, req *QueryAllSocialPostRequest) (*QueryAllSocialPostResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListSocialPost not implemented")
+
+// QueryListSourcePostsRequest defines the QueryListSourcePostsRequest message.
+type QueryListSourcePostsRequest struct {
+	SourceIndex string             `protobuf:"bytes,1,opt,name=source_index,json=sourceIndex,proto3" json:"source_index,omitempty"`
+	Pagination  *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
-func (*UnimplementedQueryServer) GetVote(ctx context.Context, req *QueryGetVoteRequest) (*QueryGetVoteResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetVote not implemented")
+
+func (m *QueryListSourcePostsRequest) Reset()         { *m = QueryListSourcePostsRequest{} }
+func (m *QueryListSourcePostsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryListSourcePostsRequest) ProtoMessage()    {}
+func (*QueryListSourcePostsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_599c3295223c14f2, []int{22}
 }
-func (*UnimplementedQueryServer) ListVote(ctx context.Context, req *QueryAllVoteRequest) (*QueryAllVoteResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListVote not implemented")
+func (m *QueryListSourcePostsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) GetSource(ctx context.Context, req *QueryGetSourceRequest) (*QueryGetSourceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetSource not implemented")
+func (m *QueryListSourcePostsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryListSourcePostsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) ListSource(ctx context.Context, req *QueryAllSourceRequest) (*QueryAllSourceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListSource not implemented")
+func (m *QueryListSourcePostsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryListSourcePostsRequest.Merge(m, src)
 }
-func (*UnimplementedQueryServer) GetPostTag(ctx context.Context, req *QueryGetPostTagRequest) (*QueryGetPostTagResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetPostTag not implemented")
+func (m *QueryListSourcePostsRequest) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) ListPostTag(ctx context.Context, req *QueryAllPostTagRequest) (*QueryAllPostTagResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListPostTag not implemented")
+func (m *QueryListSourcePostsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryListSourcePostsRequest.DiscardUnknown(m)
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
-}
+var xxx_messageInfo_QueryListSourcePostsRequest proto.InternalMessageInfo
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/resist.posts.v1.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+func (m *QueryListSourcePostsRequest) GetSourceIndex() string {
+	if m != nil {
+		return m.SourceIndex
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_GetSocialPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetSocialPostRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetSocialPost(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/resist.posts.v1.Query/GetSocialPost",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetSocialPost(ctx, req.(*QueryGetSocialPostRequest))
+func (m *QueryListSourcePostsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_ListSocialPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllSocialPostRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ListSocialPost(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/resist.posts.v1.Query/ListSocialPost",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ListSocialPost(ctx, req.(*QueryAllSocialPostRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryListSourcePostsResponse defines the QueryListSourcePostsResponse
+// message.
+type QueryListSourcePostsResponse struct {
+	SocialPost []SocialPost        `protobuf:"bytes,1,rep,name=social_post,json=socialPost,proto3" json:"social_post"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func _Query_GetVote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetVoteRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetVote(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/resist.posts.v1.Query/GetVote",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetVote(ctx, req.(*QueryGetVoteRequest))
+func (m *QueryListSourcePostsResponse) Reset()         { *m = QueryListSourcePostsResponse{} }
+func (m *QueryListSourcePostsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryListSourcePostsResponse) ProtoMessage()    {}
+func (*QueryListSourcePostsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_599c3295223c14f2, []int{23}
+}
+func (m *QueryListSourcePostsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryListSourcePostsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryListSourcePostsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryListSourcePostsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryListSourcePostsResponse.Merge(m, src)
+}
+func (m *QueryListSourcePostsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryListSourcePostsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryListSourcePostsResponse.DiscardUnknown(m)
 }
 
-func _Query_ListVote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllVoteRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ListVote(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/resist.posts.v1.Query/ListVote",
+var xxx_messageInfo_QueryListSourcePostsResponse proto.InternalMessageInfo
+
+func (m *QueryListSourcePostsResponse) GetSocialPost() []SocialPost {
+	if m != nil {
+		return m.SocialPost
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ListVote(ctx, req.(*QueryAllVoteRequest))
+	return nil
+}
+
+func (m *QueryListSourcePostsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_GetSource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetSourceRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetSource(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/resist.posts.v1.Query/GetSource",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetSource(ctx, req.(*QueryGetSourceRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryGetPostTagRequest defines the QueryGetPostTagRequest message.
+type QueryGetPostTagRequest struct {
+	Index string `protobuf:"bytes,1,opt,name=index,proto3" json:"index,omitempty"`
 }
 
-func _Query_ListSource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllSourceRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ListSource(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/resist.posts.v1.Query/ListSource",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ListSource(ctx, req.(*QueryAllSourceRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *QueryGetPostTagRequest) Reset()         { *m = QueryGetPostTagRequest{} }
+func (m *QueryGetPostTagRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetPostTagRequest) ProtoMessage()    {}
+func (*QueryGetPostTagRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_599c3295223c14f2, []int{24}
 }
-
-func _Query_GetPostTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetPostTagRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetPostTag(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/resist.posts.v1.Query/GetPostTag",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetPostTag(ctx, req.(*QueryGetPostTagRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *QueryGetPostTagRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func _Query_ListPostTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllPostTagRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ListPostTag(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/resist.posts.v1.Query/ListPostTag",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ListPostTag(ctx, req.(*QueryAllPostTagRequest))
+func (m *QueryGetPostTagRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetPostTagRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
 }
-
-var Query_serviceDesc = _Query_serviceDesc
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "resist.posts.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "GetSocialPost",
-			Handler:    _Query_GetSocialPost_Handler,
-		},
-		{
-			MethodName: "ListSocialPost",
-			Handler:    _Query_ListSocialPost_Handler,
-		},
-		{
-			MethodName: "GetVote",
-			Handler:    _Query_GetVote_Handler,
-		},
-		{
-			MethodName: "ListVote",
-			Handler:    _Query_ListVote_Handler,
-		},
-		{
-			MethodName: "GetSource",
-			Handler:    _Query_GetSource_Handler,
-		},
-		{
-			MethodName: "ListSource",
-			Handler:    _Query_ListSource_Handler,
-		},
-		{
-			MethodName: "GetPostTag",
-			Handler:    _Query_GetPostTag_Handler,
-		},
-		{
-			MethodName: "ListPostTag",
-			Handler:    _Query_ListPostTag_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "resist/posts/v1/query.proto",
+func (m *QueryGetPostTagRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetPostTagRequest.Merge(m, src)
 }
-
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryGetPostTagRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryGetPostTagRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetPostTagRequest.DiscardUnknown(m)
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
-}
+var xxx_messageInfo_QueryGetPostTagRequest proto.InternalMessageInfo
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryGetPostTagRequest) GetIndex() string {
+	if m != nil {
+		return m.Index
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryGetPostTagResponse defines the QueryGetPostTagResponse message.
+type QueryGetPostTagResponse struct {
+	PostTag PostTag `protobuf:"bytes,1,opt,name=post_tag,json=postTag,proto3" json:"post_tag"`
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryGetPostTagResponse) Reset()         { *m = QueryGetPostTagResponse{} }
+func (m *QueryGetPostTagResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetPostTagResponse) ProtoMessage()    {}
+func (*QueryGetPostTagResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_599c3295223c14f2, []int{25}
+}
+func (m *QueryGetPostTagResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetPostTagResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetPostTagResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryGetSocialPostRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryGetPostTagResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetPostTagResponse.Merge(m, src)
 }
-
-func (m *QueryGetSocialPostRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryGetPostTagResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *QueryGetSocialPostRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Index) > 0 {
-		i -= len(m.Index)
-		copy(dAtA[i:], m.Index)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Index)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *QueryGetPostTagResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetPostTagResponse.DiscardUnknown(m)
 }
 
-func (m *QueryGetSocialPostResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetPostTagResponse proto.InternalMessageInfo
+
+func (m *QueryGetPostTagResponse) GetPostTag() PostTag {
+	if m != nil {
+		return m.PostTag
 	}
-	return dAtA[:n], nil
+	return PostTag{}
 }
 
-func (m *QueryGetSocialPostResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryAllPostTagRequest defines the QueryAllPostTagRequest message.
+type QueryAllPostTagRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryGetSocialPostResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.SocialPost.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryAllPostTagRequest) Reset()         { *m = QueryAllPostTagRequest{} }
+func (m *QueryAllPostTagRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllPostTagRequest) ProtoMessage()    {}
+func (*QueryAllPostTagRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_599c3295223c14f2, []int{26}
+}
+func (m *QueryAllPostTagRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllPostTagRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllPostTagRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryAllPostTagRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllPostTagRequest.Merge(m, src)
+}
+func (m *QueryAllPostTagRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllPostTagRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllPostTagRequest.DiscardUnknown(m)
 }
 
-func (m *QueryAllSocialPostRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAllPostTagRequest proto.InternalMessageInfo
+
+func (m *QueryAllPostTagRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryAllSocialPostRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryAllPostTagResponse defines the QueryAllPostTagResponse message.
+type QueryAllPostTagResponse struct {
+	PostTag    []PostTag           `protobuf:"bytes,1,rep,name=post_tag,json=postTag,proto3" json:"post_tag"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryAllSocialPostRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryAllPostTagResponse) Reset()         { *m = QueryAllPostTagResponse{} }
+func (m *QueryAllPostTagResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllPostTagResponse) ProtoMessage()    {}
+func (*QueryAllPostTagResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_599c3295223c14f2, []int{27}
+}
+func (m *QueryAllPostTagResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllPostTagResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllPostTagResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryAllPostTagResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllPostTagResponse.Merge(m, src)
+}
+func (m *QueryAllPostTagResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllPostTagResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllPostTagResponse.DiscardUnknown(m)
 }
 
-func (m *QueryAllSocialPostResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAllPostTagResponse proto.InternalMessageInfo
+
+func (m *QueryAllPostTagResponse) GetPostTag() []PostTag {
+	if m != nil {
+		return m.PostTag
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryAllSocialPostResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryAllPostTagResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryAllSocialPostResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.SocialPost) > 0 {
-		for iNdEx := len(m.SocialPost) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.SocialPost[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+// QueryGetContentDistributionRequest defines the QueryGetContentDistributionRequest message.
+type QueryGetContentDistributionRequest struct {
+	ContentId string `protobuf:"bytes,1,opt,name=content_id,json=contentId,proto3" json:"content_id,omitempty"`
+}
+
+func (m *QueryGetContentDistributionRequest) Reset()         { *m = QueryGetContentDistributionRequest{} }
+func (m *QueryGetContentDistributionRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetContentDistributionRequest) ProtoMessage()    {}
+func (*QueryGetContentDistributionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_599c3295223c14f2, []int{28}
+}
+func (m *QueryGetContentDistributionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetContentDistributionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetContentDistributionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryGetContentDistributionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetContentDistributionRequest.Merge(m, src)
+}
+func (m *QueryGetContentDistributionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetContentDistributionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetContentDistributionRequest.DiscardUnknown(m)
 }
 
-func (m *QueryGetVoteRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetContentDistributionRequest proto.InternalMessageInfo
+
+func (m *QueryGetContentDistributionRequest) GetContentId() string {
+	if m != nil {
+		return m.ContentId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryGetVoteRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryGetContentDistributionResponse defines the QueryGetContentDistributionResponse message.
+type QueryGetContentDistributionResponse struct {
+	ContentDistribution ContentDistribution `protobuf:"bytes,1,opt,name=content_distribution,json=contentDistribution,proto3" json:"content_distribution"`
 }
 
-func (m *QueryGetVoteRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Index) > 0 {
-		i -= len(m.Index)
-		copy(dAtA[i:], m.Index)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Index)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryGetContentDistributionResponse) Reset()         { *m = QueryGetContentDistributionResponse{} }
+func (m *QueryGetContentDistributionResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetContentDistributionResponse) ProtoMessage()    {}
+func (*QueryGetContentDistributionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_599c3295223c14f2, []int{29}
+}
+func (m *QueryGetContentDistributionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetContentDistributionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetContentDistributionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryGetContentDistributionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetContentDistributionResponse.Merge(m, src)
+}
+func (m *QueryGetContentDistributionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetContentDistributionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetContentDistributionResponse.DiscardUnknown(m)
 }
 
-func (m *QueryGetVoteResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetContentDistributionResponse proto.InternalMessageInfo
+
+func (m *QueryGetContentDistributionResponse) GetContentDistribution() ContentDistribution {
+	if m != nil {
+		return m.ContentDistribution
 	}
-	return dAtA[:n], nil
+	return ContentDistribution{}
 }
 
-func (m *QueryGetVoteResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryAllContentDistributionRequest defines the QueryAllContentDistributionRequest message.
+type QueryAllContentDistributionRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryGetVoteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Vote.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryAllContentDistributionRequest) Reset()         { *m = QueryAllContentDistributionRequest{} }
+func (m *QueryAllContentDistributionRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllContentDistributionRequest) ProtoMessage()    {}
+func (*QueryAllContentDistributionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_599c3295223c14f2, []int{30}
+}
+func (m *QueryAllContentDistributionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllContentDistributionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllContentDistributionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryAllContentDistributionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllContentDistributionRequest.Merge(m, src)
+}
+func (m *QueryAllContentDistributionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllContentDistributionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllContentDistributionRequest.DiscardUnknown(m)
 }
 
-func (m *QueryAllVoteRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAllContentDistributionRequest proto.InternalMessageInfo
+
+func (m *QueryAllContentDistributionRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryAllVoteRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryAllContentDistributionResponse defines the QueryAllContentDistributionResponse message.
+type QueryAllContentDistributionResponse struct {
+	ContentDistribution []ContentDistribution `protobuf:"bytes,1,rep,name=content_distribution,json=contentDistribution,proto3" json:"content_distribution"`
+	Pagination          *query.PageResponse   `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryAllVoteRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryAllContentDistributionResponse) Reset()         { *m = QueryAllContentDistributionResponse{} }
+func (m *QueryAllContentDistributionResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllContentDistributionResponse) ProtoMessage()    {}
+func (*QueryAllContentDistributionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_599c3295223c14f2, []int{31}
+}
+func (m *QueryAllContentDistributionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllContentDistributionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllContentDistributionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryAllContentDistributionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllContentDistributionResponse.Merge(m, src)
+}
+func (m *QueryAllContentDistributionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllContentDistributionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllContentDistributionResponse.DiscardUnknown(m)
 }
 
-func (m *QueryAllVoteResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAllContentDistributionResponse proto.InternalMessageInfo
+
+func (m *QueryAllContentDistributionResponse) GetContentDistribution() []ContentDistribution {
+	if m != nil {
+		return m.ContentDistribution
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryAllVoteResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryAllContentDistributionResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryAllVoteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+// QueryAllReplicaAssignmentRequest defines the QueryAllReplicaAssignmentRequest message.
+type QueryAllReplicaAssignmentRequest struct {
+	ContentId  string             `protobuf:"bytes,1,opt,name=content_id,json=contentId,proto3" json:"content_id,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllReplicaAssignmentRequest) Reset()         { *m = QueryAllReplicaAssignmentRequest{} }
+func (m *QueryAllReplicaAssignmentRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllReplicaAssignmentRequest) ProtoMessage()    {}
+func (*QueryAllReplicaAssignmentRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_599c3295223c14f2, []int{32}
+}
+func (m *QueryAllReplicaAssignmentRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllReplicaAssignmentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllReplicaAssignmentRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.Vote) > 0 {
-		for iNdEx := len(m.Vote) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Vote[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+}
+func (m *QueryAllReplicaAssignmentRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllReplicaAssignmentRequest.Merge(m, src)
+}
+func (m *QueryAllReplicaAssignmentRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllReplicaAssignmentRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllReplicaAssignmentRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllReplicaAssignmentRequest proto.InternalMessageInfo
+
+func (m *QueryAllReplicaAssignmentRequest) GetContentId() string {
+	if m != nil {
+		return m.ContentId
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryGetSourceRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryAllReplicaAssignmentRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryGetSourceRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryAllReplicaAssignmentResponse defines the QueryAllReplicaAssignmentResponse message.
+type QueryAllReplicaAssignmentResponse struct {
+	ReplicaAssignment []ReplicaAssignment `protobuf:"bytes,1,rep,name=replica_assignment,json=replicaAssignment,proto3" json:"replica_assignment"`
+	Pagination        *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryGetSourceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Index) > 0 {
-		i -= len(m.Index)
-		copy(dAtA[i:], m.Index)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Index)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryAllReplicaAssignmentResponse) Reset()         { *m = QueryAllReplicaAssignmentResponse{} }
+func (m *QueryAllReplicaAssignmentResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllReplicaAssignmentResponse) ProtoMessage()    {}
+func (*QueryAllReplicaAssignmentResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_599c3295223c14f2, []int{33}
+}
+func (m *QueryAllReplicaAssignmentResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllReplicaAssignmentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllReplicaAssignmentResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryAllReplicaAssignmentResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllReplicaAssignmentResponse.Merge(m, src)
+}
+func (m *QueryAllReplicaAssignmentResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllReplicaAssignmentResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllReplicaAssignmentResponse.DiscardUnknown(m)
 }
 
-func (m *QueryGetSourceResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAllReplicaAssignmentResponse proto.InternalMessageInfo
+
+func (m *QueryAllReplicaAssignmentResponse) GetReplicaAssignment() []ReplicaAssignment {
+	if m != nil {
+		return m.ReplicaAssignment
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryGetSourceResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryAllReplicaAssignmentResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryGetSourceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Source.MarshalToSizedBuffer(dAtA[:i])
+// QueryGetStorageChallengeRequest defines the QueryGetStorageChallengeRequest message.
+type QueryGetStorageChallengeRequest struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *QueryGetStorageChallengeRequest) Reset()         { *m = QueryGetStorageChallengeRequest{} }
+func (m *QueryGetStorageChallengeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetStorageChallengeRequest) ProtoMessage()    {}
+func (*QueryGetStorageChallengeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_599c3295223c14f2, []int{34}
+}
+func (m *QueryGetStorageChallengeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetStorageChallengeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetStorageChallengeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryGetStorageChallengeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetStorageChallengeRequest.Merge(m, src)
+}
+func (m *QueryGetStorageChallengeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetStorageChallengeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetStorageChallengeRequest.DiscardUnknown(m)
 }
 
-func (m *QueryAllSourceRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetStorageChallengeRequest proto.InternalMessageInfo
+
+func (m *QueryGetStorageChallengeRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryAllSourceRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryGetStorageChallengeResponse defines the QueryGetStorageChallengeResponse message.
+type QueryGetStorageChallengeResponse struct {
+	StorageChallenge StorageChallenge `protobuf:"bytes,1,opt,name=storage_challenge,json=storageChallenge,proto3" json:"storage_challenge"`
 }
 
-func (m *QueryAllSourceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryGetStorageChallengeResponse) Reset()         { *m = QueryGetStorageChallengeResponse{} }
+func (m *QueryGetStorageChallengeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetStorageChallengeResponse) ProtoMessage()    {}
+func (*QueryGetStorageChallengeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_599c3295223c14f2, []int{35}
+}
+func (m *QueryGetStorageChallengeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetStorageChallengeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetStorageChallengeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryGetStorageChallengeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetStorageChallengeResponse.Merge(m, src)
+}
+func (m *QueryGetStorageChallengeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetStorageChallengeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetStorageChallengeResponse.DiscardUnknown(m)
 }
 
-func (m *QueryAllSourceResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetStorageChallengeResponse proto.InternalMessageInfo
+
+func (m *QueryGetStorageChallengeResponse) GetStorageChallenge() StorageChallenge {
+	if m != nil {
+		return m.StorageChallenge
 	}
-	return dAtA[:n], nil
+	return StorageChallenge{}
 }
 
-func (m *QueryAllSourceResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryAllStorageChallengeRequest defines the QueryAllStorageChallengeRequest message.
+type QueryAllStorageChallengeRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryAllSourceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Source) > 0 {
-		for iNdEx := len(m.Source) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Source[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryAllStorageChallengeRequest) Reset()         { *m = QueryAllStorageChallengeRequest{} }
+func (m *QueryAllStorageChallengeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllStorageChallengeRequest) ProtoMessage()    {}
+func (*QueryAllStorageChallengeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_599c3295223c14f2, []int{36}
+}
+func (m *QueryAllStorageChallengeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllStorageChallengeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllStorageChallengeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryAllStorageChallengeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllStorageChallengeRequest.Merge(m, src)
+}
+func (m *QueryAllStorageChallengeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllStorageChallengeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllStorageChallengeRequest.DiscardUnknown(m)
 }
 
-func (m *QueryGetPostTagRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAllStorageChallengeRequest proto.InternalMessageInfo
+
+func (m *QueryAllStorageChallengeRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryGetPostTagRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryAllStorageChallengeResponse defines the QueryAllStorageChallengeResponse message.
+type QueryAllStorageChallengeResponse struct {
+	StorageChallenge []StorageChallenge  `protobuf:"bytes,1,rep,name=storage_challenge,json=storageChallenge,proto3" json:"storage_challenge"`
+	Pagination       *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryGetPostTagRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Index) > 0 {
-		i -= len(m.Index)
-		copy(dAtA[i:], m.Index)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Index)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryAllStorageChallengeResponse) Reset()         { *m = QueryAllStorageChallengeResponse{} }
+func (m *QueryAllStorageChallengeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllStorageChallengeResponse) ProtoMessage()    {}
+func (*QueryAllStorageChallengeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_599c3295223c14f2, []int{37}
+}
+func (m *QueryAllStorageChallengeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllStorageChallengeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllStorageChallengeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryAllStorageChallengeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllStorageChallengeResponse.Merge(m, src)
+}
+func (m *QueryAllStorageChallengeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllStorageChallengeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllStorageChallengeResponse.DiscardUnknown(m)
 }
 
-func (m *QueryGetPostTagResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAllStorageChallengeResponse proto.InternalMessageInfo
+
+func (m *QueryAllStorageChallengeResponse) GetStorageChallenge() []StorageChallenge {
+	if m != nil {
+		return m.StorageChallenge
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryGetPostTagResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryAllStorageChallengeResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryGetPostTagResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.PostTag.MarshalToSizedBuffer(dAtA[:i])
+// QueryGetHubSyncRequest defines the QueryGetHubSyncRequest message.
+type QueryGetHubSyncRequest struct {
+	SyncId string `protobuf:"bytes,1,opt,name=sync_id,json=syncId,proto3" json:"sync_id,omitempty"`
+}
+
+func (m *QueryGetHubSyncRequest) Reset()         { *m = QueryGetHubSyncRequest{} }
+func (m *QueryGetHubSyncRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetHubSyncRequest) ProtoMessage()    {}
+func (*QueryGetHubSyncRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_599c3295223c14f2, []int{38}
+}
+func (m *QueryGetHubSyncRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetHubSyncRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetHubSyncRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryGetHubSyncRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetHubSyncRequest.Merge(m, src)
+}
+func (m *QueryGetHubSyncRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetHubSyncRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetHubSyncRequest.DiscardUnknown(m)
 }
 
-func (m *QueryAllPostTagRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetHubSyncRequest proto.InternalMessageInfo
+
+func (m *QueryGetHubSyncRequest) GetSyncId() string {
+	if m != nil {
+		return m.SyncId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryAllPostTagRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryGetHubSyncResponse defines the QueryGetHubSyncResponse message.
+type QueryGetHubSyncResponse struct {
+	HubSync HubSync `protobuf:"bytes,1,opt,name=hub_sync,json=hubSync,proto3" json:"hub_sync"`
 }
 
-func (m *QueryAllPostTagRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryGetHubSyncResponse) Reset()         { *m = QueryGetHubSyncResponse{} }
+func (m *QueryGetHubSyncResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetHubSyncResponse) ProtoMessage()    {}
+func (*QueryGetHubSyncResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_599c3295223c14f2, []int{39}
+}
+func (m *QueryGetHubSyncResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetHubSyncResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetHubSyncResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryGetHubSyncResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetHubSyncResponse.Merge(m, src)
+}
+func (m *QueryGetHubSyncResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetHubSyncResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetHubSyncResponse.DiscardUnknown(m)
 }
 
-func (m *QueryAllPostTagResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetHubSyncResponse proto.InternalMessageInfo
+
+func (m *QueryGetHubSyncResponse) GetHubSync() HubSync {
+	if m != nil {
+		return m.HubSync
 	}
-	return dAtA[:n], nil
+	return HubSync{}
 }
 
-func (m *QueryAllPostTagResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryAllHubSyncRequest defines the QueryAllHubSyncRequest message.
+type QueryAllHubSyncRequest struct {
+	// node_id restricts the result to the syncs the node is the source or target of.
+	NodeId     string             `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryAllPostTagResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.PostTag) > 0 {
-		for iNdEx := len(m.PostTag) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.PostTag[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryAllHubSyncRequest) Reset()         { *m = QueryAllHubSyncRequest{} }
+func (m *QueryAllHubSyncRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllHubSyncRequest) ProtoMessage()    {}
+func (*QueryAllHubSyncRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_599c3295223c14f2, []int{40}
+}
+func (m *QueryAllHubSyncRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllHubSyncRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllHubSyncRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+func (m *QueryAllHubSyncRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllHubSyncRequest.Merge(m, src)
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *QueryAllHubSyncRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllHubSyncRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllHubSyncRequest.DiscardUnknown(m)
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryAllHubSyncRequest proto.InternalMessageInfo
+
+func (m *QueryAllHubSyncRequest) GetNodeId() string {
+	if m != nil {
+		return m.NodeId
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return ""
 }
 
-func (m *QueryGetSocialPostRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Index)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryAllHubSyncRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return n
+	return nil
 }
 
-func (m *QueryGetSocialPostResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.SocialPost.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+// QueryAllHubSyncResponse defines the QueryAllHubSyncResponse message.
+type QueryAllHubSyncResponse struct {
+	HubSync    []HubSync           `protobuf:"bytes,1,rep,name=hub_sync,json=hubSync,proto3" json:"hub_sync"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryAllSocialPostRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryAllHubSyncResponse) Reset()         { *m = QueryAllHubSyncResponse{} }
+func (m *QueryAllHubSyncResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllHubSyncResponse) ProtoMessage()    {}
+func (*QueryAllHubSyncResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_599c3295223c14f2, []int{41}
+}
+func (m *QueryAllHubSyncResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllHubSyncResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllHubSyncResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+}
+func (m *QueryAllHubSyncResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllHubSyncResponse.Merge(m, src)
+}
+func (m *QueryAllHubSyncResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllHubSyncResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllHubSyncResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllHubSyncResponse proto.InternalMessageInfo
+
+func (m *QueryAllHubSyncResponse) GetHubSync() []HubSync {
+	if m != nil {
+		return m.HubSync
 	}
-	return n
+	return nil
 }
 
-func (m *QueryAllSocialPostResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryAllHubSyncResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	var l int
-	_ = l
-	if len(m.SocialPost) > 0 {
-		for _, e := range m.SocialPost {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	return nil
+}
+
+// QueryGetPrekeyBundleRequest defines the QueryGetPrekeyBundleRequest message.
+type QueryGetPrekeyBundleRequest struct {
+	NodeId string `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+}
+
+func (m *QueryGetPrekeyBundleRequest) Reset()         { *m = QueryGetPrekeyBundleRequest{} }
+func (m *QueryGetPrekeyBundleRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetPrekeyBundleRequest) ProtoMessage()    {}
+func (*QueryGetPrekeyBundleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_599c3295223c14f2, []int{42}
+}
+func (m *QueryGetPrekeyBundleRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetPrekeyBundleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetPrekeyBundleRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+}
+func (m *QueryGetPrekeyBundleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetPrekeyBundleRequest.Merge(m, src)
+}
+func (m *QueryGetPrekeyBundleRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetPrekeyBundleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetPrekeyBundleRequest.DiscardUnknown(m)
 }
 
-func (m *QueryGetVoteRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Index)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+var xxx_messageInfo_QueryGetPrekeyBundleRequest proto.InternalMessageInfo
+
+func (m *QueryGetPrekeyBundleRequest) GetNodeId() string {
+	if m != nil {
+		return m.NodeId
 	}
-	return n
+	return ""
 }
 
-func (m *QueryGetVoteResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Vote.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+// QueryGetPrekeyBundleResponse defines the QueryGetPrekeyBundleResponse message.
+type QueryGetPrekeyBundleResponse struct {
+	PrekeyBundle       PrekeyBundle `protobuf:"bytes,1,opt,name=prekey_bundle,json=prekeyBundle,proto3" json:"prekey_bundle"`
+	OneTimePrekeyCount uint64       `protobuf:"varint,2,opt,name=one_time_prekey_count,json=oneTimePrekeyCount,proto3" json:"one_time_prekey_count,omitempty"`
 }
 
-func (m *QueryAllVoteRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryGetPrekeyBundleResponse) Reset()         { *m = QueryGetPrekeyBundleResponse{} }
+func (m *QueryGetPrekeyBundleResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetPrekeyBundleResponse) ProtoMessage()    {}
+func (*QueryGetPrekeyBundleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_599c3295223c14f2, []int{43}
+}
+func (m *QueryGetPrekeyBundleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetPrekeyBundleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetPrekeyBundleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+}
+func (m *QueryGetPrekeyBundleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetPrekeyBundleResponse.Merge(m, src)
+}
+func (m *QueryGetPrekeyBundleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetPrekeyBundleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetPrekeyBundleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetPrekeyBundleResponse proto.InternalMessageInfo
+
+func (m *QueryGetPrekeyBundleResponse) GetPrekeyBundle() PrekeyBundle {
+	if m != nil {
+		return m.PrekeyBundle
 	}
-	return n
+	return PrekeyBundle{}
 }
 
-func (m *QueryAllVoteResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryGetPrekeyBundleResponse) GetOneTimePrekeyCount() uint64 {
+	if m != nil {
+		return m.OneTimePrekeyCount
 	}
-	var l int
-	_ = l
-	if len(m.Vote) > 0 {
-		for _, e := range m.Vote {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	return 0
+}
+
+// QueryListPendingMessagesRequest defines the QueryListPendingMessagesRequest message.
+type QueryListPendingMessagesRequest struct {
+	NodeId     string             `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryListPendingMessagesRequest) Reset()         { *m = QueryListPendingMessagesRequest{} }
+func (m *QueryListPendingMessagesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryListPendingMessagesRequest) ProtoMessage()    {}
+func (*QueryListPendingMessagesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_599c3295223c14f2, []int{44}
+}
+func (m *QueryListPendingMessagesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryListPendingMessagesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryListPendingMessagesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+}
+func (m *QueryListPendingMessagesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryListPendingMessagesRequest.Merge(m, src)
+}
+func (m *QueryListPendingMessagesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryListPendingMessagesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryListPendingMessagesRequest.DiscardUnknown(m)
 }
 
-func (m *QueryGetSourceRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Index)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+var xxx_messageInfo_QueryListPendingMessagesRequest proto.InternalMessageInfo
+
+func (m *QueryListPendingMessagesRequest) GetNodeId() string {
+	if m != nil {
+		return m.NodeId
 	}
-	return n
+	return ""
 }
 
-func (m *QueryGetSourceResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryListPendingMessagesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	var l int
-	_ = l
-	l = m.Source.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return nil
 }
 
-func (m *QueryAllSourceRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+// QueryListPendingMessagesResponse defines the QueryListPendingMessagesResponse message.
+type QueryListPendingMessagesResponse struct {
+	Messages   []SignalMessage     `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryAllSourceResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Source) > 0 {
-		for _, e := range m.Source {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+func (m *QueryListPendingMessagesResponse) Reset()         { *m = QueryListPendingMessagesResponse{} }
+func (m *QueryListPendingMessagesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryListPendingMessagesResponse) ProtoMessage()    {}
+func (*QueryListPendingMessagesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_599c3295223c14f2, []int{45}
+}
+func (m *QueryListPendingMessagesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryListPendingMessagesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryListPendingMessagesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+}
+func (m *QueryListPendingMessagesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryListPendingMessagesResponse.Merge(m, src)
+}
+func (m *QueryListPendingMessagesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryListPendingMessagesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryListPendingMessagesResponse.DiscardUnknown(m)
 }
 
-func (m *QueryGetPostTagRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Index)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+var xxx_messageInfo_QueryListPendingMessagesResponse proto.InternalMessageInfo
+
+func (m *QueryListPendingMessagesResponse) GetMessages() []SignalMessage {
+	if m != nil {
+		return m.Messages
 	}
-	return n
+	return nil
 }
 
-func (m *QueryGetPostTagResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryListPendingMessagesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	var l int
-	_ = l
-	l = m.PostTag.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return nil
 }
 
-func (m *QueryAllPostTagRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "resist.posts.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "resist.posts.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryGetSocialPostRequest)(nil), "resist.posts.v1.QueryGetSocialPostRequest")
+	proto.RegisterType((*QueryGetSocialPostResponse)(nil), "resist.posts.v1.QueryGetSocialPostResponse")
+	proto.RegisterType((*QueryAllSocialPostRequest)(nil), "resist.posts.v1.QueryAllSocialPostRequest")
+	proto.RegisterType((*QueryAllSocialPostResponse)(nil), "resist.posts.v1.QueryAllSocialPostResponse")
+	proto.RegisterType((*QueryGetThreadRequest)(nil), "resist.posts.v1.QueryGetThreadRequest")
+	proto.RegisterType((*QueryGetThreadResponse)(nil), "resist.posts.v1.QueryGetThreadResponse")
+	proto.RegisterType((*QueryListPostsByAuthorRequest)(nil), "resist.posts.v1.QueryListPostsByAuthorRequest")
+	proto.RegisterType((*QueryListPostsByAuthorResponse)(nil), "resist.posts.v1.QueryListPostsByAuthorResponse")
+	proto.RegisterType((*QueryListPostsByGroupRequest)(nil), "resist.posts.v1.QueryListPostsByGroupRequest")
+	proto.RegisterType((*QueryListPostsByGroupResponse)(nil), "resist.posts.v1.QueryListPostsByGroupResponse")
+	proto.RegisterType((*QueryListPostsSinceRequest)(nil), "resist.posts.v1.QueryListPostsSinceRequest")
+	proto.RegisterType((*QueryListPostsSinceResponse)(nil), "resist.posts.v1.QueryListPostsSinceResponse")
+	proto.RegisterType((*QueryGetVoteRequest)(nil), "resist.posts.v1.QueryGetVoteRequest")
+	proto.RegisterType((*QueryGetVoteResponse)(nil), "resist.posts.v1.QueryGetVoteResponse")
+	proto.RegisterType((*QueryAllVoteRequest)(nil), "resist.posts.v1.QueryAllVoteRequest")
+	proto.RegisterType((*QueryAllVoteResponse)(nil), "resist.posts.v1.QueryAllVoteResponse")
+	proto.RegisterType((*QueryGetSourceRequest)(nil), "resist.posts.v1.QueryGetSourceRequest")
+	proto.RegisterType((*QueryGetSourceResponse)(nil), "resist.posts.v1.QueryGetSourceResponse")
+	proto.RegisterType((*QueryAllSourceRequest)(nil), "resist.posts.v1.QueryAllSourceRequest")
+	proto.RegisterType((*QueryAllSourceResponse)(nil), "resist.posts.v1.QueryAllSourceResponse")
+	proto.RegisterType((*QueryListSourcePostsRequest)(nil), "resist.posts.v1.QueryListSourcePostsRequest")
+	proto.RegisterType((*QueryListSourcePostsResponse)(nil), "resist.posts.v1.QueryListSourcePostsResponse")
+	proto.RegisterType((*QueryGetPostTagRequest)(nil), "resist.posts.v1.QueryGetPostTagRequest")
+	proto.RegisterType((*QueryGetPostTagResponse)(nil), "resist.posts.v1.QueryGetPostTagResponse")
+	proto.RegisterType((*QueryAllPostTagRequest)(nil), "resist.posts.v1.QueryAllPostTagRequest")
+	proto.RegisterType((*QueryAllPostTagResponse)(nil), "resist.posts.v1.QueryAllPostTagResponse")
+	proto.RegisterType((*QueryGetContentDistributionRequest)(nil), "resist.posts.v1.QueryGetContentDistributionRequest")
+	proto.RegisterType((*QueryGetContentDistributionResponse)(nil), "resist.posts.v1.QueryGetContentDistributionResponse")
+	proto.RegisterType((*QueryAllContentDistributionRequest)(nil), "resist.posts.v1.QueryAllContentDistributionRequest")
+	proto.RegisterType((*QueryAllContentDistributionResponse)(nil), "resist.posts.v1.QueryAllContentDistributionResponse")
+	proto.RegisterType((*QueryAllReplicaAssignmentRequest)(nil), "resist.posts.v1.QueryAllReplicaAssignmentRequest")
+	proto.RegisterType((*QueryAllReplicaAssignmentResponse)(nil), "resist.posts.v1.QueryAllReplicaAssignmentResponse")
+	proto.RegisterType((*QueryGetStorageChallengeRequest)(nil), "resist.posts.v1.QueryGetStorageChallengeRequest")
+	proto.RegisterType((*QueryGetStorageChallengeResponse)(nil), "resist.posts.v1.QueryGetStorageChallengeResponse")
+	proto.RegisterType((*QueryAllStorageChallengeRequest)(nil), "resist.posts.v1.QueryAllStorageChallengeRequest")
+	proto.RegisterType((*QueryAllStorageChallengeResponse)(nil), "resist.posts.v1.QueryAllStorageChallengeResponse")
+	proto.RegisterType((*QueryGetHubSyncRequest)(nil), "resist.posts.v1.QueryGetHubSyncRequest")
+	proto.RegisterType((*QueryGetHubSyncResponse)(nil), "resist.posts.v1.QueryGetHubSyncResponse")
+	proto.RegisterType((*QueryAllHubSyncRequest)(nil), "resist.posts.v1.QueryAllHubSyncRequest")
+	proto.RegisterType((*QueryAllHubSyncResponse)(nil), "resist.posts.v1.QueryAllHubSyncResponse")
+	proto.RegisterType((*QueryGetPrekeyBundleRequest)(nil), "resist.posts.v1.QueryGetPrekeyBundleRequest")
+	proto.RegisterType((*QueryGetPrekeyBundleResponse)(nil), "resist.posts.v1.QueryGetPrekeyBundleResponse")
+	proto.RegisterType((*QueryListPendingMessagesRequest)(nil), "resist.posts.v1.QueryListPendingMessagesRequest")
+	proto.RegisterType((*QueryListPendingMessagesResponse)(nil), "resist.posts.v1.QueryListPendingMessagesResponse")
+}
+
+func init() { proto.RegisterFile("resist/posts/v1/query.proto", fileDescriptor_599c3295223c14f2) }
+
+var fileDescriptor_599c3295223c14f2 = []byte{
+	// 2025 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5a, 0xdd, 0x6f, 0x1c, 0x49,
+	0x11, 0x4f, 0x7b, 0x7d, 0xfe, 0xa8, 0x5c, 0x2e, 0x97, 0x8e, 0x1d, 0x3b, 0xe3, 0x78, 0x1d, 0x8f,
+	0x9d, 0x38, 0xb1, 0x93, 0x9d, 0xac, 0x2f, 0x20, 0x38, 0x5e, 0xb0, 0x73, 0xc2, 0x17, 0x89, 0x8f,
+	0xdc, 0xc6, 0x02, 0x09, 0xe9, 0x34, 0x37, 0xbb, 0xdb, 0x5a, 0x8f, 0x98, 0x9d, 0xd9, 0xdb, 0x99,
+	0xb5, 0x6c, 0x56, 0x0b, 0xe2, 0x00, 0x09, 0x10, 0x12, 0x27, 0x90, 0x10, 0x08, 0x21, 0xf1, 0x00,
+	0x1c, 0x70, 0x9c, 0x84, 0xc4, 0x0b, 0x6f, 0x08, 0x21, 0xa4, 0x7b, 0x41, 0x8a, 0xc4, 0x0b, 0x4f,
+	0x08, 0x25, 0x48, 0x3c, 0xf2, 0x2f, 0xa0, 0xe9, 0xae, 0xd9, 0x9d, 0x9d, 0x9e, 0x9e, 0xdd, 0x0d,
+	0x23, 0xe4, 0x97, 0xc4, 0x3d, 0x5d, 0xd5, 0xf5, 0xab, 0xea, 0xea, 0xae, 0xae, 0x9f, 0x0d, 0x2b,
+	0x6d, 0xe6, 0xdb, 0x7e, 0x60, 0xb4, 0x3c, 0x3f, 0xf0, 0x8d, 0xe3, 0xb2, 0xf1, 0x76, 0x87, 0xb5,
+	0x4f, 0x4b, 0xad, 0xb6, 0x17, 0x78, 0xf4, 0xa2, 0x98, 0x2c, 0xf1, 0xc9, 0xd2, 0x71, 0x59, 0xbb,
+	0x64, 0x35, 0x6d, 0xd7, 0x33, 0xf8, 0xbf, 0x42, 0x46, 0xdb, 0xae, 0x79, 0x7e, 0xd3, 0xf3, 0x8d,
+	0xaa, 0xe5, 0x33, 0xa1, 0x6c, 0x1c, 0x97, 0xab, 0x2c, 0xb0, 0xca, 0x46, 0xcb, 0x6a, 0xd8, 0xae,
+	0x15, 0xd8, 0x9e, 0x8b, 0xb2, 0x0b, 0x0d, 0xaf, 0xe1, 0xf1, 0x1f, 0x8d, 0xf0, 0x27, 0xfc, 0x7a,
+	0xad, 0xe1, 0x79, 0x0d, 0x87, 0x19, 0x56, 0xcb, 0x36, 0x2c, 0xd7, 0xf5, 0x02, 0xae, 0xe2, 0x47,
+	0xeb, 0x27, 0x01, 0xd6, 0x3c, 0x37, 0x60, 0x6e, 0x60, 0xd6, 0x6d, 0x3f, 0x68, 0xdb, 0xd5, 0x4e,
+	0x6c, 0xfd, 0x6b, 0x49, 0xd9, 0x96, 0xd5, 0xb6, 0x9a, 0xd1, 0x4a, 0x45, 0x69, 0xd6, 0xf3, 0x03,
+	0x33, 0xb0, 0x1a, 0x38, 0xbf, 0x21, 0xcd, 0xb7, 0xd9, 0x97, 0xd8, 0xa9, 0x59, 0xed, 0xb8, 0x75,
+	0x87, 0xa1, 0xd0, 0x7a, 0x52, 0xc8, 0xf7, 0x6a, 0xb6, 0xe5, 0x98, 0xe1, 0x58, 0x85, 0xc2, 0xf7,
+	0x3a, 0xed, 0x5a, 0xb4, 0xc0, 0x96, 0x34, 0x1b, 0x78, 0x6d, 0xab, 0xc1, 0xcc, 0xda, 0x91, 0xe5,
+	0x38, 0xcc, 0x6d, 0x44, 0x82, 0x5a, 0x52, 0xf0, 0xd8, 0x0b, 0x70, 0x4e, 0x5f, 0x00, 0xfa, 0x46,
+	0x18, 0xea, 0x47, 0xdc, 0xbf, 0x0a, 0x7b, 0xbb, 0xc3, 0xfc, 0x40, 0x7f, 0x03, 0x2e, 0x0f, 0x7d,
+	0xf5, 0x5b, 0x9e, 0xeb, 0x33, 0xfa, 0x2a, 0xcc, 0x88, 0x38, 0x2c, 0x93, 0xeb, 0xe4, 0xd6, 0xf9,
+	0xdd, 0xa5, 0x52, 0x62, 0x5b, 0x4b, 0x42, 0x61, 0x7f, 0xfe, 0xc3, 0x7f, 0xac, 0x9d, 0xfb, 0xd5,
+	0xbf, 0x7f, 0xb7, 0x4d, 0x2a, 0xa8, 0xa1, 0x97, 0xe1, 0x2a, 0x5f, 0xf2, 0x80, 0x05, 0x8f, 0xb9,
+	0xa3, 0x8f, 0x3c, 0x3f, 0x40, 0x7b, 0x74, 0x01, 0x5e, 0xb0, 0xdd, 0x3a, 0x3b, 0xe1, 0xeb, 0xce,
+	0x57, 0xc4, 0x40, 0x7f, 0x0b, 0xb4, 0x34, 0x15, 0x04, 0xb3, 0x0f, 0xe7, 0x63, 0x11, 0x43, 0x44,
+	0x2b, 0x12, 0xa2, 0x81, 0xe6, 0xfe, 0x74, 0x88, 0xaa, 0x02, 0x7e, 0xff, 0x8b, 0x5e, 0x43, 0x50,
+	0x7b, 0x8e, 0x23, 0x83, 0xfa, 0x14, 0xc0, 0x20, 0xef, 0x70, 0xfd, 0x9b, 0x25, 0x91, 0xa4, 0xa5,
+	0x30, 0x49, 0x4b, 0x22, 0xc3, 0x31, 0x49, 0x4b, 0x8f, 0xac, 0x06, 0x43, 0xdd, 0x4a, 0x4c, 0x53,
+	0xff, 0x35, 0x41, 0x3f, 0x12, 0x56, 0x54, 0x7e, 0x14, 0x26, 0xf6, 0x83, 0x1e, 0x0c, 0x41, 0x9d,
+	0xe2, 0x50, 0xb7, 0x46, 0x42, 0x15, 0x00, 0x86, 0xb0, 0x7e, 0x9f, 0xc0, 0x62, 0x14, 0xf3, 0xc3,
+	0xa3, 0x36, 0xb3, 0xea, 0x99, 0x5b, 0x44, 0x57, 0x60, 0xbe, 0x69, 0x9d, 0x98, 0x75, 0xd6, 0x0a,
+	0x8e, 0xb8, 0xdd, 0x0b, 0x95, 0xb9, 0xa6, 0x75, 0xf2, 0x5a, 0x38, 0x4e, 0x04, 0xb0, 0xf0, 0xdc,
+	0x01, 0xfc, 0x0f, 0x81, 0x2b, 0x49, 0x50, 0x18, 0xbc, 0x8f, 0xc0, 0xf4, 0x64, 0xbb, 0xcf, 0xc5,
+	0xe9, 0x27, 0x60, 0xb6, 0xcd, 0x5a, 0x8e, 0xcd, 0xfc, 0xe5, 0x29, 0x45, 0xbc, 0x85, 0xa1, 0xcf,
+	0x7a, 0x75, 0x86, 0x9a, 0x91, 0x46, 0x22, 0xd8, 0x85, 0xe7, 0x0e, 0x36, 0x5d, 0x86, 0xd9, 0x3a,
+	0x73, 0x58, 0xc0, 0xea, 0xcb, 0xd3, 0xd7, 0xc9, 0xad, 0xb9, 0x4a, 0x34, 0xd4, 0xbf, 0x0a, 0xab,
+	0xdc, 0xe1, 0x4f, 0xdb, 0x7e, 0x10, 0x82, 0xf7, 0xf7, 0x4f, 0xf7, 0x3a, 0xc1, 0x91, 0xd7, 0x8e,
+	0x76, 0xe3, 0x0a, 0xcc, 0x58, 0xfc, 0x03, 0x6e, 0x07, 0x8e, 0x12, 0x21, 0x9f, 0x7a, 0xee, 0x90,
+	0x7f, 0x40, 0xa0, 0xa8, 0x42, 0x70, 0x16, 0xf3, 0xf6, 0x6b, 0x04, 0xae, 0x25, 0xf1, 0x1e, 0xb4,
+	0xbd, 0x4e, 0x2b, 0x0a, 0xd8, 0x55, 0x98, 0x6b, 0x84, 0x63, 0xd3, 0xae, 0xf3, 0x90, 0x4d, 0x57,
+	0x66, 0xf9, 0xf8, 0x61, 0x3d, 0xb7, 0x98, 0xfd, 0x96, 0xc8, 0xbb, 0x86, 0x18, 0xce, 0x62, 0xc8,
+	0xbe, 0x8c, 0xb7, 0x52, 0x1f, 0xed, 0x63, 0xdb, 0xad, 0xb1, 0xd8, 0x71, 0xf7, 0xc3, 0x31, 0x06,
+	0x4b, 0x0c, 0x72, 0x0b, 0xd5, 0x6f, 0x08, 0xac, 0xa4, 0x1a, 0x3f, 0x8b, 0x81, 0xda, 0xc1, 0x62,
+	0x78, 0xc0, 0x82, 0xcf, 0x7b, 0x01, 0xcb, 0xae, 0x59, 0x07, 0xb0, 0x30, 0x2c, 0x8c, 0x1e, 0x19,
+	0x30, 0x1d, 0x56, 0x5d, 0xbc, 0xa8, 0x16, 0x25, 0x57, 0x42, 0xe1, 0xe8, 0x8a, 0x0a, 0x05, 0xf5,
+	0x37, 0xd1, 0xea, 0x9e, 0xe3, 0xc4, 0xad, 0xe6, 0x55, 0x94, 0xde, 0x25, 0x08, 0xb4, 0xbf, 0xbe,
+	0x04, 0xb4, 0x30, 0x16, 0xd0, 0xfc, 0xe2, 0x7c, 0x77, 0x50, 0x7a, 0x1e, 0xf3, 0x77, 0x4e, 0x76,
+	0xa4, 0x3f, 0x37, 0x28, 0x0a, 0x91, 0x78, 0xbf, 0x28, 0xcc, 0x88, 0x87, 0x92, 0xf2, 0x99, 0x22,
+	0x14, 0xd0, 0x0d, 0x14, 0xd6, 0x4d, 0xb4, 0xcf, 0xcb, 0x74, 0xdc, 0x7e, 0x5e, 0x31, 0xff, 0x51,
+	0x54, 0xc7, 0x62, 0x16, 0x52, 0x20, 0x17, 0xc6, 0x86, 0x9c, 0x5f, 0xec, 0xbf, 0x15, 0x3f, 0x90,
+	0xc2, 0x14, 0x3f, 0x96, 0x51, 0x08, 0xd6, 0xe1, 0x45, 0x61, 0xd2, 0x8c, 0xef, 0xc4, 0x79, 0xf1,
+	0xed, 0x21, 0x7f, 0x0a, 0xe4, 0x75, 0x37, 0xbc, 0x1f, 0xbf, 0xca, 0x87, 0xa0, 0x9c, 0xc5, 0xcb,
+	0xa1, 0x34, 0xc8, 0xc2, 0x70, 0xe1, 0x43, 0xab, 0x91, 0x9d, 0xb5, 0x87, 0xb0, 0x24, 0xc9, 0xa3,
+	0x5f, 0x1f, 0x87, 0xb9, 0xa8, 0x8f, 0xc0, 0x24, 0x5b, 0x96, 0xdf, 0xd7, 0x42, 0x27, 0x7a, 0x92,
+	0xb4, 0xc4, 0x50, 0x7f, 0x6b, 0x90, 0x58, 0x09, 0x14, 0x79, 0xe5, 0xee, 0x4f, 0x09, 0x02, 0x8f,
+	0x9b, 0x48, 0x05, 0x5e, 0x98, 0x00, 0x78, 0x7e, 0xfb, 0xf0, 0x00, 0xf4, 0x28, 0xae, 0x0f, 0x44,
+	0x5b, 0xf7, 0x5a, 0xac, 0xab, 0x8b, 0xa2, 0xb1, 0x0a, 0x10, 0x35, 0x7d, 0xf8, 0x0e, 0x98, 0xaf,
+	0xcc, 0xe3, 0x97, 0x87, 0x75, 0xfd, 0x1b, 0x04, 0x36, 0x32, 0x57, 0x41, 0x87, 0xdf, 0x84, 0x85,
+	0xb4, 0xde, 0x11, 0xc3, 0xbb, 0x29, 0x39, 0x9f, 0xb2, 0x16, 0x06, 0xe2, 0x72, 0x4d, 0x9e, 0xd2,
+	0x1d, 0xf4, 0x65, 0xcf, 0x71, 0x32, 0x7c, 0xc9, 0x6b, 0x67, 0xff, 0x1a, 0x39, 0xad, 0x32, 0x37,
+	0xd2, 0xe9, 0x42, 0x0e, 0x4e, 0xe7, 0x97, 0x09, 0xdf, 0x26, 0x70, 0x3d, 0xf2, 0xa7, 0x12, 0x3e,
+	0xd9, 0x6b, 0xd6, 0x9e, 0xef, 0xdb, 0x0d, 0xb7, 0xc9, 0xdc, 0x60, 0xbc, 0x44, 0xc8, 0xed, 0x2e,
+	0xfb, 0x0b, 0x81, 0xf5, 0x0c, 0x2c, 0x18, 0xd9, 0x2f, 0x00, 0x6d, 0x8b, 0x49, 0xd3, 0xea, 0xcf,
+	0x62, 0x5c, 0x75, 0x29, 0xae, 0xd2, 0x3a, 0x18, 0xd5, 0x4b, 0xed, 0xe4, 0x44, 0x7e, 0x31, 0x2d,
+	0xc3, 0x5a, 0xbf, 0xd6, 0x0a, 0x92, 0xe1, 0x41, 0xc4, 0x31, 0x44, 0x11, 0x7d, 0x09, 0xa6, 0xfa,
+	0x4f, 0xeb, 0x29, 0xbb, 0xae, 0x9f, 0xe0, 0x2e, 0xa4, 0xaa, 0xa0, 0xe3, 0x87, 0x70, 0x49, 0xe2,
+	0x2c, 0x30, 0x93, 0xd7, 0xe5, 0xfb, 0x3c, 0xb1, 0x0a, 0xba, 0xfd, 0xb2, 0x9f, 0xf8, 0xae, 0xdb,
+	0x08, 0x36, 0xac, 0xb2, 0x0a, 0xb0, 0x79, 0x9d, 0x9d, 0x3f, 0xc5, 0x72, 0x6d, 0x52, 0x2f, 0x0b,
+	0xff, 0x93, 0x97, 0x79, 0xee, 0x6d, 0xbf, 0x82, 0xbd, 0xde, 0xa9, 0x3e, 0x3e, 0x75, 0x6b, 0x51,
+	0x94, 0x96, 0x60, 0xd6, 0x3f, 0x75, 0x6b, 0x83, 0x13, 0x32, 0x13, 0x0e, 0x1f, 0xd6, 0xe3, 0x45,
+	0xac, 0xaf, 0x32, 0xa8, 0x05, 0x47, 0x9d, 0xaa, 0x19, 0x0a, 0x2a, 0x8b, 0x18, 0xea, 0x44, 0xb5,
+	0xe0, 0x48, 0x0c, 0xf5, 0xd3, 0x41, 0x11, 0x93, 0x81, 0xb8, 0x5e, 0x9d, 0xc5, 0x80, 0x84, 0xc3,
+	0x1c, 0xcf, 0x69, 0xbc, 0xba, 0x65, 0x7b, 0x54, 0x98, 0xc0, 0xa3, 0xfc, 0xf6, 0xe8, 0xa3, 0xf8,
+	0x3a, 0x0b, 0x5f, 0x0d, 0x9c, 0x4a, 0xdc, 0xe7, 0x4c, 0xe2, 0xa8, 0xf8, 0xe8, 0x3f, 0x89, 0xde,
+	0x52, 0x92, 0x22, 0x3a, 0xf7, 0x3a, 0x5c, 0x18, 0xe2, 0x26, 0x71, 0xcf, 0x56, 0xe5, 0xfa, 0x1d,
+	0xd3, 0x46, 0x37, 0x5f, 0x6c, 0xc5, 0xbe, 0xd1, 0x32, 0x2c, 0x7a, 0x2e, 0x33, 0x03, 0xbb, 0xc9,
+	0x4c, 0x5c, 0xb2, 0xe6, 0x75, 0xdc, 0x80, 0xbb, 0x3d, 0x5d, 0xa1, 0x9e, 0xcb, 0x0e, 0xed, 0x26,
+	0x13, 0xeb, 0x3c, 0x08, 0x67, 0xf4, 0x77, 0x08, 0x9e, 0x54, 0xde, 0x05, 0x32, 0xb7, 0x6e, 0xbb,
+	0x8d, 0xcf, 0x30, 0xdf, 0xb7, 0x1a, 0xcc, 0xff, 0xbf, 0x6d, 0xfd, 0x07, 0xd1, 0x11, 0x4e, 0x05,
+	0x81, 0x61, 0xfa, 0x24, 0xcc, 0x35, 0xf1, 0x1b, 0xe6, 0x40, 0x51, 0x3e, 0xb9, 0x76, 0xc3, 0xb5,
+	0x1c, 0x54, 0xc5, 0x10, 0xf5, 0xb5, 0x72, 0x4b, 0x85, 0xdd, 0x3f, 0xaf, 0xc0, 0x0b, 0x1c, 0x2f,
+	0x0d, 0x60, 0x46, 0xd0, 0xad, 0x74, 0x43, 0x02, 0x23, 0x73, 0xba, 0xda, 0x66, 0xb6, 0x90, 0x30,
+	0xa5, 0xaf, 0xbd, 0xf3, 0xb7, 0x7f, 0xfd, 0x60, 0xea, 0x2a, 0x5d, 0x32, 0xd2, 0x19, 0x70, 0xfa,
+	0x43, 0x02, 0x17, 0x86, 0x08, 0x59, 0xba, 0x9d, 0xbe, 0x70, 0x1a, 0xd1, 0xab, 0xed, 0x8c, 0x25,
+	0x8b, 0x58, 0xee, 0x70, 0x2c, 0x37, 0xe9, 0xa6, 0x91, 0x41, 0x95, 0x1b, 0x5d, 0xfe, 0xb0, 0xee,
+	0xd1, 0xef, 0x11, 0x78, 0x49, 0xb4, 0x0c, 0xa3, 0x90, 0xa5, 0xb1, 0xbd, 0x2a, 0x64, 0xa9, 0x9c,
+	0xad, 0xbe, 0xc9, 0x91, 0x15, 0xe9, 0xb5, 0x2c, 0x64, 0xf4, 0x9b, 0x04, 0xe6, 0xfb, 0x94, 0x25,
+	0xbd, 0xa9, 0x74, 0x7d, 0x88, 0x68, 0xd5, 0xb6, 0x46, 0xca, 0x21, 0x88, 0x2d, 0x0e, 0x62, 0x9d,
+	0xae, 0x49, 0x20, 0x02, 0x2e, 0xd8, 0x8f, 0xcc, 0x7b, 0x04, 0x2e, 0x49, 0x3c, 0x1e, 0x2d, 0xa5,
+	0xdb, 0x51, 0x51, 0x8e, 0x9a, 0x31, 0xb6, 0x3c, 0xe2, 0x2b, 0x73, 0x7c, 0x3b, 0xf4, 0xb6, 0x91,
+	0xf6, 0xeb, 0x12, 0xdf, 0xac, 0x9e, 0x9a, 0x82, 0xb5, 0x34, 0xba, 0xe2, 0xff, 0x1e, 0xfd, 0x05,
+	0x81, 0x97, 0x93, 0xec, 0x19, 0xbd, 0x3b, 0xd2, 0x70, 0x9c, 0xe9, 0xd3, 0x4a, 0xe3, 0x8a, 0x23,
+	0xcc, 0x5d, 0x0e, 0xf3, 0x0e, 0xdd, 0x56, 0xc3, 0xe4, 0x4c, 0xa1, 0xd1, 0x8d, 0x08, 0xc4, 0x1e,
+	0xfd, 0x31, 0xe6, 0xda, 0x80, 0xba, 0xa2, 0x3b, 0x23, 0xcc, 0xc6, 0xd9, 0x35, 0xed, 0xce, 0x78,
+	0xc2, 0x23, 0xcf, 0x81, 0x40, 0xc8, 0xb9, 0x39, 0xa3, 0xcb, 0xff, 0xeb, 0xd1, 0x1e, 0xcc, 0x22,
+	0xf9, 0x44, 0x37, 0x95, 0xa9, 0x14, 0xa3, 0x94, 0xb4, 0x1b, 0x23, 0xa4, 0x10, 0xc5, 0x0d, 0x8e,
+	0x62, 0x8d, 0xae, 0x1a, 0x69, 0xbf, 0x4e, 0xea, 0x27, 0xdb, 0x31, 0xcc, 0x85, 0x6e, 0x64, 0xd9,
+	0x1f, 0xa6, 0xb4, 0x54, 0xf6, 0x13, 0xc4, 0x94, 0xbe, 0xca, 0xed, 0x2f, 0xd1, 0xc5, 0x54, 0xfb,
+	0xd1, 0x61, 0x13, 0x84, 0x41, 0xc6, 0x61, 0x1b, 0xa2, 0x76, 0x32, 0x0e, 0xdb, 0x30, 0x41, 0x93,
+	0x71, 0xd8, 0x04, 0x07, 0xd2, 0xf7, 0xff, 0x2b, 0x00, 0x03, 0xe2, 0x42, 0x85, 0x23, 0x49, 0x31,
+	0xa9, 0x70, 0x48, 0x44, 0x51, 0xc6, 0xfd, 0x8c, 0x94, 0xd0, 0xcf, 0x09, 0x5c, 0x4c, 0x30, 0x27,
+	0x34, 0x23, 0xdd, 0x64, 0xae, 0x47, 0xbb, 0x3b, 0xa6, 0x34, 0x22, 0xba, 0xcf, 0x11, 0x95, 0xe8,
+	0x1d, 0x05, 0x22, 0x53, 0x8c, 0xbb, 0x71, 0xfe, 0xa8, 0x47, 0xbf, 0x43, 0x00, 0x06, 0x1c, 0x08,
+	0x55, 0xef, 0xc3, 0x30, 0x9f, 0xa1, 0xdd, 0x1a, 0x2d, 0x88, 0xb8, 0x6e, 0x73, 0x5c, 0x1b, 0x74,
+	0xdd, 0x50, 0xfd, 0xb6, 0xb6, 0xbf, 0x67, 0x5f, 0x27, 0x70, 0x3e, 0x3a, 0x7b, 0x19, 0x68, 0x24,
+	0x76, 0x45, 0x85, 0x46, 0xe6, 0x48, 0xf4, 0x75, 0x8e, 0x66, 0x85, 0x5e, 0x55, 0xa2, 0xa1, 0x7f,
+	0x24, 0x70, 0x25, 0x9d, 0x78, 0xa0, 0xaf, 0x28, 0xbd, 0x56, 0x13, 0x04, 0xda, 0xfd, 0xc9, 0x94,
+	0x10, 0xe8, 0xab, 0x1c, 0xe8, 0x7d, 0xba, 0x6b, 0x8c, 0xf3, 0xeb, 0x72, 0xa3, 0x3b, 0x68, 0xa3,
+	0x7b, 0xf4, 0xf7, 0x04, 0x96, 0xc2, 0x38, 0x4e, 0xe0, 0x42, 0x26, 0xc7, 0xa1, 0x72, 0x21, 0x9b,
+	0xa9, 0xd0, 0xef, 0x72, 0x17, 0xb6, 0xe8, 0x8d, 0xb1, 0x5c, 0xa0, 0x7f, 0x20, 0xb0, 0x18, 0xa2,
+	0x96, 0x1a, 0x6b, 0x5a, 0x56, 0x9a, 0x57, 0x11, 0x0b, 0xda, 0xee, 0x24, 0x2a, 0x88, 0xf7, 0x63,
+	0x1c, 0xef, 0x2e, 0xbd, 0x27, 0xe1, 0x95, 0x69, 0x81, 0xe1, 0x80, 0xbf, 0x4f, 0xe0, 0x72, 0x4a,
+	0x83, 0x4d, 0xef, 0xa9, 0xaf, 0xb5, 0xf4, 0x8e, 0x58, 0x2b, 0x4f, 0xa0, 0x81, 0xb0, 0x0d, 0x0e,
+	0xfb, 0x36, 0xdd, 0x32, 0x46, 0xfe, 0x21, 0x82, 0xd1, 0x0d, 0xd1, 0xbe, 0x47, 0x60, 0x81, 0xdf,
+	0x22, 0x63, 0xc2, 0x55, 0x37, 0xf0, 0x5a, 0x79, 0x02, 0x0d, 0x84, 0xbb, 0xcd, 0xe1, 0x6e, 0x52,
+	0x7d, 0x34, 0x5c, 0xfa, 0x5d, 0x71, 0x3b, 0x61, 0x5b, 0x97, 0x71, 0x3b, 0x0d, 0x37, 0xaa, 0x19,
+	0xb7, 0x53, 0xa2, 0xab, 0xd4, 0x77, 0x38, 0x9a, 0x1b, 0x74, 0x43, 0x42, 0x13, 0x35, 0x9b, 0x46,
+	0x17, 0x9b, 0xef, 0xc1, 0xfd, 0x34, 0x02, 0x8f, 0xd4, 0x38, 0x67, 0xdc, 0x4f, 0x49, 0x3c, 0xea,
+	0xfb, 0x29, 0xc2, 0x43, 0x7f, 0x46, 0xe0, 0x62, 0xa2, 0x8f, 0x54, 0x55, 0x96, 0xf4, 0x3e, 0x55,
+	0x55, 0x59, 0x14, 0xcd, 0xa9, 0x7e, 0x8f, 0x63, 0xda, 0xa6, 0xb7, 0x8c, 0xcc, 0xbf, 0xa7, 0x31,
+	0xba, 0xd8, 0x21, 0xf6, 0xe8, 0x2f, 0x09, 0x5c, 0x4e, 0xe9, 0xe3, 0x54, 0x09, 0xa6, 0xee, 0x3b,
+	0x55, 0x09, 0x96, 0xd1, 0x24, 0x66, 0x24, 0x58, 0xd3, 0xb2, 0x9d, 0xaa, 0x77, 0x32, 0x00, 0xba,
+	0x5f, 0xfa, 0xf0, 0x69, 0x91, 0x3c, 0x79, 0x5a, 0x24, 0xff, 0x7c, 0x5a, 0x24, 0xef, 0x3e, 0x2b,
+	0x9e, 0x7b, 0xf2, 0xac, 0x78, 0xee, 0xef, 0xcf, 0x8a, 0xe7, 0xbe, 0xb8, 0x80, 0xca, 0x27, 0xa8,
+	0x1e, 0x9c, 0xb6, 0x98, 0x5f, 0x9d, 0xe1, 0x7f, 0xad, 0xf3, 0xca, 0x7f, 0x03, 0x00, 0x00, 0xff,
+	0xff, 0xda, 0xba, 0x3b, 0x66, 0x65, 0x25, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Parameters queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// ListSocialPost Queries a list of SocialPost items.
+	GetSocialPost(ctx context.Context, in *QueryGetSocialPostRequest, opts ...grpc.CallOption) (*QueryGetSocialPostResponse, error)
+	// ListSocialPost defines the ListSocialPost RPC.
+	ListSocialPost(ctx context.Context, in *QueryAllSocialPostRequest, opts ...grpc.CallOption) (*QueryAllSocialPostResponse, error)
+	// GetThread Queries a post and the tree of its replies, paginated over its
+	// direct replies.
+	GetThread(ctx context.Context, in *QueryGetThreadRequest, opts ...grpc.CallOption) (*QueryGetThreadResponse, error)
+	// ListPostsByAuthor Queries the posts of an author, oldest first.
+	ListPostsByAuthor(ctx context.Context, in *QueryListPostsByAuthorRequest, opts ...grpc.CallOption) (*QueryListPostsByAuthorResponse, error)
+	// ListPostsByGroup Queries the posts of a group, oldest first.
+	ListPostsByGroup(ctx context.Context, in *QueryListPostsByGroupRequest, opts ...grpc.CallOption) (*QueryListPostsByGroupResponse, error)
+	// ListPostsSince Queries the posts created at or after a time, oldest
+	// first.
+	ListPostsSince(ctx context.Context, in *QueryListPostsSinceRequest, opts ...grpc.CallOption) (*QueryListPostsSinceResponse, error)
+	// ListVote Queries a list of Vote items.
+	GetVote(ctx context.Context, in *QueryGetVoteRequest, opts ...grpc.CallOption) (*QueryGetVoteResponse, error)
+	// ListVote defines the ListVote RPC.
+	ListVote(ctx context.Context, in *QueryAllVoteRequest, opts ...grpc.CallOption) (*QueryAllVoteResponse, error)
+	// ListSource Queries a list of Source items.
+	GetSource(ctx context.Context, in *QueryGetSourceRequest, opts ...grpc.CallOption) (*QueryGetSourceResponse, error)
+	// ListSource defines the ListSource RPC.
+	ListSource(ctx context.Context, in *QueryAllSourceRequest, opts ...grpc.CallOption) (*QueryAllSourceResponse, error)
+	// ListSourcePosts Queries the posts citing a source, oldest first.
+	ListSourcePosts(ctx context.Context, in *QueryListSourcePostsRequest, opts ...grpc.CallOption) (*QueryListSourcePostsResponse, error)
+	// ListPostTag Queries a list of PostTag items.
+	GetPostTag(ctx context.Context, in *QueryGetPostTagRequest, opts ...grpc.CallOption) (*QueryGetPostTagResponse, error)
+	// ListPostTag defines the ListPostTag RPC.
+	ListPostTag(ctx context.Context, in *QueryAllPostTagRequest, opts ...grpc.CallOption) (*QueryAllPostTagResponse, error)
+	// GetContentDistribution Queries a ContentDistribution by content id.
+	GetContentDistribution(ctx context.Context, in *QueryGetContentDistributionRequest, opts ...grpc.CallOption) (*QueryGetContentDistributionResponse, error)
+	// ListContentDistribution defines the ListContentDistribution RPC.
+	ListContentDistribution(ctx context.Context, in *QueryAllContentDistributionRequest, opts ...grpc.CallOption) (*QueryAllContentDistributionResponse, error)
+	// ListReplicaAssignment Queries the replica assignments of a content.
+	ListReplicaAssignment(ctx context.Context, in *QueryAllReplicaAssignmentRequest, opts ...grpc.CallOption) (*QueryAllReplicaAssignmentResponse, error)
+	// GetStorageChallenge Queries a StorageChallenge by id.
+	GetStorageChallenge(ctx context.Context, in *QueryGetStorageChallengeRequest, opts ...grpc.CallOption) (*QueryGetStorageChallengeResponse, error)
+	// ListStorageChallenge Queries a list of StorageChallenge items.
+	ListStorageChallenge(ctx context.Context, in *QueryAllStorageChallengeRequest, opts ...grpc.CallOption) (*QueryAllStorageChallengeResponse, error)
+	// GetHubSync Queries a HubSync by sync id.
+	GetHubSync(ctx context.Context, in *QueryGetHubSyncRequest, opts ...grpc.CallOption) (*QueryGetHubSyncResponse, error)
+	// ListHubSync Queries a list of HubSync items, optionally those of a node.
+	ListHubSync(ctx context.Context, in *QueryAllHubSyncRequest, opts ...grpc.CallOption) (*QueryAllHubSyncResponse, error)
+	// GetPrekeyBundle Queries the prekey bundle of a node and the number of
+	// one-time prekeys it has left. One-time prekeys are handed out by
+	// MsgClaimPrekeyBundle, as queries cannot consume them.
+	GetPrekeyBundle(ctx context.Context, in *QueryGetPrekeyBundleRequest, opts ...grpc.CallOption) (*QueryGetPrekeyBundleResponse, error)
+	// ListPendingMessages Queries the messages queued in the mailbox of a node.
+	ListPendingMessages(ctx context.Context, in *QueryListPendingMessagesRequest, opts ...grpc.CallOption) (*QueryListPendingMessagesResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/resist.posts.v1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetSocialPost(ctx context.Context, in *QueryGetSocialPostRequest, opts ...grpc.CallOption) (*QueryGetSocialPostResponse, error) {
+	out := new(QueryGetSocialPostResponse)
+	err := c.cc.Invoke(ctx, "/resist.posts.v1.Query/GetSocialPost", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ListSocialPost(ctx context.Context, in *QueryAllSocialPostRequest, opts ...grpc.CallOption) (*QueryAllSocialPostResponse, error) {
+	out := new(QueryAllSocialPostResponse)
+	err := c.cc.Invoke(ctx, "/resist.posts.v1.Query/ListSocialPost", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetThread(ctx context.Context, in *QueryGetThreadRequest, opts ...grpc.CallOption) (*QueryGetThreadResponse, error) {
+	out := new(QueryGetThreadResponse)
+	err := c.cc.Invoke(ctx, "/resist.posts.v1.Query/GetThread", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ListPostsByAuthor(ctx context.Context, in *QueryListPostsByAuthorRequest, opts ...grpc.CallOption) (*QueryListPostsByAuthorResponse, error) {
+	out := new(QueryListPostsByAuthorResponse)
+	err := c.cc.Invoke(ctx, "/resist.posts.v1.Query/ListPostsByAuthor", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ListPostsByGroup(ctx context.Context, in *QueryListPostsByGroupRequest, opts ...grpc.CallOption) (*QueryListPostsByGroupResponse, error) {
+	out := new(QueryListPostsByGroupResponse)
+	err := c.cc.Invoke(ctx, "/resist.posts.v1.Query/ListPostsByGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ListPostsSince(ctx context.Context, in *QueryListPostsSinceRequest, opts ...grpc.CallOption) (*QueryListPostsSinceResponse, error) {
+	out := new(QueryListPostsSinceResponse)
+	err := c.cc.Invoke(ctx, "/resist.posts.v1.Query/ListPostsSince", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetVote(ctx context.Context, in *QueryGetVoteRequest, opts ...grpc.CallOption) (*QueryGetVoteResponse, error) {
+	out := new(QueryGetVoteResponse)
+	err := c.cc.Invoke(ctx, "/resist.posts.v1.Query/GetVote", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ListVote(ctx context.Context, in *QueryAllVoteRequest, opts ...grpc.CallOption) (*QueryAllVoteResponse, error) {
+	out := new(QueryAllVoteResponse)
+	err := c.cc.Invoke(ctx, "/resist.posts.v1.Query/ListVote", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetSource(ctx context.Context, in *QueryGetSourceRequest, opts ...grpc.CallOption) (*QueryGetSourceResponse, error) {
+	out := new(QueryGetSourceResponse)
+	err := c.cc.Invoke(ctx, "/resist.posts.v1.Query/GetSource", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ListSource(ctx context.Context, in *QueryAllSourceRequest, opts ...grpc.CallOption) (*QueryAllSourceResponse, error) {
+	out := new(QueryAllSourceResponse)
+	err := c.cc.Invoke(ctx, "/resist.posts.v1.Query/ListSource", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ListSourcePosts(ctx context.Context, in *QueryListSourcePostsRequest, opts ...grpc.CallOption) (*QueryListSourcePostsResponse, error) {
+	out := new(QueryListSourcePostsResponse)
+	err := c.cc.Invoke(ctx, "/resist.posts.v1.Query/ListSourcePosts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetPostTag(ctx context.Context, in *QueryGetPostTagRequest, opts ...grpc.CallOption) (*QueryGetPostTagResponse, error) {
+	out := new(QueryGetPostTagResponse)
+	err := c.cc.Invoke(ctx, "/resist.posts.v1.Query/GetPostTag", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ListPostTag(ctx context.Context, in *QueryAllPostTagRequest, opts ...grpc.CallOption) (*QueryAllPostTagResponse, error) {
+	out := new(QueryAllPostTagResponse)
+	err := c.cc.Invoke(ctx, "/resist.posts.v1.Query/ListPostTag", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetContentDistribution(ctx context.Context, in *QueryGetContentDistributionRequest, opts ...grpc.CallOption) (*QueryGetContentDistributionResponse, error) {
+	out := new(QueryGetContentDistributionResponse)
+	err := c.cc.Invoke(ctx, "/resist.posts.v1.Query/GetContentDistribution", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ListContentDistribution(ctx context.Context, in *QueryAllContentDistributionRequest, opts ...grpc.CallOption) (*QueryAllContentDistributionResponse, error) {
+	out := new(QueryAllContentDistributionResponse)
+	err := c.cc.Invoke(ctx, "/resist.posts.v1.Query/ListContentDistribution", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ListReplicaAssignment(ctx context.Context, in *QueryAllReplicaAssignmentRequest, opts ...grpc.CallOption) (*QueryAllReplicaAssignmentResponse, error) {
+	out := new(QueryAllReplicaAssignmentResponse)
+	err := c.cc.Invoke(ctx, "/resist.posts.v1.Query/ListReplicaAssignment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetStorageChallenge(ctx context.Context, in *QueryGetStorageChallengeRequest, opts ...grpc.CallOption) (*QueryGetStorageChallengeResponse, error) {
+	out := new(QueryGetStorageChallengeResponse)
+	err := c.cc.Invoke(ctx, "/resist.posts.v1.Query/GetStorageChallenge", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ListStorageChallenge(ctx context.Context, in *QueryAllStorageChallengeRequest, opts ...grpc.CallOption) (*QueryAllStorageChallengeResponse, error) {
+	out := new(QueryAllStorageChallengeResponse)
+	err := c.cc.Invoke(ctx, "/resist.posts.v1.Query/ListStorageChallenge", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetHubSync(ctx context.Context, in *QueryGetHubSyncRequest, opts ...grpc.CallOption) (*QueryGetHubSyncResponse, error) {
+	out := new(QueryGetHubSyncResponse)
+	err := c.cc.Invoke(ctx, "/resist.posts.v1.Query/GetHubSync", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ListHubSync(ctx context.Context, in *QueryAllHubSyncRequest, opts ...grpc.CallOption) (*QueryAllHubSyncResponse, error) {
+	out := new(QueryAllHubSyncResponse)
+	err := c.cc.Invoke(ctx, "/resist.posts.v1.Query/ListHubSync", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetPrekeyBundle(ctx context.Context, in *QueryGetPrekeyBundleRequest, opts ...grpc.CallOption) (*QueryGetPrekeyBundleResponse, error) {
+	out := new(QueryGetPrekeyBundleResponse)
+	err := c.cc.Invoke(ctx, "/resist.posts.v1.Query/GetPrekeyBundle", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ListPendingMessages(ctx context.Context, in *QueryListPendingMessagesRequest, opts ...grpc.CallOption) (*QueryListPendingMessagesResponse, error) {
+	out := new(QueryListPendingMessagesResponse)
+	err := c.cc.Invoke(ctx, "/resist.posts.v1.Query/ListPendingMessages", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Parameters queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// ListSocialPost Queries a list of SocialPost items.
+	GetSocialPost(context.Context, *QueryGetSocialPostRequest) (*QueryGetSocialPostResponse, error)
+	// ListSocialPost defines the ListSocialPost RPC.
+	ListSocialPost(context.Context, *QueryAllSocialPostRequest) (*QueryAllSocialPostResponse, error)
+	// GetThread Queries a post and the tree of its replies, paginated over its
+	// direct replies.
+	GetThread(context.Context, *QueryGetThreadRequest) (*QueryGetThreadResponse, error)
+	// ListPostsByAuthor Queries the posts of an author, oldest first.
+	ListPostsByAuthor(context.Context, *QueryListPostsByAuthorRequest) (*QueryListPostsByAuthorResponse, error)
+	// ListPostsByGroup Queries the posts of a group, oldest first.
+	ListPostsByGroup(context.Context, *QueryListPostsByGroupRequest) (*QueryListPostsByGroupResponse, error)
+	// ListPostsSince Queries the posts created at or after a time, oldest
+	// first.
+	ListPostsSince(context.Context, *QueryListPostsSinceRequest) (*QueryListPostsSinceResponse, error)
+	// ListVote Queries a list of Vote items.
+	GetVote(context.Context, *QueryGetVoteRequest) (*QueryGetVoteResponse, error)
+	// ListVote defines the ListVote RPC.
+	ListVote(context.Context, *QueryAllVoteRequest) (*QueryAllVoteResponse, error)
+	// ListSource Queries a list of Source items.
+	GetSource(context.Context, *QueryGetSourceRequest) (*QueryGetSourceResponse, error)
+	// ListSource defines the ListSource RPC.
+	ListSource(context.Context, *QueryAllSourceRequest) (*QueryAllSourceResponse, error)
+	// ListSourcePosts Queries the posts citing a source, oldest first.
+	ListSourcePosts(context.Context, *QueryListSourcePostsRequest) (*QueryListSourcePostsResponse, error)
+	// ListPostTag Queries a list of PostTag items.
+	GetPostTag(context.Context, *QueryGetPostTagRequest) (*QueryGetPostTagResponse, error)
+	// ListPostTag defines the ListPostTag RPC.
+	ListPostTag(context.Context, *QueryAllPostTagRequest) (*QueryAllPostTagResponse, error)
+	// GetContentDistribution Queries a ContentDistribution by content id.
+	GetContentDistribution(context.Context, *QueryGetContentDistributionRequest) (*QueryGetContentDistributionResponse, error)
+	// ListContentDistribution defines the ListContentDistribution RPC.
+	ListContentDistribution(context.Context, *QueryAllContentDistributionRequest) (*QueryAllContentDistributionResponse, error)
+	// ListReplicaAssignment Queries the replica assignments of a content.
+	ListReplicaAssignment(context.Context, *QueryAllReplicaAssignmentRequest) (*QueryAllReplicaAssignmentResponse, error)
+	// GetStorageChallenge Queries a StorageChallenge by id.
+	GetStorageChallenge(context.Context, *QueryGetStorageChallengeRequest) (*QueryGetStorageChallengeResponse, error)
+	// ListStorageChallenge Queries a list of StorageChallenge items.
+	ListStorageChallenge(context.Context, *QueryAllStorageChallengeRequest) (*QueryAllStorageChallengeResponse, error)
+	// GetHubSync Queries a HubSync by sync id.
+	GetHubSync(context.Context, *QueryGetHubSyncRequest) (*QueryGetHubSyncResponse, error)
+	// ListHubSync Queries a list of HubSync items, optionally those of a node.
+	ListHubSync(context.Context, *QueryAllHubSyncRequest) (*QueryAllHubSyncResponse, error)
+	// GetPrekeyBundle Queries the prekey bundle of a node and the number of
+	// one-time prekeys it has left. One-time prekeys are handed out by
+	// MsgClaimPrekeyBundle, as queries cannot consume them.
+	GetPrekeyBundle(context.Context, *QueryGetPrekeyBundleRequest) (*QueryGetPrekeyBundleResponse, error)
+	// ListPendingMessages Queries the messages queued in the mailbox of a node.
+	ListPendingMessages(context.Context, *QueryListPendingMessagesRequest) (*QueryListPendingMessagesResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) GetSocialPost(ctx context.Context, req *QueryGetSocialPostRequest) (*QueryGetSocialPostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSocialPost not implemented")
+}
+func (*UnimplementedQueryServer) ListSocialPost(ctx context.Context, req *QueryAllSocialPostRequest) (*QueryAllSocialPostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSocialPost not implemented")
+}
+func (*UnimplementedQueryServer) GetThread(ctx context.Context, req *QueryGetThreadRequest) (*QueryGetThreadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetThread not implemented")
+}
+func (*UnimplementedQueryServer) ListPostsByAuthor(ctx context.Context, req *QueryListPostsByAuthorRequest) (*QueryListPostsByAuthorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPostsByAuthor not implemented")
+}
+func (*UnimplementedQueryServer) ListPostsByGroup(ctx context.Context, req *QueryListPostsByGroupRequest) (*QueryListPostsByGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPostsByGroup not implemented")
+}
+func (*UnimplementedQueryServer) ListPostsSince(ctx context.Context, req *QueryListPostsSinceRequest) (*QueryListPostsSinceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPostsSince not implemented")
+}
+func (*UnimplementedQueryServer) GetVote(ctx context.Context, req *QueryGetVoteRequest) (*QueryGetVoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVote not implemented")
+}
+func (*UnimplementedQueryServer) ListVote(ctx context.Context, req *QueryAllVoteRequest) (*QueryAllVoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListVote not implemented")
+}
+func (*UnimplementedQueryServer) GetSource(ctx context.Context, req *QueryGetSourceRequest) (*QueryGetSourceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSource not implemented")
+}
+func (*UnimplementedQueryServer) ListSource(ctx context.Context, req *QueryAllSourceRequest) (*QueryAllSourceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSource not implemented")
+}
+func (*UnimplementedQueryServer) ListSourcePosts(ctx context.Context, req *QueryListSourcePostsRequest) (*QueryListSourcePostsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSourcePosts not implemented")
+}
+func (*UnimplementedQueryServer) GetPostTag(ctx context.Context, req *QueryGetPostTagRequest) (*QueryGetPostTagResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPostTag not implemented")
+}
+func (*UnimplementedQueryServer) ListPostTag(ctx context.Context, req *QueryAllPostTagRequest) (*QueryAllPostTagResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPostTag not implemented")
+}
+func (*UnimplementedQueryServer) GetContentDistribution(ctx context.Context, req *QueryGetContentDistributionRequest) (*QueryGetContentDistributionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetContentDistribution not implemented")
+}
+func (*UnimplementedQueryServer) ListContentDistribution(ctx context.Context, req *QueryAllContentDistributionRequest) (*QueryAllContentDistributionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListContentDistribution not implemented")
+}
+func (*UnimplementedQueryServer) ListReplicaAssignment(ctx context.Context, req *QueryAllReplicaAssignmentRequest) (*QueryAllReplicaAssignmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListReplicaAssignment not implemented")
+}
+func (*UnimplementedQueryServer) GetStorageChallenge(ctx context.Context, req *QueryGetStorageChallengeRequest) (*QueryGetStorageChallengeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStorageChallenge not implemented")
+}
+func (*UnimplementedQueryServer) ListStorageChallenge(ctx context.Context, req *QueryAllStorageChallengeRequest) (*QueryAllStorageChallengeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListStorageChallenge not implemented")
+}
+func (*UnimplementedQueryServer) GetHubSync(ctx context.Context, req *QueryGetHubSyncRequest) (*QueryGetHubSyncResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHubSync not implemented")
+}
+func (*UnimplementedQueryServer) ListHubSync(ctx context.Context, req *QueryAllHubSyncRequest) (*QueryAllHubSyncResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListHubSync not implemented")
+}
+func (*UnimplementedQueryServer) GetPrekeyBundle(ctx context.Context, req *QueryGetPrekeyBundleRequest) (*QueryGetPrekeyBundleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPrekeyBundle not implemented")
+}
+func (*UnimplementedQueryServer) ListPendingMessages(ctx context.Context, req *QueryListPendingMessagesRequest) (*QueryListPendingMessagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPendingMessages not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/resist.posts.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetSocialPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetSocialPostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetSocialPost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/resist.posts.v1.Query/GetSocialPost",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetSocialPost(ctx, req.(*QueryGetSocialPostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListSocialPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllSocialPostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListSocialPost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/resist.posts.v1.Query/ListSocialPost",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListSocialPost(ctx, req.(*QueryAllSocialPostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetThread_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetThreadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetThread(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/resist.posts.v1.Query/GetThread",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetThread(ctx, req.(*QueryGetThreadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListPostsByAuthor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryListPostsByAuthorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListPostsByAuthor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/resist.posts.v1.Query/ListPostsByAuthor",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListPostsByAuthor(ctx, req.(*QueryListPostsByAuthorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListPostsByGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryListPostsByGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListPostsByGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/resist.posts.v1.Query/ListPostsByGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListPostsByGroup(ctx, req.(*QueryListPostsByGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListPostsSince_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryListPostsSinceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListPostsSince(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/resist.posts.v1.Query/ListPostsSince",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListPostsSince(ctx, req.(*QueryListPostsSinceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetVote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetVoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetVote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/resist.posts.v1.Query/GetVote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetVote(ctx, req.(*QueryGetVoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListVote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllVoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListVote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/resist.posts.v1.Query/ListVote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListVote(ctx, req.(*QueryAllVoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetSource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetSourceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetSource(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/resist.posts.v1.Query/GetSource",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetSource(ctx, req.(*QueryGetSourceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListSource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllSourceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListSource(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/resist.posts.v1.Query/ListSource",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListSource(ctx, req.(*QueryAllSourceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListSourcePosts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryListSourcePostsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListSourcePosts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/resist.posts.v1.Query/ListSourcePosts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListSourcePosts(ctx, req.(*QueryListSourcePostsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetPostTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetPostTagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetPostTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/resist.posts.v1.Query/GetPostTag",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetPostTag(ctx, req.(*QueryGetPostTagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListPostTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllPostTagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListPostTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/resist.posts.v1.Query/ListPostTag",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListPostTag(ctx, req.(*QueryAllPostTagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetContentDistribution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetContentDistributionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetContentDistribution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/resist.posts.v1.Query/GetContentDistribution",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetContentDistribution(ctx, req.(*QueryGetContentDistributionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListContentDistribution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllContentDistributionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListContentDistribution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/resist.posts.v1.Query/ListContentDistribution",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListContentDistribution(ctx, req.(*QueryAllContentDistributionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListReplicaAssignment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllReplicaAssignmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListReplicaAssignment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/resist.posts.v1.Query/ListReplicaAssignment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListReplicaAssignment(ctx, req.(*QueryAllReplicaAssignmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetStorageChallenge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetStorageChallengeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetStorageChallenge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/resist.posts.v1.Query/GetStorageChallenge",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetStorageChallenge(ctx, req.(*QueryGetStorageChallengeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListStorageChallenge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllStorageChallengeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListStorageChallenge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/resist.posts.v1.Query/ListStorageChallenge",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListStorageChallenge(ctx, req.(*QueryAllStorageChallengeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetHubSync_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetHubSyncRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetHubSync(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/resist.posts.v1.Query/GetHubSync",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetHubSync(ctx, req.(*QueryGetHubSyncRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListHubSync_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllHubSyncRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListHubSync(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/resist.posts.v1.Query/ListHubSync",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListHubSync(ctx, req.(*QueryAllHubSyncRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetPrekeyBundle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetPrekeyBundleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetPrekeyBundle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/resist.posts.v1.Query/GetPrekeyBundle",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetPrekeyBundle(ctx, req.(*QueryGetPrekeyBundleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListPendingMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryListPendingMessagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListPendingMessages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/resist.posts.v1.Query/ListPendingMessages",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListPendingMessages(ctx, req.(*QueryListPendingMessagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "resist.posts.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "GetSocialPost",
+			Handler:    _Query_GetSocialPost_Handler,
+		},
+		{
+			MethodName: "ListSocialPost",
+			Handler:    _Query_ListSocialPost_Handler,
+		},
+		{
+			MethodName: "GetThread",
+			Handler:    _Query_GetThread_Handler,
+		},
+		{
+			MethodName: "ListPostsByAuthor",
+			Handler:    _Query_ListPostsByAuthor_Handler,
+		},
+		{
+			MethodName: "ListPostsByGroup",
+			Handler:    _Query_ListPostsByGroup_Handler,
+		},
+		{
+			MethodName: "ListPostsSince",
+			Handler:    _Query_ListPostsSince_Handler,
+		},
+		{
+			MethodName: "GetVote",
+			Handler:    _Query_GetVote_Handler,
+		},
+		{
+			MethodName: "ListVote",
+			Handler:    _Query_ListVote_Handler,
+		},
+		{
+			MethodName: "GetSource",
+			Handler:    _Query_GetSource_Handler,
+		},
+		{
+			MethodName: "ListSource",
+			Handler:    _Query_ListSource_Handler,
+		},
+		{
+			MethodName: "ListSourcePosts",
+			Handler:    _Query_ListSourcePosts_Handler,
+		},
+		{
+			MethodName: "GetPostTag",
+			Handler:    _Query_GetPostTag_Handler,
+		},
+		{
+			MethodName: "ListPostTag",
+			Handler:    _Query_ListPostTag_Handler,
+		},
+		{
+			MethodName: "GetContentDistribution",
+			Handler:    _Query_GetContentDistribution_Handler,
+		},
+		{
+			MethodName: "ListContentDistribution",
+			Handler:    _Query_ListContentDistribution_Handler,
+		},
+		{
+			MethodName: "ListReplicaAssignment",
+			Handler:    _Query_ListReplicaAssignment_Handler,
+		},
+		{
+			MethodName: "GetStorageChallenge",
+			Handler:    _Query_GetStorageChallenge_Handler,
+		},
+		{
+			MethodName: "ListStorageChallenge",
+			Handler:    _Query_ListStorageChallenge_Handler,
+		},
+		{
+			MethodName: "GetHubSync",
+			Handler:    _Query_GetHubSync_Handler,
+		},
+		{
+			MethodName: "ListHubSync",
+			Handler:    _Query_ListHubSync_Handler,
+		},
+		{
+			MethodName: "GetPrekeyBundle",
+			Handler:    _Query_GetPrekeyBundle_Handler,
+		},
+		{
+			MethodName: "ListPendingMessages",
+			Handler:    _Query_ListPendingMessages_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "resist/posts/v1/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetSocialPostRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetSocialPostRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetSocialPostRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Index) > 0 {
+		i -= len(m.Index)
+		copy(dAtA[i:], m.Index)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Index)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetSocialPostResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetSocialPostResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetSocialPostResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.SocialPost.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllSocialPostRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllSocialPostRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllSocialPostRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllSocialPostResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllSocialPostResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllSocialPostResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SocialPost) > 0 {
+		for iNdEx := len(m.SocialPost) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SocialPost[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetThreadRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetThreadRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetThreadRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.MaxDepth != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaxDepth))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Index) > 0 {
+		i -= len(m.Index)
+		copy(dAtA[i:], m.Index)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Index)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetThreadResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetThreadResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetThreadResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Deleted {
+		i--
+		if m.Deleted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Replies) > 0 {
+		for iNdEx := len(m.Replies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Replies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Post.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListPostsByAuthorRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListPostsByAuthorRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListPostsByAuthorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Author) > 0 {
+		i -= len(m.Author)
+		copy(dAtA[i:], m.Author)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Author)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListPostsByAuthorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListPostsByAuthorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListPostsByAuthorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SocialPost) > 0 {
+		for iNdEx := len(m.SocialPost) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SocialPost[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListPostsByGroupRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListPostsByGroupRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListPostsByGroupRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.GroupId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GroupId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListPostsByGroupResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListPostsByGroupResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListPostsByGroupResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SocialPost) > 0 {
+		for iNdEx := len(m.SocialPost) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SocialPost[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListPostsSinceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListPostsSinceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListPostsSinceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Since != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Since))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListPostsSinceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListPostsSinceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListPostsSinceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SocialPost) > 0 {
+		for iNdEx := len(m.SocialPost) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SocialPost[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetVoteRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetVoteRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetVoteRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Index) > 0 {
+		i -= len(m.Index)
+		copy(dAtA[i:], m.Index)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Index)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetVoteResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetVoteResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetVoteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Vote.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllVoteRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllVoteRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllVoteRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllVoteResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllVoteResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllVoteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Vote) > 0 {
+		for iNdEx := len(m.Vote) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Vote[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetSourceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetSourceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetSourceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Index) > 0 {
+		i -= len(m.Index)
+		copy(dAtA[i:], m.Index)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Index)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetSourceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetSourceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetSourceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Source.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllSourceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllSourceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllSourceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllSourceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllSourceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllSourceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Source) > 0 {
+		for iNdEx := len(m.Source) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Source[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListSourcePostsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListSourcePostsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListSourcePostsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SourceIndex) > 0 {
+		i -= len(m.SourceIndex)
+		copy(dAtA[i:], m.SourceIndex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SourceIndex)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListSourcePostsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListSourcePostsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListSourcePostsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SocialPost) > 0 {
+		for iNdEx := len(m.SocialPost) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SocialPost[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetPostTagRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetPostTagRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetPostTagRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Index) > 0 {
+		i -= len(m.Index)
+		copy(dAtA[i:], m.Index)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Index)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetPostTagResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetPostTagResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetPostTagResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.PostTag.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllPostTagRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllPostTagRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllPostTagRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllPostTagResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllPostTagResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllPostTagResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PostTag) > 0 {
+		for iNdEx := len(m.PostTag) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PostTag[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetContentDistributionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetContentDistributionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetContentDistributionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ContentId) > 0 {
+		i -= len(m.ContentId)
+		copy(dAtA[i:], m.ContentId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ContentId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetContentDistributionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetContentDistributionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetContentDistributionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ContentDistribution.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllContentDistributionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllContentDistributionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllContentDistributionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllContentDistributionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllContentDistributionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllContentDistributionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ContentDistribution) > 0 {
+		for iNdEx := len(m.ContentDistribution) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ContentDistribution[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllReplicaAssignmentRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllReplicaAssignmentRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllReplicaAssignmentRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ContentId) > 0 {
+		i -= len(m.ContentId)
+		copy(dAtA[i:], m.ContentId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ContentId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllReplicaAssignmentResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllReplicaAssignmentResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllReplicaAssignmentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ReplicaAssignment) > 0 {
+		for iNdEx := len(m.ReplicaAssignment) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ReplicaAssignment[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetStorageChallengeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetStorageChallengeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetStorageChallengeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetStorageChallengeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetStorageChallengeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetStorageChallengeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.StorageChallenge.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllStorageChallengeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllStorageChallengeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllStorageChallengeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllStorageChallengeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllStorageChallengeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllStorageChallengeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.StorageChallenge) > 0 {
+		for iNdEx := len(m.StorageChallenge) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StorageChallenge[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetHubSyncRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetHubSyncRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetHubSyncRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SyncId) > 0 {
+		i -= len(m.SyncId)
+		copy(dAtA[i:], m.SyncId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SyncId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetHubSyncResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetHubSyncResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetHubSyncResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.HubSync.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllHubSyncRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllHubSyncRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllHubSyncRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.NodeId) > 0 {
+		i -= len(m.NodeId)
+		copy(dAtA[i:], m.NodeId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.NodeId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllHubSyncResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllHubSyncResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllHubSyncResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.HubSync) > 0 {
+		for iNdEx := len(m.HubSync) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HubSync[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetPrekeyBundleRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetPrekeyBundleRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetPrekeyBundleRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NodeId) > 0 {
+		i -= len(m.NodeId)
+		copy(dAtA[i:], m.NodeId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.NodeId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetPrekeyBundleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetPrekeyBundleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetPrekeyBundleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.OneTimePrekeyCount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.OneTimePrekeyCount))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.PrekeyBundle.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListPendingMessagesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListPendingMessagesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListPendingMessagesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.NodeId) > 0 {
+		i -= len(m.NodeId)
+		copy(dAtA[i:], m.NodeId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.NodeId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListPendingMessagesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListPendingMessagesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListPendingMessagesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Messages) > 0 {
+		for iNdEx := len(m.Messages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Messages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetSocialPostRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Index)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetSocialPostResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SocialPost.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllSocialPostRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllSocialPostResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SocialPost) > 0 {
+		for _, e := range m.SocialPost {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetThreadRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Index)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.MaxDepth != 0 {
+		n += 1 + sovQuery(uint64(m.MaxDepth))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetThreadResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Post.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Replies) > 0 {
+		for _, e := range m.Replies {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Deleted {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryListPostsByAuthorRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Author)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryListPostsByAuthorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SocialPost) > 0 {
+		for _, e := range m.SocialPost {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryListPostsByGroupRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GroupId != 0 {
+		n += 1 + sovQuery(uint64(m.GroupId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryListPostsByGroupResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SocialPost) > 0 {
+		for _, e := range m.SocialPost {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryListPostsSinceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Since != 0 {
+		n += 1 + sovQuery(uint64(m.Since))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryListPostsSinceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SocialPost) > 0 {
+		for _, e := range m.SocialPost {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetVoteRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Index)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetVoteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Vote.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllVoteRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllVoteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Vote) > 0 {
+		for _, e := range m.Vote {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetSourceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Index)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetSourceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Source.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllSourceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllSourceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Source) > 0 {
+		for _, e := range m.Source {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryListSourcePostsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SourceIndex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryListSourcePostsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SocialPost) > 0 {
+		for _, e := range m.SocialPost {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetPostTagRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Index)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetPostTagResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.PostTag.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllPostTagRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllPostTagResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PostTag) > 0 {
+		for _, e := range m.PostTag {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetContentDistributionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContentId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetContentDistributionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ContentDistribution.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllContentDistributionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllContentDistributionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ContentDistribution) > 0 {
+		for _, e := range m.ContentDistribution {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllReplicaAssignmentRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContentId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllReplicaAssignmentResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ReplicaAssignment) > 0 {
+		for _, e := range m.ReplicaAssignment {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetStorageChallengeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryGetStorageChallengeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.StorageChallenge.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllStorageChallengeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllStorageChallengeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.StorageChallenge) > 0 {
+		for _, e := range m.StorageChallenge {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetHubSyncRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SyncId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetHubSyncResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.HubSync.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllHubSyncRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NodeId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllHubSyncResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.HubSync) > 0 {
+		for _, e := range m.HubSync {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetPrekeyBundleRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NodeId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetPrekeyBundleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.PrekeyBundle.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.OneTimePrekeyCount != 0 {
+		n += 1 + sovQuery(uint64(m.OneTimePrekeyCount))
+	}
+	return n
+}
+
+func (m *QueryListPendingMessagesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NodeId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryListPendingMessagesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Messages) > 0 {
+		for _, e := range m.Messages {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetSocialPostRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetSocialPostRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetSocialPostRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Index = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetSocialPostResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetSocialPostResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetSocialPostResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SocialPost", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SocialPost.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllSocialPostRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllSocialPostRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllSocialPostRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllSocialPostResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllSocialPostResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllSocialPostResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SocialPost", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SocialPost = append(m.SocialPost, SocialPost{})
+			if err := m.SocialPost[len(m.SocialPost)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetThreadRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetThreadRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetThreadRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Index = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDepth", wireType)
+			}
+			m.MaxDepth = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxDepth |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetThreadResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetThreadResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetThreadResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Post", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Post.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Replies", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Replies = append(m.Replies, ThreadNode{})
+			if err := m.Replies[len(m.Replies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deleted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Deleted = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryListPostsByAuthorRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryListPostsByAuthorRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryListPostsByAuthorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Author", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Author = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryListPostsByAuthorResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryListPostsByAuthorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryListPostsByAuthorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SocialPost", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SocialPost = append(m.SocialPost, SocialPost{})
+			if err := m.SocialPost[len(m.SocialPost)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryListPostsByGroupRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryListPostsByGroupRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryListPostsByGroupRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+			}
+			m.GroupId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GroupId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryListPostsByGroupResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryListPostsByGroupResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryListPostsByGroupResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SocialPost", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SocialPost = append(m.SocialPost, SocialPost{})
+			if err := m.SocialPost[len(m.SocialPost)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryListPostsSinceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryListPostsSinceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryListPostsSinceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Since", wireType)
+			}
+			m.Since = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Since |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryListPostsSinceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryListPostsSinceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryListPostsSinceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SocialPost", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SocialPost = append(m.SocialPost, SocialPost{})
+			if err := m.SocialPost[len(m.SocialPost)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetVoteRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetVoteRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetVoteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Index = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetVoteResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetVoteResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetVoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vote", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Vote.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllVoteRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllVoteRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllVoteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllVoteResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllVoteResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllVoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vote", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Vote = append(m.Vote, Vote{})
+			if err := m.Vote[len(m.Vote)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetSourceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetSourceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetSourceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Index = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetSourceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetSourceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetSourceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Source.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllSourceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllSourceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllSourceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllSourceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllSourceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllSourceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Source = append(m.Source, Source{})
+			if err := m.Source[len(m.Source)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryListSourcePostsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryListSourcePostsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryListSourcePostsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceIndex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceIndex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryListSourcePostsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryListSourcePostsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryListSourcePostsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SocialPost", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SocialPost = append(m.SocialPost, SocialPost{})
+			if err := m.SocialPost[len(m.SocialPost)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetPostTagRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetPostTagRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetPostTagRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Index = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetPostTagResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetPostTagResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetPostTagResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PostTag", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PostTag.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *QueryAllPostTagResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	if len(m.PostTag) > 0 {
-		for _, e := range m.PostTag {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	return nil
+}
+func (m *QueryAllPostTagRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllPostTagRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllPostTagRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
+func (m *QueryAllPostTagResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllPostTagResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllPostTagResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PostTag", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PostTag = append(m.PostTag, PostTag{})
+			if err := m.PostTag[len(m.PostTag)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetContentDistributionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2262,12 +8836,44 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetContentDistributionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetContentDistributionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContentId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContentId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2289,7 +8895,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetContentDistributionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2312,15 +8918,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetContentDistributionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetContentDistributionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContentDistribution", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2347,7 +8953,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ContentDistribution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2372,7 +8978,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetSocialPostRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllContentDistributionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2395,17 +9001,17 @@ func (m *QueryGetSocialPostRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetSocialPostRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllContentDistributionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetSocialPostRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllContentDistributionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2415,23 +9021,27 @@ func (m *QueryGetSocialPostRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Index = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2454,7 +9064,7 @@ func (m *QueryGetSocialPostRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetSocialPostResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllContentDistributionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2477,15 +9087,15 @@ func (m *QueryGetSocialPostResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetSocialPostResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllContentDistributionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetSocialPostResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllContentDistributionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SocialPost", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContentDistribution", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2512,7 +9122,44 @@ func (m *QueryGetSocialPostResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SocialPost.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ContentDistribution = append(m.ContentDistribution, ContentDistribution{})
+			if err := m.ContentDistribution[len(m.ContentDistribution)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2537,7 +9184,7 @@ func (m *QueryGetSocialPostResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllSocialPostRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllReplicaAssignmentRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2560,13 +9207,45 @@ func (m *QueryAllSocialPostRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllSocialPostRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllReplicaAssignmentRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllSocialPostRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllReplicaAssignmentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContentId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContentId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -2623,7 +9302,7 @@ func (m *QueryAllSocialPostRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllSocialPostResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllReplicaAssignmentResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2646,15 +9325,15 @@ func (m *QueryAllSocialPostResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllSocialPostResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllReplicaAssignmentResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllSocialPostResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllReplicaAssignmentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SocialPost", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReplicaAssignment", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2681,8 +9360,8 @@ func (m *QueryAllSocialPostResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SocialPost = append(m.SocialPost, SocialPost{})
-			if err := m.SocialPost[len(m.SocialPost)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ReplicaAssignment = append(m.ReplicaAssignment, ReplicaAssignment{})
+			if err := m.ReplicaAssignment[len(m.ReplicaAssignment)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2743,7 +9422,7 @@ func (m *QueryAllSocialPostResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetVoteRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetStorageChallengeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2766,17 +9445,17 @@ func (m *QueryGetVoteRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetVoteRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetStorageChallengeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetVoteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetStorageChallengeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var stringLen uint64
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2786,24 +9465,11 @@ func (m *QueryGetVoteRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Index = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2825,7 +9491,7 @@ func (m *QueryGetVoteRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetVoteResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetStorageChallengeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2848,15 +9514,15 @@ func (m *QueryGetVoteResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetVoteResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetStorageChallengeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetVoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetStorageChallengeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Vote", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageChallenge", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2883,7 +9549,7 @@ func (m *QueryGetVoteResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Vote.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.StorageChallenge.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2908,7 +9574,7 @@ func (m *QueryGetVoteResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllVoteRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllStorageChallengeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2931,10 +9597,10 @@ func (m *QueryAllVoteRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllVoteRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllStorageChallengeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllVoteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllStorageChallengeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2994,7 +9660,7 @@ func (m *QueryAllVoteRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllVoteResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllStorageChallengeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3017,15 +9683,15 @@ func (m *QueryAllVoteResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllVoteResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllStorageChallengeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllVoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllStorageChallengeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Vote", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageChallenge", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3052,8 +9718,8 @@ func (m *QueryAllVoteResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Vote = append(m.Vote, Vote{})
-			if err := m.Vote[len(m.Vote)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.StorageChallenge = append(m.StorageChallenge, StorageChallenge{})
+			if err := m.StorageChallenge[len(m.StorageChallenge)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3114,7 +9780,7 @@ func (m *QueryAllVoteResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetSourceRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetHubSyncRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3137,15 +9803,15 @@ func (m *QueryGetSourceRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetSourceRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetHubSyncRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetSourceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetHubSyncRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3173,7 +9839,7 @@ func (m *QueryGetSourceRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Index = string(dAtA[iNdEx:postIndex])
+			m.SyncId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3196,7 +9862,7 @@ func (m *QueryGetSourceRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetSourceResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetHubSyncResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3219,15 +9885,15 @@ func (m *QueryGetSourceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetSourceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetHubSyncResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetSourceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetHubSyncResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HubSync", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3254,7 +9920,7 @@ func (m *QueryGetSourceResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Source.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.HubSync.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3279,7 +9945,7 @@ func (m *QueryGetSourceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllSourceRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllHubSyncRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3302,13 +9968,45 @@ func (m *QueryAllSourceRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllSourceRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllHubSyncRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllSourceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllHubSyncRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NodeId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -3365,7 +10063,7 @@ func (m *QueryAllSourceRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllSourceResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllHubSyncResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3388,15 +10086,15 @@ func (m *QueryAllSourceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllSourceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllHubSyncResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllSourceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllHubSyncResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HubSync", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3423,8 +10121,8 @@ func (m *QueryAllSourceResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Source = append(m.Source, Source{})
-			if err := m.Source[len(m.Source)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.HubSync = append(m.HubSync, HubSync{})
+			if err := m.HubSync[len(m.HubSync)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3485,7 +10183,7 @@ func (m *QueryAllSourceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetPostTagRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetPrekeyBundleRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3508,15 +10206,15 @@ func (m *QueryGetPostTagRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetPostTagRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetPrekeyBundleRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetPostTagRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetPrekeyBundleRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3544,7 +10242,7 @@ func (m *QueryGetPostTagRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Index = string(dAtA[iNdEx:postIndex])
+			m.NodeId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3567,7 +10265,7 @@ func (m *QueryGetPostTagRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetPostTagResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetPrekeyBundleResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3590,15 +10288,15 @@ func (m *QueryGetPostTagResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetPostTagResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetPrekeyBundleResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetPostTagResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetPrekeyBundleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PostTag", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PrekeyBundle", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3625,10 +10323,29 @@ func (m *QueryGetPostTagResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.PostTag.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PrekeyBundle.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OneTimePrekeyCount", wireType)
+			}
+			m.OneTimePrekeyCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OneTimePrekeyCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3650,7 +10367,7 @@ func (m *QueryGetPostTagResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllPostTagRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryListPendingMessagesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3673,13 +10390,45 @@ func (m *QueryAllPostTagRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllPostTagRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryListPendingMessagesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllPostTagRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryListPendingMessagesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NodeId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -3736,7 +10485,7 @@ func (m *QueryAllPostTagRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllPostTagResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryListPendingMessagesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3759,15 +10508,15 @@ func (m *QueryAllPostTagResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllPostTagResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryListPendingMessagesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllPostTagResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryListPendingMessagesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PostTag", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3794,8 +10543,8 @@ func (m *QueryAllPostTagResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PostTag = append(m.PostTag, PostTag{})
-			if err := m.PostTag[len(m.PostTag)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Messages = append(m.Messages, SignalMessage{})
+			if err := m.Messages[len(m.Messages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
 	math "math"
@@ -24,21 +25,33 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // SocialPost defines the SocialPost message.
 type SocialPost struct {
-	Index              string `protobuf:"bytes,1,opt,name=index,proto3" json:"index,omitempty"`
-	Title              string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	Content            string `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
-	MediaUrl           string `protobuf:"bytes,4,opt,name=media_url,json=mediaUrl,proto3" json:"media_url,omitempty"`
-	MediaType          string `protobuf:"bytes,5,opt,name=media_type,json=mediaType,proto3" json:"media_type,omitempty"`
-	GroupId            uint64 `protobuf:"varint,6,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
-	Author             string `protobuf:"bytes,7,opt,name=author,proto3" json:"author,omitempty"`
-	Upvotes            uint64 `protobuf:"varint,8,opt,name=upvotes,proto3" json:"upvotes,omitempty"`
-	Downvotes          uint64 `protobuf:"varint,9,opt,name=downvotes,proto3" json:"downvotes,omitempty"`
-	CreatedAt          uint64 `protobuf:"varint,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	Creator            string `protobuf:"bytes,11,opt,name=creator,proto3" json:"creator,omitempty"`
-	Sources            string `protobuf:"bytes,12,opt,name=sources,proto3" json:"sources,omitempty"`
+	Index     string `protobuf:"bytes,1,opt,name=index,proto3" json:"index,omitempty"`
+	Title     string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Content   string `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	MediaUrl  string `protobuf:"bytes,4,opt,name=media_url,json=mediaUrl,proto3" json:"media_url,omitempty"`
+	MediaType string `protobuf:"bytes,5,opt,name=media_type,json=mediaType,proto3" json:"media_type,omitempty"`
+	GroupId   uint64 `protobuf:"varint,6,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	Author    string `protobuf:"bytes,7,opt,name=author,proto3" json:"author,omitempty"`
+	Upvotes   uint64 `protobuf:"varint,8,opt,name=upvotes,proto3" json:"upvotes,omitempty"`
+	Downvotes uint64 `protobuf:"varint,9,opt,name=downvotes,proto3" json:"downvotes,omitempty"`
+	CreatedAt uint64 `protobuf:"varint,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Creator   string `protobuf:"bytes,11,opt,name=creator,proto3" json:"creator,omitempty"`
+	// sources is the JSON array of the sources cited by the posts created
+	// before version 2, moved to source_indexes by the migration to version 2.
+	Sources            string `protobuf:"bytes,12,opt,name=sources,proto3" json:"sources,omitempty"` // Deprecated: Do not use.
 	Intent             string `protobuf:"bytes,13,opt,name=intent,proto3" json:"intent,omitempty"`
 	ContextType        string `protobuf:"bytes,14,opt,name=context_type,json=contextType,proto3" json:"context_type,omitempty"`
 	RequiresModeration bool   `protobuf:"varint,15,opt,name=requires_moderation,json=requiresModeration,proto3" json:"requires_moderation,omitempty"`
+	// parent_index is the post this post replies to, if any.
+	ParentIndex string `protobuf:"bytes,16,opt,name=parent_index,json=parentIndex,proto3" json:"parent_index,omitempty"`
+	// root_index is the first post of the thread of a reply.
+	RootIndex string `protobuf:"bytes,17,opt,name=root_index,json=rootIndex,proto3" json:"root_index,omitempty"`
+	// quoted_index is the post this post quotes, if any.
+	QuotedIndex string `protobuf:"bytes,18,opt,name=quoted_index,json=quotedIndex,proto3" json:"quoted_index,omitempty"`
+	// reply_count is the number of direct replies to the post.
+	ReplyCount uint64 `protobuf:"varint,19,opt,name=reply_count,json=replyCount,proto3" json:"reply_count,omitempty"`
+	// source_indexes are the sources cited by the post.
+	SourceIndexes []string `protobuf:"bytes,20,rep,name=source_indexes,json=sourceIndexes,proto3" json:"source_indexes,omitempty"`
 }
 
 func (m *SocialPost) Reset()         { *m = SocialPost{} }
@@ -151,6 +164,7 @@ func (m *SocialPost) GetCreator() string {
 	return ""
 }
 
+// Deprecated: Do not use.
 func (m *SocialPost) GetSources() string {
 	if m != nil {
 		return m.Sources
@@ -179,37 +193,203 @@ func (m *SocialPost) GetRequiresModeration() bool {
 	return false
 }
 
+func (m *SocialPost) GetParentIndex() string {
+	if m != nil {
+		return m.ParentIndex
+	}
+	return ""
+}
+
+func (m *SocialPost) GetRootIndex() string {
+	if m != nil {
+		return m.RootIndex
+	}
+	return ""
+}
+
+func (m *SocialPost) GetQuotedIndex() string {
+	if m != nil {
+		return m.QuotedIndex
+	}
+	return ""
+}
+
+func (m *SocialPost) GetReplyCount() uint64 {
+	if m != nil {
+		return m.ReplyCount
+	}
+	return 0
+}
+
+func (m *SocialPost) GetSourceIndexes() []string {
+	if m != nil {
+		return m.SourceIndexes
+	}
+	return nil
+}
+
+// PostAlias maps the index of a post created before post indexes were
+// assigned from a sequence to its current index.
+type PostAlias struct {
+	OldIndex string `protobuf:"bytes,1,opt,name=old_index,json=oldIndex,proto3" json:"old_index,omitempty"`
+	Index    string `protobuf:"bytes,2,opt,name=index,proto3" json:"index,omitempty"`
+}
+
+func (m *PostAlias) Reset()         { *m = PostAlias{} }
+func (m *PostAlias) String() string { return proto.CompactTextString(m) }
+func (*PostAlias) ProtoMessage()    {}
+func (*PostAlias) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48dffaee0576b20b, []int{1}
+}
+func (m *PostAlias) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PostAlias) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PostAlias.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PostAlias) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PostAlias.Merge(m, src)
+}
+func (m *PostAlias) XXX_Size() int {
+	return m.Size()
+}
+func (m *PostAlias) XXX_DiscardUnknown() {
+	xxx_messageInfo_PostAlias.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PostAlias proto.InternalMessageInfo
+
+func (m *PostAlias) GetOldIndex() string {
+	if m != nil {
+		return m.OldIndex
+	}
+	return ""
+}
+
+func (m *PostAlias) GetIndex() string {
+	if m != nil {
+		return m.Index
+	}
+	return ""
+}
+
+// ThreadNode is a post and the replies to it, down to the requested depth.
+type ThreadNode struct {
+	Post    SocialPost   `protobuf:"bytes,1,opt,name=post,proto3" json:"post"`
+	Replies []ThreadNode `protobuf:"bytes,2,rep,name=replies,proto3" json:"replies"`
+	// deleted is set when the post was deleted, post then only keeping its
+	// place in the thread and its sources.
+	Deleted bool `protobuf:"varint,3,opt,name=deleted,proto3" json:"deleted,omitempty"`
+}
+
+func (m *ThreadNode) Reset()         { *m = ThreadNode{} }
+func (m *ThreadNode) String() string { return proto.CompactTextString(m) }
+func (*ThreadNode) ProtoMessage()    {}
+func (*ThreadNode) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48dffaee0576b20b, []int{2}
+}
+func (m *ThreadNode) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ThreadNode) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ThreadNode.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ThreadNode) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ThreadNode.Merge(m, src)
+}
+func (m *ThreadNode) XXX_Size() int {
+	return m.Size()
+}
+func (m *ThreadNode) XXX_DiscardUnknown() {
+	xxx_messageInfo_ThreadNode.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ThreadNode proto.InternalMessageInfo
+
+func (m *ThreadNode) GetPost() SocialPost {
+	if m != nil {
+		return m.Post
+	}
+	return SocialPost{}
+}
+
+func (m *ThreadNode) GetReplies() []ThreadNode {
+	if m != nil {
+		return m.Replies
+	}
+	return nil
+}
+
+func (m *ThreadNode) GetDeleted() bool {
+	if m != nil {
+		return m.Deleted
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*SocialPost)(nil), "resist.posts.v1.SocialPost")
+	proto.RegisterType((*PostAlias)(nil), "resist.posts.v1.PostAlias")
+	proto.RegisterType((*ThreadNode)(nil), "resist.posts.v1.ThreadNode")
 }
 
 func init() { proto.RegisterFile("resist/posts/v1/social_post.proto", fileDescriptor_48dffaee0576b20b) }
 
 var fileDescriptor_48dffaee0576b20b = []byte{
-	// 361 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x44, 0x92, 0xcf, 0x4e, 0xe3, 0x30,
-	0x10, 0x87, 0x9b, 0xed, 0xbf, 0xc4, 0xed, 0x6e, 0x25, 0x6f, 0xb5, 0xf2, 0x0a, 0x88, 0x5a, 0x4e,
-	0x3d, 0x25, 0xaa, 0x78, 0x02, 0xb8, 0x71, 0x40, 0x42, 0x05, 0x2e, 0x5c, 0xa2, 0x10, 0x5b, 0x60,
-	0x29, 0x8d, 0x83, 0x3d, 0x29, 0xed, 0x5b, 0xf0, 0x38, 0x3c, 0x02, 0xc7, 0x1e, 0x39, 0xa2, 0xf6,
-	0x45, 0x90, 0xc7, 0x2e, 0x1c, 0xbf, 0xdf, 0x37, 0x96, 0x67, 0x46, 0x43, 0xa6, 0x5a, 0x18, 0x69,
-	0x20, 0xad, 0x95, 0x01, 0x93, 0xae, 0xe6, 0xa9, 0x51, 0x85, 0xcc, 0xcb, 0xcc, 0x72, 0x52, 0x6b,
-	0x05, 0x8a, 0x8e, 0x5c, 0x49, 0x82, 0x25, 0xc9, 0x6a, 0x7e, 0xfa, 0xd6, 0x26, 0xe4, 0x06, 0xcb,
-	0xae, 0x95, 0x01, 0x3a, 0x26, 0x5d, 0x59, 0x71, 0xb1, 0x66, 0xc1, 0x24, 0x98, 0x45, 0x0b, 0x07,
-	0x36, 0x05, 0x09, 0xa5, 0x60, 0xbf, 0x5c, 0x8a, 0x40, 0x19, 0xe9, 0x17, 0xaa, 0x02, 0x51, 0x01,
-	0x6b, 0x63, 0x7e, 0x40, 0x7a, 0x44, 0xa2, 0xa5, 0xe0, 0x32, 0xcf, 0x1a, 0x5d, 0xb2, 0x0e, 0xba,
-	0x10, 0x83, 0x3b, 0x5d, 0xd2, 0x13, 0x42, 0x9c, 0x84, 0x4d, 0x2d, 0x58, 0x17, 0xad, 0x2b, 0xbf,
-	0xdd, 0xd4, 0x82, 0xfe, 0x27, 0xe1, 0xa3, 0x56, 0x4d, 0x9d, 0x49, 0xce, 0x7a, 0x93, 0x60, 0xd6,
-	0x59, 0xf4, 0x91, 0x2f, 0x39, 0xfd, 0x47, 0x7a, 0x79, 0x03, 0x4f, 0x4a, 0xb3, 0x3e, 0xbe, 0xf2,
-	0x64, 0x1b, 0x69, 0xea, 0x95, 0x02, 0x61, 0x58, 0xe8, 0x5e, 0x78, 0xa4, 0xc7, 0x24, 0xe2, 0xea,
-	0xa5, 0x72, 0x2e, 0x42, 0xf7, 0x13, 0xd8, 0x4e, 0x0a, 0x2d, 0x72, 0x10, 0x3c, 0xcb, 0x81, 0x11,
-	0xa7, 0x7d, 0x72, 0x0e, 0x38, 0x9f, 0x05, 0xa5, 0xd9, 0xc0, 0xcf, 0xe7, 0xd0, 0x1a, 0xa3, 0x1a,
-	0x5d, 0x08, 0xc3, 0x86, 0xce, 0x78, 0xb4, 0x2d, 0x4a, 0xb7, 0x92, 0xdf, 0xae, 0x45, 0x47, 0x74,
-	0x4a, 0x86, 0xb8, 0x9c, 0x35, 0xb8, 0xb1, 0xff, 0xa0, 0x1d, 0xf8, 0x0c, 0x07, 0x4f, 0xc9, 0x5f,
-	0x2d, 0x9e, 0x1b, 0xa9, 0x85, 0xc9, 0x96, 0x8a, 0x0b, 0x9d, 0x83, 0x54, 0x15, 0x1b, 0x4d, 0x82,
-	0x59, 0xb8, 0xa0, 0x07, 0x75, 0xf5, 0x6d, 0x2e, 0x92, 0xf7, 0x5d, 0x1c, 0x6c, 0x77, 0x71, 0xf0,
-	0xb9, 0x8b, 0x83, 0xd7, 0x7d, 0xdc, 0xda, 0xee, 0xe3, 0xd6, 0xc7, 0x3e, 0x6e, 0xdd, 0x8f, 0xfd,
-	0x21, 0xac, 0xfd, 0x29, 0xd8, 0x2f, 0xcd, 0x43, 0x0f, 0x4f, 0xe0, 0xec, 0x2b, 0x00, 0x00, 0xff,
-	0xff, 0xa8, 0x47, 0x3f, 0x80, 0x27, 0x02, 0x00, 0x00,
+	// 559 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x53, 0xcd, 0x6e, 0xd3, 0x40,
+	0x10, 0x8e, 0xd3, 0x34, 0xb1, 0x27, 0x69, 0x0b, 0xdb, 0x08, 0x2d, 0xb4, 0x75, 0x93, 0x48, 0x48,
+	0x39, 0x39, 0x2a, 0x88, 0x13, 0x12, 0x52, 0xc3, 0x29, 0x07, 0x10, 0x32, 0xe5, 0xc2, 0xc5, 0x32,
+	0xf1, 0x2a, 0xb5, 0xe4, 0x7a, 0xdc, 0xdd, 0x75, 0x48, 0xde, 0x82, 0x33, 0x4f, 0xd4, 0x63, 0x8f,
+	0x9c, 0x10, 0x4a, 0x4e, 0xbc, 0x05, 0xda, 0x1d, 0xbb, 0x41, 0x88, 0x9b, 0xbf, 0x9f, 0x59, 0xcf,
+	0xcc, 0x7e, 0x0b, 0x43, 0x29, 0x54, 0xaa, 0xf4, 0xa4, 0x40, 0xa5, 0xd5, 0x64, 0x79, 0x31, 0x51,
+	0x38, 0x4f, 0xe3, 0x2c, 0x32, 0x38, 0x28, 0x24, 0x6a, 0x64, 0x47, 0x64, 0x09, 0xac, 0x25, 0x58,
+	0x5e, 0x3c, 0xeb, 0x2f, 0x70, 0x81, 0x56, 0x9b, 0x98, 0x2f, 0xb2, 0x8d, 0x7e, 0xb7, 0x00, 0x3e,
+	0xda, 0xe2, 0x0f, 0xa8, 0x34, 0xeb, 0xc3, 0x7e, 0x9a, 0x27, 0x62, 0xc5, 0x9d, 0x81, 0x33, 0xf6,
+	0x42, 0x02, 0x86, 0xd5, 0xa9, 0xce, 0x04, 0x6f, 0x12, 0x6b, 0x01, 0xe3, 0xd0, 0x99, 0x63, 0xae,
+	0x45, 0xae, 0xf9, 0x9e, 0xe5, 0x6b, 0xc8, 0x4e, 0xc0, 0xbb, 0x11, 0x49, 0x1a, 0x47, 0xa5, 0xcc,
+	0x78, 0xcb, 0x6a, 0xae, 0x25, 0x3e, 0xc9, 0x8c, 0x9d, 0x01, 0x90, 0xa8, 0xd7, 0x85, 0xe0, 0xfb,
+	0x56, 0x25, 0xfb, 0xd5, 0xba, 0x10, 0xec, 0x29, 0xb8, 0x0b, 0x89, 0x65, 0x11, 0xa5, 0x09, 0x6f,
+	0x0f, 0x9c, 0x71, 0x2b, 0xec, 0x58, 0x3c, 0x4b, 0xd8, 0x13, 0x68, 0xc7, 0xa5, 0xbe, 0x46, 0xc9,
+	0x3b, 0xb6, 0xaa, 0x42, 0xa6, 0x91, 0xb2, 0x58, 0xa2, 0x16, 0x8a, 0xbb, 0x54, 0x51, 0x41, 0x76,
+	0x0a, 0x5e, 0x82, 0x5f, 0x73, 0xd2, 0x3c, 0xab, 0xed, 0x08, 0xd3, 0xc9, 0x5c, 0x8a, 0x58, 0x8b,
+	0x24, 0x8a, 0x35, 0x07, 0x92, 0x2b, 0xe6, 0x52, 0xdb, 0xf9, 0x0c, 0x40, 0xc9, 0xbb, 0xd5, 0x7c,
+	0x04, 0xd9, 0x29, 0x74, 0x14, 0x96, 0x72, 0x2e, 0x14, 0xef, 0x19, 0x65, 0xda, 0xe4, 0x4e, 0x58,
+	0x53, 0xa6, 0xcd, 0x94, 0xd6, 0x72, 0x40, 0x6d, 0x12, 0x62, 0x43, 0xe8, 0xd9, 0x05, 0xad, 0x34,
+	0x8d, 0x7e, 0x68, 0xd5, 0x6e, 0xc5, 0xd9, 0xe1, 0x27, 0x70, 0x2c, 0xc5, 0x6d, 0x99, 0x4a, 0xa1,
+	0xa2, 0x1b, 0x4c, 0x84, 0x8c, 0x75, 0x8a, 0x39, 0x3f, 0x1a, 0x38, 0x63, 0x37, 0x64, 0xb5, 0xf4,
+	0xee, 0x41, 0x31, 0x67, 0x16, 0xb1, 0x14, 0xb9, 0x8e, 0xe8, 0xda, 0x1e, 0xd1, 0x99, 0xc4, 0xcd,
+	0xec, 0xe5, 0x9d, 0x01, 0x48, 0xc4, 0xda, 0xf0, 0x98, 0xf6, 0x6d, 0x18, 0x92, 0x87, 0xd0, 0xbb,
+	0x2d, 0xd1, 0xec, 0x80, 0x0c, 0x8c, 0x4e, 0x20, 0x8e, 0x2c, 0xe7, 0xd0, 0x95, 0xa2, 0xc8, 0xd6,
+	0xd1, 0x1c, 0xcb, 0x5c, 0xf3, 0x63, 0xbb, 0x28, 0xb0, 0xd4, 0x5b, 0xc3, 0xb0, 0xe7, 0x70, 0x48,
+	0xc3, 0xd3, 0x19, 0x42, 0xf1, 0xfe, 0x60, 0x6f, 0xec, 0x85, 0x07, 0xc4, 0xce, 0x88, 0x1c, 0xbd,
+	0x01, 0xcf, 0x84, 0xec, 0x32, 0x4b, 0x63, 0x65, 0x32, 0x82, 0x59, 0xfd, 0x53, 0x4a, 0x9b, 0x8b,
+	0x59, 0xf5, 0xc7, 0x87, 0x18, 0x36, 0xff, 0x8a, 0xe1, 0xe8, 0xbb, 0x03, 0x70, 0x75, 0x2d, 0x45,
+	0x9c, 0xbc, 0xc7, 0x44, 0xb0, 0x57, 0xd0, 0x32, 0xe1, 0xb6, 0xc5, 0xdd, 0x17, 0x27, 0xc1, 0x3f,
+	0x81, 0x0f, 0x76, 0xb1, 0x9e, 0xb6, 0xee, 0x7e, 0x9e, 0x37, 0x42, 0x6b, 0x67, 0xaf, 0xa1, 0x63,
+	0x5a, 0x4f, 0x85, 0xe2, 0xcd, 0xc1, 0xde, 0x7f, 0x2b, 0x77, 0x3f, 0xa9, 0x2a, 0xeb, 0x0a, 0x93,
+	0x89, 0x44, 0x64, 0x42, 0x8b, 0xc4, 0x66, 0xde, 0x0d, 0x6b, 0x38, 0x0d, 0xee, 0x36, 0xbe, 0x73,
+	0xbf, 0xf1, 0x9d, 0x5f, 0x1b, 0xdf, 0xf9, 0xb6, 0xf5, 0x1b, 0xf7, 0x5b, 0xbf, 0xf1, 0x63, 0xeb,
+	0x37, 0x3e, 0xf7, 0xab, 0xc7, 0xba, 0xaa, 0x9e, 0xab, 0xb9, 0x7c, 0xf5, 0xa5, 0x6d, 0xdf, 0xdf,
+	0xcb, 0x3f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x68, 0x97, 0xbd, 0x2d, 0xcb, 0x03, 0x00, 0x00,
 }
 
 func (m *SocialPost) Marshal() (dAtA []byte, err error) {
@@ -232,6 +412,51 @@ func (m *SocialPost) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.SourceIndexes) > 0 {
+		for iNdEx := len(m.SourceIndexes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.SourceIndexes[iNdEx])
+			copy(dAtA[i:], m.SourceIndexes[iNdEx])
+			i = encodeVarintSocialPost(dAtA, i, uint64(len(m.SourceIndexes[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa2
+		}
+	}
+	if m.ReplyCount != 0 {
+		i = encodeVarintSocialPost(dAtA, i, uint64(m.ReplyCount))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
+	if len(m.QuotedIndex) > 0 {
+		i -= len(m.QuotedIndex)
+		copy(dAtA[i:], m.QuotedIndex)
+		i = encodeVarintSocialPost(dAtA, i, uint64(len(m.QuotedIndex)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
+	}
+	if len(m.RootIndex) > 0 {
+		i -= len(m.RootIndex)
+		copy(dAtA[i:], m.RootIndex)
+		i = encodeVarintSocialPost(dAtA, i, uint64(len(m.RootIndex)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if len(m.ParentIndex) > 0 {
+		i -= len(m.ParentIndex)
+		copy(dAtA[i:], m.ParentIndex)
+		i = encodeVarintSocialPost(dAtA, i, uint64(len(m.ParentIndex)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
 	if m.RequiresModeration {
 		i--
 		if m.RequiresModeration {
@@ -335,6 +560,100 @@ func (m *SocialPost) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PostAlias) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PostAlias) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PostAlias) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Index) > 0 {
+		i -= len(m.Index)
+		copy(dAtA[i:], m.Index)
+		i = encodeVarintSocialPost(dAtA, i, uint64(len(m.Index)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OldIndex) > 0 {
+		i -= len(m.OldIndex)
+		copy(dAtA[i:], m.OldIndex)
+		i = encodeVarintSocialPost(dAtA, i, uint64(len(m.OldIndex)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ThreadNode) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ThreadNode) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ThreadNode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Deleted {
+		i--
+		if m.Deleted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Replies) > 0 {
+		for iNdEx := len(m.Replies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Replies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSocialPost(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Post.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintSocialPost(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintSocialPost(dAtA []byte, offset int, v uint64) int {
 	offset -= sovSocialPost(v)
 	base := offset
@@ -407,6 +726,64 @@ func (m *SocialPost) Size() (n int) {
 	if m.RequiresModeration {
 		n += 2
 	}
+	l = len(m.ParentIndex)
+	if l > 0 {
+		n += 2 + l + sovSocialPost(uint64(l))
+	}
+	l = len(m.RootIndex)
+	if l > 0 {
+		n += 2 + l + sovSocialPost(uint64(l))
+	}
+	l = len(m.QuotedIndex)
+	if l > 0 {
+		n += 2 + l + sovSocialPost(uint64(l))
+	}
+	if m.ReplyCount != 0 {
+		n += 2 + sovSocialPost(uint64(m.ReplyCount))
+	}
+	if len(m.SourceIndexes) > 0 {
+		for _, s := range m.SourceIndexes {
+			l = len(s)
+			n += 2 + l + sovSocialPost(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PostAlias) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OldIndex)
+	if l > 0 {
+		n += 1 + l + sovSocialPost(uint64(l))
+	}
+	l = len(m.Index)
+	if l > 0 {
+		n += 1 + l + sovSocialPost(uint64(l))
+	}
+	return n
+}
+
+func (m *ThreadNode) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Post.Size()
+	n += 1 + l + sovSocialPost(uint64(l))
+	if len(m.Replies) > 0 {
+		for _, e := range m.Replies {
+			l = e.Size()
+			n += 1 + l + sovSocialPost(uint64(l))
+		}
+	}
+	if m.Deleted {
+		n += 2
+	}
 	return n
 }
 
@@ -861,6 +1238,404 @@ func (m *SocialPost) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.RequiresModeration = bool(v != 0)
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParentIndex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSocialPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSocialPost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSocialPost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ParentIndex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RootIndex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSocialPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSocialPost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSocialPost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RootIndex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuotedIndex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSocialPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSocialPost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSocialPost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QuotedIndex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReplyCount", wireType)
+			}
+			m.ReplyCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSocialPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReplyCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceIndexes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSocialPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSocialPost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSocialPost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceIndexes = append(m.SourceIndexes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSocialPost(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSocialPost
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PostAlias) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSocialPost
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PostAlias: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PostAlias: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldIndex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSocialPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSocialPost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSocialPost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OldIndex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSocialPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSocialPost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSocialPost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Index = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSocialPost(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSocialPost
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ThreadNode) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSocialPost
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ThreadNode: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ThreadNode: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Post", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSocialPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSocialPost
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSocialPost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Post.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Replies", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSocialPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSocialPost
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSocialPost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Replies = append(m.Replies, ThreadNode{})
+			if err := m.Replies[len(m.Replies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deleted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSocialPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Deleted = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSocialPost(dAtA[iNdEx:])
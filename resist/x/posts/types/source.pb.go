@@ -24,14 +24,23 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // Source defines the Source message.
 type Source struct {
-	Index            string `protobuf:"bytes,1,opt,name=index,proto3" json:"index,omitempty"`
-	Url              string `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
-	Title            string `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
-	Description      string `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Index       string `protobuf:"bytes,1,opt,name=index,proto3" json:"index,omitempty"`
+	Url         string `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	Title       string `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Description string `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	// credibility_score is computed from the votes on the source, whether it is
+	// verified and the outcomes of the reports on the posts citing it.
 	CredibilityScore int64  `protobuf:"varint,5,opt,name=credibility_score,json=credibilityScore,proto3" json:"credibility_score,omitempty"`
 	AnalysisSummary  string `protobuf:"bytes,6,opt,name=analysis_summary,json=analysisSummary,proto3" json:"analysis_summary,omitempty"`
 	Verified         bool   `protobuf:"varint,7,opt,name=verified,proto3" json:"verified,omitempty"`
 	Creator          string `protobuf:"bytes,8,opt,name=creator,proto3" json:"creator,omitempty"`
+	// upvotes and downvotes count the community votes on the source.
+	Upvotes   uint64 `protobuf:"varint,9,opt,name=upvotes,proto3" json:"upvotes,omitempty"`
+	Downvotes uint64 `protobuf:"varint,10,opt,name=downvotes,proto3" json:"downvotes,omitempty"`
+	// resolved_reports and dismissed_reports count the outcomes of the reports
+	// on the posts citing the source.
+	ResolvedReports  uint64 `protobuf:"varint,11,opt,name=resolved_reports,json=resolvedReports,proto3" json:"resolved_reports,omitempty"`
+	DismissedReports uint64 `protobuf:"varint,12,opt,name=dismissed_reports,json=dismissedReports,proto3" json:"dismissed_reports,omitempty"`
 }
 
 func (m *Source) Reset()         { *m = Source{} }
@@ -123,31 +132,129 @@ func (m *Source) GetCreator() string {
 	return ""
 }
 
+func (m *Source) GetUpvotes() uint64 {
+	if m != nil {
+		return m.Upvotes
+	}
+	return 0
+}
+
+func (m *Source) GetDownvotes() uint64 {
+	if m != nil {
+		return m.Downvotes
+	}
+	return 0
+}
+
+func (m *Source) GetResolvedReports() uint64 {
+	if m != nil {
+		return m.ResolvedReports
+	}
+	return 0
+}
+
+func (m *Source) GetDismissedReports() uint64 {
+	if m != nil {
+		return m.DismissedReports
+	}
+	return 0
+}
+
+// SourceVote is the vote of voter on a source.
+type SourceVote struct {
+	SourceIndex string `protobuf:"bytes,1,opt,name=source_index,json=sourceIndex,proto3" json:"source_index,omitempty"`
+	Voter       string `protobuf:"bytes,2,opt,name=voter,proto3" json:"voter,omitempty"`
+	// vote_type is "upvote" or "downvote".
+	VoteType string `protobuf:"bytes,3,opt,name=vote_type,json=voteType,proto3" json:"vote_type,omitempty"`
+}
+
+func (m *SourceVote) Reset()         { *m = SourceVote{} }
+func (m *SourceVote) String() string { return proto.CompactTextString(m) }
+func (*SourceVote) ProtoMessage()    {}
+func (*SourceVote) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc9f41708b151a89, []int{1}
+}
+func (m *SourceVote) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SourceVote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SourceVote.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SourceVote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SourceVote.Merge(m, src)
+}
+func (m *SourceVote) XXX_Size() int {
+	return m.Size()
+}
+func (m *SourceVote) XXX_DiscardUnknown() {
+	xxx_messageInfo_SourceVote.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SourceVote proto.InternalMessageInfo
+
+func (m *SourceVote) GetSourceIndex() string {
+	if m != nil {
+		return m.SourceIndex
+	}
+	return ""
+}
+
+func (m *SourceVote) GetVoter() string {
+	if m != nil {
+		return m.Voter
+	}
+	return ""
+}
+
+func (m *SourceVote) GetVoteType() string {
+	if m != nil {
+		return m.VoteType
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*Source)(nil), "resist.posts.v1.Source")
+	proto.RegisterType((*SourceVote)(nil), "resist.posts.v1.SourceVote")
 }
 
 func init() { proto.RegisterFile("resist/posts/v1/source.proto", fileDescriptor_cc9f41708b151a89) }
 
 var fileDescriptor_cc9f41708b151a89 = []byte{
-	// 271 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x90, 0x31, 0x6e, 0x83, 0x30,
-	0x14, 0x86, 0x71, 0x69, 0x08, 0x75, 0x87, 0x50, 0x2b, 0x83, 0x55, 0x55, 0x16, 0xea, 0x44, 0x55,
-	0x09, 0x14, 0xf5, 0x06, 0x3d, 0x02, 0x6c, 0x5d, 0x22, 0x02, 0xae, 0x64, 0x89, 0x60, 0xf4, 0x9e,
-	0x41, 0xe1, 0x16, 0x3d, 0x56, 0xc7, 0x8c, 0x1d, 0x2b, 0xb8, 0x43, 0xe7, 0x0a, 0x93, 0x44, 0xd9,
-	0xfc, 0x7f, 0xdf, 0xef, 0x37, 0xfc, 0xf4, 0x09, 0x24, 0x2a, 0x34, 0x49, 0xa3, 0xd1, 0x60, 0xd2,
-	0x6d, 0x12, 0xd4, 0x2d, 0x14, 0x32, 0x6e, 0x40, 0x1b, 0xcd, 0x56, 0xb3, 0x8d, 0xad, 0x8d, 0xbb,
-	0xcd, 0xf3, 0x1f, 0xa1, 0x5e, 0x66, 0x1b, 0x6c, 0x4d, 0x17, 0xaa, 0x2e, 0xe5, 0x81, 0x93, 0x90,
-	0x44, 0x77, 0xe9, 0x1c, 0x58, 0x40, 0xdd, 0x16, 0x2a, 0x7e, 0x63, 0xd9, 0xf4, 0x9c, 0x7a, 0x46,
-	0x99, 0x4a, 0x72, 0x77, 0xee, 0xd9, 0xc0, 0x42, 0x7a, 0x5f, 0x4a, 0x2c, 0x40, 0x35, 0x46, 0xe9,
-	0x9a, 0xdf, 0x5a, 0x77, 0x8d, 0xd8, 0x2b, 0x7d, 0x28, 0x40, 0x96, 0x6a, 0xa7, 0x2a, 0x65, 0xfa,
-	0x2d, 0x16, 0x1a, 0x24, 0x5f, 0x84, 0x24, 0x72, 0xd3, 0xe0, 0x4a, 0x64, 0x13, 0x67, 0x2f, 0x34,
-	0xc8, 0xeb, 0xbc, 0xea, 0x51, 0xe1, 0x16, 0xdb, 0xfd, 0x3e, 0x87, 0x9e, 0x7b, 0xf6, 0xe6, 0xea,
-	0xcc, 0xb3, 0x19, 0xb3, 0x47, 0xea, 0x77, 0x12, 0xd4, 0xa7, 0x92, 0x25, 0x5f, 0x86, 0x24, 0xf2,
-	0xd3, 0x4b, 0x66, 0x9c, 0x2e, 0x0b, 0x90, 0xb9, 0xd1, 0xc0, 0x7d, 0xfb, 0xfb, 0x1c, 0xdf, 0xe3,
-	0xef, 0x41, 0x90, 0xe3, 0x20, 0xc8, 0xef, 0x20, 0xc8, 0xd7, 0x28, 0x9c, 0xe3, 0x28, 0x9c, 0x9f,
-	0x51, 0x38, 0x1f, 0xeb, 0xd3, 0x82, 0x87, 0xd3, 0x86, 0xa6, 0x6f, 0x24, 0xee, 0x3c, 0x3b, 0xe0,
-	0xdb, 0x7f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x4a, 0xf8, 0x65, 0xed, 0x60, 0x01, 0x00, 0x00,
+	// 382 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x92, 0xb1, 0x8e, 0xd3, 0x40,
+	0x10, 0x86, 0xb3, 0xe4, 0x92, 0x8b, 0x27, 0x27, 0xc5, 0xac, 0x52, 0xac, 0xe0, 0x64, 0x99, 0xab,
+	0x8c, 0x90, 0x1c, 0x9d, 0x78, 0x03, 0x3a, 0x5a, 0x07, 0x51, 0xd0, 0x58, 0x8e, 0x3d, 0x48, 0x2b,
+	0x39, 0x5e, 0x6b, 0x67, 0x6d, 0xce, 0x2f, 0x40, 0xcd, 0x63, 0x51, 0x5e, 0x49, 0x89, 0x92, 0x17,
+	0x41, 0xbb, 0x6b, 0x1f, 0xee, 0xf6, 0xff, 0xfe, 0x7f, 0xac, 0xb1, 0xfe, 0x81, 0x7b, 0x8d, 0x24,
+	0xc9, 0x1c, 0x5a, 0x45, 0x86, 0x0e, 0xfd, 0xe3, 0x81, 0x54, 0xa7, 0x4b, 0x4c, 0x5b, 0xad, 0x8c,
+	0xe2, 0x3b, 0xef, 0xa6, 0xce, 0x4d, 0xfb, 0xc7, 0x87, 0x9f, 0x4b, 0x58, 0x1f, 0x5d, 0x82, 0xef,
+	0x61, 0x25, 0x9b, 0x0a, 0x9f, 0x04, 0x8b, 0x59, 0x12, 0x64, 0x5e, 0xf0, 0x10, 0x96, 0x9d, 0xae,
+	0xc5, 0x2b, 0xc7, 0xec, 0xd3, 0xe6, 0x8c, 0x34, 0x35, 0x8a, 0xa5, 0xcf, 0x39, 0xc1, 0x63, 0xd8,
+	0x56, 0x48, 0xa5, 0x96, 0xad, 0x91, 0xaa, 0x11, 0x37, 0xce, 0x9b, 0x23, 0xfe, 0x01, 0x5e, 0x97,
+	0x1a, 0x2b, 0x79, 0x92, 0xb5, 0x34, 0x43, 0x4e, 0xa5, 0xd2, 0x28, 0x56, 0x31, 0x4b, 0x96, 0x59,
+	0x38, 0x33, 0x8e, 0x96, 0xf3, 0xf7, 0x10, 0x16, 0x4d, 0x51, 0x0f, 0x24, 0x29, 0xa7, 0xee, 0x7c,
+	0x2e, 0xf4, 0x20, 0xd6, 0xee, 0x9b, 0xbb, 0x89, 0x1f, 0x3d, 0xe6, 0x6f, 0x60, 0xd3, 0xa3, 0x96,
+	0xdf, 0x25, 0x56, 0xe2, 0x36, 0x66, 0xc9, 0x26, 0x7b, 0xd1, 0x5c, 0xc0, 0x6d, 0xa9, 0xb1, 0x30,
+	0x4a, 0x8b, 0x8d, 0x9b, 0x9e, 0xa4, 0x75, 0xba, 0xb6, 0x57, 0x06, 0x49, 0x04, 0x31, 0x4b, 0x6e,
+	0xb2, 0x49, 0xf2, 0x7b, 0x08, 0x2a, 0xf5, 0xa3, 0xf1, 0x1e, 0x38, 0xef, 0x3f, 0xb0, 0x8b, 0x69,
+	0x24, 0x55, 0xf7, 0x58, 0xe5, 0x1a, 0x5b, 0xa5, 0x0d, 0x89, 0xad, 0x0b, 0xed, 0x26, 0x9e, 0x79,
+	0x6c, 0x7f, 0xb8, 0x92, 0x74, 0x96, 0x44, 0xb3, 0xec, 0x9d, 0xcb, 0x86, 0x2f, 0xc6, 0x18, 0x7e,
+	0x38, 0x01, 0xf8, 0x1e, 0xbe, 0x2a, 0x83, 0xfc, 0x1d, 0xdc, 0xf9, 0xde, 0xf2, 0x79, 0x25, 0x5b,
+	0xcf, 0x3e, 0xbb, 0x62, 0xf6, 0xb0, 0xb2, 0x1b, 0xe9, 0xb1, 0x1a, 0x2f, 0xf8, 0x5b, 0x08, 0xec,
+	0x23, 0x37, 0x43, 0x3b, 0x15, 0xb4, 0xb1, 0xe0, 0xcb, 0xd0, 0xe2, 0xa7, 0xf4, 0xf7, 0x25, 0x62,
+	0xcf, 0x97, 0x88, 0xfd, 0xbd, 0x44, 0xec, 0xd7, 0x35, 0x5a, 0x3c, 0x5f, 0xa3, 0xc5, 0x9f, 0x6b,
+	0xb4, 0xf8, 0xb6, 0x1f, 0xaf, 0xe6, 0x69, 0xbc, 0x1b, 0x3b, 0x4e, 0xa7, 0xb5, 0x3b, 0x9a, 0x8f,
+	0xff, 0x02, 0x00, 0x00, 0xff, 0xff, 0x5f, 0x61, 0x23, 0xdd, 0x54, 0x02, 0x00, 0x00,
 }
 
 func (m *Source) Marshal() (dAtA []byte, err error) {
@@ -170,6 +277,26 @@ func (m *Source) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.DismissedReports != 0 {
+		i = encodeVarintSource(dAtA, i, uint64(m.DismissedReports))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.ResolvedReports != 0 {
+		i = encodeVarintSource(dAtA, i, uint64(m.ResolvedReports))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.Downvotes != 0 {
+		i = encodeVarintSource(dAtA, i, uint64(m.Downvotes))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.Upvotes != 0 {
+		i = encodeVarintSource(dAtA, i, uint64(m.Upvotes))
+		i--
+		dAtA[i] = 0x48
+	}
 	if len(m.Creator) > 0 {
 		i -= len(m.Creator)
 		copy(dAtA[i:], m.Creator)
@@ -230,6 +357,50 @@ func (m *Source) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SourceVote) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SourceVote) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SourceVote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.VoteType) > 0 {
+		i -= len(m.VoteType)
+		copy(dAtA[i:], m.VoteType)
+		i = encodeVarintSource(dAtA, i, uint64(len(m.VoteType)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Voter) > 0 {
+		i -= len(m.Voter)
+		copy(dAtA[i:], m.Voter)
+		i = encodeVarintSource(dAtA, i, uint64(len(m.Voter)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SourceIndex) > 0 {
+		i -= len(m.SourceIndex)
+		copy(dAtA[i:], m.SourceIndex)
+		i = encodeVarintSource(dAtA, i, uint64(len(m.SourceIndex)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintSource(dAtA []byte, offset int, v uint64) int {
 	offset -= sovSource(v)
 	base := offset
@@ -277,6 +448,39 @@ func (m *Source) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovSource(uint64(l))
 	}
+	if m.Upvotes != 0 {
+		n += 1 + sovSource(uint64(m.Upvotes))
+	}
+	if m.Downvotes != 0 {
+		n += 1 + sovSource(uint64(m.Downvotes))
+	}
+	if m.ResolvedReports != 0 {
+		n += 1 + sovSource(uint64(m.ResolvedReports))
+	}
+	if m.DismissedReports != 0 {
+		n += 1 + sovSource(uint64(m.DismissedReports))
+	}
+	return n
+}
+
+func (m *SourceVote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SourceIndex)
+	if l > 0 {
+		n += 1 + l + sovSource(uint64(l))
+	}
+	l = len(m.Voter)
+	if l > 0 {
+		n += 1 + l + sovSource(uint64(l))
+	}
+	l = len(m.VoteType)
+	if l > 0 {
+		n += 1 + l + sovSource(uint64(l))
+	}
 	return n
 }
 
@@ -546,6 +750,228 @@ func (m *Source) Unmarshal(dAtA []byte) error {
 			}
 			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Upvotes", wireType)
+			}
+			m.Upvotes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSource
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Upvotes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Downvotes", wireType)
+			}
+			m.Downvotes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSource
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Downvotes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResolvedReports", wireType)
+			}
+			m.ResolvedReports = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSource
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ResolvedReports |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DismissedReports", wireType)
+			}
+			m.DismissedReports = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSource
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DismissedReports |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSource(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSource
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SourceVote) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSource
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SourceVote: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SourceVote: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceIndex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSource
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSource
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSource
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceIndex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSource
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSource
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSource
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Voter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSource
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSource
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSource
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VoteType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSource(dAtA[iNdEx:])
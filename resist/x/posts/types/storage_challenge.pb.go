@@ -0,0 +1,655 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: resist/posts/v1/storage_challenge.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// StorageChallenge asks a replica node to prove that it stores a chunk of some content
+type StorageChallenge struct {
+	Id             uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ContentId      string `protobuf:"bytes,2,opt,name=content_id,json=contentId,proto3" json:"content_id,omitempty"`
+	NodeId         string `protobuf:"bytes,3,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	ChunkIndex     uint64 `protobuf:"varint,4,opt,name=chunk_index,json=chunkIndex,proto3" json:"chunk_index,omitempty"`
+	Status         string `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	IssuedHeight   int64  `protobuf:"varint,6,opt,name=issued_height,json=issuedHeight,proto3" json:"issued_height,omitempty"`
+	DeadlineHeight int64  `protobuf:"varint,7,opt,name=deadline_height,json=deadlineHeight,proto3" json:"deadline_height,omitempty"`
+	AnsweredHeight int64  `protobuf:"varint,8,opt,name=answered_height,json=answeredHeight,proto3" json:"answered_height,omitempty"`
+	FailureReason  string `protobuf:"bytes,9,opt,name=failure_reason,json=failureReason,proto3" json:"failure_reason,omitempty"`
+}
+
+func (m *StorageChallenge) Reset()         { *m = StorageChallenge{} }
+func (m *StorageChallenge) String() string { return proto.CompactTextString(m) }
+func (*StorageChallenge) ProtoMessage()    {}
+func (*StorageChallenge) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e23b157f0f808e6, []int{0}
+}
+func (m *StorageChallenge) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StorageChallenge) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StorageChallenge.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StorageChallenge) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StorageChallenge.Merge(m, src)
+}
+func (m *StorageChallenge) XXX_Size() int {
+	return m.Size()
+}
+func (m *StorageChallenge) XXX_DiscardUnknown() {
+	xxx_messageInfo_StorageChallenge.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StorageChallenge proto.InternalMessageInfo
+
+func (m *StorageChallenge) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *StorageChallenge) GetContentId() string {
+	if m != nil {
+		return m.ContentId
+	}
+	return ""
+}
+
+func (m *StorageChallenge) GetNodeId() string {
+	if m != nil {
+		return m.NodeId
+	}
+	return ""
+}
+
+func (m *StorageChallenge) GetChunkIndex() uint64 {
+	if m != nil {
+		return m.ChunkIndex
+	}
+	return 0
+}
+
+func (m *StorageChallenge) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *StorageChallenge) GetIssuedHeight() int64 {
+	if m != nil {
+		return m.IssuedHeight
+	}
+	return 0
+}
+
+func (m *StorageChallenge) GetDeadlineHeight() int64 {
+	if m != nil {
+		return m.DeadlineHeight
+	}
+	return 0
+}
+
+func (m *StorageChallenge) GetAnsweredHeight() int64 {
+	if m != nil {
+		return m.AnsweredHeight
+	}
+	return 0
+}
+
+func (m *StorageChallenge) GetFailureReason() string {
+	if m != nil {
+		return m.FailureReason
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*StorageChallenge)(nil), "resist.posts.v1.StorageChallenge")
+}
+
+func init() {
+	proto.RegisterFile("resist/posts/v1/storage_challenge.proto", fileDescriptor_3e23b157f0f808e6)
+}
+
+var fileDescriptor_3e23b157f0f808e6 = []byte{
+	// 307 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x44, 0x91, 0xc1, 0x4e, 0xc2, 0x40,
+	0x10, 0x86, 0xd9, 0x82, 0x45, 0x46, 0x01, 0xb3, 0x31, 0xda, 0x8b, 0x95, 0x68, 0x0c, 0x9c, 0x4a,
+	0x88, 0x6f, 0xa0, 0x17, 0xb9, 0xd6, 0x9b, 0x97, 0x66, 0x65, 0x47, 0xba, 0xb1, 0xd9, 0x25, 0x9d,
+	0x2d, 0xe2, 0x5b, 0xf8, 0x2a, 0xbe, 0x85, 0x47, 0x8e, 0x1e, 0x0d, 0xbc, 0x88, 0xe9, 0xb6, 0x0d,
+	0xc7, 0xf9, 0xe6, 0xdb, 0x3f, 0x9b, 0xf9, 0x61, 0x9c, 0x23, 0x29, 0xb2, 0xd3, 0x95, 0x21, 0x4b,
+	0xd3, 0xf5, 0x6c, 0x4a, 0xd6, 0xe4, 0x62, 0x89, 0xc9, 0x22, 0x15, 0x59, 0x86, 0x7a, 0x89, 0xd1,
+	0x2a, 0x37, 0xd6, 0xf0, 0x61, 0x25, 0x46, 0x4e, 0x8c, 0xd6, 0xb3, 0x9b, 0x6f, 0x0f, 0xce, 0x9e,
+	0x2b, 0xf9, 0xb1, 0x71, 0xf9, 0x00, 0x3c, 0x25, 0x03, 0x36, 0x62, 0x93, 0x4e, 0xec, 0x29, 0xc9,
+	0xaf, 0x00, 0x16, 0x46, 0x5b, 0xd4, 0x36, 0x51, 0x32, 0xf0, 0x46, 0x6c, 0xd2, 0x8b, 0x7b, 0x35,
+	0x99, 0x4b, 0x7e, 0x09, 0x5d, 0x6d, 0x24, 0x96, 0xbb, 0xb6, 0xdb, 0xf9, 0xe5, 0x38, 0x97, 0xfc,
+	0x1a, 0x4e, 0x16, 0x69, 0xa1, 0xdf, 0x13, 0xa5, 0x25, 0x6e, 0x82, 0x8e, 0x0b, 0x04, 0x87, 0xe6,
+	0x25, 0xe1, 0x17, 0xe0, 0x93, 0x15, 0xb6, 0xa0, 0xe0, 0xa8, 0x7a, 0x58, 0x4d, 0xfc, 0x16, 0xfa,
+	0x8a, 0xa8, 0x40, 0x99, 0xa4, 0xa8, 0x96, 0xa9, 0x0d, 0xfc, 0x11, 0x9b, 0xb4, 0xe3, 0xd3, 0x0a,
+	0x3e, 0x39, 0xc6, 0xc7, 0x30, 0x94, 0x28, 0x64, 0xa6, 0x34, 0x36, 0x5a, 0xd7, 0x69, 0x83, 0x06,
+	0x1f, 0x44, 0xa1, 0xe9, 0x03, 0xf3, 0x43, 0xde, 0x71, 0x25, 0x36, 0xb8, 0x16, 0xef, 0x60, 0xf0,
+	0x26, 0x54, 0x56, 0xe4, 0x98, 0xe4, 0x28, 0xc8, 0xe8, 0xa0, 0xe7, 0xbe, 0xd5, 0xaf, 0x69, 0xec,
+	0xe0, 0x43, 0xf4, 0xb3, 0x0b, 0xd9, 0x76, 0x17, 0xb2, 0xbf, 0x5d, 0xc8, 0xbe, 0xf6, 0x61, 0x6b,
+	0xbb, 0x0f, 0x5b, 0xbf, 0xfb, 0xb0, 0xf5, 0x72, 0x5e, 0xf7, 0xb0, 0xa9, 0x9b, 0xb0, 0x9f, 0x2b,
+	0xa4, 0x57, 0xdf, 0xdd, 0xfe, 0xfe, 0x3f, 0x00, 0x00, 0xff, 0xff, 0x69, 0xfa, 0x4e, 0xf9, 0xa6,
+	0x01, 0x00, 0x00,
+}
+
+func (m *StorageChallenge) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StorageChallenge) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StorageChallenge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FailureReason) > 0 {
+		i -= len(m.FailureReason)
+		copy(dAtA[i:], m.FailureReason)
+		i = encodeVarintStorageChallenge(dAtA, i, uint64(len(m.FailureReason)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.AnsweredHeight != 0 {
+		i = encodeVarintStorageChallenge(dAtA, i, uint64(m.AnsweredHeight))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.DeadlineHeight != 0 {
+		i = encodeVarintStorageChallenge(dAtA, i, uint64(m.DeadlineHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.IssuedHeight != 0 {
+		i = encodeVarintStorageChallenge(dAtA, i, uint64(m.IssuedHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintStorageChallenge(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.ChunkIndex != 0 {
+		i = encodeVarintStorageChallenge(dAtA, i, uint64(m.ChunkIndex))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.NodeId) > 0 {
+		i -= len(m.NodeId)
+		copy(dAtA[i:], m.NodeId)
+		i = encodeVarintStorageChallenge(dAtA, i, uint64(len(m.NodeId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ContentId) > 0 {
+		i -= len(m.ContentId)
+		copy(dAtA[i:], m.ContentId)
+		i = encodeVarintStorageChallenge(dAtA, i, uint64(len(m.ContentId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintStorageChallenge(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintStorageChallenge(dAtA []byte, offset int, v uint64) int {
+	offset -= sovStorageChallenge(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *StorageChallenge) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovStorageChallenge(uint64(m.Id))
+	}
+	l = len(m.ContentId)
+	if l > 0 {
+		n += 1 + l + sovStorageChallenge(uint64(l))
+	}
+	l = len(m.NodeId)
+	if l > 0 {
+		n += 1 + l + sovStorageChallenge(uint64(l))
+	}
+	if m.ChunkIndex != 0 {
+		n += 1 + sovStorageChallenge(uint64(m.ChunkIndex))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovStorageChallenge(uint64(l))
+	}
+	if m.IssuedHeight != 0 {
+		n += 1 + sovStorageChallenge(uint64(m.IssuedHeight))
+	}
+	if m.DeadlineHeight != 0 {
+		n += 1 + sovStorageChallenge(uint64(m.DeadlineHeight))
+	}
+	if m.AnsweredHeight != 0 {
+		n += 1 + sovStorageChallenge(uint64(m.AnsweredHeight))
+	}
+	l = len(m.FailureReason)
+	if l > 0 {
+		n += 1 + l + sovStorageChallenge(uint64(l))
+	}
+	return n
+}
+
+func sovStorageChallenge(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozStorageChallenge(x uint64) (n int) {
+	return sovStorageChallenge(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *StorageChallenge) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStorageChallenge
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StorageChallenge: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StorageChallenge: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStorageChallenge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContentId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStorageChallenge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStorageChallenge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStorageChallenge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContentId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStorageChallenge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStorageChallenge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStorageChallenge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NodeId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChunkIndex", wireType)
+			}
+			m.ChunkIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStorageChallenge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChunkIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStorageChallenge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStorageChallenge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStorageChallenge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IssuedHeight", wireType)
+			}
+			m.IssuedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStorageChallenge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IssuedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeadlineHeight", wireType)
+			}
+			m.DeadlineHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStorageChallenge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DeadlineHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AnsweredHeight", wireType)
+			}
+			m.AnsweredHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStorageChallenge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AnsweredHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailureReason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStorageChallenge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStorageChallenge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStorageChallenge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FailureReason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStorageChallenge(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStorageChallenge
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipStorageChallenge(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowStorageChallenge
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowStorageChallenge
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowStorageChallenge
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthStorageChallenge
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupStorageChallenge
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthStorageChallenge
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthStorageChallenge        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowStorageChallenge          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupStorageChallenge = fmt.Errorf("proto: unexpected end of group")
+)
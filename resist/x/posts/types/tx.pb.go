@@ -132,6 +132,10 @@ type MsgCreatePost struct {
 	MediaUrl  string `protobuf:"bytes,4,opt,name=media_url,json=mediaUrl,proto3" json:"media_url,omitempty"`
 	MediaType string `protobuf:"bytes,5,opt,name=media_type,json=mediaType,proto3" json:"media_type,omitempty"`
 	GroupId   uint64 `protobuf:"varint,6,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	// quoted_index is the post quoted by the new post, if any.
+	QuotedIndex string `protobuf:"bytes,7,opt,name=quoted_index,json=quotedIndex,proto3" json:"quoted_index,omitempty"`
+	// source_indexes are the sources cited by the new post.
+	SourceIndexes []string `protobuf:"bytes,8,rep,name=source_indexes,json=sourceIndexes,proto3" json:"source_indexes,omitempty"`
 }
 
 func (m *MsgCreatePost) Reset()         { *m = MsgCreatePost{} }
@@ -209,8 +213,23 @@ func (m *MsgCreatePost) GetGroupId() uint64 {
 	return 0
 }
 
+func (m *MsgCreatePost) GetQuotedIndex() string {
+	if m != nil {
+		return m.QuotedIndex
+	}
+	return ""
+}
+
+func (m *MsgCreatePost) GetSourceIndexes() []string {
+	if m != nil {
+		return m.SourceIndexes
+	}
+	return nil
+}
+
 // MsgCreatePostResponse defines the MsgCreatePostResponse message.
 type MsgCreatePostResponse struct {
+	Index string `protobuf:"bytes,1,opt,name=index,proto3" json:"index,omitempty"`
 }
 
 func (m *MsgCreatePostResponse) Reset()         { *m = MsgCreatePostResponse{} }
@@ -246,6 +265,145 @@ func (m *MsgCreatePostResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgCreatePostResponse proto.InternalMessageInfo
 
+func (m *MsgCreatePostResponse) GetIndex() string {
+	if m != nil {
+		return m.Index
+	}
+	return ""
+}
+
+// MsgReplyToPost defines the MsgReplyToPost message. The reply belongs to the
+// group of the parent post.
+type MsgReplyToPost struct {
+	Creator     string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	ParentIndex string `protobuf:"bytes,2,opt,name=parent_index,json=parentIndex,proto3" json:"parent_index,omitempty"`
+	Content     string `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	MediaUrl    string `protobuf:"bytes,4,opt,name=media_url,json=mediaUrl,proto3" json:"media_url,omitempty"`
+	MediaType   string `protobuf:"bytes,5,opt,name=media_type,json=mediaType,proto3" json:"media_type,omitempty"`
+	// quoted_index is the post quoted by the reply, if any.
+	QuotedIndex string `protobuf:"bytes,6,opt,name=quoted_index,json=quotedIndex,proto3" json:"quoted_index,omitempty"`
+}
+
+func (m *MsgReplyToPost) Reset()         { *m = MsgReplyToPost{} }
+func (m *MsgReplyToPost) String() string { return proto.CompactTextString(m) }
+func (*MsgReplyToPost) ProtoMessage()    {}
+func (*MsgReplyToPost) Descriptor() ([]byte, []int) {
+	return fileDescriptor_14bdbb892576efd6, []int{4}
+}
+func (m *MsgReplyToPost) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgReplyToPost) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgReplyToPost.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgReplyToPost) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReplyToPost.Merge(m, src)
+}
+func (m *MsgReplyToPost) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgReplyToPost) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReplyToPost.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgReplyToPost proto.InternalMessageInfo
+
+func (m *MsgReplyToPost) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgReplyToPost) GetParentIndex() string {
+	if m != nil {
+		return m.ParentIndex
+	}
+	return ""
+}
+
+func (m *MsgReplyToPost) GetContent() string {
+	if m != nil {
+		return m.Content
+	}
+	return ""
+}
+
+func (m *MsgReplyToPost) GetMediaUrl() string {
+	if m != nil {
+		return m.MediaUrl
+	}
+	return ""
+}
+
+func (m *MsgReplyToPost) GetMediaType() string {
+	if m != nil {
+		return m.MediaType
+	}
+	return ""
+}
+
+func (m *MsgReplyToPost) GetQuotedIndex() string {
+	if m != nil {
+		return m.QuotedIndex
+	}
+	return ""
+}
+
+// MsgReplyToPostResponse defines the MsgReplyToPostResponse message.
+type MsgReplyToPostResponse struct {
+	Index string `protobuf:"bytes,1,opt,name=index,proto3" json:"index,omitempty"`
+}
+
+func (m *MsgReplyToPostResponse) Reset()         { *m = MsgReplyToPostResponse{} }
+func (m *MsgReplyToPostResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgReplyToPostResponse) ProtoMessage()    {}
+func (*MsgReplyToPostResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_14bdbb892576efd6, []int{5}
+}
+func (m *MsgReplyToPostResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgReplyToPostResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgReplyToPostResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgReplyToPostResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReplyToPostResponse.Merge(m, src)
+}
+func (m *MsgReplyToPostResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgReplyToPostResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReplyToPostResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgReplyToPostResponse proto.InternalMessageInfo
+
+func (m *MsgReplyToPostResponse) GetIndex() string {
+	if m != nil {
+		return m.Index
+	}
+	return ""
+}
+
 // MsgVotePost defines the MsgVotePost message.
 type MsgVotePost struct {
 	Creator   string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
@@ -257,7 +415,7 @@ func (m *MsgVotePost) Reset()         { *m = MsgVotePost{} }
 func (m *MsgVotePost) String() string { return proto.CompactTextString(m) }
 func (*MsgVotePost) ProtoMessage()    {}
 func (*MsgVotePost) Descriptor() ([]byte, []int) {
-	return fileDescriptor_14bdbb892576efd6, []int{4}
+	return fileDescriptor_14bdbb892576efd6, []int{6}
 }
 func (m *MsgVotePost) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -315,7 +473,7 @@ func (m *MsgVotePostResponse) Reset()         { *m = MsgVotePostResponse{} }
 func (m *MsgVotePostResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgVotePostResponse) ProtoMessage()    {}
 func (*MsgVotePostResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_14bdbb892576efd6, []int{5}
+	return fileDescriptor_14bdbb892576efd6, []int{7}
 }
 func (m *MsgVotePostResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -346,8 +504,10 @@ var xxx_messageInfo_MsgVotePostResponse proto.InternalMessageInfo
 
 // MsgCreateSocialPost defines the MsgCreateSocialPost message.
 type MsgCreateSocialPost struct {
-	Creator   string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	Index     string `protobuf:"bytes,2,opt,name=index,proto3" json:"index,omitempty"`
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	// index is ignored: the index of the post is assigned by the chain and
+	// returned in the response.
+	Index     string `protobuf:"bytes,2,opt,name=index,proto3" json:"index,omitempty"` // Deprecated: Do not use.
 	Title     string `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
 	Content   string `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`
 	MediaUrl  string `protobuf:"bytes,5,opt,name=media_url,json=mediaUrl,proto3" json:"media_url,omitempty"`
@@ -363,7 +523,7 @@ func (m *MsgCreateSocialPost) Reset()         { *m = MsgCreateSocialPost{} }
 func (m *MsgCreateSocialPost) String() string { return proto.CompactTextString(m) }
 func (*MsgCreateSocialPost) ProtoMessage()    {}
 func (*MsgCreateSocialPost) Descriptor() ([]byte, []int) {
-	return fileDescriptor_14bdbb892576efd6, []int{6}
+	return fileDescriptor_14bdbb892576efd6, []int{8}
 }
 func (m *MsgCreateSocialPost) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -399,6 +559,7 @@ func (m *MsgCreateSocialPost) GetCreator() string {
 	return ""
 }
 
+// Deprecated: Do not use.
 func (m *MsgCreateSocialPost) GetIndex() string {
 	if m != nil {
 		return m.Index
@@ -471,13 +632,14 @@ func (m *MsgCreateSocialPost) GetCreatedAt() uint64 {
 
 // MsgCreateSocialPostResponse defines the MsgCreateSocialPostResponse message.
 type MsgCreateSocialPostResponse struct {
+	Index string `protobuf:"bytes,1,opt,name=index,proto3" json:"index,omitempty"`
 }
 
 func (m *MsgCreateSocialPostResponse) Reset()         { *m = MsgCreateSocialPostResponse{} }
 func (m *MsgCreateSocialPostResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgCreateSocialPostResponse) ProtoMessage()    {}
 func (*MsgCreateSocialPostResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_14bdbb892576efd6, []int{7}
+	return fileDescriptor_14bdbb892576efd6, []int{9}
 }
 func (m *MsgCreateSocialPostResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -506,6 +668,13 @@ func (m *MsgCreateSocialPostResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgCreateSocialPostResponse proto.InternalMessageInfo
 
+func (m *MsgCreateSocialPostResponse) GetIndex() string {
+	if m != nil {
+		return m.Index
+	}
+	return ""
+}
+
 // MsgUpdateSocialPost defines the MsgUpdateSocialPost message.
 type MsgUpdateSocialPost struct {
 	Creator   string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
@@ -525,7 +694,7 @@ func (m *MsgUpdateSocialPost) Reset()         { *m = MsgUpdateSocialPost{} }
 func (m *MsgUpdateSocialPost) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateSocialPost) ProtoMessage()    {}
 func (*MsgUpdateSocialPost) Descriptor() ([]byte, []int) {
-	return fileDescriptor_14bdbb892576efd6, []int{8}
+	return fileDescriptor_14bdbb892576efd6, []int{10}
 }
 func (m *MsgUpdateSocialPost) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -639,7 +808,7 @@ func (m *MsgUpdateSocialPostResponse) Reset()         { *m = MsgUpdateSocialPost
 func (m *MsgUpdateSocialPostResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateSocialPostResponse) ProtoMessage()    {}
 func (*MsgUpdateSocialPostResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_14bdbb892576efd6, []int{9}
+	return fileDescriptor_14bdbb892576efd6, []int{11}
 }
 func (m *MsgUpdateSocialPostResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -678,7 +847,7 @@ func (m *MsgDeleteSocialPost) Reset()         { *m = MsgDeleteSocialPost{} }
 func (m *MsgDeleteSocialPost) String() string { return proto.CompactTextString(m) }
 func (*MsgDeleteSocialPost) ProtoMessage()    {}
 func (*MsgDeleteSocialPost) Descriptor() ([]byte, []int) {
-	return fileDescriptor_14bdbb892576efd6, []int{10}
+	return fileDescriptor_14bdbb892576efd6, []int{12}
 }
 func (m *MsgDeleteSocialPost) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -729,7 +898,7 @@ func (m *MsgDeleteSocialPostResponse) Reset()         { *m = MsgDeleteSocialPost
 func (m *MsgDeleteSocialPostResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgDeleteSocialPostResponse) ProtoMessage()    {}
 func (*MsgDeleteSocialPostResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_14bdbb892576efd6, []int{11}
+	return fileDescriptor_14bdbb892576efd6, []int{13}
 }
 func (m *MsgDeleteSocialPostResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -772,7 +941,7 @@ func (m *MsgCreateVote) Reset()         { *m = MsgCreateVote{} }
 func (m *MsgCreateVote) String() string { return proto.CompactTextString(m) }
 func (*MsgCreateVote) ProtoMessage()    {}
 func (*MsgCreateVote) Descriptor() ([]byte, []int) {
-	return fileDescriptor_14bdbb892576efd6, []int{12}
+	return fileDescriptor_14bdbb892576efd6, []int{14}
 }
 func (m *MsgCreateVote) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -851,7 +1020,7 @@ func (m *MsgCreateVoteResponse) Reset()         { *m = MsgCreateVoteResponse{} }
 func (m *MsgCreateVoteResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgCreateVoteResponse) ProtoMessage()    {}
 func (*MsgCreateVoteResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_14bdbb892576efd6, []int{13}
+	return fileDescriptor_14bdbb892576efd6, []int{15}
 }
 func (m *MsgCreateVoteResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -894,7 +1063,7 @@ func (m *MsgUpdateVote) Reset()         { *m = MsgUpdateVote{} }
 func (m *MsgUpdateVote) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateVote) ProtoMessage()    {}
 func (*MsgUpdateVote) Descriptor() ([]byte, []int) {
-	return fileDescriptor_14bdbb892576efd6, []int{14}
+	return fileDescriptor_14bdbb892576efd6, []int{16}
 }
 func (m *MsgUpdateVote) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -973,7 +1142,7 @@ func (m *MsgUpdateVoteResponse) Reset()         { *m = MsgUpdateVoteResponse{} }
 func (m *MsgUpdateVoteResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateVoteResponse) ProtoMessage()    {}
 func (*MsgUpdateVoteResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_14bdbb892576efd6, []int{15}
+	return fileDescriptor_14bdbb892576efd6, []int{17}
 }
 func (m *MsgUpdateVoteResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1012,7 +1181,7 @@ func (m *MsgDeleteVote) Reset()         { *m = MsgDeleteVote{} }
 func (m *MsgDeleteVote) String() string { return proto.CompactTextString(m) }
 func (*MsgDeleteVote) ProtoMessage()    {}
 func (*MsgDeleteVote) Descriptor() ([]byte, []int) {
-	return fileDescriptor_14bdbb892576efd6, []int{16}
+	return fileDescriptor_14bdbb892576efd6, []int{18}
 }
 func (m *MsgDeleteVote) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1063,7 +1232,7 @@ func (m *MsgDeleteVoteResponse) Reset()         { *m = MsgDeleteVoteResponse{} }
 func (m *MsgDeleteVoteResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgDeleteVoteResponse) ProtoMessage()    {}
 func (*MsgDeleteVoteResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_14bdbb892576efd6, []int{17}
+	return fileDescriptor_14bdbb892576efd6, []int{19}
 }
 func (m *MsgDeleteVoteResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1094,21 +1263,25 @@ var xxx_messageInfo_MsgDeleteVoteResponse proto.InternalMessageInfo
 
 // MsgCreateSource defines the MsgCreateSource message.
 type MsgCreateSource struct {
-	Creator          string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	Index            string `protobuf:"bytes,2,opt,name=index,proto3" json:"index,omitempty"`
-	Url              string `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
-	Title            string `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
-	Description      string `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	Creator     string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Index       string `protobuf:"bytes,2,opt,name=index,proto3" json:"index,omitempty"`
+	Url         string `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
+	Title       string `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
+	Description string `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	// credibility_score is ignored, the score of a source being computed
+	// on-chain.
 	CredibilityScore int64  `protobuf:"varint,6,opt,name=credibility_score,json=credibilityScore,proto3" json:"credibility_score,omitempty"`
 	AnalysisSummary  string `protobuf:"bytes,7,opt,name=analysis_summary,json=analysisSummary,proto3" json:"analysis_summary,omitempty"`
-	Verified         bool   `protobuf:"varint,8,opt,name=verified,proto3" json:"verified,omitempty"`
+	// verified is ignored, a source being verified only through
+	// MsgVerifySource.
+	Verified bool `protobuf:"varint,8,opt,name=verified,proto3" json:"verified,omitempty"`
 }
 
 func (m *MsgCreateSource) Reset()         { *m = MsgCreateSource{} }
 func (m *MsgCreateSource) String() string { return proto.CompactTextString(m) }
 func (*MsgCreateSource) ProtoMessage()    {}
 func (*MsgCreateSource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_14bdbb892576efd6, []int{18}
+	return fileDescriptor_14bdbb892576efd6, []int{20}
 }
 func (m *MsgCreateSource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1201,7 +1374,7 @@ func (m *MsgCreateSourceResponse) Reset()         { *m = MsgCreateSourceResponse
 func (m *MsgCreateSourceResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgCreateSourceResponse) ProtoMessage()    {}
 func (*MsgCreateSourceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_14bdbb892576efd6, []int{19}
+	return fileDescriptor_14bdbb892576efd6, []int{21}
 }
 func (m *MsgCreateSourceResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1232,21 +1405,25 @@ var xxx_messageInfo_MsgCreateSourceResponse proto.InternalMessageInfo
 
 // MsgUpdateSource defines the MsgUpdateSource message.
 type MsgUpdateSource struct {
-	Creator          string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	Index            string `protobuf:"bytes,2,opt,name=index,proto3" json:"index,omitempty"`
-	Url              string `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
-	Title            string `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
-	Description      string `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	Creator     string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Index       string `protobuf:"bytes,2,opt,name=index,proto3" json:"index,omitempty"`
+	Url         string `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
+	Title       string `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
+	Description string `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	// credibility_score is ignored, the score of a source being computed
+	// on-chain.
 	CredibilityScore int64  `protobuf:"varint,6,opt,name=credibility_score,json=credibilityScore,proto3" json:"credibility_score,omitempty"`
 	AnalysisSummary  string `protobuf:"bytes,7,opt,name=analysis_summary,json=analysisSummary,proto3" json:"analysis_summary,omitempty"`
-	Verified         bool   `protobuf:"varint,8,opt,name=verified,proto3" json:"verified,omitempty"`
+	// verified is ignored, a source being verified only through
+	// MsgVerifySource.
+	Verified bool `protobuf:"varint,8,opt,name=verified,proto3" json:"verified,omitempty"`
 }
 
 func (m *MsgUpdateSource) Reset()         { *m = MsgUpdateSource{} }
 func (m *MsgUpdateSource) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateSource) ProtoMessage()    {}
 func (*MsgUpdateSource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_14bdbb892576efd6, []int{20}
+	return fileDescriptor_14bdbb892576efd6, []int{22}
 }
 func (m *MsgUpdateSource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1339,7 +1516,7 @@ func (m *MsgUpdateSourceResponse) Reset()         { *m = MsgUpdateSourceResponse
 func (m *MsgUpdateSourceResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateSourceResponse) ProtoMessage()    {}
 func (*MsgUpdateSourceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_14bdbb892576efd6, []int{21}
+	return fileDescriptor_14bdbb892576efd6, []int{23}
 }
 func (m *MsgUpdateSourceResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1378,7 +1555,7 @@ func (m *MsgDeleteSource) Reset()         { *m = MsgDeleteSource{} }
 func (m *MsgDeleteSource) String() string { return proto.CompactTextString(m) }
 func (*MsgDeleteSource) ProtoMessage()    {}
 func (*MsgDeleteSource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_14bdbb892576efd6, []int{22}
+	return fileDescriptor_14bdbb892576efd6, []int{24}
 }
 func (m *MsgDeleteSource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1429,7 +1606,7 @@ func (m *MsgDeleteSourceResponse) Reset()         { *m = MsgDeleteSourceResponse
 func (m *MsgDeleteSourceResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgDeleteSourceResponse) ProtoMessage()    {}
 func (*MsgDeleteSourceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_14bdbb892576efd6, []int{23}
+	return fileDescriptor_14bdbb892576efd6, []int{25}
 }
 func (m *MsgDeleteSourceResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1458,29 +1635,26 @@ func (m *MsgDeleteSourceResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgDeleteSourceResponse proto.InternalMessageInfo
 
-// MsgCreatePostTag defines the MsgCreatePostTag message.
-type MsgCreatePostTag struct {
-	Creator         string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	Index           string `protobuf:"bytes,2,opt,name=index,proto3" json:"index,omitempty"`
-	PostIndex       string `protobuf:"bytes,3,opt,name=post_index,json=postIndex,proto3" json:"post_index,omitempty"`
-	Tag             string `protobuf:"bytes,4,opt,name=tag,proto3" json:"tag,omitempty"`
-	Category        string `protobuf:"bytes,5,opt,name=category,proto3" json:"category,omitempty"`
-	SimilarityScore int64  `protobuf:"varint,6,opt,name=similarity_score,json=similarityScore,proto3" json:"similarity_score,omitempty"`
-	RelatedPosts    string `protobuf:"bytes,7,opt,name=related_posts,json=relatedPosts,proto3" json:"related_posts,omitempty"`
+// MsgVoteSource defines the MsgVoteSource message.
+type MsgVoteSource struct {
+	Creator     string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	SourceIndex string `protobuf:"bytes,2,opt,name=source_index,json=sourceIndex,proto3" json:"source_index,omitempty"`
+	// vote_type is "upvote" or "downvote".
+	VoteType string `protobuf:"bytes,3,opt,name=vote_type,json=voteType,proto3" json:"vote_type,omitempty"`
 }
 
-func (m *MsgCreatePostTag) Reset()         { *m = MsgCreatePostTag{} }
-func (m *MsgCreatePostTag) String() string { return proto.CompactTextString(m) }
-func (*MsgCreatePostTag) ProtoMessage()    {}
-func (*MsgCreatePostTag) Descriptor() ([]byte, []int) {
-	return fileDescriptor_14bdbb892576efd6, []int{24}
+func (m *MsgVoteSource) Reset()         { *m = MsgVoteSource{} }
+func (m *MsgVoteSource) String() string { return proto.CompactTextString(m) }
+func (*MsgVoteSource) ProtoMessage()    {}
+func (*MsgVoteSource) Descriptor() ([]byte, []int) {
+	return fileDescriptor_14bdbb892576efd6, []int{26}
 }
-func (m *MsgCreatePostTag) XXX_Unmarshal(b []byte) error {
+func (m *MsgVoteSource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgCreatePostTag) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgVoteSource) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgCreatePostTag.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgVoteSource.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1490,83 +1664,56 @@ func (m *MsgCreatePostTag) XXX_Marshal(b []byte, deterministic bool) ([]byte, er
 		return b[:n], nil
 	}
 }
-func (m *MsgCreatePostTag) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgCreatePostTag.Merge(m, src)
+func (m *MsgVoteSource) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgVoteSource.Merge(m, src)
 }
-func (m *MsgCreatePostTag) XXX_Size() int {
+func (m *MsgVoteSource) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgCreatePostTag) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgCreatePostTag.DiscardUnknown(m)
+func (m *MsgVoteSource) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgVoteSource.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgCreatePostTag proto.InternalMessageInfo
+var xxx_messageInfo_MsgVoteSource proto.InternalMessageInfo
 
-func (m *MsgCreatePostTag) GetCreator() string {
+func (m *MsgVoteSource) GetCreator() string {
 	if m != nil {
 		return m.Creator
 	}
 	return ""
 }
 
-func (m *MsgCreatePostTag) GetIndex() string {
-	if m != nil {
-		return m.Index
-	}
-	return ""
-}
-
-func (m *MsgCreatePostTag) GetPostIndex() string {
-	if m != nil {
-		return m.PostIndex
-	}
-	return ""
-}
-
-func (m *MsgCreatePostTag) GetTag() string {
-	if m != nil {
-		return m.Tag
-	}
-	return ""
-}
-
-func (m *MsgCreatePostTag) GetCategory() string {
+func (m *MsgVoteSource) GetSourceIndex() string {
 	if m != nil {
-		return m.Category
+		return m.SourceIndex
 	}
 	return ""
 }
 
-func (m *MsgCreatePostTag) GetSimilarityScore() int64 {
-	if m != nil {
-		return m.SimilarityScore
-	}
-	return 0
-}
-
-func (m *MsgCreatePostTag) GetRelatedPosts() string {
+func (m *MsgVoteSource) GetVoteType() string {
 	if m != nil {
-		return m.RelatedPosts
+		return m.VoteType
 	}
 	return ""
 }
 
-// MsgCreatePostTagResponse defines the MsgCreatePostTagResponse message.
-type MsgCreatePostTagResponse struct {
+// MsgVoteSourceResponse defines the MsgVoteSourceResponse message.
+type MsgVoteSourceResponse struct {
+	CredibilityScore int64 `protobuf:"varint,1,opt,name=credibility_score,json=credibilityScore,proto3" json:"credibility_score,omitempty"`
 }
 
-func (m *MsgCreatePostTagResponse) Reset()         { *m = MsgCreatePostTagResponse{} }
-func (m *MsgCreatePostTagResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgCreatePostTagResponse) ProtoMessage()    {}
-func (*MsgCreatePostTagResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_14bdbb892576efd6, []int{25}
+func (m *MsgVoteSourceResponse) Reset()         { *m = MsgVoteSourceResponse{} }
+func (m *MsgVoteSourceResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgVoteSourceResponse) ProtoMessage()    {}
+func (*MsgVoteSourceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_14bdbb892576efd6, []int{27}
 }
-func (m *MsgCreatePostTagResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgVoteSourceResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgCreatePostTagResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgVoteSourceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgCreatePostTagResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgVoteSourceResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1576,41 +1723,45 @@ func (m *MsgCreatePostTagResponse) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (m *MsgCreatePostTagResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgCreatePostTagResponse.Merge(m, src)
+func (m *MsgVoteSourceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgVoteSourceResponse.Merge(m, src)
 }
-func (m *MsgCreatePostTagResponse) XXX_Size() int {
+func (m *MsgVoteSourceResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgCreatePostTagResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgCreatePostTagResponse.DiscardUnknown(m)
+func (m *MsgVoteSourceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgVoteSourceResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgCreatePostTagResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgVoteSourceResponse proto.InternalMessageInfo
 
-// MsgUpdatePostTag defines the MsgUpdatePostTag message.
-type MsgUpdatePostTag struct {
-	Creator         string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	Index           string `protobuf:"bytes,2,opt,name=index,proto3" json:"index,omitempty"`
-	PostIndex       string `protobuf:"bytes,3,opt,name=post_index,json=postIndex,proto3" json:"post_index,omitempty"`
-	Tag             string `protobuf:"bytes,4,opt,name=tag,proto3" json:"tag,omitempty"`
-	Category        string `protobuf:"bytes,5,opt,name=category,proto3" json:"category,omitempty"`
-	SimilarityScore int64  `protobuf:"varint,6,opt,name=similarity_score,json=similarityScore,proto3" json:"similarity_score,omitempty"`
-	RelatedPosts    string `protobuf:"bytes,7,opt,name=related_posts,json=relatedPosts,proto3" json:"related_posts,omitempty"`
+func (m *MsgVoteSourceResponse) GetCredibilityScore() int64 {
+	if m != nil {
+		return m.CredibilityScore
+	}
+	return 0
 }
 
-func (m *MsgUpdatePostTag) Reset()         { *m = MsgUpdatePostTag{} }
-func (m *MsgUpdatePostTag) String() string { return proto.CompactTextString(m) }
-func (*MsgUpdatePostTag) ProtoMessage()    {}
-func (*MsgUpdatePostTag) Descriptor() ([]byte, []int) {
-	return fileDescriptor_14bdbb892576efd6, []int{26}
+// MsgVerifySource defines the MsgVerifySource message. The creator must be
+// the module authority or a registered attestation issuer.
+type MsgVerifySource struct {
+	Creator  string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Index    string `protobuf:"bytes,2,opt,name=index,proto3" json:"index,omitempty"`
+	Verified bool   `protobuf:"varint,3,opt,name=verified,proto3" json:"verified,omitempty"`
 }
-func (m *MsgUpdatePostTag) XXX_Unmarshal(b []byte) error {
+
+func (m *MsgVerifySource) Reset()         { *m = MsgVerifySource{} }
+func (m *MsgVerifySource) String() string { return proto.CompactTextString(m) }
+func (*MsgVerifySource) ProtoMessage()    {}
+func (*MsgVerifySource) Descriptor() ([]byte, []int) {
+	return fileDescriptor_14bdbb892576efd6, []int{28}
+}
+func (m *MsgVerifySource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgUpdatePostTag) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgVerifySource) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgUpdatePostTag.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgVerifySource.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1620,17 +1771,257 @@ func (m *MsgUpdatePostTag) XXX_Marshal(b []byte, deterministic bool) ([]byte, er
 		return b[:n], nil
 	}
 }
-func (m *MsgUpdatePostTag) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgUpdatePostTag.Merge(m, src)
+func (m *MsgVerifySource) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgVerifySource.Merge(m, src)
 }
-func (m *MsgUpdatePostTag) XXX_Size() int {
+func (m *MsgVerifySource) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgUpdatePostTag) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgUpdatePostTag.DiscardUnknown(m)
+func (m *MsgVerifySource) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgVerifySource.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgUpdatePostTag proto.InternalMessageInfo
+var xxx_messageInfo_MsgVerifySource proto.InternalMessageInfo
+
+func (m *MsgVerifySource) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgVerifySource) GetIndex() string {
+	if m != nil {
+		return m.Index
+	}
+	return ""
+}
+
+func (m *MsgVerifySource) GetVerified() bool {
+	if m != nil {
+		return m.Verified
+	}
+	return false
+}
+
+// MsgVerifySourceResponse defines the MsgVerifySourceResponse message.
+type MsgVerifySourceResponse struct {
+	CredibilityScore int64 `protobuf:"varint,1,opt,name=credibility_score,json=credibilityScore,proto3" json:"credibility_score,omitempty"`
+}
+
+func (m *MsgVerifySourceResponse) Reset()         { *m = MsgVerifySourceResponse{} }
+func (m *MsgVerifySourceResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgVerifySourceResponse) ProtoMessage()    {}
+func (*MsgVerifySourceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_14bdbb892576efd6, []int{29}
+}
+func (m *MsgVerifySourceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgVerifySourceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgVerifySourceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgVerifySourceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgVerifySourceResponse.Merge(m, src)
+}
+func (m *MsgVerifySourceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgVerifySourceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgVerifySourceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgVerifySourceResponse proto.InternalMessageInfo
+
+func (m *MsgVerifySourceResponse) GetCredibilityScore() int64 {
+	if m != nil {
+		return m.CredibilityScore
+	}
+	return 0
+}
+
+// MsgCreatePostTag defines the MsgCreatePostTag message.
+type MsgCreatePostTag struct {
+	Creator         string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Index           string `protobuf:"bytes,2,opt,name=index,proto3" json:"index,omitempty"`
+	PostIndex       string `protobuf:"bytes,3,opt,name=post_index,json=postIndex,proto3" json:"post_index,omitempty"`
+	Tag             string `protobuf:"bytes,4,opt,name=tag,proto3" json:"tag,omitempty"`
+	Category        string `protobuf:"bytes,5,opt,name=category,proto3" json:"category,omitempty"`
+	SimilarityScore int64  `protobuf:"varint,6,opt,name=similarity_score,json=similarityScore,proto3" json:"similarity_score,omitempty"`
+	RelatedPosts    string `protobuf:"bytes,7,opt,name=related_posts,json=relatedPosts,proto3" json:"related_posts,omitempty"`
+}
+
+func (m *MsgCreatePostTag) Reset()         { *m = MsgCreatePostTag{} }
+func (m *MsgCreatePostTag) String() string { return proto.CompactTextString(m) }
+func (*MsgCreatePostTag) ProtoMessage()    {}
+func (*MsgCreatePostTag) Descriptor() ([]byte, []int) {
+	return fileDescriptor_14bdbb892576efd6, []int{30}
+}
+func (m *MsgCreatePostTag) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreatePostTag) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreatePostTag.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreatePostTag) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreatePostTag.Merge(m, src)
+}
+func (m *MsgCreatePostTag) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreatePostTag) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreatePostTag.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreatePostTag proto.InternalMessageInfo
+
+func (m *MsgCreatePostTag) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgCreatePostTag) GetIndex() string {
+	if m != nil {
+		return m.Index
+	}
+	return ""
+}
+
+func (m *MsgCreatePostTag) GetPostIndex() string {
+	if m != nil {
+		return m.PostIndex
+	}
+	return ""
+}
+
+func (m *MsgCreatePostTag) GetTag() string {
+	if m != nil {
+		return m.Tag
+	}
+	return ""
+}
+
+func (m *MsgCreatePostTag) GetCategory() string {
+	if m != nil {
+		return m.Category
+	}
+	return ""
+}
+
+func (m *MsgCreatePostTag) GetSimilarityScore() int64 {
+	if m != nil {
+		return m.SimilarityScore
+	}
+	return 0
+}
+
+func (m *MsgCreatePostTag) GetRelatedPosts() string {
+	if m != nil {
+		return m.RelatedPosts
+	}
+	return ""
+}
+
+// MsgCreatePostTagResponse defines the MsgCreatePostTagResponse message.
+type MsgCreatePostTagResponse struct {
+}
+
+func (m *MsgCreatePostTagResponse) Reset()         { *m = MsgCreatePostTagResponse{} }
+func (m *MsgCreatePostTagResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCreatePostTagResponse) ProtoMessage()    {}
+func (*MsgCreatePostTagResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_14bdbb892576efd6, []int{31}
+}
+func (m *MsgCreatePostTagResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreatePostTagResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreatePostTagResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreatePostTagResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreatePostTagResponse.Merge(m, src)
+}
+func (m *MsgCreatePostTagResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreatePostTagResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreatePostTagResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreatePostTagResponse proto.InternalMessageInfo
+
+// MsgUpdatePostTag defines the MsgUpdatePostTag message.
+type MsgUpdatePostTag struct {
+	Creator         string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Index           string `protobuf:"bytes,2,opt,name=index,proto3" json:"index,omitempty"`
+	PostIndex       string `protobuf:"bytes,3,opt,name=post_index,json=postIndex,proto3" json:"post_index,omitempty"`
+	Tag             string `protobuf:"bytes,4,opt,name=tag,proto3" json:"tag,omitempty"`
+	Category        string `protobuf:"bytes,5,opt,name=category,proto3" json:"category,omitempty"`
+	SimilarityScore int64  `protobuf:"varint,6,opt,name=similarity_score,json=similarityScore,proto3" json:"similarity_score,omitempty"`
+	RelatedPosts    string `protobuf:"bytes,7,opt,name=related_posts,json=relatedPosts,proto3" json:"related_posts,omitempty"`
+}
+
+func (m *MsgUpdatePostTag) Reset()         { *m = MsgUpdatePostTag{} }
+func (m *MsgUpdatePostTag) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdatePostTag) ProtoMessage()    {}
+func (*MsgUpdatePostTag) Descriptor() ([]byte, []int) {
+	return fileDescriptor_14bdbb892576efd6, []int{32}
+}
+func (m *MsgUpdatePostTag) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdatePostTag) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdatePostTag.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdatePostTag) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdatePostTag.Merge(m, src)
+}
+func (m *MsgUpdatePostTag) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdatePostTag) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdatePostTag.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdatePostTag proto.InternalMessageInfo
 
 func (m *MsgUpdatePostTag) GetCreator() string {
 	if m != nil {
@@ -1689,7 +2080,7 @@ func (m *MsgUpdatePostTagResponse) Reset()         { *m = MsgUpdatePostTagRespon
 func (m *MsgUpdatePostTagResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdatePostTagResponse) ProtoMessage()    {}
 func (*MsgUpdatePostTagResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_14bdbb892576efd6, []int{27}
+	return fileDescriptor_14bdbb892576efd6, []int{33}
 }
 func (m *MsgUpdatePostTagResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1728,7 +2119,7 @@ func (m *MsgDeletePostTag) Reset()         { *m = MsgDeletePostTag{} }
 func (m *MsgDeletePostTag) String() string { return proto.CompactTextString(m) }
 func (*MsgDeletePostTag) ProtoMessage()    {}
 func (*MsgDeletePostTag) Descriptor() ([]byte, []int) {
-	return fileDescriptor_14bdbb892576efd6, []int{28}
+	return fileDescriptor_14bdbb892576efd6, []int{34}
 }
 func (m *MsgDeletePostTag) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1779,7 +2170,7 @@ func (m *MsgDeletePostTagResponse) Reset()         { *m = MsgDeletePostTagRespon
 func (m *MsgDeletePostTagResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgDeletePostTagResponse) ProtoMessage()    {}
 func (*MsgDeletePostTagResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_14bdbb892576efd6, []int{29}
+	return fileDescriptor_14bdbb892576efd6, []int{35}
 }
 func (m *MsgDeletePostTagResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1823,7 +2214,7 @@ func (m *MsgDistributeContent) Reset()         { *m = MsgDistributeContent{} }
 func (m *MsgDistributeContent) String() string { return proto.CompactTextString(m) }
 func (*MsgDistributeContent) ProtoMessage()    {}
 func (*MsgDistributeContent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_14bdbb892576efd6, []int{30}
+	return fileDescriptor_14bdbb892576efd6, []int{36}
 }
 func (m *MsgDistributeContent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1912,7 +2303,7 @@ func (m *MsgDistributeContentResponse) Reset()         { *m = MsgDistributeConte
 func (m *MsgDistributeContentResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgDistributeContentResponse) ProtoMessage()    {}
 func (*MsgDistributeContentResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_14bdbb892576efd6, []int{31}
+	return fileDescriptor_14bdbb892576efd6, []int{37}
 }
 func (m *MsgDistributeContentResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1976,7 +2367,7 @@ func (m *MsgSyncHubContent) Reset()         { *m = MsgSyncHubContent{} }
 func (m *MsgSyncHubContent) String() string { return proto.CompactTextString(m) }
 func (*MsgSyncHubContent) ProtoMessage()    {}
 func (*MsgSyncHubContent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_14bdbb892576efd6, []int{32}
+	return fileDescriptor_14bdbb892576efd6, []int{38}
 }
 func (m *MsgSyncHubContent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2058,7 +2449,7 @@ func (m *MsgSyncHubContentResponse) Reset()         { *m = MsgSyncHubContentResp
 func (m *MsgSyncHubContentResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgSyncHubContentResponse) ProtoMessage()    {}
 func (*MsgSyncHubContentResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_14bdbb892576efd6, []int{33}
+	return fileDescriptor_14bdbb892576efd6, []int{39}
 }
 func (m *MsgSyncHubContentResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2116,13 +2507,15 @@ type MsgSendSignalMessage struct {
 	EncryptedPayload []byte `protobuf:"bytes,4,opt,name=encrypted_payload,json=encryptedPayload,proto3" json:"encrypted_payload,omitempty"`
 	MessageType      string `protobuf:"bytes,5,opt,name=message_type,json=messageType,proto3" json:"message_type,omitempty"`
 	Signature        string `protobuf:"bytes,6,opt,name=signature,proto3" json:"signature,omitempty"`
+	// sender_node is the node sending the message, owned by the creator.
+	SenderNode string `protobuf:"bytes,7,opt,name=sender_node,json=senderNode,proto3" json:"sender_node,omitempty"`
 }
 
 func (m *MsgSendSignalMessage) Reset()         { *m = MsgSendSignalMessage{} }
 func (m *MsgSendSignalMessage) String() string { return proto.CompactTextString(m) }
 func (*MsgSendSignalMessage) ProtoMessage()    {}
 func (*MsgSendSignalMessage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_14bdbb892576efd6, []int{34}
+	return fileDescriptor_14bdbb892576efd6, []int{40}
 }
 func (m *MsgSendSignalMessage) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2193,17 +2586,26 @@ func (m *MsgSendSignalMessage) GetSignature() string {
 	return ""
 }
 
+func (m *MsgSendSignalMessage) GetSenderNode() string {
+	if m != nil {
+		return m.SenderNode
+	}
+	return ""
+}
+
 // MsgSendSignalMessageResponse defines the response.
 type MsgSendSignalMessageResponse struct {
-	MessageId         string `protobuf:"bytes,1,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
-	DeliveryConfirmed bool   `protobuf:"varint,2,opt,name=delivery_confirmed,json=deliveryConfirmed,proto3" json:"delivery_confirmed,omitempty"`
+	MessageId string `protobuf:"bytes,1,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	// delivery_confirmed is set once the recipient acknowledged the message,
+	// queued messages are confirmed with MsgAckMessages.
+	DeliveryConfirmed bool `protobuf:"varint,2,opt,name=delivery_confirmed,json=deliveryConfirmed,proto3" json:"delivery_confirmed,omitempty"`
 }
 
 func (m *MsgSendSignalMessageResponse) Reset()         { *m = MsgSendSignalMessageResponse{} }
 func (m *MsgSendSignalMessageResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgSendSignalMessageResponse) ProtoMessage()    {}
 func (*MsgSendSignalMessageResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_14bdbb892576efd6, []int{35}
+	return fileDescriptor_14bdbb892576efd6, []int{41}
 }
 func (m *MsgSendSignalMessageResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2246,2080 +2648,2320 @@ func (m *MsgSendSignalMessageResponse) GetDeliveryConfirmed() bool {
 	return false
 }
 
-func init() {
-	proto.RegisterType((*MsgUpdateParams)(nil), "resist.posts.v1.MsgUpdateParams")
-	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "resist.posts.v1.MsgUpdateParamsResponse")
-	proto.RegisterType((*MsgCreatePost)(nil), "resist.posts.v1.MsgCreatePost")
-	proto.RegisterType((*MsgCreatePostResponse)(nil), "resist.posts.v1.MsgCreatePostResponse")
-	proto.RegisterType((*MsgVotePost)(nil), "resist.posts.v1.MsgVotePost")
-	proto.RegisterType((*MsgVotePostResponse)(nil), "resist.posts.v1.MsgVotePostResponse")
-	proto.RegisterType((*MsgCreateSocialPost)(nil), "resist.posts.v1.MsgCreateSocialPost")
-	proto.RegisterType((*MsgCreateSocialPostResponse)(nil), "resist.posts.v1.MsgCreateSocialPostResponse")
-	proto.RegisterType((*MsgUpdateSocialPost)(nil), "resist.posts.v1.MsgUpdateSocialPost")
-	proto.RegisterType((*MsgUpdateSocialPostResponse)(nil), "resist.posts.v1.MsgUpdateSocialPostResponse")
-	proto.RegisterType((*MsgDeleteSocialPost)(nil), "resist.posts.v1.MsgDeleteSocialPost")
-	proto.RegisterType((*MsgDeleteSocialPostResponse)(nil), "resist.posts.v1.MsgDeleteSocialPostResponse")
-	proto.RegisterType((*MsgCreateVote)(nil), "resist.posts.v1.MsgCreateVote")
-	proto.RegisterType((*MsgCreateVoteResponse)(nil), "resist.posts.v1.MsgCreateVoteResponse")
-	proto.RegisterType((*MsgUpdateVote)(nil), "resist.posts.v1.MsgUpdateVote")
-	proto.RegisterType((*MsgUpdateVoteResponse)(nil), "resist.posts.v1.MsgUpdateVoteResponse")
-	proto.RegisterType((*MsgDeleteVote)(nil), "resist.posts.v1.MsgDeleteVote")
-	proto.RegisterType((*MsgDeleteVoteResponse)(nil), "resist.posts.v1.MsgDeleteVoteResponse")
-	proto.RegisterType((*MsgCreateSource)(nil), "resist.posts.v1.MsgCreateSource")
-	proto.RegisterType((*MsgCreateSourceResponse)(nil), "resist.posts.v1.MsgCreateSourceResponse")
-	proto.RegisterType((*MsgUpdateSource)(nil), "resist.posts.v1.MsgUpdateSource")
-	proto.RegisterType((*MsgUpdateSourceResponse)(nil), "resist.posts.v1.MsgUpdateSourceResponse")
-	proto.RegisterType((*MsgDeleteSource)(nil), "resist.posts.v1.MsgDeleteSource")
-	proto.RegisterType((*MsgDeleteSourceResponse)(nil), "resist.posts.v1.MsgDeleteSourceResponse")
-	proto.RegisterType((*MsgCreatePostTag)(nil), "resist.posts.v1.MsgCreatePostTag")
-	proto.RegisterType((*MsgCreatePostTagResponse)(nil), "resist.posts.v1.MsgCreatePostTagResponse")
-	proto.RegisterType((*MsgUpdatePostTag)(nil), "resist.posts.v1.MsgUpdatePostTag")
-	proto.RegisterType((*MsgUpdatePostTagResponse)(nil), "resist.posts.v1.MsgUpdatePostTagResponse")
-	proto.RegisterType((*MsgDeletePostTag)(nil), "resist.posts.v1.MsgDeletePostTag")
-	proto.RegisterType((*MsgDeletePostTagResponse)(nil), "resist.posts.v1.MsgDeletePostTagResponse")
-	proto.RegisterType((*MsgDistributeContent)(nil), "resist.posts.v1.MsgDistributeContent")
-	proto.RegisterType((*MsgDistributeContentResponse)(nil), "resist.posts.v1.MsgDistributeContentResponse")
-	proto.RegisterType((*MsgSyncHubContent)(nil), "resist.posts.v1.MsgSyncHubContent")
-	proto.RegisterType((*MsgSyncHubContentResponse)(nil), "resist.posts.v1.MsgSyncHubContentResponse")
-	proto.RegisterType((*MsgSendSignalMessage)(nil), "resist.posts.v1.MsgSendSignalMessage")
-	proto.RegisterType((*MsgSendSignalMessageResponse)(nil), "resist.posts.v1.MsgSendSignalMessageResponse")
+// MsgAckMessages removes delivered messages from the mailbox of a node.
+type MsgAckMessages struct {
+	Creator    string   `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	NodeId     string   `protobuf:"bytes,2,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	MessageIds []string `protobuf:"bytes,3,rep,name=message_ids,json=messageIds,proto3" json:"message_ids,omitempty"`
 }
 
-func init() { proto.RegisterFile("resist/posts/v1/tx.proto", fileDescriptor_14bdbb892576efd6) }
-
-var fileDescriptor_14bdbb892576efd6 = []byte{
-	// 1702 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x59, 0x4b, 0x4f, 0x1c, 0xc7,
-	0x16, 0x66, 0x98, 0x01, 0x66, 0x0e, 0x0c, 0x8f, 0x36, 0xbe, 0x0c, 0x63, 0x3c, 0x06, 0x6c, 0xdf,
-	0x8b, 0xb9, 0xd7, 0x20, 0x7c, 0xa5, 0x2c, 0xac, 0x6c, 0x8c, 0xbd, 0x30, 0x8b, 0xb1, 0xac, 0x1e,
-	0x9c, 0x85, 0xa5, 0xa8, 0x53, 0x4c, 0x17, 0x4d, 0x45, 0xfd, 0x52, 0x55, 0x0d, 0xf1, 0xec, 0xa2,
-	0x6c, 0xa2, 0xc4, 0x5e, 0xe4, 0x5f, 0x24, 0x4b, 0x22, 0x65, 0x91, 0x9f, 0xe0, 0xa5, 0x95, 0x95,
-	0x37, 0x89, 0x22, 0x23, 0x85, 0xbf, 0x11, 0xd5, 0xa3, 0x1f, 0xd3, 0xd3, 0xd3, 0x58, 0x36, 0xde,
-	0x44, 0xde, 0xa0, 0xa9, 0xef, 0x9c, 0xae, 0x3a, 0xe7, 0xfb, 0xaa, 0x4e, 0xd7, 0x69, 0xa0, 0x41,
-	0x31, 0x23, 0x8c, 0x6f, 0x87, 0x01, 0xe3, 0x6c, 0xfb, 0x78, 0x67, 0x9b, 0x3f, 0xdb, 0x0a, 0x69,
-	0xc0, 0x03, 0x63, 0x4e, 0x59, 0xb6, 0xa4, 0x65, 0xeb, 0x78, 0xa7, 0xb9, 0x80, 0x3c, 0xe2, 0x07,
-	0xdb, 0xf2, 0xaf, 0xf2, 0x69, 0x2e, 0x75, 0x03, 0xe6, 0x05, 0x6c, 0xdb, 0x63, 0x8e, 0x78, 0xd6,
-	0x63, 0x8e, 0x36, 0x2c, 0x2b, 0x83, 0x25, 0x47, 0xdb, 0x6a, 0xa0, 0x4d, 0x8b, 0x4e, 0xe0, 0x04,
-	0x0a, 0x17, 0xbf, 0x34, 0xba, 0x92, 0x8d, 0x23, 0x44, 0x14, 0x79, 0xd1, 0x33, 0x9b, 0x59, 0x6b,
-	0x37, 0xf0, 0x39, 0xf6, 0xb9, 0x65, 0x13, 0xc6, 0x29, 0x39, 0xe8, 0x71, 0x12, 0xf8, 0xca, 0x77,
-	0xfd, 0xd7, 0x12, 0xcc, 0xb5, 0x99, 0xf3, 0x24, 0xb4, 0x11, 0xc7, 0x8f, 0xe5, 0x2c, 0xc6, 0x27,
-	0x50, 0x43, 0x3d, 0x7e, 0x14, 0x50, 0xc2, 0xfb, 0x8d, 0xd2, 0x6a, 0x69, 0xa3, 0xb6, 0xdb, 0xf8,
-	0xed, 0x97, 0xdb, 0x8b, 0x3a, 0xb0, 0x7b, 0xb6, 0x4d, 0x31, 0x63, 0x1d, 0x4e, 0x89, 0xef, 0x98,
-	0x89, 0xab, 0x71, 0x17, 0x26, 0x55, 0x1c, 0x8d, 0xf1, 0xd5, 0xd2, 0xc6, 0xf4, 0x9d, 0xa5, 0xad,
-	0x0c, 0x29, 0x5b, 0x6a, 0x81, 0xdd, 0xda, 0xcb, 0x3f, 0xae, 0x8d, 0xfd, 0x74, 0x76, 0xb2, 0x59,
-	0x32, 0xf5, 0x13, 0x77, 0x77, 0xbe, 0x39, 0x3b, 0xd9, 0x4c, 0xe6, 0xfa, 0xfe, 0xec, 0x64, 0xb3,
-	0xa5, 0xd3, 0x78, 0xa6, 0x13, 0xc9, 0x84, 0xb9, 0xbe, 0x0c, 0x4b, 0x19, 0xc8, 0xc4, 0x2c, 0x0c,
-	0x7c, 0x86, 0xd7, 0x5f, 0x97, 0xa0, 0xde, 0x66, 0xce, 0x7d, 0x8a, 0x85, 0x2d, 0x60, 0xdc, 0xb8,
-	0x03, 0x53, 0x5d, 0x31, 0x0a, 0xe8, 0xb9, 0x19, 0x45, 0x8e, 0xc6, 0x22, 0x4c, 0x70, 0xc2, 0x5d,
-	0x2c, 0xd3, 0xa9, 0x99, 0x6a, 0x60, 0x34, 0x60, 0x4a, 0xf3, 0xd9, 0x28, 0x4b, 0x3c, 0x1a, 0x1a,
-	0x57, 0xa0, 0xe6, 0x61, 0x9b, 0x20, 0xab, 0x47, 0xdd, 0x46, 0x45, 0xda, 0xaa, 0x12, 0x78, 0x42,
-	0x5d, 0xe3, 0x2a, 0x80, 0x32, 0xf2, 0x7e, 0x88, 0x1b, 0x13, 0xd2, 0xaa, 0xdc, 0xf7, 0xfb, 0x21,
-	0x36, 0x96, 0xa1, 0xea, 0xd0, 0xa0, 0x17, 0x5a, 0xc4, 0x6e, 0x4c, 0xae, 0x96, 0x36, 0x2a, 0xe6,
-	0x94, 0x1c, 0xef, 0xd9, 0x77, 0x67, 0x04, 0x35, 0x51, 0x50, 0xeb, 0x4b, 0x70, 0x79, 0x20, 0xb3,
-	0x38, 0xe7, 0xe7, 0x25, 0x98, 0x6e, 0x33, 0xe7, 0xb3, 0xe0, 0x3d, 0x32, 0xbe, 0x0a, 0x20, 0xb8,
-	0xb6, 0x88, 0x6f, 0xe3, 0x67, 0x3a, 0xed, 0x9a, 0x40, 0xf6, 0x04, 0x20, 0x12, 0x3c, 0x0e, 0x38,
-	0x56, 0x29, 0xa8, 0xe4, 0xab, 0x02, 0x10, 0x19, 0x64, 0xc2, 0xbc, 0x0c, 0x97, 0x52, 0xc1, 0xc4,
-	0x41, 0x9e, 0x8e, 0x4b, 0x5c, 0x85, 0xdf, 0x09, 0xba, 0x04, 0xb9, 0xef, 0x23, 0x4f, 0x3a, 0x4e,
-	0x35, 0x48, 0x44, 0x2b, 0x8f, 0x10, 0xad, 0x52, 0x20, 0xda, 0x44, 0xa1, 0x68, 0x93, 0x45, 0xa2,
-	0x4d, 0x0d, 0x88, 0x66, 0xfc, 0x0b, 0x26, 0xd5, 0x66, 0x6e, 0x54, 0xe5, 0x53, 0x7a, 0x24, 0x02,
-	0xe9, 0x85, 0x82, 0x33, 0xd6, 0xa8, 0xa9, 0x27, 0xf4, 0xd0, 0x58, 0x81, 0x9a, 0x1d, 0x7c, 0xe5,
-	0x2b, 0x1b, 0x48, 0x5b, 0x02, 0x88, 0x48, 0x64, 0xde, 0xd8, 0xb6, 0x10, 0x6f, 0x4c, 0x2b, 0xb3,
-	0x46, 0xee, 0xf1, 0x0c, 0xf9, 0x57, 0xe1, 0x4a, 0x0e, 0xc9, 0x59, 0x11, 0xd4, 0xc9, 0xf9, 0x28,
-	0xc2, 0x07, 0x15, 0x21, 0x4b, 0x72, 0x2c, 0x82, 0x27, 0x35, 0x78, 0x80, 0x5d, 0xfc, 0x61, 0x34,
-	0xc8, 0x8d, 0x26, 0xbb, 0x5c, 0x1c, 0xcd, 0x5f, 0xe9, 0x82, 0x29, 0x4e, 0xed, 0x05, 0x6e, 0x86,
-	0xeb, 0x50, 0x17, 0xf4, 0x51, 0x0b, 0xa9, 0xa7, 0xf4, 0xa6, 0x98, 0x91, 0xa0, 0x9e, 0x29, 0x53,
-	0x79, 0x2a, 0x85, 0x95, 0x67, 0x62, 0xb0, 0xf2, 0x08, 0xd1, 0x38, 0xf1, 0x30, 0xe3, 0xc8, 0x0b,
-	0xe5, 0xfe, 0x28, 0x9b, 0x09, 0x50, 0x50, 0x3e, 0x45, 0x9e, 0x59, 0x06, 0x94, 0x5e, 0xff, 0x7c,
-	0x06, 0x92, 0x3c, 0x63, 0x06, 0x1c, 0x49, 0x80, 0xda, 0x22, 0x17, 0x4b, 0x40, 0x6e, 0x04, 0xc9,
-	0x42, 0x71, 0x04, 0x3f, 0x8e, 0xcb, 0xcb, 0x48, 0x54, 0xb8, 0x7a, 0xb4, 0x7b, 0x91, 0x2a, 0xcc,
-	0x43, 0x59, 0x94, 0x17, 0xc5, 0xbd, 0xf8, 0x99, 0x94, 0xa9, 0x4a, 0xba, 0x4c, 0xad, 0xc2, 0xb4,
-	0x8d, 0x59, 0x97, 0x92, 0x50, 0xdc, 0x93, 0x34, 0xd7, 0x69, 0xc8, 0xf8, 0x2f, 0x2c, 0x74, 0x29,
-	0xb6, 0xc9, 0x01, 0x71, 0x09, 0xef, 0x5b, 0xac, 0x1b, 0x50, 0xac, 0x69, 0x9f, 0x4f, 0x19, 0x3a,
-	0x02, 0x37, 0x6e, 0xc1, 0x3c, 0xf2, 0x91, 0xdb, 0x67, 0x84, 0x59, 0xac, 0xe7, 0x79, 0x88, 0xf6,
-	0x65, 0x9d, 0xaa, 0x99, 0x73, 0x11, 0xde, 0x51, 0xb0, 0xd1, 0x84, 0xea, 0x31, 0xa6, 0xe4, 0x90,
-	0x60, 0x5b, 0x56, 0xac, 0xaa, 0x19, 0x8f, 0x33, 0x14, 0xaa, 0xbb, 0x4f, 0x9a, 0xa8, 0x2c, 0x89,
-	0x51, 0xe1, 0xf9, 0x48, 0xe2, 0x39, 0x24, 0xa6, 0x89, 0x8a, 0x49, 0x24, 0x92, 0xc3, 0xa8, 0x5c,
-	0x5e, 0x2c, 0x87, 0xb9, 0x51, 0xa4, 0x97, 0x8a, 0xa3, 0xf8, 0x76, 0x1c, 0xe6, 0x07, 0x2e, 0x7b,
-	0xfb, 0xc8, 0xb9, 0x40, 0x2d, 0x07, 0x2b, 0x4e, 0x39, 0x5b, 0x71, 0xe6, 0xa1, 0xcc, 0x91, 0xa3,
-	0x65, 0x15, 0x3f, 0x05, 0xb5, 0x5d, 0xc4, 0xb1, 0x13, 0xd0, 0x7e, 0x54, 0x82, 0xa2, 0xb1, 0x50,
-	0x88, 0x11, 0x8f, 0xb8, 0x88, 0x66, 0xd5, 0x9c, 0x4b, 0x70, 0x25, 0xe6, 0x75, 0xa8, 0x53, 0xec,
-	0xca, 0xd7, 0xa8, 0xbc, 0xd9, 0x6b, 0x25, 0x67, 0x34, 0x28, 0x12, 0x65, 0x19, 0x92, 0x9a, 0xd0,
-	0xc8, 0x12, 0x91, 0x65, 0x49, 0x37, 0x02, 0x1f, 0x59, 0x1a, 0x20, 0x22, 0x66, 0xe9, 0x4b, 0x49,
-	0x92, 0xda, 0x66, 0x17, 0x4e, 0x52, 0x6e, 0x1c, 0x03, 0x6b, 0xc5, 0x71, 0xfc, 0x3e, 0x0e, 0x8b,
-	0xc2, 0x18, 0xb5, 0xa2, 0xf8, 0xbe, 0xbe, 0x03, 0xbe, 0x63, 0xbf, 0x12, 0xf5, 0xb6, 0xc4, 0x8e,
-	0xfa, 0x15, 0x8d, 0xec, 0xd9, 0xc6, 0x1a, 0xcc, 0xc4, 0xad, 0x2f, 0xe2, 0x48, 0x8a, 0x37, 0x63,
-	0x4e, 0x6b, 0xec, 0x01, 0xe2, 0xc8, 0xf8, 0x14, 0xaa, 0x1e, 0xe6, 0x48, 0x9a, 0x2b, 0xb2, 0x6b,
-	0x5d, 0x1d, 0xea, 0x5a, 0x75, 0x84, 0x6d, 0xed, 0x67, 0xc6, 0x4f, 0x18, 0xff, 0x81, 0x39, 0x8e,
-	0xa8, 0x83, 0xb9, 0x45, 0x71, 0xe8, 0x92, 0x2e, 0x62, 0x52, 0xf1, 0xba, 0x39, 0xab, 0x60, 0x53,
-	0xa3, 0xc6, 0x0e, 0x2c, 0x6a, 0x0f, 0x51, 0xfb, 0x2c, 0xc6, 0xa9, 0xd8, 0x11, 0x7d, 0x7d, 0x9b,
-	0xbd, 0x94, 0xb2, 0x75, 0xb4, 0x49, 0xcc, 0x1d, 0x52, 0x7c, 0x88, 0x29, 0xc5, 0xb6, 0xe5, 0x07,
-	0x36, 0x16, 0x3b, 0xa0, 0xbc, 0x51, 0x33, 0x67, 0x63, 0xf8, 0x91, 0x40, 0x33, 0xdc, 0x3f, 0x2f,
-	0xc1, 0x4a, 0x1e, 0xbf, 0x91, 0x00, 0xe2, 0x22, 0x41, 0xc2, 0x43, 0x66, 0x1d, 0x21, 0x76, 0xa4,
-	0x98, 0x36, 0xab, 0x02, 0x78, 0x88, 0xd8, 0x91, 0x71, 0x13, 0x66, 0x11, 0x63, 0xc4, 0xf1, 0xe3,
-	0x35, 0xc7, 0xe5, 0x9a, 0xf5, 0x08, 0x95, 0x4b, 0x8a, 0xd8, 0xd2, 0xdf, 0x12, 0x04, 0xf9, 0xea,
-	0x60, 0xcc, 0xa6, 0xe1, 0x3d, 0x7b, 0xfd, 0xbb, 0x71, 0x58, 0x68, 0x33, 0xa7, 0xd3, 0xf7, 0xbb,
-	0x0f, 0x7b, 0x07, 0xef, 0x23, 0xf5, 0x35, 0x98, 0x66, 0xb2, 0x3a, 0xca, 0xb8, 0xb4, 0xd6, 0xa0,
-	0x20, 0x11, 0x94, 0x70, 0xd0, 0x5a, 0x48, 0x07, 0x15, 0x0f, 0x28, 0x28, 0x72, 0x48, 0x36, 0x0b,
-	0x6b, 0x54, 0x64, 0x62, 0x10, 0xef, 0x16, 0x26, 0x97, 0xe8, 0xfb, 0x5d, 0xcb, 0xc3, 0xfc, 0x28,
-	0xb0, 0xf5, 0xd9, 0x05, 0x01, 0xb5, 0x25, 0x62, 0x6c, 0xc1, 0x25, 0x17, 0x31, 0x6e, 0x49, 0xaf,
-	0xec, 0x85, 0x6b, 0x41, 0x98, 0x44, 0xa2, 0xfb, 0x23, 0x2e, 0x5e, 0x2f, 0x4a, 0xb0, 0x3c, 0xc4,
-	0x45, 0x2c, 0xcb, 0x12, 0x4c, 0xc9, 0x69, 0x89, 0xad, 0x45, 0x99, 0x14, 0xc3, 0x3d, 0x5b, 0x70,
-	0x8d, 0x19, 0x27, 0x9e, 0xac, 0x04, 0x07, 0x7d, 0x8e, 0xd5, 0xe7, 0x95, 0x8a, 0x39, 0x1b, 0xc3,
-	0xbb, 0x02, 0x35, 0x6e, 0x83, 0x91, 0x38, 0xda, 0x3d, 0x2a, 0xb7, 0x93, 0xe4, 0xa1, 0x6c, 0x2e,
-	0xc4, 0x96, 0x07, 0xda, 0xb0, 0xfe, 0x42, 0x1d, 0xc4, 0x0e, 0xf6, 0xed, 0x0e, 0x71, 0x7c, 0xe4,
-	0xb6, 0x31, 0x63, 0xc8, 0x79, 0xb7, 0x17, 0xdd, 0x4d, 0x98, 0xa5, 0xb8, 0x4b, 0x42, 0x22, 0xd8,
-	0x4d, 0x09, 0x54, 0x8f, 0x51, 0x29, 0x81, 0x38, 0xaf, 0x47, 0xc8, 0xf7, 0xb1, 0x9b, 0x6c, 0x99,
-	0x9a, 0x46, 0xf6, 0x6c, 0x71, 0x25, 0xc0, 0x7e, 0x97, 0xf6, 0x43, 0x59, 0xf4, 0x50, 0xdf, 0x0d,
-	0x90, 0x2d, 0x4f, 0xe5, 0x8c, 0x39, 0x1f, 0x1b, 0x1e, 0x2b, 0x5c, 0x1c, 0x6e, 0x4f, 0x45, 0x9c,
-	0xbe, 0x13, 0x4f, 0x6b, 0x2c, 0xba, 0x16, 0x8b, 0x5d, 0x8b, 0x78, 0x8f, 0xc6, 0x8d, 0x63, 0x0c,
-	0x64, 0xd4, 0x71, 0xe5, 0xb1, 0x19, 0x62, 0x23, 0xd6, 0x47, 0x76, 0xa1, 0x6a, 0xb9, 0x58, 0xa2,
-	0x9a, 0x46, 0xf6, 0x6c, 0x41, 0xbe, 0x8d, 0x5d, 0x72, 0x8c, 0x69, 0xdf, 0xea, 0x06, 0xfe, 0x21,
-	0xa1, 0x1e, 0x56, 0x15, 0xa9, 0x6a, 0x2e, 0x44, 0x96, 0xfb, 0x91, 0xe1, 0xce, 0xcf, 0x75, 0x28,
-	0xb7, 0x99, 0x63, 0x3c, 0x85, 0x99, 0x81, 0x4f, 0x6f, 0xc3, 0xc5, 0x27, 0xf3, 0x89, 0xab, 0xb9,
-	0x71, 0x9e, 0x47, 0x1c, 0xf1, 0x3e, 0x40, 0xea, 0x03, 0x58, 0x2b, 0xef, 0xb9, 0xc4, 0xde, 0xfc,
-	0x77, 0xb1, 0x3d, 0x9e, 0xf5, 0x11, 0x54, 0xe3, 0x4f, 0x4c, 0x2b, 0x79, 0xcf, 0x44, 0xd6, 0xe6,
-	0x8d, 0x22, 0x6b, 0x3c, 0xdf, 0x21, 0xcc, 0x0f, 0x7d, 0x0d, 0xba, 0x31, 0x3a, 0x96, 0xc4, 0xab,
-	0xf9, 0xbf, 0xb7, 0xf1, 0x4a, 0xaf, 0x33, 0xf4, 0xc1, 0xe3, 0xc6, 0x68, 0x2e, 0xcf, 0x5b, 0x67,
-	0x54, 0x5f, 0x2f, 0xd6, 0x19, 0x6a, 0xea, 0x73, 0xd7, 0xc9, 0x7a, 0xe5, 0xaf, 0x33, 0xaa, 0x63,
-	0x4f, 0xd4, 0x95, 0xad, 0x5a, 0x81, 0xba, 0xc2, 0x5e, 0xa4, 0x6e, 0xba, 0x03, 0x13, 0xb3, 0xa6,
-	0x3a, 0xe0, 0xd6, 0xe8, 0xcc, 0x47, 0xcf, 0x3a, 0xdc, 0x59, 0x8a, 0x59, 0x53, 0x6d, 0x65, 0x6b,
-	0x74, 0x9e, 0xa3, 0x67, 0x1d, 0xee, 0x16, 0xc5, 0xd9, 0x19, 0xe8, 0x14, 0x57, 0x8b, 0xf6, 0x83,
-	0xf0, 0xc8, 0x3f, 0x3b, 0x79, 0x4d, 0x54, 0x72, 0x2e, 0x8b, 0xe6, 0x4e, 0x7b, 0x14, 0x9d, 0xcb,
-	0xe1, 0xb9, 0x07, 0x1a, 0x8b, 0xd5, 0x22, 0xdd, 0x47, 0xcf, 0x9d, 0xd7, 0x31, 0x18, 0x9f, 0x43,
-	0x7d, 0xb0, 0x5b, 0x58, 0x2b, 0x3e, 0xd6, 0xfb, 0xc8, 0x69, 0xde, 0x3a, 0xd7, 0x25, 0x3d, 0xfd,
-	0xe0, 0x35, 0x7b, 0xad, 0xa0, 0x1a, 0x15, 0x4d, 0x9f, 0x7b, 0x47, 0x15, 0xd3, 0x0f, 0x5e, 0x50,
-	0xd7, 0x46, 0x27, 0x5e, 0x38, 0x7d, 0xee, 0xd5, 0xd3, 0x20, 0xb0, 0x30, 0x7c, 0xed, 0xbc, 0x99,
-	0xfb, 0x7c, 0xd6, 0xad, 0x79, 0xfb, 0xad, 0xdc, 0xe2, 0xa5, 0xbe, 0x80, 0xd9, 0xcc, 0x9d, 0x67,
-	0x3d, 0x6f, 0x82, 0x41, 0x9f, 0xe6, 0xe6, 0xf9, 0x3e, 0xe9, 0x64, 0x86, 0x5f, 0xdd, 0xb9, 0xc9,
-	0x0c, 0xb9, 0xe5, 0x27, 0x33, 0xf2, 0xd5, 0xd7, 0x9c, 0xf8, 0xfa, 0xec, 0x64, 0xb3, 0xb4, 0xbb,
-	0xf5, 0xf2, 0x4d, 0xab, 0xf4, 0xea, 0x4d, 0xab, 0xf4, 0xe7, 0x9b, 0x56, 0xe9, 0x87, 0xd3, 0xd6,
-	0xd8, 0xab, 0xd3, 0xd6, 0xd8, 0xeb, 0xd3, 0xd6, 0xd8, 0xd3, 0xc5, 0xcc, 0x7f, 0x6a, 0xc4, 0xfb,
-	0x98, 0x1d, 0x4c, 0xca, 0xff, 0x30, 0xfd, 0xff, 0xef, 0x00, 0x00, 0x00, 0xff, 0xff, 0x9a, 0x47,
-	0xd3, 0xed, 0x35, 0x1b, 0x00, 0x00,
+func (m *MsgAckMessages) Reset()         { *m = MsgAckMessages{} }
+func (m *MsgAckMessages) String() string { return proto.CompactTextString(m) }
+func (*MsgAckMessages) ProtoMessage()    {}
+func (*MsgAckMessages) Descriptor() ([]byte, []int) {
+	return fileDescriptor_14bdbb892576efd6, []int{42}
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// UpdateParams defines a (governance) operation for updating the module
-	// parameters. The authority defaults to the x/gov module account.
-	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
-	// CreatePost defines the CreatePost RPC.
-	CreatePost(ctx context.Context, in *MsgCreatePost, opts ...grpc.CallOption) (*MsgCreatePostResponse, error)
-	// VotePost defines the VotePost RPC.
-	VotePost(ctx context.Context, in *MsgVotePost, opts ...grpc.CallOption) (*MsgVotePostResponse, error)
-	// CreateSocialPost defines the CreateSocialPost RPC.
-	CreateSocialPost(ctx context.Context, in *MsgCreateSocialPost, opts ...grpc.CallOption) (*MsgCreateSocialPostResponse, error)
-	// UpdateSocialPost defines the UpdateSocialPost RPC.
-	UpdateSocialPost(ctx context.Context, in *MsgUpdateSocialPost, opts ...grpc.CallOption) (*MsgUpdateSocialPostResponse, error)
-	// DeleteSocialPost defines the DeleteSocialPost RPC.
-	DeleteSocialPost(ctx context.Context, in *MsgDeleteSocialPost, opts ...grpc.CallOption) (*MsgDeleteSocialPostResponse, error)
-	// CreateVote defines the CreateVote RPC.
-	CreateVote(ctx context.Context, in *MsgCreateVote, opts ...grpc.CallOption) (*MsgCreateVoteResponse, error)
-	// UpdateVote defines the UpdateVote RPC.
-	UpdateVote(ctx context.Context, in *MsgUpdateVote, opts ...grpc.CallOption) (*MsgUpdateVoteResponse, error)
-	// DeleteVote defines the DeleteVote RPC.
-	DeleteVote(ctx context.Context, in *MsgDeleteVote, opts ...grpc.CallOption) (*MsgDeleteVoteResponse, error)
-	// CreateSource defines the CreateSource RPC.
-	CreateSource(ctx context.Context, in *MsgCreateSource, opts ...grpc.CallOption) (*MsgCreateSourceResponse, error)
-	// UpdateSource defines the UpdateSource RPC.
-	UpdateSource(ctx context.Context, in *MsgUpdateSource, opts ...grpc.CallOption) (*MsgUpdateSourceResponse, error)
-	// DeleteSource defines the DeleteSource RPC.
-	DeleteSource(ctx context.Context, in *MsgDeleteSource, opts ...grpc.CallOption) (*MsgDeleteSourceResponse, error)
-	// CreatePostTag defines the CreatePostTag RPC.
-	CreatePostTag(ctx context.Context, in *MsgCreatePostTag, opts ...grpc.CallOption) (*MsgCreatePostTagResponse, error)
-	// UpdatePostTag defines the UpdatePostTag RPC.
-	UpdatePostTag(ctx context.Context, in *MsgUpdatePostTag, opts ...grpc.CallOption) (*MsgUpdatePostTagResponse, error)
-	// DeletePostTag defines the DeletePostTag RPC.
-	DeletePostTag(ctx context.Context, in *MsgDeletePostTag, opts ...grpc.CallOption) (*MsgDeletePostTagResponse, error)
-	// DistributeContent defines the DistributeContent RPC for IPFS and decentralized storage.
-	DistributeContent(ctx context.Context, in *MsgDistributeContent, opts ...grpc.CallOption) (*MsgDistributeContentResponse, error)
-	// SyncHubContent defines the SyncHubContent RPC for hub-to-hub synchronization.
-	SyncHubContent(ctx context.Context, in *MsgSyncHubContent, opts ...grpc.CallOption) (*MsgSyncHubContentResponse, error)
-	// SendSignalMessage defines the SendSignalMessage RPC for secure node communication.
-	SendSignalMessage(ctx context.Context, in *MsgSendSignalMessage, opts ...grpc.CallOption) (*MsgSendSignalMessageResponse, error)
+func (m *MsgAckMessages) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgAckMessages) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAckMessages.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgAckMessages) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAckMessages.Merge(m, src)
+}
+func (m *MsgAckMessages) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAckMessages) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAckMessages.DiscardUnknown(m)
 }
 
-func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
-	out := new(MsgUpdateParamsResponse)
-	err := c.cc.Invoke(ctx, "/resist.posts.v1.Msg/UpdateParams", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgAckMessages proto.InternalMessageInfo
+
+func (m *MsgAckMessages) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) CreatePost(ctx context.Context, in *MsgCreatePost, opts ...grpc.CallOption) (*MsgCreatePostResponse, error) {
-	out := new(MsgCreatePostResponse)
-	err := c.cc.Invoke(ctx, "/resist.posts.v1.Msg/CreatePost", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgAckMessages) GetNodeId() string {
+	if m != nil {
+		return m.NodeId
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) VotePost(ctx context.Context, in *MsgVotePost, opts ...grpc.CallOption) (*MsgVotePostResponse, error) {
-	out := new(MsgVotePostResponse)
-	err := c.cc.Invoke(ctx, "/resist.posts.v1.Msg/VotePost", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgAckMessages) GetMessageIds() []string {
+	if m != nil {
+		return m.MessageIds
 	}
-	return out, nil
+	return nil
 }
 
-func (c *msgClient) CreateSocialPost(ctx context.Context, in *MsgCreateSocialPost, opts ...grpc.CallOption) (*MsgCreateSocialPostResponse, error) {
-	out := new(MsgCreateSocialPostResponse)
-	err := c.cc.Invoke(ctx, "/resist.posts.v1.Msg/CreateSocialPost", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// MsgAckMessagesResponse defines the MsgAckMessagesResponse message.
+type MsgAckMessagesResponse struct {
+	// acked is the number of messages removed from the mailbox.
+	Acked uint64 `protobuf:"varint,1,opt,name=acked,proto3" json:"acked,omitempty"`
 }
 
-func (c *msgClient) UpdateSocialPost(ctx context.Context, in *MsgUpdateSocialPost, opts ...grpc.CallOption) (*MsgUpdateSocialPostResponse, error) {
-	out := new(MsgUpdateSocialPostResponse)
-	err := c.cc.Invoke(ctx, "/resist.posts.v1.Msg/UpdateSocialPost", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgAckMessagesResponse) Reset()         { *m = MsgAckMessagesResponse{} }
+func (m *MsgAckMessagesResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAckMessagesResponse) ProtoMessage()    {}
+func (*MsgAckMessagesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_14bdbb892576efd6, []int{43}
+}
+func (m *MsgAckMessagesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAckMessagesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAckMessagesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgAckMessagesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAckMessagesResponse.Merge(m, src)
+}
+func (m *MsgAckMessagesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAckMessagesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAckMessagesResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) DeleteSocialPost(ctx context.Context, in *MsgDeleteSocialPost, opts ...grpc.CallOption) (*MsgDeleteSocialPostResponse, error) {
-	out := new(MsgDeleteSocialPostResponse)
-	err := c.cc.Invoke(ctx, "/resist.posts.v1.Msg/DeleteSocialPost", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgAckMessagesResponse proto.InternalMessageInfo
+
+func (m *MsgAckMessagesResponse) GetAcked() uint64 {
+	if m != nil {
+		return m.Acked
 	}
-	return out, nil
+	return 0
 }
 
-func (c *msgClient) CreateVote(ctx context.Context, in *MsgCreateVote, opts ...grpc.CallOption) (*MsgCreateVoteResponse, error) {
-	out := new(MsgCreateVoteResponse)
-	err := c.cc.Invoke(ctx, "/resist.posts.v1.Msg/CreateVote", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// MsgAckReplica acknowledges that an assigned node stores a replica of some content.
+type MsgAckReplica struct {
+	Creator   string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	ContentId string `protobuf:"bytes,2,opt,name=content_id,json=contentId,proto3" json:"content_id,omitempty"`
+	NodeId    string `protobuf:"bytes,3,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	Cid       string `protobuf:"bytes,4,opt,name=cid,proto3" json:"cid,omitempty"`
 }
 
-func (c *msgClient) UpdateVote(ctx context.Context, in *MsgUpdateVote, opts ...grpc.CallOption) (*MsgUpdateVoteResponse, error) {
-	out := new(MsgUpdateVoteResponse)
-	err := c.cc.Invoke(ctx, "/resist.posts.v1.Msg/UpdateVote", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgAckReplica) Reset()         { *m = MsgAckReplica{} }
+func (m *MsgAckReplica) String() string { return proto.CompactTextString(m) }
+func (*MsgAckReplica) ProtoMessage()    {}
+func (*MsgAckReplica) Descriptor() ([]byte, []int) {
+	return fileDescriptor_14bdbb892576efd6, []int{44}
+}
+func (m *MsgAckReplica) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAckReplica) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAckReplica.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgAckReplica) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAckReplica.Merge(m, src)
+}
+func (m *MsgAckReplica) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAckReplica) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAckReplica.DiscardUnknown(m)
 }
 
-func (c *msgClient) DeleteVote(ctx context.Context, in *MsgDeleteVote, opts ...grpc.CallOption) (*MsgDeleteVoteResponse, error) {
-	out := new(MsgDeleteVoteResponse)
-	err := c.cc.Invoke(ctx, "/resist.posts.v1.Msg/DeleteVote", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgAckReplica proto.InternalMessageInfo
+
+func (m *MsgAckReplica) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) CreateSource(ctx context.Context, in *MsgCreateSource, opts ...grpc.CallOption) (*MsgCreateSourceResponse, error) {
-	out := new(MsgCreateSourceResponse)
-	err := c.cc.Invoke(ctx, "/resist.posts.v1.Msg/CreateSource", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgAckReplica) GetContentId() string {
+	if m != nil {
+		return m.ContentId
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) UpdateSource(ctx context.Context, in *MsgUpdateSource, opts ...grpc.CallOption) (*MsgUpdateSourceResponse, error) {
-	out := new(MsgUpdateSourceResponse)
-	err := c.cc.Invoke(ctx, "/resist.posts.v1.Msg/UpdateSource", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgAckReplica) GetNodeId() string {
+	if m != nil {
+		return m.NodeId
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) DeleteSource(ctx context.Context, in *MsgDeleteSource, opts ...grpc.CallOption) (*MsgDeleteSourceResponse, error) {
-	out := new(MsgDeleteSourceResponse)
-	err := c.cc.Invoke(ctx, "/resist.posts.v1.Msg/DeleteSource", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgAckReplica) GetCid() string {
+	if m != nil {
+		return m.Cid
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) CreatePostTag(ctx context.Context, in *MsgCreatePostTag, opts ...grpc.CallOption) (*MsgCreatePostTagResponse, error) {
-	out := new(MsgCreatePostTagResponse)
-	err := c.cc.Invoke(ctx, "/resist.posts.v1.Msg/CreatePostTag", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// MsgAckReplicaResponse defines the response.
+type MsgAckReplicaResponse struct {
+	CurrentReplicas uint32 `protobuf:"varint,1,opt,name=current_replicas,json=currentReplicas,proto3" json:"current_replicas,omitempty"`
 }
 
-func (c *msgClient) UpdatePostTag(ctx context.Context, in *MsgUpdatePostTag, opts ...grpc.CallOption) (*MsgUpdatePostTagResponse, error) {
-	out := new(MsgUpdatePostTagResponse)
-	err := c.cc.Invoke(ctx, "/resist.posts.v1.Msg/UpdatePostTag", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgAckReplicaResponse) Reset()         { *m = MsgAckReplicaResponse{} }
+func (m *MsgAckReplicaResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAckReplicaResponse) ProtoMessage()    {}
+func (*MsgAckReplicaResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_14bdbb892576efd6, []int{45}
+}
+func (m *MsgAckReplicaResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAckReplicaResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAckReplicaResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgAckReplicaResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAckReplicaResponse.Merge(m, src)
+}
+func (m *MsgAckReplicaResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAckReplicaResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAckReplicaResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) DeletePostTag(ctx context.Context, in *MsgDeletePostTag, opts ...grpc.CallOption) (*MsgDeletePostTagResponse, error) {
-	out := new(MsgDeletePostTagResponse)
-	err := c.cc.Invoke(ctx, "/resist.posts.v1.Msg/DeletePostTag", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgAckReplicaResponse proto.InternalMessageInfo
+
+func (m *MsgAckReplicaResponse) GetCurrentReplicas() uint32 {
+	if m != nil {
+		return m.CurrentReplicas
 	}
-	return out, nil
+	return 0
 }
 
-func (c *msgClient) DistributeContent(ctx context.Context, in *MsgDistributeContent, opts ...grpc.CallOption) (*MsgDistributeContentResponse, error) {
-	out := new(MsgDistributeContentResponse)
-	err := c.cc.Invoke(ctx, "/resist.posts.v1.Msg/DistributeContent", in, out, opts...)
-	if err != nil {
-		return nil, err
+// MsgAnswerChallenge answers a storage challenge.
+type MsgAnswerChallenge struct {
+	Creator     string   `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	ChallengeId uint64   `protobuf:"varint,2,opt,name=challenge_id,json=challengeId,proto3" json:"challenge_id,omitempty"`
+	Chunk       []byte   `protobuf:"bytes,3,opt,name=chunk,proto3" json:"chunk,omitempty"`
+	Proof       [][]byte `protobuf:"bytes,4,rep,name=proof,proto3" json:"proof,omitempty"`
+}
+
+func (m *MsgAnswerChallenge) Reset()         { *m = MsgAnswerChallenge{} }
+func (m *MsgAnswerChallenge) String() string { return proto.CompactTextString(m) }
+func (*MsgAnswerChallenge) ProtoMessage()    {}
+func (*MsgAnswerChallenge) Descriptor() ([]byte, []int) {
+	return fileDescriptor_14bdbb892576efd6, []int{46}
+}
+func (m *MsgAnswerChallenge) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAnswerChallenge) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAnswerChallenge.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgAnswerChallenge) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAnswerChallenge.Merge(m, src)
+}
+func (m *MsgAnswerChallenge) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAnswerChallenge) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAnswerChallenge.DiscardUnknown(m)
 }
 
-func (c *msgClient) SyncHubContent(ctx context.Context, in *MsgSyncHubContent, opts ...grpc.CallOption) (*MsgSyncHubContentResponse, error) {
-	out := new(MsgSyncHubContentResponse)
-	err := c.cc.Invoke(ctx, "/resist.posts.v1.Msg/SyncHubContent", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgAnswerChallenge proto.InternalMessageInfo
+
+func (m *MsgAnswerChallenge) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) SendSignalMessage(ctx context.Context, in *MsgSendSignalMessage, opts ...grpc.CallOption) (*MsgSendSignalMessageResponse, error) {
-	out := new(MsgSendSignalMessageResponse)
-	err := c.cc.Invoke(ctx, "/resist.posts.v1.Msg/SendSignalMessage", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgAnswerChallenge) GetChallengeId() uint64 {
+	if m != nil {
+		return m.ChallengeId
 	}
-	return out, nil
+	return 0
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// UpdateParams defines a (governance) operation for updating the module
-	// parameters. The authority defaults to the x/gov module account.
-	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
-	// CreatePost defines the CreatePost RPC.
-	CreatePost(context.Context, *MsgCreatePost) (*MsgCreatePostResponse, error)
-	// VotePost defines the VotePost RPC.
-	VotePost(context.Context, *MsgVotePost) (*MsgVotePostResponse, error)
-	// CreateSocialPost defines the CreateSocialPost RPC.
-	CreateSocialPost(context.Context, *MsgCreateSocialPost) (*MsgCreateSocialPostResponse, error)
-	// UpdateSocialPost defines the UpdateSocialPost RPC.
-	UpdateSocialPost(context.Context, *MsgUpdateSocialPost) (*MsgUpdateSocialPostResponse, error)
-	// DeleteSocialPost defines the DeleteSocialPost RPC.
-	DeleteSocialPost(context.Context, *MsgDeleteSocialPost) (*MsgDeleteSocialPostResponse, error)
-	// CreateVote defines the CreateVote RPC.
-	CreateVote(context.Context, *MsgCreateVote) (*MsgCreateVoteResponse, error)
-	// UpdateVote defines the UpdateVote RPC.
-	UpdateVote(context.Context, *MsgUpdateVote) (*MsgUpdateVoteResponse, error)
-	// DeleteVote defines the DeleteVote RPC.
-	DeleteVote(context.Context, *MsgDeleteVote) (*MsgDeleteVoteResponse, error)
-	// CreateSource defines the CreateSource RPC.
-	CreateSource(context.Context, *MsgCreateSource) (*MsgCreateSourceResponse, error)
-	// UpdateSource defines the UpdateSource RPC.
-	UpdateSource(context.Context, *MsgUpdateSource) (*MsgUpdateSourceResponse, error)
-	// DeleteSource defines the DeleteSource RPC.
-	DeleteSource(context.Context, *MsgDeleteSource) (*MsgDeleteSourceResponse, error)
-	// CreatePostTag defines the CreatePostTag RPC.
-	CreatePostTag(context.Context, *MsgCreatePostTag) (*MsgCreatePostTagResponse, error)
-	// UpdatePostTag defines the UpdatePostTag RPC.
-	UpdatePostTag(context.Context, *MsgUpdatePostTag) (*MsgUpdatePostTagResponse, error)
-	// DeletePostTag defines the DeletePostTag RPC.
-	DeletePostTag(context.Context, *MsgDeletePostTag) (*MsgDeletePostTagResponse, error)
-	// DistributeContent defines the DistributeContent RPC for IPFS and decentralized storage.
-	DistributeContent(context.Context, *MsgDistributeContent) (*MsgDistributeContentResponse, error)
-	// SyncHubContent defines the SyncHubContent RPC for hub-to-hub synchronization.
-	SyncHubContent(context.Context, *MsgSyncHubContent) (*MsgSyncHubContentResponse, error)
-	// SendSignalMessage defines the SendSignalMessage RPC for secure node communication.
-	SendSignalMessage(context.Context, *MsgSendSignalMessage) (*MsgSendSignalMessageResponse, error)
+func (m *MsgAnswerChallenge) GetChunk() []byte {
+	if m != nil {
+		return m.Chunk
+	}
+	return nil
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+func (m *MsgAnswerChallenge) GetProof() [][]byte {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
 }
 
-func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+// MsgAnswerChallengeResponse defines the response.
+type MsgAnswerChallengeResponse struct {
+	Passed bool `protobuf:"varint,1,opt,name=passed,proto3" json:"passed,omitempty"`
 }
-func (*UnimplementedMsgServer) CreatePost(ctx context.Context, req *MsgCreatePost) (*MsgCreatePostResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreatePost not implemented")
+
+func (m *MsgAnswerChallengeResponse) Reset()         { *m = MsgAnswerChallengeResponse{} }
+func (m *MsgAnswerChallengeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAnswerChallengeResponse) ProtoMessage()    {}
+func (*MsgAnswerChallengeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_14bdbb892576efd6, []int{47}
 }
-func (*UnimplementedMsgServer) VotePost(ctx context.Context, req *MsgVotePost) (*MsgVotePostResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method VotePost not implemented")
+func (m *MsgAnswerChallengeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) CreateSocialPost(ctx context.Context, req *MsgCreateSocialPost) (*MsgCreateSocialPostResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateSocialPost not implemented")
+func (m *MsgAnswerChallengeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAnswerChallengeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) UpdateSocialPost(ctx context.Context, req *MsgUpdateSocialPost) (*MsgUpdateSocialPostResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateSocialPost not implemented")
+func (m *MsgAnswerChallengeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAnswerChallengeResponse.Merge(m, src)
 }
-func (*UnimplementedMsgServer) DeleteSocialPost(ctx context.Context, req *MsgDeleteSocialPost) (*MsgDeleteSocialPostResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteSocialPost not implemented")
+func (m *MsgAnswerChallengeResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) CreateVote(ctx context.Context, req *MsgCreateVote) (*MsgCreateVoteResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateVote not implemented")
+func (m *MsgAnswerChallengeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAnswerChallengeResponse.DiscardUnknown(m)
 }
-func (*UnimplementedMsgServer) UpdateVote(ctx context.Context, req *MsgUpdateVote) (*MsgUpdateVoteResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateVote not implemented")
+
+var xxx_messageInfo_MsgAnswerChallengeResponse proto.InternalMessageInfo
+
+func (m *MsgAnswerChallengeResponse) GetPassed() bool {
+	if m != nil {
+		return m.Passed
+	}
+	return false
 }
-func (*UnimplementedMsgServer) DeleteVote(ctx context.Context, req *MsgDeleteVote) (*MsgDeleteVoteResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteVote not implemented")
+
+// MsgReportSyncProgress reports the number of bytes a hub sync transferred so far.
+type MsgReportSyncProgress struct {
+	Creator          string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	SyncId           string `protobuf:"bytes,2,opt,name=sync_id,json=syncId,proto3" json:"sync_id,omitempty"`
+	BytesTransferred uint64 `protobuf:"varint,3,opt,name=bytes_transferred,json=bytesTransferred,proto3" json:"bytes_transferred,omitempty"`
 }
-func (*UnimplementedMsgServer) CreateSource(ctx context.Context, req *MsgCreateSource) (*MsgCreateSourceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateSource not implemented")
+
+func (m *MsgReportSyncProgress) Reset()         { *m = MsgReportSyncProgress{} }
+func (m *MsgReportSyncProgress) String() string { return proto.CompactTextString(m) }
+func (*MsgReportSyncProgress) ProtoMessage()    {}
+func (*MsgReportSyncProgress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_14bdbb892576efd6, []int{48}
 }
-func (*UnimplementedMsgServer) UpdateSource(ctx context.Context, req *MsgUpdateSource) (*MsgUpdateSourceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateSource not implemented")
+func (m *MsgReportSyncProgress) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) DeleteSource(ctx context.Context, req *MsgDeleteSource) (*MsgDeleteSourceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteSource not implemented")
+func (m *MsgReportSyncProgress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgReportSyncProgress.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) CreatePostTag(ctx context.Context, req *MsgCreatePostTag) (*MsgCreatePostTagResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreatePostTag not implemented")
+func (m *MsgReportSyncProgress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReportSyncProgress.Merge(m, src)
 }
-func (*UnimplementedMsgServer) UpdatePostTag(ctx context.Context, req *MsgUpdatePostTag) (*MsgUpdatePostTagResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdatePostTag not implemented")
+func (m *MsgReportSyncProgress) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) DeletePostTag(ctx context.Context, req *MsgDeletePostTag) (*MsgDeletePostTagResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeletePostTag not implemented")
+func (m *MsgReportSyncProgress) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReportSyncProgress.DiscardUnknown(m)
 }
-func (*UnimplementedMsgServer) DistributeContent(ctx context.Context, req *MsgDistributeContent) (*MsgDistributeContentResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DistributeContent not implemented")
+
+var xxx_messageInfo_MsgReportSyncProgress proto.InternalMessageInfo
+
+func (m *MsgReportSyncProgress) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
 }
-func (*UnimplementedMsgServer) SyncHubContent(ctx context.Context, req *MsgSyncHubContent) (*MsgSyncHubContentResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SyncHubContent not implemented")
+
+func (m *MsgReportSyncProgress) GetSyncId() string {
+	if m != nil {
+		return m.SyncId
+	}
+	return ""
 }
-func (*UnimplementedMsgServer) SendSignalMessage(ctx context.Context, req *MsgSendSignalMessage) (*MsgSendSignalMessageResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SendSignalMessage not implemented")
+
+func (m *MsgReportSyncProgress) GetBytesTransferred() uint64 {
+	if m != nil {
+		return m.BytesTransferred
+	}
+	return 0
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+// MsgReportSyncProgressResponse defines the response.
+type MsgReportSyncProgressResponse struct {
 }
 
-func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateParams)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateParams(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/resist.posts.v1.Msg/UpdateParams",
+func (m *MsgReportSyncProgressResponse) Reset()         { *m = MsgReportSyncProgressResponse{} }
+func (m *MsgReportSyncProgressResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgReportSyncProgressResponse) ProtoMessage()    {}
+func (*MsgReportSyncProgressResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_14bdbb892576efd6, []int{49}
+}
+func (m *MsgReportSyncProgressResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgReportSyncProgressResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgReportSyncProgressResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+}
+func (m *MsgReportSyncProgressResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReportSyncProgressResponse.Merge(m, src)
+}
+func (m *MsgReportSyncProgressResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgReportSyncProgressResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReportSyncProgressResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgReportSyncProgressResponse proto.InternalMessageInfo
+
+// MsgCompleteSync closes a hub sync as completed or failed.
+type MsgCompleteSync struct {
+	Creator          string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	SyncId           string `protobuf:"bytes,2,opt,name=sync_id,json=syncId,proto3" json:"sync_id,omitempty"`
+	Success          bool   `protobuf:"varint,3,opt,name=success,proto3" json:"success,omitempty"`
+	BytesTransferred uint64 `protobuf:"varint,4,opt,name=bytes_transferred,json=bytesTransferred,proto3" json:"bytes_transferred,omitempty"`
+	FailureReason    string `protobuf:"bytes,5,opt,name=failure_reason,json=failureReason,proto3" json:"failure_reason,omitempty"`
+}
+
+func (m *MsgCompleteSync) Reset()         { *m = MsgCompleteSync{} }
+func (m *MsgCompleteSync) String() string { return proto.CompactTextString(m) }
+func (*MsgCompleteSync) ProtoMessage()    {}
+func (*MsgCompleteSync) Descriptor() ([]byte, []int) {
+	return fileDescriptor_14bdbb892576efd6, []int{50}
+}
+func (m *MsgCompleteSync) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCompleteSync) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCompleteSync.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgCompleteSync) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCompleteSync.Merge(m, src)
+}
+func (m *MsgCompleteSync) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCompleteSync) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCompleteSync.DiscardUnknown(m)
 }
 
-func _Msg_CreatePost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCreatePost)
-	if err := dec(in); err != nil {
-		return nil, err
+var xxx_messageInfo_MsgCompleteSync proto.InternalMessageInfo
+
+func (m *MsgCompleteSync) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).CreatePost(ctx, in)
+	return ""
+}
+
+func (m *MsgCompleteSync) GetSyncId() string {
+	if m != nil {
+		return m.SyncId
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/resist.posts.v1.Msg/CreatePost",
+	return ""
+}
+
+func (m *MsgCompleteSync) GetSuccess() bool {
+	if m != nil {
+		return m.Success
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CreatePost(ctx, req.(*MsgCreatePost))
+	return false
+}
+
+func (m *MsgCompleteSync) GetBytesTransferred() uint64 {
+	if m != nil {
+		return m.BytesTransferred
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Msg_VotePost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgVotePost)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *MsgCompleteSync) GetFailureReason() string {
+	if m != nil {
+		return m.FailureReason
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).VotePost(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/resist.posts.v1.Msg/VotePost",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).VotePost(ctx, req.(*MsgVotePost))
-	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_CreateSocialPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCreateSocialPost)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CreateSocialPost(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/resist.posts.v1.Msg/CreateSocialPost",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CreateSocialPost(ctx, req.(*MsgCreateSocialPost))
-	}
-	return interceptor(ctx, in, info, handler)
+// MsgCompleteSyncResponse defines the response.
+type MsgCompleteSyncResponse struct {
 }
 
-func _Msg_UpdateSocialPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateSocialPost)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateSocialPost(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/resist.posts.v1.Msg/UpdateSocialPost",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateSocialPost(ctx, req.(*MsgUpdateSocialPost))
+func (m *MsgCompleteSyncResponse) Reset()         { *m = MsgCompleteSyncResponse{} }
+func (m *MsgCompleteSyncResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCompleteSyncResponse) ProtoMessage()    {}
+func (*MsgCompleteSyncResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_14bdbb892576efd6, []int{51}
+}
+func (m *MsgCompleteSyncResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCompleteSyncResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCompleteSyncResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgCompleteSyncResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCompleteSyncResponse.Merge(m, src)
+}
+func (m *MsgCompleteSyncResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCompleteSyncResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCompleteSyncResponse.DiscardUnknown(m)
 }
 
-func _Msg_DeleteSocialPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgDeleteSocialPost)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).DeleteSocialPost(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/resist.posts.v1.Msg/DeleteSocialPost",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).DeleteSocialPost(ctx, req.(*MsgDeleteSocialPost))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_MsgCompleteSyncResponse proto.InternalMessageInfo
+
+// MsgPublishPrekeyBundle publishes the prekey bundle of a node, replacing the
+// previous one. Publishing a new identity key drops the one-time prekeys of
+// the previous identity.
+type MsgPublishPrekeyBundle struct {
+	Creator               string          `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	NodeId                string          `protobuf:"bytes,2,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	IdentityKey           []byte          `protobuf:"bytes,3,opt,name=identity_key,json=identityKey,proto3" json:"identity_key,omitempty"`
+	SigningKey            []byte          `protobuf:"bytes,4,opt,name=signing_key,json=signingKey,proto3" json:"signing_key,omitempty"`
+	SignedPrekeyId        uint32          `protobuf:"varint,5,opt,name=signed_prekey_id,json=signedPrekeyId,proto3" json:"signed_prekey_id,omitempty"`
+	SignedPrekey          []byte          `protobuf:"bytes,6,opt,name=signed_prekey,json=signedPrekey,proto3" json:"signed_prekey,omitempty"`
+	SignedPrekeySignature []byte          `protobuf:"bytes,7,opt,name=signed_prekey_signature,json=signedPrekeySignature,proto3" json:"signed_prekey_signature,omitempty"`
+	OneTimePrekeys        []OneTimePrekey `protobuf:"bytes,8,rep,name=one_time_prekeys,json=oneTimePrekeys,proto3" json:"one_time_prekeys"`
 }
 
-func _Msg_CreateVote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCreateVote)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CreateVote(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/resist.posts.v1.Msg/CreateVote",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CreateVote(ctx, req.(*MsgCreateVote))
+func (m *MsgPublishPrekeyBundle) Reset()         { *m = MsgPublishPrekeyBundle{} }
+func (m *MsgPublishPrekeyBundle) String() string { return proto.CompactTextString(m) }
+func (*MsgPublishPrekeyBundle) ProtoMessage()    {}
+func (*MsgPublishPrekeyBundle) Descriptor() ([]byte, []int) {
+	return fileDescriptor_14bdbb892576efd6, []int{52}
+}
+func (m *MsgPublishPrekeyBundle) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPublishPrekeyBundle) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPublishPrekeyBundle.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgPublishPrekeyBundle) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPublishPrekeyBundle.Merge(m, src)
+}
+func (m *MsgPublishPrekeyBundle) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPublishPrekeyBundle) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPublishPrekeyBundle.DiscardUnknown(m)
 }
 
-func _Msg_UpdateVote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateVote)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateVote(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/resist.posts.v1.Msg/UpdateVote",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateVote(ctx, req.(*MsgUpdateVote))
+var xxx_messageInfo_MsgPublishPrekeyBundle proto.InternalMessageInfo
+
+func (m *MsgPublishPrekeyBundle) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_DeleteVote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgDeleteVote)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).DeleteVote(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/resist.posts.v1.Msg/DeleteVote",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).DeleteVote(ctx, req.(*MsgDeleteVote))
+func (m *MsgPublishPrekeyBundle) GetNodeId() string {
+	if m != nil {
+		return m.NodeId
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_CreateSource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCreateSource)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CreateSource(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/resist.posts.v1.Msg/CreateSource",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CreateSource(ctx, req.(*MsgCreateSource))
+func (m *MsgPublishPrekeyBundle) GetIdentityKey() []byte {
+	if m != nil {
+		return m.IdentityKey
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Msg_UpdateSource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateSource)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateSource(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/resist.posts.v1.Msg/UpdateSource",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateSource(ctx, req.(*MsgUpdateSource))
+func (m *MsgPublishPrekeyBundle) GetSigningKey() []byte {
+	if m != nil {
+		return m.SigningKey
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Msg_DeleteSource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgDeleteSource)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).DeleteSource(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/resist.posts.v1.Msg/DeleteSource",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).DeleteSource(ctx, req.(*MsgDeleteSource))
+func (m *MsgPublishPrekeyBundle) GetSignedPrekeyId() uint32 {
+	if m != nil {
+		return m.SignedPrekeyId
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Msg_CreatePostTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCreatePostTag)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CreatePostTag(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/resist.posts.v1.Msg/CreatePostTag",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CreatePostTag(ctx, req.(*MsgCreatePostTag))
+func (m *MsgPublishPrekeyBundle) GetSignedPrekey() []byte {
+	if m != nil {
+		return m.SignedPrekey
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Msg_UpdatePostTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdatePostTag)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdatePostTag(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/resist.posts.v1.Msg/UpdatePostTag",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdatePostTag(ctx, req.(*MsgUpdatePostTag))
+func (m *MsgPublishPrekeyBundle) GetSignedPrekeySignature() []byte {
+	if m != nil {
+		return m.SignedPrekeySignature
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Msg_DeletePostTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgDeletePostTag)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).DeletePostTag(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/resist.posts.v1.Msg/DeletePostTag",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).DeletePostTag(ctx, req.(*MsgDeletePostTag))
+func (m *MsgPublishPrekeyBundle) GetOneTimePrekeys() []OneTimePrekey {
+	if m != nil {
+		return m.OneTimePrekeys
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Msg_DistributeContent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgDistributeContent)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).DistributeContent(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/resist.posts.v1.Msg/DistributeContent",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).DistributeContent(ctx, req.(*MsgDistributeContent))
-	}
-	return interceptor(ctx, in, info, handler)
+// MsgPublishPrekeyBundleResponse defines the response.
+type MsgPublishPrekeyBundleResponse struct {
 }
 
-func _Msg_SyncHubContent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSyncHubContent)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).SyncHubContent(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/resist.posts.v1.Msg/SyncHubContent",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SyncHubContent(ctx, req.(*MsgSyncHubContent))
+func (m *MsgPublishPrekeyBundleResponse) Reset()         { *m = MsgPublishPrekeyBundleResponse{} }
+func (m *MsgPublishPrekeyBundleResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgPublishPrekeyBundleResponse) ProtoMessage()    {}
+func (*MsgPublishPrekeyBundleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_14bdbb892576efd6, []int{53}
+}
+func (m *MsgPublishPrekeyBundleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPublishPrekeyBundleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPublishPrekeyBundleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgPublishPrekeyBundleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPublishPrekeyBundleResponse.Merge(m, src)
+}
+func (m *MsgPublishPrekeyBundleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPublishPrekeyBundleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPublishPrekeyBundleResponse.DiscardUnknown(m)
 }
 
-func _Msg_SendSignalMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSendSignalMessage)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).SendSignalMessage(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/resist.posts.v1.Msg/SendSignalMessage",
+var xxx_messageInfo_MsgPublishPrekeyBundleResponse proto.InternalMessageInfo
+
+// MsgReplenishOneTimePrekeys publishes new one-time prekeys of a node.
+type MsgReplenishOneTimePrekeys struct {
+	Creator        string          `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	NodeId         string          `protobuf:"bytes,2,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	OneTimePrekeys []OneTimePrekey `protobuf:"bytes,3,rep,name=one_time_prekeys,json=oneTimePrekeys,proto3" json:"one_time_prekeys"`
+}
+
+func (m *MsgReplenishOneTimePrekeys) Reset()         { *m = MsgReplenishOneTimePrekeys{} }
+func (m *MsgReplenishOneTimePrekeys) String() string { return proto.CompactTextString(m) }
+func (*MsgReplenishOneTimePrekeys) ProtoMessage()    {}
+func (*MsgReplenishOneTimePrekeys) Descriptor() ([]byte, []int) {
+	return fileDescriptor_14bdbb892576efd6, []int{54}
+}
+func (m *MsgReplenishOneTimePrekeys) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgReplenishOneTimePrekeys) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgReplenishOneTimePrekeys.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SendSignalMessage(ctx, req.(*MsgSendSignalMessage))
+}
+func (m *MsgReplenishOneTimePrekeys) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReplenishOneTimePrekeys.Merge(m, src)
+}
+func (m *MsgReplenishOneTimePrekeys) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgReplenishOneTimePrekeys) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReplenishOneTimePrekeys.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgReplenishOneTimePrekeys proto.InternalMessageInfo
+
+func (m *MsgReplenishOneTimePrekeys) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-var Msg_serviceDesc = _Msg_serviceDesc
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "resist.posts.v1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "UpdateParams",
-			Handler:    _Msg_UpdateParams_Handler,
-		},
-		{
-			MethodName: "CreatePost",
-			Handler:    _Msg_CreatePost_Handler,
-		},
-		{
-			MethodName: "VotePost",
-			Handler:    _Msg_VotePost_Handler,
-		},
-		{
-			MethodName: "CreateSocialPost",
-			Handler:    _Msg_CreateSocialPost_Handler,
-		},
-		{
-			MethodName: "UpdateSocialPost",
-			Handler:    _Msg_UpdateSocialPost_Handler,
-		},
-		{
-			MethodName: "DeleteSocialPost",
-			Handler:    _Msg_DeleteSocialPost_Handler,
-		},
-		{
-			MethodName: "CreateVote",
-			Handler:    _Msg_CreateVote_Handler,
-		},
-		{
-			MethodName: "UpdateVote",
-			Handler:    _Msg_UpdateVote_Handler,
-		},
-		{
-			MethodName: "DeleteVote",
-			Handler:    _Msg_DeleteVote_Handler,
-		},
-		{
-			MethodName: "CreateSource",
-			Handler:    _Msg_CreateSource_Handler,
-		},
-		{
-			MethodName: "UpdateSource",
-			Handler:    _Msg_UpdateSource_Handler,
-		},
-		{
-			MethodName: "DeleteSource",
-			Handler:    _Msg_DeleteSource_Handler,
-		},
-		{
-			MethodName: "CreatePostTag",
-			Handler:    _Msg_CreatePostTag_Handler,
-		},
-		{
-			MethodName: "UpdatePostTag",
-			Handler:    _Msg_UpdatePostTag_Handler,
-		},
-		{
-			MethodName: "DeletePostTag",
-			Handler:    _Msg_DeletePostTag_Handler,
-		},
-		{
-			MethodName: "DistributeContent",
-			Handler:    _Msg_DistributeContent_Handler,
-		},
-		{
-			MethodName: "SyncHubContent",
-			Handler:    _Msg_SyncHubContent_Handler,
-		},
-		{
-			MethodName: "SendSignalMessage",
-			Handler:    _Msg_SendSignalMessage_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "resist/posts/v1/tx.proto",
+func (m *MsgReplenishOneTimePrekeys) GetNodeId() string {
+	if m != nil {
+		return m.NodeId
+	}
+	return ""
 }
 
-func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgReplenishOneTimePrekeys) GetOneTimePrekeys() []OneTimePrekey {
+	if m != nil {
+		return m.OneTimePrekeys
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgReplenishOneTimePrekeysResponse defines the response.
+type MsgReplenishOneTimePrekeysResponse struct {
+	OneTimePrekeyCount uint64 `protobuf:"varint,1,opt,name=one_time_prekey_count,json=oneTimePrekeyCount,proto3" json:"one_time_prekey_count,omitempty"`
 }
 
-func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgReplenishOneTimePrekeysResponse) Reset()         { *m = MsgReplenishOneTimePrekeysResponse{} }
+func (m *MsgReplenishOneTimePrekeysResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgReplenishOneTimePrekeysResponse) ProtoMessage()    {}
+func (*MsgReplenishOneTimePrekeysResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_14bdbb892576efd6, []int{55}
+}
+func (m *MsgReplenishOneTimePrekeysResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgReplenishOneTimePrekeysResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgReplenishOneTimePrekeysResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgReplenishOneTimePrekeysResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReplenishOneTimePrekeysResponse.Merge(m, src)
+}
+func (m *MsgReplenishOneTimePrekeysResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgReplenishOneTimePrekeysResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReplenishOneTimePrekeysResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgReplenishOneTimePrekeysResponse proto.InternalMessageInfo
+
+func (m *MsgReplenishOneTimePrekeysResponse) GetOneTimePrekeyCount() uint64 {
+	if m != nil {
+		return m.OneTimePrekeyCount
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+// MsgClaimPrekeyBundle hands out the prekey bundle of node_id to the owner of
+// claimer_node_id, with one of its one-time prekeys if any is left.
+type MsgClaimPrekeyBundle struct {
+	Creator       string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	ClaimerNodeId string `protobuf:"bytes,2,opt,name=claimer_node_id,json=claimerNodeId,proto3" json:"claimer_node_id,omitempty"`
+	NodeId        string `protobuf:"bytes,3,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+}
+
+func (m *MsgClaimPrekeyBundle) Reset()         { *m = MsgClaimPrekeyBundle{} }
+func (m *MsgClaimPrekeyBundle) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimPrekeyBundle) ProtoMessage()    {}
+func (*MsgClaimPrekeyBundle) Descriptor() ([]byte, []int) {
+	return fileDescriptor_14bdbb892576efd6, []int{56}
+}
+func (m *MsgClaimPrekeyBundle) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimPrekeyBundle) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimPrekeyBundle.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgClaimPrekeyBundle) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimPrekeyBundle.Merge(m, src)
+}
+func (m *MsgClaimPrekeyBundle) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimPrekeyBundle) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimPrekeyBundle.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgClaimPrekeyBundle proto.InternalMessageInfo
+
+func (m *MsgClaimPrekeyBundle) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
 }
 
-func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgClaimPrekeyBundle) GetClaimerNodeId() string {
+	if m != nil {
+		return m.ClaimerNodeId
+	}
+	return ""
 }
 
-func (m *MsgCreatePost) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgClaimPrekeyBundle) GetNodeId() string {
+	if m != nil {
+		return m.NodeId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgCreatePost) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgClaimPrekeyBundleResponse defines the response.
+type MsgClaimPrekeyBundleResponse struct {
+	PrekeyBundle  PrekeyBundle   `protobuf:"bytes,1,opt,name=prekey_bundle,json=prekeyBundle,proto3" json:"prekey_bundle"`
+	OneTimePrekey *OneTimePrekey `protobuf:"bytes,2,opt,name=one_time_prekey,json=oneTimePrekey,proto3" json:"one_time_prekey,omitempty"`
 }
 
-func (m *MsgCreatePost) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.GroupId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.GroupId))
-		i--
-		dAtA[i] = 0x30
-	}
-	if len(m.MediaType) > 0 {
-		i -= len(m.MediaType)
-		copy(dAtA[i:], m.MediaType)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.MediaType)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.MediaUrl) > 0 {
-		i -= len(m.MediaUrl)
-		copy(dAtA[i:], m.MediaUrl)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.MediaUrl)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Content) > 0 {
-		i -= len(m.Content)
-		copy(dAtA[i:], m.Content)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Content)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Title) > 0 {
-		i -= len(m.Title)
-		copy(dAtA[i:], m.Title)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Title)))
-		i--
-		dAtA[i] = 0x12
+func (m *MsgClaimPrekeyBundleResponse) Reset()         { *m = MsgClaimPrekeyBundleResponse{} }
+func (m *MsgClaimPrekeyBundleResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimPrekeyBundleResponse) ProtoMessage()    {}
+func (*MsgClaimPrekeyBundleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_14bdbb892576efd6, []int{57}
+}
+func (m *MsgClaimPrekeyBundleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimPrekeyBundleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimPrekeyBundleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgClaimPrekeyBundleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimPrekeyBundleResponse.Merge(m, src)
+}
+func (m *MsgClaimPrekeyBundleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimPrekeyBundleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimPrekeyBundleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClaimPrekeyBundleResponse proto.InternalMessageInfo
+
+func (m *MsgClaimPrekeyBundleResponse) GetPrekeyBundle() PrekeyBundle {
+	if m != nil {
+		return m.PrekeyBundle
 	}
-	return len(dAtA) - i, nil
+	return PrekeyBundle{}
 }
 
-func (m *MsgCreatePostResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgClaimPrekeyBundleResponse) GetOneTimePrekey() *OneTimePrekey {
+	if m != nil {
+		return m.OneTimePrekey
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgCreatePostResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func init() {
+	proto.RegisterType((*MsgUpdateParams)(nil), "resist.posts.v1.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "resist.posts.v1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgCreatePost)(nil), "resist.posts.v1.MsgCreatePost")
+	proto.RegisterType((*MsgCreatePostResponse)(nil), "resist.posts.v1.MsgCreatePostResponse")
+	proto.RegisterType((*MsgReplyToPost)(nil), "resist.posts.v1.MsgReplyToPost")
+	proto.RegisterType((*MsgReplyToPostResponse)(nil), "resist.posts.v1.MsgReplyToPostResponse")
+	proto.RegisterType((*MsgVotePost)(nil), "resist.posts.v1.MsgVotePost")
+	proto.RegisterType((*MsgVotePostResponse)(nil), "resist.posts.v1.MsgVotePostResponse")
+	proto.RegisterType((*MsgCreateSocialPost)(nil), "resist.posts.v1.MsgCreateSocialPost")
+	proto.RegisterType((*MsgCreateSocialPostResponse)(nil), "resist.posts.v1.MsgCreateSocialPostResponse")
+	proto.RegisterType((*MsgUpdateSocialPost)(nil), "resist.posts.v1.MsgUpdateSocialPost")
+	proto.RegisterType((*MsgUpdateSocialPostResponse)(nil), "resist.posts.v1.MsgUpdateSocialPostResponse")
+	proto.RegisterType((*MsgDeleteSocialPost)(nil), "resist.posts.v1.MsgDeleteSocialPost")
+	proto.RegisterType((*MsgDeleteSocialPostResponse)(nil), "resist.posts.v1.MsgDeleteSocialPostResponse")
+	proto.RegisterType((*MsgCreateVote)(nil), "resist.posts.v1.MsgCreateVote")
+	proto.RegisterType((*MsgCreateVoteResponse)(nil), "resist.posts.v1.MsgCreateVoteResponse")
+	proto.RegisterType((*MsgUpdateVote)(nil), "resist.posts.v1.MsgUpdateVote")
+	proto.RegisterType((*MsgUpdateVoteResponse)(nil), "resist.posts.v1.MsgUpdateVoteResponse")
+	proto.RegisterType((*MsgDeleteVote)(nil), "resist.posts.v1.MsgDeleteVote")
+	proto.RegisterType((*MsgDeleteVoteResponse)(nil), "resist.posts.v1.MsgDeleteVoteResponse")
+	proto.RegisterType((*MsgCreateSource)(nil), "resist.posts.v1.MsgCreateSource")
+	proto.RegisterType((*MsgCreateSourceResponse)(nil), "resist.posts.v1.MsgCreateSourceResponse")
+	proto.RegisterType((*MsgUpdateSource)(nil), "resist.posts.v1.MsgUpdateSource")
+	proto.RegisterType((*MsgUpdateSourceResponse)(nil), "resist.posts.v1.MsgUpdateSourceResponse")
+	proto.RegisterType((*MsgDeleteSource)(nil), "resist.posts.v1.MsgDeleteSource")
+	proto.RegisterType((*MsgDeleteSourceResponse)(nil), "resist.posts.v1.MsgDeleteSourceResponse")
+	proto.RegisterType((*MsgVoteSource)(nil), "resist.posts.v1.MsgVoteSource")
+	proto.RegisterType((*MsgVoteSourceResponse)(nil), "resist.posts.v1.MsgVoteSourceResponse")
+	proto.RegisterType((*MsgVerifySource)(nil), "resist.posts.v1.MsgVerifySource")
+	proto.RegisterType((*MsgVerifySourceResponse)(nil), "resist.posts.v1.MsgVerifySourceResponse")
+	proto.RegisterType((*MsgCreatePostTag)(nil), "resist.posts.v1.MsgCreatePostTag")
+	proto.RegisterType((*MsgCreatePostTagResponse)(nil), "resist.posts.v1.MsgCreatePostTagResponse")
+	proto.RegisterType((*MsgUpdatePostTag)(nil), "resist.posts.v1.MsgUpdatePostTag")
+	proto.RegisterType((*MsgUpdatePostTagResponse)(nil), "resist.posts.v1.MsgUpdatePostTagResponse")
+	proto.RegisterType((*MsgDeletePostTag)(nil), "resist.posts.v1.MsgDeletePostTag")
+	proto.RegisterType((*MsgDeletePostTagResponse)(nil), "resist.posts.v1.MsgDeletePostTagResponse")
+	proto.RegisterType((*MsgDistributeContent)(nil), "resist.posts.v1.MsgDistributeContent")
+	proto.RegisterType((*MsgDistributeContentResponse)(nil), "resist.posts.v1.MsgDistributeContentResponse")
+	proto.RegisterType((*MsgSyncHubContent)(nil), "resist.posts.v1.MsgSyncHubContent")
+	proto.RegisterType((*MsgSyncHubContentResponse)(nil), "resist.posts.v1.MsgSyncHubContentResponse")
+	proto.RegisterType((*MsgSendSignalMessage)(nil), "resist.posts.v1.MsgSendSignalMessage")
+	proto.RegisterType((*MsgSendSignalMessageResponse)(nil), "resist.posts.v1.MsgSendSignalMessageResponse")
+	proto.RegisterType((*MsgAckMessages)(nil), "resist.posts.v1.MsgAckMessages")
+	proto.RegisterType((*MsgAckMessagesResponse)(nil), "resist.posts.v1.MsgAckMessagesResponse")
+	proto.RegisterType((*MsgAckReplica)(nil), "resist.posts.v1.MsgAckReplica")
+	proto.RegisterType((*MsgAckReplicaResponse)(nil), "resist.posts.v1.MsgAckReplicaResponse")
+	proto.RegisterType((*MsgAnswerChallenge)(nil), "resist.posts.v1.MsgAnswerChallenge")
+	proto.RegisterType((*MsgAnswerChallengeResponse)(nil), "resist.posts.v1.MsgAnswerChallengeResponse")
+	proto.RegisterType((*MsgReportSyncProgress)(nil), "resist.posts.v1.MsgReportSyncProgress")
+	proto.RegisterType((*MsgReportSyncProgressResponse)(nil), "resist.posts.v1.MsgReportSyncProgressResponse")
+	proto.RegisterType((*MsgCompleteSync)(nil), "resist.posts.v1.MsgCompleteSync")
+	proto.RegisterType((*MsgCompleteSyncResponse)(nil), "resist.posts.v1.MsgCompleteSyncResponse")
+	proto.RegisterType((*MsgPublishPrekeyBundle)(nil), "resist.posts.v1.MsgPublishPrekeyBundle")
+	proto.RegisterType((*MsgPublishPrekeyBundleResponse)(nil), "resist.posts.v1.MsgPublishPrekeyBundleResponse")
+	proto.RegisterType((*MsgReplenishOneTimePrekeys)(nil), "resist.posts.v1.MsgReplenishOneTimePrekeys")
+	proto.RegisterType((*MsgReplenishOneTimePrekeysResponse)(nil), "resist.posts.v1.MsgReplenishOneTimePrekeysResponse")
+	proto.RegisterType((*MsgClaimPrekeyBundle)(nil), "resist.posts.v1.MsgClaimPrekeyBundle")
+	proto.RegisterType((*MsgClaimPrekeyBundleResponse)(nil), "resist.posts.v1.MsgClaimPrekeyBundleResponse")
 }
 
-func (m *MsgCreatePostResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func init() { proto.RegisterFile("resist/posts/v1/tx.proto", fileDescriptor_14bdbb892576efd6) }
+
+var fileDescriptor_14bdbb892576efd6 = []byte{
+	// 2658 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5a, 0xcf, 0x6b, 0x1c, 0xc9,
+	0xf5, 0xf7, 0x68, 0xc6, 0xd2, 0xcc, 0x9b, 0xd1, 0xaf, 0xb6, 0xbc, 0x1a, 0x8f, 0xed, 0x91, 0x34,
+	0xfe, 0xb1, 0xb2, 0xfc, 0xb5, 0x84, 0xed, 0x2f, 0x7b, 0x30, 0xb9, 0x58, 0x32, 0x8b, 0x45, 0x90,
+	0x63, 0x5a, 0xda, 0x35, 0x2c, 0x84, 0x49, 0xab, 0xbb, 0xd4, 0xaa, 0xa8, 0xa7, 0xbb, 0x53, 0xd5,
+	0xe3, 0xf5, 0x90, 0x4b, 0x08, 0x84, 0x90, 0x6c, 0x08, 0xc9, 0x31, 0xe4, 0x10, 0x08, 0x84, 0xe4,
+	0x12, 0xf0, 0x21, 0x87, 0xfc, 0x07, 0xf1, 0x71, 0xc9, 0x29, 0x04, 0xb2, 0x04, 0x1b, 0xe2, 0x5b,
+	0x2e, 0xf9, 0x07, 0x42, 0xfd, 0xe8, 0xea, 0xea, 0x9e, 0x9a, 0x91, 0x57, 0x96, 0x2f, 0xc1, 0x17,
+	0x31, 0xf5, 0xde, 0xeb, 0xaa, 0xf7, 0x3e, 0xaf, 0xde, 0xab, 0x57, 0xaf, 0x04, 0x4d, 0x82, 0x28,
+	0xa6, 0xc9, 0x46, 0x1c, 0xd1, 0x84, 0x6e, 0x3c, 0xbd, 0xbd, 0x91, 0x3c, 0x5b, 0x8f, 0x49, 0x94,
+	0x44, 0xd6, 0xac, 0xe0, 0xac, 0x73, 0xce, 0xfa, 0xd3, 0xdb, 0xad, 0x79, 0xa7, 0x87, 0xc3, 0x68,
+	0x83, 0xff, 0x15, 0x32, 0xad, 0x45, 0x37, 0xa2, 0xbd, 0x88, 0x6e, 0xf4, 0xa8, 0xcf, 0xbe, 0xed,
+	0x51, 0x5f, 0x32, 0x2e, 0x08, 0x46, 0x97, 0x8f, 0x36, 0xc4, 0x40, 0xb2, 0x16, 0xfc, 0xc8, 0x8f,
+	0x04, 0x9d, 0xfd, 0x92, 0xd4, 0x4b, 0x45, 0x3d, 0x62, 0x87, 0x38, 0xbd, 0xf4, 0x9b, 0xb5, 0x22,
+	0xd7, 0x8d, 0xc2, 0x04, 0x85, 0x49, 0xd7, 0xc3, 0x34, 0x21, 0x78, 0xbf, 0x9f, 0xe0, 0x28, 0x94,
+	0xb2, 0x57, 0x86, 0x66, 0x22, 0xe8, 0x08, 0x0d, 0xba, 0xfb, 0xfd, 0xd0, 0x0b, 0x90, 0x10, 0xea,
+	0xfc, 0xb9, 0x04, 0xb3, 0x3b, 0xd4, 0xff, 0x24, 0xf6, 0x9c, 0x04, 0x3d, 0xe6, 0x4b, 0x59, 0x1f,
+	0x41, 0xcd, 0xe9, 0x27, 0x87, 0x11, 0xc1, 0xc9, 0xa0, 0x59, 0x5a, 0x2e, 0xad, 0xd6, 0x36, 0x9b,
+	0x7f, 0xfd, 0xd3, 0xad, 0x05, 0xa9, 0xfd, 0x7d, 0xcf, 0x23, 0x88, 0xd2, 0xdd, 0x84, 0xe0, 0xd0,
+	0xb7, 0x33, 0x51, 0xeb, 0x1e, 0x4c, 0x0a, 0x65, 0x9b, 0x13, 0xcb, 0xa5, 0xd5, 0xfa, 0x9d, 0xc5,
+	0xf5, 0x02, 0x72, 0xeb, 0x62, 0x81, 0xcd, 0xda, 0x8b, 0xaf, 0x96, 0xce, 0xfc, 0xe1, 0xf5, 0xf3,
+	0xb5, 0x92, 0x2d, 0xbf, 0xb8, 0x77, 0xfb, 0x87, 0xaf, 0x9f, 0xaf, 0x65, 0x73, 0xfd, 0xf4, 0xf5,
+	0xf3, 0xb5, 0xb6, 0xd4, 0xff, 0x99, 0xb4, 0xa0, 0xa0, 0x66, 0xe7, 0x02, 0x2c, 0x16, 0x48, 0x36,
+	0xa2, 0x71, 0x14, 0x52, 0xd4, 0xf9, 0xed, 0x04, 0x4c, 0xef, 0x50, 0x7f, 0x8b, 0x20, 0xc6, 0x8b,
+	0x68, 0x62, 0xdd, 0x81, 0x29, 0x97, 0x8d, 0x22, 0x72, 0xac, 0x45, 0xa9, 0xa0, 0xb5, 0x00, 0x67,
+	0x13, 0x9c, 0x04, 0x88, 0x9b, 0x53, 0xb3, 0xc5, 0xc0, 0x6a, 0xc2, 0x94, 0x04, 0xbd, 0x59, 0xe6,
+	0xf4, 0x74, 0x68, 0x5d, 0x84, 0x5a, 0x0f, 0x79, 0xd8, 0xe9, 0xf6, 0x49, 0xd0, 0xac, 0x70, 0x5e,
+	0x95, 0x13, 0x3e, 0x21, 0x81, 0x75, 0x19, 0x40, 0x30, 0x93, 0x41, 0x8c, 0x9a, 0x67, 0x39, 0x57,
+	0x88, 0xef, 0x0d, 0x62, 0x64, 0x5d, 0x80, 0xaa, 0x4f, 0xa2, 0x7e, 0xdc, 0xc5, 0x5e, 0x73, 0x72,
+	0xb9, 0xb4, 0x5a, 0xb1, 0xa7, 0xf8, 0x78, 0xdb, 0xb3, 0x56, 0xa0, 0xf1, 0xbd, 0x7e, 0x94, 0x20,
+	0xaf, 0x8b, 0x43, 0x0f, 0x3d, 0x6b, 0x4e, 0xf1, 0x6f, 0xeb, 0x82, 0xb6, 0xcd, 0x48, 0xd6, 0x35,
+	0x98, 0xa1, 0x51, 0x9f, 0xb8, 0x48, 0x88, 0x20, 0xda, 0xac, 0x2e, 0x97, 0x57, 0x6b, 0xf6, 0xb4,
+	0xa0, 0x6e, 0x0b, 0xe2, 0xbd, 0x06, 0x03, 0x39, 0x35, 0xaf, 0x73, 0x0b, 0xce, 0xe7, 0x30, 0x4a,
+	0xd1, 0x63, 0x76, 0x8b, 0x95, 0x4a, 0xc2, 0x6e, 0x3e, 0xe8, 0xfc, 0xbb, 0x04, 0x33, 0x3b, 0xd4,
+	0xb7, 0x51, 0x1c, 0x0c, 0xf6, 0xa2, 0x13, 0x83, 0xba, 0x02, 0x8d, 0xd8, 0x21, 0x6c, 0xcb, 0x8a,
+	0x35, 0x04, 0xb6, 0x75, 0x41, 0x13, 0xd6, 0xbc, 0x1b, 0x84, 0x8b, 0x30, 0x4e, 0x0e, 0xc1, 0x58,
+	0xc0, 0x67, 0x1d, 0x3e, 0xc8, 0xdb, 0x7b, 0x0c, 0x40, 0x5f, 0x94, 0xa0, 0xbe, 0x43, 0xfd, 0x4f,
+	0xa3, 0xb7, 0xd8, 0x72, 0x97, 0x01, 0xd8, 0x66, 0xcf, 0x61, 0x53, 0x63, 0x14, 0x81, 0xcc, 0x45,
+	0xa8, 0x3d, 0x8d, 0x12, 0x24, 0x2c, 0x14, 0xd8, 0x54, 0x19, 0x81, 0x19, 0x58, 0xd0, 0xfe, 0x3c,
+	0x9c, 0xd3, 0x94, 0x51, 0x91, 0xf1, 0x7a, 0x82, 0xd3, 0x85, 0xd7, 0x77, 0x23, 0x17, 0x3b, 0xc1,
+	0x89, 0x95, 0x6d, 0xa6, 0x30, 0x70, 0x3d, 0x37, 0x27, 0x9a, 0x25, 0x09, 0x45, 0x16, 0x39, 0xe5,
+	0x11, 0x91, 0x53, 0x19, 0xe3, 0xd7, 0xb3, 0x63, 0xfd, 0x3a, 0x39, 0x2e, 0x72, 0xa6, 0xf2, 0x91,
+	0xf3, 0x01, 0x4c, 0x8a, 0x8c, 0xd2, 0xac, 0xf2, 0xaf, 0xe4, 0x88, 0x29, 0xd2, 0x8f, 0x19, 0x6e,
+	0xb4, 0x59, 0x13, 0x5f, 0xc8, 0xa1, 0x75, 0x09, 0x6a, 0x5e, 0xf4, 0x79, 0x28, 0x78, 0xc0, 0x79,
+	0x19, 0x81, 0x69, 0xc2, 0x6d, 0x47, 0x5e, 0xd7, 0x49, 0x9a, 0x75, 0xc1, 0x96, 0x94, 0xfb, 0x49,
+	0xc1, 0x01, 0x77, 0xe1, 0xa2, 0x01, 0xe8, 0x63, 0xf6, 0xd0, 0x2b, 0xe1, 0x1e, 0x91, 0xd4, 0xde,
+	0xd2, 0x3d, 0x0b, 0x39, 0xf7, 0xbc, 0x77, 0x4d, 0xe6, 0x9a, 0xcb, 0xdc, 0x35, 0x45, 0x90, 0x55,
+	0x8c, 0xf4, 0xb8, 0x0f, 0x1e, 0xa0, 0x00, 0xbd, 0x1b, 0x1f, 0x18, 0xb5, 0x29, 0x2e, 0xa7, 0xb4,
+	0xf9, 0x57, 0x49, 0x3b, 0xcb, 0x58, 0x3c, 0x9f, 0xe2, 0x66, 0xb8, 0x02, 0xd3, 0x0c, 0x3e, 0xd2,
+	0x75, 0xc4, 0x57, 0x72, 0x53, 0x34, 0x38, 0x51, 0xce, 0x54, 0xc8, 0x49, 0x95, 0xb1, 0x39, 0xe9,
+	0x6c, 0x3e, 0x27, 0x31, 0xa7, 0x25, 0xb8, 0x87, 0x68, 0xe2, 0xf4, 0x62, 0xbe, 0x3f, 0xca, 0x76,
+	0x46, 0x28, 0xe0, 0xb0, 0xa8, 0x9d, 0x47, 0xcc, 0xce, 0x22, 0x02, 0xc2, 0x5f, 0xff, 0xfb, 0x08,
+	0x64, 0x76, 0x2a, 0x04, 0x7c, 0x0e, 0x80, 0xd8, 0x22, 0xa7, 0x0b, 0x80, 0x51, 0x83, 0x6c, 0x21,
+	0xa5, 0xc1, 0xef, 0x27, 0x78, 0x9d, 0x98, 0xa6, 0x33, 0x56, 0x55, 0x9c, 0xa2, 0x17, 0xe6, 0xa0,
+	0xcc, 0xd2, 0x8b, 0xc0, 0x9e, 0xfd, 0xcc, 0xd2, 0x54, 0x45, 0x4f, 0x53, 0xcb, 0x50, 0xf7, 0x10,
+	0x75, 0x09, 0x8e, 0x59, 0x9d, 0x2b, 0xb1, 0xd6, 0x49, 0xd6, 0x4d, 0x98, 0x77, 0x09, 0xf2, 0xf0,
+	0x3e, 0x0e, 0x70, 0x32, 0xe8, 0x52, 0x37, 0x22, 0x48, 0xc2, 0x3e, 0xa7, 0x31, 0x76, 0x19, 0xdd,
+	0xba, 0x01, 0x73, 0x4e, 0xe8, 0x04, 0x03, 0x8a, 0x69, 0x97, 0xf6, 0x7b, 0x3d, 0x87, 0x0c, 0x64,
+	0x75, 0x35, 0x9b, 0xd2, 0x77, 0x05, 0xd9, 0x6a, 0x41, 0xf5, 0x29, 0x22, 0xf8, 0x00, 0x23, 0x8f,
+	0x67, 0xac, 0xaa, 0xad, 0xc6, 0x05, 0x08, 0x45, 0x59, 0xaa, 0x03, 0x55, 0x04, 0x31, 0x4d, 0x3c,
+	0xef, 0x41, 0x3c, 0x06, 0x44, 0x1d, 0x28, 0x05, 0x22, 0xe6, 0x18, 0xa6, 0xe9, 0xf2, 0x74, 0x31,
+	0x34, 0x6a, 0xa1, 0x2f, 0xa5, 0xb4, 0xf8, 0xa5, 0xc8, 0x49, 0x2c, 0x46, 0xde, 0x42, 0x89, 0x15,
+	0x68, 0xe8, 0x75, 0x7b, 0x5a, 0x0c, 0x6b, 0x55, 0xfb, 0xd7, 0x29, 0xf9, 0x1e, 0xf0, 0xe0, 0xcd,
+	0x54, 0x52, 0xb5, 0x86, 0xd1, 0x9f, 0x25, 0xb3, 0x3f, 0x3b, 0x3f, 0x12, 0x37, 0xc2, 0x4f, 0x99,
+	0x63, 0x06, 0xa7, 0xbe, 0x49, 0xf5, 0x2d, 0x50, 0x1e, 0xbb, 0x05, 0x3e, 0xe6, 0xe0, 0xeb, 0x6a,
+	0x9c, 0xcc, 0x9e, 0x1f, 0x4f, 0xc0, 0x5c, 0xee, 0x9e, 0xb3, 0xe7, 0xf8, 0xa7, 0x68, 0x50, 0xfe,
+	0x6c, 0x28, 0x17, 0xcf, 0x86, 0x39, 0x28, 0x27, 0x8e, 0x2f, 0x03, 0x90, 0xfd, 0x64, 0x08, 0xb8,
+	0x4e, 0x82, 0xfc, 0x88, 0x0c, 0xd2, 0xc3, 0x22, 0x1d, 0xb3, 0x58, 0xa2, 0xb8, 0x87, 0x03, 0x87,
+	0x14, 0xe3, 0x6e, 0x36, 0xa3, 0x8b, 0xb0, 0xbb, 0x02, 0xd3, 0x04, 0x05, 0xbc, 0xe0, 0xe1, 0xd7,
+	0x63, 0x19, 0x73, 0x0d, 0x49, 0x64, 0x86, 0x16, 0x2f, 0x7c, 0x2d, 0x68, 0x16, 0x81, 0x50, 0xfb,
+	0x59, 0xa2, 0x24, 0x6f, 0xd3, 0xef, 0x51, 0xca, 0x01, 0xa1, 0x50, 0xfa, 0x2e, 0x07, 0x49, 0x24,
+	0x84, 0x53, 0x07, 0xc9, 0xa8, 0x47, 0x6e, 0x2d, 0xa5, 0xc7, 0x3f, 0x26, 0x60, 0x81, 0x31, 0xd3,
+	0xa6, 0x0f, 0xda, 0x92, 0xd5, 0xfa, 0x09, 0xef, 0x9c, 0x69, 0x17, 0x09, 0x7b, 0xe9, 0x9d, 0x53,
+	0x52, 0x44, 0xfb, 0x41, 0x35, 0x99, 0x9c, 0xc4, 0xe1, 0xce, 0x6b, 0xd8, 0x75, 0x49, 0x7b, 0xe0,
+	0x24, 0x8e, 0xf5, 0x0d, 0xa8, 0xf6, 0x50, 0xe2, 0x70, 0x76, 0x85, 0xb7, 0x7e, 0x96, 0x87, 0x5a,
+	0x3f, 0x52, 0xc3, 0x1d, 0x29, 0x67, 0xab, 0x2f, 0xac, 0x0f, 0x61, 0x36, 0x71, 0x88, 0x8f, 0x92,
+	0x2e, 0x41, 0x71, 0x80, 0x5d, 0x87, 0x72, 0x8f, 0x4f, 0xdb, 0x33, 0x82, 0x6c, 0x4b, 0xaa, 0x75,
+	0x1b, 0x16, 0xa4, 0x04, 0x3b, 0xa5, 0xba, 0x34, 0x21, 0x6c, 0x47, 0x0c, 0xe4, 0xbd, 0xe3, 0x9c,
+	0xc6, 0xdb, 0x95, 0x2c, 0x36, 0x77, 0x4c, 0xd0, 0x01, 0x22, 0x04, 0x79, 0xdd, 0x30, 0xf2, 0x10,
+	0xdb, 0x01, 0xe5, 0xd5, 0x9a, 0x3d, 0xa3, 0xc8, 0x8f, 0x18, 0xb5, 0x80, 0xfd, 0x17, 0x25, 0xb8,
+	0x64, 0xc2, 0x57, 0x65, 0xa0, 0x8b, 0x50, 0xc3, 0xf1, 0x01, 0xed, 0x1e, 0x3a, 0xf4, 0x50, 0xde,
+	0xe0, 0xaa, 0x8c, 0xf0, 0xd0, 0xa1, 0x87, 0xd6, 0x35, 0x98, 0x71, 0x28, 0xc5, 0x7e, 0xa8, 0xd6,
+	0x9c, 0x10, 0xdd, 0x98, 0x94, 0xca, 0x97, 0x64, 0xba, 0xe9, 0x5d, 0x3b, 0x06, 0xbe, 0x08, 0x8c,
+	0x19, 0x9d, 0xbc, 0xed, 0x75, 0x7e, 0x32, 0x01, 0xf3, 0x3b, 0xd4, 0xdf, 0x1d, 0x84, 0xee, 0xc3,
+	0xfe, 0xfe, 0xdb, 0xb8, 0x7a, 0x09, 0xe4, 0xd9, 0xc2, 0xf5, 0x92, 0xbe, 0x06, 0x41, 0x62, 0x4a,
+	0x31, 0x01, 0xe9, 0x0b, 0x2e, 0x20, 0xf4, 0x01, 0x41, 0x4a, 0x05, 0xb2, 0xcd, 0x42, 0x9b, 0x15,
+	0x6e, 0x18, 0xa8, 0xdd, 0x42, 0xf9, 0x12, 0x83, 0xd0, 0xed, 0xf6, 0x50, 0x72, 0x18, 0x79, 0x32,
+	0x76, 0x81, 0x91, 0x76, 0x38, 0xc5, 0x5a, 0x87, 0x73, 0x81, 0x43, 0x93, 0x2e, 0x97, 0x2a, 0x96,
+	0xc6, 0xf3, 0x8c, 0xc5, 0x0c, 0xdd, 0x1b, 0x51, 0x22, 0xff, 0xac, 0x04, 0x17, 0x86, 0xb0, 0x50,
+	0x6e, 0x59, 0x84, 0x29, 0x3e, 0x2d, 0xf6, 0xa4, 0x53, 0x26, 0xd9, 0x70, 0xdb, 0x63, 0x58, 0x23,
+	0x9a, 0xe0, 0x1e, 0xcf, 0x04, 0xfb, 0x83, 0x04, 0x89, 0x1e, 0x65, 0xc5, 0x9e, 0x51, 0xe4, 0x4d,
+	0x46, 0xb5, 0x6e, 0x81, 0x95, 0x09, 0x7a, 0x7d, 0xc2, 0xb7, 0x13, 0xc7, 0xa1, 0x6c, 0xcf, 0x2b,
+	0xce, 0x03, 0xc9, 0xe8, 0xfc, 0x51, 0x04, 0xe2, 0x2e, 0x0a, 0xbd, 0x5d, 0xec, 0x87, 0x4e, 0xb0,
+	0x83, 0x28, 0x75, 0xfc, 0x93, 0x9d, 0x98, 0xd7, 0x60, 0x86, 0x20, 0x17, 0xc7, 0x98, 0xa1, 0xab,
+	0x39, 0x68, 0x5a, 0x51, 0xb9, 0x0b, 0x58, 0xbc, 0x1e, 0x3a, 0x61, 0x88, 0x82, 0x6c, 0xcb, 0xd4,
+	0x24, 0x65, 0xdb, 0x63, 0x87, 0x23, 0x0a, 0x5d, 0x32, 0x88, 0x79, 0xd2, 0x73, 0x06, 0x41, 0xe4,
+	0x78, 0x3c, 0x2a, 0x1b, 0xf6, 0x9c, 0x62, 0x3c, 0x16, 0x74, 0x16, 0xdc, 0x3d, 0xa1, 0xb1, 0x7e,
+	0x7b, 0xa9, 0x4b, 0x5a, 0x7a, 0x81, 0x61, 0xbb, 0xd6, 0x49, 0xfa, 0x44, 0x5d, 0xf1, 0x15, 0x81,
+	0xbb, 0x1b, 0x85, 0x1e, 0x22, 0x42, 0xe1, 0x29, 0xe9, 0x6e, 0x4e, 0x62, 0xda, 0x16, 0xdc, 0x17,
+	0xf0, 0xb8, 0x1a, 0x82, 0x4b, 0x39, 0x90, 0x37, 0x14, 0x84, 0x3e, 0xca, 0x87, 0x35, 0x49, 0xd9,
+	0xf6, 0x98, 0x77, 0x3c, 0x14, 0xe0, 0xa7, 0x88, 0x0c, 0xba, 0x6e, 0x14, 0x1e, 0x60, 0xd2, 0x43,
+	0x22, 0x65, 0x55, 0xed, 0xf9, 0x94, 0xb3, 0x95, 0x32, 0xd8, 0x66, 0x99, 0xd9, 0xa1, 0xfe, 0x7d,
+	0xf7, 0x48, 0xae, 0x43, 0x4f, 0xe4, 0x97, 0x45, 0x98, 0x62, 0xc6, 0x65, 0xd9, 0x71, 0x92, 0x0d,
+	0xb7, 0x3d, 0x66, 0x7c, 0xa6, 0x2d, 0xbb, 0x3a, 0xf2, 0x60, 0x50, 0xea, 0x52, 0x63, 0x43, 0x51,
+	0xd3, 0x46, 0x6f, 0x06, 0x39, 0xee, 0x11, 0x12, 0x16, 0x57, 0x6c, 0x31, 0xe8, 0xfc, 0x5a, 0xd4,
+	0x98, 0xf7, 0xdd, 0x23, 0x99, 0x02, 0xdf, 0x45, 0x7a, 0xd7, 0x8c, 0x2b, 0xe7, 0x8c, 0x9b, 0x83,
+	0xb2, 0x8b, 0xbd, 0xf4, 0x4c, 0x76, 0x71, 0xb1, 0x3e, 0xdb, 0xe4, 0xd5, 0x66, 0xa6, 0x9c, 0x32,
+	0xe6, 0x06, 0xcc, 0xb9, 0x7d, 0xc2, 0x5b, 0xbc, 0x2a, 0xa1, 0x97, 0x78, 0x42, 0x9f, 0x95, 0xf4,
+	0x34, 0xa3, 0x77, 0x7e, 0x57, 0x02, 0x8b, 0x4d, 0x12, 0xd2, 0xcf, 0x11, 0xd9, 0x3a, 0x74, 0x82,
+	0x00, 0x85, 0xfe, 0x89, 0x4b, 0x69, 0x37, 0x9d, 0x20, 0x35, 0xb4, 0x62, 0xd7, 0x15, 0x6d, 0xdb,
+	0x63, 0x28, 0xbb, 0x87, 0xfd, 0xf0, 0x48, 0x1e, 0x61, 0x62, 0xc0, 0xa8, 0x31, 0x89, 0xa2, 0x03,
+	0x9e, 0xcb, 0x1a, 0xb6, 0x18, 0x14, 0x6c, 0xfd, 0x7f, 0x68, 0x0d, 0xab, 0xa9, 0x0c, 0xfe, 0x00,
+	0x26, 0x63, 0x87, 0x52, 0xe9, 0xbe, 0xaa, 0x2d, 0x47, 0x9d, 0xdf, 0x94, 0x38, 0x44, 0x36, 0x8a,
+	0x23, 0xc2, 0x93, 0xda, 0x63, 0x12, 0xf9, 0xbc, 0xa1, 0x70, 0xc2, 0x5d, 0x98, 0xe6, 0xb6, 0x89,
+	0x5c, 0x6e, 0xbb, 0x09, 0xf3, 0x3c, 0xa3, 0x75, 0x13, 0xe2, 0x84, 0x54, 0x1c, 0x6a, 0xdc, 0xc4,
+	0x8a, 0x3d, 0xc7, 0x19, 0x7b, 0x19, 0xbd, 0x60, 0xd7, 0x12, 0x5c, 0x36, 0x2a, 0xa8, 0x0a, 0x8d,
+	0xbf, 0x8b, 0xcb, 0xc0, 0x56, 0xd4, 0x8b, 0xf9, 0x25, 0x68, 0x10, 0xba, 0xa7, 0xab, 0x7c, 0x13,
+	0xa6, 0x68, 0xdf, 0x75, 0xd3, 0xce, 0x4b, 0xd5, 0x4e, 0x87, 0x66, 0xb3, 0x2a, 0x66, 0xb3, 0x58,
+	0xea, 0x3c, 0x70, 0x70, 0xd0, 0x27, 0xa8, 0x4b, 0x90, 0x43, 0xd5, 0xb5, 0x76, 0x5a, 0x52, 0x6d,
+	0x4e, 0x34, 0xdf, 0xd4, 0x35, 0xdb, 0x94, 0xdd, 0x3f, 0x2f, 0xf3, 0x58, 0x7d, 0xdc, 0xdf, 0x0f,
+	0x30, 0x3d, 0x7c, 0xcc, 0x1f, 0xce, 0x36, 0xf9, 0xbb, 0xd9, 0xe9, 0x66, 0x90, 0x15, 0x68, 0x60,
+	0x0f, 0x85, 0x09, 0x2b, 0x64, 0x8f, 0xd0, 0x20, 0x2d, 0xae, 0x52, 0xda, 0x37, 0xd1, 0x80, 0x67,
+	0x58, 0xec, 0x87, 0x38, 0xf4, 0xb9, 0x84, 0xc8, 0xe4, 0x20, 0x49, 0x4c, 0x60, 0x95, 0x95, 0xc3,
+	0xbc, 0xd8, 0x90, 0x0f, 0x7c, 0xd8, 0x4b, 0x0b, 0x28, 0x41, 0x17, 0xea, 0x6f, 0x7b, 0xac, 0x1a,
+	0xce, 0x49, 0xf2, 0x74, 0xde, 0xb0, 0x1b, 0xba, 0x98, 0xf5, 0x11, 0x2c, 0xe6, 0xa7, 0xcb, 0xb2,
+	0xff, 0x14, 0x17, 0x3f, 0xaf, 0x8b, 0xef, 0xaa, 0x93, 0xe0, 0x11, 0xcc, 0x45, 0x21, 0xe2, 0x27,
+	0xba, 0xfc, 0x52, 0xbc, 0x42, 0xd5, 0xef, 0xb4, 0x87, 0x8a, 0xc1, 0x6f, 0x85, 0x88, 0x1d, 0xf0,
+	0x12, 0xd7, 0xca, 0x8b, 0xaf, 0x96, 0xce, 0xd8, 0x33, 0x91, 0x4e, 0x2c, 0xe6, 0xce, 0x65, 0x68,
+	0x9b, 0xfd, 0xa1, 0x5c, 0xf6, 0x97, 0x12, 0x0f, 0x52, 0x96, 0x5b, 0x50, 0x88, 0xe9, 0x61, 0x6e,
+	0x8d, 0x53, 0x4e, 0xfc, 0x26, 0x5b, 0xcb, 0xa7, 0x66, 0xeb, 0x13, 0xe8, 0x8c, 0x36, 0x44, 0x65,
+	0x9d, 0xdb, 0x70, 0xbe, 0xa0, 0x43, 0xd7, 0x8d, 0xfa, 0x61, 0x22, 0xcf, 0x10, 0x2b, 0xb7, 0xc4,
+	0x16, 0xe3, 0x74, 0x7e, 0x55, 0xe2, 0xd5, 0xca, 0x56, 0xe0, 0xe0, 0xde, 0x5b, 0xef, 0xe9, 0xeb,
+	0x30, 0xeb, 0xb2, 0x89, 0xe4, 0xd1, 0x9f, 0x81, 0x34, 0x2d, 0xc9, 0x8f, 0x04, 0x56, 0xa3, 0x0e,
+	0x98, 0x82, 0xd1, 0xcf, 0x45, 0xc9, 0x3d, 0xa4, 0x9b, 0xb2, 0xf7, 0x21, 0x4c, 0xe7, 0x1e, 0xb0,
+	0xb9, 0xa6, 0xf5, 0x3b, 0x97, 0x87, 0x1f, 0x99, 0xb5, 0xaf, 0x25, 0xde, 0x8d, 0x58, 0xb7, 0xf6,
+	0x63, 0x98, 0x2d, 0x20, 0x27, 0x1f, 0xac, 0x8f, 0x71, 0x9e, 0x3d, 0x9d, 0xc3, 0xf4, 0xce, 0x7f,
+	0xce, 0x43, 0x79, 0x87, 0xfa, 0xd6, 0x67, 0xd0, 0xc8, 0xbd, 0x9f, 0x0f, 0x5f, 0x7e, 0x0a, 0xef,
+	0xd4, 0xad, 0xd5, 0xe3, 0x24, 0x94, 0xd5, 0x7b, 0x00, 0xda, 0x2b, 0x76, 0xdb, 0xf4, 0x5d, 0xc6,
+	0x6f, 0x5d, 0x1f, 0xcf, 0x57, 0xb3, 0x3e, 0x82, 0xaa, 0x7a, 0xa6, 0xbc, 0x64, 0xfa, 0x26, 0xe5,
+	0xb6, 0xae, 0x8e, 0xe3, 0xaa, 0xf9, 0x9e, 0x40, 0x5d, 0x7f, 0x17, 0x5e, 0x32, 0x7d, 0xa4, 0x09,
+	0xb4, 0x3e, 0x3c, 0x46, 0x40, 0x4d, 0x7c, 0x00, 0x73, 0x43, 0x4f, 0x95, 0x57, 0x47, 0x1b, 0x99,
+	0x49, 0xb5, 0xfe, 0xef, 0x4d, 0xa4, 0xf4, 0x75, 0x86, 0xde, 0xdc, 0xae, 0x8e, 0x76, 0xd2, 0x71,
+	0xeb, 0x8c, 0x7a, 0x5a, 0x62, 0xeb, 0x0c, 0xbd, 0x2b, 0x19, 0xd7, 0x29, 0x4a, 0x99, 0xd7, 0x19,
+	0xf5, 0x68, 0x94, 0x6d, 0x1b, 0xfe, 0x5a, 0x30, 0x66, 0xdb, 0x30, 0xfe, 0xb8, 0x6d, 0xa3, 0x3f,
+	0x02, 0xb0, 0x59, 0xb5, 0x47, 0x98, 0xf6, 0x68, 0xcb, 0x47, 0xcf, 0x3a, 0xfc, 0xb8, 0xc1, 0x66,
+	0xd5, 0x5e, 0x36, 0xda, 0xa3, 0xed, 0x1c, 0x3d, 0xeb, 0xf0, 0x83, 0x05, 0x0b, 0xca, 0xdc, 0x63,
+	0xc5, 0xf2, 0xb8, 0xfd, 0xc0, 0x24, 0xcc, 0x41, 0x69, 0xea, 0xe3, 0x67, 0x01, 0x3f, 0x6e, 0x6e,
+	0x5d, 0x62, 0x5c, 0xc0, 0x0f, 0xcf, 0x9d, 0xeb, 0x6d, 0x2f, 0x8f, 0xf3, 0xfb, 0xe8, 0xb9, 0x4d,
+	0x4d, 0x6b, 0x86, 0xb4, 0xd6, 0xb0, 0x6e, 0x8f, 0x0a, 0x6d, 0x39, 0xef, 0xf5, 0xf1, 0x7c, 0x5d,
+	0xe3, 0x5c, 0xb3, 0xd8, 0xa8, 0xb1, 0x2e, 0x61, 0xd6, 0xd8, 0xd8, 0xe9, 0xfd, 0x36, 0x4c, 0xe7,
+	0x1b, 0xb7, 0x2b, 0xe3, 0x33, 0xdc, 0x9e, 0xe3, 0xb7, 0x6e, 0x1c, 0x2b, 0xa2, 0x4f, 0x9f, 0xef,
+	0x78, 0xae, 0x8c, 0x49, 0xcc, 0xe3, 0xa6, 0x37, 0xb6, 0x0b, 0xd9, 0xf4, 0xf9, 0x5e, 0xe1, 0xca,
+	0x68, 0x57, 0x8d, 0x9d, 0xde, 0xd8, 0x05, 0xb4, 0x30, 0xcc, 0x0f, 0x77, 0x00, 0xaf, 0x19, 0xbf,
+	0x2f, 0x8a, 0xb5, 0x6e, 0xbd, 0x91, 0x98, 0x5a, 0xea, 0x3b, 0x30, 0x53, 0x68, 0x3f, 0x75, 0x4c,
+	0x13, 0xe4, 0x65, 0x5a, 0x6b, 0xc7, 0xcb, 0xe8, 0xc6, 0x0c, 0x77, 0x51, 0x8c, 0xc6, 0x0c, 0x89,
+	0x99, 0x8d, 0x19, 0xdd, 0x64, 0xd8, 0x03, 0xd0, 0xee, 0xd4, 0xc6, 0x30, 0xc8, 0xf8, 0xe6, 0x30,
+	0x30, 0x5c, 0x7b, 0x5d, 0x98, 0x2d, 0xde, 0x63, 0xaf, 0x18, 0x3f, 0xcd, 0x0b, 0xb5, 0x6e, 0xbe,
+	0x81, 0x90, 0x5a, 0x24, 0x00, 0xcb, 0x70, 0x9d, 0xbc, 0x3e, 0xe2, 0x38, 0x2d, 0xc8, 0xb5, 0xd6,
+	0xdf, 0x4c, 0x2e, 0x97, 0x43, 0xf5, 0x9b, 0x9f, 0x39, 0x87, 0x6a, 0x12, 0x23, 0x72, 0xa8, 0xe1,
+	0x86, 0x65, 0x45, 0x70, 0xce, 0x74, 0xbb, 0x32, 0x56, 0x06, 0x06, 0xc1, 0xd6, 0xc6, 0x1b, 0x0a,
+	0xaa, 0x05, 0xbf, 0x0f, 0x8b, 0xa3, 0xee, 0x06, 0x37, 0x47, 0x95, 0x23, 0x06, 0xe1, 0xd6, 0xdd,
+	0xaf, 0x21, 0xac, 0xef, 0xee, 0xe1, 0xaa, 0xdb, 0xb8, 0xbb, 0x87, 0xc4, 0xcc, 0xbb, 0x7b, 0x74,
+	0x9d, 0xfc, 0x04, 0xea, 0x7a, 0xc3, 0x6b, 0x69, 0xc4, 0xf6, 0x4d, 0x05, 0xcc, 0xb5, 0x98, 0xa1,
+	0x49, 0xd5, 0x3a, 0xfb, 0x83, 0xd7, 0xcf, 0xd7, 0x4a, 0x9b, 0xeb, 0x2f, 0x5e, 0xb6, 0x4b, 0x5f,
+	0xbe, 0x6c, 0x97, 0xfe, 0xf9, 0xb2, 0x5d, 0xfa, 0xc5, 0xab, 0xf6, 0x99, 0x2f, 0x5f, 0xb5, 0xcf,
+	0xfc, 0xed, 0x55, 0xfb, 0xcc, 0x67, 0x0b, 0x85, 0x7f, 0xd8, 0x4c, 0x06, 0x31, 0xa2, 0xfb, 0x93,
+	0xfc, 0x1f, 0x4d, 0xef, 0xfe, 0x37, 0x00, 0x00, 0xff, 0xff, 0xa5, 0x72, 0x91, 0xde, 0x61, 0x2b,
+	0x00, 0x00,
 }
 
-func (m *MsgVotePost) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// UpdateParams defines a (governance) operation for updating the module
+	// parameters. The authority defaults to the x/gov module account.
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// CreatePost defines the CreatePost RPC.
+	CreatePost(ctx context.Context, in *MsgCreatePost, opts ...grpc.CallOption) (*MsgCreatePostResponse, error)
+	// VotePost defines the VotePost RPC.
+	VotePost(ctx context.Context, in *MsgVotePost, opts ...grpc.CallOption) (*MsgVotePostResponse, error)
+	// ReplyToPost defines the ReplyToPost RPC.
+	ReplyToPost(ctx context.Context, in *MsgReplyToPost, opts ...grpc.CallOption) (*MsgReplyToPostResponse, error)
+	// CreateSocialPost defines the CreateSocialPost RPC.
+	CreateSocialPost(ctx context.Context, in *MsgCreateSocialPost, opts ...grpc.CallOption) (*MsgCreateSocialPostResponse, error)
+	// UpdateSocialPost defines the UpdateSocialPost RPC.
+	UpdateSocialPost(ctx context.Context, in *MsgUpdateSocialPost, opts ...grpc.CallOption) (*MsgUpdateSocialPostResponse, error)
+	// DeleteSocialPost defines the DeleteSocialPost RPC.
+	DeleteSocialPost(ctx context.Context, in *MsgDeleteSocialPost, opts ...grpc.CallOption) (*MsgDeleteSocialPostResponse, error)
+	// CreateVote defines the CreateVote RPC.
+	CreateVote(ctx context.Context, in *MsgCreateVote, opts ...grpc.CallOption) (*MsgCreateVoteResponse, error)
+	// UpdateVote defines the UpdateVote RPC.
+	UpdateVote(ctx context.Context, in *MsgUpdateVote, opts ...grpc.CallOption) (*MsgUpdateVoteResponse, error)
+	// DeleteVote defines the DeleteVote RPC.
+	DeleteVote(ctx context.Context, in *MsgDeleteVote, opts ...grpc.CallOption) (*MsgDeleteVoteResponse, error)
+	// CreateSource defines the CreateSource RPC.
+	CreateSource(ctx context.Context, in *MsgCreateSource, opts ...grpc.CallOption) (*MsgCreateSourceResponse, error)
+	// UpdateSource defines the UpdateSource RPC.
+	UpdateSource(ctx context.Context, in *MsgUpdateSource, opts ...grpc.CallOption) (*MsgUpdateSourceResponse, error)
+	// DeleteSource defines the DeleteSource RPC.
+	DeleteSource(ctx context.Context, in *MsgDeleteSource, opts ...grpc.CallOption) (*MsgDeleteSourceResponse, error)
+	// VoteSource defines the VoteSource RPC used to vote on the credibility of
+	// a source.
+	VoteSource(ctx context.Context, in *MsgVoteSource, opts ...grpc.CallOption) (*MsgVoteSourceResponse, error)
+	// VerifySource defines the VerifySource RPC used by the module authority or
+	// an attestation issuer to mark a source as verified or not.
+	VerifySource(ctx context.Context, in *MsgVerifySource, opts ...grpc.CallOption) (*MsgVerifySourceResponse, error)
+	// CreatePostTag defines the CreatePostTag RPC.
+	CreatePostTag(ctx context.Context, in *MsgCreatePostTag, opts ...grpc.CallOption) (*MsgCreatePostTagResponse, error)
+	// UpdatePostTag defines the UpdatePostTag RPC.
+	UpdatePostTag(ctx context.Context, in *MsgUpdatePostTag, opts ...grpc.CallOption) (*MsgUpdatePostTagResponse, error)
+	// DeletePostTag defines the DeletePostTag RPC.
+	DeletePostTag(ctx context.Context, in *MsgDeletePostTag, opts ...grpc.CallOption) (*MsgDeletePostTagResponse, error)
+	// DistributeContent defines the DistributeContent RPC for IPFS and decentralized storage.
+	DistributeContent(ctx context.Context, in *MsgDistributeContent, opts ...grpc.CallOption) (*MsgDistributeContentResponse, error)
+	// SyncHubContent defines the SyncHubContent RPC for hub-to-hub synchronization.
+	SyncHubContent(ctx context.Context, in *MsgSyncHubContent, opts ...grpc.CallOption) (*MsgSyncHubContentResponse, error)
+	// SendSignalMessage defines the SendSignalMessage RPC for secure node communication.
+	SendSignalMessage(ctx context.Context, in *MsgSendSignalMessage, opts ...grpc.CallOption) (*MsgSendSignalMessageResponse, error)
+	// AckReplica defines the AckReplica RPC used by a node owner to acknowledge
+	// that the node stores its assigned replica.
+	AckReplica(ctx context.Context, in *MsgAckReplica, opts ...grpc.CallOption) (*MsgAckReplicaResponse, error)
+	// AnswerChallenge defines the AnswerChallenge RPC used by a node owner to
+	// answer a storage challenge with a Merkle proof of the challenged chunk.
+	AnswerChallenge(ctx context.Context, in *MsgAnswerChallenge, opts ...grpc.CallOption) (*MsgAnswerChallengeResponse, error)
+	// ReportSyncProgress defines the ReportSyncProgress RPC used by the target
+	// node owner to report the progress of a hub sync.
+	ReportSyncProgress(ctx context.Context, in *MsgReportSyncProgress, opts ...grpc.CallOption) (*MsgReportSyncProgressResponse, error)
+	// CompleteSync defines the CompleteSync RPC used by the target node owner to
+	// close a hub sync.
+	CompleteSync(ctx context.Context, in *MsgCompleteSync, opts ...grpc.CallOption) (*MsgCompleteSyncResponse, error)
+	// PublishPrekeyBundle defines the PublishPrekeyBundle RPC used by a node
+	// owner to publish the prekey bundle of the node.
+	PublishPrekeyBundle(ctx context.Context, in *MsgPublishPrekeyBundle, opts ...grpc.CallOption) (*MsgPublishPrekeyBundleResponse, error)
+	// ReplenishOneTimePrekeys defines the ReplenishOneTimePrekeys RPC used by a
+	// node owner to publish new one-time prekeys.
+	ReplenishOneTimePrekeys(ctx context.Context, in *MsgReplenishOneTimePrekeys, opts ...grpc.CallOption) (*MsgReplenishOneTimePrekeysResponse, error)
+	// ClaimPrekeyBundle defines the ClaimPrekeyBundle RPC used by a node owner
+	// to get the prekey bundle of another node, consuming one of its one-time
+	// prekeys.
+	ClaimPrekeyBundle(ctx context.Context, in *MsgClaimPrekeyBundle, opts ...grpc.CallOption) (*MsgClaimPrekeyBundleResponse, error)
+	// AckMessages defines the AckMessages RPC used by a node owner to remove
+	// the messages its node received from its mailbox.
+	AckMessages(ctx context.Context, in *MsgAckMessages, opts ...grpc.CallOption) (*MsgAckMessagesResponse, error)
 }
 
-func (m *MsgVotePost) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type msgClient struct {
+	cc grpc1.ClientConn
 }
 
-func (m *MsgVotePost) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.VoteType) > 0 {
-		i -= len(m.VoteType)
-		copy(dAtA[i:], m.VoteType)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.VoteType)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.PostIndex) > 0 {
-		i -= len(m.PostIndex)
-		copy(dAtA[i:], m.PostIndex)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.PostIndex)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/resist.posts.v1.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgVotePostResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) CreatePost(ctx context.Context, in *MsgCreatePost, opts ...grpc.CallOption) (*MsgCreatePostResponse, error) {
+	out := new(MsgCreatePostResponse)
+	err := c.cc.Invoke(ctx, "/resist.posts.v1.Msg/CreatePost", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgVotePostResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) VotePost(ctx context.Context, in *MsgVotePost, opts ...grpc.CallOption) (*MsgVotePostResponse, error) {
+	out := new(MsgVotePostResponse)
+	err := c.cc.Invoke(ctx, "/resist.posts.v1.Msg/VotePost", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgVotePostResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *msgClient) ReplyToPost(ctx context.Context, in *MsgReplyToPost, opts ...grpc.CallOption) (*MsgReplyToPostResponse, error) {
+	out := new(MsgReplyToPostResponse)
+	err := c.cc.Invoke(ctx, "/resist.posts.v1.Msg/ReplyToPost", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgCreateSocialPost) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) CreateSocialPost(ctx context.Context, in *MsgCreateSocialPost, opts ...grpc.CallOption) (*MsgCreateSocialPostResponse, error) {
+	out := new(MsgCreateSocialPostResponse)
+	err := c.cc.Invoke(ctx, "/resist.posts.v1.Msg/CreateSocialPost", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgCreateSocialPost) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) UpdateSocialPost(ctx context.Context, in *MsgUpdateSocialPost, opts ...grpc.CallOption) (*MsgUpdateSocialPostResponse, error) {
+	out := new(MsgUpdateSocialPostResponse)
+	err := c.cc.Invoke(ctx, "/resist.posts.v1.Msg/UpdateSocialPost", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgCreateSocialPost) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.CreatedAt != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.CreatedAt))
-		i--
-		dAtA[i] = 0x58
-	}
-	if m.Downvotes != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Downvotes))
-		i--
-		dAtA[i] = 0x50
-	}
-	if m.Upvotes != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Upvotes))
-		i--
-		dAtA[i] = 0x48
-	}
-	if len(m.Author) > 0 {
-		i -= len(m.Author)
-		copy(dAtA[i:], m.Author)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Author)))
-		i--
-		dAtA[i] = 0x42
-	}
-	if m.GroupId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.GroupId))
-		i--
-		dAtA[i] = 0x38
-	}
-	if len(m.MediaType) > 0 {
-		i -= len(m.MediaType)
-		copy(dAtA[i:], m.MediaType)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.MediaType)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.MediaUrl) > 0 {
-		i -= len(m.MediaUrl)
-		copy(dAtA[i:], m.MediaUrl)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.MediaUrl)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.Content) > 0 {
-		i -= len(m.Content)
-		copy(dAtA[i:], m.Content)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Content)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Title) > 0 {
-		i -= len(m.Title)
-		copy(dAtA[i:], m.Title)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Title)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Index) > 0 {
-		i -= len(m.Index)
-		copy(dAtA[i:], m.Index)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Index)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) DeleteSocialPost(ctx context.Context, in *MsgDeleteSocialPost, opts ...grpc.CallOption) (*MsgDeleteSocialPostResponse, error) {
+	out := new(MsgDeleteSocialPostResponse)
+	err := c.cc.Invoke(ctx, "/resist.posts.v1.Msg/DeleteSocialPost", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgCreateSocialPostResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) CreateVote(ctx context.Context, in *MsgCreateVote, opts ...grpc.CallOption) (*MsgCreateVoteResponse, error) {
+	out := new(MsgCreateVoteResponse)
+	err := c.cc.Invoke(ctx, "/resist.posts.v1.Msg/CreateVote", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgCreateSocialPostResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) UpdateVote(ctx context.Context, in *MsgUpdateVote, opts ...grpc.CallOption) (*MsgUpdateVoteResponse, error) {
+	out := new(MsgUpdateVoteResponse)
+	err := c.cc.Invoke(ctx, "/resist.posts.v1.Msg/UpdateVote", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgCreateSocialPostResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *msgClient) DeleteVote(ctx context.Context, in *MsgDeleteVote, opts ...grpc.CallOption) (*MsgDeleteVoteResponse, error) {
+	out := new(MsgDeleteVoteResponse)
+	err := c.cc.Invoke(ctx, "/resist.posts.v1.Msg/DeleteVote", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgUpdateSocialPost) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) CreateSource(ctx context.Context, in *MsgCreateSource, opts ...grpc.CallOption) (*MsgCreateSourceResponse, error) {
+	out := new(MsgCreateSourceResponse)
+	err := c.cc.Invoke(ctx, "/resist.posts.v1.Msg/CreateSource", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgUpdateSocialPost) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) UpdateSource(ctx context.Context, in *MsgUpdateSource, opts ...grpc.CallOption) (*MsgUpdateSourceResponse, error) {
+	out := new(MsgUpdateSourceResponse)
+	err := c.cc.Invoke(ctx, "/resist.posts.v1.Msg/UpdateSource", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgUpdateSocialPost) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.CreatedAt != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.CreatedAt))
-		i--
-		dAtA[i] = 0x58
-	}
-	if m.Downvotes != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Downvotes))
-		i--
-		dAtA[i] = 0x50
-	}
-	if m.Upvotes != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Upvotes))
-		i--
-		dAtA[i] = 0x48
-	}
-	if len(m.Author) > 0 {
-		i -= len(m.Author)
-		copy(dAtA[i:], m.Author)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Author)))
-		i--
-		dAtA[i] = 0x42
-	}
-	if m.GroupId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.GroupId))
-		i--
-		dAtA[i] = 0x38
-	}
-	if len(m.MediaType) > 0 {
-		i -= len(m.MediaType)
-		copy(dAtA[i:], m.MediaType)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.MediaType)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.MediaUrl) > 0 {
-		i -= len(m.MediaUrl)
-		copy(dAtA[i:], m.MediaUrl)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.MediaUrl)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.Content) > 0 {
-		i -= len(m.Content)
-		copy(dAtA[i:], m.Content)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Content)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Title) > 0 {
-		i -= len(m.Title)
-		copy(dAtA[i:], m.Title)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Title)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Index) > 0 {
-		i -= len(m.Index)
-		copy(dAtA[i:], m.Index)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Index)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) DeleteSource(ctx context.Context, in *MsgDeleteSource, opts ...grpc.CallOption) (*MsgDeleteSourceResponse, error) {
+	out := new(MsgDeleteSourceResponse)
+	err := c.cc.Invoke(ctx, "/resist.posts.v1.Msg/DeleteSource", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgUpdateSocialPostResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) VoteSource(ctx context.Context, in *MsgVoteSource, opts ...grpc.CallOption) (*MsgVoteSourceResponse, error) {
+	out := new(MsgVoteSourceResponse)
+	err := c.cc.Invoke(ctx, "/resist.posts.v1.Msg/VoteSource", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgUpdateSocialPostResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) VerifySource(ctx context.Context, in *MsgVerifySource, opts ...grpc.CallOption) (*MsgVerifySourceResponse, error) {
+	out := new(MsgVerifySourceResponse)
+	err := c.cc.Invoke(ctx, "/resist.posts.v1.Msg/VerifySource", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgUpdateSocialPostResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *msgClient) CreatePostTag(ctx context.Context, in *MsgCreatePostTag, opts ...grpc.CallOption) (*MsgCreatePostTagResponse, error) {
+	out := new(MsgCreatePostTagResponse)
+	err := c.cc.Invoke(ctx, "/resist.posts.v1.Msg/CreatePostTag", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgDeleteSocialPost) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) UpdatePostTag(ctx context.Context, in *MsgUpdatePostTag, opts ...grpc.CallOption) (*MsgUpdatePostTagResponse, error) {
+	out := new(MsgUpdatePostTagResponse)
+	err := c.cc.Invoke(ctx, "/resist.posts.v1.Msg/UpdatePostTag", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgDeleteSocialPost) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgDeleteSocialPost) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Index) > 0 {
-		i -= len(m.Index)
-		copy(dAtA[i:], m.Index)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Index)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) DeletePostTag(ctx context.Context, in *MsgDeletePostTag, opts ...grpc.CallOption) (*MsgDeletePostTagResponse, error) {
+	out := new(MsgDeletePostTagResponse)
+	err := c.cc.Invoke(ctx, "/resist.posts.v1.Msg/DeletePostTag", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgDeleteSocialPostResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) DistributeContent(ctx context.Context, in *MsgDistributeContent, opts ...grpc.CallOption) (*MsgDistributeContentResponse, error) {
+	out := new(MsgDistributeContentResponse)
+	err := c.cc.Invoke(ctx, "/resist.posts.v1.Msg/DistributeContent", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgDeleteSocialPostResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgDeleteSocialPostResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgCreateVote) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) SyncHubContent(ctx context.Context, in *MsgSyncHubContent, opts ...grpc.CallOption) (*MsgSyncHubContentResponse, error) {
+	out := new(MsgSyncHubContentResponse)
+	err := c.cc.Invoke(ctx, "/resist.posts.v1.Msg/SyncHubContent", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgCreateVote) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *MsgCreateVote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Timestamp != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Timestamp))
-		i--
-		dAtA[i] = 0x30
-	}
-	if len(m.VoteType) > 0 {
-		i -= len(m.VoteType)
-		copy(dAtA[i:], m.VoteType)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.VoteType)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.PostIndex) > 0 {
-		i -= len(m.PostIndex)
-		copy(dAtA[i:], m.PostIndex)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.PostIndex)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.VoterAddress) > 0 {
-		i -= len(m.VoterAddress)
-		copy(dAtA[i:], m.VoterAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.VoterAddress)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Index) > 0 {
-		i -= len(m.Index)
-		copy(dAtA[i:], m.Index)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Index)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) SendSignalMessage(ctx context.Context, in *MsgSendSignalMessage, opts ...grpc.CallOption) (*MsgSendSignalMessageResponse, error) {
+	out := new(MsgSendSignalMessageResponse)
+	err := c.cc.Invoke(ctx, "/resist.posts.v1.Msg/SendSignalMessage", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgCreateVoteResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) AckReplica(ctx context.Context, in *MsgAckReplica, opts ...grpc.CallOption) (*MsgAckReplicaResponse, error) {
+	out := new(MsgAckReplicaResponse)
+	err := c.cc.Invoke(ctx, "/resist.posts.v1.Msg/AckReplica", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgCreateVoteResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgCreateVoteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgUpdateVote) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) AnswerChallenge(ctx context.Context, in *MsgAnswerChallenge, opts ...grpc.CallOption) (*MsgAnswerChallengeResponse, error) {
+	out := new(MsgAnswerChallengeResponse)
+	err := c.cc.Invoke(ctx, "/resist.posts.v1.Msg/AnswerChallenge", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgUpdateVote) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *MsgUpdateVote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Timestamp != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Timestamp))
-		i--
-		dAtA[i] = 0x30
-	}
-	if len(m.VoteType) > 0 {
-		i -= len(m.VoteType)
-		copy(dAtA[i:], m.VoteType)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.VoteType)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.PostIndex) > 0 {
-		i -= len(m.PostIndex)
-		copy(dAtA[i:], m.PostIndex)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.PostIndex)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.VoterAddress) > 0 {
-		i -= len(m.VoterAddress)
-		copy(dAtA[i:], m.VoterAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.VoterAddress)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Index) > 0 {
-		i -= len(m.Index)
-		copy(dAtA[i:], m.Index)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Index)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) ReportSyncProgress(ctx context.Context, in *MsgReportSyncProgress, opts ...grpc.CallOption) (*MsgReportSyncProgressResponse, error) {
+	out := new(MsgReportSyncProgressResponse)
+	err := c.cc.Invoke(ctx, "/resist.posts.v1.Msg/ReportSyncProgress", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgUpdateVoteResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) CompleteSync(ctx context.Context, in *MsgCompleteSync, opts ...grpc.CallOption) (*MsgCompleteSyncResponse, error) {
+	out := new(MsgCompleteSyncResponse)
+	err := c.cc.Invoke(ctx, "/resist.posts.v1.Msg/CompleteSync", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgUpdateVoteResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgUpdateVoteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgDeleteVote) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) PublishPrekeyBundle(ctx context.Context, in *MsgPublishPrekeyBundle, opts ...grpc.CallOption) (*MsgPublishPrekeyBundleResponse, error) {
+	out := new(MsgPublishPrekeyBundleResponse)
+	err := c.cc.Invoke(ctx, "/resist.posts.v1.Msg/PublishPrekeyBundle", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgDeleteVote) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) ReplenishOneTimePrekeys(ctx context.Context, in *MsgReplenishOneTimePrekeys, opts ...grpc.CallOption) (*MsgReplenishOneTimePrekeysResponse, error) {
+	out := new(MsgReplenishOneTimePrekeysResponse)
+	err := c.cc.Invoke(ctx, "/resist.posts.v1.Msg/ReplenishOneTimePrekeys", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgDeleteVote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Index) > 0 {
-		i -= len(m.Index)
-		copy(dAtA[i:], m.Index)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Index)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) ClaimPrekeyBundle(ctx context.Context, in *MsgClaimPrekeyBundle, opts ...grpc.CallOption) (*MsgClaimPrekeyBundleResponse, error) {
+	out := new(MsgClaimPrekeyBundleResponse)
+	err := c.cc.Invoke(ctx, "/resist.posts.v1.Msg/ClaimPrekeyBundle", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgDeleteVoteResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) AckMessages(ctx context.Context, in *MsgAckMessages, opts ...grpc.CallOption) (*MsgAckMessagesResponse, error) {
+	out := new(MsgAckMessagesResponse)
+	err := c.cc.Invoke(ctx, "/resist.posts.v1.Msg/AckMessages", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgDeleteVoteResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// UpdateParams defines a (governance) operation for updating the module
+	// parameters. The authority defaults to the x/gov module account.
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// CreatePost defines the CreatePost RPC.
+	CreatePost(context.Context, *MsgCreatePost) (*MsgCreatePostResponse, error)
+	// VotePost defines the VotePost RPC.
+	VotePost(context.Context, *MsgVotePost) (*MsgVotePostResponse, error)
+	// ReplyToPost defines the ReplyToPost RPC.
+	ReplyToPost(context.Context, *MsgReplyToPost) (*MsgReplyToPostResponse, error)
+	// CreateSocialPost defines the CreateSocialPost RPC.
+	CreateSocialPost(context.Context, *MsgCreateSocialPost) (*MsgCreateSocialPostResponse, error)
+	// UpdateSocialPost defines the UpdateSocialPost RPC.
+	UpdateSocialPost(context.Context, *MsgUpdateSocialPost) (*MsgUpdateSocialPostResponse, error)
+	// DeleteSocialPost defines the DeleteSocialPost RPC.
+	DeleteSocialPost(context.Context, *MsgDeleteSocialPost) (*MsgDeleteSocialPostResponse, error)
+	// CreateVote defines the CreateVote RPC.
+	CreateVote(context.Context, *MsgCreateVote) (*MsgCreateVoteResponse, error)
+	// UpdateVote defines the UpdateVote RPC.
+	UpdateVote(context.Context, *MsgUpdateVote) (*MsgUpdateVoteResponse, error)
+	// DeleteVote defines the DeleteVote RPC.
+	DeleteVote(context.Context, *MsgDeleteVote) (*MsgDeleteVoteResponse, error)
+	// CreateSource defines the CreateSource RPC.
+	CreateSource(context.Context, *MsgCreateSource) (*MsgCreateSourceResponse, error)
+	// UpdateSource defines the UpdateSource RPC.
+	UpdateSource(context.Context, *MsgUpdateSource) (*MsgUpdateSourceResponse, error)
+	// DeleteSource defines the DeleteSource RPC.
+	DeleteSource(context.Context, *MsgDeleteSource) (*MsgDeleteSourceResponse, error)
+	// VoteSource defines the VoteSource RPC used to vote on the credibility of
+	// a source.
+	VoteSource(context.Context, *MsgVoteSource) (*MsgVoteSourceResponse, error)
+	// VerifySource defines the VerifySource RPC used by the module authority or
+	// an attestation issuer to mark a source as verified or not.
+	VerifySource(context.Context, *MsgVerifySource) (*MsgVerifySourceResponse, error)
+	// CreatePostTag defines the CreatePostTag RPC.
+	CreatePostTag(context.Context, *MsgCreatePostTag) (*MsgCreatePostTagResponse, error)
+	// UpdatePostTag defines the UpdatePostTag RPC.
+	UpdatePostTag(context.Context, *MsgUpdatePostTag) (*MsgUpdatePostTagResponse, error)
+	// DeletePostTag defines the DeletePostTag RPC.
+	DeletePostTag(context.Context, *MsgDeletePostTag) (*MsgDeletePostTagResponse, error)
+	// DistributeContent defines the DistributeContent RPC for IPFS and decentralized storage.
+	DistributeContent(context.Context, *MsgDistributeContent) (*MsgDistributeContentResponse, error)
+	// SyncHubContent defines the SyncHubContent RPC for hub-to-hub synchronization.
+	SyncHubContent(context.Context, *MsgSyncHubContent) (*MsgSyncHubContentResponse, error)
+	// SendSignalMessage defines the SendSignalMessage RPC for secure node communication.
+	SendSignalMessage(context.Context, *MsgSendSignalMessage) (*MsgSendSignalMessageResponse, error)
+	// AckReplica defines the AckReplica RPC used by a node owner to acknowledge
+	// that the node stores its assigned replica.
+	AckReplica(context.Context, *MsgAckReplica) (*MsgAckReplicaResponse, error)
+	// AnswerChallenge defines the AnswerChallenge RPC used by a node owner to
+	// answer a storage challenge with a Merkle proof of the challenged chunk.
+	AnswerChallenge(context.Context, *MsgAnswerChallenge) (*MsgAnswerChallengeResponse, error)
+	// ReportSyncProgress defines the ReportSyncProgress RPC used by the target
+	// node owner to report the progress of a hub sync.
+	ReportSyncProgress(context.Context, *MsgReportSyncProgress) (*MsgReportSyncProgressResponse, error)
+	// CompleteSync defines the CompleteSync RPC used by the target node owner to
+	// close a hub sync.
+	CompleteSync(context.Context, *MsgCompleteSync) (*MsgCompleteSyncResponse, error)
+	// PublishPrekeyBundle defines the PublishPrekeyBundle RPC used by a node
+	// owner to publish the prekey bundle of the node.
+	PublishPrekeyBundle(context.Context, *MsgPublishPrekeyBundle) (*MsgPublishPrekeyBundleResponse, error)
+	// ReplenishOneTimePrekeys defines the ReplenishOneTimePrekeys RPC used by a
+	// node owner to publish new one-time prekeys.
+	ReplenishOneTimePrekeys(context.Context, *MsgReplenishOneTimePrekeys) (*MsgReplenishOneTimePrekeysResponse, error)
+	// ClaimPrekeyBundle defines the ClaimPrekeyBundle RPC used by a node owner
+	// to get the prekey bundle of another node, consuming one of its one-time
+	// prekeys.
+	ClaimPrekeyBundle(context.Context, *MsgClaimPrekeyBundle) (*MsgClaimPrekeyBundleResponse, error)
+	// AckMessages defines the AckMessages RPC used by a node owner to remove
+	// the messages its node received from its mailbox.
+	AckMessages(context.Context, *MsgAckMessages) (*MsgAckMessagesResponse, error)
 }
 
-func (m *MsgDeleteVoteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
 }
 
-func (m *MsgCreateSource) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+func (*UnimplementedMsgServer) CreatePost(ctx context.Context, req *MsgCreatePost) (*MsgCreatePostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePost not implemented")
+}
+func (*UnimplementedMsgServer) VotePost(ctx context.Context, req *MsgVotePost) (*MsgVotePostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VotePost not implemented")
+}
+func (*UnimplementedMsgServer) ReplyToPost(ctx context.Context, req *MsgReplyToPost) (*MsgReplyToPostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplyToPost not implemented")
+}
+func (*UnimplementedMsgServer) CreateSocialPost(ctx context.Context, req *MsgCreateSocialPost) (*MsgCreateSocialPostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSocialPost not implemented")
+}
+func (*UnimplementedMsgServer) UpdateSocialPost(ctx context.Context, req *MsgUpdateSocialPost) (*MsgUpdateSocialPostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSocialPost not implemented")
+}
+func (*UnimplementedMsgServer) DeleteSocialPost(ctx context.Context, req *MsgDeleteSocialPost) (*MsgDeleteSocialPostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSocialPost not implemented")
+}
+func (*UnimplementedMsgServer) CreateVote(ctx context.Context, req *MsgCreateVote) (*MsgCreateVoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateVote not implemented")
+}
+func (*UnimplementedMsgServer) UpdateVote(ctx context.Context, req *MsgUpdateVote) (*MsgUpdateVoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateVote not implemented")
+}
+func (*UnimplementedMsgServer) DeleteVote(ctx context.Context, req *MsgDeleteVote) (*MsgDeleteVoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteVote not implemented")
+}
+func (*UnimplementedMsgServer) CreateSource(ctx context.Context, req *MsgCreateSource) (*MsgCreateSourceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSource not implemented")
+}
+func (*UnimplementedMsgServer) UpdateSource(ctx context.Context, req *MsgUpdateSource) (*MsgUpdateSourceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSource not implemented")
+}
+func (*UnimplementedMsgServer) DeleteSource(ctx context.Context, req *MsgDeleteSource) (*MsgDeleteSourceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSource not implemented")
+}
+func (*UnimplementedMsgServer) VoteSource(ctx context.Context, req *MsgVoteSource) (*MsgVoteSourceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VoteSource not implemented")
+}
+func (*UnimplementedMsgServer) VerifySource(ctx context.Context, req *MsgVerifySource) (*MsgVerifySourceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifySource not implemented")
+}
+func (*UnimplementedMsgServer) CreatePostTag(ctx context.Context, req *MsgCreatePostTag) (*MsgCreatePostTagResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePostTag not implemented")
+}
+func (*UnimplementedMsgServer) UpdatePostTag(ctx context.Context, req *MsgUpdatePostTag) (*MsgUpdatePostTagResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePostTag not implemented")
+}
+func (*UnimplementedMsgServer) DeletePostTag(ctx context.Context, req *MsgDeletePostTag) (*MsgDeletePostTagResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePostTag not implemented")
+}
+func (*UnimplementedMsgServer) DistributeContent(ctx context.Context, req *MsgDistributeContent) (*MsgDistributeContentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DistributeContent not implemented")
+}
+func (*UnimplementedMsgServer) SyncHubContent(ctx context.Context, req *MsgSyncHubContent) (*MsgSyncHubContentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SyncHubContent not implemented")
+}
+func (*UnimplementedMsgServer) SendSignalMessage(ctx context.Context, req *MsgSendSignalMessage) (*MsgSendSignalMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendSignalMessage not implemented")
+}
+func (*UnimplementedMsgServer) AckReplica(ctx context.Context, req *MsgAckReplica) (*MsgAckReplicaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AckReplica not implemented")
+}
+func (*UnimplementedMsgServer) AnswerChallenge(ctx context.Context, req *MsgAnswerChallenge) (*MsgAnswerChallengeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AnswerChallenge not implemented")
+}
+func (*UnimplementedMsgServer) ReportSyncProgress(ctx context.Context, req *MsgReportSyncProgress) (*MsgReportSyncProgressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportSyncProgress not implemented")
+}
+func (*UnimplementedMsgServer) CompleteSync(ctx context.Context, req *MsgCompleteSync) (*MsgCompleteSyncResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompleteSync not implemented")
+}
+func (*UnimplementedMsgServer) PublishPrekeyBundle(ctx context.Context, req *MsgPublishPrekeyBundle) (*MsgPublishPrekeyBundleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PublishPrekeyBundle not implemented")
+}
+func (*UnimplementedMsgServer) ReplenishOneTimePrekeys(ctx context.Context, req *MsgReplenishOneTimePrekeys) (*MsgReplenishOneTimePrekeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplenishOneTimePrekeys not implemented")
+}
+func (*UnimplementedMsgServer) ClaimPrekeyBundle(ctx context.Context, req *MsgClaimPrekeyBundle) (*MsgClaimPrekeyBundleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimPrekeyBundle not implemented")
+}
+func (*UnimplementedMsgServer) AckMessages(ctx context.Context, req *MsgAckMessages) (*MsgAckMessagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AckMessages not implemented")
 }
 
-func (m *MsgCreateSource) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
 }
 
-func (m *MsgCreateSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Verified {
-		i--
-		if m.Verified {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x40
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.AnalysisSummary) > 0 {
-		i -= len(m.AnalysisSummary)
-		copy(dAtA[i:], m.AnalysisSummary)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.AnalysisSummary)))
-		i--
-		dAtA[i] = 0x3a
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
 	}
-	if m.CredibilityScore != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.CredibilityScore))
-		i--
-		dAtA[i] = 0x30
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/resist.posts.v1.Msg/UpdateParams",
 	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x2a
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
 	}
-	if len(m.Title) > 0 {
-		i -= len(m.Title)
-		copy(dAtA[i:], m.Title)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Title)))
-		i--
-		dAtA[i] = 0x22
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreatePost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreatePost)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Url) > 0 {
-		i -= len(m.Url)
-		copy(dAtA[i:], m.Url)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Url)))
-		i--
-		dAtA[i] = 0x1a
+	if interceptor == nil {
+		return srv.(MsgServer).CreatePost(ctx, in)
 	}
-	if len(m.Index) > 0 {
-		i -= len(m.Index)
-		copy(dAtA[i:], m.Index)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Index)))
-		i--
-		dAtA[i] = 0x12
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/resist.posts.v1.Msg/CreatePost",
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreatePost(ctx, req.(*MsgCreatePost))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCreateSourceResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_VotePost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgVotePost)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgCreateSourceResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgCreateSourceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(MsgServer).VotePost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/resist.posts.v1.Msg/VotePost",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).VotePost(ctx, req.(*MsgVotePost))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateSource) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_ReplyToPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgReplyToPost)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).ReplyToPost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/resist.posts.v1.Msg/ReplyToPost",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ReplyToPost(ctx, req.(*MsgReplyToPost))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateSource) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgUpdateSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Verified {
-		i--
-		if m.Verified {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x40
+func _Msg_CreateSocialPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateSocialPost)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.AnalysisSummary) > 0 {
-		i -= len(m.AnalysisSummary)
-		copy(dAtA[i:], m.AnalysisSummary)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.AnalysisSummary)))
-		i--
-		dAtA[i] = 0x3a
+	if interceptor == nil {
+		return srv.(MsgServer).CreateSocialPost(ctx, in)
 	}
-	if m.CredibilityScore != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.CredibilityScore))
-		i--
-		dAtA[i] = 0x30
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/resist.posts.v1.Msg/CreateSocialPost",
 	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x2a
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateSocialPost(ctx, req.(*MsgCreateSocialPost))
 	}
-	if len(m.Title) > 0 {
-		i -= len(m.Title)
-		copy(dAtA[i:], m.Title)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Title)))
-		i--
-		dAtA[i] = 0x22
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateSocialPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateSocialPost)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Url) > 0 {
-		i -= len(m.Url)
-		copy(dAtA[i:], m.Url)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Url)))
-		i--
-		dAtA[i] = 0x1a
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateSocialPost(ctx, in)
 	}
-	if len(m.Index) > 0 {
-		i -= len(m.Index)
-		copy(dAtA[i:], m.Index)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Index)))
-		i--
-		dAtA[i] = 0x12
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/resist.posts.v1.Msg/UpdateSocialPost",
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateSocialPost(ctx, req.(*MsgUpdateSocialPost))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateSourceResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_DeleteSocialPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeleteSocialPost)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgUpdateSourceResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgUpdateSourceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(MsgServer).DeleteSocialPost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/resist.posts.v1.Msg/DeleteSocialPost",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeleteSocialPost(ctx, req.(*MsgDeleteSocialPost))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgDeleteSource) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_CreateVote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateVote)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgDeleteSource) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(MsgServer).CreateVote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/resist.posts.v1.Msg/CreateVote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateVote(ctx, req.(*MsgCreateVote))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgDeleteSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Index) > 0 {
-		i -= len(m.Index)
-		copy(dAtA[i:], m.Index)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Index)))
-		i--
-		dAtA[i] = 0x12
+func _Msg_UpdateVote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateVote)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateVote(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/resist.posts.v1.Msg/UpdateVote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateVote(ctx, req.(*MsgUpdateVote))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgDeleteSourceResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_DeleteVote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeleteVote)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).DeleteVote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/resist.posts.v1.Msg/DeleteVote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeleteVote(ctx, req.(*MsgDeleteVote))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgDeleteSourceResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_CreateSource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateSource)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateSource(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/resist.posts.v1.Msg/CreateSource",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateSource(ctx, req.(*MsgCreateSource))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgDeleteSourceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func _Msg_UpdateSource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateSource)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateSource(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/resist.posts.v1.Msg/UpdateSource",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateSource(ctx, req.(*MsgUpdateSource))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCreatePostTag) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_DeleteSource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeleteSource)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).DeleteSource(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/resist.posts.v1.Msg/DeleteSource",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeleteSource(ctx, req.(*MsgDeleteSource))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCreatePostTag) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgCreatePostTag) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.RelatedPosts) > 0 {
-		i -= len(m.RelatedPosts)
-		copy(dAtA[i:], m.RelatedPosts)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.RelatedPosts)))
-		i--
-		dAtA[i] = 0x3a
+func _Msg_VoteSource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgVoteSource)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.SimilarityScore != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.SimilarityScore))
-		i--
-		dAtA[i] = 0x30
+	if interceptor == nil {
+		return srv.(MsgServer).VoteSource(ctx, in)
 	}
-	if len(m.Category) > 0 {
-		i -= len(m.Category)
-		copy(dAtA[i:], m.Category)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Category)))
-		i--
-		dAtA[i] = 0x2a
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/resist.posts.v1.Msg/VoteSource",
 	}
-	if len(m.Tag) > 0 {
-		i -= len(m.Tag)
-		copy(dAtA[i:], m.Tag)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Tag)))
-		i--
-		dAtA[i] = 0x22
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).VoteSource(ctx, req.(*MsgVoteSource))
 	}
-	if len(m.PostIndex) > 0 {
-		i -= len(m.PostIndex)
-		copy(dAtA[i:], m.PostIndex)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.PostIndex)))
-		i--
-		dAtA[i] = 0x1a
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_VerifySource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgVerifySource)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Index) > 0 {
-		i -= len(m.Index)
-		copy(dAtA[i:], m.Index)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Index)))
-		i--
-		dAtA[i] = 0x12
+	if interceptor == nil {
+		return srv.(MsgServer).VerifySource(ctx, in)
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/resist.posts.v1.Msg/VerifySource",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).VerifySource(ctx, req.(*MsgVerifySource))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCreatePostTagResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_CreatePostTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreatePostTag)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgCreatePostTagResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(MsgServer).CreatePostTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/resist.posts.v1.Msg/CreatePostTag",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreatePostTag(ctx, req.(*MsgCreatePostTag))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCreatePostTagResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func _Msg_UpdatePostTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdatePostTag)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdatePostTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/resist.posts.v1.Msg/UpdatePostTag",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdatePostTag(ctx, req.(*MsgUpdatePostTag))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdatePostTag) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_DeletePostTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeletePostTag)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).DeletePostTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/resist.posts.v1.Msg/DeletePostTag",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeletePostTag(ctx, req.(*MsgDeletePostTag))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdatePostTag) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_DistributeContent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDistributeContent)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DistributeContent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/resist.posts.v1.Msg/DistributeContent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DistributeContent(ctx, req.(*MsgDistributeContent))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdatePostTag) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.RelatedPosts) > 0 {
-		i -= len(m.RelatedPosts)
-		copy(dAtA[i:], m.RelatedPosts)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.RelatedPosts)))
-		i--
-		dAtA[i] = 0x3a
+func _Msg_SyncHubContent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSyncHubContent)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.SimilarityScore != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.SimilarityScore))
-		i--
-		dAtA[i] = 0x30
+	if interceptor == nil {
+		return srv.(MsgServer).SyncHubContent(ctx, in)
 	}
-	if len(m.Category) > 0 {
-		i -= len(m.Category)
-		copy(dAtA[i:], m.Category)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Category)))
-		i--
-		dAtA[i] = 0x2a
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/resist.posts.v1.Msg/SyncHubContent",
 	}
-	if len(m.Tag) > 0 {
-		i -= len(m.Tag)
-		copy(dAtA[i:], m.Tag)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Tag)))
-		i--
-		dAtA[i] = 0x22
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SyncHubContent(ctx, req.(*MsgSyncHubContent))
 	}
-	if len(m.PostIndex) > 0 {
-		i -= len(m.PostIndex)
-		copy(dAtA[i:], m.PostIndex)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.PostIndex)))
-		i--
-		dAtA[i] = 0x1a
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SendSignalMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSendSignalMessage)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Index) > 0 {
-		i -= len(m.Index)
-		copy(dAtA[i:], m.Index)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Index)))
-		i--
-		dAtA[i] = 0x12
+	if interceptor == nil {
+		return srv.(MsgServer).SendSignalMessage(ctx, in)
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/resist.posts.v1.Msg/SendSignalMessage",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SendSignalMessage(ctx, req.(*MsgSendSignalMessage))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdatePostTagResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_AckReplica_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAckReplica)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).AckReplica(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/resist.posts.v1.Msg/AckReplica",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AckReplica(ctx, req.(*MsgAckReplica))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdatePostTagResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_AnswerChallenge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAnswerChallenge)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AnswerChallenge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/resist.posts.v1.Msg/AnswerChallenge",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AnswerChallenge(ctx, req.(*MsgAnswerChallenge))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdatePostTagResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func _Msg_ReportSyncProgress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgReportSyncProgress)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ReportSyncProgress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/resist.posts.v1.Msg/ReportSyncProgress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ReportSyncProgress(ctx, req.(*MsgReportSyncProgress))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgDeletePostTag) Marshal() (dAtA []byte, err error) {
+func _Msg_CompleteSync_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCompleteSync)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CompleteSync(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/resist.posts.v1.Msg/CompleteSync",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CompleteSync(ctx, req.(*MsgCompleteSync))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_PublishPrekeyBundle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPublishPrekeyBundle)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).PublishPrekeyBundle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/resist.posts.v1.Msg/PublishPrekeyBundle",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PublishPrekeyBundle(ctx, req.(*MsgPublishPrekeyBundle))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ReplenishOneTimePrekeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgReplenishOneTimePrekeys)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ReplenishOneTimePrekeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/resist.posts.v1.Msg/ReplenishOneTimePrekeys",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ReplenishOneTimePrekeys(ctx, req.(*MsgReplenishOneTimePrekeys))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ClaimPrekeyBundle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClaimPrekeyBundle)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ClaimPrekeyBundle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/resist.posts.v1.Msg/ClaimPrekeyBundle",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClaimPrekeyBundle(ctx, req.(*MsgClaimPrekeyBundle))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AckMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAckMessages)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AckMessages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/resist.posts.v1.Msg/AckMessages",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AckMessages(ctx, req.(*MsgAckMessages))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Msg_serviceDesc = _Msg_serviceDesc
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "resist.posts.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+		{
+			MethodName: "CreatePost",
+			Handler:    _Msg_CreatePost_Handler,
+		},
+		{
+			MethodName: "VotePost",
+			Handler:    _Msg_VotePost_Handler,
+		},
+		{
+			MethodName: "ReplyToPost",
+			Handler:    _Msg_ReplyToPost_Handler,
+		},
+		{
+			MethodName: "CreateSocialPost",
+			Handler:    _Msg_CreateSocialPost_Handler,
+		},
+		{
+			MethodName: "UpdateSocialPost",
+			Handler:    _Msg_UpdateSocialPost_Handler,
+		},
+		{
+			MethodName: "DeleteSocialPost",
+			Handler:    _Msg_DeleteSocialPost_Handler,
+		},
+		{
+			MethodName: "CreateVote",
+			Handler:    _Msg_CreateVote_Handler,
+		},
+		{
+			MethodName: "UpdateVote",
+			Handler:    _Msg_UpdateVote_Handler,
+		},
+		{
+			MethodName: "DeleteVote",
+			Handler:    _Msg_DeleteVote_Handler,
+		},
+		{
+			MethodName: "CreateSource",
+			Handler:    _Msg_CreateSource_Handler,
+		},
+		{
+			MethodName: "UpdateSource",
+			Handler:    _Msg_UpdateSource_Handler,
+		},
+		{
+			MethodName: "DeleteSource",
+			Handler:    _Msg_DeleteSource_Handler,
+		},
+		{
+			MethodName: "VoteSource",
+			Handler:    _Msg_VoteSource_Handler,
+		},
+		{
+			MethodName: "VerifySource",
+			Handler:    _Msg_VerifySource_Handler,
+		},
+		{
+			MethodName: "CreatePostTag",
+			Handler:    _Msg_CreatePostTag_Handler,
+		},
+		{
+			MethodName: "UpdatePostTag",
+			Handler:    _Msg_UpdatePostTag_Handler,
+		},
+		{
+			MethodName: "DeletePostTag",
+			Handler:    _Msg_DeletePostTag_Handler,
+		},
+		{
+			MethodName: "DistributeContent",
+			Handler:    _Msg_DistributeContent_Handler,
+		},
+		{
+			MethodName: "SyncHubContent",
+			Handler:    _Msg_SyncHubContent_Handler,
+		},
+		{
+			MethodName: "SendSignalMessage",
+			Handler:    _Msg_SendSignalMessage_Handler,
+		},
+		{
+			MethodName: "AckReplica",
+			Handler:    _Msg_AckReplica_Handler,
+		},
+		{
+			MethodName: "AnswerChallenge",
+			Handler:    _Msg_AnswerChallenge_Handler,
+		},
+		{
+			MethodName: "ReportSyncProgress",
+			Handler:    _Msg_ReportSyncProgress_Handler,
+		},
+		{
+			MethodName: "CompleteSync",
+			Handler:    _Msg_CompleteSync_Handler,
+		},
+		{
+			MethodName: "PublishPrekeyBundle",
+			Handler:    _Msg_PublishPrekeyBundle_Handler,
+		},
+		{
+			MethodName: "ReplenishOneTimePrekeys",
+			Handler:    _Msg_ReplenishOneTimePrekeys_Handler,
+		},
+		{
+			MethodName: "ClaimPrekeyBundle",
+			Handler:    _Msg_ClaimPrekeyBundle_Handler,
+		},
+		{
+			MethodName: "AckMessages",
+			Handler:    _Msg_AckMessages_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "resist/posts/v1/tx.proto",
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4329,34 +4971,37 @@ func (m *MsgDeletePostTag) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgDeletePostTag) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgDeletePostTag) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Index) > 0 {
-		i -= len(m.Index)
-		copy(dAtA[i:], m.Index)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Index)))
-		i--
-		dAtA[i] = 0x12
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgDeletePostTagResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4366,12 +5011,12 @@ func (m *MsgDeletePostTagResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgDeletePostTagResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgDeletePostTagResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -4379,7 +5024,7 @@ func (m *MsgDeletePostTagResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgDistributeContent) Marshal() (dAtA []byte, err error) {
+func (m *MsgCreatePost) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4389,60 +5034,62 @@ func (m *MsgDistributeContent) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgDistributeContent) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgCreatePost) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgDistributeContent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgCreatePost) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.PreferredNodes) > 0 {
-		for iNdEx := len(m.PreferredNodes) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.PreferredNodes[iNdEx])
-			copy(dAtA[i:], m.PreferredNodes[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.PreferredNodes[iNdEx])))
+	if len(m.SourceIndexes) > 0 {
+		for iNdEx := len(m.SourceIndexes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.SourceIndexes[iNdEx])
+			copy(dAtA[i:], m.SourceIndexes[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.SourceIndexes[iNdEx])))
 			i--
-			dAtA[i] = 0x3a
+			dAtA[i] = 0x42
 		}
 	}
-	if len(m.ReplicationStrategy) > 0 {
-		i -= len(m.ReplicationStrategy)
-		copy(dAtA[i:], m.ReplicationStrategy)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ReplicationStrategy)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if m.TargetReplicas != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.TargetReplicas))
+	if len(m.QuotedIndex) > 0 {
+		i -= len(m.QuotedIndex)
+		copy(dAtA[i:], m.QuotedIndex)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.QuotedIndex)))
 		i--
-		dAtA[i] = 0x28
+		dAtA[i] = 0x3a
 	}
-	if m.Metadata != nil {
-		{
-			size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
+	if m.GroupId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.GroupId))
 		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0x30
 	}
-	if len(m.ContentData) > 0 {
-		i -= len(m.ContentData)
-		copy(dAtA[i:], m.ContentData)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ContentData)))
+	if len(m.MediaType) > 0 {
+		i -= len(m.MediaType)
+		copy(dAtA[i:], m.MediaType)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MediaType)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.MediaUrl) > 0 {
+		i -= len(m.MediaUrl)
+		copy(dAtA[i:], m.MediaUrl)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MediaUrl)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Content) > 0 {
+		i -= len(m.Content)
+		copy(dAtA[i:], m.Content)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Content)))
 		i--
 		dAtA[i] = 0x1a
 	}
-	if len(m.ContentId) > 0 {
-		i -= len(m.ContentId)
-		copy(dAtA[i:], m.ContentId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ContentId)))
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Title)))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -4456,7 +5103,7 @@ func (m *MsgDistributeContent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgDistributeContentResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgCreatePostResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4466,43 +5113,27 @@ func (m *MsgDistributeContentResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgDistributeContentResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgCreatePostResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgDistributeContentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgCreatePostResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.DistributionId) > 0 {
-		i -= len(m.DistributionId)
-		copy(dAtA[i:], m.DistributionId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.DistributionId)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.AssignedNodes) > 0 {
-		for iNdEx := len(m.AssignedNodes) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.AssignedNodes[iNdEx])
-			copy(dAtA[i:], m.AssignedNodes[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.AssignedNodes[iNdEx])))
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if len(m.IpfsHash) > 0 {
-		i -= len(m.IpfsHash)
-		copy(dAtA[i:], m.IpfsHash)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.IpfsHash)))
+	if len(m.Index) > 0 {
+		i -= len(m.Index)
+		copy(dAtA[i:], m.Index)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Index)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgSyncHubContent) Marshal() (dAtA []byte, err error) {
+func (m *MsgReplyToPost) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4512,48 +5143,48 @@ func (m *MsgSyncHubContent) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgSyncHubContent) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgReplyToPost) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgSyncHubContent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgReplyToPost) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.LastSyncTimestamp != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.LastSyncTimestamp))
+	if len(m.QuotedIndex) > 0 {
+		i -= len(m.QuotedIndex)
+		copy(dAtA[i:], m.QuotedIndex)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.QuotedIndex)))
 		i--
-		dAtA[i] = 0x30
+		dAtA[i] = 0x32
 	}
-	if len(m.SyncMethod) > 0 {
-		i -= len(m.SyncMethod)
-		copy(dAtA[i:], m.SyncMethod)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.SyncMethod)))
+	if len(m.MediaType) > 0 {
+		i -= len(m.MediaType)
+		copy(dAtA[i:], m.MediaType)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MediaType)))
 		i--
 		dAtA[i] = 0x2a
 	}
-	if len(m.ContentIds) > 0 {
-		for iNdEx := len(m.ContentIds) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.ContentIds[iNdEx])
-			copy(dAtA[i:], m.ContentIds[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.ContentIds[iNdEx])))
-			i--
-			dAtA[i] = 0x22
-		}
+	if len(m.MediaUrl) > 0 {
+		i -= len(m.MediaUrl)
+		copy(dAtA[i:], m.MediaUrl)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MediaUrl)))
+		i--
+		dAtA[i] = 0x22
 	}
-	if len(m.TargetNode) > 0 {
-		i -= len(m.TargetNode)
-		copy(dAtA[i:], m.TargetNode)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.TargetNode)))
+	if len(m.Content) > 0 {
+		i -= len(m.Content)
+		copy(dAtA[i:], m.Content)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Content)))
 		i--
 		dAtA[i] = 0x1a
 	}
-	if len(m.SourceNode) > 0 {
-		i -= len(m.SourceNode)
-		copy(dAtA[i:], m.SourceNode)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.SourceNode)))
+	if len(m.ParentIndex) > 0 {
+		i -= len(m.ParentIndex)
+		copy(dAtA[i:], m.ParentIndex)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ParentIndex)))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -4567,7 +5198,7 @@ func (m *MsgSyncHubContent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgSyncHubContentResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgReplyToPostResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4577,37 +5208,27 @@ func (m *MsgSyncHubContentResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgSyncHubContentResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgReplyToPostResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgSyncHubContentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgReplyToPostResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.EstimatedDuration != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.EstimatedDuration))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.EstimatedBytes != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.EstimatedBytes))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.SyncId) > 0 {
-		i -= len(m.SyncId)
-		copy(dAtA[i:], m.SyncId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.SyncId)))
+	if len(m.Index) > 0 {
+		i -= len(m.Index)
+		copy(dAtA[i:], m.Index)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Index)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgSendSignalMessage) Marshal() (dAtA []byte, err error) {
+func (m *MsgVotePost) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4617,48 +5238,27 @@ func (m *MsgSendSignalMessage) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgSendSignalMessage) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgVotePost) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgSendSignalMessage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgVotePost) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Signature) > 0 {
-		i -= len(m.Signature)
-		copy(dAtA[i:], m.Signature)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signature)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.MessageType) > 0 {
-		i -= len(m.MessageType)
-		copy(dAtA[i:], m.MessageType)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.MessageType)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.EncryptedPayload) > 0 {
-		i -= len(m.EncryptedPayload)
-		copy(dAtA[i:], m.EncryptedPayload)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.EncryptedPayload)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.ChannelId) > 0 {
-		i -= len(m.ChannelId)
-		copy(dAtA[i:], m.ChannelId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ChannelId)))
+	if len(m.VoteType) > 0 {
+		i -= len(m.VoteType)
+		copy(dAtA[i:], m.VoteType)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.VoteType)))
 		i--
 		dAtA[i] = 0x1a
 	}
-	if len(m.RecipientNode) > 0 {
-		i -= len(m.RecipientNode)
-		copy(dAtA[i:], m.RecipientNode)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.RecipientNode)))
+	if len(m.PostIndex) > 0 {
+		i -= len(m.PostIndex)
+		copy(dAtA[i:], m.PostIndex)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PostIndex)))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -4672,7 +5272,7 @@ func (m *MsgSendSignalMessage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgSendSignalMessageResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgVotePostResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4682,831 +5282,6305 @@ func (m *MsgSendSignalMessageResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgSendSignalMessageResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgVotePostResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgSendSignalMessageResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgVotePostResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.DeliveryConfirmed {
-		i--
-		if m.DeliveryConfirmed {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.MessageId) > 0 {
-		i -= len(m.MessageId)
-		copy(dAtA[i:], m.MessageId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.MessageId)))
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgCreateSocialPost) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *MsgCreateSocialPost) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgCreatePost) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgCreateSocialPost) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.CreatedAt != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CreatedAt))
+		i--
+		dAtA[i] = 0x58
 	}
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.Downvotes != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Downvotes))
+		i--
+		dAtA[i] = 0x50
 	}
-	l = len(m.Content)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.Upvotes != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Upvotes))
+		i--
+		dAtA[i] = 0x48
 	}
-	l = len(m.MediaUrl)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.MediaType)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Author) > 0 {
+		i -= len(m.Author)
+		copy(dAtA[i:], m.Author)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Author)))
+		i--
+		dAtA[i] = 0x42
 	}
 	if m.GroupId != 0 {
-		n += 1 + sovTx(uint64(m.GroupId))
+		i = encodeVarintTx(dAtA, i, uint64(m.GroupId))
+		i--
+		dAtA[i] = 0x38
 	}
-	return n
-}
-
-func (m *MsgCreatePostResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.MediaType) > 0 {
+		i -= len(m.MediaType)
+		copy(dAtA[i:], m.MediaType)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MediaType)))
+		i--
+		dAtA[i] = 0x32
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *MsgVotePost) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.MediaUrl) > 0 {
+		i -= len(m.MediaUrl)
+		copy(dAtA[i:], m.MediaUrl)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MediaUrl)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Content) > 0 {
+		i -= len(m.Content)
+		copy(dAtA[i:], m.Content)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Content)))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.PostIndex)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.VoteType)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Index) > 0 {
+		i -= len(m.Index)
+		copy(dAtA[i:], m.Index)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Index)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgVotePostResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgCreateSocialPostResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateSocialPostResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateSocialPostResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	if len(m.Index) > 0 {
+		i -= len(m.Index)
+		copy(dAtA[i:], m.Index)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Index)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgCreateSocialPost) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgUpdateSocialPost) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateSocialPost) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateSocialPost) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.CreatedAt != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CreatedAt))
+		i--
+		dAtA[i] = 0x58
 	}
-	l = len(m.Index)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.Downvotes != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Downvotes))
+		i--
+		dAtA[i] = 0x50
 	}
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.Upvotes != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Upvotes))
+		i--
+		dAtA[i] = 0x48
 	}
-	l = len(m.Content)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Author) > 0 {
+		i -= len(m.Author)
+		copy(dAtA[i:], m.Author)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Author)))
+		i--
+		dAtA[i] = 0x42
 	}
-	l = len(m.MediaUrl)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.GroupId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.GroupId))
+		i--
+		dAtA[i] = 0x38
 	}
-	l = len(m.MediaType)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.MediaType) > 0 {
+		i -= len(m.MediaType)
+		copy(dAtA[i:], m.MediaType)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MediaType)))
+		i--
+		dAtA[i] = 0x32
 	}
-	if m.GroupId != 0 {
-		n += 1 + sovTx(uint64(m.GroupId))
+	if len(m.MediaUrl) > 0 {
+		i -= len(m.MediaUrl)
+		copy(dAtA[i:], m.MediaUrl)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MediaUrl)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	l = len(m.Author)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Content) > 0 {
+		i -= len(m.Content)
+		copy(dAtA[i:], m.Content)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Content)))
+		i--
+		dAtA[i] = 0x22
 	}
-	if m.Upvotes != 0 {
-		n += 1 + sovTx(uint64(m.Upvotes))
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.Downvotes != 0 {
-		n += 1 + sovTx(uint64(m.Downvotes))
+	if len(m.Index) > 0 {
+		i -= len(m.Index)
+		copy(dAtA[i:], m.Index)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Index)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.CreatedAt != 0 {
-		n += 1 + sovTx(uint64(m.CreatedAt))
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgCreateSocialPostResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgUpdateSocialPostResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateSocialPost) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgUpdateSocialPostResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateSocialPostResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeleteSocialPost) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.Index)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Content)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.MediaUrl)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.MediaType)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.GroupId != 0 {
-		n += 1 + sovTx(uint64(m.GroupId))
-	}
-	l = len(m.Author)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.Upvotes != 0 {
-		n += 1 + sovTx(uint64(m.Upvotes))
-	}
-	if m.Downvotes != 0 {
-		n += 1 + sovTx(uint64(m.Downvotes))
-	}
-	if m.CreatedAt != 0 {
-		n += 1 + sovTx(uint64(m.CreatedAt))
-	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateSocialPostResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *MsgDeleteSocialPost) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgDeleteSocialPost) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgDeleteSocialPost) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Index) > 0 {
+		i -= len(m.Index)
+		copy(dAtA[i:], m.Index)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Index)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Index)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgDeleteSocialPostResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgDeleteSocialPostResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgCreateVote) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Index)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.VoterAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.PostIndex)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.VoteType)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.Timestamp != 0 {
-		n += 1 + sovTx(uint64(m.Timestamp))
-	}
-	return n
+func (m *MsgDeleteSocialPostResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgCreateVoteResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgDeleteSocialPostResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateVote) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgCreateVote) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateVote) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateVote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.Timestamp != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x30
 	}
-	l = len(m.Index)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.VoteType) > 0 {
+		i -= len(m.VoteType)
+		copy(dAtA[i:], m.VoteType)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.VoteType)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	l = len(m.VoterAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.PostIndex) > 0 {
+		i -= len(m.PostIndex)
+		copy(dAtA[i:], m.PostIndex)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PostIndex)))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.PostIndex)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.VoterAddress) > 0 {
+		i -= len(m.VoterAddress)
+		copy(dAtA[i:], m.VoterAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.VoterAddress)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.VoteType)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Index) > 0 {
+		i -= len(m.Index)
+		copy(dAtA[i:], m.Index)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Index)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Timestamp != 0 {
-		n += 1 + sovTx(uint64(m.Timestamp))
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateVoteResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgCreateVoteResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgDeleteVote) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Index)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+func (m *MsgCreateVoteResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgDeleteVoteResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgCreateVoteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgCreateSource) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgUpdateVote) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Index)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Url)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.CredibilityScore != 0 {
-		n += 1 + sovTx(uint64(m.CredibilityScore))
-	}
-	l = len(m.AnalysisSummary)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.Verified {
-		n += 2
-	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgCreateSourceResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *MsgUpdateVote) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateSource) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgUpdateVote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Index)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Url)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.Timestamp != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x30
 	}
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.VoteType) > 0 {
+		i -= len(m.VoteType)
+		copy(dAtA[i:], m.VoteType)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.VoteType)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.PostIndex) > 0 {
+		i -= len(m.PostIndex)
+		copy(dAtA[i:], m.PostIndex)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PostIndex)))
+		i--
+		dAtA[i] = 0x22
 	}
-	if m.CredibilityScore != 0 {
-		n += 1 + sovTx(uint64(m.CredibilityScore))
+	if len(m.VoterAddress) > 0 {
+		i -= len(m.VoterAddress)
+		copy(dAtA[i:], m.VoterAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.VoterAddress)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.AnalysisSummary)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Index) > 0 {
+		i -= len(m.Index)
+		copy(dAtA[i:], m.Index)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Index)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Verified {
-		n += 2
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateSourceResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgUpdateVoteResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgDeleteSource) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Index)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+func (m *MsgUpdateVoteResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgDeleteSourceResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgUpdateVoteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgCreatePostTag) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgDeleteVote) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeleteVote) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteVote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Index)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.PostIndex)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Tag)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Category)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.SimilarityScore != 0 {
-		n += 1 + sovTx(uint64(m.SimilarityScore))
+	if len(m.Index) > 0 {
+		i -= len(m.Index)
+		copy(dAtA[i:], m.Index)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Index)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.RelatedPosts)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgCreatePostTagResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgDeleteVoteResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeleteVoteResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteVoteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdatePostTag) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgCreateSource) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateSource) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Index)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.PostIndex)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.Verified {
+		i--
+		if m.Verified {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
 	}
-	l = len(m.Tag)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.AnalysisSummary) > 0 {
+		i -= len(m.AnalysisSummary)
+		copy(dAtA[i:], m.AnalysisSummary)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.AnalysisSummary)))
+		i--
+		dAtA[i] = 0x3a
 	}
-	l = len(m.Category)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.CredibilityScore != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CredibilityScore))
+		i--
+		dAtA[i] = 0x30
 	}
-	if m.SimilarityScore != 0 {
-		n += 1 + sovTx(uint64(m.SimilarityScore))
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	l = len(m.RelatedPosts)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0x22
 	}
-	return n
+	if len(m.Url) > 0 {
+		i -= len(m.Url)
+		copy(dAtA[i:], m.Url)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Url)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Index) > 0 {
+		i -= len(m.Index)
+		copy(dAtA[i:], m.Index)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Index)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdatePostTagResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgCreateSourceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgDeletePostTag) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Index)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+func (m *MsgCreateSourceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgDeletePostTagResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgCreateSourceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgDistributeContent) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgUpdateSource) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateSource) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.Verified {
+		i--
+		if m.Verified {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
 	}
-	l = len(m.ContentId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.AnalysisSummary) > 0 {
+		i -= len(m.AnalysisSummary)
+		copy(dAtA[i:], m.AnalysisSummary)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.AnalysisSummary)))
+		i--
+		dAtA[i] = 0x3a
 	}
-	l = len(m.ContentData)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.CredibilityScore != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CredibilityScore))
+		i--
+		dAtA[i] = 0x30
 	}
-	if m.Metadata != nil {
-		l = m.Metadata.Size()
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	if m.TargetReplicas != 0 {
-		n += 1 + sovTx(uint64(m.TargetReplicas))
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.ReplicationStrategy)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Url) > 0 {
+		i -= len(m.Url)
+		copy(dAtA[i:], m.Url)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Url)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if len(m.PreferredNodes) > 0 {
-		for _, s := range m.PreferredNodes {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
-		}
+	if len(m.Index) > 0 {
+		i -= len(m.Index)
+		copy(dAtA[i:], m.Index)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Index)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgDistributeContentResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.IpfsHash)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.AssignedNodes) > 0 {
-		for _, s := range m.AssignedNodes {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
-		}
-	}
-	l = len(m.DistributionId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgUpdateSourceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgSyncHubContent) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgUpdateSourceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateSourceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeleteSource) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.SourceNode)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.TargetNode)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.ContentIds) > 0 {
-		for _, s := range m.ContentIds {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
-		}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeleteSource) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Index) > 0 {
+		i -= len(m.Index)
+		copy(dAtA[i:], m.Index)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Index)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.SyncMethod)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
 	}
-	if m.LastSyncTimestamp != 0 {
-		n += 1 + sovTx(uint64(m.LastSyncTimestamp))
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeleteSourceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgSyncHubContentResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgDeleteSourceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteSourceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgVoteSource) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgVoteSource) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgVoteSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.SyncId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.VoteType) > 0 {
+		i -= len(m.VoteType)
+		copy(dAtA[i:], m.VoteType)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.VoteType)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.EstimatedBytes != 0 {
-		n += 1 + sovTx(uint64(m.EstimatedBytes))
+	if len(m.SourceIndex) > 0 {
+		i -= len(m.SourceIndex)
+		copy(dAtA[i:], m.SourceIndex)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SourceIndex)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.EstimatedDuration != 0 {
-		n += 1 + sovTx(uint64(m.EstimatedDuration))
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgSendSignalMessage) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgVoteSourceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgVoteSourceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgVoteSourceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.RecipientNode)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.CredibilityScore != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CredibilityScore))
+		i--
+		dAtA[i] = 0x8
 	}
-	l = len(m.ChannelId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgVerifySource) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.EncryptedPayload)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *MsgVerifySource) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgVerifySource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Verified {
+		i--
+		if m.Verified {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
 	}
-	l = len(m.MessageType)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Index) > 0 {
+		i -= len(m.Index)
+		copy(dAtA[i:], m.Index)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Index)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Signature)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgSendSignalMessageResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgVerifySourceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgVerifySourceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgVerifySourceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.MessageId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.DeliveryConfirmed {
-		n += 2
+	if m.CredibilityScore != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CredibilityScore))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *MsgCreatePostTag) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *MsgCreatePostTag) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
+
+func (m *MsgCreatePostTag) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RelatedPosts) > 0 {
+		i -= len(m.RelatedPosts)
+		copy(dAtA[i:], m.RelatedPosts)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RelatedPosts)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.SimilarityScore != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SimilarityScore))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Category) > 0 {
+		i -= len(m.Category)
+		copy(dAtA[i:], m.Category)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Category)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Tag) > 0 {
+		i -= len(m.Tag)
+		copy(dAtA[i:], m.Tag)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Tag)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.PostIndex) > 0 {
+		i -= len(m.PostIndex)
+		copy(dAtA[i:], m.PostIndex)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PostIndex)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Index) > 0 {
+		i -= len(m.Index)
+		copy(dAtA[i:], m.Index)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Index)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreatePostTagResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreatePostTagResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreatePostTagResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdatePostTag) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdatePostTag) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdatePostTag) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RelatedPosts) > 0 {
+		i -= len(m.RelatedPosts)
+		copy(dAtA[i:], m.RelatedPosts)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RelatedPosts)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.SimilarityScore != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SimilarityScore))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Category) > 0 {
+		i -= len(m.Category)
+		copy(dAtA[i:], m.Category)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Category)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Tag) > 0 {
+		i -= len(m.Tag)
+		copy(dAtA[i:], m.Tag)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Tag)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.PostIndex) > 0 {
+		i -= len(m.PostIndex)
+		copy(dAtA[i:], m.PostIndex)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PostIndex)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Index) > 0 {
+		i -= len(m.Index)
+		copy(dAtA[i:], m.Index)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Index)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdatePostTagResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdatePostTagResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdatePostTagResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeletePostTag) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeletePostTag) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeletePostTag) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Index) > 0 {
+		i -= len(m.Index)
+		copy(dAtA[i:], m.Index)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Index)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeletePostTagResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeletePostTagResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeletePostTagResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDistributeContent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDistributeContent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDistributeContent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PreferredNodes) > 0 {
+		for iNdEx := len(m.PreferredNodes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PreferredNodes[iNdEx])
+			copy(dAtA[i:], m.PreferredNodes[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.PreferredNodes[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.ReplicationStrategy) > 0 {
+		i -= len(m.ReplicationStrategy)
+		copy(dAtA[i:], m.ReplicationStrategy)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ReplicationStrategy)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.TargetReplicas != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TargetReplicas))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Metadata != nil {
+		{
+			size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ContentData) > 0 {
+		i -= len(m.ContentData)
+		copy(dAtA[i:], m.ContentData)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContentData)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ContentId) > 0 {
+		i -= len(m.ContentId)
+		copy(dAtA[i:], m.ContentId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContentId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDistributeContentResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDistributeContentResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDistributeContentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DistributionId) > 0 {
+		i -= len(m.DistributionId)
+		copy(dAtA[i:], m.DistributionId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DistributionId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.AssignedNodes) > 0 {
+		for iNdEx := len(m.AssignedNodes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AssignedNodes[iNdEx])
+			copy(dAtA[i:], m.AssignedNodes[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.AssignedNodes[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.IpfsHash) > 0 {
+		i -= len(m.IpfsHash)
+		copy(dAtA[i:], m.IpfsHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.IpfsHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSyncHubContent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSyncHubContent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSyncHubContent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastSyncTimestamp != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.LastSyncTimestamp))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.SyncMethod) > 0 {
+		i -= len(m.SyncMethod)
+		copy(dAtA[i:], m.SyncMethod)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SyncMethod)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ContentIds) > 0 {
+		for iNdEx := len(m.ContentIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ContentIds[iNdEx])
+			copy(dAtA[i:], m.ContentIds[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.ContentIds[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.TargetNode) > 0 {
+		i -= len(m.TargetNode)
+		copy(dAtA[i:], m.TargetNode)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TargetNode)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.SourceNode) > 0 {
+		i -= len(m.SourceNode)
+		copy(dAtA[i:], m.SourceNode)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SourceNode)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSyncHubContentResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSyncHubContentResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSyncHubContentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EstimatedDuration != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.EstimatedDuration))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.EstimatedBytes != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.EstimatedBytes))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.SyncId) > 0 {
+		i -= len(m.SyncId)
+		copy(dAtA[i:], m.SyncId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SyncId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSendSignalMessage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSendSignalMessage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSendSignalMessage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SenderNode) > 0 {
+		i -= len(m.SenderNode)
+		copy(dAtA[i:], m.SenderNode)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SenderNode)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.MessageType) > 0 {
+		i -= len(m.MessageType)
+		copy(dAtA[i:], m.MessageType)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MessageType)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.EncryptedPayload) > 0 {
+		i -= len(m.EncryptedPayload)
+		copy(dAtA[i:], m.EncryptedPayload)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.EncryptedPayload)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.RecipientNode) > 0 {
+		i -= len(m.RecipientNode)
+		copy(dAtA[i:], m.RecipientNode)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RecipientNode)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSendSignalMessageResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSendSignalMessageResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSendSignalMessageResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DeliveryConfirmed {
+		i--
+		if m.DeliveryConfirmed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.MessageId) > 0 {
+		i -= len(m.MessageId)
+		copy(dAtA[i:], m.MessageId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MessageId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAckMessages) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAckMessages) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAckMessages) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MessageIds) > 0 {
+		for iNdEx := len(m.MessageIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.MessageIds[iNdEx])
+			copy(dAtA[i:], m.MessageIds[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.MessageIds[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.NodeId) > 0 {
+		i -= len(m.NodeId)
+		copy(dAtA[i:], m.NodeId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NodeId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAckMessagesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAckMessagesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAckMessagesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Acked != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Acked))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAckReplica) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAckReplica) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAckReplica) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Cid) > 0 {
+		i -= len(m.Cid)
+		copy(dAtA[i:], m.Cid)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Cid)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.NodeId) > 0 {
+		i -= len(m.NodeId)
+		copy(dAtA[i:], m.NodeId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NodeId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ContentId) > 0 {
+		i -= len(m.ContentId)
+		copy(dAtA[i:], m.ContentId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContentId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAckReplicaResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAckReplicaResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAckReplicaResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CurrentReplicas != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CurrentReplicas))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAnswerChallenge) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAnswerChallenge) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAnswerChallenge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Proof) > 0 {
+		for iNdEx := len(m.Proof) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Proof[iNdEx])
+			copy(dAtA[i:], m.Proof[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Proof[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Chunk) > 0 {
+		i -= len(m.Chunk)
+		copy(dAtA[i:], m.Chunk)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Chunk)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ChallengeId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ChallengeId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAnswerChallengeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAnswerChallengeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAnswerChallengeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Passed {
+		i--
+		if m.Passed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgReportSyncProgress) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReportSyncProgress) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReportSyncProgress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BytesTransferred != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.BytesTransferred))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.SyncId) > 0 {
+		i -= len(m.SyncId)
+		copy(dAtA[i:], m.SyncId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SyncId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgReportSyncProgressResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReportSyncProgressResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReportSyncProgressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCompleteSync) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCompleteSync) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCompleteSync) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FailureReason) > 0 {
+		i -= len(m.FailureReason)
+		copy(dAtA[i:], m.FailureReason)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FailureReason)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.BytesTransferred != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.BytesTransferred))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Success {
+		i--
+		if m.Success {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.SyncId) > 0 {
+		i -= len(m.SyncId)
+		copy(dAtA[i:], m.SyncId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SyncId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCompleteSyncResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCompleteSyncResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCompleteSyncResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPublishPrekeyBundle) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPublishPrekeyBundle) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPublishPrekeyBundle) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OneTimePrekeys) > 0 {
+		for iNdEx := len(m.OneTimePrekeys) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OneTimePrekeys[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.SignedPrekeySignature) > 0 {
+		i -= len(m.SignedPrekeySignature)
+		copy(dAtA[i:], m.SignedPrekeySignature)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SignedPrekeySignature)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.SignedPrekey) > 0 {
+		i -= len(m.SignedPrekey)
+		copy(dAtA[i:], m.SignedPrekey)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SignedPrekey)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.SignedPrekeyId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SignedPrekeyId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.SigningKey) > 0 {
+		i -= len(m.SigningKey)
+		copy(dAtA[i:], m.SigningKey)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SigningKey)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.IdentityKey) > 0 {
+		i -= len(m.IdentityKey)
+		copy(dAtA[i:], m.IdentityKey)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.IdentityKey)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.NodeId) > 0 {
+		i -= len(m.NodeId)
+		copy(dAtA[i:], m.NodeId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NodeId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPublishPrekeyBundleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPublishPrekeyBundleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPublishPrekeyBundleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgReplenishOneTimePrekeys) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReplenishOneTimePrekeys) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReplenishOneTimePrekeys) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OneTimePrekeys) > 0 {
+		for iNdEx := len(m.OneTimePrekeys) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OneTimePrekeys[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.NodeId) > 0 {
+		i -= len(m.NodeId)
+		copy(dAtA[i:], m.NodeId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NodeId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgReplenishOneTimePrekeysResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReplenishOneTimePrekeysResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReplenishOneTimePrekeysResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.OneTimePrekeyCount != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.OneTimePrekeyCount))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimPrekeyBundle) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimPrekeyBundle) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimPrekeyBundle) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NodeId) > 0 {
+		i -= len(m.NodeId)
+		copy(dAtA[i:], m.NodeId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NodeId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ClaimerNodeId) > 0 {
+		i -= len(m.ClaimerNodeId)
+		copy(dAtA[i:], m.ClaimerNodeId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ClaimerNodeId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimPrekeyBundleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimPrekeyBundleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimPrekeyBundleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.OneTimePrekey != nil {
+		{
+			size, err := m.OneTimePrekey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.PrekeyBundle.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCreatePost) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Content)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.MediaUrl)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.MediaType)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.GroupId != 0 {
+		n += 1 + sovTx(uint64(m.GroupId))
+	}
+	l = len(m.QuotedIndex)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.SourceIndexes) > 0 {
+		for _, s := range m.SourceIndexes {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgCreatePostResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Index)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgReplyToPost) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ParentIndex)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Content)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.MediaUrl)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.MediaType)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.QuotedIndex)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgReplyToPostResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Index)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgVotePost) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.PostIndex)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.VoteType)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgVotePostResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCreateSocialPost) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Index)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Content)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.MediaUrl)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.MediaType)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.GroupId != 0 {
+		n += 1 + sovTx(uint64(m.GroupId))
+	}
+	l = len(m.Author)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Upvotes != 0 {
+		n += 1 + sovTx(uint64(m.Upvotes))
+	}
+	if m.Downvotes != 0 {
+		n += 1 + sovTx(uint64(m.Downvotes))
+	}
+	if m.CreatedAt != 0 {
+		n += 1 + sovTx(uint64(m.CreatedAt))
+	}
+	return n
+}
+
+func (m *MsgCreateSocialPostResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Index)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateSocialPost) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Index)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Content)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.MediaUrl)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.MediaType)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.GroupId != 0 {
+		n += 1 + sovTx(uint64(m.GroupId))
+	}
+	l = len(m.Author)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Upvotes != 0 {
+		n += 1 + sovTx(uint64(m.Upvotes))
+	}
+	if m.Downvotes != 0 {
+		n += 1 + sovTx(uint64(m.Downvotes))
+	}
+	if m.CreatedAt != 0 {
+		n += 1 + sovTx(uint64(m.CreatedAt))
+	}
+	return n
+}
+
+func (m *MsgUpdateSocialPostResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDeleteSocialPost) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Index)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDeleteSocialPostResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCreateVote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Index)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.VoterAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.PostIndex)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.VoteType)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sovTx(uint64(m.Timestamp))
+	}
+	return n
+}
+
+func (m *MsgCreateVoteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateVote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Index)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.VoterAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.PostIndex)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.VoteType)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sovTx(uint64(m.Timestamp))
+	}
+	return n
+}
+
+func (m *MsgUpdateVoteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDeleteVote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Index)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDeleteVoteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCreateSource) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Index)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Url)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.CredibilityScore != 0 {
+		n += 1 + sovTx(uint64(m.CredibilityScore))
+	}
+	l = len(m.AnalysisSummary)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Verified {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgCreateSourceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateSource) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Index)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Url)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.CredibilityScore != 0 {
+		n += 1 + sovTx(uint64(m.CredibilityScore))
+	}
+	l = len(m.AnalysisSummary)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Verified {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgUpdateSourceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDeleteSource) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Index)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDeleteSourceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgVoteSource) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.SourceIndex)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.VoteType)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgVoteSourceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CredibilityScore != 0 {
+		n += 1 + sovTx(uint64(m.CredibilityScore))
+	}
+	return n
+}
+
+func (m *MsgVerifySource) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Index)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Verified {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgVerifySourceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CredibilityScore != 0 {
+		n += 1 + sovTx(uint64(m.CredibilityScore))
+	}
+	return n
+}
+
+func (m *MsgCreatePostTag) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Index)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.PostIndex)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Tag)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Category)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.SimilarityScore != 0 {
+		n += 1 + sovTx(uint64(m.SimilarityScore))
+	}
+	l = len(m.RelatedPosts)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCreatePostTagResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdatePostTag) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Index)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.PostIndex)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Tag)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Category)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.SimilarityScore != 0 {
+		n += 1 + sovTx(uint64(m.SimilarityScore))
+	}
+	l = len(m.RelatedPosts)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdatePostTagResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDeletePostTag) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Index)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDeletePostTagResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDistributeContent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ContentId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ContentData)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TargetReplicas != 0 {
+		n += 1 + sovTx(uint64(m.TargetReplicas))
+	}
+	l = len(m.ReplicationStrategy)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.PreferredNodes) > 0 {
+		for _, s := range m.PreferredNodes {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgDistributeContentResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.IpfsHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.AssignedNodes) > 0 {
+		for _, s := range m.AssignedNodes {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.DistributionId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSyncHubContent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.SourceNode)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TargetNode)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.ContentIds) > 0 {
+		for _, s := range m.ContentIds {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.SyncMethod)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.LastSyncTimestamp != 0 {
+		n += 1 + sovTx(uint64(m.LastSyncTimestamp))
+	}
+	return n
+}
+
+func (m *MsgSyncHubContentResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SyncId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.EstimatedBytes != 0 {
+		n += 1 + sovTx(uint64(m.EstimatedBytes))
+	}
+	if m.EstimatedDuration != 0 {
+		n += 1 + sovTx(uint64(m.EstimatedDuration))
+	}
+	return n
+}
+
+func (m *MsgSendSignalMessage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.RecipientNode)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.EncryptedPayload)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.MessageType)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.SenderNode)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSendSignalMessageResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MessageId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.DeliveryConfirmed {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgAckMessages) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.NodeId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.MessageIds) > 0 {
+		for _, s := range m.MessageIds {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgAckMessagesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Acked != 0 {
+		n += 1 + sovTx(uint64(m.Acked))
+	}
+	return n
+}
+
+func (m *MsgAckReplica) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ContentId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.NodeId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Cid)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAckReplicaResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CurrentReplicas != 0 {
+		n += 1 + sovTx(uint64(m.CurrentReplicas))
+	}
+	return n
+}
+
+func (m *MsgAnswerChallenge) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ChallengeId != 0 {
+		n += 1 + sovTx(uint64(m.ChallengeId))
+	}
+	l = len(m.Chunk)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Proof) > 0 {
+		for _, b := range m.Proof {
+			l = len(b)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgAnswerChallengeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Passed {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgReportSyncProgress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.SyncId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.BytesTransferred != 0 {
+		n += 1 + sovTx(uint64(m.BytesTransferred))
+	}
+	return n
+}
+
+func (m *MsgReportSyncProgressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCompleteSync) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.SyncId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Success {
+		n += 2
+	}
+	if m.BytesTransferred != 0 {
+		n += 1 + sovTx(uint64(m.BytesTransferred))
+	}
+	l = len(m.FailureReason)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCompleteSyncResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgPublishPrekeyBundle) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.NodeId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.IdentityKey)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.SigningKey)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.SignedPrekeyId != 0 {
+		n += 1 + sovTx(uint64(m.SignedPrekeyId))
+	}
+	l = len(m.SignedPrekey)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.SignedPrekeySignature)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.OneTimePrekeys) > 0 {
+		for _, e := range m.OneTimePrekeys {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgPublishPrekeyBundleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgReplenishOneTimePrekeys) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.NodeId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.OneTimePrekeys) > 0 {
+		for _, e := range m.OneTimePrekeys {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgReplenishOneTimePrekeysResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OneTimePrekeyCount != 0 {
+		n += 1 + sovTx(uint64(m.OneTimePrekeyCount))
+	}
+	return n
+}
+
+func (m *MsgClaimPrekeyBundle) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ClaimerNodeId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.NodeId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgClaimPrekeyBundleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.PrekeyBundle.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.OneTimePrekey != nil {
+		l = m.OneTimePrekey.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreatePost) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreatePost: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreatePost: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Content", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Content = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MediaUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MediaUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MediaType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MediaType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+			}
+			m.GroupId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GroupId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuotedIndex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QuotedIndex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceIndexes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceIndexes = append(m.SourceIndexes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreatePostResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreatePostResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreatePostResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Index = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgReplyToPost) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgReplyToPost: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgReplyToPost: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParentIndex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ParentIndex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Content", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Content = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MediaUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MediaUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MediaType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MediaType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuotedIndex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QuotedIndex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgReplyToPostResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgReplyToPostResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgReplyToPostResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Index = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgVotePost) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgVotePost: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgVotePost: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PostIndex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PostIndex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VoteType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgVotePostResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgVotePostResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgVotePostResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateSocialPost) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateSocialPost: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateSocialPost: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Index = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Content", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Content = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MediaUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MediaUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MediaType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MediaType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+			}
+			m.GroupId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GroupId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Author", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Author = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Upvotes", wireType)
+			}
+			m.Upvotes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Upvotes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Downvotes", wireType)
+			}
+			m.Downvotes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Downvotes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			m.CreatedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedAt |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateSocialPostResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateSocialPostResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateSocialPostResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Index = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateSocialPost) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateSocialPost: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateSocialPost: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Index = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Content", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Content = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MediaUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MediaUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MediaType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MediaType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+			}
+			m.GroupId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GroupId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Author", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Author = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Upvotes", wireType)
+			}
+			m.Upvotes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Upvotes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Downvotes", wireType)
+			}
+			m.Downvotes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Downvotes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			m.CreatedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedAt |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateSocialPostResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateSocialPostResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateSocialPostResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeleteSocialPost) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeleteSocialPost: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeleteSocialPost: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Index = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeleteSocialPostResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeleteSocialPostResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeleteSocialPostResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateVote) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateVote: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateVote: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Index = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoterAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VoterAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PostIndex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PostIndex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VoteType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateVoteResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateVoteResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateVoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateVote) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateVote: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateVote: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Index = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoterAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VoterAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PostIndex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PostIndex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VoteType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateVoteResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateVoteResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateVoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeleteVote) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeleteVote: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeleteVote: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Index = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeleteVoteResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeleteVoteResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeleteVoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateSource) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateSource: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateSource: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Index = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Url", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Url = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5534,13 +11608,13 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.Title = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5550,25 +11624,95 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CredibilityScore", wireType)
+			}
+			m.CredibilityScore = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CredibilityScore |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AnalysisSummary", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.AnalysisSummary = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Verified", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Verified = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -5590,7 +11734,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCreateSourceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5613,10 +11757,10 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCreateSourceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreateSourceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -5640,7 +11784,7 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreatePost) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateSource) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5663,10 +11807,10 @@ func (m *MsgCreatePost) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreatePost: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateSource: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreatePost: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateSource: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5703,7 +11847,7 @@ func (m *MsgCreatePost) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5731,11 +11875,11 @@ func (m *MsgCreatePost) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Title = string(dAtA[iNdEx:postIndex])
+			m.Index = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Content", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Url", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5763,11 +11907,11 @@ func (m *MsgCreatePost) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Content = string(dAtA[iNdEx:postIndex])
+			m.Url = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MediaUrl", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5795,11 +11939,11 @@ func (m *MsgCreatePost) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MediaUrl = string(dAtA[iNdEx:postIndex])
+			m.Title = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MediaType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5827,13 +11971,13 @@ func (m *MsgCreatePost) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MediaType = string(dAtA[iNdEx:postIndex])
+			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CredibilityScore", wireType)
 			}
-			m.GroupId = 0
+			m.CredibilityScore = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5843,11 +11987,63 @@ func (m *MsgCreatePost) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.GroupId |= uint64(b&0x7F) << shift
+				m.CredibilityScore |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AnalysisSummary", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AnalysisSummary = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Verified", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.Verified = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -5869,7 +12065,7 @@ func (m *MsgCreatePost) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreatePostResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateSourceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5892,10 +12088,10 @@ func (m *MsgCreatePostResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreatePostResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateSourceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreatePostResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateSourceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -5919,7 +12115,7 @@ func (m *MsgCreatePostResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgVotePost) Unmarshal(dAtA []byte) error {
+func (m *MsgDeleteSource) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5937,52 +12133,20 @@ func (m *MsgVotePost) Unmarshal(dAtA []byte) error {
 			wire |= uint64(b&0x7F) << shift
 			if b < 0x80 {
 				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgVotePost: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgVotePost: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeleteSource: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeleteSource: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PostIndex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6010,11 +12174,11 @@ func (m *MsgVotePost) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PostIndex = string(dAtA[iNdEx:postIndex])
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VoteType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6042,7 +12206,7 @@ func (m *MsgVotePost) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VoteType = string(dAtA[iNdEx:postIndex])
+			m.Index = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6065,7 +12229,7 @@ func (m *MsgVotePost) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgVotePostResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgDeleteSourceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6088,10 +12252,10 @@ func (m *MsgVotePostResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgVotePostResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDeleteSourceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgVotePostResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDeleteSourceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -6115,7 +12279,7 @@ func (m *MsgVotePostResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateSocialPost) Unmarshal(dAtA []byte) error {
+func (m *MsgVoteSource) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6138,10 +12302,10 @@ func (m *MsgCreateSocialPost) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateSocialPost: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgVoteSource: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateSocialPost: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgVoteSource: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6178,7 +12342,7 @@ func (m *MsgCreateSocialPost) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceIndex", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6206,11 +12370,11 @@ func (m *MsgCreateSocialPost) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Index = string(dAtA[iNdEx:postIndex])
+			m.SourceIndex = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteType", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6238,45 +12402,63 @@ func (m *MsgCreateSocialPost) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Title = string(dAtA[iNdEx:postIndex])
+			m.VoteType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Content", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgVoteSourceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Content = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MediaUrl", wireType)
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			var stringLen uint64
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgVoteSourceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgVoteSourceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CredibilityScore", wireType)
+			}
+			m.CredibilityScore = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -6286,27 +12468,64 @@ func (m *MsgCreateSocialPost) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.CredibilityScore |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MediaUrl = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgVerifySource) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgVerifySource: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgVerifySource: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MediaType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6334,30 +12553,11 @@ func (m *MsgCreateSocialPost) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MediaType = string(dAtA[iNdEx:postIndex])
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
-			}
-			m.GroupId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.GroupId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 8:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Author", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6385,51 +12585,13 @@ func (m *MsgCreateSocialPost) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Author = string(dAtA[iNdEx:postIndex])
+			m.Index = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Upvotes", wireType)
-			}
-			m.Upvotes = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Upvotes |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Downvotes", wireType)
-			}
-			m.Downvotes = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Downvotes |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 11:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Verified", wireType)
 			}
-			m.CreatedAt = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -6439,11 +12601,12 @@ func (m *MsgCreateSocialPost) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CreatedAt |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.Verified = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -6465,7 +12628,7 @@ func (m *MsgCreateSocialPost) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateSocialPostResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgVerifySourceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6488,12 +12651,31 @@ func (m *MsgCreateSocialPostResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateSocialPostResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgVerifySourceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateSocialPostResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgVerifySourceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CredibilityScore", wireType)
+			}
+			m.CredibilityScore = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CredibilityScore |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -6515,7 +12697,7 @@ func (m *MsgCreateSocialPostResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateSocialPost) Unmarshal(dAtA []byte) error {
+func (m *MsgCreatePostTag) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6538,10 +12720,10 @@ func (m *MsgUpdateSocialPost) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateSocialPost: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCreatePostTag: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateSocialPost: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreatePostTag: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6610,7 +12792,7 @@ func (m *MsgUpdateSocialPost) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PostIndex", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6638,11 +12820,11 @@ func (m *MsgUpdateSocialPost) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Title = string(dAtA[iNdEx:postIndex])
+			m.PostIndex = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Content", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tag", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6670,11 +12852,11 @@ func (m *MsgUpdateSocialPost) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Content = string(dAtA[iNdEx:postIndex])
+			m.Tag = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MediaUrl", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Category", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6702,45 +12884,13 @@ func (m *MsgUpdateSocialPost) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MediaUrl = string(dAtA[iNdEx:postIndex])
+			m.Category = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MediaType", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.MediaType = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SimilarityScore", wireType)
 			}
-			m.GroupId = 0
+			m.SimilarityScore = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -6750,14 +12900,14 @@ func (m *MsgUpdateSocialPost) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.GroupId |= uint64(b&0x7F) << shift
+				m.SimilarityScore |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 8:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Author", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RelatedPosts", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6785,65 +12935,8 @@ func (m *MsgUpdateSocialPost) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Author = string(dAtA[iNdEx:postIndex])
+			m.RelatedPosts = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Upvotes", wireType)
-			}
-			m.Upvotes = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Upvotes |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Downvotes", wireType)
-			}
-			m.Downvotes = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Downvotes |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
-			}
-			m.CreatedAt = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.CreatedAt |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -6865,7 +12958,7 @@ func (m *MsgUpdateSocialPost) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateSocialPostResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCreatePostTagResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6888,10 +12981,10 @@ func (m *MsgUpdateSocialPostResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateSocialPostResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCreatePostTagResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateSocialPostResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreatePostTagResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -6915,7 +13008,7 @@ func (m *MsgUpdateSocialPostResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteSocialPost) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdatePostTag) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6938,15 +13031,143 @@ func (m *MsgDeleteSocialPost) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteSocialPost: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdatePostTag: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteSocialPost: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdatePostTag: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Index = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PostIndex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PostIndex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tag", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tag = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Category", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6974,11 +13195,30 @@ func (m *MsgDeleteSocialPost) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.Category = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SimilarityScore", wireType)
+			}
+			m.SimilarityScore = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SimilarityScore |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RelatedPosts", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7006,7 +13246,7 @@ func (m *MsgDeleteSocialPost) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Index = string(dAtA[iNdEx:postIndex])
+			m.RelatedPosts = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7029,7 +13269,7 @@ func (m *MsgDeleteSocialPost) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteSocialPostResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdatePostTagResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7052,10 +13292,10 @@ func (m *MsgDeleteSocialPostResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteSocialPostResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdatePostTagResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteSocialPostResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdatePostTagResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -7079,7 +13319,7 @@ func (m *MsgDeleteSocialPostResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateVote) Unmarshal(dAtA []byte) error {
+func (m *MsgDeletePostTag) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7102,10 +13342,10 @@ func (m *MsgCreateVote) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateVote: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDeletePostTag: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateVote: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDeletePostTag: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7172,121 +13412,6 @@ func (m *MsgCreateVote) Unmarshal(dAtA []byte) error {
 			}
 			m.Index = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VoterAddress", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.VoterAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PostIndex", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PostIndex = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VoteType", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.VoteType = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
-			}
-			m.Timestamp = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Timestamp |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -7308,7 +13433,7 @@ func (m *MsgCreateVote) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateVoteResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgDeletePostTagResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7331,10 +13456,10 @@ func (m *MsgCreateVoteResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateVoteResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDeletePostTagResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateVoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDeletePostTagResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -7358,7 +13483,7 @@ func (m *MsgCreateVoteResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateVote) Unmarshal(dAtA []byte) error {
+func (m *MsgDistributeContent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7381,10 +13506,10 @@ func (m *MsgUpdateVote) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateVote: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDistributeContent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateVote: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDistributeContent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7421,7 +13546,7 @@ func (m *MsgUpdateVote) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContentId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7449,13 +13574,83 @@ func (m *MsgUpdateVote) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Index = string(dAtA[iNdEx:postIndex])
+			m.ContentId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VoterAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContentData", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContentData = append(m.ContentData[:0], dAtA[iNdEx:postIndex]...)
+			if m.ContentData == nil {
+				m.ContentData = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &ContentMetadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetReplicas", wireType)
+			}
+			m.TargetReplicas = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -7465,27 +13660,14 @@ func (m *MsgUpdateVote) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.TargetReplicas |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.VoterAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PostIndex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReplicationStrategy", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7513,11 +13695,11 @@ func (m *MsgUpdateVote) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PostIndex = string(dAtA[iNdEx:postIndex])
+			m.ReplicationStrategy = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VoteType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PreferredNodes", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7545,77 +13727,8 @@ func (m *MsgUpdateVote) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VoteType = string(dAtA[iNdEx:postIndex])
+			m.PreferredNodes = append(m.PreferredNodes, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
-			}
-			m.Timestamp = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Timestamp |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgUpdateVoteResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateVoteResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateVoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -7637,7 +13750,7 @@ func (m *MsgUpdateVoteResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteVote) Unmarshal(dAtA []byte) error {
+func (m *MsgDistributeContentResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7660,15 +13773,15 @@ func (m *MsgDeleteVote) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteVote: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDistributeContentResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteVote: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDistributeContentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IpfsHash", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7696,11 +13809,11 @@ func (m *MsgDeleteVote) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.IpfsHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AssignedNodes", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7728,58 +13841,40 @@ func (m *MsgDeleteVote) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Index = string(dAtA[iNdEx:postIndex])
+			m.AssignedNodes = append(m.AssignedNodes, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributionId", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgDeleteVoteResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteVoteResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteVoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			m.DistributionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -7801,7 +13896,7 @@ func (m *MsgDeleteVoteResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateSource) Unmarshal(dAtA []byte) error {
+func (m *MsgSyncHubContent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7824,10 +13919,10 @@ func (m *MsgCreateSource) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateSource: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSyncHubContent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateSource: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSyncHubContent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7864,7 +13959,7 @@ func (m *MsgCreateSource) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceNode", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7892,75 +13987,11 @@ func (m *MsgCreateSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Index = string(dAtA[iNdEx:postIndex])
+			m.SourceNode = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Url", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Url = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Title = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetNode", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7988,13 +14019,13 @@ func (m *MsgCreateSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			m.TargetNode = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CredibilityScore", wireType)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContentIds", wireType)
 			}
-			m.CredibilityScore = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -8004,14 +14035,27 @@ func (m *MsgCreateSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CredibilityScore |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContentIds = append(m.ContentIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AnalysisSummary", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncMethod", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8039,13 +14083,13 @@ func (m *MsgCreateSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AnalysisSummary = string(dAtA[iNdEx:postIndex])
+			m.SyncMethod = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Verified", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastSyncTimestamp", wireType)
 			}
-			var v int
+			m.LastSyncTimestamp = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -8055,12 +14099,11 @@ func (m *MsgCreateSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.LastSyncTimestamp |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Verified = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -8082,7 +14125,7 @@ func (m *MsgCreateSource) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateSourceResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSyncHubContentResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8105,12 +14148,82 @@ func (m *MsgCreateSourceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateSourceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSyncHubContentResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateSourceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSyncHubContentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SyncId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EstimatedBytes", wireType)
+			}
+			m.EstimatedBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EstimatedBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EstimatedDuration", wireType)
+			}
+			m.EstimatedDuration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EstimatedDuration |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -8132,7 +14245,7 @@ func (m *MsgCreateSourceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateSource) Unmarshal(dAtA []byte) error {
+func (m *MsgSendSignalMessage) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8155,10 +14268,10 @@ func (m *MsgUpdateSource) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateSource: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSendSignalMessage: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateSource: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSendSignalMessage: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -8195,7 +14308,7 @@ func (m *MsgUpdateSource) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RecipientNode", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8223,11 +14336,11 @@ func (m *MsgUpdateSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Index = string(dAtA[iNdEx:postIndex])
+			m.RecipientNode = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Url", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8255,11 +14368,45 @@ func (m *MsgUpdateSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Url = string(dAtA[iNdEx:postIndex])
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EncryptedPayload", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EncryptedPayload = append(m.EncryptedPayload[:0], dAtA[iNdEx:postIndex]...)
+			if m.EncryptedPayload == nil {
+				m.EncryptedPayload = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageType", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8287,11 +14434,11 @@ func (m *MsgUpdateSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Title = string(dAtA[iNdEx:postIndex])
+			m.MessageType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8319,13 +14466,13 @@ func (m *MsgUpdateSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			m.Signature = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CredibilityScore", wireType)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SenderNode", wireType)
 			}
-			m.CredibilityScore = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -8335,14 +14482,77 @@ func (m *MsgUpdateSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CredibilityScore |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SenderNode = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSendSignalMessageResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSendSignalMessageResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSendSignalMessageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AnalysisSummary", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8370,11 +14580,11 @@ func (m *MsgUpdateSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AnalysisSummary = string(dAtA[iNdEx:postIndex])
+			m.MessageId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Verified", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DeliveryConfirmed", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -8391,7 +14601,7 @@ func (m *MsgUpdateSource) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.Verified = bool(v != 0)
+			m.DeliveryConfirmed = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -8413,7 +14623,7 @@ func (m *MsgUpdateSource) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateSourceResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgAckMessages) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8436,65 +14646,47 @@ func (m *MsgUpdateSourceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateSourceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAckMessages: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateSourceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAckMessages: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgDeleteSource) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteSource: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteSource: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8522,11 +14714,11 @@ func (m *MsgDeleteSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.NodeId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageIds", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8554,7 +14746,7 @@ func (m *MsgDeleteSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Index = string(dAtA[iNdEx:postIndex])
+			m.MessageIds = append(m.MessageIds, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8577,7 +14769,7 @@ func (m *MsgDeleteSource) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteSourceResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgAckMessagesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8600,12 +14792,31 @@ func (m *MsgDeleteSourceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteSourceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAckMessagesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteSourceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAckMessagesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Acked", wireType)
+			}
+			m.Acked = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Acked |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -8627,7 +14838,7 @@ func (m *MsgDeleteSourceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreatePostTag) Unmarshal(dAtA []byte) error {
+func (m *MsgAckReplica) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8650,10 +14861,10 @@ func (m *MsgCreatePostTag) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreatePostTag: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAckReplica: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreatePostTag: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAckReplica: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -8690,7 +14901,7 @@ func (m *MsgCreatePostTag) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContentId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8718,11 +14929,11 @@ func (m *MsgCreatePostTag) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Index = string(dAtA[iNdEx:postIndex])
+			m.ContentId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PostIndex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8750,11 +14961,11 @@ func (m *MsgCreatePostTag) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PostIndex = string(dAtA[iNdEx:postIndex])
+			m.NodeId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tag", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Cid", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8782,11 +14993,130 @@ func (m *MsgCreatePostTag) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Tag = string(dAtA[iNdEx:postIndex])
+			m.Cid = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAckReplicaResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAckReplicaResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAckReplicaResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentReplicas", wireType)
+			}
+			m.CurrentReplicas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CurrentReplicas |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAnswerChallenge) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAnswerChallenge: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAnswerChallenge: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Category", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8814,13 +15144,13 @@ func (m *MsgCreatePostTag) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Category = string(dAtA[iNdEx:postIndex])
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SimilarityScore", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChallengeId", wireType)
 			}
-			m.SimilarityScore = 0
+			m.ChallengeId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -8830,16 +15160,50 @@ func (m *MsgCreatePostTag) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SimilarityScore |= int64(b&0x7F) << shift
+				m.ChallengeId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chunk", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Chunk = append(m.Chunk[:0], dAtA[iNdEx:postIndex]...)
+			if m.Chunk == nil {
+				m.Chunk = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RelatedPosts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -8849,23 +15213,23 @@ func (m *MsgCreatePostTag) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RelatedPosts = string(dAtA[iNdEx:postIndex])
+			m.Proof = append(m.Proof, make([]byte, postIndex-iNdEx))
+			copy(m.Proof[len(m.Proof)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8888,7 +15252,7 @@ func (m *MsgCreatePostTag) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreatePostTagResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgAnswerChallengeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8911,12 +15275,32 @@ func (m *MsgCreatePostTagResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreatePostTagResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAnswerChallengeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreatePostTagResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAnswerChallengeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Passed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Passed = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -8938,7 +15322,7 @@ func (m *MsgCreatePostTagResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdatePostTag) Unmarshal(dAtA []byte) error {
+func (m *MsgReportSyncProgress) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8961,10 +15345,10 @@ func (m *MsgUpdatePostTag) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdatePostTag: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgReportSyncProgress: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdatePostTag: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgReportSyncProgress: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9001,7 +15385,7 @@ func (m *MsgUpdatePostTag) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9029,128 +15413,13 @@ func (m *MsgUpdatePostTag) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Index = string(dAtA[iNdEx:postIndex])
+			m.SyncId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PostIndex", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PostIndex = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tag", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Tag = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Category", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Category = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SimilarityScore", wireType)
-			}
-			m.SimilarityScore = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SimilarityScore |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RelatedPosts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BytesTransferred", wireType)
 			}
-			var stringLen uint64
+			m.BytesTransferred = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -9160,24 +15429,11 @@ func (m *MsgUpdatePostTag) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.BytesTransferred |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.RelatedPosts = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -9199,7 +15455,7 @@ func (m *MsgUpdatePostTag) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdatePostTagResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgReportSyncProgressResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9222,10 +15478,10 @@ func (m *MsgUpdatePostTagResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdatePostTagResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgReportSyncProgressResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdatePostTagResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgReportSyncProgressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -9249,7 +15505,7 @@ func (m *MsgUpdatePostTagResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeletePostTag) Unmarshal(dAtA []byte) error {
+func (m *MsgCompleteSync) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9272,10 +15528,10 @@ func (m *MsgDeletePostTag) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeletePostTag: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCompleteSync: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeletePostTag: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCompleteSync: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9312,7 +15568,7 @@ func (m *MsgDeletePostTag) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9340,7 +15596,78 @@ func (m *MsgDeletePostTag) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Index = string(dAtA[iNdEx:postIndex])
+			m.SyncId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Success = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BytesTransferred", wireType)
+			}
+			m.BytesTransferred = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BytesTransferred |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailureReason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FailureReason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9363,7 +15690,7 @@ func (m *MsgDeletePostTag) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeletePostTagResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCompleteSyncResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9386,10 +15713,10 @@ func (m *MsgDeletePostTagResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeletePostTagResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCompleteSyncResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeletePostTagResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCompleteSyncResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -9413,7 +15740,7 @@ func (m *MsgDeletePostTagResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDistributeContent) Unmarshal(dAtA []byte) error {
+func (m *MsgPublishPrekeyBundle) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9436,10 +15763,10 @@ func (m *MsgDistributeContent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDistributeContent: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgPublishPrekeyBundle: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDistributeContent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgPublishPrekeyBundle: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9476,7 +15803,7 @@ func (m *MsgDistributeContent) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContentId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9504,11 +15831,11 @@ func (m *MsgDistributeContent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContentId = string(dAtA[iNdEx:postIndex])
+			m.NodeId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContentData", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IdentityKey", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -9535,16 +15862,16 @@ func (m *MsgDistributeContent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContentData = append(m.ContentData[:0], dAtA[iNdEx:postIndex]...)
-			if m.ContentData == nil {
-				m.ContentData = []byte{}
+			m.IdentityKey = append(m.IdentityKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.IdentityKey == nil {
+				m.IdentityKey = []byte{}
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SigningKey", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -9554,33 +15881,31 @@ func (m *MsgDistributeContent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Metadata == nil {
-				m.Metadata = &ContentMetadata{}
-			}
-			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.SigningKey = append(m.SigningKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.SigningKey == nil {
+				m.SigningKey = []byte{}
 			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TargetReplicas", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SignedPrekeyId", wireType)
 			}
-			m.TargetReplicas = 0
+			m.SignedPrekeyId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -9590,16 +15915,16 @@ func (m *MsgDistributeContent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TargetReplicas |= uint32(b&0x7F) << shift
+				m.SignedPrekeyId |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReplicationStrategy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SignedPrekey", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -9609,29 +15934,31 @@ func (m *MsgDistributeContent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ReplicationStrategy = string(dAtA[iNdEx:postIndex])
+			m.SignedPrekey = append(m.SignedPrekey[:0], dAtA[iNdEx:postIndex]...)
+			if m.SignedPrekey == nil {
+				m.SignedPrekey = []byte{}
+			}
 			iNdEx = postIndex
 		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PreferredNodes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SignedPrekeySignature", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -9641,23 +15968,59 @@ func (m *MsgDistributeContent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PreferredNodes = append(m.PreferredNodes, string(dAtA[iNdEx:postIndex]))
+			m.SignedPrekeySignature = append(m.SignedPrekeySignature[:0], dAtA[iNdEx:postIndex]...)
+			if m.SignedPrekeySignature == nil {
+				m.SignedPrekeySignature = []byte{}
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OneTimePrekeys", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OneTimePrekeys = append(m.OneTimePrekeys, OneTimePrekey{})
+			if err := m.OneTimePrekeys[len(m.OneTimePrekeys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9680,7 +16043,7 @@ func (m *MsgDistributeContent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDistributeContentResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgPublishPrekeyBundleResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9703,108 +16066,12 @@ func (m *MsgDistributeContentResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDistributeContentResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgPublishPrekeyBundleResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDistributeContentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgPublishPrekeyBundleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IpfsHash", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.IpfsHash = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AssignedNodes", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.AssignedNodes = append(m.AssignedNodes, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DistributionId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DistributionId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -9826,7 +16093,7 @@ func (m *MsgDistributeContentResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSyncHubContent) Unmarshal(dAtA []byte) error {
+func (m *MsgReplenishOneTimePrekeys) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9842,86 +16109,22 @@ func (m *MsgSyncHubContent) Unmarshal(dAtA []byte) error {
 			b := dAtA[iNdEx]
 			iNdEx++
 			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSyncHubContent: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSyncHubContent: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourceNode", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if b < 0x80 {
+				break
 			}
-			m.SourceNode = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgReplenishOneTimePrekeys: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgReplenishOneTimePrekeys: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TargetNode", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9949,11 +16152,11 @@ func (m *MsgSyncHubContent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TargetNode = string(dAtA[iNdEx:postIndex])
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContentIds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9981,13 +16184,13 @@ func (m *MsgSyncHubContent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContentIds = append(m.ContentIds, string(dAtA[iNdEx:postIndex]))
+			m.NodeId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SyncMethod", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OneTimePrekeys", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -9997,43 +16200,26 @@ func (m *MsgSyncHubContent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SyncMethod = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastSyncTimestamp", wireType)
-			}
-			m.LastSyncTimestamp = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.LastSyncTimestamp |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			m.OneTimePrekeys = append(m.OneTimePrekeys, OneTimePrekey{})
+			if err := m.OneTimePrekeys[len(m.OneTimePrekeys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -10055,7 +16241,7 @@ func (m *MsgSyncHubContent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSyncHubContentResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgReplenishOneTimePrekeysResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10078,68 +16264,17 @@ func (m *MsgSyncHubContentResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSyncHubContentResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgReplenishOneTimePrekeysResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSyncHubContentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgReplenishOneTimePrekeysResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SyncId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.SyncId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EstimatedBytes", wireType)
-			}
-			m.EstimatedBytes = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.EstimatedBytes |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EstimatedDuration", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OneTimePrekeyCount", wireType)
 			}
-			m.EstimatedDuration = 0
+			m.OneTimePrekeyCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -10149,7 +16284,7 @@ func (m *MsgSyncHubContentResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EstimatedDuration |= int64(b&0x7F) << shift
+				m.OneTimePrekeyCount |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -10175,7 +16310,7 @@ func (m *MsgSyncHubContentResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSendSignalMessage) Unmarshal(dAtA []byte) error {
+func (m *MsgClaimPrekeyBundle) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10198,10 +16333,10 @@ func (m *MsgSendSignalMessage) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSendSignalMessage: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgClaimPrekeyBundle: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSendSignalMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgClaimPrekeyBundle: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -10238,7 +16373,7 @@ func (m *MsgSendSignalMessage) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecipientNode", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimerNodeId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10266,109 +16401,11 @@ func (m *MsgSendSignalMessage) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RecipientNode = string(dAtA[iNdEx:postIndex])
+			m.ClaimerNodeId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ChannelId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EncryptedPayload", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.EncryptedPayload = append(m.EncryptedPayload[:0], dAtA[iNdEx:postIndex]...)
-			if m.EncryptedPayload == nil {
-				m.EncryptedPayload = []byte{}
-			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MessageType", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.MessageType = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10396,7 +16433,7 @@ func (m *MsgSendSignalMessage) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signature = string(dAtA[iNdEx:postIndex])
+			m.NodeId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10419,7 +16456,7 @@ func (m *MsgSendSignalMessage) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSendSignalMessageResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgClaimPrekeyBundleResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10442,17 +16479,17 @@ func (m *MsgSendSignalMessageResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSendSignalMessageResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgClaimPrekeyBundleResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSendSignalMessageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgClaimPrekeyBundleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MessageId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PrekeyBundle", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -10462,29 +16499,30 @@ func (m *MsgSendSignalMessageResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MessageId = string(dAtA[iNdEx:postIndex])
+			if err := m.PrekeyBundle.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeliveryConfirmed", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OneTimePrekey", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -10494,12 +16532,28 @@ func (m *MsgSendSignalMessageResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.DeliveryConfirmed = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OneTimePrekey == nil {
+				m.OneTimePrekey = &OneTimePrekey{}
+			}
+			if err := m.OneTimePrekey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
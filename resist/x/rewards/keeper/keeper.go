@@ -25,6 +25,8 @@ type Keeper struct {
 	ResourceOffers      collections.Map[string, types.ResourceOffer]
 	ResourceAllocations collections.Map[string, types.ResourceAllocation]
 	HubMetrics          collections.Map[string, types.HubMetrics]
+	// IDSeq is hashed into the IDs of offers and allocations.
+	IDSeq collections.Sequence
 }
 
 func NewKeeper(
@@ -51,6 +53,7 @@ func NewKeeper(
 		ResourceOffers:      collections.NewMap(sb, types.ResourceOffersKey, "resource_offers", collections.StringKey, codec.CollValue[types.ResourceOffer](cdc)),
 		ResourceAllocations: collections.NewMap(sb, types.ResourceAllocationsKey, "resource_allocations", collections.StringKey, codec.CollValue[types.ResourceAllocation](cdc)),
 		HubMetrics:          collections.NewMap(sb, types.HubMetricsKey, "hub_metrics", collections.StringKey, codec.CollValue[types.HubMetrics](cdc)),
+		IDSeq:               collections.NewSequence(sb, types.IDSequenceKey, "id_sequence"),
 	}
 
 	schema, err := sb.Build()
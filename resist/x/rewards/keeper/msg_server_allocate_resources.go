@@ -3,7 +3,6 @@ package keeper
 import (
 	"context"
 	"fmt"
-	"time"
 
 	"resist/x/rewards/types"
 
@@ -55,7 +54,7 @@ func (k msgServer) AllocateResources(ctx context.Context, msg *types.MsgAllocate
 	}
 
 	// Generate allocation ID
-	allocationId, err := GenerateID("alloc")
+	allocationId, err := k.GenerateID(ctx, "alloc")
 	if err != nil {
 		return nil, errorsmod.Wrap(err, "failed to generate allocation ID")
 	}
@@ -64,6 +63,8 @@ func (k msgServer) AllocateResources(ctx context.Context, msg *types.MsgAllocate
 	totalCost := offer.PricePerHour * uint64(msg.DurationHours)
 
 	// Create resource allocation
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	now := sdkCtx.BlockTime().Unix()
 	allocation := types.ResourceAllocation{
 		AllocationId:       allocationId,
 		ContentId:          msg.ContentId,
@@ -71,8 +72,8 @@ func (k msgServer) AllocateResources(ctx context.Context, msg *types.MsgAllocate
 		Requester:          msg.Creator,
 		RequestedResources: msg.RequestedResources,
 		AllocatedResources: msg.RequestedResources, // For now, allocate exactly what's requested
-		StartTime:          time.Now().Unix(),
-		EndTime:            time.Now().Unix() + (msg.DurationHours * 3600), // Convert hours to seconds
+		StartTime:          now,
+		EndTime:            now + (msg.DurationHours * 3600), // Convert hours to seconds
 		Status:             "active",
 		CostPerHour:        offer.PricePerHour,
 		TotalCost:          totalCost,
@@ -100,21 +101,20 @@ func (k msgServer) AllocateResources(ctx context.Context, msg *types.MsgAllocate
 			TotalRevenue:      0,
 			UptimeSeconds:     0,
 			DataServedGb:      0,
-			LastUpdated:       time.Now().Unix(),
+			LastUpdated:       now,
 		}
 	}
 
 	metrics.TotalAllocations++
 	metrics.ActiveAllocations++
 	metrics.TotalRevenue += totalCost
-	metrics.LastUpdated = time.Now().Unix()
+	metrics.LastUpdated = now
 
 	if err := k.HubMetrics.Set(ctx, offer.NodeId, metrics); err != nil {
 		return nil, errorsmod.Wrap(err, "failed to update hub metrics")
 	}
 
 	// Emit event
-	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	sdkCtx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			"resources_allocated",
@@ -3,7 +3,6 @@ package keeper
 import (
 	"context"
 	"fmt"
-	"time"
 
 	"resist/x/rewards/types"
 
@@ -45,12 +44,13 @@ func (k msgServer) CreateResourceOffer(ctx context.Context, msg *types.MsgCreate
 	}
 
 	// Generate offer ID
-	offerId, err := GenerateID("offer")
+	offerId, err := k.GenerateID(ctx, "offer")
 	if err != nil {
 		return nil, errorsmod.Wrap(err, "failed to generate offer ID")
 	}
 
 	// Create resource offer
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	offer := types.ResourceOffer{
 		OfferId:            offerId,
 		NodeId:             msg.NodeId,
@@ -60,7 +60,7 @@ func (k msgServer) CreateResourceOffer(ctx context.Context, msg *types.MsgCreate
 		ContentTypes:       msg.ContentTypes,
 		Location:           node.Location,
 		IsActive:           true,
-		CreatedAt:          time.Now().Unix(),
+		CreatedAt:          sdkCtx.BlockTime().Unix(),
 	}
 
 	// Store the offer
@@ -69,7 +69,6 @@ func (k msgServer) CreateResourceOffer(ctx context.Context, msg *types.MsgCreate
 	}
 
 	// Emit event
-	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	sdkCtx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			"resource_offer_created",
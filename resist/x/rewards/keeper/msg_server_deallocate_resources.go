@@ -3,7 +3,6 @@ package keeper
 import (
 	"context"
 	"fmt"
-	"time"
 
 	"resist/x/rewards/types"
 
@@ -44,7 +43,8 @@ func (k msgServer) DeallocateResources(ctx context.Context, msg *types.MsgDeallo
 
 	// Calculate refund amount (if deallocating early)
 	var refundAmount uint64 = 0
-	currentTime := time.Now().Unix()
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	currentTime := sdkCtx.BlockTime().Unix()
 	if currentTime < allocation.EndTime {
 		// Calculate unused time
 		unusedSeconds := allocation.EndTime - currentTime
@@ -81,7 +81,7 @@ func (k msgServer) DeallocateResources(ctx context.Context, msg *types.MsgDeallo
 		if metrics.ActiveAllocations < 0 {
 			metrics.ActiveAllocations = 0
 		}
-		metrics.LastUpdated = time.Now().Unix()
+		metrics.LastUpdated = currentTime
 
 		if err := k.HubMetrics.Set(ctx, allocation.NodeId, metrics); err != nil {
 			return nil, errorsmod.Wrap(err, "failed to update hub metrics")
@@ -89,7 +89,6 @@ func (k msgServer) DeallocateResources(ctx context.Context, msg *types.MsgDeallo
 	}
 
 	// Emit event
-	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	sdkCtx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			"resources_deallocated",
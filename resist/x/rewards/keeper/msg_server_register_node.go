@@ -2,7 +2,6 @@ package keeper
 
 import (
 	"context"
-	"time"
 
 	"resist/x/rewards/types"
 
@@ -42,12 +41,13 @@ func (k msgServer) RegisterNode(ctx context.Context, msg *types.MsgRegisterNode)
 	}
 
 	// Create the node
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	node := types.Node{
 		NodeId:                msg.NodeId,
 		Owner:                 msg.Creator,
 		NodeType:              msg.NodeType,
 		StakeAmount:           msg.StakeAmount,
-		CreatedAt:             time.Now().Unix(),
+		CreatedAt:             sdkCtx.BlockTime().Unix(),
 		IsActive:              true,
 		AvailableResources:    msg.AvailableResources,
 		AllocatedResources:    &types.ResourceSpec{}, // Initialize empty
@@ -63,7 +63,6 @@ func (k msgServer) RegisterNode(ctx context.Context, msg *types.MsgRegisterNode)
 	}
 
 	// Emit event
-	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	sdkCtx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			"node_registered",
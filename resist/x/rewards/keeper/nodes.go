@@ -0,0 +1,69 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"resist/x/rewards/types"
+)
+
+// GetNode returns the registered node with the given id.
+func (k Keeper) GetNode(ctx context.Context, nodeId string) (types.Node, error) {
+	node, err := k.Nodes.Get(ctx, nodeId)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.Node{}, errorsmod.Wrapf(types.ErrNodeNotFound, "node %s", nodeId)
+	}
+	return node, err
+}
+
+// GetActiveNodes returns every active node, ordered by node id.
+func (k Keeper) GetActiveNodes(ctx context.Context) ([]types.Node, error) {
+	var nodes []types.Node
+	err := k.Nodes.Walk(ctx, nil, func(_ string, node types.Node) (bool, error) {
+		if node.IsActive {
+			nodes = append(nodes, node)
+		}
+		return false, nil
+	})
+	return nodes, err
+}
+
+// GetHubMetrics returns the metrics recorded for a node. Nodes without
+// recorded metrics get zero metrics.
+func (k Keeper) GetHubMetrics(ctx context.Context, nodeId string) (types.HubMetrics, error) {
+	metrics, err := k.HubMetrics.Get(ctx, nodeId)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.HubMetrics{NodeId: nodeId}, nil
+	}
+	return metrics, err
+}
+
+// RecordStorageAudit records the outcome of a storage challenge answered by a
+// node. A failed audit lowers the node's uptime by uptimePenalty percentage
+// points.
+func (k Keeper) RecordStorageAudit(ctx context.Context, nodeId string, passed bool, uptimePenalty uint64) error {
+	node, err := k.GetNode(ctx, nodeId)
+	if err != nil {
+		return err
+	}
+	metrics, err := k.GetHubMetrics(ctx, nodeId)
+	if err != nil {
+		return err
+	}
+
+	if passed {
+		metrics.StorageAuditsPassed++
+	} else {
+		metrics.StorageAuditsFailed++
+		node.UptimePercentage -= min(node.UptimePercentage, uptimePenalty)
+		if err := k.Nodes.Set(ctx, nodeId, node); err != nil {
+			return err
+		}
+	}
+	metrics.LastUpdated = sdk.UnwrapSDKContext(ctx).BlockTime().Unix()
+	return k.HubMetrics.Set(ctx, nodeId, metrics)
+}
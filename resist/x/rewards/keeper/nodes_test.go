@@ -0,0 +1,66 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"resist/x/rewards/types"
+)
+
+func TestNodeAccessors(t *testing.T) {
+	f := initFixture(t)
+
+	require.NoError(t, f.keeper.Nodes.Set(f.ctx, "b", types.Node{NodeId: "b", IsActive: true}))
+	require.NoError(t, f.keeper.Nodes.Set(f.ctx, "a", types.Node{NodeId: "a", IsActive: true}))
+	require.NoError(t, f.keeper.Nodes.Set(f.ctx, "c", types.Node{NodeId: "c", IsActive: false}))
+	require.NoError(t, f.keeper.HubMetrics.Set(f.ctx, "a", types.HubMetrics{NodeId: "a", DataServedGb: 7}))
+
+	node, err := f.keeper.GetNode(f.ctx, "c")
+	require.NoError(t, err)
+	require.Equal(t, "c", node.NodeId)
+
+	_, err = f.keeper.GetNode(f.ctx, "missing")
+	require.ErrorIs(t, err, types.ErrNodeNotFound)
+
+	nodes, err := f.keeper.GetActiveNodes(f.ctx)
+	require.NoError(t, err)
+	require.Len(t, nodes, 2)
+	require.Equal(t, "a", nodes[0].NodeId)
+	require.Equal(t, "b", nodes[1].NodeId)
+
+	metrics, err := f.keeper.GetHubMetrics(f.ctx, "a")
+	require.NoError(t, err)
+	require.Equal(t, uint64(7), metrics.DataServedGb)
+
+	metrics, err = f.keeper.GetHubMetrics(f.ctx, "b")
+	require.NoError(t, err)
+	require.Equal(t, "b", metrics.NodeId)
+	require.Zero(t, metrics.DataServedGb)
+}
+
+func TestRecordStorageAudit(t *testing.T) {
+	f := initFixture(t)
+
+	require.NoError(t, f.keeper.Nodes.Set(f.ctx, "a", types.Node{NodeId: "a", IsActive: true, UptimePercentage: 98}))
+
+	require.NoError(t, f.keeper.RecordStorageAudit(f.ctx, "a", true, 5))
+	require.NoError(t, f.keeper.RecordStorageAudit(f.ctx, "a", false, 5))
+
+	node, err := f.keeper.GetNode(f.ctx, "a")
+	require.NoError(t, err)
+	require.Equal(t, uint64(93), node.UptimePercentage)
+
+	metrics, err := f.keeper.GetHubMetrics(f.ctx, "a")
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), metrics.StorageAuditsPassed)
+	require.Equal(t, uint64(1), metrics.StorageAuditsFailed)
+
+	// Uptime never goes below zero
+	require.NoError(t, f.keeper.RecordStorageAudit(f.ctx, "a", false, 100))
+	node, err = f.keeper.GetNode(f.ctx, "a")
+	require.NoError(t, err)
+	require.Zero(t, node.UptimePercentage)
+
+	require.ErrorIs(t, f.keeper.RecordStorageAudit(f.ctx, "missing", false, 5), types.ErrNodeNotFound)
+}
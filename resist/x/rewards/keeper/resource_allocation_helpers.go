@@ -1,18 +1,30 @@
 package keeper
 
 import (
-	"crypto/rand"
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
 	"fmt"
 	"resist/x/rewards/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-// GenerateID generates a unique ID for offers and allocations
-func GenerateID(prefix string) (string, error) {
-	bytes := make([]byte, 16)
-	if _, err := rand.Read(bytes); err != nil {
+// GenerateID generates a unique ID for offers and allocations. IDs must be
+// the same on every validator, so they hash the header of the block and a
+// sequence instead of using local randomness.
+func (k Keeper) GenerateID(ctx context.Context, prefix string) (string, error) {
+	seq, err := k.IDSeq.Next(ctx)
+	if err != nil {
 		return "", err
 	}
-	return fmt.Sprintf("%s_%x", prefix, bytes), nil
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	hash := sha256.New()
+	hash.Write([]byte(sdkCtx.ChainID()))
+	hash.Write(sdkCtx.HeaderHash())
+	hash.Write(binary.BigEndian.AppendUint64(nil, seq))
+	return fmt.Sprintf("%s_%x", prefix, hash.Sum(nil)[:16]), nil
 }
 
 // ValidateResourceSpec validates that a resource specification is valid
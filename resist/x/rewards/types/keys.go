@@ -29,3 +29,7 @@ var ResourceAllocationsKey = collections.NewPrefix("resource_allocations")
 
 // HubMetricsKey defines the key to store hub metrics
 var HubMetricsKey = collections.NewPrefix("hub_metrics")
+
+// IDSequenceKey defines the key of the sequence used to generate offer and
+// allocation IDs
+var IDSequenceKey = collections.NewPrefix("id_sequence")
@@ -258,13 +258,15 @@ func (m *ResourceOffer) GetCreatedAt() int64 {
 
 // Hub metrics for performance tracking
 type HubMetrics struct {
-	NodeId            string `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
-	TotalAllocations  uint64 `protobuf:"varint,2,opt,name=total_allocations,json=totalAllocations,proto3" json:"total_allocations,omitempty"`
-	ActiveAllocations uint64 `protobuf:"varint,3,opt,name=active_allocations,json=activeAllocations,proto3" json:"active_allocations,omitempty"`
-	TotalRevenue      uint64 `protobuf:"varint,4,opt,name=total_revenue,json=totalRevenue,proto3" json:"total_revenue,omitempty"`
-	UptimeSeconds     uint64 `protobuf:"varint,5,opt,name=uptime_seconds,json=uptimeSeconds,proto3" json:"uptime_seconds,omitempty"`
-	DataServedGb      uint64 `protobuf:"varint,6,opt,name=data_served_gb,json=dataServedGb,proto3" json:"data_served_gb,omitempty"`
-	LastUpdated       int64  `protobuf:"varint,7,opt,name=last_updated,json=lastUpdated,proto3" json:"last_updated,omitempty"`
+	NodeId              string `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	TotalAllocations    uint64 `protobuf:"varint,2,opt,name=total_allocations,json=totalAllocations,proto3" json:"total_allocations,omitempty"`
+	ActiveAllocations   uint64 `protobuf:"varint,3,opt,name=active_allocations,json=activeAllocations,proto3" json:"active_allocations,omitempty"`
+	TotalRevenue        uint64 `protobuf:"varint,4,opt,name=total_revenue,json=totalRevenue,proto3" json:"total_revenue,omitempty"`
+	UptimeSeconds       uint64 `protobuf:"varint,5,opt,name=uptime_seconds,json=uptimeSeconds,proto3" json:"uptime_seconds,omitempty"`
+	DataServedGb        uint64 `protobuf:"varint,6,opt,name=data_served_gb,json=dataServedGb,proto3" json:"data_served_gb,omitempty"`
+	LastUpdated         int64  `protobuf:"varint,7,opt,name=last_updated,json=lastUpdated,proto3" json:"last_updated,omitempty"`
+	StorageAuditsPassed uint64 `protobuf:"varint,8,opt,name=storage_audits_passed,json=storageAuditsPassed,proto3" json:"storage_audits_passed,omitempty"`
+	StorageAuditsFailed uint64 `protobuf:"varint,9,opt,name=storage_audits_failed,json=storageAuditsFailed,proto3" json:"storage_audits_failed,omitempty"`
 }
 
 func (m *HubMetrics) Reset()         { *m = HubMetrics{} }
@@ -349,6 +351,20 @@ func (m *HubMetrics) GetLastUpdated() int64 {
 	return 0
 }
 
+func (m *HubMetrics) GetStorageAuditsPassed() uint64 {
+	if m != nil {
+		return m.StorageAuditsPassed
+	}
+	return 0
+}
+
+func (m *HubMetrics) GetStorageAuditsFailed() uint64 {
+	if m != nil {
+		return m.StorageAuditsFailed
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*ResourceAllocation)(nil), "resist.rewards.v1.ResourceAllocation")
 	proto.RegisterType((*ResourceOffer)(nil), "resist.rewards.v1.ResourceOffer")
@@ -360,46 +376,49 @@ func init() {
 }
 
 var fileDescriptor_003b72126aa2db70 = []byte{
-	// 623 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0xcd, 0x6e, 0xd3, 0x40,
-	0x10, 0xc7, 0xeb, 0x24, 0x4d, 0xe2, 0x49, 0x52, 0xd1, 0x05, 0x15, 0x53, 0xda, 0x10, 0x52, 0x90,
-	0x22, 0x55, 0xa4, 0x14, 0x9e, 0xa0, 0x70, 0xa0, 0x3d, 0x20, 0x2a, 0xb7, 0x5c, 0xb8, 0x58, 0x1b,
-	0xef, 0x14, 0x2c, 0xa5, 0x5e, 0xb3, 0xbb, 0x4e, 0xe1, 0x2d, 0x38, 0xf1, 0x4c, 0x3d, 0xa1, 0x1e,
-	0x39, 0xa2, 0xf6, 0x45, 0xd0, 0xce, 0xfa, 0xa3, 0x51, 0x2f, 0xbd, 0x79, 0x7e, 0xf3, 0xe1, 0x9d,
-	0xff, 0xcc, 0x2e, 0xec, 0x2a, 0xd4, 0x89, 0x36, 0x7b, 0x0a, 0x2f, 0xb8, 0x12, 0x7a, 0x6f, 0xb1,
-	0xbf, 0xa7, 0x50, 0xcb, 0x5c, 0xc5, 0x18, 0xf1, 0xf9, 0x5c, 0xc6, 0xdc, 0x24, 0x32, 0x9d, 0x66,
-	0x4a, 0x1a, 0xc9, 0xd6, 0x5d, 0xf0, 0xb4, 0x08, 0x9e, 0x2e, 0xf6, 0x37, 0xb7, 0xee, 0xe6, 0xa7,
-	0x52, 0xa0, 0x4b, 0x18, 0x5f, 0x36, 0x81, 0x85, 0x45, 0xb9, 0x83, 0xaa, 0x1a, 0xdb, 0x81, 0x41,
-	0x5d, 0x3b, 0x4a, 0x44, 0xe0, 0x8d, 0xbc, 0x89, 0x1f, 0xf6, 0x6b, 0x78, 0x24, 0xd8, 0x36, 0x40,
-	0x2c, 0x53, 0x83, 0xa9, 0xb1, 0x11, 0x0d, 0x8a, 0xf0, 0x0b, 0x72, 0x24, 0xd8, 0x63, 0xe8, 0xd8,
-	0x1f, 0x59, 0x5f, 0x93, 0x7c, 0x6d, 0x6b, 0x1e, 0x09, 0xb6, 0x05, 0xbe, 0xc2, 0xef, 0x39, 0x6a,
-	0x83, 0x2a, 0x68, 0xb9, 0xb4, 0x0a, 0xb0, 0x63, 0x78, 0x58, 0x1a, 0x22, 0x2a, 0x3b, 0xd5, 0xc1,
-	0xea, 0xc8, 0x9b, 0xf4, 0xde, 0x3c, 0x9b, 0xde, 0x69, 0x70, 0x5a, 0x1e, 0xff, 0x24, 0xc3, 0x38,
-	0x64, 0x55, 0x6e, 0x89, 0xb5, 0xad, 0x58, 0x9c, 0x7b, 0xa9, 0x62, 0xfb, 0x9e, 0x15, 0xab, 0xdc,
-	0xba, 0xe2, 0x36, 0x80, 0x36, 0x5c, 0x99, 0xc8, 0x24, 0xe7, 0x18, 0x74, 0x46, 0xde, 0xa4, 0x19,
-	0xfa, 0x44, 0x4e, 0x93, 0x73, 0x64, 0x4f, 0xa0, 0x8b, 0xa9, 0x70, 0xce, 0x2e, 0x39, 0x3b, 0x98,
-	0x0a, 0x72, 0x6d, 0x40, 0x5b, 0x1b, 0x6e, 0x72, 0x1d, 0xf8, 0x4e, 0x13, 0x67, 0xb1, 0x31, 0x0c,
-	0x62, 0xa9, 0x4d, 0x94, 0xa1, 0x8a, 0xbe, 0xc9, 0x5c, 0x05, 0x30, 0xf2, 0x26, 0xad, 0xb0, 0x67,
-	0xe1, 0x31, 0xaa, 0x43, 0x99, 0x2b, 0xfb, 0x57, 0x23, 0x0d, 0x9f, 0x47, 0x16, 0x06, 0x3d, 0x0a,
-	0xf0, 0x89, 0xbc, 0x97, 0xda, 0x8c, 0xff, 0x34, 0x60, 0x50, 0x1e, 0xf1, 0xd3, 0xd9, 0x19, 0x2a,
-	0x7b, 0x0e, 0x69, 0x3f, 0xea, 0x01, 0x76, 0xc8, 0x5e, 0x1e, 0x4e, 0x63, 0x69, 0x38, 0x8f, 0x60,
-	0x55, 0x5e, 0xa4, 0xa8, 0x8a, 0x99, 0x39, 0x83, 0x24, 0x5c, 0xf0, 0x64, 0xce, 0x67, 0x73, 0xbc,
-	0x25, 0x61, 0xeb, 0xbe, 0x12, 0x96, 0xb9, 0xb5, 0x84, 0x2f, 0x60, 0x2d, 0x53, 0x49, 0x8c, 0x75,
-	0xc7, 0xab, 0xd4, 0x50, 0x9f, 0x68, 0xd9, 0xf2, 0x8e, 0x95, 0xc5, 0xad, 0x98, 0xf9, 0x99, 0xd1,
-	0xd0, 0x9a, 0x76, 0x0f, 0x0b, 0x78, 0x6a, 0x19, 0xdb, 0x84, 0x6e, 0xb9, 0x95, 0x34, 0x0b, 0x3f,
-	0xac, 0x6c, 0xf6, 0x14, 0xfc, 0x44, 0x47, 0x3c, 0x36, 0xc9, 0xc2, 0xcd, 0xa2, 0x1b, 0x76, 0x13,
-	0x7d, 0x40, 0x36, 0x2d, 0xb0, 0x42, 0x5a, 0x0b, 0x6e, 0x68, 0x20, 0xcd, 0xd0, 0x2f, 0xc8, 0x81,
-	0x19, 0xff, 0x6e, 0x00, 0x1c, 0xe6, 0xb3, 0x8f, 0x68, 0x54, 0x12, 0xeb, 0xdb, 0x92, 0x79, 0x4b,
-	0x92, 0xed, 0xc2, 0xba, 0x9b, 0x4b, 0x7d, 0x3b, 0x34, 0xa9, 0xda, 0x0a, 0x1f, 0x90, 0xa3, 0xbe,
-	0x58, 0x9a, 0xbd, 0x02, 0xe6, 0x4e, 0xb3, 0x14, 0xdd, 0xa4, 0xe8, 0x75, 0xe7, 0xb9, 0x1d, 0xbe,
-	0x03, 0x03, 0x57, 0x5b, 0xe1, 0x02, 0xd3, 0x1c, 0x49, 0xf2, 0x56, 0xd8, 0x27, 0x18, 0x3a, 0xc6,
-	0x5e, 0xc2, 0x5a, 0x9e, 0xd9, 0x6d, 0x8b, 0x34, 0xc6, 0x32, 0x15, 0xba, 0xd0, 0x72, 0xe0, 0xe8,
-	0x89, 0x83, 0x56, 0x72, 0xc1, 0x0d, 0x8f, 0x34, 0xaa, 0x05, 0x8a, 0xe8, 0xeb, 0x8c, 0xae, 0x40,
-	0x2b, 0xec, 0x5b, 0x7a, 0x42, 0xf0, 0xc3, 0x8c, 0x3d, 0x87, 0xfe, 0x9c, 0x6b, 0x13, 0xe5, 0x99,
-	0xb0, 0x3a, 0x14, 0xdb, 0xdd, 0xb3, 0xec, 0xb3, 0x43, 0xef, 0x5e, 0x5f, 0x5e, 0x0f, 0xbd, 0xab,
-	0xeb, 0xa1, 0xf7, 0xef, 0x7a, 0xe8, 0xfd, 0xba, 0x19, 0xae, 0x5c, 0xdd, 0x0c, 0x57, 0xfe, 0xde,
-	0x0c, 0x57, 0xbe, 0x6c, 0x14, 0x8f, 0xcd, 0x8f, 0xea, 0xb9, 0xa1, 0xb1, 0xcd, 0xda, 0xf4, 0xda,
-	0xbc, 0xfd, 0x1f, 0x00, 0x00, 0xff, 0xff, 0x36, 0x14, 0x0c, 0x3c, 0xcd, 0x04, 0x00, 0x00,
+	// 667 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0xd1, 0x4e, 0x13, 0x4d,
+	0x14, 0xc7, 0xd9, 0xb6, 0xb4, 0xdd, 0x69, 0x4b, 0x3e, 0x86, 0x4f, 0x5c, 0x11, 0x6a, 0x2d, 0x9a,
+	0x34, 0x21, 0x16, 0xc1, 0x27, 0xa8, 0x26, 0x0a, 0x17, 0x46, 0xb2, 0xe0, 0x8d, 0x37, 0x93, 0xe9,
+	0xce, 0x01, 0x37, 0x59, 0x76, 0xd6, 0x99, 0xd9, 0xa2, 0x6f, 0xe1, 0x63, 0x71, 0x65, 0xb8, 0xf4,
+	0xd2, 0xc0, 0x7b, 0x18, 0x33, 0x67, 0x76, 0xbb, 0x54, 0xbc, 0xe0, 0xae, 0xe7, 0xf7, 0x3f, 0xe7,
+	0x74, 0xe6, 0x7f, 0xce, 0x0e, 0xd9, 0x51, 0xa0, 0x63, 0x6d, 0x76, 0x15, 0x5c, 0x70, 0x25, 0xf4,
+	0xee, 0x6c, 0x6f, 0x57, 0x81, 0x96, 0xb9, 0x8a, 0x80, 0xf1, 0x24, 0x91, 0x11, 0x37, 0xb1, 0x4c,
+	0xc7, 0x99, 0x92, 0x46, 0xd2, 0x55, 0x97, 0x3c, 0x2e, 0x92, 0xc7, 0xb3, 0xbd, 0x8d, 0xcd, 0xbb,
+	0xf5, 0xa9, 0x14, 0xe0, 0x0a, 0x86, 0x97, 0x75, 0x42, 0xc3, 0xa2, 0xdd, 0x64, 0xde, 0x8d, 0x6e,
+	0x93, 0x5e, 0xd5, 0x9b, 0xc5, 0x22, 0xf0, 0x06, 0xde, 0xc8, 0x0f, 0xbb, 0x15, 0x3c, 0x14, 0x74,
+	0x8b, 0x90, 0x48, 0xa6, 0x06, 0x52, 0x63, 0x33, 0x6a, 0x98, 0xe1, 0x17, 0xe4, 0x50, 0xd0, 0x87,
+	0xa4, 0x65, 0xff, 0xc8, 0x6a, 0x75, 0xd4, 0x9a, 0x36, 0x3c, 0x14, 0x74, 0x93, 0xf8, 0x0a, 0xbe,
+	0xe4, 0xa0, 0x0d, 0xa8, 0xa0, 0xe1, 0xca, 0xe6, 0x80, 0x1e, 0x91, 0xb5, 0x32, 0x10, 0xac, 0xbc,
+	0xa9, 0x0e, 0x96, 0x07, 0xde, 0xa8, 0xb3, 0xff, 0x64, 0x7c, 0xe7, 0x82, 0xe3, 0xf2, 0xf8, 0xc7,
+	0x19, 0x44, 0x21, 0x9d, 0xd7, 0x96, 0x58, 0xdb, 0x8e, 0xc5, 0xb9, 0x17, 0x3a, 0x36, 0xef, 0xd9,
+	0x71, 0x5e, 0x5b, 0x75, 0xdc, 0x22, 0x44, 0x1b, 0xae, 0x0c, 0x33, 0xf1, 0x39, 0x04, 0xad, 0x81,
+	0x37, 0xaa, 0x87, 0x3e, 0x92, 0x93, 0xf8, 0x1c, 0xe8, 0x23, 0xd2, 0x86, 0x54, 0x38, 0xb1, 0x8d,
+	0x62, 0x0b, 0x52, 0x81, 0xd2, 0x3a, 0x69, 0x6a, 0xc3, 0x4d, 0xae, 0x03, 0xdf, 0x79, 0xe2, 0x22,
+	0x3a, 0x24, 0xbd, 0x48, 0x6a, 0xc3, 0x32, 0x50, 0xec, 0xb3, 0xcc, 0x55, 0x40, 0x06, 0xde, 0xa8,
+	0x11, 0x76, 0x2c, 0x3c, 0x02, 0x75, 0x20, 0x73, 0x65, 0xff, 0xd5, 0x48, 0xc3, 0x13, 0x66, 0x61,
+	0xd0, 0xc1, 0x04, 0x1f, 0xc9, 0x1b, 0xa9, 0xcd, 0xf0, 0x47, 0x8d, 0xf4, 0xca, 0x23, 0x7e, 0x38,
+	0x3d, 0x05, 0x65, 0xcf, 0x21, 0xed, 0x8f, 0x6a, 0x80, 0x2d, 0x8c, 0x17, 0x87, 0x53, 0x5b, 0x18,
+	0xce, 0xff, 0x64, 0x59, 0x5e, 0xa4, 0xa0, 0x8a, 0x99, 0xb9, 0x00, 0x2d, 0x9c, 0xf1, 0x38, 0xe1,
+	0xd3, 0x04, 0x6e, 0x59, 0xd8, 0xb8, 0xaf, 0x85, 0x65, 0x6d, 0x65, 0xe1, 0x33, 0xb2, 0x92, 0xa9,
+	0x38, 0x82, 0xea, 0xc6, 0xcb, 0x78, 0xa1, 0x2e, 0xd2, 0xf2, 0xca, 0xdb, 0xd6, 0x16, 0xb7, 0x62,
+	0xe6, 0x5b, 0x86, 0x43, 0xab, 0xdb, 0x3d, 0x2c, 0xe0, 0x89, 0x65, 0x74, 0x83, 0xb4, 0xcb, 0xad,
+	0xc4, 0x59, 0xf8, 0xe1, 0x3c, 0xa6, 0x8f, 0x89, 0x1f, 0x6b, 0xc6, 0x23, 0x13, 0xcf, 0xdc, 0x2c,
+	0xda, 0x61, 0x3b, 0xd6, 0x13, 0x8c, 0x71, 0x81, 0x15, 0xe0, 0x5a, 0x70, 0x83, 0x03, 0xa9, 0x87,
+	0x7e, 0x41, 0x26, 0x66, 0xf8, 0xbb, 0x46, 0xc8, 0x41, 0x3e, 0x7d, 0x0f, 0x46, 0xc5, 0x91, 0xbe,
+	0x6d, 0x99, 0xb7, 0x60, 0xd9, 0x0e, 0x59, 0x75, 0x73, 0xa9, 0xbe, 0x0e, 0x8d, 0xae, 0x36, 0xc2,
+	0xff, 0x50, 0xa8, 0x3e, 0x2c, 0x4d, 0x5f, 0x10, 0xea, 0x4e, 0xb3, 0x90, 0x5d, 0xc7, 0xec, 0x55,
+	0xa7, 0xdc, 0x4e, 0xdf, 0x26, 0x3d, 0xd7, 0x5b, 0xc1, 0x0c, 0xd2, 0x1c, 0xd0, 0xf2, 0x46, 0xd8,
+	0x45, 0x18, 0x3a, 0x46, 0x9f, 0x93, 0x95, 0x3c, 0xb3, 0xdb, 0xc6, 0x34, 0x44, 0x32, 0x15, 0xba,
+	0xf0, 0xb2, 0xe7, 0xe8, 0xb1, 0x83, 0xd6, 0x72, 0xc1, 0x0d, 0x67, 0x1a, 0xd4, 0x0c, 0x04, 0x3b,
+	0x9b, 0xe2, 0x27, 0xd0, 0x08, 0xbb, 0x96, 0x1e, 0x23, 0x7c, 0x37, 0xa5, 0x4f, 0x49, 0x37, 0xe1,
+	0xda, 0xb0, 0x3c, 0x13, 0xd6, 0x87, 0x62, 0xbb, 0x3b, 0x96, 0x7d, 0x74, 0x88, 0xee, 0x93, 0x07,
+	0xda, 0x48, 0xc5, 0xcf, 0x80, 0xf1, 0x5c, 0xc4, 0x46, 0xb3, 0x8c, 0x6b, 0x0d, 0x02, 0x0d, 0x6e,
+	0x84, 0x6b, 0x85, 0x38, 0x41, 0xed, 0x08, 0xa5, 0x7f, 0xd4, 0x9c, 0xf2, 0x38, 0x01, 0x81, 0xb6,
+	0xff, 0x5d, 0xf3, 0x16, 0xa5, 0xd7, 0x2f, 0x2f, 0xaf, 0xfb, 0xde, 0xd5, 0x75, 0xdf, 0xfb, 0x75,
+	0xdd, 0xf7, 0xbe, 0xdf, 0xf4, 0x97, 0xae, 0x6e, 0xfa, 0x4b, 0x3f, 0x6f, 0xfa, 0x4b, 0x9f, 0xd6,
+	0x8b, 0x47, 0xed, 0xeb, 0xfc, 0x59, 0xc3, 0xf5, 0x98, 0x36, 0xf1, 0x55, 0x7b, 0xf5, 0x27, 0x00,
+	0x00, 0xff, 0xff, 0x05, 0x6e, 0x3f, 0x57, 0x35, 0x05, 0x00, 0x00,
 }
 
 func (m *ResourceAllocation) Marshal() (dAtA []byte, err error) {
@@ -616,6 +635,16 @@ func (m *HubMetrics) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.StorageAuditsFailed != 0 {
+		i = encodeVarintResourceAllocation(dAtA, i, uint64(m.StorageAuditsFailed))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.StorageAuditsPassed != 0 {
+		i = encodeVarintResourceAllocation(dAtA, i, uint64(m.StorageAuditsPassed))
+		i--
+		dAtA[i] = 0x40
+	}
 	if m.LastUpdated != 0 {
 		i = encodeVarintResourceAllocation(dAtA, i, uint64(m.LastUpdated))
 		i--
@@ -788,6 +817,12 @@ func (m *HubMetrics) Size() (n int) {
 	if m.LastUpdated != 0 {
 		n += 1 + sovResourceAllocation(uint64(m.LastUpdated))
 	}
+	if m.StorageAuditsPassed != 0 {
+		n += 1 + sovResourceAllocation(uint64(m.StorageAuditsPassed))
+	}
+	if m.StorageAuditsFailed != 0 {
+		n += 1 + sovResourceAllocation(uint64(m.StorageAuditsFailed))
+	}
 	return n
 }
 
@@ -1634,6 +1669,44 @@ func (m *HubMetrics) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageAuditsPassed", wireType)
+			}
+			m.StorageAuditsPassed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResourceAllocation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StorageAuditsPassed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageAuditsFailed", wireType)
+			}
+			m.StorageAuditsFailed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResourceAllocation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StorageAuditsFailed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipResourceAllocation(dAtA[iNdEx:])
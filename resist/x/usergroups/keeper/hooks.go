@@ -0,0 +1,55 @@
+package keeper
+
+import (
+	"context"
+	"slices"
+
+	identitytypes "resist/x/identity/types"
+	"resist/x/usergroups/types"
+)
+
+var _ identitytypes.IdentityHooks = Hooks{}
+
+// Hooks re-keys the group memberships of the identities moved by x/identity.
+type Hooks struct {
+	k *Keeper
+}
+
+// Hooks returns the identity hooks of the usergroups module.
+func (k *Keeper) Hooks() Hooks {
+	return Hooks{k}
+}
+
+// AfterIdentityMigrated replaces oldAddress with newAddress in the members,
+// admin and creator of the groups.
+func (h Hooks) AfterIdentityMigrated(ctx context.Context, oldAddress, newAddress string) error {
+	var groups []types.UserGroup
+	if err := h.k.UserGroup.Walk(ctx, nil, func(_ string, group types.UserGroup) (bool, error) {
+		if group.Admin == oldAddress || group.Creator == oldAddress || slices.Contains(group.Members, oldAddress) {
+			groups = append(groups, group)
+		}
+		return false, nil
+	}); err != nil {
+		return err
+	}
+
+	for _, group := range groups {
+		if group.Admin == oldAddress {
+			group.Admin = newAddress
+		}
+		if group.Creator == oldAddress {
+			group.Creator = newAddress
+		}
+		// Drop the old address rather than duplicating a membership the new
+		// address already holds.
+		if slices.Contains(group.Members, newAddress) {
+			group.Members = slices.DeleteFunc(group.Members, func(member string) bool { return member == oldAddress })
+		} else if i := slices.Index(group.Members, oldAddress); i >= 0 {
+			group.Members[i] = newAddress
+		}
+		if err := h.k.UserGroup.Set(ctx, group.Index, group); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"context"
 	"fmt"
 
 	"cosmossdk.io/collections"
@@ -22,7 +23,12 @@ type Keeper struct {
 	Schema collections.Schema
 	Params collections.Item[types.Params]
 
-	bankKeeper         types.BankKeeper
+	bankKeeper  types.BankKeeper
+	postsKeeper types.PostsKeeper
+	// hooks is shared by the copies of the keeper, as it is set once every
+	// module, including those depending on this keeper, is provided.
+	hooks *types.ContentReportHooks
+
 	UserGroup          collections.Map[string, types.UserGroup]
 	ContentReport      collections.Map[string, types.ContentReport]
 	GovernanceProposal collections.Map[string, types.GovernanceProposal]
@@ -35,6 +41,7 @@ func NewKeeper(
 	authority []byte,
 
 	bankKeeper types.BankKeeper,
+	postsKeeper types.PostsKeeper,
 ) *Keeper {
 	if _, err := addressCodec.BytesToString(authority); err != nil {
 		panic(fmt.Sprintf("invalid authority address %s: %s", authority, err))
@@ -48,9 +55,11 @@ func NewKeeper(
 		addressCodec: addressCodec,
 		authority:    authority,
 
-		bankKeeper: bankKeeper,
-		Params:     collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
-		UserGroup:  collections.NewMap(sb, types.UserGroupKey, "userGroup", collections.StringKey, codec.CollValue[types.UserGroup](cdc)), ContentReport: collections.NewMap(sb, types.ContentReportKey, "contentReport", collections.StringKey, codec.CollValue[types.ContentReport](cdc)), GovernanceProposal: collections.NewMap(sb, types.GovernanceProposalKey, "governanceProposal", collections.StringKey, codec.CollValue[types.GovernanceProposal](cdc))}
+		bankKeeper:  bankKeeper,
+		postsKeeper: postsKeeper,
+		hooks:       new(types.ContentReportHooks),
+		Params:      collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+		UserGroup:   collections.NewMap(sb, types.UserGroupKey, "userGroup", collections.StringKey, codec.CollValue[types.UserGroup](cdc)), ContentReport: collections.NewMap(sb, types.ContentReportKey, "contentReport", collections.StringKey, codec.CollValue[types.ContentReport](cdc)), GovernanceProposal: collections.NewMap(sb, types.GovernanceProposalKey, "governanceProposal", collections.StringKey, codec.CollValue[types.GovernanceProposal](cdc))}
 
 	schema, err := sb.Build()
 	if err != nil {
@@ -65,3 +74,36 @@ func NewKeeper(
 func (k Keeper) GetAuthority() []byte {
 	return k.authority
 }
+
+// SetHooks sets the hooks notified of the status changes of content reports.
+func (k Keeper) SetHooks(hooks types.ContentReportHooks) {
+	if *k.hooks != nil {
+		panic("cannot set content report hooks twice")
+	}
+	*k.hooks = hooks
+}
+
+// afterContentReportChanged notifies the hooks of the status changes between
+// the previous and new value of a report, either of which is nil when the
+// report is created or deleted.
+func (k Keeper) afterContentReportChanged(ctx context.Context, prev, report *types.ContentReport) error {
+	hooks := *k.hooks
+	if hooks == nil {
+		return nil
+	}
+	if prev != nil && report != nil && prev.PostId == report.PostId {
+		if prev.Status == report.Status {
+			return nil
+		}
+		return hooks.AfterContentReportStatusChanged(ctx, report.PostId, prev.Status, report.Status)
+	}
+	if prev != nil {
+		if err := hooks.AfterContentReportStatusChanged(ctx, prev.PostId, prev.Status, ""); err != nil {
+			return err
+		}
+	}
+	if report != nil {
+		return hooks.AfterContentReportStatusChanged(ctx, report.PostId, "", report.Status)
+	}
+	return nil
+}
@@ -22,6 +22,24 @@ type fixture struct {
 	ctx          context.Context
 	keeper       keeper.Keeper
 	addressCodec address.Codec
+	postsKeeper  *mockPostsKeeper
+}
+
+// mockPostsKeeper holds the aliases of re-indexed posts and the groups of
+// the posts.
+type mockPostsKeeper struct {
+	aliases map[string]string
+	groups  map[uint64]uint64
+}
+
+func (m *mockPostsKeeper) GetPostAlias(_ context.Context, oldIndex string) (string, bool, error) {
+	index, ok := m.aliases[oldIndex]
+	return index, ok, nil
+}
+
+func (m *mockPostsKeeper) GetPostGroup(_ context.Context, postId uint64) (uint64, bool, error) {
+	groupId, ok := m.groups[postId]
+	return groupId, ok, nil
 }
 
 func initFixture(t *testing.T) *fixture {
@@ -35,6 +53,7 @@ func initFixture(t *testing.T) *fixture {
 	ctx := testutil.DefaultContextWithDB(t, storeKey, storetypes.NewTransientStoreKey("transient_test")).Ctx
 
 	authority := authtypes.NewModuleAddress(types.GovModuleName)
+	postsKeeper := &mockPostsKeeper{aliases: make(map[string]string), groups: make(map[uint64]uint64)}
 
 	k := keeper.NewKeeper(
 		storeService,
@@ -42,6 +61,7 @@ func initFixture(t *testing.T) *fixture {
 		addressCodec,
 		authority,
 		nil,
+		postsKeeper,
 	)
 
 	// Initialize params
@@ -51,7 +71,8 @@ func initFixture(t *testing.T) *fixture {
 
 	return &fixture{
 		ctx:          ctx,
-		keeper:       k,
+		keeper:       *k,
 		addressCodec: addressCodec,
+		postsKeeper:  postsKeeper,
 	}
 }
@@ -0,0 +1,48 @@
+package keeper
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"resist/x/usergroups/types"
+)
+
+// Migrator handles the in-place store migrations of the module.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 updates the post id of the content reports on the posts
+// re-indexed by the migration of x/posts to sequential indexes, which must run
+// first in the same upgrade. The former index is looked up before the post at
+// the id, as a new index may equal the former index of another post.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	var reports []types.ContentReport
+	if err := m.keeper.ContentReport.Walk(ctx, nil, func(_ string, report types.ContentReport) (bool, error) {
+		index, found, err := m.keeper.postsKeeper.GetPostAlias(ctx, strconv.FormatUint(report.PostId, 10))
+		if err != nil || !found {
+			return err != nil, err
+		}
+		postId, err := strconv.ParseUint(index, 10, 64)
+		if err != nil {
+			return true, err
+		}
+		report.PostId = postId
+		reports = append(reports, report)
+		return false, nil
+	}); err != nil {
+		return err
+	}
+	for _, report := range reports {
+		if err := m.keeper.ContentReport.Set(ctx, report.Index, report); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,31 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"resist/x/usergroups/keeper"
+	"resist/x/usergroups/types"
+)
+
+func TestMigrate1to2(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx)
+
+	// Post 1 was re-indexed to 0 and post 0 to 1, post 7 kept its index
+	f.postsKeeper.aliases["1"] = "0"
+	f.postsKeeper.aliases["0"] = "1"
+	for index, postId := range map[string]uint64{"a": 0, "b": 1, "c": 7} {
+		require.NoError(t, f.keeper.ContentReport.Set(ctx, index, types.ContentReport{Index: index, PostId: postId}))
+	}
+
+	require.NoError(t, keeper.NewMigrator(f.keeper).Migrate1to2(ctx))
+
+	for index, postId := range map[string]uint64{"a": 1, "b": 0, "c": 7} {
+		report, err := f.keeper.ContentReport.Get(ctx, index)
+		require.NoError(t, err)
+		require.Equal(t, postId, report.PostId, index)
+	}
+}
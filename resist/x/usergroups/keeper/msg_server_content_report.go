@@ -1,9 +1,11 @@
 package keeper
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
+	"strconv"
 
 	"resist/x/usergroups/types"
 
@@ -25,6 +27,8 @@ func (k msgServer) CreateContentReport(ctx context.Context, msg *types.MsgCreate
 		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "index already set")
 	}
 
+	// Reports are created pending, their status and resolution being set by
+	// MsgResolveContentReport
 	var contentReport = types.ContentReport{
 		Creator:           msg.Creator,
 		Index:             msg.Index,
@@ -32,14 +36,16 @@ func (k msgServer) CreateContentReport(ctx context.Context, msg *types.MsgCreate
 		Reporter:          msg.Reporter,
 		Reason:            msg.Reason,
 		Evidence:          msg.Evidence,
-		Status:            msg.Status,
+		Status:            types.ReportStatusPending,
 		CommunityResponse: msg.CommunityResponse,
-		Resolution:        msg.Resolution,
 	}
 
 	if err := k.ContentReport.Set(ctx, contentReport.Index, contentReport); err != nil {
 		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
 	}
+	if err := k.afterContentReportChanged(ctx, nil, &contentReport); err != nil {
+		return nil, err
+	}
 
 	return &types.MsgCreateContentReportResponse{}, nil
 }
@@ -67,18 +73,23 @@ func (k msgServer) UpdateContentReport(ctx context.Context, msg *types.MsgUpdate
 	var contentReport = types.ContentReport{
 		Creator:           msg.Creator,
 		Index:             msg.Index,
-		PostId:            msg.PostId,
 		Reporter:          msg.Reporter,
 		Reason:            msg.Reason,
 		Evidence:          msg.Evidence,
-		Status:            msg.Status,
 		CommunityResponse: msg.CommunityResponse,
-		Resolution:        msg.Resolution,
+		// The reported post and the outcome of the report are kept, so that
+		// an outcome cannot be moved to another post
+		PostId:     val.PostId,
+		Status:     val.Status,
+		Resolution: val.Resolution,
 	}
 
 	if err := k.ContentReport.Set(ctx, contentReport.Index, contentReport); err != nil {
 		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to update contentReport")
 	}
+	if err := k.afterContentReportChanged(ctx, &val, &contentReport); err != nil {
+		return nil, err
+	}
 
 	return &types.MsgUpdateContentReportResponse{}, nil
 }
@@ -106,6 +117,73 @@ func (k msgServer) DeleteContentReport(ctx context.Context, msg *types.MsgDelete
 	if err := k.ContentReport.Remove(ctx, msg.Index); err != nil {
 		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to remove contentReport")
 	}
+	if err := k.afterContentReportChanged(ctx, &val, nil); err != nil {
+		return nil, err
+	}
 
 	return &types.MsgDeleteContentReportResponse{}, nil
 }
+
+func (k msgServer) ResolveContentReport(ctx context.Context, msg *types.MsgResolveContentReport) (*types.MsgResolveContentReportResponse, error) {
+	creator, err := k.addressCodec.StringToBytes(msg.Creator)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid signer address: %s", err))
+	}
+
+	switch msg.Status {
+	case types.ReportStatusPending, types.ReportStatusResolved, types.ReportStatusDismissed:
+	default:
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid report status %q", msg.Status)
+	}
+
+	// Check if the value exists
+	val, err := k.ContentReport.Get(ctx, msg.Index)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, errorsmod.Wrap(sdkerrors.ErrKeyNotFound, "index not set")
+		}
+
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
+	// Only governance and the admin of the group of the reported post moderate
+	// the report
+	if !bytes.Equal(k.GetAuthority(), creator) {
+		isModerator, err := k.isReportModerator(ctx, val, msg.Creator)
+		if err != nil {
+			return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+		} else if !isModerator {
+			return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "only the admin of the group of the reported post or the module authority can resolve a report")
+		}
+	}
+
+	contentReport := val
+	contentReport.Status = msg.Status
+	contentReport.Resolution = msg.Resolution
+
+	if err := k.ContentReport.Set(ctx, contentReport.Index, contentReport); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to update contentReport")
+	}
+	if err := k.afterContentReportChanged(ctx, &val, &contentReport); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgResolveContentReportResponse{}, nil
+}
+
+// isReportModerator reports whether address is the admin of the group of the
+// post reported by report. Reports on posts outside of any group, or on
+// unknown posts, are only moderated by governance.
+func (k msgServer) isReportModerator(ctx context.Context, report types.ContentReport, address string) (bool, error) {
+	groupId, found, err := k.postsKeeper.GetPostGroup(ctx, report.PostId)
+	if err != nil || !found || groupId == 0 {
+		return false, err
+	}
+	group, err := k.UserGroup.Get(ctx, strconv.FormatUint(groupId, 10))
+	if errors.Is(err, collections.ErrNotFound) {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+	return group.Admin == address, nil
+}
@@ -155,3 +155,98 @@ func TestContentReportMsgServerDelete(t *testing.T) {
 		})
 	}
 }
+
+func TestContentReportMsgServerResolve(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+
+	creator, err := f.addressCodec.BytesToString([]byte("signerAddr__________________"))
+	require.NoError(t, err)
+	admin, err := f.addressCodec.BytesToString([]byte("adminAddr___________________"))
+	require.NoError(t, err)
+	authority, err := f.addressCodec.BytesToString(f.keeper.GetAuthority())
+	require.NoError(t, err)
+
+	require.NoError(t, f.keeper.UserGroup.Set(f.ctx, "3", types.UserGroup{Index: "3", Admin: admin}))
+	f.postsKeeper.groups[7] = 3
+	f.postsKeeper.groups[8] = 0
+
+	// The status and resolution given by the reporter are ignored
+	for i, postId := range []uint64{7, 8} {
+		_, err = srv.CreateContentReport(f.ctx, &types.MsgCreateContentReport{Creator: creator,
+			Index:      strconv.Itoa(i),
+			PostId:     postId,
+			Status:     types.ReportStatusResolved,
+			Resolution: "resolved",
+		})
+		require.NoError(t, err)
+		rst, err := f.keeper.ContentReport.Get(f.ctx, strconv.Itoa(i))
+		require.NoError(t, err)
+		require.Equal(t, types.ReportStatusPending, rst.Status)
+		require.Empty(t, rst.Resolution)
+	}
+
+	tests := []struct {
+		desc    string
+		request *types.MsgResolveContentReport
+		err     error
+	}{
+		{
+			desc:    "invalid status",
+			request: &types.MsgResolveContentReport{Creator: admin, Index: "0", Status: "closed"},
+			err:     sdkerrors.ErrInvalidRequest,
+		},
+		{
+			desc:    "key not found",
+			request: &types.MsgResolveContentReport{Creator: admin, Index: "100000", Status: types.ReportStatusResolved},
+			err:     sdkerrors.ErrKeyNotFound,
+		},
+		{
+			desc:    "reporter",
+			request: &types.MsgResolveContentReport{Creator: creator, Index: "0", Status: types.ReportStatusResolved},
+			err:     sdkerrors.ErrUnauthorized,
+		},
+		{
+			desc:    "admin of another group",
+			request: &types.MsgResolveContentReport{Creator: admin, Index: "1", Status: types.ReportStatusResolved},
+			err:     sdkerrors.ErrUnauthorized,
+		},
+		{
+			desc:    "group admin",
+			request: &types.MsgResolveContentReport{Creator: admin, Index: "0", Status: types.ReportStatusResolved, Resolution: "removed"},
+		},
+		{
+			desc:    "authority",
+			request: &types.MsgResolveContentReport{Creator: authority, Index: "1", Status: types.ReportStatusDismissed, Resolution: "kept"},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			_, err := srv.ResolveContentReport(f.ctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+				rst, err := f.keeper.ContentReport.Get(f.ctx, tc.request.Index)
+				require.NoError(t, err)
+				require.Equal(t, tc.request.Status, rst.Status)
+				require.Equal(t, tc.request.Resolution, rst.Resolution)
+			}
+		})
+	}
+
+	// Updating a report keeps its post and outcome
+	_, err = srv.UpdateContentReport(f.ctx, &types.MsgUpdateContentReport{Creator: creator,
+		Index:  "0",
+		PostId: 8,
+		Reason: "spam",
+		Status: types.ReportStatusPending,
+	})
+	require.NoError(t, err)
+	rst, err := f.keeper.ContentReport.Get(f.ctx, "0")
+	require.NoError(t, err)
+	require.Equal(t, "spam", rst.Reason)
+	require.EqualValues(t, 7, rst.PostId)
+	require.Equal(t, types.ReportStatusResolved, rst.Status)
+	require.Equal(t, "removed", rst.Resolution)
+}
@@ -84,15 +84,15 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 				},
 				{
 					RpcMethod:      "CreateContentReport",
-					Use:            "create-content-report [index] [post-id] [reporter] [reason] [evidence] [status] [community-response] [resolution]",
+					Use:            "create-content-report [index] [post-id] [reporter] [reason] [evidence] [community-response]",
 					Short:          "Create a new content-report",
-					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "index"}, {ProtoField: "post_id"}, {ProtoField: "reporter"}, {ProtoField: "reason"}, {ProtoField: "evidence"}, {ProtoField: "status"}, {ProtoField: "community_response"}, {ProtoField: "resolution"}},
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "index"}, {ProtoField: "post_id"}, {ProtoField: "reporter"}, {ProtoField: "reason"}, {ProtoField: "evidence"}, {ProtoField: "community_response"}},
 				},
 				{
 					RpcMethod:      "UpdateContentReport",
-					Use:            "update-content-report [index] [post-id] [reporter] [reason] [evidence] [status] [community-response] [resolution]",
+					Use:            "update-content-report [index] [reporter] [reason] [evidence] [community-response]",
 					Short:          "Update content-report",
-					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "index"}, {ProtoField: "post_id"}, {ProtoField: "reporter"}, {ProtoField: "reason"}, {ProtoField: "evidence"}, {ProtoField: "status"}, {ProtoField: "community_response"}, {ProtoField: "resolution"}},
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "index"}, {ProtoField: "reporter"}, {ProtoField: "reason"}, {ProtoField: "evidence"}, {ProtoField: "community_response"}},
 				},
 				{
 					RpcMethod:      "DeleteContentReport",
@@ -100,6 +100,12 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Short:          "Delete content-report",
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "index"}},
 				},
+				{
+					RpcMethod:      "ResolveContentReport",
+					Use:            "resolve-content-report [index] [status] [resolution]",
+					Short:          "Set the status of a content-report, as the admin of the group of the reported post",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "index"}, {ProtoField: "status"}, {ProtoField: "resolution"}},
+				},
 				{
 					RpcMethod:      "CreateGovernanceProposal",
 					Use:            "create-governance-proposal [index] [title] [description] [proposer] [proposal-type] [voting-period-start] [voting-period-end] [yes-votes] [no-votes] [abstain-votes] [status]",
@@ -1,6 +1,9 @@
 package usergroups
 
 import (
+	"maps"
+	"slices"
+
 	"cosmossdk.io/core/address"
 	"cosmossdk.io/core/appmodule"
 	"cosmossdk.io/core/store"
@@ -9,6 +12,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
+	identitytypes "resist/x/identity/types"
 	"resist/x/usergroups/keeper"
 	"resist/x/usergroups/types"
 )
@@ -22,6 +26,7 @@ func init() {
 	appconfig.Register(
 		&types.Module{},
 		appconfig.Provide(ProvideModule),
+		appconfig.Invoke(InvokeSetContentReportHooks),
 	)
 }
 
@@ -33,8 +38,9 @@ type ModuleInputs struct {
 	Cdc          codec.Codec
 	AddressCodec address.Codec
 
-	AuthKeeper types.AuthKeeper
-	BankKeeper types.BankKeeper
+	AuthKeeper  types.AuthKeeper
+	BankKeeper  types.BankKeeper
+	PostsKeeper types.PostsKeeper
 }
 
 type ModuleOutputs struct {
@@ -42,6 +48,7 @@ type ModuleOutputs struct {
 
 	UsergroupsKeeper *keeper.Keeper
 	Module           appmodule.AppModule
+	IdentityHooks    identitytypes.IdentityHooksWrapper
 }
 
 func ProvideModule(in ModuleInputs) ModuleOutputs {
@@ -56,8 +63,19 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 		in.AddressCodec,
 		authority,
 		in.BankKeeper,
+		in.PostsKeeper,
 	)
 	m := NewAppModule(in.Cdc, *k, in.AuthKeeper, in.BankKeeper)
 
-	return ModuleOutputs{UsergroupsKeeper: k, Module: m}
+	return ModuleOutputs{UsergroupsKeeper: k, Module: m, IdentityHooks: identitytypes.IdentityHooksWrapper{IdentityHooks: k.Hooks()}}
+}
+
+func InvokeSetContentReportHooks(k *keeper.Keeper, contentReportHooks map[string]types.ContentReportHooksWrapper) error {
+	// Call the hooks in module name order, for determinism.
+	var hooks types.MultiContentReportHooks
+	for _, name := range slices.Sorted(maps.Keys(contentReportHooks)) {
+		hooks = append(hooks, contentReportHooks[name])
+	}
+	k.SetHooks(hooks)
+	return nil
 }
@@ -17,6 +17,7 @@ func RegisterInterfaces(registrar codectypes.InterfaceRegistry) {
 		&MsgCreateContentReport{},
 		&MsgUpdateContentReport{},
 		&MsgDeleteContentReport{},
+		&MsgResolveContentReport{},
 	)
 
 	registrar.RegisterImplementations((*sdk.Msg)(nil),
@@ -20,6 +20,12 @@ type BankKeeper interface {
 	// Methods imported from bank should be defined here
 }
 
+// PostsKeeper defines the expected interface for the Posts module.
+type PostsKeeper interface {
+	GetPostAlias(ctx context.Context, oldIndex string) (string, bool, error)
+	GetPostGroup(ctx context.Context, postId uint64) (uint64, bool, error)
+}
+
 // ParamSubspace defines the expected Subspace interface for parameters.
 type ParamSubspace interface {
 	Get(context.Context, []byte, interface{})
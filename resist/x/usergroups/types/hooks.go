@@ -0,0 +1,41 @@
+package types
+
+import "context"
+
+// ContentReportHooks are implemented by the modules following the outcome of
+// the reports on posts.
+type ContentReportHooks interface {
+	// AfterContentReportStatusChanged is called after the status of a report
+	// on post postId changed from oldStatus to newStatus. A created report
+	// changes from the empty status, and a deleted report to the empty status.
+	AfterContentReportStatusChanged(ctx context.Context, postId uint64, oldStatus, newStatus string) error
+}
+
+var _ ContentReportHooks = MultiContentReportHooks{}
+
+// MultiContentReportHooks combines multiple content report hooks, called in
+// order.
+type MultiContentReportHooks []ContentReportHooks
+
+// NewMultiContentReportHooks returns hooks calling each of hooks in order.
+func NewMultiContentReportHooks(hooks ...ContentReportHooks) MultiContentReportHooks {
+	return hooks
+}
+
+// AfterContentReportStatusChanged calls AfterContentReportStatusChanged on
+// every hook, stopping at the first error.
+func (h MultiContentReportHooks) AfterContentReportStatusChanged(ctx context.Context, postId uint64, oldStatus, newStatus string) error {
+	for _, hook := range h {
+		if err := hook.AfterContentReportStatusChanged(ctx, postId, oldStatus, newStatus); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ContentReportHooksWrapper is a wrapper for modules to inject
+// ContentReportHooks using depinject.
+type ContentReportHooksWrapper struct{ ContentReportHooks }
+
+// IsOnePerModuleType implements the depinject.OnePerModuleType interface.
+func (ContentReportHooksWrapper) IsOnePerModuleType() {}
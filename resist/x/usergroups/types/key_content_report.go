@@ -4,3 +4,11 @@ import "cosmossdk.io/collections"
 
 // ContentReportKey is the prefix to retrieve all ContentReport
 var ContentReportKey = collections.NewPrefix("contentReport/value/")
+
+// Statuses of a ContentReport. A resolved report was upheld against the
+// reported post, a dismissed one was not.
+const (
+	ReportStatusPending   = "pending"
+	ReportStatusResolved  = "resolved"
+	ReportStatusDismissed = "dismissed"
+)
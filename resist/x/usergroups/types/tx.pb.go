@@ -492,12 +492,14 @@ var xxx_messageInfo_MsgDeleteUserGroupResponse proto.InternalMessageInfo
 
 // MsgCreateContentReport defines the MsgCreateContentReport message.
 type MsgCreateContentReport struct {
-	Creator           string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	Index             string `protobuf:"bytes,2,opt,name=index,proto3" json:"index,omitempty"`
-	PostId            uint64 `protobuf:"varint,3,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
-	Reporter          string `protobuf:"bytes,4,opt,name=reporter,proto3" json:"reporter,omitempty"`
-	Reason            string `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
-	Evidence          string `protobuf:"bytes,6,opt,name=evidence,proto3" json:"evidence,omitempty"`
+	Creator  string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Index    string `protobuf:"bytes,2,opt,name=index,proto3" json:"index,omitempty"`
+	PostId   uint64 `protobuf:"varint,3,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	Reporter string `protobuf:"bytes,4,opt,name=reporter,proto3" json:"reporter,omitempty"`
+	Reason   string `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	Evidence string `protobuf:"bytes,6,opt,name=evidence,proto3" json:"evidence,omitempty"`
+	// status is ignored, a report being created pending and changing status
+	// only through MsgResolveContentReport.
 	Status            string `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`
 	CommunityResponse string `protobuf:"bytes,8,opt,name=community_response,json=communityResponse,proto3" json:"community_response,omitempty"`
 	Resolution        string `protobuf:"bytes,9,opt,name=resolution,proto3" json:"resolution,omitempty"`
@@ -638,12 +640,14 @@ var xxx_messageInfo_MsgCreateContentReportResponse proto.InternalMessageInfo
 
 // MsgUpdateContentReport defines the MsgUpdateContentReport message.
 type MsgUpdateContentReport struct {
-	Creator           string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	Index             string `protobuf:"bytes,2,opt,name=index,proto3" json:"index,omitempty"`
-	PostId            uint64 `protobuf:"varint,3,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
-	Reporter          string `protobuf:"bytes,4,opt,name=reporter,proto3" json:"reporter,omitempty"`
-	Reason            string `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
-	Evidence          string `protobuf:"bytes,6,opt,name=evidence,proto3" json:"evidence,omitempty"`
+	Creator  string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Index    string `protobuf:"bytes,2,opt,name=index,proto3" json:"index,omitempty"`
+	PostId   uint64 `protobuf:"varint,3,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	Reporter string `protobuf:"bytes,4,opt,name=reporter,proto3" json:"reporter,omitempty"`
+	Reason   string `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	Evidence string `protobuf:"bytes,6,opt,name=evidence,proto3" json:"evidence,omitempty"`
+	// status is ignored, a report being created pending and changing status
+	// only through MsgResolveContentReport.
 	Status            string `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`
 	CommunityResponse string `protobuf:"bytes,8,opt,name=community_response,json=communityResponse,proto3" json:"community_response,omitempty"`
 	Resolution        string `protobuf:"bytes,9,opt,name=resolution,proto3" json:"resolution,omitempty"`
@@ -872,6 +876,113 @@ func (m *MsgDeleteContentReportResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgDeleteContentReportResponse proto.InternalMessageInfo
 
+// MsgResolveContentReport defines the MsgResolveContentReport message.
+type MsgResolveContentReport struct {
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Index   string `protobuf:"bytes,2,opt,name=index,proto3" json:"index,omitempty"`
+	// status is "pending", "resolved" or "dismissed".
+	Status     string `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	Resolution string `protobuf:"bytes,4,opt,name=resolution,proto3" json:"resolution,omitempty"`
+}
+
+func (m *MsgResolveContentReport) Reset()         { *m = MsgResolveContentReport{} }
+func (m *MsgResolveContentReport) String() string { return proto.CompactTextString(m) }
+func (*MsgResolveContentReport) ProtoMessage()    {}
+func (*MsgResolveContentReport) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b71b0f911b3fa2ed, []int{14}
+}
+func (m *MsgResolveContentReport) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgResolveContentReport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgResolveContentReport.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgResolveContentReport) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgResolveContentReport.Merge(m, src)
+}
+func (m *MsgResolveContentReport) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgResolveContentReport) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgResolveContentReport.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgResolveContentReport proto.InternalMessageInfo
+
+func (m *MsgResolveContentReport) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgResolveContentReport) GetIndex() string {
+	if m != nil {
+		return m.Index
+	}
+	return ""
+}
+
+func (m *MsgResolveContentReport) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *MsgResolveContentReport) GetResolution() string {
+	if m != nil {
+		return m.Resolution
+	}
+	return ""
+}
+
+// MsgResolveContentReportResponse defines the MsgResolveContentReportResponse message.
+type MsgResolveContentReportResponse struct {
+}
+
+func (m *MsgResolveContentReportResponse) Reset()         { *m = MsgResolveContentReportResponse{} }
+func (m *MsgResolveContentReportResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgResolveContentReportResponse) ProtoMessage()    {}
+func (*MsgResolveContentReportResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b71b0f911b3fa2ed, []int{15}
+}
+func (m *MsgResolveContentReportResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgResolveContentReportResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgResolveContentReportResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgResolveContentReportResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgResolveContentReportResponse.Merge(m, src)
+}
+func (m *MsgResolveContentReportResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgResolveContentReportResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgResolveContentReportResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgResolveContentReportResponse proto.InternalMessageInfo
+
 // MsgCreateGovernanceProposal defines the MsgCreateGovernanceProposal message.
 type MsgCreateGovernanceProposal struct {
 	Creator           string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
@@ -892,7 +1003,7 @@ func (m *MsgCreateGovernanceProposal) Reset()         { *m = MsgCreateGovernance
 func (m *MsgCreateGovernanceProposal) String() string { return proto.CompactTextString(m) }
 func (*MsgCreateGovernanceProposal) ProtoMessage()    {}
 func (*MsgCreateGovernanceProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b71b0f911b3fa2ed, []int{14}
+	return fileDescriptor_b71b0f911b3fa2ed, []int{16}
 }
 func (m *MsgCreateGovernanceProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1013,7 +1124,7 @@ func (m *MsgCreateGovernanceProposalResponse) Reset()         { *m = MsgCreateGo
 func (m *MsgCreateGovernanceProposalResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgCreateGovernanceProposalResponse) ProtoMessage()    {}
 func (*MsgCreateGovernanceProposalResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b71b0f911b3fa2ed, []int{15}
+	return fileDescriptor_b71b0f911b3fa2ed, []int{17}
 }
 func (m *MsgCreateGovernanceProposalResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1062,7 +1173,7 @@ func (m *MsgUpdateGovernanceProposal) Reset()         { *m = MsgUpdateGovernance
 func (m *MsgUpdateGovernanceProposal) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateGovernanceProposal) ProtoMessage()    {}
 func (*MsgUpdateGovernanceProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b71b0f911b3fa2ed, []int{16}
+	return fileDescriptor_b71b0f911b3fa2ed, []int{18}
 }
 func (m *MsgUpdateGovernanceProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1183,7 +1294,7 @@ func (m *MsgUpdateGovernanceProposalResponse) Reset()         { *m = MsgUpdateGo
 func (m *MsgUpdateGovernanceProposalResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateGovernanceProposalResponse) ProtoMessage()    {}
 func (*MsgUpdateGovernanceProposalResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b71b0f911b3fa2ed, []int{17}
+	return fileDescriptor_b71b0f911b3fa2ed, []int{19}
 }
 func (m *MsgUpdateGovernanceProposalResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1222,7 +1333,7 @@ func (m *MsgDeleteGovernanceProposal) Reset()         { *m = MsgDeleteGovernance
 func (m *MsgDeleteGovernanceProposal) String() string { return proto.CompactTextString(m) }
 func (*MsgDeleteGovernanceProposal) ProtoMessage()    {}
 func (*MsgDeleteGovernanceProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b71b0f911b3fa2ed, []int{18}
+	return fileDescriptor_b71b0f911b3fa2ed, []int{20}
 }
 func (m *MsgDeleteGovernanceProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1273,7 +1384,7 @@ func (m *MsgDeleteGovernanceProposalResponse) Reset()         { *m = MsgDeleteGo
 func (m *MsgDeleteGovernanceProposalResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgDeleteGovernanceProposalResponse) ProtoMessage()    {}
 func (*MsgDeleteGovernanceProposalResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b71b0f911b3fa2ed, []int{19}
+	return fileDescriptor_b71b0f911b3fa2ed, []int{21}
 }
 func (m *MsgDeleteGovernanceProposalResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1317,6 +1428,8 @@ func init() {
 	proto.RegisterType((*MsgUpdateContentReportResponse)(nil), "resist.usergroups.v1.MsgUpdateContentReportResponse")
 	proto.RegisterType((*MsgDeleteContentReport)(nil), "resist.usergroups.v1.MsgDeleteContentReport")
 	proto.RegisterType((*MsgDeleteContentReportResponse)(nil), "resist.usergroups.v1.MsgDeleteContentReportResponse")
+	proto.RegisterType((*MsgResolveContentReport)(nil), "resist.usergroups.v1.MsgResolveContentReport")
+	proto.RegisterType((*MsgResolveContentReportResponse)(nil), "resist.usergroups.v1.MsgResolveContentReportResponse")
 	proto.RegisterType((*MsgCreateGovernanceProposal)(nil), "resist.usergroups.v1.MsgCreateGovernanceProposal")
 	proto.RegisterType((*MsgCreateGovernanceProposalResponse)(nil), "resist.usergroups.v1.MsgCreateGovernanceProposalResponse")
 	proto.RegisterType((*MsgUpdateGovernanceProposal)(nil), "resist.usergroups.v1.MsgUpdateGovernanceProposal")
@@ -1328,71 +1441,74 @@ func init() {
 func init() { proto.RegisterFile("resist/usergroups/v1/tx.proto", fileDescriptor_b71b0f911b3fa2ed) }
 
 var fileDescriptor_b71b0f911b3fa2ed = []byte{
-	// 1020 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x58, 0xcf, 0x4f, 0xe3, 0x46,
-	0x14, 0xc6, 0x24, 0x24, 0xf1, 0x23, 0x14, 0x31, 0x1b, 0x2d, 0xc6, 0xbb, 0x9b, 0xa6, 0x20, 0xa4,
-	0x08, 0x41, 0xd2, 0xb0, 0xd5, 0x4a, 0xdd, 0x4b, 0xb5, 0x6c, 0xab, 0x55, 0x0f, 0x48, 0xc8, 0xbb,
-	0xdb, 0x43, 0x2f, 0x91, 0x89, 0x47, 0xc6, 0x52, 0x3c, 0x63, 0xcd, 0x4c, 0x22, 0x72, 0xab, 0x7a,
-	0xaa, 0x7a, 0xea, 0xb1, 0x7f, 0x42, 0x8f, 0x1c, 0x7a, 0x6f, 0xd5, 0xd3, 0x1e, 0xb7, 0x3d, 0xf5,
-	0x54, 0x55, 0x70, 0xe0, 0xdf, 0xa8, 0x3c, 0x63, 0x9b, 0x60, 0x6c, 0x1c, 0x2a, 0x38, 0xac, 0xc4,
-	0x05, 0xe5, 0xfd, 0x98, 0x79, 0xdf, 0x7c, 0xdf, 0x63, 0xe6, 0x25, 0xf0, 0x84, 0x61, 0xee, 0x71,
-	0xd1, 0x1d, 0x71, 0xcc, 0x5c, 0x46, 0x47, 0x01, 0xef, 0x8e, 0x7b, 0x5d, 0x71, 0xdc, 0x09, 0x18,
-	0x15, 0x14, 0x35, 0x54, 0xb8, 0x73, 0x11, 0xee, 0x8c, 0x7b, 0xe6, 0x8a, 0xed, 0x7b, 0x84, 0x76,
-	0xe5, 0x5f, 0x95, 0x68, 0xae, 0x0e, 0x28, 0xf7, 0x29, 0xef, 0xfa, 0xdc, 0x0d, 0x37, 0xf0, 0xb9,
-	0x1b, 0x05, 0xd6, 0x54, 0xa0, 0x2f, 0xad, 0xae, 0x32, 0xa2, 0x50, 0xc3, 0xa5, 0x2e, 0x55, 0xfe,
-	0xf0, 0x53, 0xe4, 0xfd, 0x24, 0x13, 0x51, 0x60, 0x33, 0xdb, 0x8f, 0x16, 0xae, 0xff, 0xa1, 0xc1,
-	0xf2, 0x3e, 0x77, 0xdf, 0x06, 0x8e, 0x2d, 0xf0, 0x81, 0x8c, 0xa0, 0x67, 0xa0, 0xdb, 0x23, 0x71,
-	0x44, 0x99, 0x27, 0x26, 0x86, 0xd6, 0xd2, 0xda, 0xfa, 0x9e, 0xf1, 0xd7, 0xaf, 0x3b, 0x8d, 0xa8,
-	0xe2, 0x0b, 0xc7, 0x61, 0x98, 0xf3, 0xd7, 0x82, 0x79, 0xc4, 0xb5, 0x2e, 0x52, 0xd1, 0x17, 0x50,
-	0x51, 0x7b, 0x1b, 0xf3, 0x2d, 0xad, 0xbd, 0xb8, 0xfb, 0xb8, 0x93, 0x75, 0xe4, 0x8e, 0xaa, 0xb2,
-	0xa7, 0xbf, 0xfb, 0xe7, 0xe3, 0xb9, 0x5f, 0xce, 0x4f, 0xb6, 0x34, 0x2b, 0x5a, 0xf6, 0xfc, 0xd9,
-	0xf7, 0xe7, 0x27, 0x5b, 0x17, 0x1b, 0xfe, 0x78, 0x7e, 0xb2, 0xb5, 0x11, 0x1d, 0xe1, 0x78, 0xfa,
-	0x10, 0x29, 0xc0, 0xeb, 0x6b, 0xb0, 0x9a, 0x72, 0x59, 0x98, 0x07, 0x94, 0x70, 0xbc, 0xfe, 0xf3,
-	0x3c, 0xa0, 0x7d, 0xee, 0xbe, 0x64, 0xd8, 0x16, 0xf8, 0x2d, 0xc7, 0xec, 0x55, 0xb8, 0x05, 0xda,
-	0x85, 0xea, 0x20, 0x74, 0x51, 0x56, 0x78, 0xc0, 0x38, 0x11, 0x35, 0x60, 0xc1, 0x23, 0x0e, 0x3e,
-	0x96, 0xa7, 0xd3, 0x2d, 0x65, 0x20, 0x04, 0x65, 0x62, 0xfb, 0xd8, 0x28, 0x49, 0xa7, 0xfc, 0x8c,
-	0x5a, 0xb0, 0xe8, 0x60, 0x3e, 0x60, 0x5e, 0x20, 0x3c, 0x4a, 0x8c, 0xb2, 0x0c, 0x4d, 0xbb, 0xc2,
-	0xbd, 0x6c, 0xc7, 0xf7, 0x88, 0xb1, 0xa0, 0xf6, 0x92, 0x06, 0x32, 0xa0, 0xea, 0x63, 0xff, 0x10,
-	0x33, 0x6e, 0x54, 0x5a, 0xa5, 0xb6, 0x6e, 0xc5, 0x26, 0xda, 0x84, 0x8f, 0xc6, 0x54, 0xe0, 0xbe,
-	0x38, 0x62, 0x98, 0x1f, 0xd1, 0xa1, 0x63, 0x54, 0x5b, 0x5a, 0xbb, 0x6c, 0x2d, 0x85, 0xde, 0x37,
-	0xb1, 0x13, 0x3d, 0x01, 0x90, 0x68, 0xb1, 0xd3, 0xb7, 0x85, 0x51, 0x93, 0x29, 0x7a, 0xe4, 0x79,
-	0x21, 0x9e, 0xd7, 0x43, 0x7e, 0xe3, 0xf3, 0xac, 0x3f, 0x06, 0xf3, 0x2a, 0x33, 0x69, 0xe2, 0x14,
-	0xa9, 0xf7, 0xc4, 0x5d, 0x25, 0x2e, 0xc5, 0x4c, 0x42, 0xdc, 0x50, 0xf2, 0xf6, 0x25, 0x1e, 0xe2,
-	0x3b, 0xe1, 0x2d, 0x13, 0x4b, 0xaa, 0x5a, 0x82, 0xe5, 0xf7, 0x79, 0x78, 0x98, 0x68, 0xfc, 0x92,
-	0x12, 0x81, 0x89, 0xb0, 0x70, 0x40, 0x99, 0xb8, 0x45, 0x21, 0x57, 0xa1, 0x1a, 0x50, 0x2e, 0xfa,
-	0x9e, 0x23, 0xb5, 0x2c, 0x5b, 0x95, 0xd0, 0xfc, 0xda, 0x41, 0x26, 0xd4, 0x98, 0x2c, 0x86, 0x59,
-	0x24, 0x65, 0x62, 0xa3, 0x87, 0x50, 0x61, 0xd8, 0xe6, 0x34, 0x16, 0x32, 0xb2, 0xc2, 0x35, 0x78,
-	0xec, 0x39, 0x98, 0x0c, 0xb0, 0x51, 0x51, 0x6b, 0x62, 0x3b, 0x5c, 0xc3, 0x85, 0x2d, 0x46, 0x5c,
-	0x6a, 0xa8, 0x5b, 0x91, 0x85, 0x76, 0x00, 0x0d, 0xa8, 0xef, 0x8f, 0x88, 0x27, 0x26, 0x7d, 0x16,
-	0x9d, 0x5d, 0x8a, 0xa8, 0x5b, 0x2b, 0x49, 0x24, 0x26, 0x05, 0x35, 0x01, 0x18, 0xe6, 0x74, 0x38,
-	0x92, 0x3d, 0xa6, 0xcb, 0xb4, 0x29, 0x4f, 0x8a, 0xe0, 0x16, 0x34, 0xb3, 0x19, 0x4c, 0x93, 0xac,
-	0xfa, 0xe1, 0x9e, 0xe4, 0xff, 0x4f, 0x72, 0x06, 0x83, 0x09, 0xc9, 0x81, 0xe4, 0x58, 0xf5, 0xf9,
-	0x1d, 0x71, 0x9c, 0x89, 0x29, 0xa3, 0x62, 0x82, 0xe9, 0xb7, 0x12, 0x3c, 0x4a, 0x7a, 0xe3, 0x15,
-	0x1d, 0x63, 0x46, 0x6c, 0x32, 0xc0, 0x07, 0x8c, 0x06, 0x94, 0xdb, 0xc3, 0x5b, 0x54, 0xbf, 0x01,
-	0x0b, 0xc2, 0x13, 0xc3, 0xf8, 0xb2, 0x54, 0xc6, 0x0c, 0xb7, 0xa5, 0x09, 0xb5, 0x40, 0xa2, 0xc1,
-	0x2c, 0x6a, 0x81, 0xc4, 0x46, 0x1b, 0xb0, 0x14, 0x44, 0x48, 0xfb, 0x62, 0x12, 0xc4, 0x9d, 0x50,
-	0x8f, 0x9d, 0x6f, 0x26, 0x01, 0x46, 0x1d, 0x78, 0x30, 0xa6, 0xc2, 0x23, 0x6e, 0x3f, 0xc0, 0xcc,
-	0xa3, 0x4e, 0x9f, 0x0b, 0x9b, 0x09, 0xd9, 0x1a, 0x25, 0x6b, 0x45, 0x85, 0x0e, 0x64, 0xe4, 0x75,
-	0x18, 0x40, 0x5b, 0xb0, 0x72, 0x39, 0x1f, 0x13, 0x47, 0x36, 0x49, 0xc9, 0x5a, 0x9e, 0xce, 0xfe,
-	0x8a, 0x38, 0xe8, 0x11, 0xe8, 0x13, 0xcc, 0xfb, 0xe1, 0x45, 0xcc, 0x65, 0x87, 0x94, 0xad, 0xda,
-	0x04, 0xf3, 0x6f, 0x42, 0x1b, 0xad, 0x41, 0x8d, 0xd0, 0x28, 0x06, 0x32, 0x56, 0x25, 0x54, 0x85,
-	0x36, 0x60, 0xc9, 0x3e, 0xe4, 0xc2, 0xf6, 0x48, 0x14, 0x5f, 0x94, 0xf1, 0x7a, 0xe4, 0x54, 0x49,
-	0x17, 0x6d, 0x5c, 0x9f, 0x6e, 0xe3, 0x94, 0xc6, 0x9b, 0xb0, 0x71, 0x8d, 0x80, 0x69, 0xa1, 0x55,
-	0x7f, 0xde, 0x0b, 0xfd, 0xc1, 0x0a, 0x9d, 0x27, 0x60, 0x22, 0xf4, 0x48, 0xea, 0xac, 0xfe, 0xe7,
-	0xef, 0x52, 0xe7, 0x4c, 0x74, 0x79, 0x65, 0x63, 0x74, 0xbb, 0x7f, 0xea, 0x50, 0xda, 0xe7, 0x2e,
-	0x72, 0xa0, 0x7e, 0x69, 0x5e, 0xdf, 0xcc, 0x9e, 0xb3, 0x53, 0x23, 0xb1, 0xb9, 0x33, 0x53, 0x5a,
-	0x72, 0x83, 0xfb, 0xb0, 0x9c, 0x9e, 0x9a, 0xdb, 0xb9, 0x3b, 0xa4, 0x32, 0xcd, 0x4f, 0x67, 0xcd,
-	0x9c, 0x2e, 0x97, 0x9e, 0x35, 0xdb, 0x05, 0x80, 0x67, 0x29, 0x97, 0x33, 0xa5, 0x85, 0xe5, 0xd2,
-	0x23, 0x5a, 0x7e, 0xb9, 0x54, 0xe6, 0x35, 0xe5, 0x72, 0x06, 0x31, 0x34, 0x81, 0x07, 0x59, 0x43,
-	0xd8, 0x76, 0x01, 0x4d, 0x97, 0xb2, 0xcd, 0xcf, 0x6e, 0x92, 0x3d, 0x5d, 0x3a, 0x6b, 0x34, 0xd9,
-	0x2e, 0xa0, 0x6c, 0xd6, 0xd2, 0xd7, 0x3c, 0xda, 0x61, 0xe9, 0xac, 0x17, 0x7b, 0xbb, 0x80, 0xbe,
-	0x59, 0x4b, 0x5f, 0xf3, 0x36, 0xa3, 0x1f, 0x34, 0x30, 0x72, 0x1f, 0xe6, 0x5e, 0x01, 0x91, 0x57,
-	0x97, 0x98, 0x9f, 0xdf, 0x78, 0xc9, 0x25, 0x28, 0xb9, 0x4f, 0x47, 0xaf, 0x80, 0xd8, 0x1b, 0x41,
-	0x29, 0xba, 0xdf, 0x24, 0x94, 0xdc, 0xdb, 0xad, 0x57, 0x40, 0xf4, 0x8d, 0xa0, 0x14, 0x5d, 0x66,
-	0xe6, 0xc2, 0x77, 0xe1, 0x57, 0xff, 0xbd, 0xa7, 0xef, 0x4e, 0x9b, 0xda, 0xfb, 0xd3, 0xa6, 0xf6,
-	0xef, 0x69, 0x53, 0xfb, 0xe9, 0xac, 0x39, 0xf7, 0xfe, 0xac, 0x39, 0xf7, 0xf7, 0x59, 0x73, 0xee,
-	0xdb, 0xb5, 0xac, 0x6f, 0xfe, 0xe1, 0xfb, 0xc5, 0x0f, 0x2b, 0xf2, 0xb7, 0x8b, 0xa7, 0xff, 0x05,
-	0x00, 0x00, 0xff, 0xff, 0x25, 0x3d, 0x20, 0x52, 0x72, 0x11, 0x00, 0x00,
+	// 1070 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x58, 0x3f, 0x6f, 0xdb, 0x46,
+	0x14, 0x37, 0x23, 0x59, 0x12, 0x9f, 0xe5, 0x1a, 0xbe, 0x08, 0x31, 0xcd, 0x24, 0x8a, 0x62, 0xc3,
+	0x80, 0x20, 0xd8, 0x52, 0xe5, 0xb4, 0x01, 0x9a, 0xa5, 0x88, 0xd3, 0x22, 0xe8, 0x60, 0xc0, 0x60,
+	0x92, 0x0e, 0x5d, 0x04, 0x5a, 0x3c, 0xd0, 0x04, 0x44, 0x1e, 0x71, 0x77, 0x12, 0x2c, 0xa0, 0x43,
+	0xd1, 0xa9, 0xe8, 0xd4, 0xb1, 0x1f, 0xa1, 0xdd, 0x3c, 0x74, 0x6f, 0xd1, 0x29, 0x5b, 0x83, 0x4e,
+	0x9d, 0x8a, 0xc2, 0x1e, 0xfc, 0x35, 0x0a, 0xde, 0x91, 0x94, 0x44, 0x93, 0xa6, 0x54, 0xc4, 0x43,
+	0x01, 0x2f, 0x82, 0xde, 0x9f, 0xbb, 0xf7, 0xde, 0xef, 0xf7, 0x78, 0xf7, 0x48, 0x78, 0x48, 0x31,
+	0x73, 0x18, 0xef, 0x0c, 0x19, 0xa6, 0x36, 0x25, 0x43, 0x9f, 0x75, 0x46, 0xdd, 0x0e, 0x3f, 0x6d,
+	0xfb, 0x94, 0x70, 0x82, 0x6a, 0xd2, 0xdc, 0x9e, 0x98, 0xdb, 0xa3, 0xae, 0xbe, 0x6e, 0xba, 0x8e,
+	0x47, 0x3a, 0xe2, 0x57, 0x3a, 0xea, 0x1b, 0x7d, 0xc2, 0x5c, 0xc2, 0x3a, 0x2e, 0xb3, 0x83, 0x0d,
+	0x5c, 0x66, 0x87, 0x86, 0x4d, 0x69, 0xe8, 0x09, 0xa9, 0x23, 0x85, 0xd0, 0x54, 0xb3, 0x89, 0x4d,
+	0xa4, 0x3e, 0xf8, 0x17, 0x6a, 0x1f, 0xa7, 0x66, 0xe4, 0x9b, 0xd4, 0x74, 0xc3, 0x85, 0x5b, 0xbf,
+	0x2b, 0xb0, 0x76, 0xc8, 0xec, 0x37, 0xbe, 0x65, 0x72, 0x7c, 0x24, 0x2c, 0xe8, 0x29, 0xa8, 0xe6,
+	0x90, 0x9f, 0x10, 0xea, 0xf0, 0xb1, 0xa6, 0x34, 0x94, 0xa6, 0x7a, 0xa0, 0xfd, 0xf9, 0xcb, 0x5e,
+	0x2d, 0x8c, 0xf8, 0xdc, 0xb2, 0x28, 0x66, 0xec, 0x15, 0xa7, 0x8e, 0x67, 0x1b, 0x13, 0x57, 0xf4,
+	0x29, 0x94, 0xe4, 0xde, 0xda, 0x9d, 0x86, 0xd2, 0x5c, 0xd9, 0x7f, 0xd0, 0x4e, 0x2b, 0xb9, 0x2d,
+	0xa3, 0x1c, 0xa8, 0x6f, 0xff, 0x7e, 0xb4, 0xf4, 0xd3, 0xe5, 0x59, 0x4b, 0x31, 0xc2, 0x65, 0xcf,
+	0x9e, 0x7e, 0x7b, 0x79, 0xd6, 0x9a, 0x6c, 0xf8, 0xfd, 0xe5, 0x59, 0x6b, 0x3b, 0x2c, 0xe1, 0x74,
+	0xba, 0x88, 0x44, 0xc2, 0x5b, 0x9b, 0xb0, 0x91, 0x50, 0x19, 0x98, 0xf9, 0xc4, 0x63, 0x78, 0xeb,
+	0xc7, 0x3b, 0x80, 0x0e, 0x99, 0xfd, 0x82, 0x62, 0x93, 0xe3, 0x37, 0x0c, 0xd3, 0x97, 0xc1, 0x16,
+	0x68, 0x1f, 0xca, 0xfd, 0x40, 0x45, 0x68, 0x6e, 0x81, 0x91, 0x23, 0xaa, 0xc1, 0xb2, 0xe3, 0x59,
+	0xf8, 0x54, 0x54, 0xa7, 0x1a, 0x52, 0x40, 0x08, 0x8a, 0x9e, 0xe9, 0x62, 0xad, 0x20, 0x94, 0xe2,
+	0x3f, 0x6a, 0xc0, 0x8a, 0x85, 0x59, 0x9f, 0x3a, 0x3e, 0x77, 0x88, 0xa7, 0x15, 0x85, 0x69, 0x5a,
+	0x15, 0xec, 0x65, 0x5a, 0xae, 0xe3, 0x69, 0xcb, 0x72, 0x2f, 0x21, 0x20, 0x0d, 0xca, 0x2e, 0x76,
+	0x8f, 0x31, 0x65, 0x5a, 0xa9, 0x51, 0x68, 0xaa, 0x46, 0x24, 0xa2, 0x1d, 0xf8, 0x60, 0x44, 0x38,
+	0xee, 0xf1, 0x13, 0x8a, 0xd9, 0x09, 0x19, 0x58, 0x5a, 0xb9, 0xa1, 0x34, 0x8b, 0xc6, 0x6a, 0xa0,
+	0x7d, 0x1d, 0x29, 0xd1, 0x43, 0x00, 0x91, 0x2d, 0xb6, 0x7a, 0x26, 0xd7, 0x2a, 0xc2, 0x45, 0x0d,
+	0x35, 0xcf, 0xf9, 0xb3, 0x6a, 0x80, 0x6f, 0x54, 0xcf, 0xd6, 0x03, 0xd0, 0xaf, 0x22, 0x93, 0x04,
+	0x4e, 0x82, 0x7a, 0x0b, 0xdc, 0x55, 0xe0, 0x12, 0xc8, 0xc4, 0xc0, 0x0d, 0x04, 0x6e, 0x9f, 0xe1,
+	0x01, 0xbe, 0x11, 0xdc, 0x52, 0x73, 0x49, 0x44, 0x8b, 0x73, 0xf9, 0xed, 0x0e, 0xdc, 0x8b, 0x39,
+	0x7e, 0x41, 0x3c, 0x8e, 0x3d, 0x6e, 0x60, 0x9f, 0x50, 0xfe, 0x1e, 0x89, 0xdc, 0x80, 0xb2, 0x4f,
+	0x18, 0xef, 0x39, 0x96, 0xe0, 0xb2, 0x68, 0x94, 0x02, 0xf1, 0x0b, 0x0b, 0xe9, 0x50, 0xa1, 0x22,
+	0x18, 0xa6, 0x21, 0x95, 0xb1, 0x8c, 0xee, 0x41, 0x89, 0x62, 0x93, 0x91, 0x88, 0xc8, 0x50, 0x0a,
+	0xd6, 0xe0, 0x91, 0x63, 0x61, 0xaf, 0x8f, 0xb5, 0x92, 0x5c, 0x13, 0xc9, 0xc1, 0x1a, 0xc6, 0x4d,
+	0x3e, 0x64, 0x82, 0x43, 0xd5, 0x08, 0x25, 0xb4, 0x07, 0xa8, 0x4f, 0x5c, 0x77, 0xe8, 0x39, 0x7c,
+	0xdc, 0xa3, 0x61, 0xed, 0x82, 0x44, 0xd5, 0x58, 0x8f, 0x2d, 0x11, 0x28, 0xa8, 0x0e, 0x40, 0x31,
+	0x23, 0x83, 0xa1, 0xe8, 0x31, 0x55, 0xb8, 0x4d, 0x69, 0x12, 0x00, 0x37, 0xa0, 0x9e, 0x8e, 0x60,
+	0x12, 0x64, 0xd9, 0x0f, 0xb7, 0x20, 0xff, 0x77, 0x90, 0x53, 0x10, 0x8c, 0x41, 0xf6, 0x05, 0xc6,
+	0xb2, 0xcf, 0x6f, 0x08, 0xe3, 0xd4, 0x9c, 0x52, 0x22, 0xc6, 0x39, 0xfd, 0xac, 0x88, 0x7b, 0xc7,
+	0x08, 0xaa, 0x1a, 0xdd, 0x18, 0xf3, 0x13, 0x42, 0x0a, 0x33, 0x84, 0xcc, 0x22, 0x5c, 0xcc, 0x41,
+	0xf8, 0x31, 0x3c, 0xca, 0x48, 0x35, 0x2e, 0xe7, 0xd7, 0x02, 0xdc, 0x8f, 0x5b, 0xfd, 0x25, 0x19,
+	0x61, 0xea, 0x99, 0x5e, 0x1f, 0x1f, 0x51, 0xe2, 0x13, 0x66, 0x0e, 0xde, 0x63, 0x49, 0x35, 0x58,
+	0xe6, 0x0e, 0x1f, 0x44, 0x67, 0xbf, 0x14, 0xe6, 0x38, 0xfc, 0x75, 0xa8, 0xf8, 0x22, 0x1b, 0x4c,
+	0xc3, 0x8e, 0x8e, 0x65, 0xb4, 0x0d, 0xab, 0x7e, 0x98, 0x69, 0x8f, 0x8f, 0xfd, 0xa8, 0xb1, 0xab,
+	0x91, 0xf2, 0xf5, 0xd8, 0xc7, 0xa8, 0x0d, 0x77, 0x47, 0x84, 0x3b, 0x9e, 0xdd, 0xf3, 0x31, 0x75,
+	0x88, 0xd5, 0x63, 0xdc, 0xa4, 0x5c, 0x74, 0x7a, 0xc1, 0x58, 0x97, 0xa6, 0x23, 0x61, 0x79, 0x15,
+	0x18, 0x50, 0x0b, 0xd6, 0x67, 0xfd, 0xb1, 0x67, 0x89, 0x9e, 0x2f, 0x18, 0x6b, 0xd3, 0xde, 0x9f,
+	0x7b, 0x16, 0xba, 0x0f, 0xea, 0x18, 0xb3, 0x5e, 0x70, 0xaf, 0x30, 0xd1, 0xf0, 0x45, 0xa3, 0x32,
+	0xc6, 0xec, 0xcb, 0x40, 0x46, 0x9b, 0x50, 0xf1, 0x48, 0x68, 0x03, 0x61, 0x2b, 0x7b, 0x44, 0x9a,
+	0xb6, 0x61, 0xd5, 0x3c, 0x66, 0xdc, 0x74, 0xbc, 0xd0, 0xbe, 0x22, 0xec, 0xd5, 0x50, 0x29, 0x9d,
+	0x26, 0x4d, 0x50, 0x9d, 0x6e, 0x82, 0x04, 0xc9, 0x3b, 0xb0, 0x7d, 0x0d, 0x81, 0x49, 0xa2, 0xe5,
+	0xe3, 0x76, 0x4b, 0xf4, 0xff, 0x96, 0xe8, 0x2c, 0x02, 0x63, 0xa2, 0x87, 0x82, 0x67, 0x79, 0x84,
+	0xdd, 0x24, 0xcf, 0xa9, 0xd9, 0x65, 0x85, 0x8d, 0xb2, 0xdb, 0xff, 0x03, 0xa0, 0x70, 0xc8, 0x6c,
+	0x64, 0x41, 0x75, 0xe6, 0xf5, 0x63, 0x27, 0xfd, 0xb5, 0x21, 0x31, 0xe1, 0xeb, 0x7b, 0x73, 0xb9,
+	0xc5, 0x17, 0x92, 0x0b, 0x6b, 0xc9, 0x97, 0x80, 0x66, 0xe6, 0x0e, 0x09, 0x4f, 0xfd, 0xc3, 0x79,
+	0x3d, 0xa7, 0xc3, 0x25, 0x47, 0xe7, 0x66, 0x4e, 0xc2, 0xf3, 0x84, 0xcb, 0x18, 0x3a, 0x83, 0x70,
+	0xc9, 0x89, 0x33, 0x3b, 0x5c, 0xc2, 0xf3, 0x9a, 0x70, 0x19, 0x73, 0x25, 0x1a, 0xc3, 0xdd, 0xb4,
+	0x99, 0x72, 0x37, 0x07, 0xa6, 0x19, 0x6f, 0xfd, 0xa3, 0x45, 0xbc, 0xa7, 0x43, 0xa7, 0x4d, 0x5a,
+	0xbb, 0x39, 0x90, 0xcd, 0x1b, 0xfa, 0x9a, 0x19, 0x24, 0x08, 0x9d, 0x36, 0x80, 0xec, 0xe6, 0xc0,
+	0x37, 0x6f, 0xe8, 0x6b, 0x46, 0x0d, 0xf4, 0x35, 0xd4, 0x52, 0xc7, 0x8c, 0xec, 0x87, 0x20, 0xcd,
+	0x5d, 0xff, 0x78, 0x21, 0xf7, 0x38, 0xfa, 0x77, 0x0a, 0x68, 0x99, 0x63, 0x41, 0x37, 0x87, 0xc6,
+	0xab, 0x4b, 0xf4, 0x4f, 0x16, 0x5e, 0x32, 0x93, 0x4a, 0xe6, 0xc5, 0xd5, 0xcd, 0xa1, 0x75, 0xa1,
+	0x54, 0xf2, 0x4e, 0x57, 0x91, 0x4a, 0xe6, 0xd9, 0xda, 0xcd, 0xa1, 0x79, 0xa1, 0x54, 0xf2, 0x8e,
+	0x52, 0x7d, 0xf9, 0x9b, 0xcb, 0xb3, 0x96, 0x72, 0xf0, 0xe4, 0xed, 0x79, 0x5d, 0x79, 0x77, 0x5e,
+	0x57, 0xfe, 0x39, 0xaf, 0x2b, 0x3f, 0x5c, 0xd4, 0x97, 0xde, 0x5d, 0xd4, 0x97, 0xfe, 0xba, 0xa8,
+	0x2f, 0x7d, 0xb5, 0x99, 0xf6, 0x19, 0x25, 0xb8, 0x3d, 0xd9, 0x71, 0x49, 0x7c, 0x08, 0x7a, 0xf2,
+	0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xa6, 0xee, 0x7c, 0xf4, 0xbf, 0x12, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1422,6 +1538,10 @@ type MsgClient interface {
 	UpdateContentReport(ctx context.Context, in *MsgUpdateContentReport, opts ...grpc.CallOption) (*MsgUpdateContentReportResponse, error)
 	// DeleteContentReport defines the DeleteContentReport RPC.
 	DeleteContentReport(ctx context.Context, in *MsgDeleteContentReport, opts ...grpc.CallOption) (*MsgDeleteContentReportResponse, error)
+	// ResolveContentReport defines the ResolveContentReport RPC used by the
+	// admin of the group of the reported post, or the module authority, to
+	// change the status of a report.
+	ResolveContentReport(ctx context.Context, in *MsgResolveContentReport, opts ...grpc.CallOption) (*MsgResolveContentReportResponse, error)
 	// CreateGovernanceProposal defines the CreateGovernanceProposal RPC.
 	CreateGovernanceProposal(ctx context.Context, in *MsgCreateGovernanceProposal, opts ...grpc.CallOption) (*MsgCreateGovernanceProposalResponse, error)
 	// UpdateGovernanceProposal defines the UpdateGovernanceProposal RPC.
@@ -1501,6 +1621,15 @@ func (c *msgClient) DeleteContentReport(ctx context.Context, in *MsgDeleteConten
 	return out, nil
 }
 
+func (c *msgClient) ResolveContentReport(ctx context.Context, in *MsgResolveContentReport, opts ...grpc.CallOption) (*MsgResolveContentReportResponse, error) {
+	out := new(MsgResolveContentReportResponse)
+	err := c.cc.Invoke(ctx, "/resist.usergroups.v1.Msg/ResolveContentReport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) CreateGovernanceProposal(ctx context.Context, in *MsgCreateGovernanceProposal, opts ...grpc.CallOption) (*MsgCreateGovernanceProposalResponse, error) {
 	out := new(MsgCreateGovernanceProposalResponse)
 	err := c.cc.Invoke(ctx, "/resist.usergroups.v1.Msg/CreateGovernanceProposal", in, out, opts...)
@@ -1545,6 +1674,10 @@ type MsgServer interface {
 	UpdateContentReport(context.Context, *MsgUpdateContentReport) (*MsgUpdateContentReportResponse, error)
 	// DeleteContentReport defines the DeleteContentReport RPC.
 	DeleteContentReport(context.Context, *MsgDeleteContentReport) (*MsgDeleteContentReportResponse, error)
+	// ResolveContentReport defines the ResolveContentReport RPC used by the
+	// admin of the group of the reported post, or the module authority, to
+	// change the status of a report.
+	ResolveContentReport(context.Context, *MsgResolveContentReport) (*MsgResolveContentReportResponse, error)
 	// CreateGovernanceProposal defines the CreateGovernanceProposal RPC.
 	CreateGovernanceProposal(context.Context, *MsgCreateGovernanceProposal) (*MsgCreateGovernanceProposalResponse, error)
 	// UpdateGovernanceProposal defines the UpdateGovernanceProposal RPC.
@@ -1578,6 +1711,9 @@ func (*UnimplementedMsgServer) UpdateContentReport(ctx context.Context, req *Msg
 func (*UnimplementedMsgServer) DeleteContentReport(ctx context.Context, req *MsgDeleteContentReport) (*MsgDeleteContentReportResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteContentReport not implemented")
 }
+func (*UnimplementedMsgServer) ResolveContentReport(ctx context.Context, req *MsgResolveContentReport) (*MsgResolveContentReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveContentReport not implemented")
+}
 func (*UnimplementedMsgServer) CreateGovernanceProposal(ctx context.Context, req *MsgCreateGovernanceProposal) (*MsgCreateGovernanceProposalResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateGovernanceProposal not implemented")
 }
@@ -1718,6 +1854,24 @@ func _Msg_DeleteContentReport_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_ResolveContentReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgResolveContentReport)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ResolveContentReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/resist.usergroups.v1.Msg/ResolveContentReport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ResolveContentReport(ctx, req.(*MsgResolveContentReport))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_CreateGovernanceProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgCreateGovernanceProposal)
 	if err := dec(in); err != nil {
@@ -1805,6 +1959,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteContentReport",
 			Handler:    _Msg_DeleteContentReport_Handler,
 		},
+		{
+			MethodName: "ResolveContentReport",
+			Handler:    _Msg_ResolveContentReport_Handler,
+		},
 		{
 			MethodName: "CreateGovernanceProposal",
 			Handler:    _Msg_CreateGovernanceProposal_Handler,
@@ -2419,6 +2577,80 @@ func (m *MsgDeleteContentReportResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgResolveContentReport) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgResolveContentReport) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgResolveContentReport) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Resolution) > 0 {
+		i -= len(m.Resolution)
+		copy(dAtA[i:], m.Resolution)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Resolution)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Index) > 0 {
+		i -= len(m.Index)
+		copy(dAtA[i:], m.Index)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Index)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgResolveContentReportResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgResolveContentReportResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgResolveContentReportResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func (m *MsgCreateGovernanceProposal) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3012,6 +3244,40 @@ func (m *MsgDeleteContentReportResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgResolveContentReport) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Index)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Resolution)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgResolveContentReportResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func (m *MsgCreateGovernanceProposal) Size() (n int) {
 	if m == nil {
 		return 0
@@ -5069,6 +5335,234 @@ func (m *MsgDeleteContentReportResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgResolveContentReport) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgResolveContentReport: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgResolveContentReport: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Index = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resolution", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Resolution = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgResolveContentReportResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgResolveContentReportResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgResolveContentReportResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *MsgCreateGovernanceProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0